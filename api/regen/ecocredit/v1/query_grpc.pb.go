@@ -22,7 +22,8 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type QueryClient interface {
-	// Classes queries for all credit classes with pagination.
+	// Classes queries for all credit classes with pagination. The credit classes
+	// can optionally be filtered by status.
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
 	// ClassesByAdmin queries for all credit classes with a specific admin
 	// address.
@@ -34,6 +35,8 @@ type QueryClient interface {
 	// Projects queries for all projects with pagination.
 	Projects(ctx context.Context, in *QueryProjectsRequest, opts ...grpc.CallOption) (*QueryProjectsResponse, error)
 	// ProjectsByClass queries for all projects within a class with pagination.
+	// Projects that have been migrated to a different credit class are listed
+	// under the credit class to which they were migrated.
 	ProjectsByClass(ctx context.Context, in *QueryProjectsByClassRequest, opts ...grpc.CallOption) (*QueryProjectsByClassResponse, error)
 	// ProjectsByReferenceId queries for all projects by reference-id with
 	// pagination.
@@ -41,7 +44,9 @@ type QueryClient interface {
 	// ProjectsByAdmin queries for all projects by admin with
 	// pagination.
 	ProjectsByAdmin(ctx context.Context, in *QueryProjectsByAdminRequest, opts ...grpc.CallOption) (*QueryProjectsByAdminResponse, error)
-	// Project queries for information on a project.
+	// Project queries for information on a project. The project id can also be
+	// a previous project id of a project that has been migrated to a different
+	// credit class, in which case the current project is returned.
 	Project(ctx context.Context, in *QueryProjectRequest, opts ...grpc.CallOption) (*QueryProjectResponse, error)
 	// Batches queries for all batches with pagination.
 	Batches(ctx context.Context, in *QueryBatchesRequest, opts ...grpc.CallOption) (*QueryBatchesResponse, error)
@@ -99,6 +104,211 @@ type QueryClient interface {
 	//
 	// Since Revision 1
 	AllowedBridgeChains(ctx context.Context, in *QueryAllowedBridgeChainsRequest, opts ...grpc.CallOption) (*QueryAllowedBridgeChainsResponse, error)
+	// Retirement queries a retirement record by id.
+	//
+	// Since Revision 1
+	Retirement(ctx context.Context, in *QueryRetirementRequest, opts ...grpc.CallOption) (*QueryRetirementResponse, error)
+	// RetirementsByOwner queries all retirement records for a given owner
+	// address.
+	//
+	// Since Revision 1
+	RetirementsByOwner(ctx context.Context, in *QueryRetirementsByOwnerRequest, opts ...grpc.CallOption) (*QueryRetirementsByOwnerResponse, error)
+	// RetirementsByBatch queries all retirement records for a given credit
+	// batch.
+	//
+	// Since Revision 1
+	RetirementsByBatch(ctx context.Context, in *QueryRetirementsByBatchRequest, opts ...grpc.CallOption) (*QueryRetirementsByBatchResponse, error)
+	// Certificate queries a retirement certificate by the x/nft class and token
+	// identifiers and resolves the certificate to its underlying retirement.
+	//
+	// Since Revision 1
+	Certificate(ctx context.Context, in *QueryCertificateRequest, opts ...grpc.CallOption) (*QueryCertificateResponse, error)
+	// CertificatesByOwner queries all retirement certificates currently owned by
+	// a given account.
+	//
+	// Since Revision 1
+	CertificatesByOwner(ctx context.Context, in *QueryCertificatesByOwnerRequest, opts ...grpc.CallOption) (*QueryCertificatesByOwnerResponse, error)
+	// CreditLock queries a credit lock by id.
+	//
+	// Since Revision 1
+	CreditLock(ctx context.Context, in *QueryCreditLockRequest, opts ...grpc.CallOption) (*QueryCreditLockResponse, error)
+	// CreditLocksByOwner queries all credit locks for a given owner address.
+	//
+	// Since Revision 1
+	CreditLocksByOwner(ctx context.Context, in *QueryCreditLocksByOwnerRequest, opts ...grpc.CallOption) (*QueryCreditLocksByOwnerResponse, error)
+	// CreditLocksByBatch queries all credit locks for a given credit batch.
+	//
+	// Since Revision 1
+	CreditLocksByBatch(ctx context.Context, in *QueryCreditLocksByBatchRequest, opts ...grpc.CallOption) (*QueryCreditLocksByBatchResponse, error)
+	// ClassBuffer queries the buffer pool of a credit class.
+	//
+	// Since Revision 1
+	ClassBuffer(ctx context.Context, in *QueryClassBufferRequest, opts ...grpc.CallOption) (*QueryClassBufferResponse, error)
+	// Reversal queries a reversal record by id.
+	//
+	// Since Revision 1
+	Reversal(ctx context.Context, in *QueryReversalRequest, opts ...grpc.CallOption) (*QueryReversalResponse, error)
+	// ReversalsByClass queries all reversal records for a given credit class.
+	//
+	// Since Revision 1
+	ReversalsByClass(ctx context.Context, in *QueryReversalsByClassRequest, opts ...grpc.CallOption) (*QueryReversalsByClassResponse, error)
+	// ClassVerifiers queries for the addresses of the verifiers for a credit
+	// class.
+	//
+	// Since Revision 1
+	ClassVerifiers(ctx context.Context, in *QueryClassVerifiersRequest, opts ...grpc.CallOption) (*QueryClassVerifiersResponse, error)
+	// ProjectsByStatus queries for all projects with a given status with
+	// pagination.
+	//
+	// Since Revision 1
+	ProjectsByStatus(ctx context.Context, in *QueryProjectsByStatusRequest, opts ...grpc.CallOption) (*QueryProjectsByStatusResponse, error)
+	// ProjectStatusHistory queries the status history of a project with
+	// pagination.
+	//
+	// Since Revision 1
+	ProjectStatusHistory(ctx context.Context, in *QueryProjectStatusHistoryRequest, opts ...grpc.CallOption) (*QueryProjectStatusHistoryResponse, error)
+	// ClassBatchApproval queries the batch approval setting of a credit class.
+	//
+	// Since Revision 1
+	ClassBatchApproval(ctx context.Context, in *QueryClassBatchApprovalRequest, opts ...grpc.CallOption) (*QueryClassBatchApprovalResponse, error)
+	// PendingBatch queries a pending batch by its unique identifier.
+	//
+	// Since Revision 1
+	PendingBatch(ctx context.Context, in *QueryPendingBatchRequest, opts ...grpc.CallOption) (*QueryPendingBatchResponse, error)
+	// PendingBatchesByClass queries all pending batches of a credit class with
+	// pagination.
+	//
+	// Since Revision 1
+	PendingBatchesByClass(ctx context.Context, in *QueryPendingBatchesByClassRequest, opts ...grpc.CallOption) (*QueryPendingBatchesByClassResponse, error)
+	// IssuanceHeadroom queries the remaining amount of credits an issuer can
+	// issue for a project within a vintage year given the issuance caps of the
+	// credit class.
+	//
+	// Since Revision 1
+	IssuanceHeadroom(ctx context.Context, in *QueryIssuanceHeadroomRequest, opts ...grpc.CallOption) (*QueryIssuanceHeadroomResponse, error)
+	// BatchMetadataHistory queries the metadata history of a credit batch with
+	// pagination.
+	//
+	// Since Revision 1
+	BatchMetadataHistory(ctx context.Context, in *QueryBatchMetadataHistoryRequest, opts ...grpc.CallOption) (*QueryBatchMetadataHistoryResponse, error)
+	// ClassBatchMetadataCosign queries whether or not the admin of a credit class
+	// must co-sign updates to the metadata of the credit batches of the credit
+	// class.
+	//
+	// Since Revision 1
+	ClassBatchMetadataCosign(ctx context.Context, in *QueryClassBatchMetadataCosignRequest, opts ...grpc.CallOption) (*QueryClassBatchMetadataCosignResponse, error)
+	// SupplyByProject queries the aggregated tradable, retired, and cancelled
+	// supply of all credit batches issued within a project.
+	//
+	// Since Revision 1
+	SupplyByProject(ctx context.Context, in *QuerySupplyByProjectRequest, opts ...grpc.CallOption) (*QuerySupplyByProjectResponse, error)
+	// SupplyByClass queries the aggregated tradable, retired, and cancelled
+	// supply of all credit batches issued within a credit class.
+	//
+	// Since Revision 1
+	SupplyByClass(ctx context.Context, in *QuerySupplyByClassRequest, opts ...grpc.CallOption) (*QuerySupplyByClassResponse, error)
+	// SupplyByCreditType queries the aggregated tradable, retired, and cancelled
+	// supply of all credit batches issued within credit classes of a credit
+	// type.
+	//
+	// Since Revision 1
+	SupplyByCreditType(ctx context.Context, in *QuerySupplyByCreditTypeRequest, opts ...grpc.CallOption) (*QuerySupplyByCreditTypeResponse, error)
+	// BalancesByClass queries all credit balances the given account is holding
+	// within a credit class. Balances are ordered by credit batch and can be
+	// returned in reverse order by setting pagination.reverse.
+	//
+	// Since Revision 1
+	BalancesByClass(ctx context.Context, in *QueryBalancesByClassRequest, opts ...grpc.CallOption) (*QueryBalancesByClassResponse, error)
+	// BalancesByProject queries all credit balances the given account is holding
+	// within a project. Balances are ordered by credit batch and can be returned
+	// in reverse order by setting pagination.reverse.
+	//
+	// Since Revision 1
+	BalancesByProject(ctx context.Context, in *QueryBalancesByProjectRequest, opts ...grpc.CallOption) (*QueryBalancesByProjectResponse, error)
+	// HoldersByProject queries all accounts holding or having held credits
+	// issued within a project along with their total balances across the credit
+	// batches of the project. Holders are ordered by address and can be returned
+	// in reverse order by setting pagination.reverse.
+	//
+	// Since Revision 1
+	HoldersByProject(ctx context.Context, in *QueryHoldersByProjectRequest, opts ...grpc.CallOption) (*QueryHoldersByProjectResponse, error)
+	// RetirementSummary queries the total amount of credits retired by an account
+	// within a calendar year, grouped by credit type and retirement
+	// jurisdiction. The results can optionally be filtered by credit type.
+	//
+	// Only retirements recorded after the upgrade that introduced retirement
+	// records are included. The year of earlier retirements is not recorded
+	// in state.
+	//
+	// Since Revision 1
+	RetirementSummary(ctx context.Context, in *QueryRetirementSummaryRequest, opts ...grpc.CallOption) (*QueryRetirementSummaryResponse, error)
+	// BatchByOriginTx queries the credit batch that was created or had credits
+	// minted by a transaction from another chain or registry.
+	//
+	// Since Revision 1
+	BatchByOriginTx(ctx context.Context, in *QueryBatchByOriginTxRequest, opts ...grpc.CallOption) (*QueryBatchByOriginTxResponse, error)
+	// StrictJurisdictions queries the strict jurisdictions enabled setting.
+	//
+	// Since Revision 1
+	StrictJurisdictions(ctx context.Context, in *QueryStrictJurisdictionsRequest, opts ...grpc.CallOption) (*QueryStrictJurisdictionsResponse, error)
+	// ProjectsByJurisdiction queries all projects within a jurisdiction with
+	// pagination. A country code (e.g. "US") matches projects within the country
+	// and all of its subdivisions, and a subdivision code (e.g. "US-WA") matches
+	// projects within the subdivision and all of its postal codes.
+	//
+	// Since Revision 1
+	ProjectsByJurisdiction(ctx context.Context, in *QueryProjectsByJurisdictionRequest, opts ...grpc.CallOption) (*QueryProjectsByJurisdictionResponse, error)
+	// RetirementsByJurisdiction queries all retirement records within a
+	// jurisdiction with pagination. Jurisdictions are matched in the same way as
+	// in ProjectsByJurisdiction.
+	//
+	// Since Revision 1
+	RetirementsByJurisdiction(ctx context.Context, in *QueryRetirementsByJurisdictionRequest, opts ...grpc.CallOption) (*QueryRetirementsByJurisdictionResponse, error)
+	// NationalRegistries queries all national registries with pagination.
+	//
+	// Since Revision 1
+	NationalRegistries(ctx context.Context, in *QueryNationalRegistriesRequest, opts ...grpc.CallOption) (*QueryNationalRegistriesResponse, error)
+	// Article6AuthorizationsByBatch queries the Article 6 authorizations of a
+	// credit batch and of retirements of credits from the credit batch with
+	// pagination.
+	//
+	// Since Revision 1
+	Article6AuthorizationsByBatch(ctx context.Context, in *QueryArticle6AuthorizationsByBatchRequest, opts ...grpc.CallOption) (*QueryArticle6AuthorizationsByBatchResponse, error)
+	// ClassSerialization queries whether or not the credits issued within a
+	// credit class are identified by serial number ranges.
+	//
+	// Since Revision 1
+	ClassSerialization(ctx context.Context, in *QueryClassSerializationRequest, opts ...grpc.CallOption) (*QueryClassSerializationResponse, error)
+	// SerialsByBatch queries all serial number ranges of a credit batch with
+	// pagination.
+	//
+	// Since Revision 1
+	SerialsByBatch(ctx context.Context, in *QuerySerialsByBatchRequest, opts ...grpc.CallOption) (*QuerySerialsByBatchResponse, error)
+	// SerialsByOwner queries all serial number ranges owned by an account with
+	// pagination.
+	//
+	// Since Revision 1
+	SerialsByOwner(ctx context.Context, in *QuerySerialsByOwnerRequest, opts ...grpc.CallOption) (*QuerySerialsByOwnerResponse, error)
+	// BridgeRequestsByOwner queries all bridge requests of an account with
+	// pagination.
+	//
+	// Since Revision 1
+	BridgeRequestsByOwner(ctx context.Context, in *QueryBridgeRequestsByOwnerRequest, opts ...grpc.CallOption) (*QueryBridgeRequestsByOwnerResponse, error)
+	// BridgeRequestsByStatus queries all bridge requests with a given status
+	// with pagination.
+	//
+	// Since Revision 1
+	BridgeRequestsByStatus(ctx context.Context, in *QueryBridgeRequestsByStatusRequest, opts ...grpc.CallOption) (*QueryBridgeRequestsByStatusResponse, error)
+	// AllowedBridgeServices queries all bridge service addresses that are
+	// allowed to acknowledge and fail bridge requests to a bridge chain.
+	//
+	// Since Revision 1
+	AllowedBridgeServices(ctx context.Context, in *QueryAllowedBridgeServicesRequest, opts ...grpc.CallOption) (*QueryAllowedBridgeServicesResponse, error)
+	// AllowedBridgeContracts queries all contract addresses that credits are
+	// allowed to be bridged from and to on a bridge chain.
+	//
+	// Since Revision 1
+	AllowedBridgeContracts(ctx context.Context, in *QueryAllowedBridgeContractsRequest, opts ...grpc.CallOption) (*QueryAllowedBridgeContractsResponse, error)
 }
 
 type queryClient struct {
@@ -344,649 +554,2059 @@ func (c *queryClient) AllowedBridgeChains(ctx context.Context, in *QueryAllowedB
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-// All implementations must embed UnimplementedQueryServer
-// for forward compatibility
-type QueryServer interface {
-	// Classes queries for all credit classes with pagination.
-	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
-	// ClassesByAdmin queries for all credit classes with a specific admin
-	// address.
-	ClassesByAdmin(context.Context, *QueryClassesByAdminRequest) (*QueryClassesByAdminResponse, error)
-	// Class queries for information on a credit class.
-	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
-	// ClassIssuers queries for the addresses of the issuers for a credit class.
-	ClassIssuers(context.Context, *QueryClassIssuersRequest) (*QueryClassIssuersResponse, error)
-	// Projects queries for all projects with pagination.
-	Projects(context.Context, *QueryProjectsRequest) (*QueryProjectsResponse, error)
-	// ProjectsByClass queries for all projects within a class with pagination.
-	ProjectsByClass(context.Context, *QueryProjectsByClassRequest) (*QueryProjectsByClassResponse, error)
-	// ProjectsByReferenceId queries for all projects by reference-id with
-	// pagination.
-	ProjectsByReferenceId(context.Context, *QueryProjectsByReferenceIdRequest) (*QueryProjectsByReferenceIdResponse, error)
-	// ProjectsByAdmin queries for all projects by admin with
-	// pagination.
-	ProjectsByAdmin(context.Context, *QueryProjectsByAdminRequest) (*QueryProjectsByAdminResponse, error)
-	// Project queries for information on a project.
-	Project(context.Context, *QueryProjectRequest) (*QueryProjectResponse, error)
-	// Batches queries for all batches with pagination.
-	Batches(context.Context, *QueryBatchesRequest) (*QueryBatchesResponse, error)
-	// BatchesByIssuer queries all batches issued from a given issuer address.
-	BatchesByIssuer(context.Context, *QueryBatchesByIssuerRequest) (*QueryBatchesByIssuerResponse, error)
-	// BatchesByClass queries all batches issued from a given class.
-	BatchesByClass(context.Context, *QueryBatchesByClassRequest) (*QueryBatchesByClassResponse, error)
-	// BatchesByProject queries for all batches from a given project with
-	// pagination.
-	BatchesByProject(context.Context, *QueryBatchesByProjectRequest) (*QueryBatchesByProjectResponse, error)
-	// Batch queries for information on a credit batch.
-	Batch(context.Context, *QueryBatchRequest) (*QueryBatchResponse, error)
-	// Balance queries the balance (both tradable and retired) of a given credit
-	// batch for a given account address.
-	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
-	// Balances queries all credit balances the given account holds.
-	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
-	// BalancesByBatch queries all credit balances from a given batch.
-	//
-	// Since Revision 1
-	BalancesByBatch(context.Context, *QueryBalancesByBatchRequest) (*QueryBalancesByBatchResponse, error)
-	// AllBalances queries all credit balances.
-	//
-	// Since Revision 1
-	AllBalances(context.Context, *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error)
-	// Supply queries the tradable and retired supply of a credit batch.
-	Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error)
-	// CreditTypes returns the list of allowed types that credit classes can have.
-	// See Types/CreditType for more details.
-	CreditTypes(context.Context, *QueryCreditTypesRequest) (*QueryCreditTypesResponse, error)
-	// Deprecated: Do not use.
-	// Params queries the ecocredit module parameters.
-	//
-	// Deprecated (Since Revision 1): This rpc will be removed in the next
-	// version.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// CreditType queries credit type information by abbreviation.
-	CreditType(context.Context, *QueryCreditTypeRequest) (*QueryCreditTypeResponse, error)
-	// ClassCreatorAllowlist queries the credit class creator allowlist
-	// enabled setting.
-	//
-	// Since Revision 1
-	ClassCreatorAllowlist(context.Context, *QueryClassCreatorAllowlistRequest) (*QueryClassCreatorAllowlistResponse, error)
-	// AllowedClassCreators queries allowed credit class creators list.
-	//
-	// Since Revision 1
-	AllowedClassCreators(context.Context, *QueryAllowedClassCreatorsRequest) (*QueryAllowedClassCreatorsResponse, error)
-	// ClassFee returns the credit class creation fee. If not set, a credit class
-	// creation fee is not required.
-	//
-	// Since Revision 1
-	ClassFee(context.Context, *QueryClassFeeRequest) (*QueryClassFeeResponse, error)
-	// AllowedBridgeChains queries the list of chains allowed to be used in bridge
-	// operations.
-	//
-	// Since Revision 1
-	AllowedBridgeChains(context.Context, *QueryAllowedBridgeChainsRequest) (*QueryAllowedBridgeChainsResponse, error)
-	mustEmbedUnimplementedQueryServer()
+func (c *queryClient) Retirement(ctx context.Context, in *QueryRetirementRequest, opts ...grpc.CallOption) (*QueryRetirementResponse, error) {
+	out := new(QueryRetirementResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/Retirement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedQueryServer must be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (c *queryClient) RetirementsByOwner(ctx context.Context, in *QueryRetirementsByOwnerRequest, opts ...grpc.CallOption) (*QueryRetirementsByOwnerResponse, error) {
+	out := new(QueryRetirementsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/RetirementsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedQueryServer) Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
+func (c *queryClient) RetirementsByBatch(ctx context.Context, in *QueryRetirementsByBatchRequest, opts ...grpc.CallOption) (*QueryRetirementsByBatchResponse, error) {
+	out := new(QueryRetirementsByBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/RetirementsByBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedQueryServer) ClassesByAdmin(context.Context, *QueryClassesByAdminRequest) (*QueryClassesByAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClassesByAdmin not implemented")
+
+func (c *queryClient) Certificate(ctx context.Context, in *QueryCertificateRequest, opts ...grpc.CallOption) (*QueryCertificateResponse, error) {
+	out := new(QueryCertificateResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/Certificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedQueryServer) Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Class not implemented")
+
+func (c *queryClient) CertificatesByOwner(ctx context.Context, in *QueryCertificatesByOwnerRequest, opts ...grpc.CallOption) (*QueryCertificatesByOwnerResponse, error) {
+	out := new(QueryCertificatesByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/CertificatesByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedQueryServer) ClassIssuers(context.Context, *QueryClassIssuersRequest) (*QueryClassIssuersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClassIssuers not implemented")
+
+func (c *queryClient) CreditLock(ctx context.Context, in *QueryCreditLockRequest, opts ...grpc.CallOption) (*QueryCreditLockResponse, error) {
+	out := new(QueryCreditLockResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/CreditLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedQueryServer) Projects(context.Context, *QueryProjectsRequest) (*QueryProjectsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Projects not implemented")
+
+func (c *queryClient) CreditLocksByOwner(ctx context.Context, in *QueryCreditLocksByOwnerRequest, opts ...grpc.CallOption) (*QueryCreditLocksByOwnerResponse, error) {
+	out := new(QueryCreditLocksByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/CreditLocksByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedQueryServer) ProjectsByClass(context.Context, *QueryProjectsByClassRequest) (*QueryProjectsByClassResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProjectsByClass not implemented")
+
+func (c *queryClient) CreditLocksByBatch(ctx context.Context, in *QueryCreditLocksByBatchRequest, opts ...grpc.CallOption) (*QueryCreditLocksByBatchResponse, error) {
+	out := new(QueryCreditLocksByBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/CreditLocksByBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedQueryServer) ProjectsByReferenceId(context.Context, *QueryProjectsByReferenceIdRequest) (*QueryProjectsByReferenceIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProjectsByReferenceId not implemented")
+
+func (c *queryClient) ClassBuffer(ctx context.Context, in *QueryClassBufferRequest, opts ...grpc.CallOption) (*QueryClassBufferResponse, error) {
+	out := new(QueryClassBufferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ClassBuffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedQueryServer) ProjectsByAdmin(context.Context, *QueryProjectsByAdminRequest) (*QueryProjectsByAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProjectsByAdmin not implemented")
+
+func (c *queryClient) Reversal(ctx context.Context, in *QueryReversalRequest, opts ...grpc.CallOption) (*QueryReversalResponse, error) {
+	out := new(QueryReversalResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/Reversal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedQueryServer) Project(context.Context, *QueryProjectRequest) (*QueryProjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Project not implemented")
+
+func (c *queryClient) ReversalsByClass(ctx context.Context, in *QueryReversalsByClassRequest, opts ...grpc.CallOption) (*QueryReversalsByClassResponse, error) {
+	out := new(QueryReversalsByClassResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ReversalsByClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedQueryServer) Batches(context.Context, *QueryBatchesRequest) (*QueryBatchesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Batches not implemented")
+
+func (c *queryClient) ClassVerifiers(ctx context.Context, in *QueryClassVerifiersRequest, opts ...grpc.CallOption) (*QueryClassVerifiersResponse, error) {
+	out := new(QueryClassVerifiersResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ClassVerifiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedQueryServer) BatchesByIssuer(context.Context, *QueryBatchesByIssuerRequest) (*QueryBatchesByIssuerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchesByIssuer not implemented")
+
+func (c *queryClient) ProjectsByStatus(ctx context.Context, in *QueryProjectsByStatusRequest, opts ...grpc.CallOption) (*QueryProjectsByStatusResponse, error) {
+	out := new(QueryProjectsByStatusResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ProjectsByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectStatusHistory(ctx context.Context, in *QueryProjectStatusHistoryRequest, opts ...grpc.CallOption) (*QueryProjectStatusHistoryResponse, error) {
+	out := new(QueryProjectStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ProjectStatusHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClassBatchApproval(ctx context.Context, in *QueryClassBatchApprovalRequest, opts ...grpc.CallOption) (*QueryClassBatchApprovalResponse, error) {
+	out := new(QueryClassBatchApprovalResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ClassBatchApproval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingBatch(ctx context.Context, in *QueryPendingBatchRequest, opts ...grpc.CallOption) (*QueryPendingBatchResponse, error) {
+	out := new(QueryPendingBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/PendingBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingBatchesByClass(ctx context.Context, in *QueryPendingBatchesByClassRequest, opts ...grpc.CallOption) (*QueryPendingBatchesByClassResponse, error) {
+	out := new(QueryPendingBatchesByClassResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/PendingBatchesByClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IssuanceHeadroom(ctx context.Context, in *QueryIssuanceHeadroomRequest, opts ...grpc.CallOption) (*QueryIssuanceHeadroomResponse, error) {
+	out := new(QueryIssuanceHeadroomResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/IssuanceHeadroom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BatchMetadataHistory(ctx context.Context, in *QueryBatchMetadataHistoryRequest, opts ...grpc.CallOption) (*QueryBatchMetadataHistoryResponse, error) {
+	out := new(QueryBatchMetadataHistoryResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/BatchMetadataHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClassBatchMetadataCosign(ctx context.Context, in *QueryClassBatchMetadataCosignRequest, opts ...grpc.CallOption) (*QueryClassBatchMetadataCosignResponse, error) {
+	out := new(QueryClassBatchMetadataCosignResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ClassBatchMetadataCosign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyByProject(ctx context.Context, in *QuerySupplyByProjectRequest, opts ...grpc.CallOption) (*QuerySupplyByProjectResponse, error) {
+	out := new(QuerySupplyByProjectResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/SupplyByProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyByClass(ctx context.Context, in *QuerySupplyByClassRequest, opts ...grpc.CallOption) (*QuerySupplyByClassResponse, error) {
+	out := new(QuerySupplyByClassResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/SupplyByClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyByCreditType(ctx context.Context, in *QuerySupplyByCreditTypeRequest, opts ...grpc.CallOption) (*QuerySupplyByCreditTypeResponse, error) {
+	out := new(QuerySupplyByCreditTypeResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/SupplyByCreditType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BalancesByClass(ctx context.Context, in *QueryBalancesByClassRequest, opts ...grpc.CallOption) (*QueryBalancesByClassResponse, error) {
+	out := new(QueryBalancesByClassResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/BalancesByClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BalancesByProject(ctx context.Context, in *QueryBalancesByProjectRequest, opts ...grpc.CallOption) (*QueryBalancesByProjectResponse, error) {
+	out := new(QueryBalancesByProjectResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/BalancesByProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HoldersByProject(ctx context.Context, in *QueryHoldersByProjectRequest, opts ...grpc.CallOption) (*QueryHoldersByProjectResponse, error) {
+	out := new(QueryHoldersByProjectResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/HoldersByProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RetirementSummary(ctx context.Context, in *QueryRetirementSummaryRequest, opts ...grpc.CallOption) (*QueryRetirementSummaryResponse, error) {
+	out := new(QueryRetirementSummaryResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/RetirementSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BatchByOriginTx(ctx context.Context, in *QueryBatchByOriginTxRequest, opts ...grpc.CallOption) (*QueryBatchByOriginTxResponse, error) {
+	out := new(QueryBatchByOriginTxResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/BatchByOriginTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StrictJurisdictions(ctx context.Context, in *QueryStrictJurisdictionsRequest, opts ...grpc.CallOption) (*QueryStrictJurisdictionsResponse, error) {
+	out := new(QueryStrictJurisdictionsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/StrictJurisdictions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectsByJurisdiction(ctx context.Context, in *QueryProjectsByJurisdictionRequest, opts ...grpc.CallOption) (*QueryProjectsByJurisdictionResponse, error) {
+	out := new(QueryProjectsByJurisdictionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ProjectsByJurisdiction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RetirementsByJurisdiction(ctx context.Context, in *QueryRetirementsByJurisdictionRequest, opts ...grpc.CallOption) (*QueryRetirementsByJurisdictionResponse, error) {
+	out := new(QueryRetirementsByJurisdictionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/RetirementsByJurisdiction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NationalRegistries(ctx context.Context, in *QueryNationalRegistriesRequest, opts ...grpc.CallOption) (*QueryNationalRegistriesResponse, error) {
+	out := new(QueryNationalRegistriesResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/NationalRegistries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Article6AuthorizationsByBatch(ctx context.Context, in *QueryArticle6AuthorizationsByBatchRequest, opts ...grpc.CallOption) (*QueryArticle6AuthorizationsByBatchResponse, error) {
+	out := new(QueryArticle6AuthorizationsByBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/Article6AuthorizationsByBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClassSerialization(ctx context.Context, in *QueryClassSerializationRequest, opts ...grpc.CallOption) (*QueryClassSerializationResponse, error) {
+	out := new(QueryClassSerializationResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ClassSerialization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SerialsByBatch(ctx context.Context, in *QuerySerialsByBatchRequest, opts ...grpc.CallOption) (*QuerySerialsByBatchResponse, error) {
+	out := new(QuerySerialsByBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/SerialsByBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SerialsByOwner(ctx context.Context, in *QuerySerialsByOwnerRequest, opts ...grpc.CallOption) (*QuerySerialsByOwnerResponse, error) {
+	out := new(QuerySerialsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/SerialsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeRequestsByOwner(ctx context.Context, in *QueryBridgeRequestsByOwnerRequest, opts ...grpc.CallOption) (*QueryBridgeRequestsByOwnerResponse, error) {
+	out := new(QueryBridgeRequestsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/BridgeRequestsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BridgeRequestsByStatus(ctx context.Context, in *QueryBridgeRequestsByStatusRequest, opts ...grpc.CallOption) (*QueryBridgeRequestsByStatusResponse, error) {
+	out := new(QueryBridgeRequestsByStatusResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/BridgeRequestsByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedBridgeServices(ctx context.Context, in *QueryAllowedBridgeServicesRequest, opts ...grpc.CallOption) (*QueryAllowedBridgeServicesResponse, error) {
+	out := new(QueryAllowedBridgeServicesResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/AllowedBridgeServices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllowedBridgeContracts(ctx context.Context, in *QueryAllowedBridgeContractsRequest, opts ...grpc.CallOption) (*QueryAllowedBridgeContractsResponse, error) {
+	out := new(QueryAllowedBridgeContractsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/AllowedBridgeContracts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// Classes queries for all credit classes with pagination. The credit classes
+	// can optionally be filtered by status.
+	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// ClassesByAdmin queries for all credit classes with a specific admin
+	// address.
+	ClassesByAdmin(context.Context, *QueryClassesByAdminRequest) (*QueryClassesByAdminResponse, error)
+	// Class queries for information on a credit class.
+	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
+	// ClassIssuers queries for the addresses of the issuers for a credit class.
+	ClassIssuers(context.Context, *QueryClassIssuersRequest) (*QueryClassIssuersResponse, error)
+	// Projects queries for all projects with pagination.
+	Projects(context.Context, *QueryProjectsRequest) (*QueryProjectsResponse, error)
+	// ProjectsByClass queries for all projects within a class with pagination.
+	// Projects that have been migrated to a different credit class are listed
+	// under the credit class to which they were migrated.
+	ProjectsByClass(context.Context, *QueryProjectsByClassRequest) (*QueryProjectsByClassResponse, error)
+	// ProjectsByReferenceId queries for all projects by reference-id with
+	// pagination.
+	ProjectsByReferenceId(context.Context, *QueryProjectsByReferenceIdRequest) (*QueryProjectsByReferenceIdResponse, error)
+	// ProjectsByAdmin queries for all projects by admin with
+	// pagination.
+	ProjectsByAdmin(context.Context, *QueryProjectsByAdminRequest) (*QueryProjectsByAdminResponse, error)
+	// Project queries for information on a project. The project id can also be
+	// a previous project id of a project that has been migrated to a different
+	// credit class, in which case the current project is returned.
+	Project(context.Context, *QueryProjectRequest) (*QueryProjectResponse, error)
+	// Batches queries for all batches with pagination.
+	Batches(context.Context, *QueryBatchesRequest) (*QueryBatchesResponse, error)
+	// BatchesByIssuer queries all batches issued from a given issuer address.
+	BatchesByIssuer(context.Context, *QueryBatchesByIssuerRequest) (*QueryBatchesByIssuerResponse, error)
+	// BatchesByClass queries all batches issued from a given class.
+	BatchesByClass(context.Context, *QueryBatchesByClassRequest) (*QueryBatchesByClassResponse, error)
+	// BatchesByProject queries for all batches from a given project with
+	// pagination.
+	BatchesByProject(context.Context, *QueryBatchesByProjectRequest) (*QueryBatchesByProjectResponse, error)
+	// Batch queries for information on a credit batch.
+	Batch(context.Context, *QueryBatchRequest) (*QueryBatchResponse, error)
+	// Balance queries the balance (both tradable and retired) of a given credit
+	// batch for a given account address.
+	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
+	// Balances queries all credit balances the given account holds.
+	Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error)
+	// BalancesByBatch queries all credit balances from a given batch.
+	//
+	// Since Revision 1
+	BalancesByBatch(context.Context, *QueryBalancesByBatchRequest) (*QueryBalancesByBatchResponse, error)
+	// AllBalances queries all credit balances.
+	//
+	// Since Revision 1
+	AllBalances(context.Context, *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error)
+	// Supply queries the tradable and retired supply of a credit batch.
+	Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error)
+	// CreditTypes returns the list of allowed types that credit classes can have.
+	// See Types/CreditType for more details.
+	CreditTypes(context.Context, *QueryCreditTypesRequest) (*QueryCreditTypesResponse, error)
+	// Deprecated: Do not use.
+	// Params queries the ecocredit module parameters.
+	//
+	// Deprecated (Since Revision 1): This rpc will be removed in the next
+	// version.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// CreditType queries credit type information by abbreviation.
+	CreditType(context.Context, *QueryCreditTypeRequest) (*QueryCreditTypeResponse, error)
+	// ClassCreatorAllowlist queries the credit class creator allowlist
+	// enabled setting.
+	//
+	// Since Revision 1
+	ClassCreatorAllowlist(context.Context, *QueryClassCreatorAllowlistRequest) (*QueryClassCreatorAllowlistResponse, error)
+	// AllowedClassCreators queries allowed credit class creators list.
+	//
+	// Since Revision 1
+	AllowedClassCreators(context.Context, *QueryAllowedClassCreatorsRequest) (*QueryAllowedClassCreatorsResponse, error)
+	// ClassFee returns the credit class creation fee. If not set, a credit class
+	// creation fee is not required.
+	//
+	// Since Revision 1
+	ClassFee(context.Context, *QueryClassFeeRequest) (*QueryClassFeeResponse, error)
+	// AllowedBridgeChains queries the list of chains allowed to be used in bridge
+	// operations.
+	//
+	// Since Revision 1
+	AllowedBridgeChains(context.Context, *QueryAllowedBridgeChainsRequest) (*QueryAllowedBridgeChainsResponse, error)
+	// Retirement queries a retirement record by id.
+	//
+	// Since Revision 1
+	Retirement(context.Context, *QueryRetirementRequest) (*QueryRetirementResponse, error)
+	// RetirementsByOwner queries all retirement records for a given owner
+	// address.
+	//
+	// Since Revision 1
+	RetirementsByOwner(context.Context, *QueryRetirementsByOwnerRequest) (*QueryRetirementsByOwnerResponse, error)
+	// RetirementsByBatch queries all retirement records for a given credit
+	// batch.
+	//
+	// Since Revision 1
+	RetirementsByBatch(context.Context, *QueryRetirementsByBatchRequest) (*QueryRetirementsByBatchResponse, error)
+	// Certificate queries a retirement certificate by the x/nft class and token
+	// identifiers and resolves the certificate to its underlying retirement.
+	//
+	// Since Revision 1
+	Certificate(context.Context, *QueryCertificateRequest) (*QueryCertificateResponse, error)
+	// CertificatesByOwner queries all retirement certificates currently owned by
+	// a given account.
+	//
+	// Since Revision 1
+	CertificatesByOwner(context.Context, *QueryCertificatesByOwnerRequest) (*QueryCertificatesByOwnerResponse, error)
+	// CreditLock queries a credit lock by id.
+	//
+	// Since Revision 1
+	CreditLock(context.Context, *QueryCreditLockRequest) (*QueryCreditLockResponse, error)
+	// CreditLocksByOwner queries all credit locks for a given owner address.
+	//
+	// Since Revision 1
+	CreditLocksByOwner(context.Context, *QueryCreditLocksByOwnerRequest) (*QueryCreditLocksByOwnerResponse, error)
+	// CreditLocksByBatch queries all credit locks for a given credit batch.
+	//
+	// Since Revision 1
+	CreditLocksByBatch(context.Context, *QueryCreditLocksByBatchRequest) (*QueryCreditLocksByBatchResponse, error)
+	// ClassBuffer queries the buffer pool of a credit class.
+	//
+	// Since Revision 1
+	ClassBuffer(context.Context, *QueryClassBufferRequest) (*QueryClassBufferResponse, error)
+	// Reversal queries a reversal record by id.
+	//
+	// Since Revision 1
+	Reversal(context.Context, *QueryReversalRequest) (*QueryReversalResponse, error)
+	// ReversalsByClass queries all reversal records for a given credit class.
+	//
+	// Since Revision 1
+	ReversalsByClass(context.Context, *QueryReversalsByClassRequest) (*QueryReversalsByClassResponse, error)
+	// ClassVerifiers queries for the addresses of the verifiers for a credit
+	// class.
+	//
+	// Since Revision 1
+	ClassVerifiers(context.Context, *QueryClassVerifiersRequest) (*QueryClassVerifiersResponse, error)
+	// ProjectsByStatus queries for all projects with a given status with
+	// pagination.
+	//
+	// Since Revision 1
+	ProjectsByStatus(context.Context, *QueryProjectsByStatusRequest) (*QueryProjectsByStatusResponse, error)
+	// ProjectStatusHistory queries the status history of a project with
+	// pagination.
+	//
+	// Since Revision 1
+	ProjectStatusHistory(context.Context, *QueryProjectStatusHistoryRequest) (*QueryProjectStatusHistoryResponse, error)
+	// ClassBatchApproval queries the batch approval setting of a credit class.
+	//
+	// Since Revision 1
+	ClassBatchApproval(context.Context, *QueryClassBatchApprovalRequest) (*QueryClassBatchApprovalResponse, error)
+	// PendingBatch queries a pending batch by its unique identifier.
+	//
+	// Since Revision 1
+	PendingBatch(context.Context, *QueryPendingBatchRequest) (*QueryPendingBatchResponse, error)
+	// PendingBatchesByClass queries all pending batches of a credit class with
+	// pagination.
+	//
+	// Since Revision 1
+	PendingBatchesByClass(context.Context, *QueryPendingBatchesByClassRequest) (*QueryPendingBatchesByClassResponse, error)
+	// IssuanceHeadroom queries the remaining amount of credits an issuer can
+	// issue for a project within a vintage year given the issuance caps of the
+	// credit class.
+	//
+	// Since Revision 1
+	IssuanceHeadroom(context.Context, *QueryIssuanceHeadroomRequest) (*QueryIssuanceHeadroomResponse, error)
+	// BatchMetadataHistory queries the metadata history of a credit batch with
+	// pagination.
+	//
+	// Since Revision 1
+	BatchMetadataHistory(context.Context, *QueryBatchMetadataHistoryRequest) (*QueryBatchMetadataHistoryResponse, error)
+	// ClassBatchMetadataCosign queries whether or not the admin of a credit class
+	// must co-sign updates to the metadata of the credit batches of the credit
+	// class.
+	//
+	// Since Revision 1
+	ClassBatchMetadataCosign(context.Context, *QueryClassBatchMetadataCosignRequest) (*QueryClassBatchMetadataCosignResponse, error)
+	// SupplyByProject queries the aggregated tradable, retired, and cancelled
+	// supply of all credit batches issued within a project.
+	//
+	// Since Revision 1
+	SupplyByProject(context.Context, *QuerySupplyByProjectRequest) (*QuerySupplyByProjectResponse, error)
+	// SupplyByClass queries the aggregated tradable, retired, and cancelled
+	// supply of all credit batches issued within a credit class.
+	//
+	// Since Revision 1
+	SupplyByClass(context.Context, *QuerySupplyByClassRequest) (*QuerySupplyByClassResponse, error)
+	// SupplyByCreditType queries the aggregated tradable, retired, and cancelled
+	// supply of all credit batches issued within credit classes of a credit
+	// type.
+	//
+	// Since Revision 1
+	SupplyByCreditType(context.Context, *QuerySupplyByCreditTypeRequest) (*QuerySupplyByCreditTypeResponse, error)
+	// BalancesByClass queries all credit balances the given account is holding
+	// within a credit class. Balances are ordered by credit batch and can be
+	// returned in reverse order by setting pagination.reverse.
+	//
+	// Since Revision 1
+	BalancesByClass(context.Context, *QueryBalancesByClassRequest) (*QueryBalancesByClassResponse, error)
+	// BalancesByProject queries all credit balances the given account is holding
+	// within a project. Balances are ordered by credit batch and can be returned
+	// in reverse order by setting pagination.reverse.
+	//
+	// Since Revision 1
+	BalancesByProject(context.Context, *QueryBalancesByProjectRequest) (*QueryBalancesByProjectResponse, error)
+	// HoldersByProject queries all accounts holding or having held credits
+	// issued within a project along with their total balances across the credit
+	// batches of the project. Holders are ordered by address and can be returned
+	// in reverse order by setting pagination.reverse.
+	//
+	// Since Revision 1
+	HoldersByProject(context.Context, *QueryHoldersByProjectRequest) (*QueryHoldersByProjectResponse, error)
+	// RetirementSummary queries the total amount of credits retired by an account
+	// within a calendar year, grouped by credit type and retirement
+	// jurisdiction. The results can optionally be filtered by credit type.
+	//
+	// Only retirements recorded after the upgrade that introduced retirement
+	// records are included. The year of earlier retirements is not recorded
+	// in state.
+	//
+	// Since Revision 1
+	RetirementSummary(context.Context, *QueryRetirementSummaryRequest) (*QueryRetirementSummaryResponse, error)
+	// BatchByOriginTx queries the credit batch that was created or had credits
+	// minted by a transaction from another chain or registry.
+	//
+	// Since Revision 1
+	BatchByOriginTx(context.Context, *QueryBatchByOriginTxRequest) (*QueryBatchByOriginTxResponse, error)
+	// StrictJurisdictions queries the strict jurisdictions enabled setting.
+	//
+	// Since Revision 1
+	StrictJurisdictions(context.Context, *QueryStrictJurisdictionsRequest) (*QueryStrictJurisdictionsResponse, error)
+	// ProjectsByJurisdiction queries all projects within a jurisdiction with
+	// pagination. A country code (e.g. "US") matches projects within the country
+	// and all of its subdivisions, and a subdivision code (e.g. "US-WA") matches
+	// projects within the subdivision and all of its postal codes.
+	//
+	// Since Revision 1
+	ProjectsByJurisdiction(context.Context, *QueryProjectsByJurisdictionRequest) (*QueryProjectsByJurisdictionResponse, error)
+	// RetirementsByJurisdiction queries all retirement records within a
+	// jurisdiction with pagination. Jurisdictions are matched in the same way as
+	// in ProjectsByJurisdiction.
+	//
+	// Since Revision 1
+	RetirementsByJurisdiction(context.Context, *QueryRetirementsByJurisdictionRequest) (*QueryRetirementsByJurisdictionResponse, error)
+	// NationalRegistries queries all national registries with pagination.
+	//
+	// Since Revision 1
+	NationalRegistries(context.Context, *QueryNationalRegistriesRequest) (*QueryNationalRegistriesResponse, error)
+	// Article6AuthorizationsByBatch queries the Article 6 authorizations of a
+	// credit batch and of retirements of credits from the credit batch with
+	// pagination.
+	//
+	// Since Revision 1
+	Article6AuthorizationsByBatch(context.Context, *QueryArticle6AuthorizationsByBatchRequest) (*QueryArticle6AuthorizationsByBatchResponse, error)
+	// ClassSerialization queries whether or not the credits issued within a
+	// credit class are identified by serial number ranges.
+	//
+	// Since Revision 1
+	ClassSerialization(context.Context, *QueryClassSerializationRequest) (*QueryClassSerializationResponse, error)
+	// SerialsByBatch queries all serial number ranges of a credit batch with
+	// pagination.
+	//
+	// Since Revision 1
+	SerialsByBatch(context.Context, *QuerySerialsByBatchRequest) (*QuerySerialsByBatchResponse, error)
+	// SerialsByOwner queries all serial number ranges owned by an account with
+	// pagination.
+	//
+	// Since Revision 1
+	SerialsByOwner(context.Context, *QuerySerialsByOwnerRequest) (*QuerySerialsByOwnerResponse, error)
+	// BridgeRequestsByOwner queries all bridge requests of an account with
+	// pagination.
+	//
+	// Since Revision 1
+	BridgeRequestsByOwner(context.Context, *QueryBridgeRequestsByOwnerRequest) (*QueryBridgeRequestsByOwnerResponse, error)
+	// BridgeRequestsByStatus queries all bridge requests with a given status
+	// with pagination.
+	//
+	// Since Revision 1
+	BridgeRequestsByStatus(context.Context, *QueryBridgeRequestsByStatusRequest) (*QueryBridgeRequestsByStatusResponse, error)
+	// AllowedBridgeServices queries all bridge service addresses that are
+	// allowed to acknowledge and fail bridge requests to a bridge chain.
+	//
+	// Since Revision 1
+	AllowedBridgeServices(context.Context, *QueryAllowedBridgeServicesRequest) (*QueryAllowedBridgeServicesResponse, error)
+	// AllowedBridgeContracts queries all contract addresses that credits are
+	// allowed to be bridged from and to on a bridge chain.
+	//
+	// Since Revision 1
+	AllowedBridgeContracts(context.Context, *QueryAllowedBridgeContractsRequest) (*QueryAllowedBridgeContractsResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
+}
+func (UnimplementedQueryServer) ClassesByAdmin(context.Context, *QueryClassesByAdminRequest) (*QueryClassesByAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassesByAdmin not implemented")
+}
+func (UnimplementedQueryServer) Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Class not implemented")
+}
+func (UnimplementedQueryServer) ClassIssuers(context.Context, *QueryClassIssuersRequest) (*QueryClassIssuersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassIssuers not implemented")
+}
+func (UnimplementedQueryServer) Projects(context.Context, *QueryProjectsRequest) (*QueryProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Projects not implemented")
+}
+func (UnimplementedQueryServer) ProjectsByClass(context.Context, *QueryProjectsByClassRequest) (*QueryProjectsByClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectsByClass not implemented")
+}
+func (UnimplementedQueryServer) ProjectsByReferenceId(context.Context, *QueryProjectsByReferenceIdRequest) (*QueryProjectsByReferenceIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectsByReferenceId not implemented")
+}
+func (UnimplementedQueryServer) ProjectsByAdmin(context.Context, *QueryProjectsByAdminRequest) (*QueryProjectsByAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectsByAdmin not implemented")
+}
+func (UnimplementedQueryServer) Project(context.Context, *QueryProjectRequest) (*QueryProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Project not implemented")
+}
+func (UnimplementedQueryServer) Batches(context.Context, *QueryBatchesRequest) (*QueryBatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batches not implemented")
+}
+func (UnimplementedQueryServer) BatchesByIssuer(context.Context, *QueryBatchesByIssuerRequest) (*QueryBatchesByIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchesByIssuer not implemented")
 }
 func (UnimplementedQueryServer) BatchesByClass(context.Context, *QueryBatchesByClassRequest) (*QueryBatchesByClassResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchesByClass not implemented")
 }
-func (UnimplementedQueryServer) BatchesByProject(context.Context, *QueryBatchesByProjectRequest) (*QueryBatchesByProjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchesByProject not implemented")
+func (UnimplementedQueryServer) BatchesByProject(context.Context, *QueryBatchesByProjectRequest) (*QueryBatchesByProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchesByProject not implemented")
+}
+func (UnimplementedQueryServer) Batch(context.Context, *QueryBatchRequest) (*QueryBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (UnimplementedQueryServer) Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
+}
+func (UnimplementedQueryServer) Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
+}
+func (UnimplementedQueryServer) BalancesByBatch(context.Context, *QueryBalancesByBatchRequest) (*QueryBalancesByBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalancesByBatch not implemented")
+}
+func (UnimplementedQueryServer) AllBalances(context.Context, *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllBalances not implemented")
+}
+func (UnimplementedQueryServer) Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Supply not implemented")
+}
+func (UnimplementedQueryServer) CreditTypes(context.Context, *QueryCreditTypesRequest) (*QueryCreditTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreditTypes not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (UnimplementedQueryServer) CreditType(context.Context, *QueryCreditTypeRequest) (*QueryCreditTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreditType not implemented")
+}
+func (UnimplementedQueryServer) ClassCreatorAllowlist(context.Context, *QueryClassCreatorAllowlistRequest) (*QueryClassCreatorAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassCreatorAllowlist not implemented")
+}
+func (UnimplementedQueryServer) AllowedClassCreators(context.Context, *QueryAllowedClassCreatorsRequest) (*QueryAllowedClassCreatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedClassCreators not implemented")
+}
+func (UnimplementedQueryServer) ClassFee(context.Context, *QueryClassFeeRequest) (*QueryClassFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassFee not implemented")
+}
+func (UnimplementedQueryServer) AllowedBridgeChains(context.Context, *QueryAllowedBridgeChainsRequest) (*QueryAllowedBridgeChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedBridgeChains not implemented")
+}
+func (UnimplementedQueryServer) Retirement(context.Context, *QueryRetirementRequest) (*QueryRetirementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Retirement not implemented")
+}
+func (UnimplementedQueryServer) RetirementsByOwner(context.Context, *QueryRetirementsByOwnerRequest) (*QueryRetirementsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetirementsByOwner not implemented")
+}
+func (UnimplementedQueryServer) RetirementsByBatch(context.Context, *QueryRetirementsByBatchRequest) (*QueryRetirementsByBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetirementsByBatch not implemented")
+}
+func (UnimplementedQueryServer) Certificate(context.Context, *QueryCertificateRequest) (*QueryCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Certificate not implemented")
+}
+func (UnimplementedQueryServer) CertificatesByOwner(context.Context, *QueryCertificatesByOwnerRequest) (*QueryCertificatesByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CertificatesByOwner not implemented")
+}
+func (UnimplementedQueryServer) CreditLock(context.Context, *QueryCreditLockRequest) (*QueryCreditLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreditLock not implemented")
+}
+func (UnimplementedQueryServer) CreditLocksByOwner(context.Context, *QueryCreditLocksByOwnerRequest) (*QueryCreditLocksByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreditLocksByOwner not implemented")
+}
+func (UnimplementedQueryServer) CreditLocksByBatch(context.Context, *QueryCreditLocksByBatchRequest) (*QueryCreditLocksByBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreditLocksByBatch not implemented")
+}
+func (UnimplementedQueryServer) ClassBuffer(context.Context, *QueryClassBufferRequest) (*QueryClassBufferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassBuffer not implemented")
+}
+func (UnimplementedQueryServer) Reversal(context.Context, *QueryReversalRequest) (*QueryReversalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reversal not implemented")
+}
+func (UnimplementedQueryServer) ReversalsByClass(context.Context, *QueryReversalsByClassRequest) (*QueryReversalsByClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReversalsByClass not implemented")
+}
+func (UnimplementedQueryServer) ClassVerifiers(context.Context, *QueryClassVerifiersRequest) (*QueryClassVerifiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassVerifiers not implemented")
+}
+func (UnimplementedQueryServer) ProjectsByStatus(context.Context, *QueryProjectsByStatusRequest) (*QueryProjectsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectsByStatus not implemented")
+}
+func (UnimplementedQueryServer) ProjectStatusHistory(context.Context, *QueryProjectStatusHistoryRequest) (*QueryProjectStatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectStatusHistory not implemented")
+}
+func (UnimplementedQueryServer) ClassBatchApproval(context.Context, *QueryClassBatchApprovalRequest) (*QueryClassBatchApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassBatchApproval not implemented")
+}
+func (UnimplementedQueryServer) PendingBatch(context.Context, *QueryPendingBatchRequest) (*QueryPendingBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingBatch not implemented")
+}
+func (UnimplementedQueryServer) PendingBatchesByClass(context.Context, *QueryPendingBatchesByClassRequest) (*QueryPendingBatchesByClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingBatchesByClass not implemented")
+}
+func (UnimplementedQueryServer) IssuanceHeadroom(context.Context, *QueryIssuanceHeadroomRequest) (*QueryIssuanceHeadroomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssuanceHeadroom not implemented")
+}
+func (UnimplementedQueryServer) BatchMetadataHistory(context.Context, *QueryBatchMetadataHistoryRequest) (*QueryBatchMetadataHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchMetadataHistory not implemented")
+}
+func (UnimplementedQueryServer) ClassBatchMetadataCosign(context.Context, *QueryClassBatchMetadataCosignRequest) (*QueryClassBatchMetadataCosignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassBatchMetadataCosign not implemented")
+}
+func (UnimplementedQueryServer) SupplyByProject(context.Context, *QuerySupplyByProjectRequest) (*QuerySupplyByProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyByProject not implemented")
+}
+func (UnimplementedQueryServer) SupplyByClass(context.Context, *QuerySupplyByClassRequest) (*QuerySupplyByClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyByClass not implemented")
+}
+func (UnimplementedQueryServer) SupplyByCreditType(context.Context, *QuerySupplyByCreditTypeRequest) (*QuerySupplyByCreditTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyByCreditType not implemented")
+}
+func (UnimplementedQueryServer) BalancesByClass(context.Context, *QueryBalancesByClassRequest) (*QueryBalancesByClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalancesByClass not implemented")
+}
+func (UnimplementedQueryServer) BalancesByProject(context.Context, *QueryBalancesByProjectRequest) (*QueryBalancesByProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BalancesByProject not implemented")
+}
+func (UnimplementedQueryServer) HoldersByProject(context.Context, *QueryHoldersByProjectRequest) (*QueryHoldersByProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldersByProject not implemented")
+}
+func (UnimplementedQueryServer) RetirementSummary(context.Context, *QueryRetirementSummaryRequest) (*QueryRetirementSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetirementSummary not implemented")
+}
+func (UnimplementedQueryServer) BatchByOriginTx(context.Context, *QueryBatchByOriginTxRequest) (*QueryBatchByOriginTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchByOriginTx not implemented")
+}
+func (UnimplementedQueryServer) StrictJurisdictions(context.Context, *QueryStrictJurisdictionsRequest) (*QueryStrictJurisdictionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StrictJurisdictions not implemented")
+}
+func (UnimplementedQueryServer) ProjectsByJurisdiction(context.Context, *QueryProjectsByJurisdictionRequest) (*QueryProjectsByJurisdictionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectsByJurisdiction not implemented")
+}
+func (UnimplementedQueryServer) RetirementsByJurisdiction(context.Context, *QueryRetirementsByJurisdictionRequest) (*QueryRetirementsByJurisdictionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetirementsByJurisdiction not implemented")
+}
+func (UnimplementedQueryServer) NationalRegistries(context.Context, *QueryNationalRegistriesRequest) (*QueryNationalRegistriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NationalRegistries not implemented")
+}
+func (UnimplementedQueryServer) Article6AuthorizationsByBatch(context.Context, *QueryArticle6AuthorizationsByBatchRequest) (*QueryArticle6AuthorizationsByBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Article6AuthorizationsByBatch not implemented")
+}
+func (UnimplementedQueryServer) ClassSerialization(context.Context, *QueryClassSerializationRequest) (*QueryClassSerializationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassSerialization not implemented")
+}
+func (UnimplementedQueryServer) SerialsByBatch(context.Context, *QuerySerialsByBatchRequest) (*QuerySerialsByBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SerialsByBatch not implemented")
+}
+func (UnimplementedQueryServer) SerialsByOwner(context.Context, *QuerySerialsByOwnerRequest) (*QuerySerialsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SerialsByOwner not implemented")
+}
+func (UnimplementedQueryServer) BridgeRequestsByOwner(context.Context, *QueryBridgeRequestsByOwnerRequest) (*QueryBridgeRequestsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeRequestsByOwner not implemented")
+}
+func (UnimplementedQueryServer) BridgeRequestsByStatus(context.Context, *QueryBridgeRequestsByStatusRequest) (*QueryBridgeRequestsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeRequestsByStatus not implemented")
+}
+func (UnimplementedQueryServer) AllowedBridgeServices(context.Context, *QueryAllowedBridgeServicesRequest) (*QueryAllowedBridgeServicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedBridgeServices not implemented")
+}
+func (UnimplementedQueryServer) AllowedBridgeContracts(context.Context, *QueryAllowedBridgeContractsRequest) (*QueryAllowedBridgeContractsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllowedBridgeContracts not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Classes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/Classes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Classes(ctx, req.(*QueryClassesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClassesByAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassesByAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassesByAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ClassesByAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassesByAdmin(ctx, req.(*QueryClassesByAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Class_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Class(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/Class",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Class(ctx, req.(*QueryClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClassIssuers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassIssuersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassIssuers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ClassIssuers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassIssuers(ctx, req.(*QueryClassIssuersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Projects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Projects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/Projects",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Projects(ctx, req.(*QueryProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectsByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectsByClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectsByClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ProjectsByClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectsByClass(ctx, req.(*QueryProjectsByClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectsByReferenceId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectsByReferenceIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectsByReferenceId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ProjectsByReferenceId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectsByReferenceId(ctx, req.(*QueryProjectsByReferenceIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectsByAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectsByAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectsByAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ProjectsByAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectsByAdmin(ctx, req.(*QueryProjectsByAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Project_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Project(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/Project",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Project(ctx, req.(*QueryProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Batches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Batches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/Batches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Batches(ctx, req.(*QueryBatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchesByIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchesByIssuerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchesByIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/BatchesByIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchesByIssuer(ctx, req.(*QueryBatchesByIssuerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchesByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchesByClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchesByClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/BatchesByClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchesByClass(ctx, req.(*QueryBatchesByClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BatchesByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchesByProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchesByProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/BatchesByProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchesByProject(ctx, req.(*QueryBatchesByProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/Batch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Batch(ctx, req.(*QueryBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/Balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Balance(ctx, req.(*QueryBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Balances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/Balances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Balances(ctx, req.(*QueryBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BalancesByBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalancesByBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BalancesByBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/BalancesByBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BalancesByBatch(ctx, req.(*QueryBalancesByBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/AllBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllBalances(ctx, req.(*QueryAllBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Supply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Supply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/Supply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Supply(ctx, req.(*QuerySupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CreditTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreditTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreditTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/CreditTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreditTypes(ctx, req.(*QueryCreditTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CreditType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreditTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreditType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/CreditType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreditType(ctx, req.(*QueryCreditTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueryServer) Batch(context.Context, *QueryBatchRequest) (*QueryBatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+
+func _Query_ClassCreatorAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassCreatorAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassCreatorAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ClassCreatorAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassCreatorAllowlist(ctx, req.(*QueryClassCreatorAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueryServer) Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
+
+func _Query_AllowedClassCreators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedClassCreatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedClassCreators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/AllowedClassCreators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedClassCreators(ctx, req.(*QueryAllowedClassCreatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueryServer) Balances(context.Context, *QueryBalancesRequest) (*QueryBalancesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Balances not implemented")
+
+func _Query_ClassFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ClassFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassFee(ctx, req.(*QueryClassFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueryServer) BalancesByBatch(context.Context, *QueryBalancesByBatchRequest) (*QueryBalancesByBatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BalancesByBatch not implemented")
+
+func _Query_AllowedBridgeChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedBridgeChainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllowedBridgeChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/AllowedBridgeChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllowedBridgeChains(ctx, req.(*QueryAllowedBridgeChainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueryServer) AllBalances(context.Context, *QueryAllBalancesRequest) (*QueryAllBalancesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllBalances not implemented")
+
+func _Query_Retirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetirementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Retirement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/Retirement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Retirement(ctx, req.(*QueryRetirementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueryServer) Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Supply not implemented")
+
+func _Query_RetirementsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetirementsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RetirementsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/RetirementsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RetirementsByOwner(ctx, req.(*QueryRetirementsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueryServer) CreditTypes(context.Context, *QueryCreditTypesRequest) (*QueryCreditTypesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreditTypes not implemented")
+
+func _Query_RetirementsByBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetirementsByBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RetirementsByBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/RetirementsByBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RetirementsByBatch(ctx, req.(*QueryRetirementsByBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+
+func _Query_Certificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Certificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/Certificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Certificate(ctx, req.(*QueryCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueryServer) CreditType(context.Context, *QueryCreditTypeRequest) (*QueryCreditTypeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreditType not implemented")
+
+func _Query_CertificatesByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCertificatesByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CertificatesByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/CertificatesByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CertificatesByOwner(ctx, req.(*QueryCertificatesByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueryServer) ClassCreatorAllowlist(context.Context, *QueryClassCreatorAllowlistRequest) (*QueryClassCreatorAllowlistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClassCreatorAllowlist not implemented")
+
+func _Query_CreditLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreditLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreditLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/CreditLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreditLock(ctx, req.(*QueryCreditLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueryServer) AllowedClassCreators(context.Context, *QueryAllowedClassCreatorsRequest) (*QueryAllowedClassCreatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllowedClassCreators not implemented")
+
+func _Query_CreditLocksByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreditLocksByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreditLocksByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/CreditLocksByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreditLocksByOwner(ctx, req.(*QueryCreditLocksByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueryServer) ClassFee(context.Context, *QueryClassFeeRequest) (*QueryClassFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClassFee not implemented")
+
+func _Query_CreditLocksByBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreditLocksByBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreditLocksByBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/CreditLocksByBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreditLocksByBatch(ctx, req.(*QueryCreditLocksByBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClassBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassBufferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ClassBuffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassBuffer(ctx, req.(*QueryClassBufferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Reversal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReversalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Reversal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/Reversal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Reversal(ctx, req.(*QueryReversalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReversalsByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReversalsByClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReversalsByClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ReversalsByClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReversalsByClass(ctx, req.(*QueryReversalsByClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueryServer) AllowedBridgeChains(context.Context, *QueryAllowedBridgeChainsRequest) (*QueryAllowedBridgeChainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllowedBridgeChains not implemented")
+
+func _Query_ClassVerifiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassVerifiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassVerifiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ClassVerifiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassVerifiers(ctx, req.(*QueryClassVerifiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
-// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to QueryServer will
-// result in compilation errors.
-type UnsafeQueryServer interface {
-	mustEmbedUnimplementedQueryServer()
+func _Query_ProjectsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ProjectsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectsByStatus(ctx, req.(*QueryProjectsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
-	s.RegisterService(&Query_ServiceDesc, srv)
+func _Query_ProjectStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ProjectStatusHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectStatusHistory(ctx, req.(*QueryProjectStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClassesRequest)
+func _Query_ClassBatchApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassBatchApprovalRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Classes(ctx, in)
+		return srv.(QueryServer).ClassBatchApproval(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/Classes",
+		FullMethod: "/regen.ecocredit.v1.Query/ClassBatchApproval",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Classes(ctx, req.(*QueryClassesRequest))
+		return srv.(QueryServer).ClassBatchApproval(ctx, req.(*QueryClassBatchApprovalRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_ClassesByAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClassesByAdminRequest)
+func _Query_PendingBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingBatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).ClassesByAdmin(ctx, in)
+		return srv.(QueryServer).PendingBatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/ClassesByAdmin",
+		FullMethod: "/regen.ecocredit.v1.Query/PendingBatch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClassesByAdmin(ctx, req.(*QueryClassesByAdminRequest))
+		return srv.(QueryServer).PendingBatch(ctx, req.(*QueryPendingBatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Class_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClassRequest)
+func _Query_PendingBatchesByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingBatchesByClassRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Class(ctx, in)
+		return srv.(QueryServer).PendingBatchesByClass(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/Class",
+		FullMethod: "/regen.ecocredit.v1.Query/PendingBatchesByClass",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Class(ctx, req.(*QueryClassRequest))
+		return srv.(QueryServer).PendingBatchesByClass(ctx, req.(*QueryPendingBatchesByClassRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_ClassIssuers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClassIssuersRequest)
+func _Query_IssuanceHeadroom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIssuanceHeadroomRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).ClassIssuers(ctx, in)
+		return srv.(QueryServer).IssuanceHeadroom(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/ClassIssuers",
+		FullMethod: "/regen.ecocredit.v1.Query/IssuanceHeadroom",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClassIssuers(ctx, req.(*QueryClassIssuersRequest))
+		return srv.(QueryServer).IssuanceHeadroom(ctx, req.(*QueryIssuanceHeadroomRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Projects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProjectsRequest)
+func _Query_BatchMetadataHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchMetadataHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Projects(ctx, in)
+		return srv.(QueryServer).BatchMetadataHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/Projects",
+		FullMethod: "/regen.ecocredit.v1.Query/BatchMetadataHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Projects(ctx, req.(*QueryProjectsRequest))
+		return srv.(QueryServer).BatchMetadataHistory(ctx, req.(*QueryBatchMetadataHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_ProjectsByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProjectsByClassRequest)
+func _Query_ClassBatchMetadataCosign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassBatchMetadataCosignRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).ProjectsByClass(ctx, in)
+		return srv.(QueryServer).ClassBatchMetadataCosign(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/ProjectsByClass",
+		FullMethod: "/regen.ecocredit.v1.Query/ClassBatchMetadataCosign",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProjectsByClass(ctx, req.(*QueryProjectsByClassRequest))
+		return srv.(QueryServer).ClassBatchMetadataCosign(ctx, req.(*QueryClassBatchMetadataCosignRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_ProjectsByReferenceId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProjectsByReferenceIdRequest)
+func _Query_SupplyByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyByProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).ProjectsByReferenceId(ctx, in)
+		return srv.(QueryServer).SupplyByProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/ProjectsByReferenceId",
+		FullMethod: "/regen.ecocredit.v1.Query/SupplyByProject",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProjectsByReferenceId(ctx, req.(*QueryProjectsByReferenceIdRequest))
+		return srv.(QueryServer).SupplyByProject(ctx, req.(*QuerySupplyByProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_ProjectsByAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProjectsByAdminRequest)
+func _Query_SupplyByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyByClassRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).ProjectsByAdmin(ctx, in)
+		return srv.(QueryServer).SupplyByClass(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/ProjectsByAdmin",
+		FullMethod: "/regen.ecocredit.v1.Query/SupplyByClass",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProjectsByAdmin(ctx, req.(*QueryProjectsByAdminRequest))
+		return srv.(QueryServer).SupplyByClass(ctx, req.(*QuerySupplyByClassRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Project_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProjectRequest)
+func _Query_SupplyByCreditType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyByCreditTypeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Project(ctx, in)
+		return srv.(QueryServer).SupplyByCreditType(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/Project",
+		FullMethod: "/regen.ecocredit.v1.Query/SupplyByCreditType",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Project(ctx, req.(*QueryProjectRequest))
+		return srv.(QueryServer).SupplyByCreditType(ctx, req.(*QuerySupplyByCreditTypeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Batches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBatchesRequest)
+func _Query_BalancesByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalancesByClassRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Batches(ctx, in)
+		return srv.(QueryServer).BalancesByClass(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/Batches",
+		FullMethod: "/regen.ecocredit.v1.Query/BalancesByClass",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Batches(ctx, req.(*QueryBatchesRequest))
+		return srv.(QueryServer).BalancesByClass(ctx, req.(*QueryBalancesByClassRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_BatchesByIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBatchesByIssuerRequest)
+func _Query_BalancesByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalancesByProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).BatchesByIssuer(ctx, in)
+		return srv.(QueryServer).BalancesByProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/BatchesByIssuer",
+		FullMethod: "/regen.ecocredit.v1.Query/BalancesByProject",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchesByIssuer(ctx, req.(*QueryBatchesByIssuerRequest))
+		return srv.(QueryServer).BalancesByProject(ctx, req.(*QueryBalancesByProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_BatchesByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBatchesByClassRequest)
+func _Query_HoldersByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHoldersByProjectRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).BatchesByClass(ctx, in)
+		return srv.(QueryServer).HoldersByProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/BatchesByClass",
+		FullMethod: "/regen.ecocredit.v1.Query/HoldersByProject",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchesByClass(ctx, req.(*QueryBatchesByClassRequest))
+		return srv.(QueryServer).HoldersByProject(ctx, req.(*QueryHoldersByProjectRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_BatchesByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBatchesByProjectRequest)
+func _Query_RetirementSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetirementSummaryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).BatchesByProject(ctx, in)
+		return srv.(QueryServer).RetirementSummary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/BatchesByProject",
+		FullMethod: "/regen.ecocredit.v1.Query/RetirementSummary",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchesByProject(ctx, req.(*QueryBatchesByProjectRequest))
+		return srv.(QueryServer).RetirementSummary(ctx, req.(*QueryRetirementSummaryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBatchRequest)
+func _Query_BatchByOriginTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchByOriginTxRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Batch(ctx, in)
+		return srv.(QueryServer).BatchByOriginTx(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/Batch",
+		FullMethod: "/regen.ecocredit.v1.Query/BatchByOriginTx",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Batch(ctx, req.(*QueryBatchRequest))
+		return srv.(QueryServer).BatchByOriginTx(ctx, req.(*QueryBatchByOriginTxRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBalanceRequest)
+func _Query_StrictJurisdictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStrictJurisdictionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Balance(ctx, in)
+		return srv.(QueryServer).StrictJurisdictions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/Balance",
+		FullMethod: "/regen.ecocredit.v1.Query/StrictJurisdictions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Balance(ctx, req.(*QueryBalanceRequest))
+		return srv.(QueryServer).StrictJurisdictions(ctx, req.(*QueryStrictJurisdictionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Balances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBalancesRequest)
+func _Query_ProjectsByJurisdiction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectsByJurisdictionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Balances(ctx, in)
+		return srv.(QueryServer).ProjectsByJurisdiction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/Balances",
+		FullMethod: "/regen.ecocredit.v1.Query/ProjectsByJurisdiction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Balances(ctx, req.(*QueryBalancesRequest))
+		return srv.(QueryServer).ProjectsByJurisdiction(ctx, req.(*QueryProjectsByJurisdictionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_BalancesByBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBalancesByBatchRequest)
+func _Query_RetirementsByJurisdiction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetirementsByJurisdictionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).BalancesByBatch(ctx, in)
+		return srv.(QueryServer).RetirementsByJurisdiction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/BalancesByBatch",
+		FullMethod: "/regen.ecocredit.v1.Query/RetirementsByJurisdiction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BalancesByBatch(ctx, req.(*QueryBalancesByBatchRequest))
+		return srv.(QueryServer).RetirementsByJurisdiction(ctx, req.(*QueryRetirementsByJurisdictionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_AllBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBalancesRequest)
+func _Query_NationalRegistries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNationalRegistriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).AllBalances(ctx, in)
+		return srv.(QueryServer).NationalRegistries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/AllBalances",
+		FullMethod: "/regen.ecocredit.v1.Query/NationalRegistries",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllBalances(ctx, req.(*QueryAllBalancesRequest))
+		return srv.(QueryServer).NationalRegistries(ctx, req.(*QueryNationalRegistriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Supply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySupplyRequest)
+func _Query_Article6AuthorizationsByBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryArticle6AuthorizationsByBatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Supply(ctx, in)
+		return srv.(QueryServer).Article6AuthorizationsByBatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/Supply",
+		FullMethod: "/regen.ecocredit.v1.Query/Article6AuthorizationsByBatch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Supply(ctx, req.(*QuerySupplyRequest))
+		return srv.(QueryServer).Article6AuthorizationsByBatch(ctx, req.(*QueryArticle6AuthorizationsByBatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_CreditTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCreditTypesRequest)
+func _Query_ClassSerialization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassSerializationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).CreditTypes(ctx, in)
+		return srv.(QueryServer).ClassSerialization(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/CreditTypes",
+		FullMethod: "/regen.ecocredit.v1.Query/ClassSerialization",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CreditTypes(ctx, req.(*QueryCreditTypesRequest))
+		return srv.(QueryServer).ClassSerialization(ctx, req.(*QueryClassSerializationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
+func _Query_SerialsByBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySerialsByBatchRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+		return srv.(QueryServer).SerialsByBatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/Params",
+		FullMethod: "/regen.ecocredit.v1.Query/SerialsByBatch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+		return srv.(QueryServer).SerialsByBatch(ctx, req.(*QuerySerialsByBatchRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_CreditType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCreditTypeRequest)
+func _Query_SerialsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySerialsByOwnerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).CreditType(ctx, in)
+		return srv.(QueryServer).SerialsByOwner(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/CreditType",
+		FullMethod: "/regen.ecocredit.v1.Query/SerialsByOwner",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CreditType(ctx, req.(*QueryCreditTypeRequest))
+		return srv.(QueryServer).SerialsByOwner(ctx, req.(*QuerySerialsByOwnerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_ClassCreatorAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClassCreatorAllowlistRequest)
+func _Query_BridgeRequestsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeRequestsByOwnerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).ClassCreatorAllowlist(ctx, in)
+		return srv.(QueryServer).BridgeRequestsByOwner(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/ClassCreatorAllowlist",
+		FullMethod: "/regen.ecocredit.v1.Query/BridgeRequestsByOwner",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClassCreatorAllowlist(ctx, req.(*QueryClassCreatorAllowlistRequest))
+		return srv.(QueryServer).BridgeRequestsByOwner(ctx, req.(*QueryBridgeRequestsByOwnerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_AllowedClassCreators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllowedClassCreatorsRequest)
+func _Query_BridgeRequestsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeRequestsByStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).AllowedClassCreators(ctx, in)
+		return srv.(QueryServer).BridgeRequestsByStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/AllowedClassCreators",
+		FullMethod: "/regen.ecocredit.v1.Query/BridgeRequestsByStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllowedClassCreators(ctx, req.(*QueryAllowedClassCreatorsRequest))
+		return srv.(QueryServer).BridgeRequestsByStatus(ctx, req.(*QueryBridgeRequestsByStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_ClassFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClassFeeRequest)
+func _Query_AllowedBridgeServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedBridgeServicesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).ClassFee(ctx, in)
+		return srv.(QueryServer).AllowedBridgeServices(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/ClassFee",
+		FullMethod: "/regen.ecocredit.v1.Query/AllowedBridgeServices",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClassFee(ctx, req.(*QueryClassFeeRequest))
+		return srv.(QueryServer).AllowedBridgeServices(ctx, req.(*QueryAllowedBridgeServicesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_AllowedBridgeChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllowedBridgeChainsRequest)
+func _Query_AllowedBridgeContracts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllowedBridgeContractsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).AllowedBridgeChains(ctx, in)
+		return srv.(QueryServer).AllowedBridgeContracts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Query/AllowedBridgeChains",
+		FullMethod: "/regen.ecocredit.v1.Query/AllowedBridgeContracts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllowedBridgeChains(ctx, req.(*QueryAllowedBridgeChainsRequest))
+		return srv.(QueryServer).AllowedBridgeContracts(ctx, req.(*QueryAllowedBridgeContractsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1102,6 +2722,166 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllowedBridgeChains",
 			Handler:    _Query_AllowedBridgeChains_Handler,
 		},
+		{
+			MethodName: "Retirement",
+			Handler:    _Query_Retirement_Handler,
+		},
+		{
+			MethodName: "RetirementsByOwner",
+			Handler:    _Query_RetirementsByOwner_Handler,
+		},
+		{
+			MethodName: "RetirementsByBatch",
+			Handler:    _Query_RetirementsByBatch_Handler,
+		},
+		{
+			MethodName: "Certificate",
+			Handler:    _Query_Certificate_Handler,
+		},
+		{
+			MethodName: "CertificatesByOwner",
+			Handler:    _Query_CertificatesByOwner_Handler,
+		},
+		{
+			MethodName: "CreditLock",
+			Handler:    _Query_CreditLock_Handler,
+		},
+		{
+			MethodName: "CreditLocksByOwner",
+			Handler:    _Query_CreditLocksByOwner_Handler,
+		},
+		{
+			MethodName: "CreditLocksByBatch",
+			Handler:    _Query_CreditLocksByBatch_Handler,
+		},
+		{
+			MethodName: "ClassBuffer",
+			Handler:    _Query_ClassBuffer_Handler,
+		},
+		{
+			MethodName: "Reversal",
+			Handler:    _Query_Reversal_Handler,
+		},
+		{
+			MethodName: "ReversalsByClass",
+			Handler:    _Query_ReversalsByClass_Handler,
+		},
+		{
+			MethodName: "ClassVerifiers",
+			Handler:    _Query_ClassVerifiers_Handler,
+		},
+		{
+			MethodName: "ProjectsByStatus",
+			Handler:    _Query_ProjectsByStatus_Handler,
+		},
+		{
+			MethodName: "ProjectStatusHistory",
+			Handler:    _Query_ProjectStatusHistory_Handler,
+		},
+		{
+			MethodName: "ClassBatchApproval",
+			Handler:    _Query_ClassBatchApproval_Handler,
+		},
+		{
+			MethodName: "PendingBatch",
+			Handler:    _Query_PendingBatch_Handler,
+		},
+		{
+			MethodName: "PendingBatchesByClass",
+			Handler:    _Query_PendingBatchesByClass_Handler,
+		},
+		{
+			MethodName: "IssuanceHeadroom",
+			Handler:    _Query_IssuanceHeadroom_Handler,
+		},
+		{
+			MethodName: "BatchMetadataHistory",
+			Handler:    _Query_BatchMetadataHistory_Handler,
+		},
+		{
+			MethodName: "ClassBatchMetadataCosign",
+			Handler:    _Query_ClassBatchMetadataCosign_Handler,
+		},
+		{
+			MethodName: "SupplyByProject",
+			Handler:    _Query_SupplyByProject_Handler,
+		},
+		{
+			MethodName: "SupplyByClass",
+			Handler:    _Query_SupplyByClass_Handler,
+		},
+		{
+			MethodName: "SupplyByCreditType",
+			Handler:    _Query_SupplyByCreditType_Handler,
+		},
+		{
+			MethodName: "BalancesByClass",
+			Handler:    _Query_BalancesByClass_Handler,
+		},
+		{
+			MethodName: "BalancesByProject",
+			Handler:    _Query_BalancesByProject_Handler,
+		},
+		{
+			MethodName: "HoldersByProject",
+			Handler:    _Query_HoldersByProject_Handler,
+		},
+		{
+			MethodName: "RetirementSummary",
+			Handler:    _Query_RetirementSummary_Handler,
+		},
+		{
+			MethodName: "BatchByOriginTx",
+			Handler:    _Query_BatchByOriginTx_Handler,
+		},
+		{
+			MethodName: "StrictJurisdictions",
+			Handler:    _Query_StrictJurisdictions_Handler,
+		},
+		{
+			MethodName: "ProjectsByJurisdiction",
+			Handler:    _Query_ProjectsByJurisdiction_Handler,
+		},
+		{
+			MethodName: "RetirementsByJurisdiction",
+			Handler:    _Query_RetirementsByJurisdiction_Handler,
+		},
+		{
+			MethodName: "NationalRegistries",
+			Handler:    _Query_NationalRegistries_Handler,
+		},
+		{
+			MethodName: "Article6AuthorizationsByBatch",
+			Handler:    _Query_Article6AuthorizationsByBatch_Handler,
+		},
+		{
+			MethodName: "ClassSerialization",
+			Handler:    _Query_ClassSerialization_Handler,
+		},
+		{
+			MethodName: "SerialsByBatch",
+			Handler:    _Query_SerialsByBatch_Handler,
+		},
+		{
+			MethodName: "SerialsByOwner",
+			Handler:    _Query_SerialsByOwner_Handler,
+		},
+		{
+			MethodName: "BridgeRequestsByOwner",
+			Handler:    _Query_BridgeRequestsByOwner_Handler,
+		},
+		{
+			MethodName: "BridgeRequestsByStatus",
+			Handler:    _Query_BridgeRequestsByStatus_Handler,
+		},
+		{
+			MethodName: "AllowedBridgeServices",
+			Handler:    _Query_AllowedBridgeServices_Handler,
+		},
+		{
+			MethodName: "AllowedBridgeContracts",
+			Handler:    _Query_AllowedBridgeContracts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/v1/query.proto",
@@ -590,6 +590,19 @@ func (this ProjectClassKeyReferenceIdIndexKey) WithClassKeyReferenceId(class_key
 	return this
 }
 
+type ProjectJurisdictionIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectJurisdictionIndexKey) id() uint32            { return 6 }
+func (x ProjectJurisdictionIndexKey) values() []interface{} { return x.vs }
+func (x ProjectJurisdictionIndexKey) projectIndexKey()      {}
+
+func (this ProjectJurisdictionIndexKey) WithJurisdiction(jurisdiction string) ProjectJurisdictionIndexKey {
+	this.vs = []interface{}{jurisdiction}
+	return this
+}
+
 type projectTable struct {
 	table ormtable.AutoIncrementTable
 }
@@ -2058,191 +2071,4838 @@ func NewAllowedBridgeChainTable(db ormtable.Schema) (AllowedBridgeChainTable, er
 	return allowedBridgeChainTable{table}, nil
 }
 
-type StateStore interface {
-	CreditTypeTable() CreditTypeTable
-	ClassTable() ClassTable
-	ClassIssuerTable() ClassIssuerTable
-	ProjectTable() ProjectTable
-	BatchTable() BatchTable
-	ClassSequenceTable() ClassSequenceTable
-	ProjectSequenceTable() ProjectSequenceTable
-	BatchSequenceTable() BatchSequenceTable
-	BatchBalanceTable() BatchBalanceTable
-	BatchSupplyTable() BatchSupplyTable
-	OriginTxIndexTable() OriginTxIndexTable
-	BatchContractTable() BatchContractTable
-	ClassCreatorAllowlistTable() ClassCreatorAllowlistTable
-	AllowedClassCreatorTable() AllowedClassCreatorTable
-	ClassFeeTable() ClassFeeTable
-	AllowedBridgeChainTable() AllowedBridgeChainTable
+type RetirementTable interface {
+	Insert(ctx context.Context, retirement *Retirement) error
+	InsertReturningID(ctx context.Context, retirement *Retirement) (uint64, error)
+	Update(ctx context.Context, retirement *Retirement) error
+	Save(ctx context.Context, retirement *Retirement) error
+	Delete(ctx context.Context, retirement *Retirement) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Retirement, error)
+	List(ctx context.Context, prefixKey RetirementIndexKey, opts ...ormlist.Option) (RetirementIterator, error)
+	ListRange(ctx context.Context, from, to RetirementIndexKey, opts ...ormlist.Option) (RetirementIterator, error)
+	DeleteBy(ctx context.Context, prefixKey RetirementIndexKey) error
+	DeleteRange(ctx context.Context, from, to RetirementIndexKey) error
 
 	doNotImplement()
 }
 
-type stateStore struct {
-	creditType            CreditTypeTable
-	class                 ClassTable
-	classIssuer           ClassIssuerTable
-	project               ProjectTable
-	batch                 BatchTable
-	classSequence         ClassSequenceTable
-	projectSequence       ProjectSequenceTable
-	batchSequence         BatchSequenceTable
-	batchBalance          BatchBalanceTable
-	batchSupply           BatchSupplyTable
-	originTxIndex         OriginTxIndexTable
-	batchContract         BatchContractTable
-	classCreatorAllowlist ClassCreatorAllowlistTable
-	allowedClassCreator   AllowedClassCreatorTable
-	classFee              ClassFeeTable
-	allowedBridgeChain    AllowedBridgeChainTable
+type RetirementIterator struct {
+	ormtable.Iterator
 }
 
-func (x stateStore) CreditTypeTable() CreditTypeTable {
-	return x.creditType
+func (i RetirementIterator) Value() (*Retirement, error) {
+	var retirement Retirement
+	err := i.UnmarshalMessage(&retirement)
+	return &retirement, err
 }
 
-func (x stateStore) ClassTable() ClassTable {
-	return x.class
+type RetirementIndexKey interface {
+	id() uint32
+	values() []interface{}
+	retirementIndexKey()
 }
 
-func (x stateStore) ClassIssuerTable() ClassIssuerTable {
-	return x.classIssuer
+// primary key starting index..
+type RetirementPrimaryKey = RetirementIdIndexKey
+
+type RetirementIdIndexKey struct {
+	vs []interface{}
 }
 
-func (x stateStore) ProjectTable() ProjectTable {
-	return x.project
+func (x RetirementIdIndexKey) id() uint32            { return 0 }
+func (x RetirementIdIndexKey) values() []interface{} { return x.vs }
+func (x RetirementIdIndexKey) retirementIndexKey()   {}
+
+func (this RetirementIdIndexKey) WithId(id uint64) RetirementIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
 }
 
-func (x stateStore) BatchTable() BatchTable {
-	return x.batch
+type RetirementOwnerIndexKey struct {
+	vs []interface{}
 }
 
-func (x stateStore) ClassSequenceTable() ClassSequenceTable {
-	return x.classSequence
+func (x RetirementOwnerIndexKey) id() uint32            { return 1 }
+func (x RetirementOwnerIndexKey) values() []interface{} { return x.vs }
+func (x RetirementOwnerIndexKey) retirementIndexKey()   {}
+
+func (this RetirementOwnerIndexKey) WithOwner(owner []byte) RetirementOwnerIndexKey {
+	this.vs = []interface{}{owner}
+	return this
 }
 
-func (x stateStore) ProjectSequenceTable() ProjectSequenceTable {
-	return x.projectSequence
+type RetirementBatchKeyIndexKey struct {
+	vs []interface{}
 }
 
-func (x stateStore) BatchSequenceTable() BatchSequenceTable {
-	return x.batchSequence
+func (x RetirementBatchKeyIndexKey) id() uint32            { return 2 }
+func (x RetirementBatchKeyIndexKey) values() []interface{} { return x.vs }
+func (x RetirementBatchKeyIndexKey) retirementIndexKey()   {}
+
+func (this RetirementBatchKeyIndexKey) WithBatchKey(batch_key uint64) RetirementBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
 }
 
-func (x stateStore) BatchBalanceTable() BatchBalanceTable {
-	return x.batchBalance
+type RetirementTimestampIndexKey struct {
+	vs []interface{}
 }
 
-func (x stateStore) BatchSupplyTable() BatchSupplyTable {
-	return x.batchSupply
+func (x RetirementTimestampIndexKey) id() uint32            { return 3 }
+func (x RetirementTimestampIndexKey) values() []interface{} { return x.vs }
+func (x RetirementTimestampIndexKey) retirementIndexKey()   {}
+
+func (this RetirementTimestampIndexKey) WithTimestamp(timestamp *timestamppb.Timestamp) RetirementTimestampIndexKey {
+	this.vs = []interface{}{timestamp}
+	return this
 }
 
-func (x stateStore) OriginTxIndexTable() OriginTxIndexTable {
-	return x.originTxIndex
+type RetirementJurisdictionIndexKey struct {
+	vs []interface{}
 }
 
-func (x stateStore) BatchContractTable() BatchContractTable {
-	return x.batchContract
+func (x RetirementJurisdictionIndexKey) id() uint32            { return 4 }
+func (x RetirementJurisdictionIndexKey) values() []interface{} { return x.vs }
+func (x RetirementJurisdictionIndexKey) retirementIndexKey()   {}
+
+func (this RetirementJurisdictionIndexKey) WithJurisdiction(jurisdiction string) RetirementJurisdictionIndexKey {
+	this.vs = []interface{}{jurisdiction}
+	return this
 }
 
-func (x stateStore) ClassCreatorAllowlistTable() ClassCreatorAllowlistTable {
-	return x.classCreatorAllowlist
+type retirementTable struct {
+	table ormtable.AutoIncrementTable
 }
 
-func (x stateStore) AllowedClassCreatorTable() AllowedClassCreatorTable {
-	return x.allowedClassCreator
+func (this retirementTable) Insert(ctx context.Context, retirement *Retirement) error {
+	return this.table.Insert(ctx, retirement)
 }
 
-func (x stateStore) ClassFeeTable() ClassFeeTable {
-	return x.classFee
+func (this retirementTable) Update(ctx context.Context, retirement *Retirement) error {
+	return this.table.Update(ctx, retirement)
 }
 
-func (x stateStore) AllowedBridgeChainTable() AllowedBridgeChainTable {
-	return x.allowedBridgeChain
+func (this retirementTable) Save(ctx context.Context, retirement *Retirement) error {
+	return this.table.Save(ctx, retirement)
 }
 
-func (stateStore) doNotImplement() {}
+func (this retirementTable) Delete(ctx context.Context, retirement *Retirement) error {
+	return this.table.Delete(ctx, retirement)
+}
 
-var _ StateStore = stateStore{}
+func (this retirementTable) InsertReturningID(ctx context.Context, retirement *Retirement) (uint64, error) {
+	return this.table.InsertReturningID(ctx, retirement)
+}
 
-func NewStateStore(db ormtable.Schema) (StateStore, error) {
-	creditTypeTable, err := NewCreditTypeTable(db)
-	if err != nil {
-		return nil, err
-	}
+func (this retirementTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
 
-	classTable, err := NewClassTable(db)
+func (this retirementTable) Get(ctx context.Context, id uint64) (*Retirement, error) {
+	var retirement Retirement
+	found, err := this.table.PrimaryKey().Get(ctx, &retirement, id)
 	if err != nil {
 		return nil, err
 	}
-
-	classIssuerTable, err := NewClassIssuerTable(db)
-	if err != nil {
-		return nil, err
+	if !found {
+		return nil, ormerrors.NotFound
 	}
+	return &retirement, nil
+}
 
-	projectTable, err := NewProjectTable(db)
-	if err != nil {
-		return nil, err
-	}
+func (this retirementTable) List(ctx context.Context, prefixKey RetirementIndexKey, opts ...ormlist.Option) (RetirementIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return RetirementIterator{it}, err
+}
 
-	batchTable, err := NewBatchTable(db)
-	if err != nil {
-		return nil, err
-	}
+func (this retirementTable) ListRange(ctx context.Context, from, to RetirementIndexKey, opts ...ormlist.Option) (RetirementIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return RetirementIterator{it}, err
+}
 
-	classSequenceTable, err := NewClassSequenceTable(db)
-	if err != nil {
-		return nil, err
-	}
+func (this retirementTable) DeleteBy(ctx context.Context, prefixKey RetirementIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
 
-	projectSequenceTable, err := NewProjectSequenceTable(db)
-	if err != nil {
-		return nil, err
-	}
+func (this retirementTable) DeleteRange(ctx context.Context, from, to RetirementIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
 
-	batchSequenceTable, err := NewBatchSequenceTable(db)
-	if err != nil {
-		return nil, err
-	}
+func (this retirementTable) doNotImplement() {}
 
-	batchBalanceTable, err := NewBatchBalanceTable(db)
-	if err != nil {
-		return nil, err
-	}
+var _ RetirementTable = retirementTable{}
 
-	batchSupplyTable, err := NewBatchSupplyTable(db)
-	if err != nil {
-		return nil, err
+func NewRetirementTable(db ormtable.Schema) (RetirementTable, error) {
+	table := db.GetTable(&Retirement{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Retirement{}).ProtoReflect().Descriptor().FullName()))
 	}
+	return retirementTable{table.(ormtable.AutoIncrementTable)}, nil
+}
 
-	originTxIndexTable, err := NewOriginTxIndexTable(db)
-	if err != nil {
-		return nil, err
-	}
+type RetirementCertificateTable interface {
+	Insert(ctx context.Context, retirementCertificate *RetirementCertificate) error
+	Update(ctx context.Context, retirementCertificate *RetirementCertificate) error
+	Save(ctx context.Context, retirementCertificate *RetirementCertificate) error
+	Delete(ctx context.Context, retirementCertificate *RetirementCertificate) error
+	Has(ctx context.Context, retirement_id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, retirement_id uint64) (*RetirementCertificate, error)
+	HasByNftClassIdNftId(ctx context.Context, nft_class_id string, nft_id string) (found bool, err error)
+	// GetByNftClassIdNftId returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	GetByNftClassIdNftId(ctx context.Context, nft_class_id string, nft_id string) (*RetirementCertificate, error)
+	List(ctx context.Context, prefixKey RetirementCertificateIndexKey, opts ...ormlist.Option) (RetirementCertificateIterator, error)
+	ListRange(ctx context.Context, from, to RetirementCertificateIndexKey, opts ...ormlist.Option) (RetirementCertificateIterator, error)
+	DeleteBy(ctx context.Context, prefixKey RetirementCertificateIndexKey) error
+	DeleteRange(ctx context.Context, from, to RetirementCertificateIndexKey) error
 
-	batchContractTable, err := NewBatchContractTable(db)
-	if err != nil {
-		return nil, err
-	}
+	doNotImplement()
+}
 
-	classCreatorAllowlistTable, err := NewClassCreatorAllowlistTable(db)
+type RetirementCertificateIterator struct {
+	ormtable.Iterator
+}
+
+func (i RetirementCertificateIterator) Value() (*RetirementCertificate, error) {
+	var retirementCertificate RetirementCertificate
+	err := i.UnmarshalMessage(&retirementCertificate)
+	return &retirementCertificate, err
+}
+
+type RetirementCertificateIndexKey interface {
+	id() uint32
+	values() []interface{}
+	retirementCertificateIndexKey()
+}
+
+// primary key starting index..
+type RetirementCertificatePrimaryKey = RetirementCertificateRetirementIdIndexKey
+
+type RetirementCertificateRetirementIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x RetirementCertificateRetirementIdIndexKey) id() uint32                     { return 0 }
+func (x RetirementCertificateRetirementIdIndexKey) values() []interface{}          { return x.vs }
+func (x RetirementCertificateRetirementIdIndexKey) retirementCertificateIndexKey() {}
+
+func (this RetirementCertificateRetirementIdIndexKey) WithRetirementId(retirement_id uint64) RetirementCertificateRetirementIdIndexKey {
+	this.vs = []interface{}{retirement_id}
+	return this
+}
+
+type RetirementCertificateNftClassIdNftIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x RetirementCertificateNftClassIdNftIdIndexKey) id() uint32                     { return 1 }
+func (x RetirementCertificateNftClassIdNftIdIndexKey) values() []interface{}          { return x.vs }
+func (x RetirementCertificateNftClassIdNftIdIndexKey) retirementCertificateIndexKey() {}
+
+func (this RetirementCertificateNftClassIdNftIdIndexKey) WithNftClassId(nft_class_id string) RetirementCertificateNftClassIdNftIdIndexKey {
+	this.vs = []interface{}{nft_class_id}
+	return this
+}
+
+func (this RetirementCertificateNftClassIdNftIdIndexKey) WithNftClassIdNftId(nft_class_id string, nft_id string) RetirementCertificateNftClassIdNftIdIndexKey {
+	this.vs = []interface{}{nft_class_id, nft_id}
+	return this
+}
+
+type retirementCertificateTable struct {
+	table ormtable.Table
+}
+
+func (this retirementCertificateTable) Insert(ctx context.Context, retirementCertificate *RetirementCertificate) error {
+	return this.table.Insert(ctx, retirementCertificate)
+}
+
+func (this retirementCertificateTable) Update(ctx context.Context, retirementCertificate *RetirementCertificate) error {
+	return this.table.Update(ctx, retirementCertificate)
+}
+
+func (this retirementCertificateTable) Save(ctx context.Context, retirementCertificate *RetirementCertificate) error {
+	return this.table.Save(ctx, retirementCertificate)
+}
+
+func (this retirementCertificateTable) Delete(ctx context.Context, retirementCertificate *RetirementCertificate) error {
+	return this.table.Delete(ctx, retirementCertificate)
+}
+
+func (this retirementCertificateTable) Has(ctx context.Context, retirement_id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, retirement_id)
+}
+
+func (this retirementCertificateTable) Get(ctx context.Context, retirement_id uint64) (*RetirementCertificate, error) {
+	var retirementCertificate RetirementCertificate
+	found, err := this.table.PrimaryKey().Get(ctx, &retirementCertificate, retirement_id)
 	if err != nil {
 		return nil, err
 	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &retirementCertificate, nil
+}
 
-	allowedClassCreatorTable, err := NewAllowedClassCreatorTable(db)
+func (this retirementCertificateTable) HasByNftClassIdNftId(ctx context.Context, nft_class_id string, nft_id string) (found bool, err error) {
+	return this.table.GetIndexByID(1).(ormtable.UniqueIndex).Has(ctx,
+		nft_class_id,
+		nft_id,
+	)
+}
+
+func (this retirementCertificateTable) GetByNftClassIdNftId(ctx context.Context, nft_class_id string, nft_id string) (*RetirementCertificate, error) {
+	var retirementCertificate RetirementCertificate
+	found, err := this.table.GetIndexByID(1).(ormtable.UniqueIndex).Get(ctx, &retirementCertificate,
+		nft_class_id,
+		nft_id,
+	)
 	if err != nil {
 		return nil, err
 	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &retirementCertificate, nil
+}
 
-	classFeeTable, err := NewClassFeeTable(db)
-	if err != nil {
-		return nil, err
+func (this retirementCertificateTable) List(ctx context.Context, prefixKey RetirementCertificateIndexKey, opts ...ormlist.Option) (RetirementCertificateIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return RetirementCertificateIterator{it}, err
+}
+
+func (this retirementCertificateTable) ListRange(ctx context.Context, from, to RetirementCertificateIndexKey, opts ...ormlist.Option) (RetirementCertificateIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return RetirementCertificateIterator{it}, err
+}
+
+func (this retirementCertificateTable) DeleteBy(ctx context.Context, prefixKey RetirementCertificateIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this retirementCertificateTable) DeleteRange(ctx context.Context, from, to RetirementCertificateIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this retirementCertificateTable) doNotImplement() {}
+
+var _ RetirementCertificateTable = retirementCertificateTable{}
+
+func NewRetirementCertificateTable(db ormtable.Schema) (RetirementCertificateTable, error) {
+	table := db.GetTable(&RetirementCertificate{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&RetirementCertificate{}).ProtoReflect().Descriptor().FullName()))
 	}
+	return retirementCertificateTable{table}, nil
+}
 
-	allowedBridgeChainTable, err := NewAllowedBridgeChainTable(db)
+type BatchAllowanceTable interface {
+	Insert(ctx context.Context, batchAllowance *BatchAllowance) error
+	Update(ctx context.Context, batchAllowance *BatchAllowance) error
+	Save(ctx context.Context, batchAllowance *BatchAllowance) error
+	Delete(ctx context.Context, batchAllowance *BatchAllowance) error
+	Has(ctx context.Context, owner []byte, spender []byte, batch_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, owner []byte, spender []byte, batch_key uint64) (*BatchAllowance, error)
+	List(ctx context.Context, prefixKey BatchAllowanceIndexKey, opts ...ormlist.Option) (BatchAllowanceIterator, error)
+	ListRange(ctx context.Context, from, to BatchAllowanceIndexKey, opts ...ormlist.Option) (BatchAllowanceIterator, error)
+	DeleteBy(ctx context.Context, prefixKey BatchAllowanceIndexKey) error
+	DeleteRange(ctx context.Context, from, to BatchAllowanceIndexKey) error
+
+	doNotImplement()
+}
+
+type BatchAllowanceIterator struct {
+	ormtable.Iterator
+}
+
+func (i BatchAllowanceIterator) Value() (*BatchAllowance, error) {
+	var batchAllowance BatchAllowance
+	err := i.UnmarshalMessage(&batchAllowance)
+	return &batchAllowance, err
+}
+
+type BatchAllowanceIndexKey interface {
+	id() uint32
+	values() []interface{}
+	batchAllowanceIndexKey()
+}
+
+// primary key starting index..
+type BatchAllowancePrimaryKey = BatchAllowanceOwnerSpenderBatchKeyIndexKey
+
+type BatchAllowanceOwnerSpenderBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchAllowanceOwnerSpenderBatchKeyIndexKey) id() uint32              { return 0 }
+func (x BatchAllowanceOwnerSpenderBatchKeyIndexKey) values() []interface{}   { return x.vs }
+func (x BatchAllowanceOwnerSpenderBatchKeyIndexKey) batchAllowanceIndexKey() {}
+
+func (this BatchAllowanceOwnerSpenderBatchKeyIndexKey) WithOwner(owner []byte) BatchAllowanceOwnerSpenderBatchKeyIndexKey {
+	this.vs = []interface{}{owner}
+	return this
+}
+
+func (this BatchAllowanceOwnerSpenderBatchKeyIndexKey) WithOwnerSpender(owner []byte, spender []byte) BatchAllowanceOwnerSpenderBatchKeyIndexKey {
+	this.vs = []interface{}{owner, spender}
+	return this
+}
+
+func (this BatchAllowanceOwnerSpenderBatchKeyIndexKey) WithOwnerSpenderBatchKey(owner []byte, spender []byte, batch_key uint64) BatchAllowanceOwnerSpenderBatchKeyIndexKey {
+	this.vs = []interface{}{owner, spender, batch_key}
+	return this
+}
+
+type BatchAllowanceSpenderIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchAllowanceSpenderIndexKey) id() uint32              { return 1 }
+func (x BatchAllowanceSpenderIndexKey) values() []interface{}   { return x.vs }
+func (x BatchAllowanceSpenderIndexKey) batchAllowanceIndexKey() {}
+
+func (this BatchAllowanceSpenderIndexKey) WithSpender(spender []byte) BatchAllowanceSpenderIndexKey {
+	this.vs = []interface{}{spender}
+	return this
+}
+
+type batchAllowanceTable struct {
+	table ormtable.Table
+}
+
+func (this batchAllowanceTable) Insert(ctx context.Context, batchAllowance *BatchAllowance) error {
+	return this.table.Insert(ctx, batchAllowance)
+}
+
+func (this batchAllowanceTable) Update(ctx context.Context, batchAllowance *BatchAllowance) error {
+	return this.table.Update(ctx, batchAllowance)
+}
+
+func (this batchAllowanceTable) Save(ctx context.Context, batchAllowance *BatchAllowance) error {
+	return this.table.Save(ctx, batchAllowance)
+}
+
+func (this batchAllowanceTable) Delete(ctx context.Context, batchAllowance *BatchAllowance) error {
+	return this.table.Delete(ctx, batchAllowance)
+}
+
+func (this batchAllowanceTable) Has(ctx context.Context, owner []byte, spender []byte, batch_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, owner, spender, batch_key)
+}
+
+func (this batchAllowanceTable) Get(ctx context.Context, owner []byte, spender []byte, batch_key uint64) (*BatchAllowance, error) {
+	var batchAllowance BatchAllowance
+	found, err := this.table.PrimaryKey().Get(ctx, &batchAllowance, owner, spender, batch_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &batchAllowance, nil
+}
+
+func (this batchAllowanceTable) List(ctx context.Context, prefixKey BatchAllowanceIndexKey, opts ...ormlist.Option) (BatchAllowanceIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return BatchAllowanceIterator{it}, err
+}
+
+func (this batchAllowanceTable) ListRange(ctx context.Context, from, to BatchAllowanceIndexKey, opts ...ormlist.Option) (BatchAllowanceIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return BatchAllowanceIterator{it}, err
+}
+
+func (this batchAllowanceTable) DeleteBy(ctx context.Context, prefixKey BatchAllowanceIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this batchAllowanceTable) DeleteRange(ctx context.Context, from, to BatchAllowanceIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this batchAllowanceTable) doNotImplement() {}
+
+var _ BatchAllowanceTable = batchAllowanceTable{}
+
+func NewBatchAllowanceTable(db ormtable.Schema) (BatchAllowanceTable, error) {
+	table := db.GetTable(&BatchAllowance{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BatchAllowance{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return batchAllowanceTable{table}, nil
+}
+
+type ClassAllowanceTable interface {
+	Insert(ctx context.Context, classAllowance *ClassAllowance) error
+	Update(ctx context.Context, classAllowance *ClassAllowance) error
+	Save(ctx context.Context, classAllowance *ClassAllowance) error
+	Delete(ctx context.Context, classAllowance *ClassAllowance) error
+	Has(ctx context.Context, owner []byte, spender []byte, class_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, owner []byte, spender []byte, class_key uint64) (*ClassAllowance, error)
+	List(ctx context.Context, prefixKey ClassAllowanceIndexKey, opts ...ormlist.Option) (ClassAllowanceIterator, error)
+	ListRange(ctx context.Context, from, to ClassAllowanceIndexKey, opts ...ormlist.Option) (ClassAllowanceIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ClassAllowanceIndexKey) error
+	DeleteRange(ctx context.Context, from, to ClassAllowanceIndexKey) error
+
+	doNotImplement()
+}
+
+type ClassAllowanceIterator struct {
+	ormtable.Iterator
+}
+
+func (i ClassAllowanceIterator) Value() (*ClassAllowance, error) {
+	var classAllowance ClassAllowance
+	err := i.UnmarshalMessage(&classAllowance)
+	return &classAllowance, err
+}
+
+type ClassAllowanceIndexKey interface {
+	id() uint32
+	values() []interface{}
+	classAllowanceIndexKey()
+}
+
+// primary key starting index..
+type ClassAllowancePrimaryKey = ClassAllowanceOwnerSpenderClassKeyIndexKey
+
+type ClassAllowanceOwnerSpenderClassKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ClassAllowanceOwnerSpenderClassKeyIndexKey) id() uint32              { return 0 }
+func (x ClassAllowanceOwnerSpenderClassKeyIndexKey) values() []interface{}   { return x.vs }
+func (x ClassAllowanceOwnerSpenderClassKeyIndexKey) classAllowanceIndexKey() {}
+
+func (this ClassAllowanceOwnerSpenderClassKeyIndexKey) WithOwner(owner []byte) ClassAllowanceOwnerSpenderClassKeyIndexKey {
+	this.vs = []interface{}{owner}
+	return this
+}
+
+func (this ClassAllowanceOwnerSpenderClassKeyIndexKey) WithOwnerSpender(owner []byte, spender []byte) ClassAllowanceOwnerSpenderClassKeyIndexKey {
+	this.vs = []interface{}{owner, spender}
+	return this
+}
+
+func (this ClassAllowanceOwnerSpenderClassKeyIndexKey) WithOwnerSpenderClassKey(owner []byte, spender []byte, class_key uint64) ClassAllowanceOwnerSpenderClassKeyIndexKey {
+	this.vs = []interface{}{owner, spender, class_key}
+	return this
+}
+
+type ClassAllowanceSpenderIndexKey struct {
+	vs []interface{}
+}
+
+func (x ClassAllowanceSpenderIndexKey) id() uint32              { return 1 }
+func (x ClassAllowanceSpenderIndexKey) values() []interface{}   { return x.vs }
+func (x ClassAllowanceSpenderIndexKey) classAllowanceIndexKey() {}
+
+func (this ClassAllowanceSpenderIndexKey) WithSpender(spender []byte) ClassAllowanceSpenderIndexKey {
+	this.vs = []interface{}{spender}
+	return this
+}
+
+type classAllowanceTable struct {
+	table ormtable.Table
+}
+
+func (this classAllowanceTable) Insert(ctx context.Context, classAllowance *ClassAllowance) error {
+	return this.table.Insert(ctx, classAllowance)
+}
+
+func (this classAllowanceTable) Update(ctx context.Context, classAllowance *ClassAllowance) error {
+	return this.table.Update(ctx, classAllowance)
+}
+
+func (this classAllowanceTable) Save(ctx context.Context, classAllowance *ClassAllowance) error {
+	return this.table.Save(ctx, classAllowance)
+}
+
+func (this classAllowanceTable) Delete(ctx context.Context, classAllowance *ClassAllowance) error {
+	return this.table.Delete(ctx, classAllowance)
+}
+
+func (this classAllowanceTable) Has(ctx context.Context, owner []byte, spender []byte, class_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, owner, spender, class_key)
+}
+
+func (this classAllowanceTable) Get(ctx context.Context, owner []byte, spender []byte, class_key uint64) (*ClassAllowance, error) {
+	var classAllowance ClassAllowance
+	found, err := this.table.PrimaryKey().Get(ctx, &classAllowance, owner, spender, class_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &classAllowance, nil
+}
+
+func (this classAllowanceTable) List(ctx context.Context, prefixKey ClassAllowanceIndexKey, opts ...ormlist.Option) (ClassAllowanceIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ClassAllowanceIterator{it}, err
+}
+
+func (this classAllowanceTable) ListRange(ctx context.Context, from, to ClassAllowanceIndexKey, opts ...ormlist.Option) (ClassAllowanceIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ClassAllowanceIterator{it}, err
+}
+
+func (this classAllowanceTable) DeleteBy(ctx context.Context, prefixKey ClassAllowanceIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this classAllowanceTable) DeleteRange(ctx context.Context, from, to ClassAllowanceIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this classAllowanceTable) doNotImplement() {}
+
+var _ ClassAllowanceTable = classAllowanceTable{}
+
+func NewClassAllowanceTable(db ormtable.Schema) (ClassAllowanceTable, error) {
+	table := db.GetTable(&ClassAllowance{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ClassAllowance{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return classAllowanceTable{table}, nil
+}
+
+type CreditLockTable interface {
+	Insert(ctx context.Context, creditLock *CreditLock) error
+	InsertReturningID(ctx context.Context, creditLock *CreditLock) (uint64, error)
+	Update(ctx context.Context, creditLock *CreditLock) error
+	Save(ctx context.Context, creditLock *CreditLock) error
+	Delete(ctx context.Context, creditLock *CreditLock) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*CreditLock, error)
+	List(ctx context.Context, prefixKey CreditLockIndexKey, opts ...ormlist.Option) (CreditLockIterator, error)
+	ListRange(ctx context.Context, from, to CreditLockIndexKey, opts ...ormlist.Option) (CreditLockIterator, error)
+	DeleteBy(ctx context.Context, prefixKey CreditLockIndexKey) error
+	DeleteRange(ctx context.Context, from, to CreditLockIndexKey) error
+
+	doNotImplement()
+}
+
+type CreditLockIterator struct {
+	ormtable.Iterator
+}
+
+func (i CreditLockIterator) Value() (*CreditLock, error) {
+	var creditLock CreditLock
+	err := i.UnmarshalMessage(&creditLock)
+	return &creditLock, err
+}
+
+type CreditLockIndexKey interface {
+	id() uint32
+	values() []interface{}
+	creditLockIndexKey()
+}
+
+// primary key starting index..
+type CreditLockPrimaryKey = CreditLockIdIndexKey
+
+type CreditLockIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x CreditLockIdIndexKey) id() uint32            { return 0 }
+func (x CreditLockIdIndexKey) values() []interface{} { return x.vs }
+func (x CreditLockIdIndexKey) creditLockIndexKey()   {}
+
+func (this CreditLockIdIndexKey) WithId(id uint64) CreditLockIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type CreditLockOwnerBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x CreditLockOwnerBatchKeyIndexKey) id() uint32            { return 1 }
+func (x CreditLockOwnerBatchKeyIndexKey) values() []interface{} { return x.vs }
+func (x CreditLockOwnerBatchKeyIndexKey) creditLockIndexKey()   {}
+
+func (this CreditLockOwnerBatchKeyIndexKey) WithOwner(owner []byte) CreditLockOwnerBatchKeyIndexKey {
+	this.vs = []interface{}{owner}
+	return this
+}
+
+func (this CreditLockOwnerBatchKeyIndexKey) WithOwnerBatchKey(owner []byte, batch_key uint64) CreditLockOwnerBatchKeyIndexKey {
+	this.vs = []interface{}{owner, batch_key}
+	return this
+}
+
+type CreditLockBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x CreditLockBatchKeyIndexKey) id() uint32            { return 2 }
+func (x CreditLockBatchKeyIndexKey) values() []interface{} { return x.vs }
+func (x CreditLockBatchKeyIndexKey) creditLockIndexKey()   {}
+
+func (this CreditLockBatchKeyIndexKey) WithBatchKey(batch_key uint64) CreditLockBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type CreditLockUnlockTimeIndexKey struct {
+	vs []interface{}
+}
+
+func (x CreditLockUnlockTimeIndexKey) id() uint32            { return 3 }
+func (x CreditLockUnlockTimeIndexKey) values() []interface{} { return x.vs }
+func (x CreditLockUnlockTimeIndexKey) creditLockIndexKey()   {}
+
+func (this CreditLockUnlockTimeIndexKey) WithUnlockTime(unlock_time *timestamppb.Timestamp) CreditLockUnlockTimeIndexKey {
+	this.vs = []interface{}{unlock_time}
+	return this
+}
+
+type creditLockTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this creditLockTable) Insert(ctx context.Context, creditLock *CreditLock) error {
+	return this.table.Insert(ctx, creditLock)
+}
+
+func (this creditLockTable) Update(ctx context.Context, creditLock *CreditLock) error {
+	return this.table.Update(ctx, creditLock)
+}
+
+func (this creditLockTable) Save(ctx context.Context, creditLock *CreditLock) error {
+	return this.table.Save(ctx, creditLock)
+}
+
+func (this creditLockTable) Delete(ctx context.Context, creditLock *CreditLock) error {
+	return this.table.Delete(ctx, creditLock)
+}
+
+func (this creditLockTable) InsertReturningID(ctx context.Context, creditLock *CreditLock) (uint64, error) {
+	return this.table.InsertReturningID(ctx, creditLock)
+}
+
+func (this creditLockTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this creditLockTable) Get(ctx context.Context, id uint64) (*CreditLock, error) {
+	var creditLock CreditLock
+	found, err := this.table.PrimaryKey().Get(ctx, &creditLock, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &creditLock, nil
+}
+
+func (this creditLockTable) List(ctx context.Context, prefixKey CreditLockIndexKey, opts ...ormlist.Option) (CreditLockIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return CreditLockIterator{it}, err
+}
+
+func (this creditLockTable) ListRange(ctx context.Context, from, to CreditLockIndexKey, opts ...ormlist.Option) (CreditLockIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return CreditLockIterator{it}, err
+}
+
+func (this creditLockTable) DeleteBy(ctx context.Context, prefixKey CreditLockIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this creditLockTable) DeleteRange(ctx context.Context, from, to CreditLockIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this creditLockTable) doNotImplement() {}
+
+var _ CreditLockTable = creditLockTable{}
+
+func NewCreditLockTable(db ormtable.Schema) (CreditLockTable, error) {
+	table := db.GetTable(&CreditLock{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&CreditLock{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return creditLockTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type ClassBufferTable interface {
+	Insert(ctx context.Context, classBuffer *ClassBuffer) error
+	Update(ctx context.Context, classBuffer *ClassBuffer) error
+	Save(ctx context.Context, classBuffer *ClassBuffer) error
+	Delete(ctx context.Context, classBuffer *ClassBuffer) error
+	Has(ctx context.Context, class_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, class_key uint64) (*ClassBuffer, error)
+	List(ctx context.Context, prefixKey ClassBufferIndexKey, opts ...ormlist.Option) (ClassBufferIterator, error)
+	ListRange(ctx context.Context, from, to ClassBufferIndexKey, opts ...ormlist.Option) (ClassBufferIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ClassBufferIndexKey) error
+	DeleteRange(ctx context.Context, from, to ClassBufferIndexKey) error
+
+	doNotImplement()
+}
+
+type ClassBufferIterator struct {
+	ormtable.Iterator
+}
+
+func (i ClassBufferIterator) Value() (*ClassBuffer, error) {
+	var classBuffer ClassBuffer
+	err := i.UnmarshalMessage(&classBuffer)
+	return &classBuffer, err
+}
+
+type ClassBufferIndexKey interface {
+	id() uint32
+	values() []interface{}
+	classBufferIndexKey()
+}
+
+// primary key starting index..
+type ClassBufferPrimaryKey = ClassBufferClassKeyIndexKey
+
+type ClassBufferClassKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ClassBufferClassKeyIndexKey) id() uint32            { return 0 }
+func (x ClassBufferClassKeyIndexKey) values() []interface{} { return x.vs }
+func (x ClassBufferClassKeyIndexKey) classBufferIndexKey()  {}
+
+func (this ClassBufferClassKeyIndexKey) WithClassKey(class_key uint64) ClassBufferClassKeyIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+type classBufferTable struct {
+	table ormtable.Table
+}
+
+func (this classBufferTable) Insert(ctx context.Context, classBuffer *ClassBuffer) error {
+	return this.table.Insert(ctx, classBuffer)
+}
+
+func (this classBufferTable) Update(ctx context.Context, classBuffer *ClassBuffer) error {
+	return this.table.Update(ctx, classBuffer)
+}
+
+func (this classBufferTable) Save(ctx context.Context, classBuffer *ClassBuffer) error {
+	return this.table.Save(ctx, classBuffer)
+}
+
+func (this classBufferTable) Delete(ctx context.Context, classBuffer *ClassBuffer) error {
+	return this.table.Delete(ctx, classBuffer)
+}
+
+func (this classBufferTable) Has(ctx context.Context, class_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, class_key)
+}
+
+func (this classBufferTable) Get(ctx context.Context, class_key uint64) (*ClassBuffer, error) {
+	var classBuffer ClassBuffer
+	found, err := this.table.PrimaryKey().Get(ctx, &classBuffer, class_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &classBuffer, nil
+}
+
+func (this classBufferTable) List(ctx context.Context, prefixKey ClassBufferIndexKey, opts ...ormlist.Option) (ClassBufferIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ClassBufferIterator{it}, err
+}
+
+func (this classBufferTable) ListRange(ctx context.Context, from, to ClassBufferIndexKey, opts ...ormlist.Option) (ClassBufferIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ClassBufferIterator{it}, err
+}
+
+func (this classBufferTable) DeleteBy(ctx context.Context, prefixKey ClassBufferIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this classBufferTable) DeleteRange(ctx context.Context, from, to ClassBufferIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this classBufferTable) doNotImplement() {}
+
+var _ ClassBufferTable = classBufferTable{}
+
+func NewClassBufferTable(db ormtable.Schema) (ClassBufferTable, error) {
+	table := db.GetTable(&ClassBuffer{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ClassBuffer{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return classBufferTable{table}, nil
+}
+
+type ReversalTable interface {
+	Insert(ctx context.Context, reversal *Reversal) error
+	InsertReturningID(ctx context.Context, reversal *Reversal) (uint64, error)
+	Update(ctx context.Context, reversal *Reversal) error
+	Save(ctx context.Context, reversal *Reversal) error
+	Delete(ctx context.Context, reversal *Reversal) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Reversal, error)
+	List(ctx context.Context, prefixKey ReversalIndexKey, opts ...ormlist.Option) (ReversalIterator, error)
+	ListRange(ctx context.Context, from, to ReversalIndexKey, opts ...ormlist.Option) (ReversalIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ReversalIndexKey) error
+	DeleteRange(ctx context.Context, from, to ReversalIndexKey) error
+
+	doNotImplement()
+}
+
+type ReversalIterator struct {
+	ormtable.Iterator
+}
+
+func (i ReversalIterator) Value() (*Reversal, error) {
+	var reversal Reversal
+	err := i.UnmarshalMessage(&reversal)
+	return &reversal, err
+}
+
+type ReversalIndexKey interface {
+	id() uint32
+	values() []interface{}
+	reversalIndexKey()
+}
+
+// primary key starting index..
+type ReversalPrimaryKey = ReversalIdIndexKey
+
+type ReversalIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x ReversalIdIndexKey) id() uint32            { return 0 }
+func (x ReversalIdIndexKey) values() []interface{} { return x.vs }
+func (x ReversalIdIndexKey) reversalIndexKey()     {}
+
+func (this ReversalIdIndexKey) WithId(id uint64) ReversalIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type ReversalClassKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ReversalClassKeyIndexKey) id() uint32            { return 1 }
+func (x ReversalClassKeyIndexKey) values() []interface{} { return x.vs }
+func (x ReversalClassKeyIndexKey) reversalIndexKey()     {}
+
+func (this ReversalClassKeyIndexKey) WithClassKey(class_key uint64) ReversalClassKeyIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+type reversalTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this reversalTable) Insert(ctx context.Context, reversal *Reversal) error {
+	return this.table.Insert(ctx, reversal)
+}
+
+func (this reversalTable) Update(ctx context.Context, reversal *Reversal) error {
+	return this.table.Update(ctx, reversal)
+}
+
+func (this reversalTable) Save(ctx context.Context, reversal *Reversal) error {
+	return this.table.Save(ctx, reversal)
+}
+
+func (this reversalTable) Delete(ctx context.Context, reversal *Reversal) error {
+	return this.table.Delete(ctx, reversal)
+}
+
+func (this reversalTable) InsertReturningID(ctx context.Context, reversal *Reversal) (uint64, error) {
+	return this.table.InsertReturningID(ctx, reversal)
+}
+
+func (this reversalTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this reversalTable) Get(ctx context.Context, id uint64) (*Reversal, error) {
+	var reversal Reversal
+	found, err := this.table.PrimaryKey().Get(ctx, &reversal, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &reversal, nil
+}
+
+func (this reversalTable) List(ctx context.Context, prefixKey ReversalIndexKey, opts ...ormlist.Option) (ReversalIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ReversalIterator{it}, err
+}
+
+func (this reversalTable) ListRange(ctx context.Context, from, to ReversalIndexKey, opts ...ormlist.Option) (ReversalIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ReversalIterator{it}, err
+}
+
+func (this reversalTable) DeleteBy(ctx context.Context, prefixKey ReversalIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this reversalTable) DeleteRange(ctx context.Context, from, to ReversalIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this reversalTable) doNotImplement() {}
+
+var _ ReversalTable = reversalTable{}
+
+func NewReversalTable(db ormtable.Schema) (ReversalTable, error) {
+	table := db.GetTable(&Reversal{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Reversal{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return reversalTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type ClassVerifierTable interface {
+	Insert(ctx context.Context, classVerifier *ClassVerifier) error
+	Update(ctx context.Context, classVerifier *ClassVerifier) error
+	Save(ctx context.Context, classVerifier *ClassVerifier) error
+	Delete(ctx context.Context, classVerifier *ClassVerifier) error
+	Has(ctx context.Context, class_key uint64, verifier []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, class_key uint64, verifier []byte) (*ClassVerifier, error)
+	List(ctx context.Context, prefixKey ClassVerifierIndexKey, opts ...ormlist.Option) (ClassVerifierIterator, error)
+	ListRange(ctx context.Context, from, to ClassVerifierIndexKey, opts ...ormlist.Option) (ClassVerifierIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ClassVerifierIndexKey) error
+	DeleteRange(ctx context.Context, from, to ClassVerifierIndexKey) error
+
+	doNotImplement()
+}
+
+type ClassVerifierIterator struct {
+	ormtable.Iterator
+}
+
+func (i ClassVerifierIterator) Value() (*ClassVerifier, error) {
+	var classVerifier ClassVerifier
+	err := i.UnmarshalMessage(&classVerifier)
+	return &classVerifier, err
+}
+
+type ClassVerifierIndexKey interface {
+	id() uint32
+	values() []interface{}
+	classVerifierIndexKey()
+}
+
+// primary key starting index..
+type ClassVerifierPrimaryKey = ClassVerifierClassKeyVerifierIndexKey
+
+type ClassVerifierClassKeyVerifierIndexKey struct {
+	vs []interface{}
+}
+
+func (x ClassVerifierClassKeyVerifierIndexKey) id() uint32             { return 0 }
+func (x ClassVerifierClassKeyVerifierIndexKey) values() []interface{}  { return x.vs }
+func (x ClassVerifierClassKeyVerifierIndexKey) classVerifierIndexKey() {}
+
+func (this ClassVerifierClassKeyVerifierIndexKey) WithClassKey(class_key uint64) ClassVerifierClassKeyVerifierIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+func (this ClassVerifierClassKeyVerifierIndexKey) WithClassKeyVerifier(class_key uint64, verifier []byte) ClassVerifierClassKeyVerifierIndexKey {
+	this.vs = []interface{}{class_key, verifier}
+	return this
+}
+
+type classVerifierTable struct {
+	table ormtable.Table
+}
+
+func (this classVerifierTable) Insert(ctx context.Context, classVerifier *ClassVerifier) error {
+	return this.table.Insert(ctx, classVerifier)
+}
+
+func (this classVerifierTable) Update(ctx context.Context, classVerifier *ClassVerifier) error {
+	return this.table.Update(ctx, classVerifier)
+}
+
+func (this classVerifierTable) Save(ctx context.Context, classVerifier *ClassVerifier) error {
+	return this.table.Save(ctx, classVerifier)
+}
+
+func (this classVerifierTable) Delete(ctx context.Context, classVerifier *ClassVerifier) error {
+	return this.table.Delete(ctx, classVerifier)
+}
+
+func (this classVerifierTable) Has(ctx context.Context, class_key uint64, verifier []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, class_key, verifier)
+}
+
+func (this classVerifierTable) Get(ctx context.Context, class_key uint64, verifier []byte) (*ClassVerifier, error) {
+	var classVerifier ClassVerifier
+	found, err := this.table.PrimaryKey().Get(ctx, &classVerifier, class_key, verifier)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &classVerifier, nil
+}
+
+func (this classVerifierTable) List(ctx context.Context, prefixKey ClassVerifierIndexKey, opts ...ormlist.Option) (ClassVerifierIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ClassVerifierIterator{it}, err
+}
+
+func (this classVerifierTable) ListRange(ctx context.Context, from, to ClassVerifierIndexKey, opts ...ormlist.Option) (ClassVerifierIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ClassVerifierIterator{it}, err
+}
+
+func (this classVerifierTable) DeleteBy(ctx context.Context, prefixKey ClassVerifierIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this classVerifierTable) DeleteRange(ctx context.Context, from, to ClassVerifierIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this classVerifierTable) doNotImplement() {}
+
+var _ ClassVerifierTable = classVerifierTable{}
+
+func NewClassVerifierTable(db ormtable.Schema) (ClassVerifierTable, error) {
+	table := db.GetTable(&ClassVerifier{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ClassVerifier{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return classVerifierTable{table}, nil
+}
+
+type ProjectLifecycleTable interface {
+	Insert(ctx context.Context, projectLifecycle *ProjectLifecycle) error
+	Update(ctx context.Context, projectLifecycle *ProjectLifecycle) error
+	Save(ctx context.Context, projectLifecycle *ProjectLifecycle) error
+	Delete(ctx context.Context, projectLifecycle *ProjectLifecycle) error
+	Has(ctx context.Context, project_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, project_key uint64) (*ProjectLifecycle, error)
+	List(ctx context.Context, prefixKey ProjectLifecycleIndexKey, opts ...ormlist.Option) (ProjectLifecycleIterator, error)
+	ListRange(ctx context.Context, from, to ProjectLifecycleIndexKey, opts ...ormlist.Option) (ProjectLifecycleIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ProjectLifecycleIndexKey) error
+	DeleteRange(ctx context.Context, from, to ProjectLifecycleIndexKey) error
+
+	doNotImplement()
+}
+
+type ProjectLifecycleIterator struct {
+	ormtable.Iterator
+}
+
+func (i ProjectLifecycleIterator) Value() (*ProjectLifecycle, error) {
+	var projectLifecycle ProjectLifecycle
+	err := i.UnmarshalMessage(&projectLifecycle)
+	return &projectLifecycle, err
+}
+
+type ProjectLifecycleIndexKey interface {
+	id() uint32
+	values() []interface{}
+	projectLifecycleIndexKey()
+}
+
+// primary key starting index..
+type ProjectLifecyclePrimaryKey = ProjectLifecycleProjectKeyIndexKey
+
+type ProjectLifecycleProjectKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectLifecycleProjectKeyIndexKey) id() uint32                { return 0 }
+func (x ProjectLifecycleProjectKeyIndexKey) values() []interface{}     { return x.vs }
+func (x ProjectLifecycleProjectKeyIndexKey) projectLifecycleIndexKey() {}
+
+func (this ProjectLifecycleProjectKeyIndexKey) WithProjectKey(project_key uint64) ProjectLifecycleProjectKeyIndexKey {
+	this.vs = []interface{}{project_key}
+	return this
+}
+
+type ProjectLifecycleStatusIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectLifecycleStatusIndexKey) id() uint32                { return 1 }
+func (x ProjectLifecycleStatusIndexKey) values() []interface{}     { return x.vs }
+func (x ProjectLifecycleStatusIndexKey) projectLifecycleIndexKey() {}
+
+func (this ProjectLifecycleStatusIndexKey) WithStatus(status uint32) ProjectLifecycleStatusIndexKey {
+	this.vs = []interface{}{status}
+	return this
+}
+
+type projectLifecycleTable struct {
+	table ormtable.Table
+}
+
+func (this projectLifecycleTable) Insert(ctx context.Context, projectLifecycle *ProjectLifecycle) error {
+	return this.table.Insert(ctx, projectLifecycle)
+}
+
+func (this projectLifecycleTable) Update(ctx context.Context, projectLifecycle *ProjectLifecycle) error {
+	return this.table.Update(ctx, projectLifecycle)
+}
+
+func (this projectLifecycleTable) Save(ctx context.Context, projectLifecycle *ProjectLifecycle) error {
+	return this.table.Save(ctx, projectLifecycle)
+}
+
+func (this projectLifecycleTable) Delete(ctx context.Context, projectLifecycle *ProjectLifecycle) error {
+	return this.table.Delete(ctx, projectLifecycle)
+}
+
+func (this projectLifecycleTable) Has(ctx context.Context, project_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, project_key)
+}
+
+func (this projectLifecycleTable) Get(ctx context.Context, project_key uint64) (*ProjectLifecycle, error) {
+	var projectLifecycle ProjectLifecycle
+	found, err := this.table.PrimaryKey().Get(ctx, &projectLifecycle, project_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &projectLifecycle, nil
+}
+
+func (this projectLifecycleTable) List(ctx context.Context, prefixKey ProjectLifecycleIndexKey, opts ...ormlist.Option) (ProjectLifecycleIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ProjectLifecycleIterator{it}, err
+}
+
+func (this projectLifecycleTable) ListRange(ctx context.Context, from, to ProjectLifecycleIndexKey, opts ...ormlist.Option) (ProjectLifecycleIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ProjectLifecycleIterator{it}, err
+}
+
+func (this projectLifecycleTable) DeleteBy(ctx context.Context, prefixKey ProjectLifecycleIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this projectLifecycleTable) DeleteRange(ctx context.Context, from, to ProjectLifecycleIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this projectLifecycleTable) doNotImplement() {}
+
+var _ ProjectLifecycleTable = projectLifecycleTable{}
+
+func NewProjectLifecycleTable(db ormtable.Schema) (ProjectLifecycleTable, error) {
+	table := db.GetTable(&ProjectLifecycle{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ProjectLifecycle{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return projectLifecycleTable{table}, nil
+}
+
+type ProjectStatusChangeTable interface {
+	Insert(ctx context.Context, projectStatusChange *ProjectStatusChange) error
+	InsertReturningID(ctx context.Context, projectStatusChange *ProjectStatusChange) (uint64, error)
+	Update(ctx context.Context, projectStatusChange *ProjectStatusChange) error
+	Save(ctx context.Context, projectStatusChange *ProjectStatusChange) error
+	Delete(ctx context.Context, projectStatusChange *ProjectStatusChange) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*ProjectStatusChange, error)
+	List(ctx context.Context, prefixKey ProjectStatusChangeIndexKey, opts ...ormlist.Option) (ProjectStatusChangeIterator, error)
+	ListRange(ctx context.Context, from, to ProjectStatusChangeIndexKey, opts ...ormlist.Option) (ProjectStatusChangeIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ProjectStatusChangeIndexKey) error
+	DeleteRange(ctx context.Context, from, to ProjectStatusChangeIndexKey) error
+
+	doNotImplement()
+}
+
+type ProjectStatusChangeIterator struct {
+	ormtable.Iterator
+}
+
+func (i ProjectStatusChangeIterator) Value() (*ProjectStatusChange, error) {
+	var projectStatusChange ProjectStatusChange
+	err := i.UnmarshalMessage(&projectStatusChange)
+	return &projectStatusChange, err
+}
+
+type ProjectStatusChangeIndexKey interface {
+	id() uint32
+	values() []interface{}
+	projectStatusChangeIndexKey()
+}
+
+// primary key starting index..
+type ProjectStatusChangePrimaryKey = ProjectStatusChangeIdIndexKey
+
+type ProjectStatusChangeIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectStatusChangeIdIndexKey) id() uint32                   { return 0 }
+func (x ProjectStatusChangeIdIndexKey) values() []interface{}        { return x.vs }
+func (x ProjectStatusChangeIdIndexKey) projectStatusChangeIndexKey() {}
+
+func (this ProjectStatusChangeIdIndexKey) WithId(id uint64) ProjectStatusChangeIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type ProjectStatusChangeProjectKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectStatusChangeProjectKeyIndexKey) id() uint32                   { return 1 }
+func (x ProjectStatusChangeProjectKeyIndexKey) values() []interface{}        { return x.vs }
+func (x ProjectStatusChangeProjectKeyIndexKey) projectStatusChangeIndexKey() {}
+
+func (this ProjectStatusChangeProjectKeyIndexKey) WithProjectKey(project_key uint64) ProjectStatusChangeProjectKeyIndexKey {
+	this.vs = []interface{}{project_key}
+	return this
+}
+
+type projectStatusChangeTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this projectStatusChangeTable) Insert(ctx context.Context, projectStatusChange *ProjectStatusChange) error {
+	return this.table.Insert(ctx, projectStatusChange)
+}
+
+func (this projectStatusChangeTable) Update(ctx context.Context, projectStatusChange *ProjectStatusChange) error {
+	return this.table.Update(ctx, projectStatusChange)
+}
+
+func (this projectStatusChangeTable) Save(ctx context.Context, projectStatusChange *ProjectStatusChange) error {
+	return this.table.Save(ctx, projectStatusChange)
+}
+
+func (this projectStatusChangeTable) Delete(ctx context.Context, projectStatusChange *ProjectStatusChange) error {
+	return this.table.Delete(ctx, projectStatusChange)
+}
+
+func (this projectStatusChangeTable) InsertReturningID(ctx context.Context, projectStatusChange *ProjectStatusChange) (uint64, error) {
+	return this.table.InsertReturningID(ctx, projectStatusChange)
+}
+
+func (this projectStatusChangeTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this projectStatusChangeTable) Get(ctx context.Context, id uint64) (*ProjectStatusChange, error) {
+	var projectStatusChange ProjectStatusChange
+	found, err := this.table.PrimaryKey().Get(ctx, &projectStatusChange, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &projectStatusChange, nil
+}
+
+func (this projectStatusChangeTable) List(ctx context.Context, prefixKey ProjectStatusChangeIndexKey, opts ...ormlist.Option) (ProjectStatusChangeIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ProjectStatusChangeIterator{it}, err
+}
+
+func (this projectStatusChangeTable) ListRange(ctx context.Context, from, to ProjectStatusChangeIndexKey, opts ...ormlist.Option) (ProjectStatusChangeIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ProjectStatusChangeIterator{it}, err
+}
+
+func (this projectStatusChangeTable) DeleteBy(ctx context.Context, prefixKey ProjectStatusChangeIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this projectStatusChangeTable) DeleteRange(ctx context.Context, from, to ProjectStatusChangeIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this projectStatusChangeTable) doNotImplement() {}
+
+var _ ProjectStatusChangeTable = projectStatusChangeTable{}
+
+func NewProjectStatusChangeTable(db ormtable.Schema) (ProjectStatusChangeTable, error) {
+	table := db.GetTable(&ProjectStatusChange{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ProjectStatusChange{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return projectStatusChangeTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type ClassIssuanceCapTable interface {
+	Insert(ctx context.Context, classIssuanceCap *ClassIssuanceCap) error
+	Update(ctx context.Context, classIssuanceCap *ClassIssuanceCap) error
+	Save(ctx context.Context, classIssuanceCap *ClassIssuanceCap) error
+	Delete(ctx context.Context, classIssuanceCap *ClassIssuanceCap) error
+	Has(ctx context.Context, class_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, class_key uint64) (*ClassIssuanceCap, error)
+	List(ctx context.Context, prefixKey ClassIssuanceCapIndexKey, opts ...ormlist.Option) (ClassIssuanceCapIterator, error)
+	ListRange(ctx context.Context, from, to ClassIssuanceCapIndexKey, opts ...ormlist.Option) (ClassIssuanceCapIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ClassIssuanceCapIndexKey) error
+	DeleteRange(ctx context.Context, from, to ClassIssuanceCapIndexKey) error
+
+	doNotImplement()
+}
+
+type ClassIssuanceCapIterator struct {
+	ormtable.Iterator
+}
+
+func (i ClassIssuanceCapIterator) Value() (*ClassIssuanceCap, error) {
+	var classIssuanceCap ClassIssuanceCap
+	err := i.UnmarshalMessage(&classIssuanceCap)
+	return &classIssuanceCap, err
+}
+
+type ClassIssuanceCapIndexKey interface {
+	id() uint32
+	values() []interface{}
+	classIssuanceCapIndexKey()
+}
+
+// primary key starting index..
+type ClassIssuanceCapPrimaryKey = ClassIssuanceCapClassKeyIndexKey
+
+type ClassIssuanceCapClassKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ClassIssuanceCapClassKeyIndexKey) id() uint32                { return 0 }
+func (x ClassIssuanceCapClassKeyIndexKey) values() []interface{}     { return x.vs }
+func (x ClassIssuanceCapClassKeyIndexKey) classIssuanceCapIndexKey() {}
+
+func (this ClassIssuanceCapClassKeyIndexKey) WithClassKey(class_key uint64) ClassIssuanceCapClassKeyIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+type classIssuanceCapTable struct {
+	table ormtable.Table
+}
+
+func (this classIssuanceCapTable) Insert(ctx context.Context, classIssuanceCap *ClassIssuanceCap) error {
+	return this.table.Insert(ctx, classIssuanceCap)
+}
+
+func (this classIssuanceCapTable) Update(ctx context.Context, classIssuanceCap *ClassIssuanceCap) error {
+	return this.table.Update(ctx, classIssuanceCap)
+}
+
+func (this classIssuanceCapTable) Save(ctx context.Context, classIssuanceCap *ClassIssuanceCap) error {
+	return this.table.Save(ctx, classIssuanceCap)
+}
+
+func (this classIssuanceCapTable) Delete(ctx context.Context, classIssuanceCap *ClassIssuanceCap) error {
+	return this.table.Delete(ctx, classIssuanceCap)
+}
+
+func (this classIssuanceCapTable) Has(ctx context.Context, class_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, class_key)
+}
+
+func (this classIssuanceCapTable) Get(ctx context.Context, class_key uint64) (*ClassIssuanceCap, error) {
+	var classIssuanceCap ClassIssuanceCap
+	found, err := this.table.PrimaryKey().Get(ctx, &classIssuanceCap, class_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &classIssuanceCap, nil
+}
+
+func (this classIssuanceCapTable) List(ctx context.Context, prefixKey ClassIssuanceCapIndexKey, opts ...ormlist.Option) (ClassIssuanceCapIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ClassIssuanceCapIterator{it}, err
+}
+
+func (this classIssuanceCapTable) ListRange(ctx context.Context, from, to ClassIssuanceCapIndexKey, opts ...ormlist.Option) (ClassIssuanceCapIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ClassIssuanceCapIterator{it}, err
+}
+
+func (this classIssuanceCapTable) DeleteBy(ctx context.Context, prefixKey ClassIssuanceCapIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this classIssuanceCapTable) DeleteRange(ctx context.Context, from, to ClassIssuanceCapIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this classIssuanceCapTable) doNotImplement() {}
+
+var _ ClassIssuanceCapTable = classIssuanceCapTable{}
+
+func NewClassIssuanceCapTable(db ormtable.Schema) (ClassIssuanceCapTable, error) {
+	table := db.GetTable(&ClassIssuanceCap{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ClassIssuanceCap{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return classIssuanceCapTable{table}, nil
+}
+
+type ProjectVintageIssuanceTable interface {
+	Insert(ctx context.Context, projectVintageIssuance *ProjectVintageIssuance) error
+	Update(ctx context.Context, projectVintageIssuance *ProjectVintageIssuance) error
+	Save(ctx context.Context, projectVintageIssuance *ProjectVintageIssuance) error
+	Delete(ctx context.Context, projectVintageIssuance *ProjectVintageIssuance) error
+	Has(ctx context.Context, project_key uint64, vintage_year uint32) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, project_key uint64, vintage_year uint32) (*ProjectVintageIssuance, error)
+	List(ctx context.Context, prefixKey ProjectVintageIssuanceIndexKey, opts ...ormlist.Option) (ProjectVintageIssuanceIterator, error)
+	ListRange(ctx context.Context, from, to ProjectVintageIssuanceIndexKey, opts ...ormlist.Option) (ProjectVintageIssuanceIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ProjectVintageIssuanceIndexKey) error
+	DeleteRange(ctx context.Context, from, to ProjectVintageIssuanceIndexKey) error
+
+	doNotImplement()
+}
+
+type ProjectVintageIssuanceIterator struct {
+	ormtable.Iterator
+}
+
+func (i ProjectVintageIssuanceIterator) Value() (*ProjectVintageIssuance, error) {
+	var projectVintageIssuance ProjectVintageIssuance
+	err := i.UnmarshalMessage(&projectVintageIssuance)
+	return &projectVintageIssuance, err
+}
+
+type ProjectVintageIssuanceIndexKey interface {
+	id() uint32
+	values() []interface{}
+	projectVintageIssuanceIndexKey()
+}
+
+// primary key starting index..
+type ProjectVintageIssuancePrimaryKey = ProjectVintageIssuanceProjectKeyVintageYearIndexKey
+
+type ProjectVintageIssuanceProjectKeyVintageYearIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectVintageIssuanceProjectKeyVintageYearIndexKey) id() uint32                      { return 0 }
+func (x ProjectVintageIssuanceProjectKeyVintageYearIndexKey) values() []interface{}           { return x.vs }
+func (x ProjectVintageIssuanceProjectKeyVintageYearIndexKey) projectVintageIssuanceIndexKey() {}
+
+func (this ProjectVintageIssuanceProjectKeyVintageYearIndexKey) WithProjectKey(project_key uint64) ProjectVintageIssuanceProjectKeyVintageYearIndexKey {
+	this.vs = []interface{}{project_key}
+	return this
+}
+
+func (this ProjectVintageIssuanceProjectKeyVintageYearIndexKey) WithProjectKeyVintageYear(project_key uint64, vintage_year uint32) ProjectVintageIssuanceProjectKeyVintageYearIndexKey {
+	this.vs = []interface{}{project_key, vintage_year}
+	return this
+}
+
+type projectVintageIssuanceTable struct {
+	table ormtable.Table
+}
+
+func (this projectVintageIssuanceTable) Insert(ctx context.Context, projectVintageIssuance *ProjectVintageIssuance) error {
+	return this.table.Insert(ctx, projectVintageIssuance)
+}
+
+func (this projectVintageIssuanceTable) Update(ctx context.Context, projectVintageIssuance *ProjectVintageIssuance) error {
+	return this.table.Update(ctx, projectVintageIssuance)
+}
+
+func (this projectVintageIssuanceTable) Save(ctx context.Context, projectVintageIssuance *ProjectVintageIssuance) error {
+	return this.table.Save(ctx, projectVintageIssuance)
+}
+
+func (this projectVintageIssuanceTable) Delete(ctx context.Context, projectVintageIssuance *ProjectVintageIssuance) error {
+	return this.table.Delete(ctx, projectVintageIssuance)
+}
+
+func (this projectVintageIssuanceTable) Has(ctx context.Context, project_key uint64, vintage_year uint32) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, project_key, vintage_year)
+}
+
+func (this projectVintageIssuanceTable) Get(ctx context.Context, project_key uint64, vintage_year uint32) (*ProjectVintageIssuance, error) {
+	var projectVintageIssuance ProjectVintageIssuance
+	found, err := this.table.PrimaryKey().Get(ctx, &projectVintageIssuance, project_key, vintage_year)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &projectVintageIssuance, nil
+}
+
+func (this projectVintageIssuanceTable) List(ctx context.Context, prefixKey ProjectVintageIssuanceIndexKey, opts ...ormlist.Option) (ProjectVintageIssuanceIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ProjectVintageIssuanceIterator{it}, err
+}
+
+func (this projectVintageIssuanceTable) ListRange(ctx context.Context, from, to ProjectVintageIssuanceIndexKey, opts ...ormlist.Option) (ProjectVintageIssuanceIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ProjectVintageIssuanceIterator{it}, err
+}
+
+func (this projectVintageIssuanceTable) DeleteBy(ctx context.Context, prefixKey ProjectVintageIssuanceIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this projectVintageIssuanceTable) DeleteRange(ctx context.Context, from, to ProjectVintageIssuanceIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this projectVintageIssuanceTable) doNotImplement() {}
+
+var _ ProjectVintageIssuanceTable = projectVintageIssuanceTable{}
+
+func NewProjectVintageIssuanceTable(db ormtable.Schema) (ProjectVintageIssuanceTable, error) {
+	table := db.GetTable(&ProjectVintageIssuance{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ProjectVintageIssuance{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return projectVintageIssuanceTable{table}, nil
+}
+
+type ClassBatchApprovalTable interface {
+	Insert(ctx context.Context, classBatchApproval *ClassBatchApproval) error
+	Update(ctx context.Context, classBatchApproval *ClassBatchApproval) error
+	Save(ctx context.Context, classBatchApproval *ClassBatchApproval) error
+	Delete(ctx context.Context, classBatchApproval *ClassBatchApproval) error
+	Has(ctx context.Context, class_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, class_key uint64) (*ClassBatchApproval, error)
+	List(ctx context.Context, prefixKey ClassBatchApprovalIndexKey, opts ...ormlist.Option) (ClassBatchApprovalIterator, error)
+	ListRange(ctx context.Context, from, to ClassBatchApprovalIndexKey, opts ...ormlist.Option) (ClassBatchApprovalIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ClassBatchApprovalIndexKey) error
+	DeleteRange(ctx context.Context, from, to ClassBatchApprovalIndexKey) error
+
+	doNotImplement()
+}
+
+type ClassBatchApprovalIterator struct {
+	ormtable.Iterator
+}
+
+func (i ClassBatchApprovalIterator) Value() (*ClassBatchApproval, error) {
+	var classBatchApproval ClassBatchApproval
+	err := i.UnmarshalMessage(&classBatchApproval)
+	return &classBatchApproval, err
+}
+
+type ClassBatchApprovalIndexKey interface {
+	id() uint32
+	values() []interface{}
+	classBatchApprovalIndexKey()
+}
+
+// primary key starting index..
+type ClassBatchApprovalPrimaryKey = ClassBatchApprovalClassKeyIndexKey
+
+type ClassBatchApprovalClassKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ClassBatchApprovalClassKeyIndexKey) id() uint32                  { return 0 }
+func (x ClassBatchApprovalClassKeyIndexKey) values() []interface{}       { return x.vs }
+func (x ClassBatchApprovalClassKeyIndexKey) classBatchApprovalIndexKey() {}
+
+func (this ClassBatchApprovalClassKeyIndexKey) WithClassKey(class_key uint64) ClassBatchApprovalClassKeyIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+type classBatchApprovalTable struct {
+	table ormtable.Table
+}
+
+func (this classBatchApprovalTable) Insert(ctx context.Context, classBatchApproval *ClassBatchApproval) error {
+	return this.table.Insert(ctx, classBatchApproval)
+}
+
+func (this classBatchApprovalTable) Update(ctx context.Context, classBatchApproval *ClassBatchApproval) error {
+	return this.table.Update(ctx, classBatchApproval)
+}
+
+func (this classBatchApprovalTable) Save(ctx context.Context, classBatchApproval *ClassBatchApproval) error {
+	return this.table.Save(ctx, classBatchApproval)
+}
+
+func (this classBatchApprovalTable) Delete(ctx context.Context, classBatchApproval *ClassBatchApproval) error {
+	return this.table.Delete(ctx, classBatchApproval)
+}
+
+func (this classBatchApprovalTable) Has(ctx context.Context, class_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, class_key)
+}
+
+func (this classBatchApprovalTable) Get(ctx context.Context, class_key uint64) (*ClassBatchApproval, error) {
+	var classBatchApproval ClassBatchApproval
+	found, err := this.table.PrimaryKey().Get(ctx, &classBatchApproval, class_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &classBatchApproval, nil
+}
+
+func (this classBatchApprovalTable) List(ctx context.Context, prefixKey ClassBatchApprovalIndexKey, opts ...ormlist.Option) (ClassBatchApprovalIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ClassBatchApprovalIterator{it}, err
+}
+
+func (this classBatchApprovalTable) ListRange(ctx context.Context, from, to ClassBatchApprovalIndexKey, opts ...ormlist.Option) (ClassBatchApprovalIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ClassBatchApprovalIterator{it}, err
+}
+
+func (this classBatchApprovalTable) DeleteBy(ctx context.Context, prefixKey ClassBatchApprovalIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this classBatchApprovalTable) DeleteRange(ctx context.Context, from, to ClassBatchApprovalIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this classBatchApprovalTable) doNotImplement() {}
+
+var _ ClassBatchApprovalTable = classBatchApprovalTable{}
+
+func NewClassBatchApprovalTable(db ormtable.Schema) (ClassBatchApprovalTable, error) {
+	table := db.GetTable(&ClassBatchApproval{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ClassBatchApproval{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return classBatchApprovalTable{table}, nil
+}
+
+type PendingBatchTable interface {
+	Insert(ctx context.Context, pendingBatch *PendingBatch) error
+	InsertReturningID(ctx context.Context, pendingBatch *PendingBatch) (uint64, error)
+	Update(ctx context.Context, pendingBatch *PendingBatch) error
+	Save(ctx context.Context, pendingBatch *PendingBatch) error
+	Delete(ctx context.Context, pendingBatch *PendingBatch) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*PendingBatch, error)
+	List(ctx context.Context, prefixKey PendingBatchIndexKey, opts ...ormlist.Option) (PendingBatchIterator, error)
+	ListRange(ctx context.Context, from, to PendingBatchIndexKey, opts ...ormlist.Option) (PendingBatchIterator, error)
+	DeleteBy(ctx context.Context, prefixKey PendingBatchIndexKey) error
+	DeleteRange(ctx context.Context, from, to PendingBatchIndexKey) error
+
+	doNotImplement()
+}
+
+type PendingBatchIterator struct {
+	ormtable.Iterator
+}
+
+func (i PendingBatchIterator) Value() (*PendingBatch, error) {
+	var pendingBatch PendingBatch
+	err := i.UnmarshalMessage(&pendingBatch)
+	return &pendingBatch, err
+}
+
+type PendingBatchIndexKey interface {
+	id() uint32
+	values() []interface{}
+	pendingBatchIndexKey()
+}
+
+// primary key starting index..
+type PendingBatchPrimaryKey = PendingBatchIdIndexKey
+
+type PendingBatchIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x PendingBatchIdIndexKey) id() uint32            { return 0 }
+func (x PendingBatchIdIndexKey) values() []interface{} { return x.vs }
+func (x PendingBatchIdIndexKey) pendingBatchIndexKey() {}
+
+func (this PendingBatchIdIndexKey) WithId(id uint64) PendingBatchIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type PendingBatchClassKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x PendingBatchClassKeyIndexKey) id() uint32            { return 1 }
+func (x PendingBatchClassKeyIndexKey) values() []interface{} { return x.vs }
+func (x PendingBatchClassKeyIndexKey) pendingBatchIndexKey() {}
+
+func (this PendingBatchClassKeyIndexKey) WithClassKey(class_key uint64) PendingBatchClassKeyIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+type PendingBatchExpirationIndexKey struct {
+	vs []interface{}
+}
+
+func (x PendingBatchExpirationIndexKey) id() uint32            { return 2 }
+func (x PendingBatchExpirationIndexKey) values() []interface{} { return x.vs }
+func (x PendingBatchExpirationIndexKey) pendingBatchIndexKey() {}
+
+func (this PendingBatchExpirationIndexKey) WithExpiration(expiration *timestamppb.Timestamp) PendingBatchExpirationIndexKey {
+	this.vs = []interface{}{expiration}
+	return this
+}
+
+type pendingBatchTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this pendingBatchTable) Insert(ctx context.Context, pendingBatch *PendingBatch) error {
+	return this.table.Insert(ctx, pendingBatch)
+}
+
+func (this pendingBatchTable) Update(ctx context.Context, pendingBatch *PendingBatch) error {
+	return this.table.Update(ctx, pendingBatch)
+}
+
+func (this pendingBatchTable) Save(ctx context.Context, pendingBatch *PendingBatch) error {
+	return this.table.Save(ctx, pendingBatch)
+}
+
+func (this pendingBatchTable) Delete(ctx context.Context, pendingBatch *PendingBatch) error {
+	return this.table.Delete(ctx, pendingBatch)
+}
+
+func (this pendingBatchTable) InsertReturningID(ctx context.Context, pendingBatch *PendingBatch) (uint64, error) {
+	return this.table.InsertReturningID(ctx, pendingBatch)
+}
+
+func (this pendingBatchTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this pendingBatchTable) Get(ctx context.Context, id uint64) (*PendingBatch, error) {
+	var pendingBatch PendingBatch
+	found, err := this.table.PrimaryKey().Get(ctx, &pendingBatch, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &pendingBatch, nil
+}
+
+func (this pendingBatchTable) List(ctx context.Context, prefixKey PendingBatchIndexKey, opts ...ormlist.Option) (PendingBatchIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return PendingBatchIterator{it}, err
+}
+
+func (this pendingBatchTable) ListRange(ctx context.Context, from, to PendingBatchIndexKey, opts ...ormlist.Option) (PendingBatchIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return PendingBatchIterator{it}, err
+}
+
+func (this pendingBatchTable) DeleteBy(ctx context.Context, prefixKey PendingBatchIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this pendingBatchTable) DeleteRange(ctx context.Context, from, to PendingBatchIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this pendingBatchTable) doNotImplement() {}
+
+var _ PendingBatchTable = pendingBatchTable{}
+
+func NewPendingBatchTable(db ormtable.Schema) (PendingBatchTable, error) {
+	table := db.GetTable(&PendingBatch{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&PendingBatch{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return pendingBatchTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type ClassBatchMetadataCosignTable interface {
+	Insert(ctx context.Context, classBatchMetadataCosign *ClassBatchMetadataCosign) error
+	Update(ctx context.Context, classBatchMetadataCosign *ClassBatchMetadataCosign) error
+	Save(ctx context.Context, classBatchMetadataCosign *ClassBatchMetadataCosign) error
+	Delete(ctx context.Context, classBatchMetadataCosign *ClassBatchMetadataCosign) error
+	Has(ctx context.Context, class_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, class_key uint64) (*ClassBatchMetadataCosign, error)
+	List(ctx context.Context, prefixKey ClassBatchMetadataCosignIndexKey, opts ...ormlist.Option) (ClassBatchMetadataCosignIterator, error)
+	ListRange(ctx context.Context, from, to ClassBatchMetadataCosignIndexKey, opts ...ormlist.Option) (ClassBatchMetadataCosignIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ClassBatchMetadataCosignIndexKey) error
+	DeleteRange(ctx context.Context, from, to ClassBatchMetadataCosignIndexKey) error
+
+	doNotImplement()
+}
+
+type ClassBatchMetadataCosignIterator struct {
+	ormtable.Iterator
+}
+
+func (i ClassBatchMetadataCosignIterator) Value() (*ClassBatchMetadataCosign, error) {
+	var classBatchMetadataCosign ClassBatchMetadataCosign
+	err := i.UnmarshalMessage(&classBatchMetadataCosign)
+	return &classBatchMetadataCosign, err
+}
+
+type ClassBatchMetadataCosignIndexKey interface {
+	id() uint32
+	values() []interface{}
+	classBatchMetadataCosignIndexKey()
+}
+
+// primary key starting index..
+type ClassBatchMetadataCosignPrimaryKey = ClassBatchMetadataCosignClassKeyIndexKey
+
+type ClassBatchMetadataCosignClassKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ClassBatchMetadataCosignClassKeyIndexKey) id() uint32                        { return 0 }
+func (x ClassBatchMetadataCosignClassKeyIndexKey) values() []interface{}             { return x.vs }
+func (x ClassBatchMetadataCosignClassKeyIndexKey) classBatchMetadataCosignIndexKey() {}
+
+func (this ClassBatchMetadataCosignClassKeyIndexKey) WithClassKey(class_key uint64) ClassBatchMetadataCosignClassKeyIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+type classBatchMetadataCosignTable struct {
+	table ormtable.Table
+}
+
+func (this classBatchMetadataCosignTable) Insert(ctx context.Context, classBatchMetadataCosign *ClassBatchMetadataCosign) error {
+	return this.table.Insert(ctx, classBatchMetadataCosign)
+}
+
+func (this classBatchMetadataCosignTable) Update(ctx context.Context, classBatchMetadataCosign *ClassBatchMetadataCosign) error {
+	return this.table.Update(ctx, classBatchMetadataCosign)
+}
+
+func (this classBatchMetadataCosignTable) Save(ctx context.Context, classBatchMetadataCosign *ClassBatchMetadataCosign) error {
+	return this.table.Save(ctx, classBatchMetadataCosign)
+}
+
+func (this classBatchMetadataCosignTable) Delete(ctx context.Context, classBatchMetadataCosign *ClassBatchMetadataCosign) error {
+	return this.table.Delete(ctx, classBatchMetadataCosign)
+}
+
+func (this classBatchMetadataCosignTable) Has(ctx context.Context, class_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, class_key)
+}
+
+func (this classBatchMetadataCosignTable) Get(ctx context.Context, class_key uint64) (*ClassBatchMetadataCosign, error) {
+	var classBatchMetadataCosign ClassBatchMetadataCosign
+	found, err := this.table.PrimaryKey().Get(ctx, &classBatchMetadataCosign, class_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &classBatchMetadataCosign, nil
+}
+
+func (this classBatchMetadataCosignTable) List(ctx context.Context, prefixKey ClassBatchMetadataCosignIndexKey, opts ...ormlist.Option) (ClassBatchMetadataCosignIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ClassBatchMetadataCosignIterator{it}, err
+}
+
+func (this classBatchMetadataCosignTable) ListRange(ctx context.Context, from, to ClassBatchMetadataCosignIndexKey, opts ...ormlist.Option) (ClassBatchMetadataCosignIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ClassBatchMetadataCosignIterator{it}, err
+}
+
+func (this classBatchMetadataCosignTable) DeleteBy(ctx context.Context, prefixKey ClassBatchMetadataCosignIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this classBatchMetadataCosignTable) DeleteRange(ctx context.Context, from, to ClassBatchMetadataCosignIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this classBatchMetadataCosignTable) doNotImplement() {}
+
+var _ ClassBatchMetadataCosignTable = classBatchMetadataCosignTable{}
+
+func NewClassBatchMetadataCosignTable(db ormtable.Schema) (ClassBatchMetadataCosignTable, error) {
+	table := db.GetTable(&ClassBatchMetadataCosign{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ClassBatchMetadataCosign{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return classBatchMetadataCosignTable{table}, nil
+}
+
+type BatchMetadataChangeTable interface {
+	Insert(ctx context.Context, batchMetadataChange *BatchMetadataChange) error
+	InsertReturningID(ctx context.Context, batchMetadataChange *BatchMetadataChange) (uint64, error)
+	Update(ctx context.Context, batchMetadataChange *BatchMetadataChange) error
+	Save(ctx context.Context, batchMetadataChange *BatchMetadataChange) error
+	Delete(ctx context.Context, batchMetadataChange *BatchMetadataChange) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*BatchMetadataChange, error)
+	List(ctx context.Context, prefixKey BatchMetadataChangeIndexKey, opts ...ormlist.Option) (BatchMetadataChangeIterator, error)
+	ListRange(ctx context.Context, from, to BatchMetadataChangeIndexKey, opts ...ormlist.Option) (BatchMetadataChangeIterator, error)
+	DeleteBy(ctx context.Context, prefixKey BatchMetadataChangeIndexKey) error
+	DeleteRange(ctx context.Context, from, to BatchMetadataChangeIndexKey) error
+
+	doNotImplement()
+}
+
+type BatchMetadataChangeIterator struct {
+	ormtable.Iterator
+}
+
+func (i BatchMetadataChangeIterator) Value() (*BatchMetadataChange, error) {
+	var batchMetadataChange BatchMetadataChange
+	err := i.UnmarshalMessage(&batchMetadataChange)
+	return &batchMetadataChange, err
+}
+
+type BatchMetadataChangeIndexKey interface {
+	id() uint32
+	values() []interface{}
+	batchMetadataChangeIndexKey()
+}
+
+// primary key starting index..
+type BatchMetadataChangePrimaryKey = BatchMetadataChangeIdIndexKey
+
+type BatchMetadataChangeIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchMetadataChangeIdIndexKey) id() uint32                   { return 0 }
+func (x BatchMetadataChangeIdIndexKey) values() []interface{}        { return x.vs }
+func (x BatchMetadataChangeIdIndexKey) batchMetadataChangeIndexKey() {}
+
+func (this BatchMetadataChangeIdIndexKey) WithId(id uint64) BatchMetadataChangeIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type BatchMetadataChangeBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchMetadataChangeBatchKeyIndexKey) id() uint32                   { return 1 }
+func (x BatchMetadataChangeBatchKeyIndexKey) values() []interface{}        { return x.vs }
+func (x BatchMetadataChangeBatchKeyIndexKey) batchMetadataChangeIndexKey() {}
+
+func (this BatchMetadataChangeBatchKeyIndexKey) WithBatchKey(batch_key uint64) BatchMetadataChangeBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type batchMetadataChangeTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this batchMetadataChangeTable) Insert(ctx context.Context, batchMetadataChange *BatchMetadataChange) error {
+	return this.table.Insert(ctx, batchMetadataChange)
+}
+
+func (this batchMetadataChangeTable) Update(ctx context.Context, batchMetadataChange *BatchMetadataChange) error {
+	return this.table.Update(ctx, batchMetadataChange)
+}
+
+func (this batchMetadataChangeTable) Save(ctx context.Context, batchMetadataChange *BatchMetadataChange) error {
+	return this.table.Save(ctx, batchMetadataChange)
+}
+
+func (this batchMetadataChangeTable) Delete(ctx context.Context, batchMetadataChange *BatchMetadataChange) error {
+	return this.table.Delete(ctx, batchMetadataChange)
+}
+
+func (this batchMetadataChangeTable) InsertReturningID(ctx context.Context, batchMetadataChange *BatchMetadataChange) (uint64, error) {
+	return this.table.InsertReturningID(ctx, batchMetadataChange)
+}
+
+func (this batchMetadataChangeTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this batchMetadataChangeTable) Get(ctx context.Context, id uint64) (*BatchMetadataChange, error) {
+	var batchMetadataChange BatchMetadataChange
+	found, err := this.table.PrimaryKey().Get(ctx, &batchMetadataChange, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &batchMetadataChange, nil
+}
+
+func (this batchMetadataChangeTable) List(ctx context.Context, prefixKey BatchMetadataChangeIndexKey, opts ...ormlist.Option) (BatchMetadataChangeIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return BatchMetadataChangeIterator{it}, err
+}
+
+func (this batchMetadataChangeTable) ListRange(ctx context.Context, from, to BatchMetadataChangeIndexKey, opts ...ormlist.Option) (BatchMetadataChangeIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return BatchMetadataChangeIterator{it}, err
+}
+
+func (this batchMetadataChangeTable) DeleteBy(ctx context.Context, prefixKey BatchMetadataChangeIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this batchMetadataChangeTable) DeleteRange(ctx context.Context, from, to BatchMetadataChangeIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this batchMetadataChangeTable) doNotImplement() {}
+
+var _ BatchMetadataChangeTable = batchMetadataChangeTable{}
+
+func NewBatchMetadataChangeTable(db ormtable.Schema) (BatchMetadataChangeTable, error) {
+	table := db.GetTable(&BatchMetadataChange{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BatchMetadataChange{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return batchMetadataChangeTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type ProjectAliasTable interface {
+	Insert(ctx context.Context, projectAlias *ProjectAlias) error
+	Update(ctx context.Context, projectAlias *ProjectAlias) error
+	Save(ctx context.Context, projectAlias *ProjectAlias) error
+	Delete(ctx context.Context, projectAlias *ProjectAlias) error
+	Has(ctx context.Context, alias string) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, alias string) (*ProjectAlias, error)
+	List(ctx context.Context, prefixKey ProjectAliasIndexKey, opts ...ormlist.Option) (ProjectAliasIterator, error)
+	ListRange(ctx context.Context, from, to ProjectAliasIndexKey, opts ...ormlist.Option) (ProjectAliasIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ProjectAliasIndexKey) error
+	DeleteRange(ctx context.Context, from, to ProjectAliasIndexKey) error
+
+	doNotImplement()
+}
+
+type ProjectAliasIterator struct {
+	ormtable.Iterator
+}
+
+func (i ProjectAliasIterator) Value() (*ProjectAlias, error) {
+	var projectAlias ProjectAlias
+	err := i.UnmarshalMessage(&projectAlias)
+	return &projectAlias, err
+}
+
+type ProjectAliasIndexKey interface {
+	id() uint32
+	values() []interface{}
+	projectAliasIndexKey()
+}
+
+// primary key starting index..
+type ProjectAliasPrimaryKey = ProjectAliasAliasIndexKey
+
+type ProjectAliasAliasIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectAliasAliasIndexKey) id() uint32            { return 0 }
+func (x ProjectAliasAliasIndexKey) values() []interface{} { return x.vs }
+func (x ProjectAliasAliasIndexKey) projectAliasIndexKey() {}
+
+func (this ProjectAliasAliasIndexKey) WithAlias(alias string) ProjectAliasAliasIndexKey {
+	this.vs = []interface{}{alias}
+	return this
+}
+
+type ProjectAliasProjectKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectAliasProjectKeyIndexKey) id() uint32            { return 1 }
+func (x ProjectAliasProjectKeyIndexKey) values() []interface{} { return x.vs }
+func (x ProjectAliasProjectKeyIndexKey) projectAliasIndexKey() {}
+
+func (this ProjectAliasProjectKeyIndexKey) WithProjectKey(project_key uint64) ProjectAliasProjectKeyIndexKey {
+	this.vs = []interface{}{project_key}
+	return this
+}
+
+type projectAliasTable struct {
+	table ormtable.Table
+}
+
+func (this projectAliasTable) Insert(ctx context.Context, projectAlias *ProjectAlias) error {
+	return this.table.Insert(ctx, projectAlias)
+}
+
+func (this projectAliasTable) Update(ctx context.Context, projectAlias *ProjectAlias) error {
+	return this.table.Update(ctx, projectAlias)
+}
+
+func (this projectAliasTable) Save(ctx context.Context, projectAlias *ProjectAlias) error {
+	return this.table.Save(ctx, projectAlias)
+}
+
+func (this projectAliasTable) Delete(ctx context.Context, projectAlias *ProjectAlias) error {
+	return this.table.Delete(ctx, projectAlias)
+}
+
+func (this projectAliasTable) Has(ctx context.Context, alias string) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, alias)
+}
+
+func (this projectAliasTable) Get(ctx context.Context, alias string) (*ProjectAlias, error) {
+	var projectAlias ProjectAlias
+	found, err := this.table.PrimaryKey().Get(ctx, &projectAlias, alias)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &projectAlias, nil
+}
+
+func (this projectAliasTable) List(ctx context.Context, prefixKey ProjectAliasIndexKey, opts ...ormlist.Option) (ProjectAliasIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ProjectAliasIterator{it}, err
+}
+
+func (this projectAliasTable) ListRange(ctx context.Context, from, to ProjectAliasIndexKey, opts ...ormlist.Option) (ProjectAliasIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ProjectAliasIterator{it}, err
+}
+
+func (this projectAliasTable) DeleteBy(ctx context.Context, prefixKey ProjectAliasIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this projectAliasTable) DeleteRange(ctx context.Context, from, to ProjectAliasIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this projectAliasTable) doNotImplement() {}
+
+var _ ProjectAliasTable = projectAliasTable{}
+
+func NewProjectAliasTable(db ormtable.Schema) (ProjectAliasTable, error) {
+	table := db.GetTable(&ProjectAlias{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ProjectAlias{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return projectAliasTable{table}, nil
+}
+
+type ClassLifecycleTable interface {
+	Insert(ctx context.Context, classLifecycle *ClassLifecycle) error
+	Update(ctx context.Context, classLifecycle *ClassLifecycle) error
+	Save(ctx context.Context, classLifecycle *ClassLifecycle) error
+	Delete(ctx context.Context, classLifecycle *ClassLifecycle) error
+	Has(ctx context.Context, class_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, class_key uint64) (*ClassLifecycle, error)
+	List(ctx context.Context, prefixKey ClassLifecycleIndexKey, opts ...ormlist.Option) (ClassLifecycleIterator, error)
+	ListRange(ctx context.Context, from, to ClassLifecycleIndexKey, opts ...ormlist.Option) (ClassLifecycleIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ClassLifecycleIndexKey) error
+	DeleteRange(ctx context.Context, from, to ClassLifecycleIndexKey) error
+
+	doNotImplement()
+}
+
+type ClassLifecycleIterator struct {
+	ormtable.Iterator
+}
+
+func (i ClassLifecycleIterator) Value() (*ClassLifecycle, error) {
+	var classLifecycle ClassLifecycle
+	err := i.UnmarshalMessage(&classLifecycle)
+	return &classLifecycle, err
+}
+
+type ClassLifecycleIndexKey interface {
+	id() uint32
+	values() []interface{}
+	classLifecycleIndexKey()
+}
+
+// primary key starting index..
+type ClassLifecyclePrimaryKey = ClassLifecycleClassKeyIndexKey
+
+type ClassLifecycleClassKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ClassLifecycleClassKeyIndexKey) id() uint32              { return 0 }
+func (x ClassLifecycleClassKeyIndexKey) values() []interface{}   { return x.vs }
+func (x ClassLifecycleClassKeyIndexKey) classLifecycleIndexKey() {}
+
+func (this ClassLifecycleClassKeyIndexKey) WithClassKey(class_key uint64) ClassLifecycleClassKeyIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+type ClassLifecycleStatusIndexKey struct {
+	vs []interface{}
+}
+
+func (x ClassLifecycleStatusIndexKey) id() uint32              { return 1 }
+func (x ClassLifecycleStatusIndexKey) values() []interface{}   { return x.vs }
+func (x ClassLifecycleStatusIndexKey) classLifecycleIndexKey() {}
+
+func (this ClassLifecycleStatusIndexKey) WithStatus(status uint32) ClassLifecycleStatusIndexKey {
+	this.vs = []interface{}{status}
+	return this
+}
+
+type classLifecycleTable struct {
+	table ormtable.Table
+}
+
+func (this classLifecycleTable) Insert(ctx context.Context, classLifecycle *ClassLifecycle) error {
+	return this.table.Insert(ctx, classLifecycle)
+}
+
+func (this classLifecycleTable) Update(ctx context.Context, classLifecycle *ClassLifecycle) error {
+	return this.table.Update(ctx, classLifecycle)
+}
+
+func (this classLifecycleTable) Save(ctx context.Context, classLifecycle *ClassLifecycle) error {
+	return this.table.Save(ctx, classLifecycle)
+}
+
+func (this classLifecycleTable) Delete(ctx context.Context, classLifecycle *ClassLifecycle) error {
+	return this.table.Delete(ctx, classLifecycle)
+}
+
+func (this classLifecycleTable) Has(ctx context.Context, class_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, class_key)
+}
+
+func (this classLifecycleTable) Get(ctx context.Context, class_key uint64) (*ClassLifecycle, error) {
+	var classLifecycle ClassLifecycle
+	found, err := this.table.PrimaryKey().Get(ctx, &classLifecycle, class_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &classLifecycle, nil
+}
+
+func (this classLifecycleTable) List(ctx context.Context, prefixKey ClassLifecycleIndexKey, opts ...ormlist.Option) (ClassLifecycleIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ClassLifecycleIterator{it}, err
+}
+
+func (this classLifecycleTable) ListRange(ctx context.Context, from, to ClassLifecycleIndexKey, opts ...ormlist.Option) (ClassLifecycleIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ClassLifecycleIterator{it}, err
+}
+
+func (this classLifecycleTable) DeleteBy(ctx context.Context, prefixKey ClassLifecycleIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this classLifecycleTable) DeleteRange(ctx context.Context, from, to ClassLifecycleIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this classLifecycleTable) doNotImplement() {}
+
+var _ ClassLifecycleTable = classLifecycleTable{}
+
+func NewClassLifecycleTable(db ormtable.Schema) (ClassLifecycleTable, error) {
+	table := db.GetTable(&ClassLifecycle{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ClassLifecycle{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return classLifecycleTable{table}, nil
+}
+
+type ProjectSupplyTable interface {
+	Insert(ctx context.Context, projectSupply *ProjectSupply) error
+	Update(ctx context.Context, projectSupply *ProjectSupply) error
+	Save(ctx context.Context, projectSupply *ProjectSupply) error
+	Delete(ctx context.Context, projectSupply *ProjectSupply) error
+	Has(ctx context.Context, project_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, project_key uint64) (*ProjectSupply, error)
+	List(ctx context.Context, prefixKey ProjectSupplyIndexKey, opts ...ormlist.Option) (ProjectSupplyIterator, error)
+	ListRange(ctx context.Context, from, to ProjectSupplyIndexKey, opts ...ormlist.Option) (ProjectSupplyIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ProjectSupplyIndexKey) error
+	DeleteRange(ctx context.Context, from, to ProjectSupplyIndexKey) error
+
+	doNotImplement()
+}
+
+type ProjectSupplyIterator struct {
+	ormtable.Iterator
+}
+
+func (i ProjectSupplyIterator) Value() (*ProjectSupply, error) {
+	var projectSupply ProjectSupply
+	err := i.UnmarshalMessage(&projectSupply)
+	return &projectSupply, err
+}
+
+type ProjectSupplyIndexKey interface {
+	id() uint32
+	values() []interface{}
+	projectSupplyIndexKey()
+}
+
+// primary key starting index..
+type ProjectSupplyPrimaryKey = ProjectSupplyProjectKeyIndexKey
+
+type ProjectSupplyProjectKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectSupplyProjectKeyIndexKey) id() uint32             { return 0 }
+func (x ProjectSupplyProjectKeyIndexKey) values() []interface{}  { return x.vs }
+func (x ProjectSupplyProjectKeyIndexKey) projectSupplyIndexKey() {}
+
+func (this ProjectSupplyProjectKeyIndexKey) WithProjectKey(project_key uint64) ProjectSupplyProjectKeyIndexKey {
+	this.vs = []interface{}{project_key}
+	return this
+}
+
+type projectSupplyTable struct {
+	table ormtable.Table
+}
+
+func (this projectSupplyTable) Insert(ctx context.Context, projectSupply *ProjectSupply) error {
+	return this.table.Insert(ctx, projectSupply)
+}
+
+func (this projectSupplyTable) Update(ctx context.Context, projectSupply *ProjectSupply) error {
+	return this.table.Update(ctx, projectSupply)
+}
+
+func (this projectSupplyTable) Save(ctx context.Context, projectSupply *ProjectSupply) error {
+	return this.table.Save(ctx, projectSupply)
+}
+
+func (this projectSupplyTable) Delete(ctx context.Context, projectSupply *ProjectSupply) error {
+	return this.table.Delete(ctx, projectSupply)
+}
+
+func (this projectSupplyTable) Has(ctx context.Context, project_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, project_key)
+}
+
+func (this projectSupplyTable) Get(ctx context.Context, project_key uint64) (*ProjectSupply, error) {
+	var projectSupply ProjectSupply
+	found, err := this.table.PrimaryKey().Get(ctx, &projectSupply, project_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &projectSupply, nil
+}
+
+func (this projectSupplyTable) List(ctx context.Context, prefixKey ProjectSupplyIndexKey, opts ...ormlist.Option) (ProjectSupplyIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ProjectSupplyIterator{it}, err
+}
+
+func (this projectSupplyTable) ListRange(ctx context.Context, from, to ProjectSupplyIndexKey, opts ...ormlist.Option) (ProjectSupplyIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ProjectSupplyIterator{it}, err
+}
+
+func (this projectSupplyTable) DeleteBy(ctx context.Context, prefixKey ProjectSupplyIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this projectSupplyTable) DeleteRange(ctx context.Context, from, to ProjectSupplyIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this projectSupplyTable) doNotImplement() {}
+
+var _ ProjectSupplyTable = projectSupplyTable{}
+
+func NewProjectSupplyTable(db ormtable.Schema) (ProjectSupplyTable, error) {
+	table := db.GetTable(&ProjectSupply{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ProjectSupply{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return projectSupplyTable{table}, nil
+}
+
+type ClassSupplyTable interface {
+	Insert(ctx context.Context, classSupply *ClassSupply) error
+	Update(ctx context.Context, classSupply *ClassSupply) error
+	Save(ctx context.Context, classSupply *ClassSupply) error
+	Delete(ctx context.Context, classSupply *ClassSupply) error
+	Has(ctx context.Context, class_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, class_key uint64) (*ClassSupply, error)
+	List(ctx context.Context, prefixKey ClassSupplyIndexKey, opts ...ormlist.Option) (ClassSupplyIterator, error)
+	ListRange(ctx context.Context, from, to ClassSupplyIndexKey, opts ...ormlist.Option) (ClassSupplyIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ClassSupplyIndexKey) error
+	DeleteRange(ctx context.Context, from, to ClassSupplyIndexKey) error
+
+	doNotImplement()
+}
+
+type ClassSupplyIterator struct {
+	ormtable.Iterator
+}
+
+func (i ClassSupplyIterator) Value() (*ClassSupply, error) {
+	var classSupply ClassSupply
+	err := i.UnmarshalMessage(&classSupply)
+	return &classSupply, err
+}
+
+type ClassSupplyIndexKey interface {
+	id() uint32
+	values() []interface{}
+	classSupplyIndexKey()
+}
+
+// primary key starting index..
+type ClassSupplyPrimaryKey = ClassSupplyClassKeyIndexKey
+
+type ClassSupplyClassKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ClassSupplyClassKeyIndexKey) id() uint32            { return 0 }
+func (x ClassSupplyClassKeyIndexKey) values() []interface{} { return x.vs }
+func (x ClassSupplyClassKeyIndexKey) classSupplyIndexKey()  {}
+
+func (this ClassSupplyClassKeyIndexKey) WithClassKey(class_key uint64) ClassSupplyClassKeyIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+type classSupplyTable struct {
+	table ormtable.Table
+}
+
+func (this classSupplyTable) Insert(ctx context.Context, classSupply *ClassSupply) error {
+	return this.table.Insert(ctx, classSupply)
+}
+
+func (this classSupplyTable) Update(ctx context.Context, classSupply *ClassSupply) error {
+	return this.table.Update(ctx, classSupply)
+}
+
+func (this classSupplyTable) Save(ctx context.Context, classSupply *ClassSupply) error {
+	return this.table.Save(ctx, classSupply)
+}
+
+func (this classSupplyTable) Delete(ctx context.Context, classSupply *ClassSupply) error {
+	return this.table.Delete(ctx, classSupply)
+}
+
+func (this classSupplyTable) Has(ctx context.Context, class_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, class_key)
+}
+
+func (this classSupplyTable) Get(ctx context.Context, class_key uint64) (*ClassSupply, error) {
+	var classSupply ClassSupply
+	found, err := this.table.PrimaryKey().Get(ctx, &classSupply, class_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &classSupply, nil
+}
+
+func (this classSupplyTable) List(ctx context.Context, prefixKey ClassSupplyIndexKey, opts ...ormlist.Option) (ClassSupplyIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ClassSupplyIterator{it}, err
+}
+
+func (this classSupplyTable) ListRange(ctx context.Context, from, to ClassSupplyIndexKey, opts ...ormlist.Option) (ClassSupplyIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ClassSupplyIterator{it}, err
+}
+
+func (this classSupplyTable) DeleteBy(ctx context.Context, prefixKey ClassSupplyIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this classSupplyTable) DeleteRange(ctx context.Context, from, to ClassSupplyIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this classSupplyTable) doNotImplement() {}
+
+var _ ClassSupplyTable = classSupplyTable{}
+
+func NewClassSupplyTable(db ormtable.Schema) (ClassSupplyTable, error) {
+	table := db.GetTable(&ClassSupply{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ClassSupply{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return classSupplyTable{table}, nil
+}
+
+type CreditTypeSupplyTable interface {
+	Insert(ctx context.Context, creditTypeSupply *CreditTypeSupply) error
+	Update(ctx context.Context, creditTypeSupply *CreditTypeSupply) error
+	Save(ctx context.Context, creditTypeSupply *CreditTypeSupply) error
+	Delete(ctx context.Context, creditTypeSupply *CreditTypeSupply) error
+	Has(ctx context.Context, credit_type_abbrev string) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, credit_type_abbrev string) (*CreditTypeSupply, error)
+	List(ctx context.Context, prefixKey CreditTypeSupplyIndexKey, opts ...ormlist.Option) (CreditTypeSupplyIterator, error)
+	ListRange(ctx context.Context, from, to CreditTypeSupplyIndexKey, opts ...ormlist.Option) (CreditTypeSupplyIterator, error)
+	DeleteBy(ctx context.Context, prefixKey CreditTypeSupplyIndexKey) error
+	DeleteRange(ctx context.Context, from, to CreditTypeSupplyIndexKey) error
+
+	doNotImplement()
+}
+
+type CreditTypeSupplyIterator struct {
+	ormtable.Iterator
+}
+
+func (i CreditTypeSupplyIterator) Value() (*CreditTypeSupply, error) {
+	var creditTypeSupply CreditTypeSupply
+	err := i.UnmarshalMessage(&creditTypeSupply)
+	return &creditTypeSupply, err
+}
+
+type CreditTypeSupplyIndexKey interface {
+	id() uint32
+	values() []interface{}
+	creditTypeSupplyIndexKey()
+}
+
+// primary key starting index..
+type CreditTypeSupplyPrimaryKey = CreditTypeSupplyCreditTypeAbbrevIndexKey
+
+type CreditTypeSupplyCreditTypeAbbrevIndexKey struct {
+	vs []interface{}
+}
+
+func (x CreditTypeSupplyCreditTypeAbbrevIndexKey) id() uint32                { return 0 }
+func (x CreditTypeSupplyCreditTypeAbbrevIndexKey) values() []interface{}     { return x.vs }
+func (x CreditTypeSupplyCreditTypeAbbrevIndexKey) creditTypeSupplyIndexKey() {}
+
+func (this CreditTypeSupplyCreditTypeAbbrevIndexKey) WithCreditTypeAbbrev(credit_type_abbrev string) CreditTypeSupplyCreditTypeAbbrevIndexKey {
+	this.vs = []interface{}{credit_type_abbrev}
+	return this
+}
+
+type creditTypeSupplyTable struct {
+	table ormtable.Table
+}
+
+func (this creditTypeSupplyTable) Insert(ctx context.Context, creditTypeSupply *CreditTypeSupply) error {
+	return this.table.Insert(ctx, creditTypeSupply)
+}
+
+func (this creditTypeSupplyTable) Update(ctx context.Context, creditTypeSupply *CreditTypeSupply) error {
+	return this.table.Update(ctx, creditTypeSupply)
+}
+
+func (this creditTypeSupplyTable) Save(ctx context.Context, creditTypeSupply *CreditTypeSupply) error {
+	return this.table.Save(ctx, creditTypeSupply)
+}
+
+func (this creditTypeSupplyTable) Delete(ctx context.Context, creditTypeSupply *CreditTypeSupply) error {
+	return this.table.Delete(ctx, creditTypeSupply)
+}
+
+func (this creditTypeSupplyTable) Has(ctx context.Context, credit_type_abbrev string) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, credit_type_abbrev)
+}
+
+func (this creditTypeSupplyTable) Get(ctx context.Context, credit_type_abbrev string) (*CreditTypeSupply, error) {
+	var creditTypeSupply CreditTypeSupply
+	found, err := this.table.PrimaryKey().Get(ctx, &creditTypeSupply, credit_type_abbrev)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &creditTypeSupply, nil
+}
+
+func (this creditTypeSupplyTable) List(ctx context.Context, prefixKey CreditTypeSupplyIndexKey, opts ...ormlist.Option) (CreditTypeSupplyIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return CreditTypeSupplyIterator{it}, err
+}
+
+func (this creditTypeSupplyTable) ListRange(ctx context.Context, from, to CreditTypeSupplyIndexKey, opts ...ormlist.Option) (CreditTypeSupplyIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return CreditTypeSupplyIterator{it}, err
+}
+
+func (this creditTypeSupplyTable) DeleteBy(ctx context.Context, prefixKey CreditTypeSupplyIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this creditTypeSupplyTable) DeleteRange(ctx context.Context, from, to CreditTypeSupplyIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this creditTypeSupplyTable) doNotImplement() {}
+
+var _ CreditTypeSupplyTable = creditTypeSupplyTable{}
+
+func NewCreditTypeSupplyTable(db ormtable.Schema) (CreditTypeSupplyTable, error) {
+	table := db.GetTable(&CreditTypeSupply{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&CreditTypeSupply{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return creditTypeSupplyTable{table}, nil
+}
+
+type BatchBalanceIndexTable interface {
+	Insert(ctx context.Context, batchBalanceIndex *BatchBalanceIndex) error
+	Update(ctx context.Context, batchBalanceIndex *BatchBalanceIndex) error
+	Save(ctx context.Context, batchBalanceIndex *BatchBalanceIndex) error
+	Delete(ctx context.Context, batchBalanceIndex *BatchBalanceIndex) error
+	Has(ctx context.Context, address []byte, batch_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, address []byte, batch_key uint64) (*BatchBalanceIndex, error)
+	List(ctx context.Context, prefixKey BatchBalanceIndexIndexKey, opts ...ormlist.Option) (BatchBalanceIndexIterator, error)
+	ListRange(ctx context.Context, from, to BatchBalanceIndexIndexKey, opts ...ormlist.Option) (BatchBalanceIndexIterator, error)
+	DeleteBy(ctx context.Context, prefixKey BatchBalanceIndexIndexKey) error
+	DeleteRange(ctx context.Context, from, to BatchBalanceIndexIndexKey) error
+
+	doNotImplement()
+}
+
+type BatchBalanceIndexIterator struct {
+	ormtable.Iterator
+}
+
+func (i BatchBalanceIndexIterator) Value() (*BatchBalanceIndex, error) {
+	var batchBalanceIndex BatchBalanceIndex
+	err := i.UnmarshalMessage(&batchBalanceIndex)
+	return &batchBalanceIndex, err
+}
+
+type BatchBalanceIndexIndexKey interface {
+	id() uint32
+	values() []interface{}
+	batchBalanceIndexIndexKey()
+}
+
+// primary key starting index..
+type BatchBalanceIndexPrimaryKey = BatchBalanceIndexAddressBatchKeyIndexKey
+
+type BatchBalanceIndexAddressBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchBalanceIndexAddressBatchKeyIndexKey) id() uint32                 { return 0 }
+func (x BatchBalanceIndexAddressBatchKeyIndexKey) values() []interface{}      { return x.vs }
+func (x BatchBalanceIndexAddressBatchKeyIndexKey) batchBalanceIndexIndexKey() {}
+
+func (this BatchBalanceIndexAddressBatchKeyIndexKey) WithAddress(address []byte) BatchBalanceIndexAddressBatchKeyIndexKey {
+	this.vs = []interface{}{address}
+	return this
+}
+
+func (this BatchBalanceIndexAddressBatchKeyIndexKey) WithAddressBatchKey(address []byte, batch_key uint64) BatchBalanceIndexAddressBatchKeyIndexKey {
+	this.vs = []interface{}{address, batch_key}
+	return this
+}
+
+type BatchBalanceIndexAddressClassKeyBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchBalanceIndexAddressClassKeyBatchKeyIndexKey) id() uint32                 { return 1 }
+func (x BatchBalanceIndexAddressClassKeyBatchKeyIndexKey) values() []interface{}      { return x.vs }
+func (x BatchBalanceIndexAddressClassKeyBatchKeyIndexKey) batchBalanceIndexIndexKey() {}
+
+func (this BatchBalanceIndexAddressClassKeyBatchKeyIndexKey) WithAddress(address []byte) BatchBalanceIndexAddressClassKeyBatchKeyIndexKey {
+	this.vs = []interface{}{address}
+	return this
+}
+
+func (this BatchBalanceIndexAddressClassKeyBatchKeyIndexKey) WithAddressClassKey(address []byte, class_key uint64) BatchBalanceIndexAddressClassKeyBatchKeyIndexKey {
+	this.vs = []interface{}{address, class_key}
+	return this
+}
+
+func (this BatchBalanceIndexAddressClassKeyBatchKeyIndexKey) WithAddressClassKeyBatchKey(address []byte, class_key uint64, batch_key uint64) BatchBalanceIndexAddressClassKeyBatchKeyIndexKey {
+	this.vs = []interface{}{address, class_key, batch_key}
+	return this
+}
+
+type BatchBalanceIndexAddressProjectKeyBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchBalanceIndexAddressProjectKeyBatchKeyIndexKey) id() uint32                 { return 2 }
+func (x BatchBalanceIndexAddressProjectKeyBatchKeyIndexKey) values() []interface{}      { return x.vs }
+func (x BatchBalanceIndexAddressProjectKeyBatchKeyIndexKey) batchBalanceIndexIndexKey() {}
+
+func (this BatchBalanceIndexAddressProjectKeyBatchKeyIndexKey) WithAddress(address []byte) BatchBalanceIndexAddressProjectKeyBatchKeyIndexKey {
+	this.vs = []interface{}{address}
+	return this
+}
+
+func (this BatchBalanceIndexAddressProjectKeyBatchKeyIndexKey) WithAddressProjectKey(address []byte, project_key uint64) BatchBalanceIndexAddressProjectKeyBatchKeyIndexKey {
+	this.vs = []interface{}{address, project_key}
+	return this
+}
+
+func (this BatchBalanceIndexAddressProjectKeyBatchKeyIndexKey) WithAddressProjectKeyBatchKey(address []byte, project_key uint64, batch_key uint64) BatchBalanceIndexAddressProjectKeyBatchKeyIndexKey {
+	this.vs = []interface{}{address, project_key, batch_key}
+	return this
+}
+
+type batchBalanceIndexTable struct {
+	table ormtable.Table
+}
+
+func (this batchBalanceIndexTable) Insert(ctx context.Context, batchBalanceIndex *BatchBalanceIndex) error {
+	return this.table.Insert(ctx, batchBalanceIndex)
+}
+
+func (this batchBalanceIndexTable) Update(ctx context.Context, batchBalanceIndex *BatchBalanceIndex) error {
+	return this.table.Update(ctx, batchBalanceIndex)
+}
+
+func (this batchBalanceIndexTable) Save(ctx context.Context, batchBalanceIndex *BatchBalanceIndex) error {
+	return this.table.Save(ctx, batchBalanceIndex)
+}
+
+func (this batchBalanceIndexTable) Delete(ctx context.Context, batchBalanceIndex *BatchBalanceIndex) error {
+	return this.table.Delete(ctx, batchBalanceIndex)
+}
+
+func (this batchBalanceIndexTable) Has(ctx context.Context, address []byte, batch_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, address, batch_key)
+}
+
+func (this batchBalanceIndexTable) Get(ctx context.Context, address []byte, batch_key uint64) (*BatchBalanceIndex, error) {
+	var batchBalanceIndex BatchBalanceIndex
+	found, err := this.table.PrimaryKey().Get(ctx, &batchBalanceIndex, address, batch_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &batchBalanceIndex, nil
+}
+
+func (this batchBalanceIndexTable) List(ctx context.Context, prefixKey BatchBalanceIndexIndexKey, opts ...ormlist.Option) (BatchBalanceIndexIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return BatchBalanceIndexIterator{it}, err
+}
+
+func (this batchBalanceIndexTable) ListRange(ctx context.Context, from, to BatchBalanceIndexIndexKey, opts ...ormlist.Option) (BatchBalanceIndexIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return BatchBalanceIndexIterator{it}, err
+}
+
+func (this batchBalanceIndexTable) DeleteBy(ctx context.Context, prefixKey BatchBalanceIndexIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this batchBalanceIndexTable) DeleteRange(ctx context.Context, from, to BatchBalanceIndexIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this batchBalanceIndexTable) doNotImplement() {}
+
+var _ BatchBalanceIndexTable = batchBalanceIndexTable{}
+
+func NewBatchBalanceIndexTable(db ormtable.Schema) (BatchBalanceIndexTable, error) {
+	table := db.GetTable(&BatchBalanceIndex{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BatchBalanceIndex{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return batchBalanceIndexTable{table}, nil
+}
+
+type ProjectHolderTable interface {
+	Insert(ctx context.Context, projectHolder *ProjectHolder) error
+	Update(ctx context.Context, projectHolder *ProjectHolder) error
+	Save(ctx context.Context, projectHolder *ProjectHolder) error
+	Delete(ctx context.Context, projectHolder *ProjectHolder) error
+	Has(ctx context.Context, project_key uint64, address []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, project_key uint64, address []byte) (*ProjectHolder, error)
+	List(ctx context.Context, prefixKey ProjectHolderIndexKey, opts ...ormlist.Option) (ProjectHolderIterator, error)
+	ListRange(ctx context.Context, from, to ProjectHolderIndexKey, opts ...ormlist.Option) (ProjectHolderIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ProjectHolderIndexKey) error
+	DeleteRange(ctx context.Context, from, to ProjectHolderIndexKey) error
+
+	doNotImplement()
+}
+
+type ProjectHolderIterator struct {
+	ormtable.Iterator
+}
+
+func (i ProjectHolderIterator) Value() (*ProjectHolder, error) {
+	var projectHolder ProjectHolder
+	err := i.UnmarshalMessage(&projectHolder)
+	return &projectHolder, err
+}
+
+type ProjectHolderIndexKey interface {
+	id() uint32
+	values() []interface{}
+	projectHolderIndexKey()
+}
+
+// primary key starting index..
+type ProjectHolderPrimaryKey = ProjectHolderProjectKeyAddressIndexKey
+
+type ProjectHolderProjectKeyAddressIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectHolderProjectKeyAddressIndexKey) id() uint32             { return 0 }
+func (x ProjectHolderProjectKeyAddressIndexKey) values() []interface{}  { return x.vs }
+func (x ProjectHolderProjectKeyAddressIndexKey) projectHolderIndexKey() {}
+
+func (this ProjectHolderProjectKeyAddressIndexKey) WithProjectKey(project_key uint64) ProjectHolderProjectKeyAddressIndexKey {
+	this.vs = []interface{}{project_key}
+	return this
+}
+
+func (this ProjectHolderProjectKeyAddressIndexKey) WithProjectKeyAddress(project_key uint64, address []byte) ProjectHolderProjectKeyAddressIndexKey {
+	this.vs = []interface{}{project_key, address}
+	return this
+}
+
+type projectHolderTable struct {
+	table ormtable.Table
+}
+
+func (this projectHolderTable) Insert(ctx context.Context, projectHolder *ProjectHolder) error {
+	return this.table.Insert(ctx, projectHolder)
+}
+
+func (this projectHolderTable) Update(ctx context.Context, projectHolder *ProjectHolder) error {
+	return this.table.Update(ctx, projectHolder)
+}
+
+func (this projectHolderTable) Save(ctx context.Context, projectHolder *ProjectHolder) error {
+	return this.table.Save(ctx, projectHolder)
+}
+
+func (this projectHolderTable) Delete(ctx context.Context, projectHolder *ProjectHolder) error {
+	return this.table.Delete(ctx, projectHolder)
+}
+
+func (this projectHolderTable) Has(ctx context.Context, project_key uint64, address []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, project_key, address)
+}
+
+func (this projectHolderTable) Get(ctx context.Context, project_key uint64, address []byte) (*ProjectHolder, error) {
+	var projectHolder ProjectHolder
+	found, err := this.table.PrimaryKey().Get(ctx, &projectHolder, project_key, address)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &projectHolder, nil
+}
+
+func (this projectHolderTable) List(ctx context.Context, prefixKey ProjectHolderIndexKey, opts ...ormlist.Option) (ProjectHolderIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ProjectHolderIterator{it}, err
+}
+
+func (this projectHolderTable) ListRange(ctx context.Context, from, to ProjectHolderIndexKey, opts ...ormlist.Option) (ProjectHolderIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ProjectHolderIterator{it}, err
+}
+
+func (this projectHolderTable) DeleteBy(ctx context.Context, prefixKey ProjectHolderIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this projectHolderTable) DeleteRange(ctx context.Context, from, to ProjectHolderIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this projectHolderTable) doNotImplement() {}
+
+var _ ProjectHolderTable = projectHolderTable{}
+
+func NewProjectHolderTable(db ormtable.Schema) (ProjectHolderTable, error) {
+	table := db.GetTable(&ProjectHolder{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ProjectHolder{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return projectHolderTable{table}, nil
+}
+
+type RetirementSummaryTable interface {
+	Insert(ctx context.Context, retirementSummary *RetirementSummary) error
+	Update(ctx context.Context, retirementSummary *RetirementSummary) error
+	Save(ctx context.Context, retirementSummary *RetirementSummary) error
+	Delete(ctx context.Context, retirementSummary *RetirementSummary) error
+	Has(ctx context.Context, owner []byte, year uint32, credit_type_abbrev string, jurisdiction string) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, owner []byte, year uint32, credit_type_abbrev string, jurisdiction string) (*RetirementSummary, error)
+	List(ctx context.Context, prefixKey RetirementSummaryIndexKey, opts ...ormlist.Option) (RetirementSummaryIterator, error)
+	ListRange(ctx context.Context, from, to RetirementSummaryIndexKey, opts ...ormlist.Option) (RetirementSummaryIterator, error)
+	DeleteBy(ctx context.Context, prefixKey RetirementSummaryIndexKey) error
+	DeleteRange(ctx context.Context, from, to RetirementSummaryIndexKey) error
+
+	doNotImplement()
+}
+
+type RetirementSummaryIterator struct {
+	ormtable.Iterator
+}
+
+func (i RetirementSummaryIterator) Value() (*RetirementSummary, error) {
+	var retirementSummary RetirementSummary
+	err := i.UnmarshalMessage(&retirementSummary)
+	return &retirementSummary, err
+}
+
+type RetirementSummaryIndexKey interface {
+	id() uint32
+	values() []interface{}
+	retirementSummaryIndexKey()
+}
+
+// primary key starting index..
+type RetirementSummaryPrimaryKey = RetirementSummaryOwnerYearCreditTypeAbbrevJurisdictionIndexKey
+
+type RetirementSummaryOwnerYearCreditTypeAbbrevJurisdictionIndexKey struct {
+	vs []interface{}
+}
+
+func (x RetirementSummaryOwnerYearCreditTypeAbbrevJurisdictionIndexKey) id() uint32 { return 0 }
+func (x RetirementSummaryOwnerYearCreditTypeAbbrevJurisdictionIndexKey) values() []interface{} {
+	return x.vs
+}
+func (x RetirementSummaryOwnerYearCreditTypeAbbrevJurisdictionIndexKey) retirementSummaryIndexKey() {}
+
+func (this RetirementSummaryOwnerYearCreditTypeAbbrevJurisdictionIndexKey) WithOwner(owner []byte) RetirementSummaryOwnerYearCreditTypeAbbrevJurisdictionIndexKey {
+	this.vs = []interface{}{owner}
+	return this
+}
+
+func (this RetirementSummaryOwnerYearCreditTypeAbbrevJurisdictionIndexKey) WithOwnerYear(owner []byte, year uint32) RetirementSummaryOwnerYearCreditTypeAbbrevJurisdictionIndexKey {
+	this.vs = []interface{}{owner, year}
+	return this
+}
+
+func (this RetirementSummaryOwnerYearCreditTypeAbbrevJurisdictionIndexKey) WithOwnerYearCreditTypeAbbrev(owner []byte, year uint32, credit_type_abbrev string) RetirementSummaryOwnerYearCreditTypeAbbrevJurisdictionIndexKey {
+	this.vs = []interface{}{owner, year, credit_type_abbrev}
+	return this
+}
+
+func (this RetirementSummaryOwnerYearCreditTypeAbbrevJurisdictionIndexKey) WithOwnerYearCreditTypeAbbrevJurisdiction(owner []byte, year uint32, credit_type_abbrev string, jurisdiction string) RetirementSummaryOwnerYearCreditTypeAbbrevJurisdictionIndexKey {
+	this.vs = []interface{}{owner, year, credit_type_abbrev, jurisdiction}
+	return this
+}
+
+type retirementSummaryTable struct {
+	table ormtable.Table
+}
+
+func (this retirementSummaryTable) Insert(ctx context.Context, retirementSummary *RetirementSummary) error {
+	return this.table.Insert(ctx, retirementSummary)
+}
+
+func (this retirementSummaryTable) Update(ctx context.Context, retirementSummary *RetirementSummary) error {
+	return this.table.Update(ctx, retirementSummary)
+}
+
+func (this retirementSummaryTable) Save(ctx context.Context, retirementSummary *RetirementSummary) error {
+	return this.table.Save(ctx, retirementSummary)
+}
+
+func (this retirementSummaryTable) Delete(ctx context.Context, retirementSummary *RetirementSummary) error {
+	return this.table.Delete(ctx, retirementSummary)
+}
+
+func (this retirementSummaryTable) Has(ctx context.Context, owner []byte, year uint32, credit_type_abbrev string, jurisdiction string) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, owner, year, credit_type_abbrev, jurisdiction)
+}
+
+func (this retirementSummaryTable) Get(ctx context.Context, owner []byte, year uint32, credit_type_abbrev string, jurisdiction string) (*RetirementSummary, error) {
+	var retirementSummary RetirementSummary
+	found, err := this.table.PrimaryKey().Get(ctx, &retirementSummary, owner, year, credit_type_abbrev, jurisdiction)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &retirementSummary, nil
+}
+
+func (this retirementSummaryTable) List(ctx context.Context, prefixKey RetirementSummaryIndexKey, opts ...ormlist.Option) (RetirementSummaryIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return RetirementSummaryIterator{it}, err
+}
+
+func (this retirementSummaryTable) ListRange(ctx context.Context, from, to RetirementSummaryIndexKey, opts ...ormlist.Option) (RetirementSummaryIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return RetirementSummaryIterator{it}, err
+}
+
+func (this retirementSummaryTable) DeleteBy(ctx context.Context, prefixKey RetirementSummaryIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this retirementSummaryTable) DeleteRange(ctx context.Context, from, to RetirementSummaryIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this retirementSummaryTable) doNotImplement() {}
+
+var _ RetirementSummaryTable = retirementSummaryTable{}
+
+func NewRetirementSummaryTable(db ormtable.Schema) (RetirementSummaryTable, error) {
+	table := db.GetTable(&RetirementSummary{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&RetirementSummary{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return retirementSummaryTable{table}, nil
+}
+
+// singleton store
+type StrictJurisdictionsTable interface {
+	Get(ctx context.Context) (*StrictJurisdictions, error)
+	Save(ctx context.Context, strictJurisdictions *StrictJurisdictions) error
+}
+
+type strictJurisdictionsTable struct {
+	table ormtable.Table
+}
+
+var _ StrictJurisdictionsTable = strictJurisdictionsTable{}
+
+func (x strictJurisdictionsTable) Get(ctx context.Context) (*StrictJurisdictions, error) {
+	strictJurisdictions := &StrictJurisdictions{}
+	_, err := x.table.Get(ctx, strictJurisdictions)
+	return strictJurisdictions, err
+}
+
+func (x strictJurisdictionsTable) Save(ctx context.Context, strictJurisdictions *StrictJurisdictions) error {
+	return x.table.Save(ctx, strictJurisdictions)
+}
+
+func NewStrictJurisdictionsTable(db ormtable.Schema) (StrictJurisdictionsTable, error) {
+	table := db.GetTable(&StrictJurisdictions{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&StrictJurisdictions{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return &strictJurisdictionsTable{table}, nil
+}
+
+type NationalRegistryTable interface {
+	Insert(ctx context.Context, nationalRegistry *NationalRegistry) error
+	Update(ctx context.Context, nationalRegistry *NationalRegistry) error
+	Save(ctx context.Context, nationalRegistry *NationalRegistry) error
+	Delete(ctx context.Context, nationalRegistry *NationalRegistry) error
+	Has(ctx context.Context, country string, address []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, country string, address []byte) (*NationalRegistry, error)
+	List(ctx context.Context, prefixKey NationalRegistryIndexKey, opts ...ormlist.Option) (NationalRegistryIterator, error)
+	ListRange(ctx context.Context, from, to NationalRegistryIndexKey, opts ...ormlist.Option) (NationalRegistryIterator, error)
+	DeleteBy(ctx context.Context, prefixKey NationalRegistryIndexKey) error
+	DeleteRange(ctx context.Context, from, to NationalRegistryIndexKey) error
+
+	doNotImplement()
+}
+
+type NationalRegistryIterator struct {
+	ormtable.Iterator
+}
+
+func (i NationalRegistryIterator) Value() (*NationalRegistry, error) {
+	var nationalRegistry NationalRegistry
+	err := i.UnmarshalMessage(&nationalRegistry)
+	return &nationalRegistry, err
+}
+
+type NationalRegistryIndexKey interface {
+	id() uint32
+	values() []interface{}
+	nationalRegistryIndexKey()
+}
+
+// primary key starting index..
+type NationalRegistryPrimaryKey = NationalRegistryCountryAddressIndexKey
+
+type NationalRegistryCountryAddressIndexKey struct {
+	vs []interface{}
+}
+
+func (x NationalRegistryCountryAddressIndexKey) id() uint32                { return 0 }
+func (x NationalRegistryCountryAddressIndexKey) values() []interface{}     { return x.vs }
+func (x NationalRegistryCountryAddressIndexKey) nationalRegistryIndexKey() {}
+
+func (this NationalRegistryCountryAddressIndexKey) WithCountry(country string) NationalRegistryCountryAddressIndexKey {
+	this.vs = []interface{}{country}
+	return this
+}
+
+func (this NationalRegistryCountryAddressIndexKey) WithCountryAddress(country string, address []byte) NationalRegistryCountryAddressIndexKey {
+	this.vs = []interface{}{country, address}
+	return this
+}
+
+type nationalRegistryTable struct {
+	table ormtable.Table
+}
+
+func (this nationalRegistryTable) Insert(ctx context.Context, nationalRegistry *NationalRegistry) error {
+	return this.table.Insert(ctx, nationalRegistry)
+}
+
+func (this nationalRegistryTable) Update(ctx context.Context, nationalRegistry *NationalRegistry) error {
+	return this.table.Update(ctx, nationalRegistry)
+}
+
+func (this nationalRegistryTable) Save(ctx context.Context, nationalRegistry *NationalRegistry) error {
+	return this.table.Save(ctx, nationalRegistry)
+}
+
+func (this nationalRegistryTable) Delete(ctx context.Context, nationalRegistry *NationalRegistry) error {
+	return this.table.Delete(ctx, nationalRegistry)
+}
+
+func (this nationalRegistryTable) Has(ctx context.Context, country string, address []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, country, address)
+}
+
+func (this nationalRegistryTable) Get(ctx context.Context, country string, address []byte) (*NationalRegistry, error) {
+	var nationalRegistry NationalRegistry
+	found, err := this.table.PrimaryKey().Get(ctx, &nationalRegistry, country, address)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &nationalRegistry, nil
+}
+
+func (this nationalRegistryTable) List(ctx context.Context, prefixKey NationalRegistryIndexKey, opts ...ormlist.Option) (NationalRegistryIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return NationalRegistryIterator{it}, err
+}
+
+func (this nationalRegistryTable) ListRange(ctx context.Context, from, to NationalRegistryIndexKey, opts ...ormlist.Option) (NationalRegistryIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return NationalRegistryIterator{it}, err
+}
+
+func (this nationalRegistryTable) DeleteBy(ctx context.Context, prefixKey NationalRegistryIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this nationalRegistryTable) DeleteRange(ctx context.Context, from, to NationalRegistryIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this nationalRegistryTable) doNotImplement() {}
+
+var _ NationalRegistryTable = nationalRegistryTable{}
+
+func NewNationalRegistryTable(db ormtable.Schema) (NationalRegistryTable, error) {
+	table := db.GetTable(&NationalRegistry{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&NationalRegistry{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return nationalRegistryTable{table}, nil
+}
+
+type Article6AuthorizationTable interface {
+	Insert(ctx context.Context, article6Authorization *Article6Authorization) error
+	Update(ctx context.Context, article6Authorization *Article6Authorization) error
+	Save(ctx context.Context, article6Authorization *Article6Authorization) error
+	Delete(ctx context.Context, article6Authorization *Article6Authorization) error
+	Has(ctx context.Context, batch_key uint64, retirement_id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, batch_key uint64, retirement_id uint64) (*Article6Authorization, error)
+	List(ctx context.Context, prefixKey Article6AuthorizationIndexKey, opts ...ormlist.Option) (Article6AuthorizationIterator, error)
+	ListRange(ctx context.Context, from, to Article6AuthorizationIndexKey, opts ...ormlist.Option) (Article6AuthorizationIterator, error)
+	DeleteBy(ctx context.Context, prefixKey Article6AuthorizationIndexKey) error
+	DeleteRange(ctx context.Context, from, to Article6AuthorizationIndexKey) error
+
+	doNotImplement()
+}
+
+type Article6AuthorizationIterator struct {
+	ormtable.Iterator
+}
+
+func (i Article6AuthorizationIterator) Value() (*Article6Authorization, error) {
+	var article6Authorization Article6Authorization
+	err := i.UnmarshalMessage(&article6Authorization)
+	return &article6Authorization, err
+}
+
+type Article6AuthorizationIndexKey interface {
+	id() uint32
+	values() []interface{}
+	article6AuthorizationIndexKey()
+}
+
+// primary key starting index..
+type Article6AuthorizationPrimaryKey = Article6AuthorizationBatchKeyRetirementIdIndexKey
+
+type Article6AuthorizationBatchKeyRetirementIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x Article6AuthorizationBatchKeyRetirementIdIndexKey) id() uint32                     { return 0 }
+func (x Article6AuthorizationBatchKeyRetirementIdIndexKey) values() []interface{}          { return x.vs }
+func (x Article6AuthorizationBatchKeyRetirementIdIndexKey) article6AuthorizationIndexKey() {}
+
+func (this Article6AuthorizationBatchKeyRetirementIdIndexKey) WithBatchKey(batch_key uint64) Article6AuthorizationBatchKeyRetirementIdIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+func (this Article6AuthorizationBatchKeyRetirementIdIndexKey) WithBatchKeyRetirementId(batch_key uint64, retirement_id uint64) Article6AuthorizationBatchKeyRetirementIdIndexKey {
+	this.vs = []interface{}{batch_key, retirement_id}
+	return this
+}
+
+type article6AuthorizationTable struct {
+	table ormtable.Table
+}
+
+func (this article6AuthorizationTable) Insert(ctx context.Context, article6Authorization *Article6Authorization) error {
+	return this.table.Insert(ctx, article6Authorization)
+}
+
+func (this article6AuthorizationTable) Update(ctx context.Context, article6Authorization *Article6Authorization) error {
+	return this.table.Update(ctx, article6Authorization)
+}
+
+func (this article6AuthorizationTable) Save(ctx context.Context, article6Authorization *Article6Authorization) error {
+	return this.table.Save(ctx, article6Authorization)
+}
+
+func (this article6AuthorizationTable) Delete(ctx context.Context, article6Authorization *Article6Authorization) error {
+	return this.table.Delete(ctx, article6Authorization)
+}
+
+func (this article6AuthorizationTable) Has(ctx context.Context, batch_key uint64, retirement_id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, batch_key, retirement_id)
+}
+
+func (this article6AuthorizationTable) Get(ctx context.Context, batch_key uint64, retirement_id uint64) (*Article6Authorization, error) {
+	var article6Authorization Article6Authorization
+	found, err := this.table.PrimaryKey().Get(ctx, &article6Authorization, batch_key, retirement_id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &article6Authorization, nil
+}
+
+func (this article6AuthorizationTable) List(ctx context.Context, prefixKey Article6AuthorizationIndexKey, opts ...ormlist.Option) (Article6AuthorizationIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return Article6AuthorizationIterator{it}, err
+}
+
+func (this article6AuthorizationTable) ListRange(ctx context.Context, from, to Article6AuthorizationIndexKey, opts ...ormlist.Option) (Article6AuthorizationIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return Article6AuthorizationIterator{it}, err
+}
+
+func (this article6AuthorizationTable) DeleteBy(ctx context.Context, prefixKey Article6AuthorizationIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this article6AuthorizationTable) DeleteRange(ctx context.Context, from, to Article6AuthorizationIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this article6AuthorizationTable) doNotImplement() {}
+
+var _ Article6AuthorizationTable = article6AuthorizationTable{}
+
+func NewArticle6AuthorizationTable(db ormtable.Schema) (Article6AuthorizationTable, error) {
+	table := db.GetTable(&Article6Authorization{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Article6Authorization{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return article6AuthorizationTable{table}, nil
+}
+
+type ClassSerializationTable interface {
+	Insert(ctx context.Context, classSerialization *ClassSerialization) error
+	Update(ctx context.Context, classSerialization *ClassSerialization) error
+	Save(ctx context.Context, classSerialization *ClassSerialization) error
+	Delete(ctx context.Context, classSerialization *ClassSerialization) error
+	Has(ctx context.Context, class_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, class_key uint64) (*ClassSerialization, error)
+	List(ctx context.Context, prefixKey ClassSerializationIndexKey, opts ...ormlist.Option) (ClassSerializationIterator, error)
+	ListRange(ctx context.Context, from, to ClassSerializationIndexKey, opts ...ormlist.Option) (ClassSerializationIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ClassSerializationIndexKey) error
+	DeleteRange(ctx context.Context, from, to ClassSerializationIndexKey) error
+
+	doNotImplement()
+}
+
+type ClassSerializationIterator struct {
+	ormtable.Iterator
+}
+
+func (i ClassSerializationIterator) Value() (*ClassSerialization, error) {
+	var classSerialization ClassSerialization
+	err := i.UnmarshalMessage(&classSerialization)
+	return &classSerialization, err
+}
+
+type ClassSerializationIndexKey interface {
+	id() uint32
+	values() []interface{}
+	classSerializationIndexKey()
+}
+
+// primary key starting index..
+type ClassSerializationPrimaryKey = ClassSerializationClassKeyIndexKey
+
+type ClassSerializationClassKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ClassSerializationClassKeyIndexKey) id() uint32                  { return 0 }
+func (x ClassSerializationClassKeyIndexKey) values() []interface{}       { return x.vs }
+func (x ClassSerializationClassKeyIndexKey) classSerializationIndexKey() {}
+
+func (this ClassSerializationClassKeyIndexKey) WithClassKey(class_key uint64) ClassSerializationClassKeyIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+type classSerializationTable struct {
+	table ormtable.Table
+}
+
+func (this classSerializationTable) Insert(ctx context.Context, classSerialization *ClassSerialization) error {
+	return this.table.Insert(ctx, classSerialization)
+}
+
+func (this classSerializationTable) Update(ctx context.Context, classSerialization *ClassSerialization) error {
+	return this.table.Update(ctx, classSerialization)
+}
+
+func (this classSerializationTable) Save(ctx context.Context, classSerialization *ClassSerialization) error {
+	return this.table.Save(ctx, classSerialization)
+}
+
+func (this classSerializationTable) Delete(ctx context.Context, classSerialization *ClassSerialization) error {
+	return this.table.Delete(ctx, classSerialization)
+}
+
+func (this classSerializationTable) Has(ctx context.Context, class_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, class_key)
+}
+
+func (this classSerializationTable) Get(ctx context.Context, class_key uint64) (*ClassSerialization, error) {
+	var classSerialization ClassSerialization
+	found, err := this.table.PrimaryKey().Get(ctx, &classSerialization, class_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &classSerialization, nil
+}
+
+func (this classSerializationTable) List(ctx context.Context, prefixKey ClassSerializationIndexKey, opts ...ormlist.Option) (ClassSerializationIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ClassSerializationIterator{it}, err
+}
+
+func (this classSerializationTable) ListRange(ctx context.Context, from, to ClassSerializationIndexKey, opts ...ormlist.Option) (ClassSerializationIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ClassSerializationIterator{it}, err
+}
+
+func (this classSerializationTable) DeleteBy(ctx context.Context, prefixKey ClassSerializationIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this classSerializationTable) DeleteRange(ctx context.Context, from, to ClassSerializationIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this classSerializationTable) doNotImplement() {}
+
+var _ ClassSerializationTable = classSerializationTable{}
+
+func NewClassSerializationTable(db ormtable.Schema) (ClassSerializationTable, error) {
+	table := db.GetTable(&ClassSerialization{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ClassSerialization{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return classSerializationTable{table}, nil
+}
+
+type BatchSerialSequenceTable interface {
+	Insert(ctx context.Context, batchSerialSequence *BatchSerialSequence) error
+	Update(ctx context.Context, batchSerialSequence *BatchSerialSequence) error
+	Save(ctx context.Context, batchSerialSequence *BatchSerialSequence) error
+	Delete(ctx context.Context, batchSerialSequence *BatchSerialSequence) error
+	Has(ctx context.Context, batch_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, batch_key uint64) (*BatchSerialSequence, error)
+	List(ctx context.Context, prefixKey BatchSerialSequenceIndexKey, opts ...ormlist.Option) (BatchSerialSequenceIterator, error)
+	ListRange(ctx context.Context, from, to BatchSerialSequenceIndexKey, opts ...ormlist.Option) (BatchSerialSequenceIterator, error)
+	DeleteBy(ctx context.Context, prefixKey BatchSerialSequenceIndexKey) error
+	DeleteRange(ctx context.Context, from, to BatchSerialSequenceIndexKey) error
+
+	doNotImplement()
+}
+
+type BatchSerialSequenceIterator struct {
+	ormtable.Iterator
+}
+
+func (i BatchSerialSequenceIterator) Value() (*BatchSerialSequence, error) {
+	var batchSerialSequence BatchSerialSequence
+	err := i.UnmarshalMessage(&batchSerialSequence)
+	return &batchSerialSequence, err
+}
+
+type BatchSerialSequenceIndexKey interface {
+	id() uint32
+	values() []interface{}
+	batchSerialSequenceIndexKey()
+}
+
+// primary key starting index..
+type BatchSerialSequencePrimaryKey = BatchSerialSequenceBatchKeyIndexKey
+
+type BatchSerialSequenceBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchSerialSequenceBatchKeyIndexKey) id() uint32                   { return 0 }
+func (x BatchSerialSequenceBatchKeyIndexKey) values() []interface{}        { return x.vs }
+func (x BatchSerialSequenceBatchKeyIndexKey) batchSerialSequenceIndexKey() {}
+
+func (this BatchSerialSequenceBatchKeyIndexKey) WithBatchKey(batch_key uint64) BatchSerialSequenceBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type batchSerialSequenceTable struct {
+	table ormtable.Table
+}
+
+func (this batchSerialSequenceTable) Insert(ctx context.Context, batchSerialSequence *BatchSerialSequence) error {
+	return this.table.Insert(ctx, batchSerialSequence)
+}
+
+func (this batchSerialSequenceTable) Update(ctx context.Context, batchSerialSequence *BatchSerialSequence) error {
+	return this.table.Update(ctx, batchSerialSequence)
+}
+
+func (this batchSerialSequenceTable) Save(ctx context.Context, batchSerialSequence *BatchSerialSequence) error {
+	return this.table.Save(ctx, batchSerialSequence)
+}
+
+func (this batchSerialSequenceTable) Delete(ctx context.Context, batchSerialSequence *BatchSerialSequence) error {
+	return this.table.Delete(ctx, batchSerialSequence)
+}
+
+func (this batchSerialSequenceTable) Has(ctx context.Context, batch_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, batch_key)
+}
+
+func (this batchSerialSequenceTable) Get(ctx context.Context, batch_key uint64) (*BatchSerialSequence, error) {
+	var batchSerialSequence BatchSerialSequence
+	found, err := this.table.PrimaryKey().Get(ctx, &batchSerialSequence, batch_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &batchSerialSequence, nil
+}
+
+func (this batchSerialSequenceTable) List(ctx context.Context, prefixKey BatchSerialSequenceIndexKey, opts ...ormlist.Option) (BatchSerialSequenceIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return BatchSerialSequenceIterator{it}, err
+}
+
+func (this batchSerialSequenceTable) ListRange(ctx context.Context, from, to BatchSerialSequenceIndexKey, opts ...ormlist.Option) (BatchSerialSequenceIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return BatchSerialSequenceIterator{it}, err
+}
+
+func (this batchSerialSequenceTable) DeleteBy(ctx context.Context, prefixKey BatchSerialSequenceIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this batchSerialSequenceTable) DeleteRange(ctx context.Context, from, to BatchSerialSequenceIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this batchSerialSequenceTable) doNotImplement() {}
+
+var _ BatchSerialSequenceTable = batchSerialSequenceTable{}
+
+func NewBatchSerialSequenceTable(db ormtable.Schema) (BatchSerialSequenceTable, error) {
+	table := db.GetTable(&BatchSerialSequence{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BatchSerialSequence{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return batchSerialSequenceTable{table}, nil
+}
+
+type SerialRangeTable interface {
+	Insert(ctx context.Context, serialRange *SerialRange) error
+	Update(ctx context.Context, serialRange *SerialRange) error
+	Save(ctx context.Context, serialRange *SerialRange) error
+	Delete(ctx context.Context, serialRange *SerialRange) error
+	Has(ctx context.Context, batch_key uint64, start uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, batch_key uint64, start uint64) (*SerialRange, error)
+	List(ctx context.Context, prefixKey SerialRangeIndexKey, opts ...ormlist.Option) (SerialRangeIterator, error)
+	ListRange(ctx context.Context, from, to SerialRangeIndexKey, opts ...ormlist.Option) (SerialRangeIterator, error)
+	DeleteBy(ctx context.Context, prefixKey SerialRangeIndexKey) error
+	DeleteRange(ctx context.Context, from, to SerialRangeIndexKey) error
+
+	doNotImplement()
+}
+
+type SerialRangeIterator struct {
+	ormtable.Iterator
+}
+
+func (i SerialRangeIterator) Value() (*SerialRange, error) {
+	var serialRange SerialRange
+	err := i.UnmarshalMessage(&serialRange)
+	return &serialRange, err
+}
+
+type SerialRangeIndexKey interface {
+	id() uint32
+	values() []interface{}
+	serialRangeIndexKey()
+}
+
+// primary key starting index..
+type SerialRangePrimaryKey = SerialRangeBatchKeyStartIndexKey
+
+type SerialRangeBatchKeyStartIndexKey struct {
+	vs []interface{}
+}
+
+func (x SerialRangeBatchKeyStartIndexKey) id() uint32            { return 0 }
+func (x SerialRangeBatchKeyStartIndexKey) values() []interface{} { return x.vs }
+func (x SerialRangeBatchKeyStartIndexKey) serialRangeIndexKey()  {}
+
+func (this SerialRangeBatchKeyStartIndexKey) WithBatchKey(batch_key uint64) SerialRangeBatchKeyStartIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+func (this SerialRangeBatchKeyStartIndexKey) WithBatchKeyStart(batch_key uint64, start uint64) SerialRangeBatchKeyStartIndexKey {
+	this.vs = []interface{}{batch_key, start}
+	return this
+}
+
+type SerialRangeOwnerBatchKeyStatusIndexKey struct {
+	vs []interface{}
+}
+
+func (x SerialRangeOwnerBatchKeyStatusIndexKey) id() uint32            { return 1 }
+func (x SerialRangeOwnerBatchKeyStatusIndexKey) values() []interface{} { return x.vs }
+func (x SerialRangeOwnerBatchKeyStatusIndexKey) serialRangeIndexKey()  {}
+
+func (this SerialRangeOwnerBatchKeyStatusIndexKey) WithOwner(owner []byte) SerialRangeOwnerBatchKeyStatusIndexKey {
+	this.vs = []interface{}{owner}
+	return this
+}
+
+func (this SerialRangeOwnerBatchKeyStatusIndexKey) WithOwnerBatchKey(owner []byte, batch_key uint64) SerialRangeOwnerBatchKeyStatusIndexKey {
+	this.vs = []interface{}{owner, batch_key}
+	return this
+}
+
+func (this SerialRangeOwnerBatchKeyStatusIndexKey) WithOwnerBatchKeyStatus(owner []byte, batch_key uint64, status uint32) SerialRangeOwnerBatchKeyStatusIndexKey {
+	this.vs = []interface{}{owner, batch_key, status}
+	return this
+}
+
+type serialRangeTable struct {
+	table ormtable.Table
+}
+
+func (this serialRangeTable) Insert(ctx context.Context, serialRange *SerialRange) error {
+	return this.table.Insert(ctx, serialRange)
+}
+
+func (this serialRangeTable) Update(ctx context.Context, serialRange *SerialRange) error {
+	return this.table.Update(ctx, serialRange)
+}
+
+func (this serialRangeTable) Save(ctx context.Context, serialRange *SerialRange) error {
+	return this.table.Save(ctx, serialRange)
+}
+
+func (this serialRangeTable) Delete(ctx context.Context, serialRange *SerialRange) error {
+	return this.table.Delete(ctx, serialRange)
+}
+
+func (this serialRangeTable) Has(ctx context.Context, batch_key uint64, start uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, batch_key, start)
+}
+
+func (this serialRangeTable) Get(ctx context.Context, batch_key uint64, start uint64) (*SerialRange, error) {
+	var serialRange SerialRange
+	found, err := this.table.PrimaryKey().Get(ctx, &serialRange, batch_key, start)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &serialRange, nil
+}
+
+func (this serialRangeTable) List(ctx context.Context, prefixKey SerialRangeIndexKey, opts ...ormlist.Option) (SerialRangeIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return SerialRangeIterator{it}, err
+}
+
+func (this serialRangeTable) ListRange(ctx context.Context, from, to SerialRangeIndexKey, opts ...ormlist.Option) (SerialRangeIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return SerialRangeIterator{it}, err
+}
+
+func (this serialRangeTable) DeleteBy(ctx context.Context, prefixKey SerialRangeIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this serialRangeTable) DeleteRange(ctx context.Context, from, to SerialRangeIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this serialRangeTable) doNotImplement() {}
+
+var _ SerialRangeTable = serialRangeTable{}
+
+func NewSerialRangeTable(db ormtable.Schema) (SerialRangeTable, error) {
+	table := db.GetTable(&SerialRange{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&SerialRange{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return serialRangeTable{table}, nil
+}
+
+type NativeBatchContractTable interface {
+	Insert(ctx context.Context, nativeBatchContract *NativeBatchContract) error
+	Update(ctx context.Context, nativeBatchContract *NativeBatchContract) error
+	Save(ctx context.Context, nativeBatchContract *NativeBatchContract) error
+	Delete(ctx context.Context, nativeBatchContract *NativeBatchContract) error
+	Has(ctx context.Context, batch_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, batch_key uint64) (*NativeBatchContract, error)
+	List(ctx context.Context, prefixKey NativeBatchContractIndexKey, opts ...ormlist.Option) (NativeBatchContractIterator, error)
+	ListRange(ctx context.Context, from, to NativeBatchContractIndexKey, opts ...ormlist.Option) (NativeBatchContractIterator, error)
+	DeleteBy(ctx context.Context, prefixKey NativeBatchContractIndexKey) error
+	DeleteRange(ctx context.Context, from, to NativeBatchContractIndexKey) error
+
+	doNotImplement()
+}
+
+type NativeBatchContractIterator struct {
+	ormtable.Iterator
+}
+
+func (i NativeBatchContractIterator) Value() (*NativeBatchContract, error) {
+	var nativeBatchContract NativeBatchContract
+	err := i.UnmarshalMessage(&nativeBatchContract)
+	return &nativeBatchContract, err
+}
+
+type NativeBatchContractIndexKey interface {
+	id() uint32
+	values() []interface{}
+	nativeBatchContractIndexKey()
+}
+
+// primary key starting index..
+type NativeBatchContractPrimaryKey = NativeBatchContractBatchKeyIndexKey
+
+type NativeBatchContractBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x NativeBatchContractBatchKeyIndexKey) id() uint32                   { return 0 }
+func (x NativeBatchContractBatchKeyIndexKey) values() []interface{}        { return x.vs }
+func (x NativeBatchContractBatchKeyIndexKey) nativeBatchContractIndexKey() {}
+
+func (this NativeBatchContractBatchKeyIndexKey) WithBatchKey(batch_key uint64) NativeBatchContractBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type nativeBatchContractTable struct {
+	table ormtable.Table
+}
+
+func (this nativeBatchContractTable) Insert(ctx context.Context, nativeBatchContract *NativeBatchContract) error {
+	return this.table.Insert(ctx, nativeBatchContract)
+}
+
+func (this nativeBatchContractTable) Update(ctx context.Context, nativeBatchContract *NativeBatchContract) error {
+	return this.table.Update(ctx, nativeBatchContract)
+}
+
+func (this nativeBatchContractTable) Save(ctx context.Context, nativeBatchContract *NativeBatchContract) error {
+	return this.table.Save(ctx, nativeBatchContract)
+}
+
+func (this nativeBatchContractTable) Delete(ctx context.Context, nativeBatchContract *NativeBatchContract) error {
+	return this.table.Delete(ctx, nativeBatchContract)
+}
+
+func (this nativeBatchContractTable) Has(ctx context.Context, batch_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, batch_key)
+}
+
+func (this nativeBatchContractTable) Get(ctx context.Context, batch_key uint64) (*NativeBatchContract, error) {
+	var nativeBatchContract NativeBatchContract
+	found, err := this.table.PrimaryKey().Get(ctx, &nativeBatchContract, batch_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &nativeBatchContract, nil
+}
+
+func (this nativeBatchContractTable) List(ctx context.Context, prefixKey NativeBatchContractIndexKey, opts ...ormlist.Option) (NativeBatchContractIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return NativeBatchContractIterator{it}, err
+}
+
+func (this nativeBatchContractTable) ListRange(ctx context.Context, from, to NativeBatchContractIndexKey, opts ...ormlist.Option) (NativeBatchContractIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return NativeBatchContractIterator{it}, err
+}
+
+func (this nativeBatchContractTable) DeleteBy(ctx context.Context, prefixKey NativeBatchContractIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this nativeBatchContractTable) DeleteRange(ctx context.Context, from, to NativeBatchContractIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this nativeBatchContractTable) doNotImplement() {}
+
+var _ NativeBatchContractTable = nativeBatchContractTable{}
+
+func NewNativeBatchContractTable(db ormtable.Schema) (NativeBatchContractTable, error) {
+	table := db.GetTable(&NativeBatchContract{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&NativeBatchContract{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return nativeBatchContractTable{table}, nil
+}
+
+type BridgeRequestTable interface {
+	Insert(ctx context.Context, bridgeRequest *BridgeRequest) error
+	InsertReturningID(ctx context.Context, bridgeRequest *BridgeRequest) (uint64, error)
+	Update(ctx context.Context, bridgeRequest *BridgeRequest) error
+	Save(ctx context.Context, bridgeRequest *BridgeRequest) error
+	Delete(ctx context.Context, bridgeRequest *BridgeRequest) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*BridgeRequest, error)
+	List(ctx context.Context, prefixKey BridgeRequestIndexKey, opts ...ormlist.Option) (BridgeRequestIterator, error)
+	ListRange(ctx context.Context, from, to BridgeRequestIndexKey, opts ...ormlist.Option) (BridgeRequestIterator, error)
+	DeleteBy(ctx context.Context, prefixKey BridgeRequestIndexKey) error
+	DeleteRange(ctx context.Context, from, to BridgeRequestIndexKey) error
+
+	doNotImplement()
+}
+
+type BridgeRequestIterator struct {
+	ormtable.Iterator
+}
+
+func (i BridgeRequestIterator) Value() (*BridgeRequest, error) {
+	var bridgeRequest BridgeRequest
+	err := i.UnmarshalMessage(&bridgeRequest)
+	return &bridgeRequest, err
+}
+
+type BridgeRequestIndexKey interface {
+	id() uint32
+	values() []interface{}
+	bridgeRequestIndexKey()
+}
+
+// primary key starting index..
+type BridgeRequestPrimaryKey = BridgeRequestIdIndexKey
+
+type BridgeRequestIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x BridgeRequestIdIndexKey) id() uint32             { return 0 }
+func (x BridgeRequestIdIndexKey) values() []interface{}  { return x.vs }
+func (x BridgeRequestIdIndexKey) bridgeRequestIndexKey() {}
+
+func (this BridgeRequestIdIndexKey) WithId(id uint64) BridgeRequestIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type BridgeRequestOwnerIndexKey struct {
+	vs []interface{}
+}
+
+func (x BridgeRequestOwnerIndexKey) id() uint32             { return 1 }
+func (x BridgeRequestOwnerIndexKey) values() []interface{}  { return x.vs }
+func (x BridgeRequestOwnerIndexKey) bridgeRequestIndexKey() {}
+
+func (this BridgeRequestOwnerIndexKey) WithOwner(owner []byte) BridgeRequestOwnerIndexKey {
+	this.vs = []interface{}{owner}
+	return this
+}
+
+type BridgeRequestStatusIndexKey struct {
+	vs []interface{}
+}
+
+func (x BridgeRequestStatusIndexKey) id() uint32             { return 2 }
+func (x BridgeRequestStatusIndexKey) values() []interface{}  { return x.vs }
+func (x BridgeRequestStatusIndexKey) bridgeRequestIndexKey() {}
+
+func (this BridgeRequestStatusIndexKey) WithStatus(status uint32) BridgeRequestStatusIndexKey {
+	this.vs = []interface{}{status}
+	return this
+}
+
+type bridgeRequestTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this bridgeRequestTable) Insert(ctx context.Context, bridgeRequest *BridgeRequest) error {
+	return this.table.Insert(ctx, bridgeRequest)
+}
+
+func (this bridgeRequestTable) Update(ctx context.Context, bridgeRequest *BridgeRequest) error {
+	return this.table.Update(ctx, bridgeRequest)
+}
+
+func (this bridgeRequestTable) Save(ctx context.Context, bridgeRequest *BridgeRequest) error {
+	return this.table.Save(ctx, bridgeRequest)
+}
+
+func (this bridgeRequestTable) Delete(ctx context.Context, bridgeRequest *BridgeRequest) error {
+	return this.table.Delete(ctx, bridgeRequest)
+}
+
+func (this bridgeRequestTable) InsertReturningID(ctx context.Context, bridgeRequest *BridgeRequest) (uint64, error) {
+	return this.table.InsertReturningID(ctx, bridgeRequest)
+}
+
+func (this bridgeRequestTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this bridgeRequestTable) Get(ctx context.Context, id uint64) (*BridgeRequest, error) {
+	var bridgeRequest BridgeRequest
+	found, err := this.table.PrimaryKey().Get(ctx, &bridgeRequest, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &bridgeRequest, nil
+}
+
+func (this bridgeRequestTable) List(ctx context.Context, prefixKey BridgeRequestIndexKey, opts ...ormlist.Option) (BridgeRequestIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return BridgeRequestIterator{it}, err
+}
+
+func (this bridgeRequestTable) ListRange(ctx context.Context, from, to BridgeRequestIndexKey, opts ...ormlist.Option) (BridgeRequestIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return BridgeRequestIterator{it}, err
+}
+
+func (this bridgeRequestTable) DeleteBy(ctx context.Context, prefixKey BridgeRequestIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this bridgeRequestTable) DeleteRange(ctx context.Context, from, to BridgeRequestIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this bridgeRequestTable) doNotImplement() {}
+
+var _ BridgeRequestTable = bridgeRequestTable{}
+
+func NewBridgeRequestTable(db ormtable.Schema) (BridgeRequestTable, error) {
+	table := db.GetTable(&BridgeRequest{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BridgeRequest{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return bridgeRequestTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type AllowedBridgeServiceTable interface {
+	Insert(ctx context.Context, allowedBridgeService *AllowedBridgeService) error
+	Update(ctx context.Context, allowedBridgeService *AllowedBridgeService) error
+	Save(ctx context.Context, allowedBridgeService *AllowedBridgeService) error
+	Delete(ctx context.Context, allowedBridgeService *AllowedBridgeService) error
+	Has(ctx context.Context, chain_name string, address []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, chain_name string, address []byte) (*AllowedBridgeService, error)
+	List(ctx context.Context, prefixKey AllowedBridgeServiceIndexKey, opts ...ormlist.Option) (AllowedBridgeServiceIterator, error)
+	ListRange(ctx context.Context, from, to AllowedBridgeServiceIndexKey, opts ...ormlist.Option) (AllowedBridgeServiceIterator, error)
+	DeleteBy(ctx context.Context, prefixKey AllowedBridgeServiceIndexKey) error
+	DeleteRange(ctx context.Context, from, to AllowedBridgeServiceIndexKey) error
+
+	doNotImplement()
+}
+
+type AllowedBridgeServiceIterator struct {
+	ormtable.Iterator
+}
+
+func (i AllowedBridgeServiceIterator) Value() (*AllowedBridgeService, error) {
+	var allowedBridgeService AllowedBridgeService
+	err := i.UnmarshalMessage(&allowedBridgeService)
+	return &allowedBridgeService, err
+}
+
+type AllowedBridgeServiceIndexKey interface {
+	id() uint32
+	values() []interface{}
+	allowedBridgeServiceIndexKey()
+}
+
+// primary key starting index..
+type AllowedBridgeServicePrimaryKey = AllowedBridgeServiceChainNameAddressIndexKey
+
+type AllowedBridgeServiceChainNameAddressIndexKey struct {
+	vs []interface{}
+}
+
+func (x AllowedBridgeServiceChainNameAddressIndexKey) id() uint32                    { return 0 }
+func (x AllowedBridgeServiceChainNameAddressIndexKey) values() []interface{}         { return x.vs }
+func (x AllowedBridgeServiceChainNameAddressIndexKey) allowedBridgeServiceIndexKey() {}
+
+func (this AllowedBridgeServiceChainNameAddressIndexKey) WithChainName(chain_name string) AllowedBridgeServiceChainNameAddressIndexKey {
+	this.vs = []interface{}{chain_name}
+	return this
+}
+
+func (this AllowedBridgeServiceChainNameAddressIndexKey) WithChainNameAddress(chain_name string, address []byte) AllowedBridgeServiceChainNameAddressIndexKey {
+	this.vs = []interface{}{chain_name, address}
+	return this
+}
+
+type allowedBridgeServiceTable struct {
+	table ormtable.Table
+}
+
+func (this allowedBridgeServiceTable) Insert(ctx context.Context, allowedBridgeService *AllowedBridgeService) error {
+	return this.table.Insert(ctx, allowedBridgeService)
+}
+
+func (this allowedBridgeServiceTable) Update(ctx context.Context, allowedBridgeService *AllowedBridgeService) error {
+	return this.table.Update(ctx, allowedBridgeService)
+}
+
+func (this allowedBridgeServiceTable) Save(ctx context.Context, allowedBridgeService *AllowedBridgeService) error {
+	return this.table.Save(ctx, allowedBridgeService)
+}
+
+func (this allowedBridgeServiceTable) Delete(ctx context.Context, allowedBridgeService *AllowedBridgeService) error {
+	return this.table.Delete(ctx, allowedBridgeService)
+}
+
+func (this allowedBridgeServiceTable) Has(ctx context.Context, chain_name string, address []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, chain_name, address)
+}
+
+func (this allowedBridgeServiceTable) Get(ctx context.Context, chain_name string, address []byte) (*AllowedBridgeService, error) {
+	var allowedBridgeService AllowedBridgeService
+	found, err := this.table.PrimaryKey().Get(ctx, &allowedBridgeService, chain_name, address)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &allowedBridgeService, nil
+}
+
+func (this allowedBridgeServiceTable) List(ctx context.Context, prefixKey AllowedBridgeServiceIndexKey, opts ...ormlist.Option) (AllowedBridgeServiceIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return AllowedBridgeServiceIterator{it}, err
+}
+
+func (this allowedBridgeServiceTable) ListRange(ctx context.Context, from, to AllowedBridgeServiceIndexKey, opts ...ormlist.Option) (AllowedBridgeServiceIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return AllowedBridgeServiceIterator{it}, err
+}
+
+func (this allowedBridgeServiceTable) DeleteBy(ctx context.Context, prefixKey AllowedBridgeServiceIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this allowedBridgeServiceTable) DeleteRange(ctx context.Context, from, to AllowedBridgeServiceIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this allowedBridgeServiceTable) doNotImplement() {}
+
+var _ AllowedBridgeServiceTable = allowedBridgeServiceTable{}
+
+func NewAllowedBridgeServiceTable(db ormtable.Schema) (AllowedBridgeServiceTable, error) {
+	table := db.GetTable(&AllowedBridgeService{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&AllowedBridgeService{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return allowedBridgeServiceTable{table}, nil
+}
+
+type AllowedBridgeContractTable interface {
+	Insert(ctx context.Context, allowedBridgeContract *AllowedBridgeContract) error
+	Update(ctx context.Context, allowedBridgeContract *AllowedBridgeContract) error
+	Save(ctx context.Context, allowedBridgeContract *AllowedBridgeContract) error
+	Delete(ctx context.Context, allowedBridgeContract *AllowedBridgeContract) error
+	Has(ctx context.Context, chain_name string, contract string) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, chain_name string, contract string) (*AllowedBridgeContract, error)
+	List(ctx context.Context, prefixKey AllowedBridgeContractIndexKey, opts ...ormlist.Option) (AllowedBridgeContractIterator, error)
+	ListRange(ctx context.Context, from, to AllowedBridgeContractIndexKey, opts ...ormlist.Option) (AllowedBridgeContractIterator, error)
+	DeleteBy(ctx context.Context, prefixKey AllowedBridgeContractIndexKey) error
+	DeleteRange(ctx context.Context, from, to AllowedBridgeContractIndexKey) error
+
+	doNotImplement()
+}
+
+type AllowedBridgeContractIterator struct {
+	ormtable.Iterator
+}
+
+func (i AllowedBridgeContractIterator) Value() (*AllowedBridgeContract, error) {
+	var allowedBridgeContract AllowedBridgeContract
+	err := i.UnmarshalMessage(&allowedBridgeContract)
+	return &allowedBridgeContract, err
+}
+
+type AllowedBridgeContractIndexKey interface {
+	id() uint32
+	values() []interface{}
+	allowedBridgeContractIndexKey()
+}
+
+// primary key starting index..
+type AllowedBridgeContractPrimaryKey = AllowedBridgeContractChainNameContractIndexKey
+
+type AllowedBridgeContractChainNameContractIndexKey struct {
+	vs []interface{}
+}
+
+func (x AllowedBridgeContractChainNameContractIndexKey) id() uint32                     { return 0 }
+func (x AllowedBridgeContractChainNameContractIndexKey) values() []interface{}          { return x.vs }
+func (x AllowedBridgeContractChainNameContractIndexKey) allowedBridgeContractIndexKey() {}
+
+func (this AllowedBridgeContractChainNameContractIndexKey) WithChainName(chain_name string) AllowedBridgeContractChainNameContractIndexKey {
+	this.vs = []interface{}{chain_name}
+	return this
+}
+
+func (this AllowedBridgeContractChainNameContractIndexKey) WithChainNameContract(chain_name string, contract string) AllowedBridgeContractChainNameContractIndexKey {
+	this.vs = []interface{}{chain_name, contract}
+	return this
+}
+
+type allowedBridgeContractTable struct {
+	table ormtable.Table
+}
+
+func (this allowedBridgeContractTable) Insert(ctx context.Context, allowedBridgeContract *AllowedBridgeContract) error {
+	return this.table.Insert(ctx, allowedBridgeContract)
+}
+
+func (this allowedBridgeContractTable) Update(ctx context.Context, allowedBridgeContract *AllowedBridgeContract) error {
+	return this.table.Update(ctx, allowedBridgeContract)
+}
+
+func (this allowedBridgeContractTable) Save(ctx context.Context, allowedBridgeContract *AllowedBridgeContract) error {
+	return this.table.Save(ctx, allowedBridgeContract)
+}
+
+func (this allowedBridgeContractTable) Delete(ctx context.Context, allowedBridgeContract *AllowedBridgeContract) error {
+	return this.table.Delete(ctx, allowedBridgeContract)
+}
+
+func (this allowedBridgeContractTable) Has(ctx context.Context, chain_name string, contract string) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, chain_name, contract)
+}
+
+func (this allowedBridgeContractTable) Get(ctx context.Context, chain_name string, contract string) (*AllowedBridgeContract, error) {
+	var allowedBridgeContract AllowedBridgeContract
+	found, err := this.table.PrimaryKey().Get(ctx, &allowedBridgeContract, chain_name, contract)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &allowedBridgeContract, nil
+}
+
+func (this allowedBridgeContractTable) List(ctx context.Context, prefixKey AllowedBridgeContractIndexKey, opts ...ormlist.Option) (AllowedBridgeContractIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return AllowedBridgeContractIterator{it}, err
+}
+
+func (this allowedBridgeContractTable) ListRange(ctx context.Context, from, to AllowedBridgeContractIndexKey, opts ...ormlist.Option) (AllowedBridgeContractIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return AllowedBridgeContractIterator{it}, err
+}
+
+func (this allowedBridgeContractTable) DeleteBy(ctx context.Context, prefixKey AllowedBridgeContractIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this allowedBridgeContractTable) DeleteRange(ctx context.Context, from, to AllowedBridgeContractIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this allowedBridgeContractTable) doNotImplement() {}
+
+var _ AllowedBridgeContractTable = allowedBridgeContractTable{}
+
+func NewAllowedBridgeContractTable(db ormtable.Schema) (AllowedBridgeContractTable, error) {
+	table := db.GetTable(&AllowedBridgeContract{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&AllowedBridgeContract{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return allowedBridgeContractTable{table}, nil
+}
+
+type StateStore interface {
+	CreditTypeTable() CreditTypeTable
+	ClassTable() ClassTable
+	ClassIssuerTable() ClassIssuerTable
+	ProjectTable() ProjectTable
+	BatchTable() BatchTable
+	ClassSequenceTable() ClassSequenceTable
+	ProjectSequenceTable() ProjectSequenceTable
+	BatchSequenceTable() BatchSequenceTable
+	BatchBalanceTable() BatchBalanceTable
+	BatchSupplyTable() BatchSupplyTable
+	OriginTxIndexTable() OriginTxIndexTable
+	BatchContractTable() BatchContractTable
+	ClassCreatorAllowlistTable() ClassCreatorAllowlistTable
+	AllowedClassCreatorTable() AllowedClassCreatorTable
+	ClassFeeTable() ClassFeeTable
+	AllowedBridgeChainTable() AllowedBridgeChainTable
+	RetirementTable() RetirementTable
+	RetirementCertificateTable() RetirementCertificateTable
+	BatchAllowanceTable() BatchAllowanceTable
+	ClassAllowanceTable() ClassAllowanceTable
+	CreditLockTable() CreditLockTable
+	ClassBufferTable() ClassBufferTable
+	ReversalTable() ReversalTable
+	ClassVerifierTable() ClassVerifierTable
+	ProjectLifecycleTable() ProjectLifecycleTable
+	ProjectStatusChangeTable() ProjectStatusChangeTable
+	ClassIssuanceCapTable() ClassIssuanceCapTable
+	ProjectVintageIssuanceTable() ProjectVintageIssuanceTable
+	ClassBatchApprovalTable() ClassBatchApprovalTable
+	PendingBatchTable() PendingBatchTable
+	ClassBatchMetadataCosignTable() ClassBatchMetadataCosignTable
+	BatchMetadataChangeTable() BatchMetadataChangeTable
+	ProjectAliasTable() ProjectAliasTable
+	ClassLifecycleTable() ClassLifecycleTable
+	ProjectSupplyTable() ProjectSupplyTable
+	ClassSupplyTable() ClassSupplyTable
+	CreditTypeSupplyTable() CreditTypeSupplyTable
+	BatchBalanceIndexTable() BatchBalanceIndexTable
+	ProjectHolderTable() ProjectHolderTable
+	RetirementSummaryTable() RetirementSummaryTable
+	StrictJurisdictionsTable() StrictJurisdictionsTable
+	NationalRegistryTable() NationalRegistryTable
+	Article6AuthorizationTable() Article6AuthorizationTable
+	ClassSerializationTable() ClassSerializationTable
+	BatchSerialSequenceTable() BatchSerialSequenceTable
+	SerialRangeTable() SerialRangeTable
+	NativeBatchContractTable() NativeBatchContractTable
+	BridgeRequestTable() BridgeRequestTable
+	AllowedBridgeServiceTable() AllowedBridgeServiceTable
+	AllowedBridgeContractTable() AllowedBridgeContractTable
+
+	doNotImplement()
+}
+
+type stateStore struct {
+	creditType               CreditTypeTable
+	class                    ClassTable
+	classIssuer              ClassIssuerTable
+	project                  ProjectTable
+	batch                    BatchTable
+	classSequence            ClassSequenceTable
+	projectSequence          ProjectSequenceTable
+	batchSequence            BatchSequenceTable
+	batchBalance             BatchBalanceTable
+	batchSupply              BatchSupplyTable
+	originTxIndex            OriginTxIndexTable
+	batchContract            BatchContractTable
+	classCreatorAllowlist    ClassCreatorAllowlistTable
+	allowedClassCreator      AllowedClassCreatorTable
+	classFee                 ClassFeeTable
+	allowedBridgeChain       AllowedBridgeChainTable
+	retirement               RetirementTable
+	retirementCertificate    RetirementCertificateTable
+	batchAllowance           BatchAllowanceTable
+	classAllowance           ClassAllowanceTable
+	creditLock               CreditLockTable
+	classBuffer              ClassBufferTable
+	reversal                 ReversalTable
+	classVerifier            ClassVerifierTable
+	projectLifecycle         ProjectLifecycleTable
+	projectStatusChange      ProjectStatusChangeTable
+	classIssuanceCap         ClassIssuanceCapTable
+	projectVintageIssuance   ProjectVintageIssuanceTable
+	classBatchApproval       ClassBatchApprovalTable
+	pendingBatch             PendingBatchTable
+	classBatchMetadataCosign ClassBatchMetadataCosignTable
+	batchMetadataChange      BatchMetadataChangeTable
+	projectAlias             ProjectAliasTable
+	classLifecycle           ClassLifecycleTable
+	projectSupply            ProjectSupplyTable
+	classSupply              ClassSupplyTable
+	creditTypeSupply         CreditTypeSupplyTable
+	batchBalanceIndex        BatchBalanceIndexTable
+	projectHolder            ProjectHolderTable
+	retirementSummary        RetirementSummaryTable
+	strictJurisdictions      StrictJurisdictionsTable
+	nationalRegistry         NationalRegistryTable
+	article6Authorization    Article6AuthorizationTable
+	classSerialization       ClassSerializationTable
+	batchSerialSequence      BatchSerialSequenceTable
+	serialRange              SerialRangeTable
+	nativeBatchContract      NativeBatchContractTable
+	bridgeRequest            BridgeRequestTable
+	allowedBridgeService     AllowedBridgeServiceTable
+	allowedBridgeContract    AllowedBridgeContractTable
+}
+
+func (x stateStore) CreditTypeTable() CreditTypeTable {
+	return x.creditType
+}
+
+func (x stateStore) ClassTable() ClassTable {
+	return x.class
+}
+
+func (x stateStore) ClassIssuerTable() ClassIssuerTable {
+	return x.classIssuer
+}
+
+func (x stateStore) ProjectTable() ProjectTable {
+	return x.project
+}
+
+func (x stateStore) BatchTable() BatchTable {
+	return x.batch
+}
+
+func (x stateStore) ClassSequenceTable() ClassSequenceTable {
+	return x.classSequence
+}
+
+func (x stateStore) ProjectSequenceTable() ProjectSequenceTable {
+	return x.projectSequence
+}
+
+func (x stateStore) BatchSequenceTable() BatchSequenceTable {
+	return x.batchSequence
+}
+
+func (x stateStore) BatchBalanceTable() BatchBalanceTable {
+	return x.batchBalance
+}
+
+func (x stateStore) BatchSupplyTable() BatchSupplyTable {
+	return x.batchSupply
+}
+
+func (x stateStore) OriginTxIndexTable() OriginTxIndexTable {
+	return x.originTxIndex
+}
+
+func (x stateStore) BatchContractTable() BatchContractTable {
+	return x.batchContract
+}
+
+func (x stateStore) ClassCreatorAllowlistTable() ClassCreatorAllowlistTable {
+	return x.classCreatorAllowlist
+}
+
+func (x stateStore) AllowedClassCreatorTable() AllowedClassCreatorTable {
+	return x.allowedClassCreator
+}
+
+func (x stateStore) ClassFeeTable() ClassFeeTable {
+	return x.classFee
+}
+
+func (x stateStore) AllowedBridgeChainTable() AllowedBridgeChainTable {
+	return x.allowedBridgeChain
+}
+
+func (x stateStore) RetirementTable() RetirementTable {
+	return x.retirement
+}
+
+func (x stateStore) RetirementCertificateTable() RetirementCertificateTable {
+	return x.retirementCertificate
+}
+
+func (x stateStore) BatchAllowanceTable() BatchAllowanceTable {
+	return x.batchAllowance
+}
+
+func (x stateStore) ClassAllowanceTable() ClassAllowanceTable {
+	return x.classAllowance
+}
+
+func (x stateStore) CreditLockTable() CreditLockTable {
+	return x.creditLock
+}
+
+func (x stateStore) ClassBufferTable() ClassBufferTable {
+	return x.classBuffer
+}
+
+func (x stateStore) ReversalTable() ReversalTable {
+	return x.reversal
+}
+
+func (x stateStore) ClassVerifierTable() ClassVerifierTable {
+	return x.classVerifier
+}
+
+func (x stateStore) ProjectLifecycleTable() ProjectLifecycleTable {
+	return x.projectLifecycle
+}
+
+func (x stateStore) ProjectStatusChangeTable() ProjectStatusChangeTable {
+	return x.projectStatusChange
+}
+
+func (x stateStore) ClassIssuanceCapTable() ClassIssuanceCapTable {
+	return x.classIssuanceCap
+}
+
+func (x stateStore) ProjectVintageIssuanceTable() ProjectVintageIssuanceTable {
+	return x.projectVintageIssuance
+}
+
+func (x stateStore) ClassBatchApprovalTable() ClassBatchApprovalTable {
+	return x.classBatchApproval
+}
+
+func (x stateStore) PendingBatchTable() PendingBatchTable {
+	return x.pendingBatch
+}
+
+func (x stateStore) ClassBatchMetadataCosignTable() ClassBatchMetadataCosignTable {
+	return x.classBatchMetadataCosign
+}
+
+func (x stateStore) BatchMetadataChangeTable() BatchMetadataChangeTable {
+	return x.batchMetadataChange
+}
+
+func (x stateStore) ProjectAliasTable() ProjectAliasTable {
+	return x.projectAlias
+}
+
+func (x stateStore) ClassLifecycleTable() ClassLifecycleTable {
+	return x.classLifecycle
+}
+
+func (x stateStore) ProjectSupplyTable() ProjectSupplyTable {
+	return x.projectSupply
+}
+
+func (x stateStore) ClassSupplyTable() ClassSupplyTable {
+	return x.classSupply
+}
+
+func (x stateStore) CreditTypeSupplyTable() CreditTypeSupplyTable {
+	return x.creditTypeSupply
+}
+
+func (x stateStore) BatchBalanceIndexTable() BatchBalanceIndexTable {
+	return x.batchBalanceIndex
+}
+
+func (x stateStore) ProjectHolderTable() ProjectHolderTable {
+	return x.projectHolder
+}
+
+func (x stateStore) RetirementSummaryTable() RetirementSummaryTable {
+	return x.retirementSummary
+}
+
+func (x stateStore) StrictJurisdictionsTable() StrictJurisdictionsTable {
+	return x.strictJurisdictions
+}
+
+func (x stateStore) NationalRegistryTable() NationalRegistryTable {
+	return x.nationalRegistry
+}
+
+func (x stateStore) Article6AuthorizationTable() Article6AuthorizationTable {
+	return x.article6Authorization
+}
+
+func (x stateStore) ClassSerializationTable() ClassSerializationTable {
+	return x.classSerialization
+}
+
+func (x stateStore) BatchSerialSequenceTable() BatchSerialSequenceTable {
+	return x.batchSerialSequence
+}
+
+func (x stateStore) SerialRangeTable() SerialRangeTable {
+	return x.serialRange
+}
+
+func (x stateStore) NativeBatchContractTable() NativeBatchContractTable {
+	return x.nativeBatchContract
+}
+
+func (x stateStore) BridgeRequestTable() BridgeRequestTable {
+	return x.bridgeRequest
+}
+
+func (x stateStore) AllowedBridgeServiceTable() AllowedBridgeServiceTable {
+	return x.allowedBridgeService
+}
+
+func (x stateStore) AllowedBridgeContractTable() AllowedBridgeContractTable {
+	return x.allowedBridgeContract
+}
+
+func (stateStore) doNotImplement() {}
+
+var _ StateStore = stateStore{}
+
+func NewStateStore(db ormtable.Schema) (StateStore, error) {
+	creditTypeTable, err := NewCreditTypeTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	classTable, err := NewClassTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	classIssuerTable, err := NewClassIssuerTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	projectTable, err := NewProjectTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	batchTable, err := NewBatchTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	classSequenceTable, err := NewClassSequenceTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	projectSequenceTable, err := NewProjectSequenceTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	batchSequenceTable, err := NewBatchSequenceTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	batchBalanceTable, err := NewBatchBalanceTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	batchSupplyTable, err := NewBatchSupplyTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	originTxIndexTable, err := NewOriginTxIndexTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	batchContractTable, err := NewBatchContractTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	classCreatorAllowlistTable, err := NewClassCreatorAllowlistTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedClassCreatorTable, err := NewAllowedClassCreatorTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	classFeeTable, err := NewClassFeeTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedBridgeChainTable, err := NewAllowedBridgeChainTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	retirementTable, err := NewRetirementTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	retirementCertificateTable, err := NewRetirementCertificateTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	batchAllowanceTable, err := NewBatchAllowanceTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	classAllowanceTable, err := NewClassAllowanceTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	creditLockTable, err := NewCreditLockTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	classBufferTable, err := NewClassBufferTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	reversalTable, err := NewReversalTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	classVerifierTable, err := NewClassVerifierTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	projectLifecycleTable, err := NewProjectLifecycleTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	projectStatusChangeTable, err := NewProjectStatusChangeTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	classIssuanceCapTable, err := NewClassIssuanceCapTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	projectVintageIssuanceTable, err := NewProjectVintageIssuanceTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	classBatchApprovalTable, err := NewClassBatchApprovalTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	pendingBatchTable, err := NewPendingBatchTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	classBatchMetadataCosignTable, err := NewClassBatchMetadataCosignTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	batchMetadataChangeTable, err := NewBatchMetadataChangeTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	projectAliasTable, err := NewProjectAliasTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	classLifecycleTable, err := NewClassLifecycleTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	projectSupplyTable, err := NewProjectSupplyTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	classSupplyTable, err := NewClassSupplyTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	creditTypeSupplyTable, err := NewCreditTypeSupplyTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	batchBalanceIndexTable, err := NewBatchBalanceIndexTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	projectHolderTable, err := NewProjectHolderTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	retirementSummaryTable, err := NewRetirementSummaryTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	strictJurisdictionsTable, err := NewStrictJurisdictionsTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	nationalRegistryTable, err := NewNationalRegistryTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	article6AuthorizationTable, err := NewArticle6AuthorizationTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	classSerializationTable, err := NewClassSerializationTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	batchSerialSequenceTable, err := NewBatchSerialSequenceTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	serialRangeTable, err := NewSerialRangeTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	nativeBatchContractTable, err := NewNativeBatchContractTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	bridgeRequestTable, err := NewBridgeRequestTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedBridgeServiceTable, err := NewAllowedBridgeServiceTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedBridgeContractTable, err := NewAllowedBridgeContractTable(db)
 	if err != nil {
 		return nil, err
 	}
@@ -2264,5 +6924,39 @@ func NewStateStore(db ormtable.Schema) (StateStore, error) {
 		allowedClassCreatorTable,
 		classFeeTable,
 		allowedBridgeChainTable,
+		retirementTable,
+		retirementCertificateTable,
+		batchAllowanceTable,
+		classAllowanceTable,
+		creditLockTable,
+		classBufferTable,
+		reversalTable,
+		classVerifierTable,
+		projectLifecycleTable,
+		projectStatusChangeTable,
+		classIssuanceCapTable,
+		projectVintageIssuanceTable,
+		classBatchApprovalTable,
+		pendingBatchTable,
+		classBatchMetadataCosignTable,
+		batchMetadataChangeTable,
+		projectAliasTable,
+		classLifecycleTable,
+		projectSupplyTable,
+		classSupplyTable,
+		creditTypeSupplyTable,
+		batchBalanceIndexTable,
+		projectHolderTable,
+		retirementSummaryTable,
+		strictJurisdictionsTable,
+		nationalRegistryTable,
+		article6AuthorizationTable,
+		classSerializationTable,
+		batchSerialSequenceTable,
+		serialRangeTable,
+		nativeBatchContractTable,
+		bridgeRequestTable,
+		allowedBridgeServiceTable,
+		allowedBridgeContractTable,
 	}, nil
 }
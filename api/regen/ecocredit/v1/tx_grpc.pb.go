@@ -92,7 +92,12 @@ type MsgClient interface {
 	UpdateProjectMetadata(ctx context.Context, in *MsgUpdateProjectMetadata, opts ...grpc.CallOption) (*MsgUpdateProjectMetadataResponse, error)
 	// Bridge processes credits being sent back to the source chain. When credits
 	// are sent back to the source chain, the credits are cancelled and an event
-	// is emitted to be handled by an external bridge service.
+	// is emitted to be handled by an external bridge service. Credits from a
+	// credit batch issued on Regen Ledger and linked to a contract on the target
+	// chain (see Msg/LinkBatchContract) are locked in the bridge escrow account
+	// of the target chain rather than cancelled. A pending bridge request is
+	// recorded for each credit batch, which the bridge service acknowledges or
+	// fails (see Msg/AcknowledgeBridge and Msg/FailBridge).
 	Bridge(ctx context.Context, in *MsgBridge, opts ...grpc.CallOption) (*MsgBridgeResponse, error)
 	// BridgeReceive processes credits being sent from another chain. When the
 	// credits are sent from the same vintage as an existing credit batch within
@@ -100,7 +105,9 @@ type MsgClient interface {
 	// existing credit batch, otherwise the credits will be issued in a new credit
 	// batch. The new credit batch will be created under an existing project if a
 	// project with a matching reference id already exists within the scope of the
-	// credit class, otherwise a new project will be created.
+	// credit class, otherwise a new project will be created. The issuer must be
+	// an allowed bridge service of the source chain and the contract must be an
+	// allowed bridge contract of the source chain.
 	BridgeReceive(ctx context.Context, in *MsgBridgeReceive, opts ...grpc.CallOption) (*MsgBridgeReceiveResponse, error)
 	// AddCreditType is a governance method that allows the addition of new
 	// credit types to the network.
@@ -141,6 +148,231 @@ type MsgClient interface {
 	//
 	// Since Revision 1
 	RemoveAllowedBridgeChain(ctx context.Context, in *MsgRemoveAllowedBridgeChain, opts ...grpc.CallOption) (*MsgRemoveAllowedBridgeChainResponse, error)
+	// Approve sets the amount of tradable credits from a credit batch or from
+	// any credit batch within a credit class that a spender is allowed to send
+	// or retire on behalf of the credit owner. Approving an allowance that
+	// already exists overwrites the existing amount.
+	//
+	// Since Revision 1
+	Approve(ctx context.Context, in *MsgApprove, opts ...grpc.CallOption) (*MsgApproveResponse, error)
+	// RevokeApproval removes an allowance previously approved by the credit
+	// owner.
+	//
+	// Since Revision 1
+	RevokeApproval(ctx context.Context, in *MsgRevokeApproval, opts ...grpc.CallOption) (*MsgRevokeApprovalResponse, error)
+	// SendFrom sends tradable credits from the credit owner's account to another
+	// account on behalf of the credit owner. The amount of credits sent (both
+	// tradable and retired upon receipt) is deducted from the allowance of the
+	// spender. An allowance for the credit batch is used if one exists,
+	// otherwise an allowance for the credit class is used.
+	//
+	// Since Revision 1
+	SendFrom(ctx context.Context, in *MsgSendFrom, opts ...grpc.CallOption) (*MsgSendFromResponse, error)
+	// RetireFrom retires tradable credits of the credit owner on behalf of the
+	// credit owner. The amount of credits retired is deducted from the allowance
+	// of the spender. An allowance for the credit batch is used if one exists,
+	// otherwise an allowance for the credit class is used.
+	//
+	// Since Revision 1
+	RetireFrom(ctx context.Context, in *MsgRetireFrom, opts ...grpc.CallOption) (*MsgRetireFromResponse, error)
+	// SendLocked sends tradable credits from the sender's account to another
+	// account where they are locked according to a lock schedule. Locked credits
+	// cannot be transferred, retired, or cancelled until they unlock. Unlocked
+	// credits are moved into the tradable balance of the recipient at the
+	// beginning of each block.
+	//
+	// Since Revision 1
+	SendLocked(ctx context.Context, in *MsgSendLocked, opts ...grpc.CallOption) (*MsgSendLockedResponse, error)
+	// UpdateClassBuffer updates the percentage of the tradable credits of each
+	// issuance within a credit class that is sent to the buffer pool of the
+	// credit class. Only the admin of the credit class can update the buffer
+	// percentage. A buffer percentage of zero disables the buffer pool.
+	//
+	// Since Revision 1
+	UpdateClassBuffer(ctx context.Context, in *MsgUpdateClassBuffer, opts ...grpc.CallOption) (*MsgUpdateClassBufferResponse, error)
+	// ReverseCredits cancels credits from the buffer pool of a credit class to
+	// account for a named reversal event (e.g. a fire or other non-permanence
+	// event). A reversal record is stored for each reversal event. Only the
+	// admin of the credit class or the governance authority can reverse credits.
+	//
+	// Since Revision 1
+	ReverseCredits(ctx context.Context, in *MsgReverseCredits, opts ...grpc.CallOption) (*MsgReverseCreditsResponse, error)
+	// UpdateClassVerifiers updates the credit class verifier list. Verifiers are
+	// authorized to update the status of projects within the credit class. Only
+	// the admin of the credit class can update the verifier list.
+	//
+	// Since Revision 1
+	UpdateClassVerifiers(ctx context.Context, in *MsgUpdateClassVerifiers, opts ...grpc.CallOption) (*MsgUpdateClassVerifiersResponse, error)
+	// UpdateProjectStatus updates the lifecycle status of a project. Only the
+	// admin or a verifier of the credit class can update the status. Credits can
+	// only be issued for a project with an active status or a project whose
+	// status has never been updated. The status of a retired project cannot be
+	// updated.
+	//
+	// Since Revision 1
+	UpdateProjectStatus(ctx context.Context, in *MsgUpdateProjectStatus, opts ...grpc.CallOption) (*MsgUpdateProjectStatusResponse, error)
+	// UpdateClassBatchApproval updates whether or not the credit batches of a
+	// credit class must be approved before they are created. Only the admin of
+	// the credit class can update the setting. When batch approval is required,
+	// credit batches cannot be created with Msg/CreateBatch and must instead be
+	// proposed with Msg/ProposeBatch.
+	//
+	// Since Revision 1
+	UpdateClassBatchApproval(ctx context.Context, in *MsgUpdateClassBatchApproval, opts ...grpc.CallOption) (*MsgUpdateClassBatchApprovalResponse, error)
+	// ProposeBatch proposes a new credit batch for a credit class that requires
+	// batch approval. Only an issuer of the credit class can propose a credit
+	// batch. The proposed credit batch is stored as a pending batch until it is
+	// approved, rejected, or it expires.
+	//
+	// Since Revision 1
+	ProposeBatch(ctx context.Context, in *MsgProposeBatch, opts ...grpc.CallOption) (*MsgProposeBatchResponse, error)
+	// ApproveBatch approves a pending batch and creates the credit batch. Only
+	// the admin or a verifier of the credit class can approve a pending batch.
+	//
+	// Since Revision 1
+	ApproveBatch(ctx context.Context, in *MsgApproveBatch, opts ...grpc.CallOption) (*MsgApproveBatchResponse, error)
+	// RejectBatch rejects and removes a pending batch. Only the admin or a
+	// verifier of the credit class can reject a pending batch.
+	//
+	// Since Revision 1
+	RejectBatch(ctx context.Context, in *MsgRejectBatch, opts ...grpc.CallOption) (*MsgRejectBatchResponse, error)
+	// UpdateBatchMetadata updates the metadata of a credit batch. Only the issuer
+	// of the credit batch can update the metadata. If the credit class requires a
+	// co-signature, the admin of the credit class must also sign the message.
+	// Each update is recorded in the metadata history of the credit batch.
+	//
+	// Since Revision 1
+	UpdateBatchMetadata(ctx context.Context, in *MsgUpdateBatchMetadata, opts ...grpc.CallOption) (*MsgUpdateBatchMetadataResponse, error)
+	// UpdateClassBatchMetadataCosign updates whether or not the admin of a
+	// credit class must co-sign updates to the metadata of the credit batches of
+	// the credit class. Only the admin of the credit class can update the
+	// setting.
+	//
+	// Since Revision 1
+	UpdateClassBatchMetadataCosign(ctx context.Context, in *MsgUpdateClassBatchMetadataCosign, opts ...grpc.CallOption) (*MsgUpdateClassBatchMetadataCosignResponse, error)
+	// MigrateProject moves a project to a different credit class with the same
+	// credit type. The admin of the current credit class and the admin of the
+	// new credit class must both sign the message. The project is assigned a new
+	// project id generated from the new credit class and the previous project id
+	// remains resolvable as an alias. Existing credit batch denoms are not
+	// changed.
+	//
+	// Since Revision 1
+	MigrateProject(ctx context.Context, in *MsgMigrateProject, opts ...grpc.CallOption) (*MsgMigrateProjectResponse, error)
+	// UpdateClassStatus updates the status of a credit class. Only the admin of
+	// the credit class or the governance authority can update the status. New
+	// projects cannot be created within a credit class that is closed to new
+	// projects, closed to issuance, or deprecated. Credits cannot be issued
+	// within a credit class that is closed to issuance or deprecated, and
+	// credits from a deprecated credit class cannot be put into baskets.
+	//
+	// Since Revision 1
+	UpdateClassStatus(ctx context.Context, in *MsgUpdateClassStatus, opts ...grpc.CallOption) (*MsgUpdateClassStatusResponse, error)
+	// SetStrictJurisdictions is a governance method that updates the strict
+	// jurisdictions enabled setting. When enabled, the jurisdictions of new
+	// projects and retirements must exist in the ISO 3166-1 and ISO 3166-2
+	// datasets embedded in the module. When disabled, jurisdictions are only
+	// checked for the expected format.
+	//
+	// Since Revision 1
+	SetStrictJurisdictions(ctx context.Context, in *MsgSetStrictJurisdictions, opts ...grpc.CallOption) (*MsgSetStrictJurisdictionsResponse, error)
+	// AddNationalRegistry is a governance method that authorizes an account to
+	// act on behalf of the national registry of a country. National registries
+	// can set Article 6 authorizations for credits hosted within their country.
+	//
+	// Since Revision 1
+	AddNationalRegistry(ctx context.Context, in *MsgAddNationalRegistry, opts ...grpc.CallOption) (*MsgAddNationalRegistryResponse, error)
+	// RemoveNationalRegistry is a governance method that removes an account from
+	// the national registries of a country.
+	//
+	// Since Revision 1
+	RemoveNationalRegistry(ctx context.Context, in *MsgRemoveNationalRegistry, opts ...grpc.CallOption) (*MsgRemoveNationalRegistryResponse, error)
+	// SetArticle6Authorization sets the Article 6 authorization of a credit
+	// batch or of a single retirement of credits from a credit batch, recording
+	// the letter of authorization of the host country and whether a
+	// corresponding adjustment has been applied. The letter of authorization
+	// must be anchored in the data module. Only the admin of the credit class or
+	// a national registry of the host country can set the authorization, and an
+	// existing authorization is overwritten.
+	//
+	// Since Revision 1
+	SetArticle6Authorization(ctx context.Context, in *MsgSetArticle6Authorization, opts ...grpc.CallOption) (*MsgSetArticle6AuthorizationResponse, error)
+	// UpdateClassSerialization updates whether or not the credits issued within
+	// a credit class are identified by serial number ranges. Serialization can
+	// only be updated by the admin of the credit class before any credits have
+	// been issued within the credit class, and can only be enabled for credit
+	// classes with an integer-precision credit type.
+	//
+	// Since Revision 1
+	UpdateClassSerialization(ctx context.Context, in *MsgUpdateClassSerialization, opts ...grpc.CallOption) (*MsgUpdateClassSerializationResponse, error)
+	// LinkBatchContract links a credit batch issued on Regen Ledger to a
+	// contract on an allowed bridge chain so that credits from the credit batch
+	// can be bridged to the chain. Only the admin of the credit class can link a
+	// credit batch, and a credit batch can only be linked once. Credits from a
+	// linked credit batch are locked in a bridge escrow account when bridged
+	// rather than cancelled.
+	//
+	// Since Revision 1
+	LinkBatchContract(ctx context.Context, in *MsgLinkBatchContract, opts ...grpc.CallOption) (*MsgLinkBatchContractResponse, error)
+	// BridgeReturn processes credits from a linked credit batch being returned
+	// from the chain the credits were bridged to. The credits are unlocked from
+	// the bridge escrow account and sent to the recipient. Only an issuer of the
+	// credit class can process returned credits. Msg/BridgeReceive uses the same
+	// path when the origin contract is linked to a credit batch issued on Regen
+	// Ledger.
+	//
+	// Since Revision 1
+	BridgeReturn(ctx context.Context, in *MsgBridgeReturn, opts ...grpc.CallOption) (*MsgBridgeReturnResponse, error)
+	// AcknowledgeBridge marks a pending bridge request as completed once the
+	// credits have been received on the target chain. Only an allowed bridge
+	// service of the target chain can acknowledge a bridge request.
+	//
+	// Since Revision 1
+	AcknowledgeBridge(ctx context.Context, in *MsgAcknowledgeBridge, opts ...grpc.CallOption) (*MsgAcknowledgeBridgeResponse, error)
+	// FailBridge marks a pending bridge request as failed and returns the
+	// credits to the owner. Cancelled credits are restored to the tradable
+	// balance of the owner and locked credits are unlocked from the bridge
+	// escrow account. Only an allowed bridge service of the target chain can
+	// fail a bridge request.
+	//
+	// Since Revision 1
+	FailBridge(ctx context.Context, in *MsgFailBridge, opts ...grpc.CallOption) (*MsgFailBridgeResponse, error)
+	// AddAllowedBridgeService is a governance method that allows a bridge
+	// service address to bridge credits from an allowed bridge chain and to
+	// acknowledge and fail bridge requests to an allowed bridge chain.
+	//
+	// Since Revision 1
+	AddAllowedBridgeService(ctx context.Context, in *MsgAddAllowedBridgeService, opts ...grpc.CallOption) (*MsgAddAllowedBridgeServiceResponse, error)
+	// RemoveAllowedBridgeService is a governance method that removes a bridge
+	// service address from the allowed bridge services of a bridge chain.
+	//
+	// Since Revision 1
+	RemoveAllowedBridgeService(ctx context.Context, in *MsgRemoveAllowedBridgeService, opts ...grpc.CallOption) (*MsgRemoveAllowedBridgeServiceResponse, error)
+	// AddAllowedBridgeContract is a governance method that allows credits to be
+	// bridged from and to a contract on an allowed bridge chain. The contract
+	// must be an ethereum address encoded with the EIP-55 checksum.
+	//
+	// Since Revision 1
+	AddAllowedBridgeContract(ctx context.Context, in *MsgAddAllowedBridgeContract, opts ...grpc.CallOption) (*MsgAddAllowedBridgeContractResponse, error)
+	// RemoveAllowedBridgeContract is a governance method that removes a contract
+	// from the allowed bridge contracts of a bridge chain.
+	//
+	// Since Revision 1
+	RemoveAllowedBridgeContract(ctx context.Context, in *MsgRemoveAllowedBridgeContract, opts ...grpc.CallOption) (*MsgRemoveAllowedBridgeContractResponse, error)
+	// Wrap moves tradable credits from the balance of the owner into the wrap
+	// submodule escrow and mints bank tokens of the wrapped denom of the credit
+	// batch to the owner. Each credit batch has its own wrapped denom and the
+	// denom metadata is registered in the bank module using the precision of
+	// the credit type when credits from the credit batch are first wrapped.
+	//
+	// Since Revision 1
+	Wrap(ctx context.Context, in *MsgWrap, opts ...grpc.CallOption) (*MsgWrapResponse, error)
+	// Unwrap burns bank tokens of the wrapped denom of a credit batch held by
+	// the owner and moves the equivalent amount of credits from the wrap
+	// submodule escrow back to the tradable balance of the owner.
+	//
+	// Since Revision 1
+	Unwrap(ctx context.Context, in *MsgUnwrap, opts ...grpc.CallOption) (*MsgUnwrapResponse, error)
 }
 
 type msgClient struct {
@@ -349,6 +581,294 @@ func (c *msgClient) RemoveAllowedBridgeChain(ctx context.Context, in *MsgRemoveA
 	return out, nil
 }
 
+func (c *msgClient) Approve(ctx context.Context, in *MsgApprove, opts ...grpc.CallOption) (*MsgApproveResponse, error) {
+	out := new(MsgApproveResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/Approve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeApproval(ctx context.Context, in *MsgRevokeApproval, opts ...grpc.CallOption) (*MsgRevokeApprovalResponse, error) {
+	out := new(MsgRevokeApprovalResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/RevokeApproval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendFrom(ctx context.Context, in *MsgSendFrom, opts ...grpc.CallOption) (*MsgSendFromResponse, error) {
+	out := new(MsgSendFromResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/SendFrom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetireFrom(ctx context.Context, in *MsgRetireFrom, opts ...grpc.CallOption) (*MsgRetireFromResponse, error) {
+	out := new(MsgRetireFromResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/RetireFrom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendLocked(ctx context.Context, in *MsgSendLocked, opts ...grpc.CallOption) (*MsgSendLockedResponse, error) {
+	out := new(MsgSendLockedResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/SendLocked", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateClassBuffer(ctx context.Context, in *MsgUpdateClassBuffer, opts ...grpc.CallOption) (*MsgUpdateClassBufferResponse, error) {
+	out := new(MsgUpdateClassBufferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateClassBuffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReverseCredits(ctx context.Context, in *MsgReverseCredits, opts ...grpc.CallOption) (*MsgReverseCreditsResponse, error) {
+	out := new(MsgReverseCreditsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/ReverseCredits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateClassVerifiers(ctx context.Context, in *MsgUpdateClassVerifiers, opts ...grpc.CallOption) (*MsgUpdateClassVerifiersResponse, error) {
+	out := new(MsgUpdateClassVerifiersResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateClassVerifiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateProjectStatus(ctx context.Context, in *MsgUpdateProjectStatus, opts ...grpc.CallOption) (*MsgUpdateProjectStatusResponse, error) {
+	out := new(MsgUpdateProjectStatusResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateProjectStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateClassBatchApproval(ctx context.Context, in *MsgUpdateClassBatchApproval, opts ...grpc.CallOption) (*MsgUpdateClassBatchApprovalResponse, error) {
+	out := new(MsgUpdateClassBatchApprovalResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateClassBatchApproval", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeBatch(ctx context.Context, in *MsgProposeBatch, opts ...grpc.CallOption) (*MsgProposeBatchResponse, error) {
+	out := new(MsgProposeBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/ProposeBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveBatch(ctx context.Context, in *MsgApproveBatch, opts ...grpc.CallOption) (*MsgApproveBatchResponse, error) {
+	out := new(MsgApproveBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/ApproveBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RejectBatch(ctx context.Context, in *MsgRejectBatch, opts ...grpc.CallOption) (*MsgRejectBatchResponse, error) {
+	out := new(MsgRejectBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/RejectBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBatchMetadata(ctx context.Context, in *MsgUpdateBatchMetadata, opts ...grpc.CallOption) (*MsgUpdateBatchMetadataResponse, error) {
+	out := new(MsgUpdateBatchMetadataResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateBatchMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateClassBatchMetadataCosign(ctx context.Context, in *MsgUpdateClassBatchMetadataCosign, opts ...grpc.CallOption) (*MsgUpdateClassBatchMetadataCosignResponse, error) {
+	out := new(MsgUpdateClassBatchMetadataCosignResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateClassBatchMetadataCosign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateProject(ctx context.Context, in *MsgMigrateProject, opts ...grpc.CallOption) (*MsgMigrateProjectResponse, error) {
+	out := new(MsgMigrateProjectResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/MigrateProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateClassStatus(ctx context.Context, in *MsgUpdateClassStatus, opts ...grpc.CallOption) (*MsgUpdateClassStatusResponse, error) {
+	out := new(MsgUpdateClassStatusResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateClassStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetStrictJurisdictions(ctx context.Context, in *MsgSetStrictJurisdictions, opts ...grpc.CallOption) (*MsgSetStrictJurisdictionsResponse, error) {
+	out := new(MsgSetStrictJurisdictionsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/SetStrictJurisdictions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddNationalRegistry(ctx context.Context, in *MsgAddNationalRegistry, opts ...grpc.CallOption) (*MsgAddNationalRegistryResponse, error) {
+	out := new(MsgAddNationalRegistryResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/AddNationalRegistry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveNationalRegistry(ctx context.Context, in *MsgRemoveNationalRegistry, opts ...grpc.CallOption) (*MsgRemoveNationalRegistryResponse, error) {
+	out := new(MsgRemoveNationalRegistryResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/RemoveNationalRegistry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetArticle6Authorization(ctx context.Context, in *MsgSetArticle6Authorization, opts ...grpc.CallOption) (*MsgSetArticle6AuthorizationResponse, error) {
+	out := new(MsgSetArticle6AuthorizationResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/SetArticle6Authorization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateClassSerialization(ctx context.Context, in *MsgUpdateClassSerialization, opts ...grpc.CallOption) (*MsgUpdateClassSerializationResponse, error) {
+	out := new(MsgUpdateClassSerializationResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateClassSerialization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LinkBatchContract(ctx context.Context, in *MsgLinkBatchContract, opts ...grpc.CallOption) (*MsgLinkBatchContractResponse, error) {
+	out := new(MsgLinkBatchContractResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/LinkBatchContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BridgeReturn(ctx context.Context, in *MsgBridgeReturn, opts ...grpc.CallOption) (*MsgBridgeReturnResponse, error) {
+	out := new(MsgBridgeReturnResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/BridgeReturn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcknowledgeBridge(ctx context.Context, in *MsgAcknowledgeBridge, opts ...grpc.CallOption) (*MsgAcknowledgeBridgeResponse, error) {
+	out := new(MsgAcknowledgeBridgeResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/AcknowledgeBridge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FailBridge(ctx context.Context, in *MsgFailBridge, opts ...grpc.CallOption) (*MsgFailBridgeResponse, error) {
+	out := new(MsgFailBridgeResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/FailBridge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAllowedBridgeService(ctx context.Context, in *MsgAddAllowedBridgeService, opts ...grpc.CallOption) (*MsgAddAllowedBridgeServiceResponse, error) {
+	out := new(MsgAddAllowedBridgeServiceResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/AddAllowedBridgeService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAllowedBridgeService(ctx context.Context, in *MsgRemoveAllowedBridgeService, opts ...grpc.CallOption) (*MsgRemoveAllowedBridgeServiceResponse, error) {
+	out := new(MsgRemoveAllowedBridgeServiceResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/RemoveAllowedBridgeService", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddAllowedBridgeContract(ctx context.Context, in *MsgAddAllowedBridgeContract, opts ...grpc.CallOption) (*MsgAddAllowedBridgeContractResponse, error) {
+	out := new(MsgAddAllowedBridgeContractResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/AddAllowedBridgeContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveAllowedBridgeContract(ctx context.Context, in *MsgRemoveAllowedBridgeContract, opts ...grpc.CallOption) (*MsgRemoveAllowedBridgeContractResponse, error) {
+	out := new(MsgRemoveAllowedBridgeContractResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/RemoveAllowedBridgeContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Wrap(ctx context.Context, in *MsgWrap, opts ...grpc.CallOption) (*MsgWrapResponse, error) {
+	out := new(MsgWrapResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/Wrap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unwrap(ctx context.Context, in *MsgUnwrap, opts ...grpc.CallOption) (*MsgUnwrapResponse, error) {
+	out := new(MsgUnwrapResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/Unwrap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -423,7 +943,12 @@ type MsgServer interface {
 	UpdateProjectMetadata(context.Context, *MsgUpdateProjectMetadata) (*MsgUpdateProjectMetadataResponse, error)
 	// Bridge processes credits being sent back to the source chain. When credits
 	// are sent back to the source chain, the credits are cancelled and an event
-	// is emitted to be handled by an external bridge service.
+	// is emitted to be handled by an external bridge service. Credits from a
+	// credit batch issued on Regen Ledger and linked to a contract on the target
+	// chain (see Msg/LinkBatchContract) are locked in the bridge escrow account
+	// of the target chain rather than cancelled. A pending bridge request is
+	// recorded for each credit batch, which the bridge service acknowledges or
+	// fails (see Msg/AcknowledgeBridge and Msg/FailBridge).
 	Bridge(context.Context, *MsgBridge) (*MsgBridgeResponse, error)
 	// BridgeReceive processes credits being sent from another chain. When the
 	// credits are sent from the same vintage as an existing credit batch within
@@ -431,7 +956,9 @@ type MsgServer interface {
 	// existing credit batch, otherwise the credits will be issued in a new credit
 	// batch. The new credit batch will be created under an existing project if a
 	// project with a matching reference id already exists within the scope of the
-	// credit class, otherwise a new project will be created.
+	// credit class, otherwise a new project will be created. The issuer must be
+	// an allowed bridge service of the source chain and the contract must be an
+	// allowed bridge contract of the source chain.
 	BridgeReceive(context.Context, *MsgBridgeReceive) (*MsgBridgeReceiveResponse, error)
 	// AddCreditType is a governance method that allows the addition of new
 	// credit types to the network.
@@ -472,6 +999,231 @@ type MsgServer interface {
 	//
 	// Since Revision 1
 	RemoveAllowedBridgeChain(context.Context, *MsgRemoveAllowedBridgeChain) (*MsgRemoveAllowedBridgeChainResponse, error)
+	// Approve sets the amount of tradable credits from a credit batch or from
+	// any credit batch within a credit class that a spender is allowed to send
+	// or retire on behalf of the credit owner. Approving an allowance that
+	// already exists overwrites the existing amount.
+	//
+	// Since Revision 1
+	Approve(context.Context, *MsgApprove) (*MsgApproveResponse, error)
+	// RevokeApproval removes an allowance previously approved by the credit
+	// owner.
+	//
+	// Since Revision 1
+	RevokeApproval(context.Context, *MsgRevokeApproval) (*MsgRevokeApprovalResponse, error)
+	// SendFrom sends tradable credits from the credit owner's account to another
+	// account on behalf of the credit owner. The amount of credits sent (both
+	// tradable and retired upon receipt) is deducted from the allowance of the
+	// spender. An allowance for the credit batch is used if one exists,
+	// otherwise an allowance for the credit class is used.
+	//
+	// Since Revision 1
+	SendFrom(context.Context, *MsgSendFrom) (*MsgSendFromResponse, error)
+	// RetireFrom retires tradable credits of the credit owner on behalf of the
+	// credit owner. The amount of credits retired is deducted from the allowance
+	// of the spender. An allowance for the credit batch is used if one exists,
+	// otherwise an allowance for the credit class is used.
+	//
+	// Since Revision 1
+	RetireFrom(context.Context, *MsgRetireFrom) (*MsgRetireFromResponse, error)
+	// SendLocked sends tradable credits from the sender's account to another
+	// account where they are locked according to a lock schedule. Locked credits
+	// cannot be transferred, retired, or cancelled until they unlock. Unlocked
+	// credits are moved into the tradable balance of the recipient at the
+	// beginning of each block.
+	//
+	// Since Revision 1
+	SendLocked(context.Context, *MsgSendLocked) (*MsgSendLockedResponse, error)
+	// UpdateClassBuffer updates the percentage of the tradable credits of each
+	// issuance within a credit class that is sent to the buffer pool of the
+	// credit class. Only the admin of the credit class can update the buffer
+	// percentage. A buffer percentage of zero disables the buffer pool.
+	//
+	// Since Revision 1
+	UpdateClassBuffer(context.Context, *MsgUpdateClassBuffer) (*MsgUpdateClassBufferResponse, error)
+	// ReverseCredits cancels credits from the buffer pool of a credit class to
+	// account for a named reversal event (e.g. a fire or other non-permanence
+	// event). A reversal record is stored for each reversal event. Only the
+	// admin of the credit class or the governance authority can reverse credits.
+	//
+	// Since Revision 1
+	ReverseCredits(context.Context, *MsgReverseCredits) (*MsgReverseCreditsResponse, error)
+	// UpdateClassVerifiers updates the credit class verifier list. Verifiers are
+	// authorized to update the status of projects within the credit class. Only
+	// the admin of the credit class can update the verifier list.
+	//
+	// Since Revision 1
+	UpdateClassVerifiers(context.Context, *MsgUpdateClassVerifiers) (*MsgUpdateClassVerifiersResponse, error)
+	// UpdateProjectStatus updates the lifecycle status of a project. Only the
+	// admin or a verifier of the credit class can update the status. Credits can
+	// only be issued for a project with an active status or a project whose
+	// status has never been updated. The status of a retired project cannot be
+	// updated.
+	//
+	// Since Revision 1
+	UpdateProjectStatus(context.Context, *MsgUpdateProjectStatus) (*MsgUpdateProjectStatusResponse, error)
+	// UpdateClassBatchApproval updates whether or not the credit batches of a
+	// credit class must be approved before they are created. Only the admin of
+	// the credit class can update the setting. When batch approval is required,
+	// credit batches cannot be created with Msg/CreateBatch and must instead be
+	// proposed with Msg/ProposeBatch.
+	//
+	// Since Revision 1
+	UpdateClassBatchApproval(context.Context, *MsgUpdateClassBatchApproval) (*MsgUpdateClassBatchApprovalResponse, error)
+	// ProposeBatch proposes a new credit batch for a credit class that requires
+	// batch approval. Only an issuer of the credit class can propose a credit
+	// batch. The proposed credit batch is stored as a pending batch until it is
+	// approved, rejected, or it expires.
+	//
+	// Since Revision 1
+	ProposeBatch(context.Context, *MsgProposeBatch) (*MsgProposeBatchResponse, error)
+	// ApproveBatch approves a pending batch and creates the credit batch. Only
+	// the admin or a verifier of the credit class can approve a pending batch.
+	//
+	// Since Revision 1
+	ApproveBatch(context.Context, *MsgApproveBatch) (*MsgApproveBatchResponse, error)
+	// RejectBatch rejects and removes a pending batch. Only the admin or a
+	// verifier of the credit class can reject a pending batch.
+	//
+	// Since Revision 1
+	RejectBatch(context.Context, *MsgRejectBatch) (*MsgRejectBatchResponse, error)
+	// UpdateBatchMetadata updates the metadata of a credit batch. Only the issuer
+	// of the credit batch can update the metadata. If the credit class requires a
+	// co-signature, the admin of the credit class must also sign the message.
+	// Each update is recorded in the metadata history of the credit batch.
+	//
+	// Since Revision 1
+	UpdateBatchMetadata(context.Context, *MsgUpdateBatchMetadata) (*MsgUpdateBatchMetadataResponse, error)
+	// UpdateClassBatchMetadataCosign updates whether or not the admin of a
+	// credit class must co-sign updates to the metadata of the credit batches of
+	// the credit class. Only the admin of the credit class can update the
+	// setting.
+	//
+	// Since Revision 1
+	UpdateClassBatchMetadataCosign(context.Context, *MsgUpdateClassBatchMetadataCosign) (*MsgUpdateClassBatchMetadataCosignResponse, error)
+	// MigrateProject moves a project to a different credit class with the same
+	// credit type. The admin of the current credit class and the admin of the
+	// new credit class must both sign the message. The project is assigned a new
+	// project id generated from the new credit class and the previous project id
+	// remains resolvable as an alias. Existing credit batch denoms are not
+	// changed.
+	//
+	// Since Revision 1
+	MigrateProject(context.Context, *MsgMigrateProject) (*MsgMigrateProjectResponse, error)
+	// UpdateClassStatus updates the status of a credit class. Only the admin of
+	// the credit class or the governance authority can update the status. New
+	// projects cannot be created within a credit class that is closed to new
+	// projects, closed to issuance, or deprecated. Credits cannot be issued
+	// within a credit class that is closed to issuance or deprecated, and
+	// credits from a deprecated credit class cannot be put into baskets.
+	//
+	// Since Revision 1
+	UpdateClassStatus(context.Context, *MsgUpdateClassStatus) (*MsgUpdateClassStatusResponse, error)
+	// SetStrictJurisdictions is a governance method that updates the strict
+	// jurisdictions enabled setting. When enabled, the jurisdictions of new
+	// projects and retirements must exist in the ISO 3166-1 and ISO 3166-2
+	// datasets embedded in the module. When disabled, jurisdictions are only
+	// checked for the expected format.
+	//
+	// Since Revision 1
+	SetStrictJurisdictions(context.Context, *MsgSetStrictJurisdictions) (*MsgSetStrictJurisdictionsResponse, error)
+	// AddNationalRegistry is a governance method that authorizes an account to
+	// act on behalf of the national registry of a country. National registries
+	// can set Article 6 authorizations for credits hosted within their country.
+	//
+	// Since Revision 1
+	AddNationalRegistry(context.Context, *MsgAddNationalRegistry) (*MsgAddNationalRegistryResponse, error)
+	// RemoveNationalRegistry is a governance method that removes an account from
+	// the national registries of a country.
+	//
+	// Since Revision 1
+	RemoveNationalRegistry(context.Context, *MsgRemoveNationalRegistry) (*MsgRemoveNationalRegistryResponse, error)
+	// SetArticle6Authorization sets the Article 6 authorization of a credit
+	// batch or of a single retirement of credits from a credit batch, recording
+	// the letter of authorization of the host country and whether a
+	// corresponding adjustment has been applied. The letter of authorization
+	// must be anchored in the data module. Only the admin of the credit class or
+	// a national registry of the host country can set the authorization, and an
+	// existing authorization is overwritten.
+	//
+	// Since Revision 1
+	SetArticle6Authorization(context.Context, *MsgSetArticle6Authorization) (*MsgSetArticle6AuthorizationResponse, error)
+	// UpdateClassSerialization updates whether or not the credits issued within
+	// a credit class are identified by serial number ranges. Serialization can
+	// only be updated by the admin of the credit class before any credits have
+	// been issued within the credit class, and can only be enabled for credit
+	// classes with an integer-precision credit type.
+	//
+	// Since Revision 1
+	UpdateClassSerialization(context.Context, *MsgUpdateClassSerialization) (*MsgUpdateClassSerializationResponse, error)
+	// LinkBatchContract links a credit batch issued on Regen Ledger to a
+	// contract on an allowed bridge chain so that credits from the credit batch
+	// can be bridged to the chain. Only the admin of the credit class can link a
+	// credit batch, and a credit batch can only be linked once. Credits from a
+	// linked credit batch are locked in a bridge escrow account when bridged
+	// rather than cancelled.
+	//
+	// Since Revision 1
+	LinkBatchContract(context.Context, *MsgLinkBatchContract) (*MsgLinkBatchContractResponse, error)
+	// BridgeReturn processes credits from a linked credit batch being returned
+	// from the chain the credits were bridged to. The credits are unlocked from
+	// the bridge escrow account and sent to the recipient. Only an issuer of the
+	// credit class can process returned credits. Msg/BridgeReceive uses the same
+	// path when the origin contract is linked to a credit batch issued on Regen
+	// Ledger.
+	//
+	// Since Revision 1
+	BridgeReturn(context.Context, *MsgBridgeReturn) (*MsgBridgeReturnResponse, error)
+	// AcknowledgeBridge marks a pending bridge request as completed once the
+	// credits have been received on the target chain. Only an allowed bridge
+	// service of the target chain can acknowledge a bridge request.
+	//
+	// Since Revision 1
+	AcknowledgeBridge(context.Context, *MsgAcknowledgeBridge) (*MsgAcknowledgeBridgeResponse, error)
+	// FailBridge marks a pending bridge request as failed and returns the
+	// credits to the owner. Cancelled credits are restored to the tradable
+	// balance of the owner and locked credits are unlocked from the bridge
+	// escrow account. Only an allowed bridge service of the target chain can
+	// fail a bridge request.
+	//
+	// Since Revision 1
+	FailBridge(context.Context, *MsgFailBridge) (*MsgFailBridgeResponse, error)
+	// AddAllowedBridgeService is a governance method that allows a bridge
+	// service address to bridge credits from an allowed bridge chain and to
+	// acknowledge and fail bridge requests to an allowed bridge chain.
+	//
+	// Since Revision 1
+	AddAllowedBridgeService(context.Context, *MsgAddAllowedBridgeService) (*MsgAddAllowedBridgeServiceResponse, error)
+	// RemoveAllowedBridgeService is a governance method that removes a bridge
+	// service address from the allowed bridge services of a bridge chain.
+	//
+	// Since Revision 1
+	RemoveAllowedBridgeService(context.Context, *MsgRemoveAllowedBridgeService) (*MsgRemoveAllowedBridgeServiceResponse, error)
+	// AddAllowedBridgeContract is a governance method that allows credits to be
+	// bridged from and to a contract on an allowed bridge chain. The contract
+	// must be an ethereum address encoded with the EIP-55 checksum.
+	//
+	// Since Revision 1
+	AddAllowedBridgeContract(context.Context, *MsgAddAllowedBridgeContract) (*MsgAddAllowedBridgeContractResponse, error)
+	// RemoveAllowedBridgeContract is a governance method that removes a contract
+	// from the allowed bridge contracts of a bridge chain.
+	//
+	// Since Revision 1
+	RemoveAllowedBridgeContract(context.Context, *MsgRemoveAllowedBridgeContract) (*MsgRemoveAllowedBridgeContractResponse, error)
+	// Wrap moves tradable credits from the balance of the owner into the wrap
+	// submodule escrow and mints bank tokens of the wrapped denom of the credit
+	// batch to the owner. Each credit batch has its own wrapped denom and the
+	// denom metadata is registered in the bank module using the precision of
+	// the credit type when credits from the credit batch are first wrapped.
+	//
+	// Since Revision 1
+	Wrap(context.Context, *MsgWrap) (*MsgWrapResponse, error)
+	// Unwrap burns bank tokens of the wrapped denom of a credit batch held by
+	// the owner and moves the equivalent amount of credits from the wrap
+	// submodule escrow back to the tradable balance of the owner.
+	//
+	// Since Revision 1
+	Unwrap(context.Context, *MsgUnwrap) (*MsgUnwrapResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -536,420 +1288,1092 @@ func (UnimplementedMsgServer) AddClassCreator(context.Context, *MsgAddClassCreat
 func (UnimplementedMsgServer) RemoveClassCreator(context.Context, *MsgRemoveClassCreator) (*MsgRemoveClassCreatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveClassCreator not implemented")
 }
-func (UnimplementedMsgServer) UpdateClassFee(context.Context, *MsgUpdateClassFee) (*MsgUpdateClassFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassFee not implemented")
+func (UnimplementedMsgServer) UpdateClassFee(context.Context, *MsgUpdateClassFee) (*MsgUpdateClassFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassFee not implemented")
+}
+func (UnimplementedMsgServer) AddAllowedBridgeChain(context.Context, *MsgAddAllowedBridgeChain) (*MsgAddAllowedBridgeChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedBridgeChain not implemented")
+}
+func (UnimplementedMsgServer) RemoveAllowedBridgeChain(context.Context, *MsgRemoveAllowedBridgeChain) (*MsgRemoveAllowedBridgeChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowedBridgeChain not implemented")
+}
+func (UnimplementedMsgServer) Approve(context.Context, *MsgApprove) (*MsgApproveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Approve not implemented")
+}
+func (UnimplementedMsgServer) RevokeApproval(context.Context, *MsgRevokeApproval) (*MsgRevokeApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApproval not implemented")
+}
+func (UnimplementedMsgServer) SendFrom(context.Context, *MsgSendFrom) (*MsgSendFromResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendFrom not implemented")
+}
+func (UnimplementedMsgServer) RetireFrom(context.Context, *MsgRetireFrom) (*MsgRetireFromResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireFrom not implemented")
+}
+func (UnimplementedMsgServer) SendLocked(context.Context, *MsgSendLocked) (*MsgSendLockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendLocked not implemented")
+}
+func (UnimplementedMsgServer) UpdateClassBuffer(context.Context, *MsgUpdateClassBuffer) (*MsgUpdateClassBufferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassBuffer not implemented")
+}
+func (UnimplementedMsgServer) ReverseCredits(context.Context, *MsgReverseCredits) (*MsgReverseCreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseCredits not implemented")
+}
+func (UnimplementedMsgServer) UpdateClassVerifiers(context.Context, *MsgUpdateClassVerifiers) (*MsgUpdateClassVerifiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassVerifiers not implemented")
+}
+func (UnimplementedMsgServer) UpdateProjectStatus(context.Context, *MsgUpdateProjectStatus) (*MsgUpdateProjectStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectStatus not implemented")
+}
+func (UnimplementedMsgServer) UpdateClassBatchApproval(context.Context, *MsgUpdateClassBatchApproval) (*MsgUpdateClassBatchApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassBatchApproval not implemented")
+}
+func (UnimplementedMsgServer) ProposeBatch(context.Context, *MsgProposeBatch) (*MsgProposeBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeBatch not implemented")
+}
+func (UnimplementedMsgServer) ApproveBatch(context.Context, *MsgApproveBatch) (*MsgApproveBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveBatch not implemented")
+}
+func (UnimplementedMsgServer) RejectBatch(context.Context, *MsgRejectBatch) (*MsgRejectBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectBatch not implemented")
+}
+func (UnimplementedMsgServer) UpdateBatchMetadata(context.Context, *MsgUpdateBatchMetadata) (*MsgUpdateBatchMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBatchMetadata not implemented")
+}
+func (UnimplementedMsgServer) UpdateClassBatchMetadataCosign(context.Context, *MsgUpdateClassBatchMetadataCosign) (*MsgUpdateClassBatchMetadataCosignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassBatchMetadataCosign not implemented")
+}
+func (UnimplementedMsgServer) MigrateProject(context.Context, *MsgMigrateProject) (*MsgMigrateProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateProject not implemented")
+}
+func (UnimplementedMsgServer) UpdateClassStatus(context.Context, *MsgUpdateClassStatus) (*MsgUpdateClassStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassStatus not implemented")
+}
+func (UnimplementedMsgServer) SetStrictJurisdictions(context.Context, *MsgSetStrictJurisdictions) (*MsgSetStrictJurisdictionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStrictJurisdictions not implemented")
+}
+func (UnimplementedMsgServer) AddNationalRegistry(context.Context, *MsgAddNationalRegistry) (*MsgAddNationalRegistryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNationalRegistry not implemented")
+}
+func (UnimplementedMsgServer) RemoveNationalRegistry(context.Context, *MsgRemoveNationalRegistry) (*MsgRemoveNationalRegistryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveNationalRegistry not implemented")
+}
+func (UnimplementedMsgServer) SetArticle6Authorization(context.Context, *MsgSetArticle6Authorization) (*MsgSetArticle6AuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetArticle6Authorization not implemented")
+}
+func (UnimplementedMsgServer) UpdateClassSerialization(context.Context, *MsgUpdateClassSerialization) (*MsgUpdateClassSerializationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassSerialization not implemented")
+}
+func (UnimplementedMsgServer) LinkBatchContract(context.Context, *MsgLinkBatchContract) (*MsgLinkBatchContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkBatchContract not implemented")
+}
+func (UnimplementedMsgServer) BridgeReturn(context.Context, *MsgBridgeReturn) (*MsgBridgeReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BridgeReturn not implemented")
+}
+func (UnimplementedMsgServer) AcknowledgeBridge(context.Context, *MsgAcknowledgeBridge) (*MsgAcknowledgeBridgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeBridge not implemented")
+}
+func (UnimplementedMsgServer) FailBridge(context.Context, *MsgFailBridge) (*MsgFailBridgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailBridge not implemented")
+}
+func (UnimplementedMsgServer) AddAllowedBridgeService(context.Context, *MsgAddAllowedBridgeService) (*MsgAddAllowedBridgeServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedBridgeService not implemented")
+}
+func (UnimplementedMsgServer) RemoveAllowedBridgeService(context.Context, *MsgRemoveAllowedBridgeService) (*MsgRemoveAllowedBridgeServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowedBridgeService not implemented")
+}
+func (UnimplementedMsgServer) AddAllowedBridgeContract(context.Context, *MsgAddAllowedBridgeContract) (*MsgAddAllowedBridgeContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedBridgeContract not implemented")
+}
+func (UnimplementedMsgServer) RemoveAllowedBridgeContract(context.Context, *MsgRemoveAllowedBridgeContract) (*MsgRemoveAllowedBridgeContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowedBridgeContract not implemented")
+}
+func (UnimplementedMsgServer) Wrap(context.Context, *MsgWrap) (*MsgWrapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Wrap not implemented")
+}
+func (UnimplementedMsgServer) Unwrap(context.Context, *MsgUnwrap) (*MsgUnwrapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unwrap not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_CreateClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateClass)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/CreateClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateClass(ctx, req.(*MsgCreateClass))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateProject)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/CreateProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateProject(ctx, req.(*MsgCreateProject))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/CreateBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateBatch(ctx, req.(*MsgCreateBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MintBatchCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMintBatchCredits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MintBatchCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/MintBatchCredits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MintBatchCredits(ctx, req.(*MsgMintBatchCredits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SealBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSealBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SealBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/SealBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SealBatch(ctx, req.(*MsgSealBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSend)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Send(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/Send",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Send(ctx, req.(*MsgSend))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Retire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetire)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Retire(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/Retire",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Retire(ctx, req.(*MsgRetire))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/Cancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Cancel(ctx, req.(*MsgCancel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateClassAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClassAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClassAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClassAdmin(ctx, req.(*MsgUpdateClassAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateClassIssuers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClassIssuers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClassIssuers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassIssuers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClassIssuers(ctx, req.(*MsgUpdateClassIssuers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateClassMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClassMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClassMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClassMetadata(ctx, req.(*MsgUpdateClassMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateProjectAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProjectAdmin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProjectAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateProjectAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProjectAdmin(ctx, req.(*MsgUpdateProjectAdmin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateProjectMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProjectMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProjectMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateProjectMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProjectMetadata(ctx, req.(*MsgUpdateProjectMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Bridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBridge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Bridge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/Bridge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Bridge(ctx, req.(*MsgBridge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BridgeReceive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBridgeReceive)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BridgeReceive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/BridgeReceive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BridgeReceive(ctx, req.(*MsgBridgeReceive))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddCreditType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddCreditType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddCreditType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/AddCreditType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddCreditType(ctx, req.(*MsgAddCreditType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetClassCreatorAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetClassCreatorAllowlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetClassCreatorAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/SetClassCreatorAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetClassCreatorAllowlist(ctx, req.(*MsgSetClassCreatorAllowlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddClassCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddClassCreator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddClassCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/AddClassCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddClassCreator(ctx, req.(*MsgAddClassCreator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveClassCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveClassCreator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveClassCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/RemoveClassCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveClassCreator(ctx, req.(*MsgRemoveClassCreator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateClassFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClassFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClassFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClassFee(ctx, req.(*MsgUpdateClassFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddAllowedBridgeChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAllowedBridgeChain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddAllowedBridgeChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/AddAllowedBridgeChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddAllowedBridgeChain(ctx, req.(*MsgAddAllowedBridgeChain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveAllowedBridgeChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAllowedBridgeChain)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAllowedBridgeChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/RemoveAllowedBridgeChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAllowedBridgeChain(ctx, req.(*MsgRemoveAllowedBridgeChain))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Approve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApprove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Approve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/Approve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Approve(ctx, req.(*MsgApprove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeApproval)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/RevokeApproval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeApproval(ctx, req.(*MsgRevokeApproval))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendFrom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/SendFrom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendFrom(ctx, req.(*MsgSendFrom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetireFrom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetireFrom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetireFrom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/RetireFrom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetireFrom(ctx, req.(*MsgRetireFrom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendLocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendLocked)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendLocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/SendLocked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendLocked(ctx, req.(*MsgSendLocked))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateClassBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClassBuffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClassBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassBuffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClassBuffer(ctx, req.(*MsgUpdateClassBuffer))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMsgServer) AddAllowedBridgeChain(context.Context, *MsgAddAllowedBridgeChain) (*MsgAddAllowedBridgeChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedBridgeChain not implemented")
+
+func _Msg_ReverseCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReverseCredits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReverseCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/ReverseCredits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReverseCredits(ctx, req.(*MsgReverseCredits))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMsgServer) RemoveAllowedBridgeChain(context.Context, *MsgRemoveAllowedBridgeChain) (*MsgRemoveAllowedBridgeChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowedBridgeChain not implemented")
+
+func _Msg_UpdateClassVerifiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClassVerifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClassVerifiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassVerifiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClassVerifiers(ctx, req.(*MsgUpdateClassVerifiers))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
-// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MsgServer will
-// result in compilation errors.
-type UnsafeMsgServer interface {
-	mustEmbedUnimplementedMsgServer()
+func _Msg_UpdateProjectStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProjectStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProjectStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateProjectStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProjectStatus(ctx, req.(*MsgUpdateProjectStatus))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
-	s.RegisterService(&Msg_ServiceDesc, srv)
+func _Msg_UpdateClassBatchApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClassBatchApproval)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClassBatchApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassBatchApproval",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClassBatchApproval(ctx, req.(*MsgUpdateClassBatchApproval))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_CreateClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateClass)
+func _Msg_ProposeBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeBatch)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).CreateClass(ctx, in)
+		return srv.(MsgServer).ProposeBatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/CreateClass",
+		FullMethod: "/regen.ecocredit.v1.Msg/ProposeBatch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateClass(ctx, req.(*MsgCreateClass))
+		return srv.(MsgServer).ProposeBatch(ctx, req.(*MsgProposeBatch))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateProject)
+func _Msg_ApproveBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveBatch)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).CreateProject(ctx, in)
+		return srv.(MsgServer).ApproveBatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/CreateProject",
+		FullMethod: "/regen.ecocredit.v1.Msg/ApproveBatch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateProject(ctx, req.(*MsgCreateProject))
+		return srv.(MsgServer).ApproveBatch(ctx, req.(*MsgApproveBatch))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_CreateBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateBatch)
+func _Msg_RejectBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRejectBatch)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).CreateBatch(ctx, in)
+		return srv.(MsgServer).RejectBatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/CreateBatch",
+		FullMethod: "/regen.ecocredit.v1.Msg/RejectBatch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateBatch(ctx, req.(*MsgCreateBatch))
+		return srv.(MsgServer).RejectBatch(ctx, req.(*MsgRejectBatch))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_MintBatchCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMintBatchCredits)
+func _Msg_UpdateBatchMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBatchMetadata)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).MintBatchCredits(ctx, in)
+		return srv.(MsgServer).UpdateBatchMetadata(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/MintBatchCredits",
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateBatchMetadata",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MintBatchCredits(ctx, req.(*MsgMintBatchCredits))
+		return srv.(MsgServer).UpdateBatchMetadata(ctx, req.(*MsgUpdateBatchMetadata))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_SealBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSealBatch)
+func _Msg_UpdateClassBatchMetadataCosign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClassBatchMetadataCosign)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).SealBatch(ctx, in)
+		return srv.(MsgServer).UpdateClassBatchMetadataCosign(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/SealBatch",
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassBatchMetadataCosign",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SealBatch(ctx, req.(*MsgSealBatch))
+		return srv.(MsgServer).UpdateClassBatchMetadataCosign(ctx, req.(*MsgUpdateClassBatchMetadataCosign))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSend)
+func _Msg_MigrateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateProject)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).Send(ctx, in)
+		return srv.(MsgServer).MigrateProject(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/Send",
+		FullMethod: "/regen.ecocredit.v1.Msg/MigrateProject",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Send(ctx, req.(*MsgSend))
+		return srv.(MsgServer).MigrateProject(ctx, req.(*MsgMigrateProject))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_Retire_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRetire)
+func _Msg_UpdateClassStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClassStatus)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).Retire(ctx, in)
+		return srv.(MsgServer).UpdateClassStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/Retire",
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Retire(ctx, req.(*MsgRetire))
+		return srv.(MsgServer).UpdateClassStatus(ctx, req.(*MsgUpdateClassStatus))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancel)
+func _Msg_SetStrictJurisdictions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetStrictJurisdictions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).Cancel(ctx, in)
+		return srv.(MsgServer).SetStrictJurisdictions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/Cancel",
+		FullMethod: "/regen.ecocredit.v1.Msg/SetStrictJurisdictions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Cancel(ctx, req.(*MsgCancel))
+		return srv.(MsgServer).SetStrictJurisdictions(ctx, req.(*MsgSetStrictJurisdictions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateClassAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateClassAdmin)
+func _Msg_AddNationalRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddNationalRegistry)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateClassAdmin(ctx, in)
+		return srv.(MsgServer).AddNationalRegistry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassAdmin",
+		FullMethod: "/regen.ecocredit.v1.Msg/AddNationalRegistry",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateClassAdmin(ctx, req.(*MsgUpdateClassAdmin))
+		return srv.(MsgServer).AddNationalRegistry(ctx, req.(*MsgAddNationalRegistry))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateClassIssuers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateClassIssuers)
+func _Msg_RemoveNationalRegistry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveNationalRegistry)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateClassIssuers(ctx, in)
+		return srv.(MsgServer).RemoveNationalRegistry(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassIssuers",
+		FullMethod: "/regen.ecocredit.v1.Msg/RemoveNationalRegistry",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateClassIssuers(ctx, req.(*MsgUpdateClassIssuers))
+		return srv.(MsgServer).RemoveNationalRegistry(ctx, req.(*MsgRemoveNationalRegistry))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateClassMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateClassMetadata)
+func _Msg_SetArticle6Authorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetArticle6Authorization)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateClassMetadata(ctx, in)
+		return srv.(MsgServer).SetArticle6Authorization(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassMetadata",
+		FullMethod: "/regen.ecocredit.v1.Msg/SetArticle6Authorization",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateClassMetadata(ctx, req.(*MsgUpdateClassMetadata))
+		return srv.(MsgServer).SetArticle6Authorization(ctx, req.(*MsgSetArticle6Authorization))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateProjectAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateProjectAdmin)
+func _Msg_UpdateClassSerialization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClassSerialization)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateProjectAdmin(ctx, in)
+		return srv.(MsgServer).UpdateClassSerialization(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/UpdateProjectAdmin",
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassSerialization",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateProjectAdmin(ctx, req.(*MsgUpdateProjectAdmin))
+		return srv.(MsgServer).UpdateClassSerialization(ctx, req.(*MsgUpdateClassSerialization))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateProjectMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateProjectMetadata)
+func _Msg_LinkBatchContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLinkBatchContract)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateProjectMetadata(ctx, in)
+		return srv.(MsgServer).LinkBatchContract(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/UpdateProjectMetadata",
+		FullMethod: "/regen.ecocredit.v1.Msg/LinkBatchContract",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateProjectMetadata(ctx, req.(*MsgUpdateProjectMetadata))
+		return srv.(MsgServer).LinkBatchContract(ctx, req.(*MsgLinkBatchContract))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_Bridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBridge)
+func _Msg_BridgeReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBridgeReturn)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).Bridge(ctx, in)
+		return srv.(MsgServer).BridgeReturn(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/Bridge",
+		FullMethod: "/regen.ecocredit.v1.Msg/BridgeReturn",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Bridge(ctx, req.(*MsgBridge))
+		return srv.(MsgServer).BridgeReturn(ctx, req.(*MsgBridgeReturn))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_BridgeReceive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBridgeReceive)
+func _Msg_AcknowledgeBridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcknowledgeBridge)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).BridgeReceive(ctx, in)
+		return srv.(MsgServer).AcknowledgeBridge(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/BridgeReceive",
+		FullMethod: "/regen.ecocredit.v1.Msg/AcknowledgeBridge",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BridgeReceive(ctx, req.(*MsgBridgeReceive))
+		return srv.(MsgServer).AcknowledgeBridge(ctx, req.(*MsgAcknowledgeBridge))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_AddCreditType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddCreditType)
+func _Msg_FailBridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFailBridge)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).AddCreditType(ctx, in)
+		return srv.(MsgServer).FailBridge(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/AddCreditType",
+		FullMethod: "/regen.ecocredit.v1.Msg/FailBridge",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddCreditType(ctx, req.(*MsgAddCreditType))
+		return srv.(MsgServer).FailBridge(ctx, req.(*MsgFailBridge))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_SetClassCreatorAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetClassCreatorAllowlist)
+func _Msg_AddAllowedBridgeService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAllowedBridgeService)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).SetClassCreatorAllowlist(ctx, in)
+		return srv.(MsgServer).AddAllowedBridgeService(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/SetClassCreatorAllowlist",
+		FullMethod: "/regen.ecocredit.v1.Msg/AddAllowedBridgeService",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetClassCreatorAllowlist(ctx, req.(*MsgSetClassCreatorAllowlist))
+		return srv.(MsgServer).AddAllowedBridgeService(ctx, req.(*MsgAddAllowedBridgeService))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_AddClassCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddClassCreator)
+func _Msg_RemoveAllowedBridgeService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAllowedBridgeService)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).AddClassCreator(ctx, in)
+		return srv.(MsgServer).RemoveAllowedBridgeService(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/AddClassCreator",
+		FullMethod: "/regen.ecocredit.v1.Msg/RemoveAllowedBridgeService",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddClassCreator(ctx, req.(*MsgAddClassCreator))
+		return srv.(MsgServer).RemoveAllowedBridgeService(ctx, req.(*MsgRemoveAllowedBridgeService))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_RemoveClassCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveClassCreator)
+func _Msg_AddAllowedBridgeContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddAllowedBridgeContract)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).RemoveClassCreator(ctx, in)
+		return srv.(MsgServer).AddAllowedBridgeContract(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/RemoveClassCreator",
+		FullMethod: "/regen.ecocredit.v1.Msg/AddAllowedBridgeContract",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveClassCreator(ctx, req.(*MsgRemoveClassCreator))
+		return srv.(MsgServer).AddAllowedBridgeContract(ctx, req.(*MsgAddAllowedBridgeContract))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateClassFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateClassFee)
+func _Msg_RemoveAllowedBridgeContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAllowedBridgeContract)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateClassFee(ctx, in)
+		return srv.(MsgServer).RemoveAllowedBridgeContract(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassFee",
+		FullMethod: "/regen.ecocredit.v1.Msg/RemoveAllowedBridgeContract",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateClassFee(ctx, req.(*MsgUpdateClassFee))
+		return srv.(MsgServer).RemoveAllowedBridgeContract(ctx, req.(*MsgRemoveAllowedBridgeContract))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_AddAllowedBridgeChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddAllowedBridgeChain)
+func _Msg_Wrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWrap)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).AddAllowedBridgeChain(ctx, in)
+		return srv.(MsgServer).Wrap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/AddAllowedBridgeChain",
+		FullMethod: "/regen.ecocredit.v1.Msg/Wrap",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddAllowedBridgeChain(ctx, req.(*MsgAddAllowedBridgeChain))
+		return srv.(MsgServer).Wrap(ctx, req.(*MsgWrap))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_RemoveAllowedBridgeChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveAllowedBridgeChain)
+func _Msg_Unwrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnwrap)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).RemoveAllowedBridgeChain(ctx, in)
+		return srv.(MsgServer).Unwrap(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/regen.ecocredit.v1.Msg/RemoveAllowedBridgeChain",
+		FullMethod: "/regen.ecocredit.v1.Msg/Unwrap",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveAllowedBridgeChain(ctx, req.(*MsgRemoveAllowedBridgeChain))
+		return srv.(MsgServer).Unwrap(ctx, req.(*MsgUnwrap))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1049,6 +2473,134 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveAllowedBridgeChain",
 			Handler:    _Msg_RemoveAllowedBridgeChain_Handler,
 		},
+		{
+			MethodName: "Approve",
+			Handler:    _Msg_Approve_Handler,
+		},
+		{
+			MethodName: "RevokeApproval",
+			Handler:    _Msg_RevokeApproval_Handler,
+		},
+		{
+			MethodName: "SendFrom",
+			Handler:    _Msg_SendFrom_Handler,
+		},
+		{
+			MethodName: "RetireFrom",
+			Handler:    _Msg_RetireFrom_Handler,
+		},
+		{
+			MethodName: "SendLocked",
+			Handler:    _Msg_SendLocked_Handler,
+		},
+		{
+			MethodName: "UpdateClassBuffer",
+			Handler:    _Msg_UpdateClassBuffer_Handler,
+		},
+		{
+			MethodName: "ReverseCredits",
+			Handler:    _Msg_ReverseCredits_Handler,
+		},
+		{
+			MethodName: "UpdateClassVerifiers",
+			Handler:    _Msg_UpdateClassVerifiers_Handler,
+		},
+		{
+			MethodName: "UpdateProjectStatus",
+			Handler:    _Msg_UpdateProjectStatus_Handler,
+		},
+		{
+			MethodName: "UpdateClassBatchApproval",
+			Handler:    _Msg_UpdateClassBatchApproval_Handler,
+		},
+		{
+			MethodName: "ProposeBatch",
+			Handler:    _Msg_ProposeBatch_Handler,
+		},
+		{
+			MethodName: "ApproveBatch",
+			Handler:    _Msg_ApproveBatch_Handler,
+		},
+		{
+			MethodName: "RejectBatch",
+			Handler:    _Msg_RejectBatch_Handler,
+		},
+		{
+			MethodName: "UpdateBatchMetadata",
+			Handler:    _Msg_UpdateBatchMetadata_Handler,
+		},
+		{
+			MethodName: "UpdateClassBatchMetadataCosign",
+			Handler:    _Msg_UpdateClassBatchMetadataCosign_Handler,
+		},
+		{
+			MethodName: "MigrateProject",
+			Handler:    _Msg_MigrateProject_Handler,
+		},
+		{
+			MethodName: "UpdateClassStatus",
+			Handler:    _Msg_UpdateClassStatus_Handler,
+		},
+		{
+			MethodName: "SetStrictJurisdictions",
+			Handler:    _Msg_SetStrictJurisdictions_Handler,
+		},
+		{
+			MethodName: "AddNationalRegistry",
+			Handler:    _Msg_AddNationalRegistry_Handler,
+		},
+		{
+			MethodName: "RemoveNationalRegistry",
+			Handler:    _Msg_RemoveNationalRegistry_Handler,
+		},
+		{
+			MethodName: "SetArticle6Authorization",
+			Handler:    _Msg_SetArticle6Authorization_Handler,
+		},
+		{
+			MethodName: "UpdateClassSerialization",
+			Handler:    _Msg_UpdateClassSerialization_Handler,
+		},
+		{
+			MethodName: "LinkBatchContract",
+			Handler:    _Msg_LinkBatchContract_Handler,
+		},
+		{
+			MethodName: "BridgeReturn",
+			Handler:    _Msg_BridgeReturn_Handler,
+		},
+		{
+			MethodName: "AcknowledgeBridge",
+			Handler:    _Msg_AcknowledgeBridge_Handler,
+		},
+		{
+			MethodName: "FailBridge",
+			Handler:    _Msg_FailBridge_Handler,
+		},
+		{
+			MethodName: "AddAllowedBridgeService",
+			Handler:    _Msg_AddAllowedBridgeService_Handler,
+		},
+		{
+			MethodName: "RemoveAllowedBridgeService",
+			Handler:    _Msg_RemoveAllowedBridgeService_Handler,
+		},
+		{
+			MethodName: "AddAllowedBridgeContract",
+			Handler:    _Msg_AddAllowedBridgeContract_Handler,
+		},
+		{
+			MethodName: "RemoveAllowedBridgeContract",
+			Handler:    _Msg_RemoveAllowedBridgeContract_Handler,
+		},
+		{
+			MethodName: "Wrap",
+			Handler:    _Msg_Wrap_Handler,
+		},
+		{
+			MethodName: "Unwrap",
+			Handler:    _Msg_Unwrap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/v1/tx.proto",
@@ -81,6 +81,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	nftmodule "github.com/cosmos/cosmos-sdk/x/nft/module"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -104,13 +107,18 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v5/modules/core/05-port/types"
 	ibchost "github.com/cosmos/ibc-go/v5/modules/core/24-host"
 	ibckeeper "github.com/cosmos/ibc-go/v5/modules/core/keeper"
+	ibctestingtypes "github.com/cosmos/ibc-go/v5/testing/types"
 
 	regenupgrades "github.com/regen-network/regen-ledger/v4/app/upgrades"
 	v5 "github.com/regen-network/regen-ledger/v4/app/upgrades/v5"
+	v6 "github.com/regen-network/regen-ledger/v4/app/upgrades/v6"
 	"github.com/regen-network/regen-ledger/x/data"
 	datamodule "github.com/regen-network/regen-ledger/x/data/module"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
+	ecocreditbase "github.com/regen-network/regen-ledger/x/ecocredit/base"
 	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
+	ecocreditibc "github.com/regen-network/regen-ledger/x/ecocredit/ibc"
+	ecocreditibcmodule "github.com/regen-network/regen-ledger/x/ecocredit/ibc/module"
 	ecocreditmodule "github.com/regen-network/regen-ledger/x/ecocredit/module"
 
 	// unnamed import of statik for swagger UI support
@@ -152,8 +160,10 @@ var (
 		feegrantmodule.AppModuleBasic{},
 		authzmodule.AppModuleBasic{},
 		groupmodule.AppModuleBasic{},
+		nftmodule.AppModuleBasic{},
 		ecocreditmodule.Module{},
 		datamodule.Module{},
+		ecocreditibcmodule.Module{},
 		gov.NewAppModuleBasic(
 			[]govclient.ProposalHandler{
 				paramsclient.ProposalHandler, distrclient.ProposalHandler,
@@ -169,6 +179,7 @@ var (
 			authtypes.FeeCollectorName:      nil,
 			distrtypes.ModuleName:           nil,
 			icatypes.ModuleName:             nil,
+			nft.ModuleName:                  nil,
 			minttypes.ModuleName:            {authtypes.Minter},
 			stakingtypes.BondedPoolName:     {authtypes.Burner, authtypes.Staking},
 			stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
@@ -176,12 +187,13 @@ var (
 			ibctransfertypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
 			ecocredit.ModuleName:            {authtypes.Burner},
 			baskettypes.BasketSubModuleName: {authtypes.Burner, authtypes.Minter},
+			ecocreditbase.WrapSubModuleName: {authtypes.Burner, authtypes.Minter},
 		}
 
 		return perms
 	}()
 
-	upgrades = []regenupgrades.Upgrade{v5.Upgrade}
+	upgrades = []regenupgrades.Upgrade{v5.Upgrade, v6.Upgrade}
 )
 
 func init() {
@@ -196,6 +208,7 @@ type RegenApp struct {
 	cdc               *codec.LegacyAmino
 	appCodec          codec.Codec
 	interfaceRegistry types.InterfaceRegistry
+	txConfig          client.TxConfig
 
 	invCheckPeriod uint
 
@@ -222,12 +235,15 @@ type RegenApp struct {
 	FeeGrantKeeper   feegrantkeeper.Keeper
 	AuthzKeeper      authzkeeper.Keeper
 	GroupKeeper      groupkeeper.Keeper
+	NFTKeeper        nftkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
 	ScopedICAHostKeeper  capabilitykeeper.ScopedKeeper
 
+	ScopedEcocreditIBCKeeper capabilitykeeper.ScopedKeeper
+
 	// the module manager
 	ModuleManager *module.Manager
 
@@ -259,7 +275,7 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, capabilitytypes.StoreKey, feegrant.StoreKey,
-		authzkeeper.StoreKey,
+		authzkeeper.StoreKey, nftkeeper.StoreKey,
 		ibchost.StoreKey, ibctransfertypes.StoreKey, group.StoreKey,
 		ecocredit.ModuleName, data.ModuleName,
 		icahosttypes.StoreKey,
@@ -273,6 +289,7 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		cdc:               legacyAmino,
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
+		txConfig:          encodingConfig.TxConfig,
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
 		tkeys:             tkeys,
@@ -289,6 +306,7 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	app.ScopedIBCKeeper = app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	app.ScopedTransferKeeper = app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	app.ScopedICAHostKeeper = app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	app.ScopedEcocreditIBCKeeper = app.CapabilityKeeper.ScopeToModule(ecocreditibc.SubModuleName)
 
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
 	// their scoped modules in `NewApp` with `ScopeToModule`
@@ -368,11 +386,21 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 
 	icaHostIBCModule := icahost.NewIBCModule(*app.ICAHostKeeper)
 
-	// Create static IBC router, add transfer route, then set and seal it
+	ecocreditIBCMod := ecocreditibcmodule.NewModule(
+		keys[ecocredit.ModuleName],
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		app.ScopedEcocreditIBCKeeper,
+	)
+	ecocreditIBCModule := ecocreditibcmodule.NewIBCModule(ecocreditIBCMod.Keeper)
+
+	// Create static IBC router, add transfer and ecocredit routes, then set and seal it
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
-		AddRoute(ibctransfertypes.ModuleName, transferIBCModule)
+		AddRoute(ibctransfertypes.ModuleName, transferIBCModule).
+		AddRoute(ecocreditibc.SubModuleName, ecocreditIBCModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	// create evidence keeper with router
@@ -395,6 +423,8 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	groupConfig := group.DefaultConfig()
 	app.GroupKeeper = groupkeeper.NewKeeper(keys[group.StoreKey], appCodec, app.MsgServiceRouter(), app.AccountKeeper, groupConfig)
 
+	app.NFTKeeper = nftkeeper.NewKeeper(keys[nftkeeper.StoreKey], appCodec, app.AccountKeeper, app.BankKeeper)
+
 	// register custom modules here
 	dataMod := datamodule.NewModule(app.keys[data.ModuleName], app.AccountKeeper, app.BankKeeper)
 
@@ -408,6 +438,8 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.NFTKeeper,
+		dataMod.Keeper(),
 		app.GetSubspace(ecocredit.DefaultParamspace),
 		app.GovKeeper,
 	)
@@ -437,8 +469,10 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
 		ecocreditMod,
+		ecocreditIBCMod,
 		dataMod,
 		groupmodule.NewAppModule(appCodec, app.GroupKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		// TODO: wire up controller https://github.com/regen-network/regen-ledger/issues/1453
 		ica.NewAppModule(nil, app.ICAHostKeeper),
 	)
@@ -466,6 +500,7 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
 		group.ModuleName,
+		nft.ModuleName,
 		ecocredit.ModuleName,
 		data.ModuleName,
 
@@ -473,6 +508,7 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		ibchost.ModuleName,
 		ibctransfertypes.ModuleName,
 		icatypes.ModuleName,
+		ecocreditibc.SubModuleName,
 	)
 	app.ModuleManager.SetOrderEndBlockers(
 		crisistypes.ModuleName,
@@ -492,6 +528,7 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
 		group.ModuleName,
+		nft.ModuleName,
 		ecocredit.ModuleName,
 		data.ModuleName,
 
@@ -499,6 +536,7 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		ibchost.ModuleName,
 		ibctransfertypes.ModuleName,
 		icatypes.ModuleName,
+		ecocreditibc.SubModuleName,
 	)
 	// NOTE: The genutils module must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
@@ -523,6 +561,7 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		group.ModuleName,
+		nft.ModuleName,
 		ecocredit.ModuleName,
 		data.ModuleName,
 
@@ -530,6 +569,7 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		ibctransfertypes.ModuleName,
 		ibchost.ModuleName,
 		icatypes.ModuleName,
+		ecocreditibc.SubModuleName,
 	)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.ModuleManager.RegisterServices(app.configurator)
@@ -556,6 +596,7 @@ func NewRegenApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		evidence.NewAppModule(app.EvidenceKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		ibc.NewAppModule(app.IBCKeeper),
 		transferModule,
 		// TODO: wire up controller https://github.com/regen-network/regen-ledger/issues/1453
@@ -688,6 +729,41 @@ func (app *RegenApp) GetSubspace(moduleName string) paramstypes.Subspace {
 	return subspace
 }
 
+// GetBaseApp returns the BaseApp of RegenApp.
+//
+// NOTE: This is solely to be used for testing purposes (ibctesting.TestingApp).
+func (app *RegenApp) GetBaseApp() *baseapp.BaseApp {
+	return app.BaseApp
+}
+
+// GetStakingKeeper returns the staking keeper of RegenApp.
+//
+// NOTE: This is solely to be used for testing purposes (ibctesting.TestingApp).
+func (app *RegenApp) GetStakingKeeper() ibctestingtypes.StakingKeeper {
+	return app.StakingKeeper
+}
+
+// GetIBCKeeper returns the IBC keeper of RegenApp.
+//
+// NOTE: This is solely to be used for testing purposes (ibctesting.TestingApp).
+func (app *RegenApp) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetScopedIBCKeeper returns the scoped IBC keeper of RegenApp.
+//
+// NOTE: This is solely to be used for testing purposes (ibctesting.TestingApp).
+func (app *RegenApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+// GetTxConfig returns the TxConfig of RegenApp.
+//
+// NOTE: This is solely to be used for testing purposes (ibctesting.TestingApp).
+func (app *RegenApp) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // SimulationManager implements the SimulationApp interface
 func (app *RegenApp) SimulationManager() *module.SimulationManager {
 	return app.sm
@@ -0,0 +1,265 @@
+package testsuite
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	dbm "github.com/tendermint/tm-db"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v5/testing"
+
+	"github.com/regen-network/regen-ledger/v4/app"
+	basetypes "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/ibc"
+	ibctypes "github.com/regen-network/regen-ledger/x/ecocredit/ibc/types/v1"
+)
+
+type EcocreditIBCTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+	chainA      *ibctesting.TestChain
+	chainB      *ibctesting.TestChain
+	path        *ibctesting.Path
+	batchDenom  string
+}
+
+func TestEcocreditIBC(t *testing.T) {
+	suite.Run(t, new(EcocreditIBCTestSuite))
+}
+
+// testingApp sets a block time for block headers without one, as the first
+// block after genesis in the ibc testing harness does not include a time.
+type testingApp struct {
+	*app.RegenApp
+}
+
+func (a testingApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	if req.Header.Time.IsZero() {
+		req.Header.Time = genesisTime
+	}
+	return a.RegenApp.BeginBlock(req)
+}
+
+// genesisTime is the start time of the ibc testing coordinator.
+var genesisTime = time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)
+
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	encCfg := app.MakeEncodingConfig()
+	regenApp := app.NewRegenApp(
+		log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{},
+		app.DefaultNodeHome, 5, encCfg, EmptyAppOptions{},
+	)
+	return testingApp{regenApp}, app.NewDefaultGenesisState(encCfg.Codec)
+}
+
+func (s *EcocreditIBCTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(1))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	s.path = ibctesting.NewPath(s.chainA, s.chainB)
+	for _, endpoint := range []*ibctesting.Endpoint{s.path.EndpointA, s.path.EndpointB} {
+		endpoint.ChannelConfig.PortID = ibc.PortID
+		endpoint.ChannelConfig.Version = ibc.Version
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	s.coordinator.Setup(s.path)
+
+	s.batchDenom = s.issueCredits(s.chainA, "100")
+}
+
+func (s *EcocreditIBCTestSuite) TestTransferAndReturn() {
+	require := s.Require()
+
+	sender := s.chainA.SenderAccount.GetAddress()
+	receiver := s.chainB.SenderAccount.GetAddress()
+	escrow := ibc.EscrowAddress(s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID)
+
+	// send credits from chain A to chain B
+	s.transfer(s.path.EndpointA, s.path.EndpointB, s.batchDenom, "10", receiver.String())
+
+	s.requireTradable(s.chainA, sender, s.batchDenom, "90")
+	s.requireTradable(s.chainA, escrow, s.batchDenom, "10")
+
+	traces, err := ibctypes.NewQueryClient(s.queryHelper(s.chainB)).DenomTraces(
+		sdk.WrapSDKContext(s.chainB.GetContext()), &ibctypes.QueryDenomTracesRequest{},
+	)
+	require.NoError(err)
+	require.Len(traces.DenomTraces, 1)
+
+	voucherDenom := traces.DenomTraces[0].BatchDenom
+	trace := traces.DenomTraces[0].DenomTrace
+	require.Equal(ibc.PortID+"/"+s.path.EndpointB.ChannelID, trace.Path)
+	require.Equal(s.batchDenom, trace.BaseDenom)
+
+	s.requireTradable(s.chainB, receiver, voucherDenom, "10")
+
+	// credit class, project and batch metadata is preserved
+	s.requireMetadata(voucherDenom)
+
+	// send some voucher credits back to chain A
+	s.transfer(s.path.EndpointB, s.path.EndpointA, voucherDenom, "4", sender.String())
+
+	s.requireTradable(s.chainB, receiver, voucherDenom, "6")
+	s.requireTradable(s.chainA, sender, s.batchDenom, "94")
+	s.requireTradable(s.chainA, escrow, s.batchDenom, "6")
+}
+
+func (s *EcocreditIBCTestSuite) TestRefundOnErrorAcknowledgement() {
+	sender := s.chainA.SenderAccount.GetAddress()
+	escrow := ibc.EscrowAddress(s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID)
+
+	// the receiving chain fails to receive credits sent to an invalid address
+	s.transfer(s.path.EndpointA, s.path.EndpointB, s.batchDenom, "10", "invalid")
+
+	s.requireTradable(s.chainA, sender, s.batchDenom, "100")
+	s.requireTradable(s.chainA, escrow, s.batchDenom, "0")
+}
+
+func (s *EcocreditIBCTestSuite) TestRefundOnTimeout() {
+	require := s.Require()
+
+	sender := s.chainA.SenderAccount.GetAddress()
+	receiver := s.chainB.SenderAccount.GetAddress()
+	escrow := ibc.EscrowAddress(s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID)
+
+	timeoutHeight := clienttypes.NewHeight(
+		clienttypes.ParseChainID(s.chainB.ChainID),
+		uint64(s.chainB.GetContext().BlockHeight())+1,
+	)
+
+	packet := s.sendTransfer(s.path.EndpointA, s.batchDenom, "10", receiver.String(), timeoutHeight)
+
+	s.requireTradable(s.chainA, sender, s.batchDenom, "90")
+	s.requireTradable(s.chainA, escrow, s.batchDenom, "10")
+
+	// advance chain B past the timeout height
+	s.coordinator.CommitNBlocks(s.chainB, 2)
+	require.NoError(s.path.EndpointA.UpdateClient())
+	require.NoError(s.path.EndpointA.TimeoutPacket(packet))
+
+	s.requireTradable(s.chainA, sender, s.batchDenom, "100")
+	s.requireTradable(s.chainA, escrow, s.batchDenom, "0")
+}
+
+// issueCredits creates a credit class, project and credit batch on the chain
+// and issues tradable credits to the sender account of the chain.
+func (s *EcocreditIBCTestSuite) issueCredits(chain *ibctesting.TestChain, amount string) string {
+	require := s.Require()
+
+	admin := chain.SenderAccount.GetAddress().String()
+	startDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	fee := sdk.NewInt64Coin(sdk.DefaultBondDenom, basetypes.DefaultClassFee.Int64())
+
+	_, err := chain.SendMsgs(
+		&basetypes.MsgCreateClass{
+			Admin:            admin,
+			Issuers:          []string{admin},
+			Metadata:         "regen:class-metadata",
+			CreditTypeAbbrev: "C",
+			Fee:              &fee,
+		},
+		&basetypes.MsgCreateProject{
+			Admin:        admin,
+			ClassId:      "C01",
+			Metadata:     "regen:project-metadata",
+			Jurisdiction: "US-WA",
+			ReferenceId:  "VCS-001",
+		},
+		&basetypes.MsgCreateBatch{
+			Issuer:    admin,
+			ProjectId: "C01-001",
+			Issuance: []*basetypes.BatchIssuance{
+				{Recipient: admin, TradableAmount: amount},
+			},
+			Metadata:  "regen:batch-metadata",
+			StartDate: &startDate,
+			EndDate:   &endDate,
+		},
+	)
+	require.NoError(err)
+
+	return "C01-001-20200101-20210101-001"
+}
+
+// transfer sends credits from the source endpoint and relays the packet and
+// the acknowledgement to the destination endpoint and back.
+func (s *EcocreditIBCTestSuite) transfer(src, dst *ibctesting.Endpoint, batchDenom, amount, receiver string) {
+	packet := s.sendTransfer(src, batchDenom, amount, receiver, dst.Chain.GetTimeoutHeight())
+	s.Require().NoError(s.path.RelayPacket(packet))
+}
+
+// sendTransfer sends credits from the source endpoint and returns the packet.
+func (s *EcocreditIBCTestSuite) sendTransfer(src *ibctesting.Endpoint, batchDenom, amount, receiver string, timeoutHeight clienttypes.Height) channeltypes.Packet {
+	require := s.Require()
+
+	res, err := src.Chain.SendMsgs(&ibctypes.MsgTransfer{
+		SourcePort:    src.ChannelConfig.PortID,
+		SourceChannel: src.ChannelID,
+		Sender:        src.Chain.SenderAccount.GetAddress().String(),
+		Receiver:      receiver,
+		BatchDenom:    batchDenom,
+		Amount:        amount,
+		TimeoutHeight: timeoutHeight.String(),
+	})
+	require.NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	require.NoError(err)
+
+	return packet
+}
+
+func (s *EcocreditIBCTestSuite) queryHelper(chain *ibctesting.TestChain) *baseapp.QueryServiceTestHelper {
+	return &baseapp.QueryServiceTestHelper{
+		GRPCQueryRouter: chain.App.GetBaseApp().GRPCQueryRouter(),
+		Ctx:             chain.GetContext(),
+	}
+}
+
+func (s *EcocreditIBCTestSuite) requireTradable(chain *ibctesting.TestChain, addr sdk.AccAddress, batchDenom, expected string) {
+	require := s.Require()
+
+	res, err := basetypes.NewQueryClient(s.queryHelper(chain)).Balance(
+		sdk.WrapSDKContext(chain.GetContext()),
+		&basetypes.QueryBalanceRequest{Address: addr.String(), BatchDenom: batchDenom},
+	)
+	require.NoError(err)
+	require.Equal(expected, res.Balance.TradableAmount)
+}
+
+func (s *EcocreditIBCTestSuite) requireMetadata(voucherDenom string) {
+	require := s.Require()
+
+	ctx := sdk.WrapSDKContext(s.chainB.GetContext())
+	client := basetypes.NewQueryClient(s.queryHelper(s.chainB))
+
+	batch, err := client.Batch(ctx, &basetypes.QueryBatchRequest{BatchDenom: voucherDenom})
+	require.NoError(err)
+	require.Equal("regen:batch-metadata", batch.Batch.Metadata)
+	require.Equal(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Unix(), batch.Batch.StartDate.Seconds)
+
+	project, err := client.Project(ctx, &basetypes.QueryProjectRequest{ProjectId: batch.Batch.ProjectId})
+	require.NoError(err)
+	require.Equal("regen:project-metadata", project.Project.Metadata)
+	require.Equal("US-WA", project.Project.Jurisdiction)
+	require.Equal("VCS-001", project.Project.ReferenceId)
+
+	class, err := client.Class(ctx, &basetypes.QueryClassRequest{ClassId: project.Project.ClassId})
+	require.NoError(err)
+	require.Equal("regen:class-metadata", class.Class.Metadata)
+	require.Equal("C", class.Class.CreditTypeAbbrev)
+}
@@ -0,0 +1,29 @@
+package v6
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/regen-network/regen-ledger/v4/app/upgrades"
+)
+
+const Name = "v6"
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName: Name,
+	CreateUpgradeHandler: func(mm *module.Manager, cfg module.Configurator) upgradetypes.UpgradeHandler {
+		return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			// x/nft is not in the version map so InitGenesis is run, and the
+			// ecocredit module is migrated from consensus version 3 to 4
+			return mm.RunMigrations(ctx, cfg, fromVM)
+		}
+	},
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added: []string{
+			nftkeeper.StoreKey,
+		},
+	},
+}
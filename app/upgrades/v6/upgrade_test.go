@@ -0,0 +1,52 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/regen-network/regen-ledger/v4/app/testsuite"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+type UpgradeTestSuite struct {
+	testsuite.UpgradeTestSuite
+}
+
+func TestUpgrade(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+const dummyUpgradeHeight = 5
+
+func (suite *UpgradeTestSuite) TestV6Upgrade() {
+	suite.Setup()
+
+	// remove the nft module from the version map as it is added in the upgrade
+	// and set the ecocredit module to the consensus version before the upgrade
+	vm := suite.App.UpgradeKeeper.GetModuleVersionMap(suite.Ctx)
+	delete(vm, nft.ModuleName)
+	vm[ecocredit.ModuleName] = 3
+	suite.App.UpgradeKeeper.SetModuleVersionMap(suite.Ctx, vm)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(dummyUpgradeHeight - 1)
+	plan := upgradetypes.Plan{Name: "v6", Height: dummyUpgradeHeight}
+	err := suite.App.UpgradeKeeper.ScheduleUpgrade(suite.Ctx, plan)
+	suite.Require().NoError(err)
+	_, exists := suite.App.UpgradeKeeper.GetUpgradePlan(suite.Ctx)
+	suite.Require().True(exists)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(dummyUpgradeHeight)
+	suite.Require().NotPanics(func() {
+		beginBlockRequest := abci.RequestBeginBlock{}
+		suite.App.BeginBlocker(suite.Ctx, beginBlockRequest)
+	})
+
+	vm = suite.App.UpgradeKeeper.GetModuleVersionMap(suite.Ctx)
+	suite.Require().Equal(uint64(1), vm[nft.ModuleName])
+	suite.Require().Equal(uint64(4), vm[ecocredit.ModuleName])
+}
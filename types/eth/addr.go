@@ -1,8 +1,12 @@
 package eth
 
 import (
+	"encoding/hex"
 	"fmt"
 	"regexp"
+	"strings"
+
+	"golang.org/x/crypto/sha3"
 )
 
 var RegexAddress = `0x[0-9a-fA-F]{40}`
@@ -11,3 +15,35 @@ func IsValidAddress(addr string) bool {
 	re := regexp.MustCompile(fmt.Sprintf(`^%s$`, RegexAddress))
 	return re.MatchString(addr)
 }
+
+// IsValidChecksumAddress returns whether or not the address is a valid
+// ethereum address encoded with the mixed-case checksum defined in EIP-55.
+func IsValidChecksumAddress(addr string) bool {
+	return IsValidAddress(addr) && addr == ToChecksumAddress(addr)
+}
+
+// ToChecksumAddress encodes a valid ethereum address with the mixed-case
+// checksum defined in EIP-55. Each hex letter of the address is capitalized if
+// the corresponding nibble of the keccak-256 hash of the lowercase hex address
+// is greater than or equal to 8. The address is returned unchanged if it is not
+// a valid ethereum address.
+func ToChecksumAddress(addr string) string {
+	if !IsValidAddress(addr) {
+		return addr
+	}
+
+	lower := strings.ToLower(addr[2:])
+
+	hash := sha3.NewLegacyKeccak256()
+	hash.Write([]byte(lower))
+	digest := hex.EncodeToString(hash.Sum(nil))
+
+	result := []byte(lower)
+	for i, c := range result {
+		if c >= 'a' && c <= 'f' && digest[i] >= '8' {
+			result[i] = c - 'a' + 'A'
+		}
+	}
+
+	return "0x" + string(result)
+}
@@ -31,3 +31,62 @@ func testInvalidAddress(t *rapid.T) {
 	addr := genInvalidAddress.Draw(t, "addr")
 	require.False(t, IsValidAddress(addr))
 }
+
+func TestChecksumAddress(t *testing.T) {
+	testCases := []struct {
+		name     string
+		addr     string
+		expected string
+		valid    bool
+	}{
+		{
+			name:     "checksum address",
+			addr:     "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			expected: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			valid:    true,
+		},
+		{
+			name:     "checksum address with uppercase prefix letters",
+			addr:     "0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+			expected: "0xD1220A0cf47c7B9Be7A2E6BA89F429762e7b9aDb",
+			valid:    true,
+		},
+		{
+			name:     "lowercase address",
+			addr:     "0xfb6916095ca1df60bb79ce92ce3ea74c37c5d359",
+			expected: "0xfB6916095ca1df60bB79Ce92cE3Ea74c37c5d359",
+			valid:    false,
+		},
+		{
+			name:     "uppercase address",
+			addr:     "0xDBF03B407C01E7CD3CBEA99509D93F8DDDC8C6FB",
+			expected: "0xdbF03B407c01E7cD3CBea99509d93f8DDDC8C6FB",
+			valid:    false,
+		},
+		{
+			name:     "invalid checksum",
+			addr:     "0x5aaeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			expected: "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			valid:    false,
+		},
+		{
+			name:     "address without letters",
+			addr:     "0x0000000000000000000000000000000000000001",
+			expected: "0x0000000000000000000000000000000000000001",
+			valid:    true,
+		},
+		{
+			name:     "invalid address",
+			addr:     "0x01",
+			expected: "0x01",
+			valid:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, ToChecksumAddress(tc.addr))
+			require.Equal(t, tc.valid, IsValidChecksumAddress(tc.addr))
+		})
+	}
+}
@@ -72,10 +72,18 @@ func (a Module) LegacyQuerierHandler(amino *codec.LegacyAmino) sdk.Querier {
 }
 
 func (a *Module) RegisterServices(cfg module.Configurator) {
-	impl := server.NewServer(a.sk, a.ak, a.bk)
-	data.RegisterMsgServer(cfg.MsgServer(), impl)
-	data.RegisterQueryServer(cfg.QueryServer(), impl)
-	a.keeper = impl
+	keeper := a.Keeper()
+	data.RegisterMsgServer(cfg.MsgServer(), keeper.MsgServer())
+	data.RegisterQueryServer(cfg.QueryServer(), keeper.QueryServer())
+}
+
+// Keeper returns the keeper of the data module, which is created on first use
+// so that it can be provided to other modules before services are registered.
+func (a *Module) Keeper() server.Keeper {
+	if a.keeper == nil {
+		a.keeper = server.NewServer(a.sk, a.ak, a.bk)
+	}
+	return a.keeper
 }
 
 var _ module.AppModuleBasic = Module{}
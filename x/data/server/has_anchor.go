@@ -0,0 +1,20 @@
+package server
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+)
+
+// HasAnchor checks if data with the IRI has been anchored.
+func (s serverImpl) HasAnchor(ctx context.Context, iri string) (bool, error) {
+	dataID, err := s.stateStore.DataIDTable().GetByIri(ctx, iri)
+	if err != nil {
+		if ormerrors.NotFound.Is(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return s.stateStore.DataAnchorTable().Has(ctx, dataID.Id)
+}
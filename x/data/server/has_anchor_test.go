@@ -0,0 +1,57 @@
+package server
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/regen-network/regen-ledger/api/regen/data/v1"
+	"github.com/regen-network/regen-ledger/x/data"
+)
+
+func TestHasAnchor(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	id := []byte{0}
+	ch := &data.ContentHash{Graph: &data.ContentHash_Graph{
+		Hash:                      bytes.Repeat([]byte{0}, 32),
+		DigestAlgorithm:           data.DigestAlgorithm_DIGEST_ALGORITHM_BLAKE2B_256,
+		CanonicalizationAlgorithm: data.GraphCanonicalizationAlgorithm_GRAPH_CANONICALIZATION_ALGORITHM_URDNA2015,
+		MerkleTree:                data.GraphMerkleTree_GRAPH_MERKLE_TREE_NONE_UNSPECIFIED,
+	}}
+	iri, err := ch.ToIRI()
+	require.NoError(t, err)
+
+	// data id that has not been registered
+	found, err := s.server.HasAnchor(s.ctx, iri)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// insert data id
+	err = s.server.stateStore.DataIDTable().Insert(s.ctx, &api.DataID{
+		Id:  id,
+		Iri: iri,
+	})
+	require.NoError(t, err)
+
+	// data id that has not been anchored
+	found, err = s.server.HasAnchor(s.ctx, iri)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// insert data anchor
+	err = s.server.stateStore.DataAnchorTable().Insert(s.ctx, &api.DataAnchor{
+		Id:        id,
+		Timestamp: timestamppb.New(time.Now().UTC()),
+	})
+	require.NoError(t, err)
+
+	// data id that has been anchored
+	found, err = s.server.HasAnchor(s.ctx, iri)
+	require.NoError(t, err)
+	require.True(t, found)
+}
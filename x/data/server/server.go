@@ -1,6 +1,7 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -23,7 +24,11 @@ var _ Keeper = serverImpl{}
 type Keeper interface {
 	InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) ([]types.ValidatorUpdate, error)
 	ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) (json.RawMessage, error)
+	MsgServer() data.MsgServer
 	QueryServer() data.QueryServer
+
+	// HasAnchor checks if data with the IRI has been anchored.
+	HasAnchor(ctx context.Context, iri string) (bool, error)
 }
 
 type serverImpl struct {
@@ -34,6 +39,10 @@ type serverImpl struct {
 	accountKeeper data.AccountKeeper
 }
 
+func (s serverImpl) MsgServer() data.MsgServer {
+	return s
+}
+
 func (s serverImpl) QueryServer() data.QueryServer {
 	return s
 }
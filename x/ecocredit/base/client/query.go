@@ -1,10 +1,13 @@
 package client
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
 )
@@ -14,9 +17,15 @@ func QueryClassesCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "classes",
 		Short: "List all credit classes",
-		Long:  "List all credit classes with optional pagination flags.",
+		Long: `List all credit classes with optional pagination flags.
+
+Optional Flags:
+
+- status:  only list credit classes with the given status (active,
+           closed-to-new-projects, closed-to-issuance, or deprecated)`,
 		Example: `regen q ecocredit classes
-regen q ecocredit classes --limit 10 --offset 10`,
+regen q ecocredit classes --limit 10 --offset 10
+regen q ecocredit classes --status deprecated`,
 		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c, ctx, err := mkQueryClient(cmd)
@@ -29,12 +38,27 @@ regen q ecocredit classes --limit 10 --offset 10`,
 				return err
 			}
 
-			res, err := c.Classes(cmd.Context(), &types.QueryClassesRequest{
+			req := &types.QueryClassesRequest{
 				Pagination: pagination,
-			})
+			}
+
+			status, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+
+			if status != "" {
+				req.Status, err = parseClassStatus(status)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := c.Classes(cmd.Context(), req)
 			return printQueryResponse(ctx, res, err)
 		},
 	}
+	cmd.Flags().String(FlagStatus, "", "only list credit classes with the given status")
 	flags.AddPaginationFlagsToCmd(cmd, "classes")
 	return qflags(cmd)
 }
@@ -640,3 +664,1235 @@ func QueryAllowedBridgeChains() *cobra.Command {
 	flags.AddPaginationFlagsToCmd(cmd, "allowed-bridge-chains")
 	return qflags(cmd)
 }
+
+// QueryRetirementCmd returns a query command that retrieves a retirement record.
+func QueryRetirementCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "retirement [id]",
+		Short:   "Retrieve retirement information",
+		Long:    "Retrieve retirement information by retirement id.",
+		Example: "regen q ecocredit retirement 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid retirement id: %s", err)
+			}
+
+			res, err := c.Retirement(cmd.Context(), &types.QueryRetirementRequest{
+				Id: id,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryRetirementsByOwnerCmd returns a query command that retrieves all
+// retirement records for an owner with pagination.
+func QueryRetirementsByOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retirements-by-owner [owner]",
+		Short: "List all retirements by owner",
+		Long:  "List all retirements by owner with optional pagination flags.",
+		Example: `regen q ecocredit retirements-by-owner regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38
+regen q ecocredit retirements-by-owner regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.RetirementsByOwner(cmd.Context(), &types.QueryRetirementsByOwnerRequest{
+				Owner:      args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "retirements-by-owner")
+
+	return qflags(cmd)
+}
+
+// QueryRetirementsByBatchCmd returns a query command that retrieves all
+// retirement records for a credit batch with pagination.
+func QueryRetirementsByBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retirements-by-batch [batch-denom]",
+		Short: "List all retirements by credit batch",
+		Long:  "List all retirements by credit batch with optional pagination flags.",
+		Example: `regen q ecocredit retirements-by-batch C01-001-20200101-20210101-001
+regen q ecocredit retirements-by-batch C01-001-20200101-20210101-001 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.RetirementsByBatch(cmd.Context(), &types.QueryRetirementsByBatchRequest{
+				BatchDenom: args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "retirements-by-batch")
+
+	return qflags(cmd)
+}
+
+// QueryCertificateCmd returns a query command that retrieves a retirement
+// certificate and its underlying retirement record.
+func QueryCertificateCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "certificate [nft-class-id] [nft-id]",
+		Short:   "Retrieve retirement certificate information",
+		Long:    "Retrieve retirement certificate information by nft class id and nft id.",
+		Example: "regen q ecocredit certificate ecocredit:C01 retirement-1",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.Certificate(cmd.Context(), &types.QueryCertificateRequest{
+				NftClassId: args[0],
+				NftId:      args[1],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryCertificatesByOwnerCmd returns a query command that retrieves all
+// retirement certificates owned by an account with pagination.
+func QueryCertificatesByOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "certificates-by-owner [owner]",
+		Short: "List all retirement certificates by owner",
+		Long:  "List all retirement certificates by owner with optional pagination flags.",
+		Example: `regen q ecocredit certificates-by-owner regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38
+regen q ecocredit certificates-by-owner regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.CertificatesByOwner(cmd.Context(), &types.QueryCertificatesByOwnerRequest{
+				Owner:      args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "certificates-by-owner")
+
+	return qflags(cmd)
+}
+
+// QueryCreditLockCmd returns a query command that retrieves a credit lock.
+func QueryCreditLockCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "credit-lock [id]",
+		Short:   "Retrieve credit lock information",
+		Long:    "Retrieve credit lock information by credit lock id.",
+		Example: "regen q ecocredit credit-lock 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid credit lock id: %s", err)
+			}
+
+			res, err := c.CreditLock(cmd.Context(), &types.QueryCreditLockRequest{
+				Id: id,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryCreditLocksByOwnerCmd returns a query command that retrieves all credit
+// locks for an owner with pagination.
+func QueryCreditLocksByOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "credit-locks-by-owner [owner]",
+		Short: "List all credit locks by owner",
+		Long:  "List all credit locks by owner with optional pagination flags.",
+		Example: `regen q ecocredit credit-locks-by-owner regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38
+regen q ecocredit credit-locks-by-owner regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.CreditLocksByOwner(cmd.Context(), &types.QueryCreditLocksByOwnerRequest{
+				Owner:      args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "credit-locks-by-owner")
+
+	return qflags(cmd)
+}
+
+// QueryCreditLocksByBatchCmd returns a query command that retrieves all credit
+// locks for a credit batch with pagination.
+func QueryCreditLocksByBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "credit-locks-by-batch [batch-denom]",
+		Short: "List all credit locks by credit batch",
+		Long:  "List all credit locks by credit batch with optional pagination flags.",
+		Example: `regen q ecocredit credit-locks-by-batch C01-001-20200101-20210101-001
+regen q ecocredit credit-locks-by-batch C01-001-20200101-20210101-001 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.CreditLocksByBatch(cmd.Context(), &types.QueryCreditLocksByBatchRequest{
+				BatchDenom: args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "credit-locks-by-batch")
+
+	return qflags(cmd)
+}
+
+// QueryClassBufferCmd returns a query command that retrieves the buffer pool
+// address and buffer percentage of a credit class.
+func QueryClassBufferCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "class-buffer [class-id]",
+		Short:   "Retrieve the buffer pool of a credit class",
+		Long:    "Retrieve the buffer pool address and buffer percentage of a credit class.",
+		Example: "regen q ecocredit class-buffer C01",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ClassBuffer(cmd.Context(), &types.QueryClassBufferRequest{
+				ClassId: args[0],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryReversalCmd returns a query command that retrieves reversal information.
+func QueryReversalCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "reversal [id]",
+		Short:   "Retrieve reversal information",
+		Long:    "Retrieve reversal information by reversal id.",
+		Example: "regen q ecocredit reversal 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid reversal id: %s", err)
+			}
+
+			res, err := c.Reversal(cmd.Context(), &types.QueryReversalRequest{
+				Id: id,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryReversalsByClassCmd returns a query command that retrieves all reversal
+// records for a credit class with pagination.
+func QueryReversalsByClassCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reversals-by-class [class-id]",
+		Short: "List all reversals by credit class",
+		Long:  "List all reversals by credit class with optional pagination flags.",
+		Example: `regen q ecocredit reversals-by-class C01
+regen q ecocredit reversals-by-class C01 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ReversalsByClass(cmd.Context(), &types.QueryReversalsByClassRequest{
+				ClassId:    args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "reversals-by-class")
+
+	return qflags(cmd)
+}
+
+// QueryClassVerifiersCmd returns a query command that retrieves the verifiers
+// of a credit class with pagination.
+func QueryClassVerifiersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-verifiers [class-id]",
+		Short: "Retrieve verifier addresses for a credit class",
+		Long:  "Retrieve verifier addresses for a credit class with optional pagination flags.",
+		Example: `regen q ecocredit class-verifiers C01
+regen q ecocredit class-verifiers C01 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ClassVerifiers(cmd.Context(), &types.QueryClassVerifiersRequest{
+				ClassId:    args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "class-verifiers")
+
+	return qflags(cmd)
+}
+
+// QueryProjectsByStatusCmd returns a query command that retrieves all projects
+// with a given status with pagination.
+func QueryProjectsByStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projects-by-status [status]",
+		Short: "List all projects with a given status",
+		Long: `List all projects with a given status with optional pagination flags. The status
+must be one of proposed, registered, validated, active, suspended, or retired.`,
+		Example: `regen q ecocredit projects-by-status active
+regen q ecocredit projects-by-status suspended --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := parseProjectStatus(args[0])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ProjectsByStatus(cmd.Context(), &types.QueryProjectsByStatusRequest{
+				Status:     status,
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "projects-by-status")
+
+	return qflags(cmd)
+}
+
+// QueryProjectStatusHistoryCmd returns a query command that retrieves the
+// status history of a project with pagination.
+func QueryProjectStatusHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project-status-history [project-id]",
+		Short: "List the status history of a project",
+		Long:  "List the status history of a project with optional pagination flags.",
+		Example: `regen q ecocredit project-status-history C01-001
+regen q ecocredit project-status-history C01-001 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ProjectStatusHistory(cmd.Context(), &types.QueryProjectStatusHistoryRequest{
+				ProjectId:  args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "project-status-history")
+
+	return qflags(cmd)
+}
+
+// QueryIssuanceHeadroomCmd returns a query command that retrieves the remaining
+// amount of credits an issuer can issue for a project within a vintage year.
+func QueryIssuanceHeadroomCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issuance-headroom [project-id] [issuer] [vintage-year]",
+		Short: "Retrieve the remaining issuance of an issuer for a project within a vintage year",
+		Long: `Retrieve the remaining amount of credits an issuer can issue for a project within a
+vintage year given the issuance caps of the credit class. Remaining amounts are empty when
+there is no cap.`,
+		Example: "regen q ecocredit issuance-headroom C01-001 regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4 2020",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vintageYear, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid vintage year: %s", err)
+			}
+
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.IssuanceHeadroom(cmd.Context(), &types.QueryIssuanceHeadroomRequest{
+				ProjectId:   args[0],
+				Issuer:      args[1],
+				VintageYear: uint32(vintageYear),
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	return qflags(cmd)
+}
+
+// QueryClassBatchApprovalCmd returns a query command that retrieves the batch
+// approval setting of a credit class.
+func QueryClassBatchApprovalCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "class-batch-approval [class-id]",
+		Short:   "Retrieve the batch approval setting of a credit class",
+		Long:    "Retrieve whether credit batches issued within a credit class must be approved and the expiry period of pending batches.",
+		Example: "regen q ecocredit class-batch-approval C01",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ClassBatchApproval(cmd.Context(), &types.QueryClassBatchApprovalRequest{
+				ClassId: args[0],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryPendingBatchCmd returns a query command that retrieves pending batch
+// information.
+func QueryPendingBatchCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "pending-batch [id]",
+		Short:   "Retrieve pending batch information",
+		Long:    "Retrieve pending batch information by pending batch id.",
+		Example: "regen q ecocredit pending-batch 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid pending batch id: %s", err)
+			}
+
+			res, err := c.PendingBatch(cmd.Context(), &types.QueryPendingBatchRequest{
+				Id: id,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryPendingBatchesByClassCmd returns a query command that retrieves all
+// pending batches for a credit class with pagination.
+func QueryPendingBatchesByClassCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-batches-by-class [class-id]",
+		Short: "List all pending batches by credit class",
+		Long:  "List all pending batches by credit class with optional pagination flags.",
+		Example: `regen q ecocredit pending-batches-by-class C01
+regen q ecocredit pending-batches-by-class C01 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.PendingBatchesByClass(cmd.Context(), &types.QueryPendingBatchesByClassRequest{
+				ClassId:    args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "pending-batches-by-class")
+
+	return qflags(cmd)
+}
+
+// QueryBatchMetadataHistoryCmd returns a query command that retrieves the
+// metadata history of a credit batch with pagination.
+func QueryBatchMetadataHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-metadata-history [batch-denom]",
+		Short: "Retrieve the metadata history of a credit batch",
+		Long:  "Retrieve the metadata changes of a credit batch in the order they occurred with optional pagination flags.",
+		Example: `regen q ecocredit batch-metadata-history C01-001-20200101-20210101-001
+regen q ecocredit batch-metadata-history C01-001-20200101-20210101-001 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.BatchMetadataHistory(cmd.Context(), &types.QueryBatchMetadataHistoryRequest{
+				BatchDenom: args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "batch-metadata-history")
+
+	return qflags(cmd)
+}
+
+// QueryClassBatchMetadataCosignCmd returns a query command that retrieves
+// whether the admin of a credit class must co-sign batch metadata updates.
+func QueryClassBatchMetadataCosignCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "class-batch-metadata-cosign [class-id]",
+		Short:   "Retrieve whether the class admin must co-sign batch metadata updates",
+		Long:    "Retrieve whether the admin of a credit class must co-sign updates to the metadata of the credit batches of the credit class.",
+		Example: "regen q ecocredit class-batch-metadata-cosign C01",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ClassBatchMetadataCosign(cmd.Context(), &types.QueryClassBatchMetadataCosignRequest{
+				ClassId: args[0],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryProjectSupplyCmd returns a query command that retrieves the aggregated
+// supply of all credit batches issued within a project.
+func QueryProjectSupplyCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "project-supply [project-id]",
+		Short:   "Retrieve the aggregated supply of a project",
+		Long:    "Retrieve the aggregated tradable, retired, and cancelled supply of all credit batches issued within a project.",
+		Example: "regen q ecocredit project-supply C01-001",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := c.SupplyByProject(cmd.Context(), &types.QuerySupplyByProjectRequest{
+				ProjectId: args[0],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryClassSupplyCmd returns a query command that retrieves the aggregated
+// supply of all credit batches issued within a credit class.
+func QueryClassSupplyCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "class-supply [class-id]",
+		Short:   "Retrieve the aggregated supply of a credit class",
+		Long:    "Retrieve the aggregated tradable, retired, and cancelled supply of all credit batches issued within a credit class.",
+		Example: "regen q ecocredit class-supply C01",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := c.SupplyByClass(cmd.Context(), &types.QuerySupplyByClassRequest{
+				ClassId: args[0],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryCreditTypeSupplyCmd returns a query command that retrieves the
+// aggregated supply of all credit batches issued within credit classes of a
+// credit type.
+func QueryCreditTypeSupplyCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "credit-type-supply [credit-type-abbrev]",
+		Short:   "Retrieve the aggregated supply of a credit type",
+		Long:    "Retrieve the aggregated tradable, retired, and cancelled supply of all credit batches issued within credit classes of a credit type.",
+		Example: "regen q ecocredit credit-type-supply C",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := c.SupplyByCreditType(cmd.Context(), &types.QuerySupplyByCreditTypeRequest{
+				CreditTypeAbbrev: args[0],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryBalancesByClassCmd returns a query command that retrieves the credit
+// batch balances of an account within a credit class.
+func QueryBalancesByClassCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balances-by-class [address] [class-id]",
+		Short: "Retrieve the credit batch balances of an account within a credit class",
+		Long:  "Retrieve the credit batch balances of an account within a credit class with optional pagination flags.",
+		Example: `regen q ecocredit balances-by-class regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 C01
+regen q ecocredit balances-by-class regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 C01 --limit 10 --reverse`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.BalancesByClass(cmd.Context(), &types.QueryBalancesByClassRequest{
+				Address:    args[0],
+				ClassId:    args[1],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "balances-by-class")
+
+	return qflags(cmd)
+}
+
+// QueryBalancesByProjectCmd returns a query command that retrieves the credit
+// batch balances of an account within a project.
+func QueryBalancesByProjectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balances-by-project [address] [project-id]",
+		Short: "Retrieve the credit batch balances of an account within a project",
+		Long:  "Retrieve the credit batch balances of an account within a project with optional pagination flags.",
+		Example: `regen q ecocredit balances-by-project regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 C01-001
+regen q ecocredit balances-by-project regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 C01-001 --limit 10 --reverse`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.BalancesByProject(cmd.Context(), &types.QueryBalancesByProjectRequest{
+				Address:    args[0],
+				ProjectId:  args[1],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "balances-by-project")
+
+	return qflags(cmd)
+}
+
+// QueryHoldersByProjectCmd returns a query command that retrieves the accounts
+// holding credits from credit batches issued within a project.
+func QueryHoldersByProjectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holders-by-project [project-id]",
+		Short: "Retrieve the holders of credits issued within a project",
+		Long:  "Retrieve the accounts holding credits issued within a project and their aggregated balances with optional pagination flags.",
+		Example: `regen q ecocredit holders-by-project C01-001
+regen q ecocredit holders-by-project C01-001 --limit 10 --reverse`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.HoldersByProject(cmd.Context(), &types.QueryHoldersByProjectRequest{
+				ProjectId:  args[0],
+				Pagination: pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "holders-by-project")
+
+	return qflags(cmd)
+}
+
+// QueryRetirementSummaryCmd returns a query command that retrieves the total
+// amount of credits retired by an account within a calendar year.
+func QueryRetirementSummaryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retirement-summary [owner] [year]",
+		Short: "Retrieve the total credits retired by an account within a year",
+		Long: `Retrieve the total amount of credits retired by an account within a calendar year (UTC),
+grouped by credit type and retirement jurisdiction, with optional pagination flags.
+
+Retirements made before the upgrade that introduced retirement records are not included.`,
+		Example: `regen q ecocredit retirement-summary regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 2022
+regen q ecocredit retirement-summary regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 2022 --credit-type C`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			year, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid year: %s", err)
+			}
+
+			creditType, err := cmd.Flags().GetString(FlagCreditType)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.RetirementSummary(cmd.Context(), &types.QueryRetirementSummaryRequest{
+				Owner:            args[0],
+				Year:             uint32(year),
+				CreditTypeAbbrev: creditType,
+				Pagination:       pagination,
+			})
+
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	cmd.Flags().String(FlagCreditType, "", "only include retirements of the given credit type abbreviation")
+	flags.AddPaginationFlagsToCmd(cmd, "retirement-summary")
+
+	return qflags(cmd)
+}
+
+// QueryBatchByOriginTxCmd returns a query command that retrieves the credit
+// batch that was created or had credits minted by an originating transaction.
+func QueryBatchByOriginTxCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "batch-by-origin-tx [class-id] [source] [id]",
+		Short:   "Retrieve the credit batch created or minted by an origin tx",
+		Long:    "Retrieve the credit batch that was created or had credits minted by a transaction from another chain or registry.",
+		Example: "regen q ecocredit batch-by-origin-tx C01 polygon 0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := c.BatchByOriginTx(cmd.Context(), &types.QueryBatchByOriginTxRequest{
+				ClassId: args[0],
+				Source:  args[1],
+				Id:      args[2],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryStrictJurisdictionsCmd returns a query command that retrieves the
+// strict jurisdictions enabled setting.
+func QueryStrictJurisdictionsCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "strict-jurisdictions",
+		Short:   "Retrieve the strict jurisdictions enabled setting",
+		Long:    "Retrieve whether jurisdictions are validated against the ISO 3166-1 and ISO 3166-2 datasets.",
+		Example: "regen q ecocredit strict-jurisdictions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := c.StrictJurisdictions(cmd.Context(), &types.QueryStrictJurisdictionsRequest{})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryProjectsByJurisdictionCmd returns a query command that retrieves all
+// projects within a jurisdiction with pagination.
+func QueryProjectsByJurisdictionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projects-by-jurisdiction [jurisdiction]",
+		Short: "List projects by jurisdiction",
+		Long: `List projects by jurisdiction with optional pagination flags.
+
+Projects within nested jurisdictions are included (e.g. querying by "US" includes
+projects with jurisdiction "US-WA" and "US-WA 98225").`,
+		Example: `regen q ecocredit projects-by-jurisdiction US
+regen q ecocredit projects-by-jurisdiction US-WA --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ProjectsByJurisdiction(cmd.Context(), &types.QueryProjectsByJurisdictionRequest{
+				Jurisdiction: args[0],
+				Pagination:   pagination,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "projects-by-jurisdiction")
+	return qflags(cmd)
+}
+
+// QueryRetirementsByJurisdictionCmd returns a query command that retrieves all
+// retirement records within a jurisdiction with pagination.
+func QueryRetirementsByJurisdictionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retirements-by-jurisdiction [jurisdiction]",
+		Short: "List retirements by jurisdiction",
+		Long: `List retirements by jurisdiction with optional pagination flags.
+
+Retirements within nested jurisdictions are included (e.g. querying by "US"
+includes retirements with jurisdiction "US-WA" and "US-WA 98225").`,
+		Example: `regen q ecocredit retirements-by-jurisdiction US
+regen q ecocredit retirements-by-jurisdiction US-WA --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.RetirementsByJurisdiction(cmd.Context(), &types.QueryRetirementsByJurisdictionRequest{
+				Jurisdiction: args[0],
+				Pagination:   pagination,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "retirements-by-jurisdiction")
+	return qflags(cmd)
+}
+
+// QueryNationalRegistriesCmd returns a query command that retrieves all
+// national registries with pagination.
+func QueryNationalRegistriesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "national-registries",
+		Short: "List national registries",
+		Long:  "List national registry addresses authorized to set Article 6 authorization records with optional pagination flags.",
+		Example: `regen q ecocredit national-registries
+regen q ecocredit national-registries --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.NationalRegistries(cmd.Context(), &types.QueryNationalRegistriesRequest{
+				Pagination: pagination,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "national-registries")
+	return qflags(cmd)
+}
+
+// QueryArticle6AuthorizationsByBatchCmd returns a query command that retrieves
+// all Article 6 authorization records of a credit batch with pagination.
+func QueryArticle6AuthorizationsByBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "article6-authorizations-by-batch [batch-denom]",
+		Short: "List Article 6 authorization records by credit batch",
+		Long: `List Article 6 authorization records of a credit batch and its retirements with
+optional pagination flags.`,
+		Example: `regen q ecocredit article6-authorizations-by-batch C01-001-20200101-20210101-001
+regen q ecocredit article6-authorizations-by-batch C01-001-20200101-20210101-001 --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.Article6AuthorizationsByBatch(cmd.Context(), &types.QueryArticle6AuthorizationsByBatchRequest{
+				BatchDenom: args[0],
+				Pagination: pagination,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "article6-authorizations-by-batch")
+	return qflags(cmd)
+}
+
+// QueryClassSerializationCmd returns a query command that retrieves whether
+// the credits of a credit class are serialized.
+func QueryClassSerializationCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "class-serialization [class-id]",
+		Short:   "Retrieve whether the credits of a credit class are serialized",
+		Long:    "Retrieve whether the credits of a credit class are identified by contiguous serial number ranges.",
+		Example: "regen q ecocredit class-serialization C01",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ClassSerialization(cmd.Context(), &types.QueryClassSerializationRequest{
+				ClassId: args[0],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QuerySerialsByBatchCmd returns a query command that retrieves all serial
+// number ranges of a credit batch with pagination.
+func QuerySerialsByBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serials-by-batch [batch-denom]",
+		Short: "List serial number ranges by credit batch",
+		Long:  "List serial number ranges of a serialized credit batch with optional pagination flags.",
+		Example: `regen q ecocredit serials-by-batch C01-001-20200101-20210101-001
+regen q ecocredit serials-by-batch C01-001-20200101-20210101-001 --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.SerialsByBatch(cmd.Context(), &types.QuerySerialsByBatchRequest{
+				BatchDenom: args[0],
+				Pagination: pagination,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "serials-by-batch")
+	return qflags(cmd)
+}
+
+// QuerySerialsByOwnerCmd returns a query command that retrieves all serial
+// number ranges owned by an account with pagination.
+func QuerySerialsByOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serials-by-owner [owner]",
+		Short: "List serial number ranges by owner",
+		Long:  "List serial number ranges owned by an account with optional pagination flags.",
+		Example: `regen q ecocredit serials-by-owner regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38
+regen q ecocredit serials-by-owner regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.SerialsByOwner(cmd.Context(), &types.QuerySerialsByOwnerRequest{
+				Owner:      args[0],
+				Pagination: pagination,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "serials-by-owner")
+	return qflags(cmd)
+}
+
+// QueryBridgeRequestsByOwnerCmd returns a query command that retrieves the
+// bridge requests of an account.
+func QueryBridgeRequestsByOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-requests-by-owner [owner]",
+		Short: "List bridge requests by owner",
+		Long:  "List bridge requests of an account with optional pagination flags.",
+		Example: `regen q ecocredit bridge-requests-by-owner regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38
+regen q ecocredit bridge-requests-by-owner regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.BridgeRequestsByOwner(cmd.Context(), &types.QueryBridgeRequestsByOwnerRequest{
+				Owner:      args[0],
+				Pagination: pagination,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "bridge-requests-by-owner")
+	return qflags(cmd)
+}
+
+// QueryBridgeRequestsByStatusCmd returns a query command that retrieves the
+// bridge requests with a given status.
+func QueryBridgeRequestsByStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bridge-requests-by-status [status]",
+		Short: "List bridge requests by status",
+		Long: `List bridge requests with a given status with optional pagination flags.
+
+The status must be one of pending, completed, or failed.`,
+		Example: `regen q ecocredit bridge-requests-by-status pending
+regen q ecocredit bridge-requests-by-status failed --limit 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := parseBridgeRequestStatus(args[0])
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.BridgeRequestsByStatus(cmd.Context(), &types.QueryBridgeRequestsByStatusRequest{
+				Status:     status,
+				Pagination: pagination,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "bridge-requests-by-status")
+	return qflags(cmd)
+}
+
+// QueryAllowedBridgeServicesCmd returns a query command that retrieves the
+// allowed bridge services of a bridge chain.
+func QueryAllowedBridgeServicesCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "allowed-bridge-services [chain-name]",
+		Short:   "Retrieve the list of allowed bridge services of a chain",
+		Long:    "Retrieve the list of bridge service addresses that are allowed to bridge credits from and to a chain.",
+		Example: "regen q ecocredit allowed-bridge-services polygon",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := c.AllowedBridgeServices(cmd.Context(), &types.QueryAllowedBridgeServicesRequest{
+				ChainName: args[0],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryAllowedBridgeContractsCmd returns a query command that retrieves the
+// allowed bridge contracts of a bridge chain.
+func QueryAllowedBridgeContractsCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "allowed-bridge-contracts [chain-name]",
+		Short:   "Retrieve the list of allowed bridge contracts of a chain",
+		Long:    "Retrieve the list of contract addresses on a chain that credits are allowed to be bridged from and to.",
+		Example: "regen q ecocredit allowed-bridge-contracts polygon",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+			res, err := c.AllowedBridgeContracts(cmd.Context(), &types.QueryAllowedBridgeContractsRequest{
+				ChainName: args[0],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
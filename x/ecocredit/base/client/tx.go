@@ -6,13 +6,16 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	regentypes "github.com/regen-network/regen-ledger/types"
 	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
@@ -23,7 +26,26 @@ const (
 	FlagRemoveIssuers          string = "remove-issuers"
 	FlagReferenceID            string = "reference-id"
 	FlagRetirementJurisdiction string = "retirement-jurisdiction"
+	FlagRetirementReason       string = "retirement-reason"
+	FlagRetirementBeneficiary  string = "retirement-beneficiary"
 	FlagClassFee               string = "class-fee"
+	FlagBatchDenom             string = "batch-denom"
+	FlagClassID                string = "class-id"
+	FlagAllowedRecipients      string = "allowed-recipients"
+	FlagAllowedJurisdictions   string = "allowed-jurisdictions"
+	FlagExpiration             string = "expiration"
+	FlagLockType               string = "lock-type"
+	FlagStartDate              string = "start-date"
+	FlagReason                 string = "reason"
+	FlagAddVerifiers           string = "add-verifiers"
+	FlagRemoveVerifiers        string = "remove-verifiers"
+	FlagIssuerCaps             string = "issuer-caps"
+	FlagProjectVintageCap      string = "project-vintage-cap"
+	FlagExpiryPeriod           string = "expiry-period"
+	FlagClassAdmin             string = "class-admin"
+	FlagStatus                 string = "status"
+	FlagCreditType             string = "credit-type"
+	FlagRetirementID           string = "retirement-id"
 )
 
 // TxCreateClassCmd returns a transaction command that creates a credit class.
@@ -292,6 +314,7 @@ func TxSendCmd() *cobra.Command {
 		Long: `Sends credits from a single batch from the transaction author (--from) to the recipient.
 
 By default, the credits will be sent as tradable. Use the --retirement-jurisdiction flag to retire the credits to the recipient address.
+The --retirement-reason and --retirement-beneficiary flags can be used to record why and on whose behalf the credits were retired.
 
 Parameters:
 
@@ -309,6 +332,14 @@ Parameters:
 			if err != nil {
 				return err
 			}
+			reason, err := cmd.Flags().GetString(FlagRetirementReason)
+			if err != nil {
+				return err
+			}
+			beneficiary, err := cmd.Flags().GetString(FlagRetirementBeneficiary)
+			if err != nil {
+				return err
+			}
 			tradableAmount := args[0]
 			retiredAmount := "0"
 			if len(retireTo) > 0 {
@@ -320,6 +351,8 @@ Parameters:
 				BatchDenom:             args[1],
 				RetiredAmount:          retiredAmount,
 				RetirementJurisdiction: retireTo,
+				RetirementReason:       reason,
+				RetirementBeneficiary:  beneficiary,
 			}
 			msg := types.MsgSend{
 				Sender:    clientCtx.GetFromAddress().String(),
@@ -330,6 +363,8 @@ Parameters:
 		},
 	}
 	cmd.Flags().String(FlagRetirementJurisdiction, "", "Jurisdiction to retire the credits to. If empty, credits are not retired. (default empty)")
+	cmd.Flags().String(FlagRetirementReason, "", "the reason for retiring the credits")
+	cmd.Flags().String(FlagRetirementBeneficiary, "", "the address or name of the beneficiary of the retirement")
 	return txFlags(cmd)
 }
 
@@ -358,7 +393,9 @@ Example JSON:
     "batch_denom": "C01-001-20200101-20210101-002",
     "tradable_amount": "50",
     "retired_amount": "100",
-    "retirement_jurisdiction": "YY-ZZ 12345"
+    "retirement_jurisdiction": "YY-ZZ 12345",
+    "retirement_reason": "offsetting 2022 emissions",
+    "retirement_beneficiary": "Acme Inc."
   }
 ]`,
 		Args: cobra.ExactArgs(2),
@@ -397,7 +434,12 @@ func TxRetireCmd() *cobra.Command {
 Parameters:
 
 - credits:                  path to JSON file containing credits to retire
-- retirement-jurisdiction:  the jurisdiction in which the credit will be retired`,
+- retirement-jurisdiction:  the jurisdiction in which the credit will be retired
+
+Optional Flags:
+
+- retirement-reason:       the reason for retiring the credits
+- retirement-beneficiary:  the address or name of the beneficiary of the retirement`,
 		Example: `
 regen tx ecocredit retire credits.json "US-WA 98225"
 
@@ -425,16 +467,30 @@ Example JSON:
 				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
 			}
 
+			reason, err := cmd.Flags().GetString(FlagRetirementReason)
+			if err != nil {
+				return err
+			}
+
+			beneficiary, err := cmd.Flags().GetString(FlagRetirementBeneficiary)
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgRetire{
 				Owner:        clientCtx.GetFromAddress().String(),
 				Credits:      credits,
 				Jurisdiction: args[1],
+				Reason:       reason,
+				Beneficiary:  beneficiary,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	cmd.Flags().String(FlagRetirementReason, "", "the reason for retiring the credits")
+	cmd.Flags().String(FlagRetirementBeneficiary, "", "the address or name of the beneficiary of the retirement")
 	return txFlags(cmd)
 }
 
@@ -571,10 +627,15 @@ Parameters:
 
 Flags:
 
-- add-issuers:     the new list of issuers to add to the class issuers list
-- remove-issuers:  the new list of issuers to remove from the class issuers list`,
+- add-issuers:          the new list of issuers to add to the class issuers list
+- remove-issuers:       the new list of issuers to remove from the class issuers list
+- issuer-caps:          the issuance caps of class issuers in the format <issuer>=<max-issuance>
+                        (an empty max issuance removes the cap of the issuer)
+- project-vintage-cap:  the issuance cap of each project within each vintage year
+                        (an empty value removes the cap)`,
 		Example: `regen tx ecocredit update-class-issuers C01 --add-issuers addr1,addr2,addr3
-regen tx ecocredit update-class-issuers C01 --add-issuers addr1,addr2 --remove-issuers addr3,addr4`,
+regen tx ecocredit update-class-issuers C01 --add-issuers addr1,addr2 --remove-issuers addr3,addr4
+regen tx ecocredit update-class-issuers C01 --issuer-caps addr1=1000,addr2= --project-vintage-cap 500`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 
@@ -596,16 +657,40 @@ regen tx ecocredit update-class-issuers C01 --add-issuers addr1,addr2 --remove-i
 				removeIssuers[i] = strings.TrimSpace(removeIssuers[i])
 			}
 
+			// parse issuer caps
+			caps, err := cmd.Flags().GetStringSlice(FlagIssuerCaps)
+			if err != nil {
+				return err
+			}
+			issuerCaps, err := parseIssuerCaps(caps)
+			if err != nil {
+				return err
+			}
+
+			// parse project vintage cap (only set when the flag is provided)
+			var projectVintageCap *types.MsgUpdateClassIssuers_ProjectVintageCap
+			if cmd.Flags().Changed(FlagProjectVintageCap) {
+				maxIssuance, err := cmd.Flags().GetString(FlagProjectVintageCap)
+				if err != nil {
+					return err
+				}
+				projectVintageCap = &types.MsgUpdateClassIssuers_ProjectVintageCap{
+					MaxIssuance: strings.TrimSpace(maxIssuance),
+				}
+			}
+
 			clientCtx, err := sdkclient.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
 			msg := types.MsgUpdateClassIssuers{
-				Admin:         clientCtx.GetFromAddress().String(),
-				ClassId:       args[0],
-				AddIssuers:    addIssuers,
-				RemoveIssuers: removeIssuers,
+				Admin:             clientCtx.GetFromAddress().String(),
+				ClassId:           args[0],
+				AddIssuers:        addIssuers,
+				RemoveIssuers:     removeIssuers,
+				IssuerCaps:        issuerCaps,
+				ProjectVintageCap: projectVintageCap,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
@@ -614,6 +699,8 @@ regen tx ecocredit update-class-issuers C01 --add-issuers addr1,addr2 --remove-i
 
 	cmd.Flags().StringSlice(FlagAddIssuers, []string{}, "comma separated (no spaces) list of addresses")
 	cmd.Flags().StringSlice(FlagRemoveIssuers, []string{}, "comma separated (no spaces) list of addresses")
+	cmd.Flags().StringSlice(FlagIssuerCaps, []string{}, "comma separated (no spaces) list of <issuer>=<max-issuance>")
+	cmd.Flags().String(FlagProjectVintageCap, "", "the issuance cap of each project within each vintage year")
 
 	return txFlags(cmd)
 }
@@ -678,3 +765,1457 @@ func TxUpdateProjectMetadataCmd() *cobra.Command {
 
 	return txFlags(cmd)
 }
+
+// TxApproveCmd returns a transaction command that approves a spender to transfer
+// or retire credits on behalf of the transaction author.
+func TxApproveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [spender] [amount] [flags]",
+		Short: "Approves a spender to transfer or retire credits owned by the transaction author (--from)",
+		Long: `Approves a spender to transfer or retire credits owned by the transaction author (--from).
+
+The allowance applies either to a single credit batch (--batch-denom) or to all credit batches
+within a credit class (--class-id). Exactly one of the two flags must be set. Approving a spender
+again overwrites the existing allowance.
+
+Parameters:
+
+- spender:  the address of the account approved to spend the credits
+- amount:   the maximum amount of credits the spender is allowed to spend
+
+Flags:
+
+- batch-denom:  the denom of the credit batch the allowance applies to
+- class-id:     the id of the credit class the allowance applies to`,
+		Example: `regen tx ecocredit approve regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf 100 --batch-denom C01-001-20200101-20210101-001 --from myKey
+regen tx ecocredit approve regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf 100 --class-id C01 --from myKey`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			batchDenom, err := cmd.Flags().GetString(FlagBatchDenom)
+			if err != nil {
+				return err
+			}
+
+			classID, err := cmd.Flags().GetString(FlagClassID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgApprove{
+				Owner:      clientCtx.GetFromAddress().String(),
+				Spender:    args[0],
+				BatchDenom: batchDenom,
+				ClassId:    classID,
+				Amount:     args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagBatchDenom, "", "the denom of the credit batch the allowance applies to")
+	cmd.Flags().String(FlagClassID, "", "the id of the credit class the allowance applies to")
+
+	return txFlags(cmd)
+}
+
+// TxRevokeApprovalCmd returns a transaction command that revokes an allowance
+// granted by the transaction author.
+func TxRevokeApprovalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-approval [spender] [flags]",
+		Short: "Revokes an allowance granted by the transaction author (--from) to a spender",
+		Long: `Revokes an allowance granted by the transaction author (--from) to a spender.
+
+Parameters:
+
+- spender:  the address of the account the allowance was granted to
+
+Flags:
+
+- batch-denom:  the denom of the credit batch the allowance applies to
+- class-id:     the id of the credit class the allowance applies to`,
+		Example: `regen tx ecocredit revoke-approval regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf --batch-denom C01-001-20200101-20210101-001 --from myKey
+regen tx ecocredit revoke-approval regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf --class-id C01 --from myKey`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			batchDenom, err := cmd.Flags().GetString(FlagBatchDenom)
+			if err != nil {
+				return err
+			}
+
+			classID, err := cmd.Flags().GetString(FlagClassID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRevokeApproval{
+				Owner:      clientCtx.GetFromAddress().String(),
+				Spender:    args[0],
+				BatchDenom: batchDenom,
+				ClassId:    classID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagBatchDenom, "", "the denom of the credit batch the allowance applies to")
+	cmd.Flags().String(FlagClassID, "", "the id of the credit class the allowance applies to")
+
+	return txFlags(cmd)
+}
+
+// TxSendFromCmd returns a transaction command that sends credits from a single
+// batch on behalf of the owner using an allowance.
+func TxSendFromCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-from [owner] [amount] [batch-denom] [recipient] [flags]",
+		Short: "Sends credits on behalf of the owner using an allowance granted to the transaction author (--from)",
+		Long: `Sends credits from a single batch on behalf of the owner using an allowance granted to the
+transaction author (--from).
+
+By default, the credits will be sent as tradable. Use the --retirement-jurisdiction flag to retire the credits to the recipient address.
+The --retirement-reason and --retirement-beneficiary flags can be used to record why and on whose behalf the credits were retired.
+
+Parameters:
+
+- owner:        the address of the account that owns the credits
+- amount:       the amount of credits to send
+- batch-denom:  the denomination of the credit batch
+- recipient:    the recipient account address`,
+		Example: "regen tx ecocredit send-from regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw 20 C01-001-20200101-20210101-001 regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf --from myKey",
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			retireTo, err := cmd.Flags().GetString(FlagRetirementJurisdiction)
+			if err != nil {
+				return err
+			}
+			reason, err := cmd.Flags().GetString(FlagRetirementReason)
+			if err != nil {
+				return err
+			}
+			beneficiary, err := cmd.Flags().GetString(FlagRetirementBeneficiary)
+			if err != nil {
+				return err
+			}
+			tradableAmount := args[1]
+			retiredAmount := "0"
+			if len(retireTo) > 0 {
+				tradableAmount = "0"
+				retiredAmount = args[1]
+			}
+			credit := types.MsgSend_SendCredits{
+				TradableAmount:         tradableAmount,
+				BatchDenom:             args[2],
+				RetiredAmount:          retiredAmount,
+				RetirementJurisdiction: retireTo,
+				RetirementReason:       reason,
+				RetirementBeneficiary:  beneficiary,
+			}
+			msg := types.MsgSendFrom{
+				Spender:   clientCtx.GetFromAddress().String(),
+				Owner:     args[0],
+				Recipient: args[3],
+				Credits:   []*types.MsgSend_SendCredits{&credit},
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().String(FlagRetirementJurisdiction, "", "Jurisdiction to retire the credits to. If empty, credits are not retired. (default empty)")
+	cmd.Flags().String(FlagRetirementReason, "", "the reason for retiring the credits")
+	cmd.Flags().String(FlagRetirementBeneficiary, "", "the address or name of the beneficiary of the retirement")
+	return txFlags(cmd)
+}
+
+// TxRetireFromCmd returns a transaction command that retires credits on behalf
+// of the owner using an allowance.
+func TxRetireFromCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retire-from [owner] [credits] [retirement-jurisdiction]",
+		Short: "Retires credits on behalf of the owner using an allowance granted to the transaction author (--from)",
+		Long: `Retires credits on behalf of the owner using an allowance granted to the transaction author (--from).
+The retired credits remain in the account of the owner.
+
+Parameters:
+
+- owner:                    the address of the account that owns the credits
+- credits:                  path to JSON file containing credits to retire
+- retirement-jurisdiction:  the jurisdiction in which the credit will be retired
+
+Optional Flags:
+
+- retirement-reason:       the reason for retiring the credits
+- retirement-beneficiary:  the address or name of the beneficiary of the retirement`,
+		Example: `
+regen tx ecocredit retire-from regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf credits.json "US-WA 98225"
+
+Example JSON:
+[
+  {
+    "batch_denom": "C01-001-20200101-20210101-001",
+    "amount": "5"
+  },
+  {
+    "batch_denom": "C01-001-20200101-20210101-002",
+    "amount": "10"
+  }
+]`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Parse the JSON file representing the credits
+			credits, err := parseCredits(args[1])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			reason, err := cmd.Flags().GetString(FlagRetirementReason)
+			if err != nil {
+				return err
+			}
+
+			beneficiary, err := cmd.Flags().GetString(FlagRetirementBeneficiary)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRetireFrom{
+				Spender:      clientCtx.GetFromAddress().String(),
+				Owner:        args[0],
+				Credits:      credits,
+				Jurisdiction: args[2],
+				Reason:       reason,
+				Beneficiary:  beneficiary,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagRetirementReason, "", "the reason for retiring the credits")
+	cmd.Flags().String(FlagRetirementBeneficiary, "", "the address or name of the beneficiary of the retirement")
+
+	return txFlags(cmd)
+}
+
+// TxGrantSendCmd returns a transaction command that grants a SendCreditsAuthorization.
+func TxGrantSendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-send [grantee] [spend-limits-json] [flags]",
+		Short: "Grants an authorization to send credits on behalf of the transaction author (--from)",
+		Long: `Grants an authorization to send credits on behalf of the transaction author (--from)
+using x/authz. The amount of credits sent (tradable and retired) is subtracted from the spend
+limit of the credit batch or, if no batch limit is set, the credit class each time the
+authorization is used.
+
+Parameters:
+
+- grantee:            the address of the account allowed to send credits
+- spend-limits-json:  path to JSON file containing the spend limits
+
+Optional Flags:
+
+- allowed-recipients:     comma-separated list of addresses credits can be sent to
+- allowed-jurisdictions:  comma-separated list of jurisdictions credits can be retired in
+- expiration:             expire time as Unix timestamp (0 for no expiry)`,
+		Example: `regen tx ecocredit grant-send regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf limits.json --allowed-recipients regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw
+
+Example JSON:
+
+[
+  {
+    "batch_denom": "C01-001-20200101-20210101-001",
+    "amount": "100"
+  },
+  {
+    "class_id": "C02",
+    "amount": "50"
+  }
+]`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			limits, err := parseCreditLimits(args[1])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			recipients, err := cmd.Flags().GetStringSlice(FlagAllowedRecipients)
+			if err != nil {
+				return err
+			}
+
+			jurisdictions, err := cmd.Flags().GetStringSlice(FlagAllowedJurisdictions)
+			if err != nil {
+				return err
+			}
+
+			expiration, err := parseExpiration(cmd)
+			if err != nil {
+				return err
+			}
+
+			authorization := &types.SendCreditsAuthorization{
+				SpendLimits:          limits,
+				AllowedRecipients:    recipients,
+				AllowedJurisdictions: jurisdictions,
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAllowedRecipients, []string{}, "comma-separated list of addresses credits can be sent to")
+	cmd.Flags().StringSlice(FlagAllowedJurisdictions, []string{}, "comma-separated list of jurisdictions credits can be retired in")
+	cmd.Flags().Int64(FlagExpiration, 0, "expire time as Unix timestamp (0 for no expiry)")
+
+	return txFlags(cmd)
+}
+
+// TxGrantRetireCmd returns a transaction command that grants a RetireCreditsAuthorization.
+func TxGrantRetireCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-retire [grantee] [retire-limits-json] [flags]",
+		Short: "Grants an authorization to retire credits on behalf of the transaction author (--from)",
+		Long: `Grants an authorization to retire credits on behalf of the transaction author (--from)
+using x/authz. The amount of credits retired is subtracted from the retire limit of the credit
+batch or, if no batch limit is set, the credit class each time the authorization is used.
+
+Parameters:
+
+- grantee:             the address of the account allowed to retire credits
+- retire-limits-json:  path to JSON file containing the retire limits
+
+Optional Flags:
+
+- allowed-jurisdictions:  comma-separated list of jurisdictions credits can be retired in
+- expiration:             expire time as Unix timestamp (0 for no expiry)`,
+		Example: `regen tx ecocredit grant-retire regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf limits.json --allowed-jurisdictions US-WA,US-OR
+
+Example JSON:
+
+[
+  {
+    "batch_denom": "C01-001-20200101-20210101-001",
+    "amount": "100"
+  },
+  {
+    "class_id": "C02",
+    "amount": "50"
+  }
+]`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			limits, err := parseCreditLimits(args[1])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			jurisdictions, err := cmd.Flags().GetStringSlice(FlagAllowedJurisdictions)
+			if err != nil {
+				return err
+			}
+
+			expiration, err := parseExpiration(cmd)
+			if err != nil {
+				return err
+			}
+
+			authorization := &types.RetireCreditsAuthorization{
+				RetireLimits:         limits,
+				AllowedJurisdictions: jurisdictions,
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAllowedJurisdictions, []string{}, "comma-separated list of jurisdictions credits can be retired in")
+	cmd.Flags().Int64(FlagExpiration, 0, "expire time as Unix timestamp (0 for no expiry)")
+
+	return txFlags(cmd)
+}
+
+// TxSendLockedCmd returns a transaction command that sends credits to a
+// recipient where they are locked according to a lock schedule.
+func TxSendLockedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-locked [recipient] [credits-json] [end-date] [flags]",
+		Short: "Sends credits from the transaction author (--from) to the recipient where they are locked until the end date",
+		Long: `Sends credits from the transaction author (--from) to the recipient where they are locked
+according to a lock schedule. Locked credits are unlocked into the tradable balance of the
+recipient at the end date (cliff) or gradually between the start date and the end date (linear).
+
+Parameters:
+
+- recipient:     the address of the account receiving the locked credits
+- credits-json:  path to JSON file containing credits to send
+- end-date:      the date the credits are fully unlocked (format: yyyy-mm-dd)
+
+Optional Flags:
+
+- lock-type:   the lock type of the lock schedule (cliff or linear)
+- start-date:  the date the credits begin to unlock (defaults to the block time)`,
+		Example: `regen tx ecocredit send-locked regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf credits.json 2025-01-01
+regen tx ecocredit send-locked regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf credits.json 2025-01-01 --lock-type linear --start-date 2024-01-01
+
+Example JSON:
+
+[
+  {
+    "batch_denom": "C01-001-20200101-20210101-001",
+    "amount": "5"
+  },
+  {
+    "batch_denom": "C01-001-20200101-20210101-002",
+    "amount": "10"
+  }
+]`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			credits, err := parseCredits(args[1])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			endDate, err := regentypes.ParseDate("end date", args[2])
+			if err != nil {
+				return err
+			}
+
+			lockTypeStr, err := cmd.Flags().GetString(FlagLockType)
+			if err != nil {
+				return err
+			}
+
+			var lockType types.LockType
+			switch lockTypeStr {
+			case "cliff":
+				lockType = types.LockType_LOCK_TYPE_CLIFF
+			case "linear":
+				lockType = types.LockType_LOCK_TYPE_LINEAR
+			default:
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid lock type %s: must be cliff or linear", lockTypeStr)
+			}
+
+			msg := types.MsgSendLocked{
+				Sender:    clientCtx.GetFromAddress().String(),
+				Recipient: args[0],
+				Credits:   credits,
+				LockType:  lockType,
+				EndTime:   &endDate,
+			}
+
+			startDateStr, err := cmd.Flags().GetString(FlagStartDate)
+			if err != nil {
+				return err
+			}
+
+			if startDateStr != "" {
+				startDate, err := regentypes.ParseDate("start date", startDateStr)
+				if err != nil {
+					return err
+				}
+				msg.StartTime = &startDate
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagLockType, "cliff", "the lock type of the lock schedule (cliff or linear)")
+	cmd.Flags().String(FlagStartDate, "", "the date the credits begin to unlock (format: yyyy-mm-dd)")
+
+	return txFlags(cmd)
+}
+
+// TxUpdateClassBufferCmd returns a transaction command that updates the buffer
+// percentage of a credit class.
+func TxUpdateClassBufferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class-buffer [class-id] [buffer-percentage]",
+		Short: "Updates the buffer percentage for a specific credit class",
+		Long: `Updates the buffer percentage for a specific credit class.
+
+The buffer percentage is the percentage of tradable credits withheld into the
+buffer pool of the credit class each time credits are issued. Setting the buffer
+percentage to zero removes the buffer.
+
+The '--from' flag must equal the credit class admin.
+
+Parameters:
+
+- class-id:           the ID of the credit class to update
+- buffer-percentage:  the percentage of issued credits withheld into the buffer pool (0-100)`,
+		Example: "regen tx ecocredit update-class-buffer C01 10",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUpdateClassBuffer{
+				Admin:            clientCtx.GetFromAddress().String(),
+				ClassId:          args[0],
+				BufferPercentage: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxReverseCreditsCmd returns a transaction command that reverses credits from
+// the buffer pool of a credit class.
+func TxReverseCreditsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reverse-credits [class-id] [name] [credits-json] [flags]",
+		Short: "Reverses credits from the buffer pool of a credit class",
+		Long: `Reverses credits from the buffer pool of a credit class in response to a
+reversal event (e.g. a wildfire). The reversed credits are cancelled and a
+reversal record is stored.
+
+The '--from' flag must equal the credit class admin or the governance authority.
+
+Parameters:
+
+- class-id:      the ID of the credit class
+- name:          the name of the reversal event
+- credits-json:  path to JSON file containing credits to reverse
+
+Optional Flags:
+
+- reason:  the reason for the reversal`,
+		Example: `regen tx ecocredit reverse-credits C01 "2023 Tahoe fire" credits.json
+regen tx ecocredit reverse-credits C01 "2023 Tahoe fire" credits.json --reason "wildfire"
+
+Example JSON:
+
+[
+  {
+    "batch_denom": "C01-001-20200101-20210101-001",
+    "amount": "5"
+  },
+  {
+    "batch_denom": "C01-001-20200101-20210101-002",
+    "amount": "10"
+  }
+]`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			credits, err := parseCredits(args[2])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgReverseCredits{
+				Signer:  clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Name:    args[1],
+				Reason:  reason,
+				Credits: credits,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "the reason for the reversal")
+
+	return txFlags(cmd)
+}
+
+// TxUpdateClassVerifiersCmd returns a transaction command that updates the
+// verifiers of a credit class.
+func TxUpdateClassVerifiersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class-verifiers [class-id]",
+		Short: "Update the list of verifiers for a specific credit class",
+		Long: `Update the list of verifiers for a specific credit class. Verifiers are
+authorized to update the status of projects within the credit class.
+
+The '--from' flag must equal the current credit class admin.
+
+Parameters:
+
+- class-id:  the ID of the credit class to update
+
+Flags:
+
+- add-verifiers:     the new list of verifiers to add to the class verifiers list
+- remove-verifiers:  the new list of verifiers to remove from the class verifiers list`,
+		Example: `regen tx ecocredit update-class-verifiers C01 --add-verifiers addr1,addr2,addr3
+regen tx ecocredit update-class-verifiers C01 --add-verifiers addr1,addr2 --remove-verifiers addr3,addr4`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			// parse add verifiers
+			addVerifiers, err := cmd.Flags().GetStringSlice(FlagAddVerifiers)
+			if err != nil {
+				return err
+			}
+			for i := range addVerifiers {
+				addVerifiers[i] = strings.TrimSpace(addVerifiers[i])
+			}
+
+			// parse remove verifiers
+			removeVerifiers, err := cmd.Flags().GetStringSlice(FlagRemoveVerifiers)
+			if err != nil {
+				return err
+			}
+			for i := range removeVerifiers {
+				removeVerifiers[i] = strings.TrimSpace(removeVerifiers[i])
+			}
+
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUpdateClassVerifiers{
+				Admin:           clientCtx.GetFromAddress().String(),
+				ClassId:         args[0],
+				AddVerifiers:    addVerifiers,
+				RemoveVerifiers: removeVerifiers,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAddVerifiers, []string{}, "comma separated (no spaces) list of addresses")
+	cmd.Flags().StringSlice(FlagRemoveVerifiers, []string{}, "comma separated (no spaces) list of addresses")
+
+	return txFlags(cmd)
+}
+
+// TxUpdateProjectStatusCmd returns a transaction command that updates the
+// lifecycle status of a project.
+func TxUpdateProjectStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-project-status [project-id] [status] [flags]",
+		Short: "Updates the lifecycle status of a project",
+		Long: `Updates the lifecycle status of a project. Credits can only be issued for a
+project with an active status or a project whose status has never been updated.
+The status of a retired project cannot be updated.
+
+The '--from' flag must equal the credit class admin or a credit class verifier.
+
+Parameters:
+
+- project-id:  the ID of the project to update
+- status:      the new status of the project (proposed, registered, validated,
+               active, suspended, or retired)
+
+Optional Flags:
+
+- reason:  the reason for updating the status`,
+		Example: `regen tx ecocredit update-project-status C01-001 active
+regen tx ecocredit update-project-status C01-001 suspended --reason "pending investigation"`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := parseProjectStatus(args[1])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUpdateProjectStatus{
+				Signer:    clientCtx.GetFromAddress().String(),
+				ProjectId: args[0],
+				Status:    status,
+				Reason:    reason,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "the reason for updating the status")
+
+	return txFlags(cmd)
+}
+
+// TxUpdateClassBatchApprovalCmd returns a transaction command that updates
+// whether credit batches issued within a credit class must be approved.
+func TxUpdateClassBatchApprovalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class-batch-approval [class-id] [require-approval] [flags]",
+		Short: "Updates whether credit batches issued within a credit class must be approved",
+		Long: `Updates whether credit batches issued within a credit class must be approved.
+When approval is required, issuers must propose credit batches with propose-batch,
+and the proposed credit batches must be approved by the credit class admin or a
+credit class verifier before the expiry period ends.
+
+The '--from' flag must equal the credit class admin.
+
+Parameters:
+
+- class-id:          the ID of the credit class to update
+- require-approval:  whether credit batches must be approved (true or false)
+
+Flags:
+
+- expiry-period:  the period after which a proposed credit batch expires (e.g. 168h),
+                  required when approval is required`,
+		Example: `regen tx ecocredit update-class-batch-approval C01 true --expiry-period 168h
+regen tx ecocredit update-class-batch-approval C01 false`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			requireApproval, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("require approval: %s", err)
+			}
+
+			expiryPeriodStr, err := cmd.Flags().GetString(FlagExpiryPeriod)
+			if err != nil {
+				return err
+			}
+
+			var expiryPeriod *gogotypes.Duration
+			if expiryPeriodStr != "" {
+				d, err := time.ParseDuration(expiryPeriodStr)
+				if err != nil {
+					return sdkerrors.ErrInvalidRequest.Wrapf("expiry period: %s", err)
+				}
+				expiryPeriod = gogotypes.DurationProto(d)
+			}
+
+			msg := types.MsgUpdateClassBatchApproval{
+				Admin:           clientCtx.GetFromAddress().String(),
+				ClassId:         args[0],
+				RequireApproval: requireApproval,
+				ExpiryPeriod:    expiryPeriod,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExpiryPeriod, "", "the period after which a proposed credit batch expires")
+
+	return txFlags(cmd)
+}
+
+// TxProposeBatchCmd returns a transaction command that proposes a new credit
+// batch for a credit class that requires batch approval.
+func TxProposeBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-batch [batch-json]",
+		Short: "Proposes a new credit batch for approval",
+		Long: `Proposes a new credit batch for a credit class that requires batch approval.
+The credit batch is issued once approved by the credit class admin or a credit
+class verifier.
+
+Parameters:
+
+- batch-json:  path to JSON file containing credit batch information`,
+		Example: `regen tx ecocredit propose-batch batch.json
+
+Example JSON:
+
+{
+  "project_id": "C01-001",
+  "issuance": [
+    {
+      "recipient": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "tradable_amount": "1000",
+      "retired_amount": "500",
+      "retirement_jurisdiction": "US-WA"
+    }
+  ],
+  "metadata": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGxGREWGKjEdXYG.rdf",
+  "start_date": "2020-01-01T00:00:00Z",
+  "end_date": "2021-01-01T00:00:00Z",
+  "open": false
+}`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// the JSON file has the same format as create-batch
+			batch, err := parseMsgCreateBatch(clientCtx, args[0])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			msg := types.MsgProposeBatch{
+				Issuer:    clientCtx.GetFromAddress().String(),
+				ProjectId: batch.ProjectId,
+				Issuance:  batch.Issuance,
+				Metadata:  batch.Metadata,
+				StartDate: batch.StartDate,
+				EndDate:   batch.EndDate,
+				Open:      batch.Open,
+				OriginTx:  batch.OriginTx,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxApproveBatchCmd returns a transaction command that approves a pending batch.
+func TxApproveBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-batch [pending-batch-id]",
+		Short: "Approves a pending batch and issues the credit batch",
+		Long: `Approves a pending batch and issues the credit batch.
+
+The '--from' flag must equal the credit class admin or a credit class verifier.
+
+Parameters:
+
+- pending-batch-id:  the ID of the pending batch to approve`,
+		Example: "regen tx ecocredit approve-batch 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("pending batch id: %s", err)
+			}
+
+			msg := types.MsgApproveBatch{
+				Approver:       clientCtx.GetFromAddress().String(),
+				PendingBatchId: id,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxRejectBatchCmd returns a transaction command that rejects a pending batch.
+func TxRejectBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject-batch [pending-batch-id] [flags]",
+		Short: "Rejects a pending batch",
+		Long: `Rejects a pending batch. The credit batch is not issued.
+
+The '--from' flag must equal the credit class admin or a credit class verifier.
+
+Parameters:
+
+- pending-batch-id:  the ID of the pending batch to reject
+
+Optional Flags:
+
+- reason:  the reason for rejecting the pending batch`,
+		Example: `regen tx ecocredit reject-batch 1 --reason "missing verification report"`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("pending batch id: %s", err)
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRejectBatch{
+				Approver:       clientCtx.GetFromAddress().String(),
+				PendingBatchId: id,
+				Reason:         reason,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "the reason for rejecting the pending batch")
+
+	return txFlags(cmd)
+}
+
+// TxUpdateBatchMetadataCmd returns a transaction command that updates the
+// metadata of a credit batch.
+func TxUpdateBatchMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-batch-metadata [batch-denom] [new-metadata] [flags]",
+		Short: "Updates the metadata of a credit batch",
+		Long: `Updates the metadata of a credit batch. Each update is recorded in the
+metadata history of the credit batch.
+
+The '--from' flag must equal the issuer of the credit batch. If the credit class
+requires the class admin to co-sign batch metadata updates, the '--class-admin'
+flag must be set and the transaction must also be signed by the class admin
+(e.g. by generating the transaction with '--generate-only' and signing it with
+both accounts).
+
+Parameters:
+
+- batch-denom:   the denom of the credit batch to update
+- new-metadata:  any arbitrary metadata to attach to the credit batch
+
+Optional Flags:
+
+- class-admin:  the address of the class admin co-signing the update`,
+		Example: `regen tx ecocredit update-batch-metadata C01-001-20200101-20210101-001 regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGxGREWGKjEdXYG.rdf
+regen tx ecocredit update-batch-metadata C01-001-20200101-20210101-001 regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGxGREWGKjEdXYG.rdf --class-admin regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6 --generate-only`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			classAdmin, err := cmd.Flags().GetString(FlagClassAdmin)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUpdateBatchMetadata{
+				Issuer:      clientCtx.GetFromAddress().String(),
+				BatchDenom:  args[0],
+				NewMetadata: args[1],
+				ClassAdmin:  classAdmin,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagClassAdmin, "", "the address of the class admin co-signing the update")
+
+	return txFlags(cmd)
+}
+
+// TxUpdateClassBatchMetadataCosignCmd returns a transaction command that
+// updates whether the admin of a credit class must co-sign updates to the
+// metadata of the credit batches of the credit class.
+func TxUpdateClassBatchMetadataCosignCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class-batch-metadata-cosign [class-id] [require-cosign]",
+		Short: "Updates whether the class admin must co-sign batch metadata updates",
+		Long: `Updates whether the class admin must co-sign updates to the metadata of the
+credit batches of a credit class.
+
+The '--from' flag must equal the credit class admin.
+
+Parameters:
+
+- class-id:        the ID of the credit class to update
+- require-cosign:  whether the class admin must co-sign (true or false)`,
+		Example: `regen tx ecocredit update-class-batch-metadata-cosign C01 true`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			requireCosign, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("require cosign: %s", err)
+			}
+
+			msg := types.MsgUpdateClassBatchMetadataCosign{
+				Admin:         clientCtx.GetFromAddress().String(),
+				ClassId:       args[0],
+				RequireCosign: requireCosign,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxMigrateProjectCmd returns a transaction command that migrates a project to
+// a different credit class.
+func TxMigrateProjectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-project [project-id] [new-class-id] [new-class-admin]",
+		Short: "Migrates a project to a different credit class",
+		Long: `Migrates a project to a different credit class with the same credit type.
+
+The project is assigned a new project ID generated from the new credit class and
+the previous project ID remains resolvable as an alias. Existing credit batch
+denoms are not changed.
+
+The '--from' flag must equal the admin of the current credit class and the
+transaction must also be signed by the admin of the new credit class (e.g. by
+generating the transaction with '--generate-only' and signing it with both
+accounts).
+
+Parameters:
+
+- project-id:       the ID of the project to migrate
+- new-class-id:     the ID of the credit class to migrate the project to
+- new-class-admin:  the address of the admin of the new credit class`,
+		Example: `regen tx ecocredit migrate-project C01-001 C02 regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6 --generate-only`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgMigrateProject{
+				Admin:         clientCtx.GetFromAddress().String(),
+				ProjectId:     args[0],
+				NewClassId:    args[1],
+				NewClassAdmin: args[2],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxUpdateClassStatusCmd returns a transaction command that updates the status
+// of a credit class.
+func TxUpdateClassStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class-status [class-id] [status]",
+		Short: "Updates the status of a credit class",
+		Long: `Updates the status of a credit class.
+
+New projects cannot be created within a credit class that is closed to new
+projects, closed to issuance, or deprecated. Credits cannot be issued within a
+credit class that is closed to issuance or deprecated, and credits from a
+deprecated credit class cannot be put into baskets.
+
+The '--from' flag must equal the credit class admin or the governance authority.
+
+Parameters:
+
+- class-id:  the ID of the credit class to update
+- status:    the new status of the credit class (active, closed-to-new-projects,
+             closed-to-issuance, or deprecated)`,
+		Example: `regen tx ecocredit update-class-status C01 closed-to-new-projects
+regen tx ecocredit update-class-status C01 deprecated`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, err := parseClassStatus(args[1])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+			}
+
+			msg := types.MsgUpdateClassStatus{
+				Signer:  clientCtx.GetFromAddress().String(),
+				ClassId: args[0],
+				Status:  status,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxSetArticle6AuthorizationCmd returns a transaction command that sets the
+// Article 6 authorization record of a credit batch or retirement.
+func TxSetArticle6AuthorizationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-article6-authorization [batch-denom] [host-jurisdiction] [authorization-iri] [adjustment-status] [authorized-use]",
+		Short: "Sets the Article 6 authorization record of a credit batch or retirement",
+		Long: `Sets the Article 6 authorization record of a credit batch or retirement,
+recording the host country letter of authorization and whether a corresponding
+adjustment has been applied. An existing record is replaced.
+
+The '--from' flag must equal the credit class admin or a national registry
+address of the host jurisdiction country.
+
+Parameters:
+
+- batch-denom:        the denom of the credit batch
+- host-jurisdiction:  the jurisdiction of the host country
+- authorization-iri:  the IRI of the letter of authorization anchored in the data module
+- adjustment-status:  the corresponding adjustment status (not-applied, pending, or applied)
+- authorized-use:     the authorized use of the credits (ndc, corsia, or voluntary)
+
+Optional Flags:
+
+- retirement-id:  the ID of the retirement the record applies to (defaults to the
+                  credit batch as a whole)`,
+		Example: `regen tx ecocredit set-article6-authorization C01-001-20200101-20210101-001 KE regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf pending ndc
+regen tx ecocredit set-article6-authorization C01-001-20200101-20210101-001 KE regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf applied corsia --retirement-id 1`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			adjustmentStatus, err := parseAdjustmentStatus(args[3])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+			}
+
+			authorizedUse, err := parseAuthorizedUse(args[4])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+			}
+
+			retirementID, err := cmd.Flags().GetUint64(FlagRetirementID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetArticle6Authorization{
+				Signer:           clientCtx.GetFromAddress().String(),
+				BatchDenom:       args[0],
+				RetirementId:     retirementID,
+				HostJurisdiction: args[1],
+				AuthorizationIri: args[2],
+				AdjustmentStatus: adjustmentStatus,
+				AuthorizedUse:    authorizedUse,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagRetirementID, 0, "the ID of the retirement the record applies to")
+
+	return txFlags(cmd)
+}
+
+// TxUpdateClassSerializationCmd returns a transaction command that updates
+// whether the credits of a credit class are identified by serial numbers.
+func TxUpdateClassSerializationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class-serialization [class-id] [serialized]",
+		Short: "Updates whether the credits of a credit class are serialized",
+		Long: `Updates whether the credits of a credit class are identified by contiguous
+serial number ranges. Serialization can only be updated before any credits have
+been issued within the credit class and can only be enabled for credit classes
+with a credit type that has a precision of 0.
+
+The '--from' flag must equal the credit class admin.
+
+Parameters:
+
+- class-id:    the ID of the credit class to update
+- serialized:  whether the credits are serialized (true or false)`,
+		Example: `regen tx ecocredit update-class-serialization C01 true`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			serialized, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("serialized: %s", err)
+			}
+
+			msg := types.MsgUpdateClassSerialization{
+				Admin:      clientCtx.GetFromAddress().String(),
+				ClassId:    args[0],
+				Serialized: serialized,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxLinkBatchContractCmd returns a transaction command that links a credit
+// batch to a contract on another chain.
+func TxLinkBatchContractCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "link-batch-contract [batch-denom] [target] [contract]",
+		Short: "Links a credit batch to a contract on another chain",
+		Long: `Links a credit batch issued on Regen Ledger to a contract on an allowed bridge
+chain so that credits from the credit batch can be bridged to the chain. Bridged
+credits are locked in the bridge escrow account of the chain rather than
+cancelled, and unlocked when returned. A credit batch can only be linked once.
+
+The '--from' flag must equal the credit class admin.
+
+Parameters:
+
+- batch-denom:  the denom of the credit batch to link
+- target:       the name of the chain on which the contract exists
+- contract:     the address of the contract on the target chain`,
+		Example: `regen tx ecocredit link-batch-contract C01-001-20200101-20210101-001 polygon 0x06012c8cf97bead5deae237070f9587f8e7a266d`,
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgLinkBatchContract{
+				Admin:      clientCtx.GetFromAddress().String(),
+				BatchDenom: args[0],
+				Target:     args[1],
+				Contract:   args[2],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxAcknowledgeBridgeCmd returns a transaction command that acknowledges a
+// pending bridge request.
+func TxAcknowledgeBridgeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "acknowledge-bridge [request-id]",
+		Short: "Acknowledges a pending bridge request",
+		Long: `Acknowledges a pending bridge request once the credits have been received on
+the target chain.
+
+The '--from' flag must equal an allowed bridge service of the target chain.
+
+Parameters:
+
+- request-id:  the id of the bridge request`,
+		Example: `regen tx ecocredit acknowledge-bridge 1`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			requestID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid request id: %w", err)
+			}
+
+			msg := types.MsgAcknowledgeBridge{
+				Service:   clientCtx.GetFromAddress().String(),
+				RequestId: requestID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxFailBridgeCmd returns a transaction command that fails a pending bridge
+// request and returns the credits to the owner.
+func TxFailBridgeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fail-bridge [request-id] [reason]",
+		Short: "Fails a pending bridge request",
+		Long: `Fails a pending bridge request and returns the credits to the owner. Cancelled
+credits are restored to the tradable balance of the owner and locked credits are
+sent back from the bridge escrow account of the target chain.
+
+The '--from' flag must equal an allowed bridge service of the target chain.
+
+Parameters:
+
+- request-id:  the id of the bridge request
+- reason:      the reason the bridge request failed (optional)`,
+		Example: `regen tx ecocredit fail-bridge 1 "relay transaction reverted"`,
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			requestID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid request id: %w", err)
+			}
+
+			var reason string
+			if len(args) == 2 {
+				reason = args[1]
+			}
+
+			msg := types.MsgFailBridge{
+				Service:   clientCtx.GetFromAddress().String(),
+				RequestId: requestID,
+				Reason:    reason,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxWrapCmd returns a transaction command that wraps tradable credits into
+// bank tokens.
+func TxWrapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wrap [batch-denom] [amount]",
+		Short: "Wraps tradable credits into bank tokens",
+		Long: `Wraps tradable credits from a credit batch into bank tokens. The credits are
+moved into the escrow of the ecocredit module and the equivalent amount of tokens
+with the denom eco.<exponent-prefix><batch-denom> is minted to the owner. The
+token amount is the credit amount multiplied by 10^precision of the credit type.
+
+Parameters:
+
+- batch-denom:  the denom of the credit batch
+- amount:       the amount of tradable credits to wrap`,
+		Example: `regen tx ecocredit wrap C01-001-20200101-20210101-001 10.5`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgWrap{
+				Owner:      clientCtx.GetFromAddress().String(),
+				BatchDenom: args[0],
+				Amount:     args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxUnwrapCmd returns a transaction command that unwraps bank tokens into
+// tradable credits.
+func TxUnwrapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unwrap [batch-denom] [amount]",
+		Short: "Unwraps bank tokens into tradable credits",
+		Long: `Unwraps bank tokens of a credit batch into tradable credits. The equivalent
+amount of tokens is burned and the credits are moved from the escrow of the
+ecocredit module to the tradable balance of the owner.
+
+Parameters:
+
+- batch-denom:  the denom of the credit batch
+- amount:       the amount of credits to unwrap (not the token amount)`,
+		Example: `regen tx ecocredit unwrap C01-001-20200101-20210101-001 10.5`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgUnwrap{
+				Owner:      clientCtx.GetFromAddress().String(),
+				BatchDenom: args[0],
+				Amount:     args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
@@ -3,7 +3,10 @@ package client
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
+	"strings"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/spf13/cobra"
@@ -101,3 +104,146 @@ func parseSendCredits(jsonFile string) ([]*types.MsgSend_SendCredits, error) {
 
 	return sendCredits, nil
 }
+
+func parseCreditLimits(jsonFile string) ([]*types.CreditLimit, error) {
+	bz, err := ioutil.ReadFile(jsonFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := regentypes.CheckDuplicateKey(json.NewDecoder(bytes.NewReader(bz)), nil); err != nil {
+		return nil, err
+	}
+
+	var limits []*types.CreditLimit
+
+	// using json package because array is not a proto message
+	err = json.Unmarshal(bz, &limits)
+	if err != nil {
+		return nil, err
+	}
+
+	return limits, nil
+}
+
+func parseExpiration(cmd *cobra.Command) (*time.Time, error) {
+	exp, err := cmd.Flags().GetInt64(FlagExpiration)
+	if err != nil {
+		return nil, err
+	}
+	if exp == 0 {
+		return nil, nil
+	}
+	e := time.Unix(exp, 0)
+	return &e, nil
+}
+
+// parseProjectStatus parses a project status from either the full enum name
+// (e.g. "PROJECT_STATUS_ACTIVE") or the short name (e.g. "active").
+func parseProjectStatus(status string) (types.ProjectStatus, error) {
+	name := strings.ToUpper(strings.TrimSpace(status))
+	if !strings.HasPrefix(name, "PROJECT_STATUS_") {
+		name = "PROJECT_STATUS_" + name
+	}
+
+	value, ok := types.ProjectStatus_value[name]
+	if !ok || value == int32(types.ProjectStatus_PROJECT_STATUS_UNSPECIFIED) {
+		return types.ProjectStatus_PROJECT_STATUS_UNSPECIFIED, fmt.Errorf(
+			"invalid project status %s: must be one of proposed, registered, validated, active, suspended, or retired", status,
+		)
+	}
+
+	return types.ProjectStatus(value), nil
+}
+
+// parseIssuerCaps parses issuer caps in the format "<issuer>=<max-issuance>".
+// An empty max issuance (e.g. "<issuer>=") removes the cap of the issuer.
+func parseIssuerCaps(caps []string) ([]*types.MsgUpdateClassIssuers_IssuerCap, error) {
+	issuerCaps := make([]*types.MsgUpdateClassIssuers_IssuerCap, 0, len(caps))
+	for _, c := range caps {
+		parts := strings.SplitN(strings.TrimSpace(c), "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid issuer cap %s: expected format <issuer>=<max-issuance>", c)
+		}
+		issuerCaps = append(issuerCaps, &types.MsgUpdateClassIssuers_IssuerCap{
+			Issuer:      parts[0],
+			MaxIssuance: parts[1],
+		})
+	}
+	return issuerCaps, nil
+}
+
+// parseClassStatus parses a class status from either the full enum name (e.g.
+// "CLASS_STATUS_DEPRECATED") or the short name (e.g. "deprecated" or
+// "closed-to-issuance").
+func parseClassStatus(status string) (types.ClassStatus, error) {
+	name := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(status), "-", "_"))
+	if !strings.HasPrefix(name, "CLASS_STATUS_") {
+		name = "CLASS_STATUS_" + name
+	}
+
+	value, ok := types.ClassStatus_value[name]
+	if !ok || value == int32(types.ClassStatus_CLASS_STATUS_UNSPECIFIED) {
+		return types.ClassStatus_CLASS_STATUS_UNSPECIFIED, fmt.Errorf(
+			"invalid class status %s: must be one of active, closed-to-new-projects, closed-to-issuance, or deprecated", status,
+		)
+	}
+
+	return types.ClassStatus(value), nil
+}
+
+// parseAdjustmentStatus parses an adjustment status from either the full enum
+// name (e.g. "ADJUSTMENT_STATUS_APPLIED") or the short name (e.g. "applied" or
+// "not-applied").
+func parseAdjustmentStatus(status string) (types.AdjustmentStatus, error) {
+	name := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(status), "-", "_"))
+	if !strings.HasPrefix(name, "ADJUSTMENT_STATUS_") {
+		name = "ADJUSTMENT_STATUS_" + name
+	}
+
+	value, ok := types.AdjustmentStatus_value[name]
+	if !ok || value == int32(types.AdjustmentStatus_ADJUSTMENT_STATUS_UNSPECIFIED) {
+		return types.AdjustmentStatus_ADJUSTMENT_STATUS_UNSPECIFIED, fmt.Errorf(
+			"invalid adjustment status %s: must be one of not-applied, pending, or applied", status,
+		)
+	}
+
+	return types.AdjustmentStatus(value), nil
+}
+
+// parseAuthorizedUse parses an authorized use from either the full enum name
+// (e.g. "AUTHORIZED_USE_CORSIA") or the short name (e.g. "ndc" or "corsia").
+func parseAuthorizedUse(use string) (types.AuthorizedUse, error) {
+	name := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(use), "-", "_"))
+	if !strings.HasPrefix(name, "AUTHORIZED_USE_") {
+		name = "AUTHORIZED_USE_" + name
+	}
+
+	value, ok := types.AuthorizedUse_value[name]
+	if !ok || value == int32(types.AuthorizedUse_AUTHORIZED_USE_UNSPECIFIED) {
+		return types.AuthorizedUse_AUTHORIZED_USE_UNSPECIFIED, fmt.Errorf(
+			"invalid authorized use %s: must be one of ndc, corsia, or voluntary", use,
+		)
+	}
+
+	return types.AuthorizedUse(value), nil
+}
+
+// parseBridgeRequestStatus parses a bridge request status from either the full
+// enum name (e.g. "BRIDGE_REQUEST_STATUS_PENDING") or the short name (e.g.
+// "pending").
+func parseBridgeRequestStatus(status string) (types.BridgeRequestStatus, error) {
+	name := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(status), "-", "_"))
+	if !strings.HasPrefix(name, "BRIDGE_REQUEST_STATUS_") {
+		name = "BRIDGE_REQUEST_STATUS_" + name
+	}
+
+	value, ok := types.BridgeRequestStatus_value[name]
+	if !ok || value == int32(types.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_UNSPECIFIED) {
+		return types.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_UNSPECIFIED, fmt.Errorf(
+			"invalid bridge request status %s: must be one of pending, completed, or failed", status,
+		)
+	}
+
+	return types.BridgeRequestStatus(value), nil
+}
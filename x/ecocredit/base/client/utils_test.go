@@ -250,3 +250,368 @@ func TestParseCredits(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCreditLimits(t *testing.T) {
+	emptyJSON := testutil.WriteToNewTempFile(t, `{}`).Name()
+	invalidJSON := testutil.WriteToNewTempFile(t, `{foo:bar}`).Name()
+	duplicateJSON := testutil.WriteToNewTempFile(t, `{"foo":"bar","foo":"baz"}`).Name()
+	validJSON := testutil.WriteToNewTempFile(t, `[
+		{
+			"batch_denom": "C01-001-20210101-20210101-001",
+			"amount": "10"
+		},
+		{
+			"class_id": "C02",
+			"amount": "2.5"
+		}
+	]`).Name()
+
+	testCases := []struct {
+		name      string
+		file      string
+		expErr    bool
+		expErrMsg string
+		expRes    []*types.CreditLimit
+	}{
+		{
+			name:      "empty file path",
+			file:      "",
+			expErr:    true,
+			expErrMsg: "no such file or directory",
+		},
+		{
+			name:      "empty json object",
+			file:      emptyJSON,
+			expErr:    true,
+			expErrMsg: "cannot unmarshal object",
+		},
+		{
+			name:      "invalid file format",
+			file:      invalidJSON,
+			expErr:    true,
+			expErrMsg: "invalid character",
+		},
+		{
+			name:      "duplicate json keys",
+			file:      duplicateJSON,
+			expErr:    true,
+			expErrMsg: "duplicate key",
+		},
+		{
+			name: "valid test",
+			file: validJSON,
+			expRes: []*types.CreditLimit{
+				{
+					BatchDenom: "C01-001-20210101-20210101-001",
+					Amount:     "10",
+				},
+				{
+					ClassId: "C02",
+					Amount:  "2.5",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := parseCreditLimits(tc.file)
+			if tc.expErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, res)
+			}
+		})
+	}
+}
+
+func TestParseProjectStatus(t *testing.T) {
+	testCases := []struct {
+		name      string
+		status    string
+		expErr    bool
+		expErrMsg string
+		expRes    types.ProjectStatus
+	}{
+		{
+			name:   "short name",
+			status: "active",
+			expRes: types.ProjectStatus_PROJECT_STATUS_ACTIVE,
+		},
+		{
+			name:   "short name upper case",
+			status: "SUSPENDED",
+			expRes: types.ProjectStatus_PROJECT_STATUS_SUSPENDED,
+		},
+		{
+			name:   "full name",
+			status: "PROJECT_STATUS_RETIRED",
+			expRes: types.ProjectStatus_PROJECT_STATUS_RETIRED,
+		},
+		{
+			name:      "unspecified",
+			status:    "unspecified",
+			expErr:    true,
+			expErrMsg: "invalid project status unspecified",
+		},
+		{
+			name:      "unknown",
+			status:    "foo",
+			expErr:    true,
+			expErrMsg: "invalid project status foo",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := parseProjectStatus(tc.status)
+			if tc.expErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, res)
+			}
+		})
+	}
+}
+
+func TestParseIssuerCaps(t *testing.T) {
+	testCases := []struct {
+		name      string
+		caps      []string
+		expErr    bool
+		expErrMsg string
+		expRes    []*types.MsgUpdateClassIssuers_IssuerCap
+	}{
+		{
+			name: "valid",
+			caps: []string{"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6=1000", " regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4="},
+			expRes: []*types.MsgUpdateClassIssuers_IssuerCap{
+				{Issuer: "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6", MaxIssuance: "1000"},
+				{Issuer: "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4", MaxIssuance: ""},
+			},
+		},
+		{
+			name:      "missing separator",
+			caps:      []string{"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"},
+			expErr:    true,
+			expErrMsg: "expected format <issuer>=<max-issuance>",
+		},
+		{
+			name:      "missing issuer",
+			caps:      []string{"=1000"},
+			expErr:    true,
+			expErrMsg: "expected format <issuer>=<max-issuance>",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := parseIssuerCaps(tc.caps)
+			if tc.expErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, res)
+			}
+		})
+	}
+}
+
+func TestParseClassStatus(t *testing.T) {
+	testCases := []struct {
+		name      string
+		status    string
+		expErr    bool
+		expErrMsg string
+		expRes    types.ClassStatus
+	}{
+		{
+			name:   "short name",
+			status: "deprecated",
+			expRes: types.ClassStatus_CLASS_STATUS_DEPRECATED,
+		},
+		{
+			name:   "short name with hyphens",
+			status: "closed-to-new-projects",
+			expRes: types.ClassStatus_CLASS_STATUS_CLOSED_TO_NEW_PROJECTS,
+		},
+		{
+			name:   "short name upper case",
+			status: "ACTIVE",
+			expRes: types.ClassStatus_CLASS_STATUS_ACTIVE,
+		},
+		{
+			name:   "full name",
+			status: "CLASS_STATUS_CLOSED_TO_ISSUANCE",
+			expRes: types.ClassStatus_CLASS_STATUS_CLOSED_TO_ISSUANCE,
+		},
+		{
+			name:      "unspecified",
+			status:    "unspecified",
+			expErr:    true,
+			expErrMsg: "invalid class status unspecified",
+		},
+		{
+			name:      "unknown",
+			status:    "foo",
+			expErr:    true,
+			expErrMsg: "invalid class status foo",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := parseClassStatus(tc.status)
+			if tc.expErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, res)
+			}
+		})
+	}
+}
+
+func TestParseAdjustmentStatus(t *testing.T) {
+	testCases := []struct {
+		name      string
+		status    string
+		expRes    types.AdjustmentStatus
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:   "short name",
+			status: "not-applied",
+			expRes: types.AdjustmentStatus_ADJUSTMENT_STATUS_NOT_APPLIED,
+		},
+		{
+			name:   "full name",
+			status: "ADJUSTMENT_STATUS_APPLIED",
+			expRes: types.AdjustmentStatus_ADJUSTMENT_STATUS_APPLIED,
+		},
+		{
+			name:      "unspecified",
+			status:    "unspecified",
+			expErr:    true,
+			expErrMsg: "invalid adjustment status unspecified",
+		},
+		{
+			name:      "unknown",
+			status:    "foo",
+			expErr:    true,
+			expErrMsg: "invalid adjustment status foo",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := parseAdjustmentStatus(tc.status)
+			if tc.expErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, res)
+			}
+		})
+	}
+}
+
+func TestParseAuthorizedUse(t *testing.T) {
+	testCases := []struct {
+		name      string
+		use       string
+		expRes    types.AuthorizedUse
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:   "short name",
+			use:    "corsia",
+			expRes: types.AuthorizedUse_AUTHORIZED_USE_CORSIA,
+		},
+		{
+			name:   "full name",
+			use:    "AUTHORIZED_USE_NDC",
+			expRes: types.AuthorizedUse_AUTHORIZED_USE_NDC,
+		},
+		{
+			name:      "unspecified",
+			use:       "unspecified",
+			expErr:    true,
+			expErrMsg: "invalid authorized use unspecified",
+		},
+		{
+			name:      "unknown",
+			use:       "foo",
+			expErr:    true,
+			expErrMsg: "invalid authorized use foo",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := parseAuthorizedUse(tc.use)
+			if tc.expErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, res)
+			}
+		})
+	}
+}
+
+func TestParseBridgeRequestStatus(t *testing.T) {
+	testCases := []struct {
+		name      string
+		status    string
+		expRes    types.BridgeRequestStatus
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:   "short name",
+			status: "pending",
+			expRes: types.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_PENDING,
+		},
+		{
+			name:   "full name",
+			status: "BRIDGE_REQUEST_STATUS_FAILED",
+			expRes: types.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_FAILED,
+		},
+		{
+			name:      "unspecified",
+			status:    "unspecified",
+			expErr:    true,
+			expErrMsg: "invalid bridge request status unspecified",
+		},
+		{
+			name:      "unknown",
+			status:    "foo",
+			expErr:    true,
+			expErrMsg: "invalid bridge request status foo",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := parseBridgeRequestStatus(tc.status)
+			if tc.expErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, res)
+			}
+		})
+	}
+}
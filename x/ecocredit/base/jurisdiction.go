@@ -0,0 +1,87 @@
+package base
+
+import (
+	_ "embed" // embed the ISO 3166 dataset
+	"strings"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// iso3166Data contains one ISO 3166-1 alpha-2 country code or ISO 3166-2
+// subdivision code per line. Lines starting with "#" are comments.
+//
+//go:embed data/iso3166.txt
+var iso3166Data string
+
+// iso3166Codes is the set of ISO 3166-1 alpha-2 country codes and ISO 3166-2
+// subdivision codes parsed from iso3166Data.
+var iso3166Codes = parseISO3166Codes(iso3166Data)
+
+func parseISO3166Codes(data string) map[string]struct{} {
+	codes := make(map[string]struct{})
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		codes[line] = struct{}{}
+	}
+	return codes
+}
+
+// ValidateJurisdictionStrict checks that the jurisdiction conforms to the
+// format described in ValidateJurisdiction and that the country code and the
+// optional subdivision code exist in the embedded ISO 3166-1 and ISO 3166-2
+// datasets. The postal code is not checked against any dataset. The return is
+// nil if the jurisdiction is valid.
+func ValidateJurisdictionStrict(jurisdiction string) error {
+	if err := ValidateJurisdiction(jurisdiction); err != nil {
+		return err
+	}
+
+	matches := regexJurisdiction.FindStringSubmatch(jurisdiction)
+	country, region := matches[1], matches[2]
+
+	if _, ok := iso3166Codes[country]; !ok {
+		return ecocredit.ErrParseFailure.Wrapf(
+			"country code %s is not a valid ISO 3166-1 alpha-2 code", country,
+		)
+	}
+
+	if region != "" {
+		subdivision := country + "-" + region
+		if _, ok := iso3166Codes[subdivision]; !ok {
+			return ecocredit.ErrParseFailure.Wrapf(
+				"subdivision code %s is not a valid ISO 3166-2 code", subdivision,
+			)
+		}
+	}
+
+	return nil
+}
+
+// JurisdictionRange returns the inclusive range of jurisdictions nested within
+// the jurisdiction. The range of a country code (e.g. "US") includes the
+// country and all subdivisions and postal codes within the country (e.g.
+// "US-WA" and "US-WA 98225"). The range of a subdivision code (e.g. "US-WA")
+// includes the subdivision and all postal codes within the subdivision. The
+// range of a full jurisdiction with a postal code only includes itself, in
+// which case from and to are equal.
+func JurisdictionRange(jurisdiction string) (from, to string, err error) {
+	if err := ValidateJurisdiction(jurisdiction); err != nil {
+		return "", "", err
+	}
+
+	matches := regexJurisdiction.FindStringSubmatch(jurisdiction)
+	switch {
+	case matches[3] != "":
+		// a postal code only matches itself
+		return jurisdiction, jurisdiction, nil
+	case matches[2] != "":
+		// "~" sorts after any valid postal code character
+		return jurisdiction, jurisdiction + " ~", nil
+	default:
+		// "~" sorts after any valid subdivision code character
+		return jurisdiction, jurisdiction + "-~", nil
+	}
+}
@@ -0,0 +1,69 @@
+package base
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateJurisdictionStrict(t *testing.T) {
+	testCases := []struct {
+		name         string
+		jurisdiction string
+		expErr       string
+	}{
+		{"valid country", "US", ""},
+		{"valid subdivision", "US-WA", ""},
+		{"valid subdivision with postal code", "US-WA 98225", ""},
+		{"valid subdivision with alphanumeric code", "CZ-20A", ""},
+		{"empty", "", "empty string is not allowed: parse error"},
+		{"invalid format", "foo", "expected format <country-code>[-<region-code>[ <postal-code>]]: parse error"},
+		{"unknown country", "ZZ", "country code ZZ is not a valid ISO 3166-1 alpha-2 code: parse error"},
+		{"unknown subdivision", "US-999", "subdivision code US-999 is not a valid ISO 3166-2 code: parse error"},
+		{"subdivision of another country", "CA-WA", "subdivision code CA-WA is not a valid ISO 3166-2 code: parse error"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateJurisdictionStrict(tc.jurisdiction)
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestJurisdictionRange(t *testing.T) {
+	testCases := []struct {
+		name         string
+		jurisdiction string
+		expFrom      string
+		expTo        string
+		expErr       bool
+	}{
+		{"country", "US", "US", "US-~", false},
+		{"subdivision", "US-WA", "US-WA", "US-WA ~", false},
+		{"postal code", "US-WA 98225", "US-WA 98225", "US-WA 98225", false},
+		{"invalid", "foo", "", "", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			from, to, err := JurisdictionRange(tc.jurisdiction)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expFrom, from)
+			require.Equal(t, tc.expTo, to)
+
+			// nested jurisdictions fall within the range
+			require.True(t, from <= tc.jurisdiction && tc.jurisdiction <= to)
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+)
+
+// AddAggregateSupply adds the tradable, retired, and cancelled amounts to the
+// aggregated supply of the project, credit class, and credit type of the credit
+// batch. Amounts may be negative in order to move credits between the tradable,
+// retired, and cancelled amounts. The aggregated supply is derived from the
+// batch supplies (see the v4 migration and genesis validation), so a resulting
+// amount that would be negative is set to zero rather than failing the state
+// transition of the credit batch.
+func AddAggregateSupply(ctx context.Context, ss api.StateStore, batchKey uint64, tradable, retired, cancelled math.Dec) error {
+	batch, err := ss.BatchTable().Get(ctx, batchKey)
+	if err != nil {
+		return err
+	}
+
+	project, err := ss.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return err
+	}
+
+	class, err := ss.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return err
+	}
+
+	projectSupply, err := ss.ProjectSupplyTable().Get(ctx, project.Key)
+	if err != nil {
+		if !ormerrors.IsNotFound(err) {
+			return err
+		}
+		projectSupply = &api.ProjectSupply{ProjectKey: project.Key}
+	}
+	projectSupply.TradableAmount, projectSupply.RetiredAmount, projectSupply.CancelledAmount, err = addSupplyAmounts(
+		projectSupply.TradableAmount, projectSupply.RetiredAmount, projectSupply.CancelledAmount,
+		tradable, retired, cancelled,
+	)
+	if err != nil {
+		return err
+	}
+	if err := ss.ProjectSupplyTable().Save(ctx, projectSupply); err != nil {
+		return err
+	}
+
+	classSupply, err := ss.ClassSupplyTable().Get(ctx, class.Key)
+	if err != nil {
+		if !ormerrors.IsNotFound(err) {
+			return err
+		}
+		classSupply = &api.ClassSupply{ClassKey: class.Key}
+	}
+	classSupply.TradableAmount, classSupply.RetiredAmount, classSupply.CancelledAmount, err = addSupplyAmounts(
+		classSupply.TradableAmount, classSupply.RetiredAmount, classSupply.CancelledAmount,
+		tradable, retired, cancelled,
+	)
+	if err != nil {
+		return err
+	}
+	if err := ss.ClassSupplyTable().Save(ctx, classSupply); err != nil {
+		return err
+	}
+
+	creditTypeSupply, err := ss.CreditTypeSupplyTable().Get(ctx, class.CreditTypeAbbrev)
+	if err != nil {
+		if !ormerrors.IsNotFound(err) {
+			return err
+		}
+		creditTypeSupply = &api.CreditTypeSupply{CreditTypeAbbrev: class.CreditTypeAbbrev}
+	}
+	creditTypeSupply.TradableAmount, creditTypeSupply.RetiredAmount, creditTypeSupply.CancelledAmount, err = addSupplyAmounts(
+		creditTypeSupply.TradableAmount, creditTypeSupply.RetiredAmount, creditTypeSupply.CancelledAmount,
+		tradable, retired, cancelled,
+	)
+	if err != nil {
+		return err
+	}
+	return ss.CreditTypeSupplyTable().Save(ctx, creditTypeSupply)
+}
+
+// RetireAggregateSupply moves `amount` of credits from the tradable amount to
+// the retired amount of the aggregated supply of the credit batch.
+func RetireAggregateSupply(ctx context.Context, ss api.StateStore, batchKey uint64, amount math.Dec) error {
+	return AddAggregateSupply(ctx, ss, batchKey, negate(amount), amount, math.NewDecFromInt64(0))
+}
+
+// CancelAggregateSupply moves `amount` of credits from the tradable amount to
+// the cancelled amount of the aggregated supply of the credit batch.
+func CancelAggregateSupply(ctx context.Context, ss api.StateStore, batchKey uint64, amount math.Dec) error {
+	return AddAggregateSupply(ctx, ss, batchKey, negate(amount), math.NewDecFromInt64(0), amount)
+}
+
+// RestoreAggregateSupply moves `amount` of credits from the cancelled amount
+// back to the tradable amount of the aggregated supply of the credit batch.
+func RestoreAggregateSupply(ctx context.Context, ss api.StateStore, batchKey uint64, amount math.Dec) error {
+	return AddAggregateSupply(ctx, ss, batchKey, amount, math.NewDecFromInt64(0), negate(amount))
+}
+
+// moveClassSupply moves the aggregated supply of a project from the aggregated
+// supply of one credit class to another. The aggregated supply of the credit
+// type is unchanged because both credit classes must have the same credit type.
+func (k Keeper) moveClassSupply(ctx context.Context, projectKey, fromClassKey, toClassKey uint64) error {
+	projectSupply, err := k.stateStore.ProjectSupplyTable().Get(ctx, projectKey)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil // no credits have been issued within the project
+		}
+		return err
+	}
+
+	tradable, retired, cancelled, err := parseSupplyAmounts(
+		projectSupply.TradableAmount, projectSupply.RetiredAmount, projectSupply.CancelledAmount,
+	)
+	if err != nil {
+		return err
+	}
+
+	fromSupply, err := k.stateStore.ClassSupplyTable().Get(ctx, fromClassKey)
+	if err != nil {
+		if !ormerrors.IsNotFound(err) {
+			return err
+		}
+		fromSupply = &api.ClassSupply{ClassKey: fromClassKey}
+	}
+	fromSupply.TradableAmount, fromSupply.RetiredAmount, fromSupply.CancelledAmount, err = addSupplyAmounts(
+		fromSupply.TradableAmount, fromSupply.RetiredAmount, fromSupply.CancelledAmount,
+		negate(tradable), negate(retired), negate(cancelled),
+	)
+	if err != nil {
+		return err
+	}
+	if err := k.stateStore.ClassSupplyTable().Save(ctx, fromSupply); err != nil {
+		return err
+	}
+
+	toSupply, err := k.stateStore.ClassSupplyTable().Get(ctx, toClassKey)
+	if err != nil {
+		if !ormerrors.IsNotFound(err) {
+			return err
+		}
+		toSupply = &api.ClassSupply{ClassKey: toClassKey}
+	}
+	toSupply.TradableAmount, toSupply.RetiredAmount, toSupply.CancelledAmount, err = addSupplyAmounts(
+		toSupply.TradableAmount, toSupply.RetiredAmount, toSupply.CancelledAmount,
+		tradable, retired, cancelled,
+	)
+	if err != nil {
+		return err
+	}
+	return k.stateStore.ClassSupplyTable().Save(ctx, toSupply)
+}
+
+// addSupplyAmounts adds the tradable, retired, and cancelled amounts to the
+// current tradable, retired, and cancelled amounts of an aggregated supply. A
+// resulting amount that would be negative is set to zero.
+func addSupplyAmounts(currentTradable, currentRetired, currentCancelled string, tradable, retired, cancelled math.Dec) (string, string, string, error) {
+	t, r, c, err := parseSupplyAmounts(currentTradable, currentRetired, currentCancelled)
+	if err != nil {
+		return "", "", "", err
+	}
+	current := [3]math.Dec{t, r, c}
+	deltas := [3]math.Dec{tradable, retired, cancelled}
+	var result [3]string
+	for i, dec := range current {
+		dec, err = dec.Add(deltas[i])
+		if err != nil {
+			return "", "", "", err
+		}
+		if dec.IsNegative() {
+			dec = math.NewDecFromInt64(0)
+		}
+		result[i] = dec.String()
+	}
+	return result[0], result[1], result[2], nil
+}
+
+// parseSupplyAmounts parses the tradable, retired, and cancelled amounts of a
+// supply. An empty amount is treated as zero.
+func parseSupplyAmounts(tradable, retired, cancelled string) (math.Dec, math.Dec, math.Dec, error) {
+	var decs [3]math.Dec
+	for i, amount := range [3]string{tradable, retired, cancelled} {
+		if amount == "" {
+			amount = "0"
+		}
+		dec, err := math.NewNonNegativeDecFromString(amount)
+		if err != nil {
+			return math.Dec{}, math.Dec{}, math.Dec{}, err
+		}
+		decs[i] = dec
+	}
+	return decs[0], decs[1], decs[2], nil
+}
+
+// negate returns the negation of the decimal.
+func negate(x math.Dec) math.Dec {
+	zero := math.NewDecFromInt64(0)
+	// subtraction from zero cannot fail for finite decimals
+	neg, _ := zero.Sub(x)
+	return neg
+}
@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestAggregateSupply(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               testClassID,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(t, err)
+
+	projectKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       testProjectID,
+		ClassKey: classKey,
+	})
+	require.NoError(t, err)
+
+	batchKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: projectKey,
+		Denom:      testBatchDenom,
+	})
+	require.NoError(t, err)
+
+	zero := math.NewDecFromInt64(0)
+
+	// issue credits
+	require.NoError(t, AddAggregateSupply(s.ctx, s.stateStore, batchKey, dec(t, "100"), dec(t, "10"), zero))
+	assertAggregateSupply(t, s, projectKey, classKey, "C", "100", "10", "0")
+
+	// retire credits
+	require.NoError(t, RetireAggregateSupply(s.ctx, s.stateStore, batchKey, dec(t, "20.5")))
+	assertAggregateSupply(t, s, projectKey, classKey, "C", "79.5", "30.5", "0")
+
+	// cancel credits
+	require.NoError(t, CancelAggregateSupply(s.ctx, s.stateStore, batchKey, dec(t, "9.5")))
+	assertAggregateSupply(t, s, projectKey, classKey, "C", "70.0", "30.5", "9.5")
+
+	// move the supply of the project to another credit class
+	classKey2, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               "C02",
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, s.k.moveClassSupply(s.ctx, projectKey, classKey, classKey2))
+
+	classSupply, err := s.stateStore.ClassSupplyTable().Get(s.ctx, classKey)
+	require.NoError(t, err)
+	require.True(t, dec(t, classSupply.TradableAmount).IsZero())
+	require.True(t, dec(t, classSupply.RetiredAmount).IsZero())
+	require.True(t, dec(t, classSupply.CancelledAmount).IsZero())
+
+	assertAggregateSupply(t, s, projectKey, classKey2, "C", "70.0", "30.5", "9.5")
+}
+
+func TestAggregateSupplyNotNegative(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               testClassID,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(t, err)
+
+	projectKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       testProjectID,
+		ClassKey: classKey,
+	})
+	require.NoError(t, err)
+
+	batchKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: projectKey,
+		Denom:      testBatchDenom,
+	})
+	require.NoError(t, err)
+
+	// an aggregated supply that does not account for the credit batch does not
+	// prevent retiring credits
+	require.NoError(t, RetireAggregateSupply(s.ctx, s.stateStore, batchKey, dec(t, "5")))
+	assertAggregateSupply(t, s, projectKey, classKey, "C", "0", "5", "0")
+}
+
+func TestAggregateSupplyMsgs(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	require.NoError(t, s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: "C",
+		Name:         "carbon",
+		Unit:         "metric ton CO2 equivalent",
+		Precision:    6,
+	}))
+
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               testClassID,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(t, err)
+
+	projectKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:           testProjectID,
+		ClassKey:     classKey,
+		Jurisdiction: "US-WA",
+	})
+	require.NoError(t, err)
+
+	batchKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: projectKey,
+		Denom:      testBatchDenom,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, s.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:        batchKey,
+		TradableAmount:  "100",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	}))
+
+	require.NoError(t, s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       batchKey,
+		Address:        s.addr,
+		TradableAmount: "100",
+	}))
+
+	zero := math.NewDecFromInt64(0)
+	require.NoError(t, AddAggregateSupply(s.ctx, s.stateStore, batchKey, dec(t, "100"), zero, zero))
+
+	_, err = s.k.Retire(s.ctx, &types.MsgRetire{
+		Owner:        s.addr.String(),
+		Credits:      []*types.Credits{{BatchDenom: testBatchDenom, Amount: "10"}},
+		Jurisdiction: "US-WA",
+	})
+	require.NoError(t, err)
+	assertAggregateSupply(t, s, projectKey, classKey, "C", "90", "10", "0")
+
+	_, err = s.k.Cancel(s.ctx, &types.MsgCancel{
+		Owner:   s.addr.String(),
+		Credits: []*types.Credits{{BatchDenom: testBatchDenom, Amount: "5"}},
+		Reason:  "transferring credits to another registry",
+	})
+	require.NoError(t, err)
+	assertAggregateSupply(t, s, projectKey, classKey, "C", "85", "10", "5")
+
+	_, err = s.k.Send(s.ctx, &types.MsgSend{
+		Sender:    s.addr.String(),
+		Recipient: s.addr2.String(),
+		Credits: []*types.MsgSend_SendCredits{
+			{
+				BatchDenom:             testBatchDenom,
+				TradableAmount:         "10",
+				RetiredAmount:          "5",
+				RetirementJurisdiction: "US-WA",
+			},
+		},
+	})
+	require.NoError(t, err)
+	assertAggregateSupply(t, s, projectKey, classKey, "C", "80", "15", "5")
+}
+
+func assertAggregateSupply(t *testing.T, s *baseSuite, projectKey, classKey uint64, creditType, tradable, retired, cancelled string) {
+	projectSupply, err := s.stateStore.ProjectSupplyTable().Get(s.ctx, projectKey)
+	require.NoError(t, err)
+	require.Equal(t, tradable, projectSupply.TradableAmount)
+	require.Equal(t, retired, projectSupply.RetiredAmount)
+	require.Equal(t, cancelled, projectSupply.CancelledAmount)
+
+	classSupply, err := s.stateStore.ClassSupplyTable().Get(s.ctx, classKey)
+	require.NoError(t, err)
+	require.Equal(t, tradable, classSupply.TradableAmount)
+	require.Equal(t, retired, classSupply.RetiredAmount)
+	require.Equal(t, cancelled, classSupply.CancelledAmount)
+
+	creditTypeSupply, err := s.stateStore.CreditTypeSupplyTable().Get(s.ctx, creditType)
+	require.NoError(t, err)
+	require.Equal(t, tradable, creditTypeSupply.TradableAmount)
+	require.Equal(t, retired, creditTypeSupply.RetiredAmount)
+	require.Equal(t, cancelled, creditTypeSupply.CancelledAmount)
+}
+
+func dec(t *testing.T, s string) math.Dec {
+	d, err := math.NewDecFromString(s)
+	require.NoError(t, err)
+	return d
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// spendAllowance deducts the amount from the allowance the owner approved for
+// the spender. The allowance for the credit batch is used if one exists,
+// otherwise the allowance for the credit class of the credit batch is used.
+// An allowance is removed once the full amount has been spent.
+func (k Keeper) spendAllowance(ctx context.Context, owner, spender sdk.AccAddress, batch *api.Batch, amount math.Dec) error {
+	batchAllowance, err := k.stateStore.BatchAllowanceTable().Get(ctx, owner, spender, batch.Key)
+	if err == nil {
+		remaining, err := subAllowance(batchAllowance.Amount, amount, batch.Denom)
+		if err != nil {
+			return err
+		}
+
+		if remaining.IsZero() {
+			return k.stateStore.BatchAllowanceTable().Delete(ctx, batchAllowance)
+		}
+
+		batchAllowance.Amount = remaining.String()
+		return k.stateStore.BatchAllowanceTable().Update(ctx, batchAllowance)
+	}
+	if !ormerrors.NotFound.Is(err) {
+		return err
+	}
+
+	project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return err
+	}
+
+	classAllowance, err := k.stateStore.ClassAllowanceTable().Get(ctx, owner, spender, project.ClassKey)
+	if err != nil {
+		if ormerrors.NotFound.Is(err) {
+			return ecocredit.ErrInsufficientAllowance.Wrapf(
+				"%s has no allowance from %s for credit batch %s", spender, owner, batch.Denom,
+			)
+		}
+		return err
+	}
+
+	remaining, err := subAllowance(classAllowance.Amount, amount, batch.Denom)
+	if err != nil {
+		return err
+	}
+
+	if remaining.IsZero() {
+		return k.stateStore.ClassAllowanceTable().Delete(ctx, classAllowance)
+	}
+
+	classAllowance.Amount = remaining.String()
+	return k.stateStore.ClassAllowanceTable().Update(ctx, classAllowance)
+}
+
+// subAllowance subtracts the amount from the allowance amount and returns an
+// insufficient allowance error if the amount exceeds the allowance amount.
+func subAllowance(allowanceAmount string, amount math.Dec, batchDenom string) (math.Dec, error) {
+	allowance, err := math.NewNonNegativeDecFromString(allowanceAmount)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	remaining, err := math.SafeSubBalance(allowance, amount)
+	if err != nil {
+		return math.Dec{}, ecocredit.ErrInsufficientAllowance.Wrapf(
+			"allowance for credit batch %s: %s, amount %s", batchDenom, allowance, amount,
+		)
+	}
+
+	return remaining, nil
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+)
+
+// IndexBatchBalance adds the batch balance of the account to the batch balance
+// index and adds the account to the holders of the project of the credit batch
+// if the account has not held credits from the credit batch before.
+func IndexBatchBalance(ctx context.Context, ss api.StateStore, addr sdk.AccAddress, batchKey uint64) error {
+	found, err := ss.BatchBalanceIndexTable().Has(ctx, addr, batchKey)
+	if err != nil {
+		return err
+	}
+	if found {
+		return nil
+	}
+
+	batch, err := ss.BatchTable().Get(ctx, batchKey)
+	if err != nil {
+		return err
+	}
+
+	project, err := ss.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return err
+	}
+
+	if err := ss.BatchBalanceIndexTable().Insert(ctx, &api.BatchBalanceIndex{
+		Address:    addr,
+		BatchKey:   batchKey,
+		ClassKey:   project.ClassKey,
+		ProjectKey: project.Key,
+	}); err != nil {
+		return err
+	}
+
+	return ss.ProjectHolderTable().Save(ctx, &api.ProjectHolder{
+		ProjectKey: project.Key,
+		Address:    addr,
+	})
+}
+
+// reindexProjectBalances updates the credit class of the batch balance index
+// entries of all credit batches issued within the project.
+func (k Keeper) reindexProjectBalances(ctx context.Context, projectKey, classKey uint64) error {
+	bItr, err := k.stateStore.BatchTable().List(ctx, api.BatchProjectKeyIndexKey{}.WithProjectKey(projectKey))
+	if err != nil {
+		return err
+	}
+
+	var batchKeys []uint64
+	for bItr.Next() {
+		batch, err := bItr.Value()
+		if err != nil {
+			bItr.Close()
+			return err
+		}
+		batchKeys = append(batchKeys, batch.Key)
+	}
+	bItr.Close()
+
+	for _, batchKey := range batchKeys {
+		if err := k.reindexBatchBalances(ctx, batchKey, classKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reindexBatchBalances updates the credit class of the batch balance index
+// entries of the credit batch.
+func (k Keeper) reindexBatchBalances(ctx context.Context, batchKey, classKey uint64) error {
+	itr, err := k.stateStore.BatchBalanceTable().List(ctx, api.BatchBalanceBatchKeyAddressIndexKey{}.WithBatchKey(batchKey))
+	if err != nil {
+		return err
+	}
+
+	var addrs []sdk.AccAddress
+	for itr.Next() {
+		balance, err := itr.Value()
+		if err != nil {
+			itr.Close()
+			return err
+		}
+		addrs = append(addrs, balance.Address)
+	}
+	itr.Close()
+
+	for _, addr := range addrs {
+		index, err := k.stateStore.BatchBalanceIndexTable().Get(ctx, addr, batchKey)
+		if err != nil {
+			return err
+		}
+		index.ClassKey = classKey
+		if err := k.stateStore.BatchBalanceIndexTable().Update(ctx, index); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// assertBatchApprovalNotRequired makes sure that credit batches can be created
+// directly for the credit class. Returns ErrInvalidRequest if the credit class
+// requires batch approval.
+func (k Keeper) assertBatchApprovalNotRequired(ctx context.Context, class *api.Class) error {
+	required, err := k.stateStore.ClassBatchApprovalTable().Has(ctx, class.Key)
+	if err != nil {
+		return err
+	}
+
+	if required {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"credit class %s requires batch approval: credit batches must be proposed", class.Id,
+		)
+	}
+
+	return nil
+}
+
+// assertClassAdminOrVerifier makes sure that the address is the admin or a
+// verifier of the credit class. Returns ErrUnauthorized otherwise.
+func (k Keeper) assertClassAdminOrVerifier(ctx context.Context, class *api.Class, addr sdk.AccAddress) error {
+	if addr.Equals(sdk.AccAddress(class.Admin)) {
+		return nil
+	}
+
+	isVerifier, err := k.stateStore.ClassVerifierTable().Has(ctx, class.Key, addr)
+	if err != nil {
+		return err
+	}
+
+	if !isVerifier {
+		return sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin or a verifier of credit class %s", addr, class.Id,
+		)
+	}
+
+	return nil
+}
+
+// pendingBatchIssuance converts the issuance of a credit batch into the
+// issuance stored in a pending batch.
+func pendingBatchIssuance(issuance []*types.BatchIssuance) []*api.PendingBatch_Issuance {
+	res := make([]*api.PendingBatch_Issuance, len(issuance))
+	for i, iss := range issuance {
+		res[i] = &api.PendingBatch_Issuance{
+			Recipient:              iss.Recipient,
+			TradableAmount:         iss.TradableAmount,
+			RetiredAmount:          iss.RetiredAmount,
+			RetirementJurisdiction: iss.RetirementJurisdiction,
+			RetirementReason:       iss.RetirementReason,
+			RetirementBeneficiary:  iss.RetirementBeneficiary,
+		}
+	}
+	return res
+}
+
+// batchIssuance converts the issuance stored in a pending batch into the
+// issuance of a credit batch.
+func batchIssuance(issuance []*api.PendingBatch_Issuance) []*types.BatchIssuance {
+	res := make([]*types.BatchIssuance, len(issuance))
+	for i, iss := range issuance {
+		res[i] = &types.BatchIssuance{
+			Recipient:              iss.Recipient,
+			TradableAmount:         iss.TradableAmount,
+			RetiredAmount:          iss.RetiredAmount,
+			RetirementJurisdiction: iss.RetirementJurisdiction,
+			RetirementReason:       iss.RetirementReason,
+			RetirementBeneficiary:  iss.RetirementBeneficiary,
+		}
+	}
+	return res
+}
+
+// pendingBatchOriginTx converts the origin tx of a credit batch into the
+// origin tx stored in a pending batch.
+func pendingBatchOriginTx(originTx *types.OriginTx) *api.PendingBatch_OriginTx {
+	if originTx == nil {
+		return nil
+	}
+	return &api.PendingBatch_OriginTx{
+		Id:       originTx.Id,
+		Source:   originTx.Source,
+		Contract: originTx.Contract,
+		Note:     originTx.Note,
+	}
+}
+
+// batchOriginTx converts the origin tx stored in a pending batch into the
+// origin tx of a credit batch.
+func batchOriginTx(originTx *api.PendingBatch_OriginTx) *types.OriginTx {
+	if originTx == nil {
+		return nil
+	}
+	return &types.OriginTx{
+		Id:       originTx.Id,
+		Source:   originTx.Source,
+		Contract: originTx.Contract,
+		Note:     originTx.Note,
+	}
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// getBufferPercentage returns the buffer percentage of the credit class or
+// zero if the credit class does not have a buffer pool.
+func (k Keeper) getBufferPercentage(ctx context.Context, classKey uint64) (math.Dec, error) {
+	classBuffer, err := k.stateStore.ClassBufferTable().Get(ctx, classKey)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return math.NewDecFromInt64(0), nil
+		}
+		return math.Dec{}, err
+	}
+
+	return math.NewDecFromString(classBuffer.Percentage)
+}
+
+// getBufferAmount returns the amount of credits withheld from the tradable
+// amount of an issuance for the buffer pool. The amount is truncated to the
+// precision of the credit type.
+func getBufferAmount(tradable, percentage math.Dec, precision uint32) (math.Dec, error) {
+	if tradable.IsZero() || percentage.IsZero() {
+		return math.NewDecFromInt64(0), nil
+	}
+
+	// tradable * percentage / 100 is calculated in the smallest unit of the
+	// credit type and truncated to avoid withholding partial units
+	units, err := tradable.MulExact(math.NewDecFinite(1, int32(precision)))
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	units, err = units.Mul(percentage)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	units, err = units.QuoInteger(math.NewDecFromInt64(100))
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	amount, err := units.MulExact(math.NewDecFinite(1, -int32(precision)))
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	amount, _ = amount.Reduce()
+
+	return amount, nil
+}
+
+// sendToBufferPool adds the amount of credits to the tradable balance of the
+// buffer pool account and emits a transfer event from the ecocredit module.
+func (k Keeper) sendToBufferPool(ctx context.Context, bufferPool sdk.AccAddress, batchKey uint64, batchDenom string, amount math.Dec) error {
+	if err := AddAndSaveBalance(ctx, k.stateStore.BatchBalanceTable(), bufferPool, batchKey, amount); err != nil {
+		return err
+	}
+	if err := IndexBatchBalance(ctx, k.stateStore, bufferPool, batchKey); err != nil {
+		return err
+	}
+	if err := IssueSerials(ctx, k.stateStore, batchKey, bufferPool, amount, api.SerialStatus_SERIAL_STATUS_TRADABLE); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventTransfer{
+		Sender:         k.moduleAddress.String(), // ecocredit module
+		Recipient:      bufferPool.String(),
+		BatchDenom:     batchDenom,
+		TradableAmount: amount.String(),
+		RetiredAmount:  "0",
+	})
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// MintRetirementCertificate mints an x/nft token to the owner of the retired
+// credits as proof of the retirement and links the token to the retirement.
+// The x/nft class of the credit class is created if it does not yet exist.
+// The credit class is looked up through the project of the credit batch rather
+// than parsed from the batch denom so that the certificate is issued by the
+// credit class the project belongs to.
+func MintRetirementCertificate(ctx context.Context, ss api.StateStore, nk ecocredit.NFTKeeper, retirement *api.Retirement, batchDenom string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	batch, err := ss.BatchTable().Get(ctx, retirement.BatchKey)
+	if err != nil {
+		return err
+	}
+
+	project, err := ss.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return err
+	}
+
+	class, err := ss.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return err
+	}
+
+	classID := class.Id
+	nftClassID := base.FormatCertificateClassID(classID)
+
+	if !nk.HasClass(sdkCtx, nftClassID) {
+		err := nk.SaveClass(sdkCtx, nft.Class{
+			Id:          nftClassID,
+			Name:        fmt.Sprintf("%s retirement certificates", classID),
+			Description: fmt.Sprintf("proof of retirement of credits issued under credit class %s", classID),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	data, err := codectypes.NewAnyWithValue(&types.RetirementCertificateData{
+		RetirementId: retirement.Id,
+		BatchDenom:   batchDenom,
+		Amount:       retirement.Amount,
+		Jurisdiction: retirement.Jurisdiction,
+		Beneficiary:  retirement.Beneficiary,
+		Timestamp:    regentypes.ProtobufToGogoTimestamp(retirement.Timestamp),
+	})
+	if err != nil {
+		return err
+	}
+
+	nftID := base.FormatCertificateID(retirement.Id)
+
+	err = nk.Mint(sdkCtx, nft.NFT{
+		ClassId: nftClassID,
+		Id:      nftID,
+		Data:    data,
+	}, retirement.Owner)
+	if err != nil {
+		return err
+	}
+
+	return ss.RetirementCertificateTable().Insert(ctx, &api.RetirementCertificate{
+		RetirementId: retirement.Id,
+		NftClassId:   nftClassID,
+		NftId:        nftID,
+	})
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestMintRetirementCertificate(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	timestamp, err := regentypes.ParseDate("timestamp", "2022-01-01")
+	assert.NilError(t, err)
+
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: testClassID})
+	assert.NilError(t, err)
+
+	projectKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       testProjectID,
+		ClassKey: classKey,
+	})
+	assert.NilError(t, err)
+
+	batchKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: projectKey,
+		Denom:      testBatchDenom,
+	})
+	assert.NilError(t, err)
+
+	retirement := &api.Retirement{
+		Owner:        s.addr,
+		BatchKey:     batchKey,
+		Amount:       "10",
+		Jurisdiction: "US-WA",
+		Timestamp:    timestamppb.New(timestamp),
+		Beneficiary:  "Acme Inc.",
+	}
+
+	id, err := s.stateStore.RetirementTable().InsertReturningID(s.ctx, retirement)
+	assert.NilError(t, err)
+	retirement.Id = id
+
+	// mint certificate and create the nft class for the credit class
+	err = MintRetirementCertificate(s.ctx, s.stateStore, s.nftKeeper, retirement, testBatchDenom)
+	assert.NilError(t, err)
+
+	certificate, err := s.stateStore.RetirementCertificateTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Equal(t, "ecocredit:C01", certificate.NftClassId)
+	assert.Equal(t, "retirement-1", certificate.NftId)
+
+	assert.Check(t, s.nftKeeper.HasClass(s.sdkCtx, certificate.NftClassId))
+	assert.DeepEqual(t, s.addr, s.nftKeeper.GetOwner(s.sdkCtx, certificate.NftClassId, certificate.NftId))
+
+	token, found := s.nftKeeper.GetNFT(s.sdkCtx, certificate.NftClassId, certificate.NftId)
+	assert.Check(t, found)
+
+	var data types.RetirementCertificateData
+	assert.NilError(t, data.Unmarshal(token.Data.Value))
+	assert.Equal(t, id, data.RetirementId)
+	assert.Equal(t, testBatchDenom, data.BatchDenom)
+	assert.Equal(t, retirement.Amount, data.Amount)
+	assert.Equal(t, retirement.Jurisdiction, data.Jurisdiction)
+	assert.Equal(t, retirement.Beneficiary, data.Beneficiary)
+	assert.DeepEqual(t, regentypes.ProtobufToGogoTimestamp(retirement.Timestamp), data.Timestamp)
+
+	// mint second certificate using the existing nft class
+	retirement2 := &api.Retirement{
+		Owner:        s.addr2,
+		BatchKey:     batchKey,
+		Amount:       "20",
+		Jurisdiction: "US-WA",
+		Timestamp:    timestamppb.New(timestamp),
+	}
+
+	id2, err := s.stateStore.RetirementTable().InsertReturningID(s.ctx, retirement2)
+	assert.NilError(t, err)
+	retirement2.Id = id2
+
+	err = MintRetirementCertificate(s.ctx, s.stateStore, s.nftKeeper, retirement2, testBatchDenom)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, s.addr2, s.nftKeeper.GetOwner(s.sdkCtx, "ecocredit:C01", "retirement-2"))
+
+	// minting a certificate for the same retirement fails
+	err = MintRetirementCertificate(s.ctx, s.stateStore, s.nftKeeper, retirement2, testBatchDenom)
+	assert.ErrorContains(t, err, "nft already exist")
+
+	// move the project to another credit class
+	classKey2, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: "C02"})
+	assert.NilError(t, err)
+
+	project, err := s.stateStore.ProjectTable().Get(s.ctx, projectKey)
+	assert.NilError(t, err)
+	project.ClassKey = classKey2
+	assert.NilError(t, s.stateStore.ProjectTable().Update(s.ctx, project))
+
+	// mint certificate for the batch of the project using the nft class of
+	// the credit class the project belongs to rather than the credit class in
+	// the batch denom
+	retirement3 := &api.Retirement{
+		Owner:        s.addr,
+		BatchKey:     batchKey,
+		Amount:       "5",
+		Jurisdiction: "US-WA",
+		Timestamp:    timestamppb.New(timestamp),
+	}
+
+	id3, err := s.stateStore.RetirementTable().InsertReturningID(s.ctx, retirement3)
+	assert.NilError(t, err)
+	retirement3.Id = id3
+
+	err = MintRetirementCertificate(s.ctx, s.stateStore, s.nftKeeper, retirement3, testBatchDenom)
+	assert.NilError(t, err)
+
+	certificate3, err := s.stateStore.RetirementCertificateTable().Get(s.ctx, id3)
+	assert.NilError(t, err)
+	assert.Equal(t, "ecocredit:C02", certificate3.NftClassId)
+	assert.DeepEqual(t, s.addr, s.nftKeeper.GetOwner(s.sdkCtx, "ecocredit:C02", "retirement-3"))
+}
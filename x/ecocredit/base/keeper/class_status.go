@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+)
+
+// getClassStatus returns the status of the credit class or an unspecified
+// status if the status of the credit class has never been updated.
+func getClassStatus(ctx context.Context, ss api.StateStore, classKey uint64) (api.ClassStatus, error) {
+	lifecycle, err := ss.ClassLifecycleTable().Get(ctx, classKey)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return api.ClassStatus_CLASS_STATUS_UNSPECIFIED, nil
+		}
+		return api.ClassStatus_CLASS_STATUS_UNSPECIFIED, err
+	}
+
+	return api.ClassStatus(lifecycle.Status), nil
+}
+
+// assertClassOpenToNewProjects makes sure that new projects can be created
+// within the credit class. New projects cannot be created within a credit class
+// that is closed to new projects, closed to issuance, or deprecated. Returns
+// ErrInvalidRequest otherwise.
+func (k Keeper) assertClassOpenToNewProjects(ctx context.Context, class *api.Class) error {
+	status, err := getClassStatus(ctx, k.stateStore, class.Key)
+	if err != nil {
+		return err
+	}
+
+	switch status {
+	case api.ClassStatus_CLASS_STATUS_CLOSED_TO_NEW_PROJECTS,
+		api.ClassStatus_CLASS_STATUS_CLOSED_TO_ISSUANCE,
+		api.ClassStatus_CLASS_STATUS_DEPRECATED:
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"cannot create projects within credit class %s with status %s", class.Id, status,
+		)
+	}
+
+	return nil
+}
+
+// AssertClassCanIssue makes sure that credits can be issued within the credit
+// class. Credits cannot be issued within a credit class that is closed to
+// issuance or deprecated. Returns ErrInvalidRequest otherwise.
+func AssertClassCanIssue(ctx context.Context, ss api.StateStore, class *api.Class) error {
+	status, err := getClassStatus(ctx, ss, class.Key)
+	if err != nil {
+		return err
+	}
+
+	switch status {
+	case api.ClassStatus_CLASS_STATUS_CLOSED_TO_ISSUANCE,
+		api.ClassStatus_CLASS_STATUS_DEPRECATED:
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"cannot issue credits within credit class %s with status %s", class.Id, status,
+		)
+	}
+
+	return nil
+}
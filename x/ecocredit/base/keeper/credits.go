@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// subTradableBalance subtracts credits from the tradable balance of the owner.
+// Locked and escrowed credits are not part of the tradable balance and cannot
+// be subtracted. Returns ErrInsufficientCredits if the tradable balance of the
+// owner is less than the amount.
+func subTradableBalance(ctx context.Context, ss api.StateStore, batch *api.Batch, owner sdk.AccAddress, amount math.Dec) error {
+	balance, err := ss.BatchBalanceTable().Get(ctx, owner, batch.Key)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return ecocredit.ErrInsufficientCredits.Wrapf("%s does not have any credits from batch %s", owner, batch.Denom)
+		}
+		return err
+	}
+
+	tradable, err := math.NewDecFromString(balance.TradableAmount)
+	if err != nil {
+		return err
+	}
+
+	newTradable, err := math.SafeSubBalance(tradable, amount)
+	if err != nil {
+		return ecocredit.ErrInsufficientCredits.Wrapf("tradable balance: %s, amount %s", tradable, amount)
+	}
+
+	balance.TradableAmount = newTradable.String()
+	return ss.BatchBalanceTable().Update(ctx, balance)
+}
+
+// addTradableBalance adds credits to the tradable balance of the owner and
+// indexes the balance by credit class and project.
+func addTradableBalance(ctx context.Context, ss api.StateStore, batch *api.Batch, owner sdk.AccAddress, amount math.Dec) error {
+	if err := AddAndSaveBalance(ctx, ss.BatchBalanceTable(), owner, batch.Key, amount); err != nil {
+		return err
+	}
+
+	return IndexBatchBalance(ctx, ss, owner, batch.Key)
+}
+
+// TransferCredits moves tradable credits from one account to another, updating
+// the serial ranges of serialized credit batches.
+func TransferCredits(ctx context.Context, ss api.StateStore, batch *api.Batch, from, to sdk.AccAddress, amount math.Dec) error {
+	if err := subTradableBalance(ctx, ss, batch, from, amount); err != nil {
+		return err
+	}
+
+	if err := addTradableBalance(ctx, ss, batch, to, amount); err != nil {
+		return err
+	}
+
+	if err := TransferSerials(ctx, ss, batch.Key, from, to, amount, api.SerialStatus_SERIAL_STATUS_TRADABLE); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventTransfer{
+		Sender:         from.String(),
+		Recipient:      to.String(),
+		BatchDenom:     batch.Denom,
+		TradableAmount: amount.String(),
+	})
+}
+
+// CancelCredits removes credits from the tradable balance of the owner and the
+// tradable supply of the credit batch, adding them to the cancelled supply.
+func CancelCredits(ctx context.Context, ss api.StateStore, batch *api.Batch, owner sdk.AccAddress, amount math.Dec, reason string) error {
+	if err := subTradableBalance(ctx, ss, batch, owner, amount); err != nil {
+		return err
+	}
+
+	supply, err := ss.BatchSupplyTable().Get(ctx, batch.Key)
+	if err != nil {
+		return err
+	}
+
+	tradable, _, cancelled, err := parseSupplyAmounts(supply.TradableAmount, supply.RetiredAmount, supply.CancelledAmount)
+	if err != nil {
+		return err
+	}
+
+	if tradable, err = math.SafeSubBalance(tradable, amount); err != nil {
+		return err
+	}
+	if cancelled, err = cancelled.Add(amount); err != nil {
+		return err
+	}
+
+	supply.TradableAmount = tradable.String()
+	supply.CancelledAmount = cancelled.String()
+	if err = ss.BatchSupplyTable().Update(ctx, supply); err != nil {
+		return err
+	}
+
+	if err = CancelAggregateSupply(ctx, ss, batch.Key, amount); err != nil {
+		return err
+	}
+
+	if err = CancelSerials(ctx, ss, batch.Key, owner, amount); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventCancel{
+		Owner:      owner.String(),
+		BatchDenom: batch.Denom,
+		Amount:     amount.String(),
+		Reason:     reason,
+	})
+}
+
+// RestoreCancelledCredits moves credits cancelled by the owner back into the
+// tradable supply of the credit batch and the tradable balance of the owner.
+func RestoreCancelledCredits(ctx context.Context, ss api.StateStore, batch *api.Batch, owner sdk.AccAddress, amount math.Dec) error {
+	supply, err := ss.BatchSupplyTable().Get(ctx, batch.Key)
+	if err != nil {
+		return err
+	}
+
+	tradable, _, cancelled, err := parseSupplyAmounts(supply.TradableAmount, supply.RetiredAmount, supply.CancelledAmount)
+	if err != nil {
+		return err
+	}
+
+	if tradable, err = tradable.Add(amount); err != nil {
+		return err
+	}
+	if cancelled, err = math.SafeSubBalance(cancelled, amount); err != nil {
+		return err
+	}
+
+	supply.TradableAmount = tradable.String()
+	supply.CancelledAmount = cancelled.String()
+	if err = ss.BatchSupplyTable().Update(ctx, supply); err != nil {
+		return err
+	}
+
+	if err = addTradableBalance(ctx, ss, batch, owner, amount); err != nil {
+		return err
+	}
+
+	if err = RestoreAggregateSupply(ctx, ss, batch.Key, amount); err != nil {
+		return err
+	}
+
+	return RestoreSerials(ctx, ss, batch.Key, owner, amount)
+}
+
+// MintCredits issues additional tradable credits from the credit batch to the
+// recipient on behalf of the issuer. Credits can only be minted for a project
+// with an active status or a project without a status, and cannot be minted
+// within a credit class that is closed to issuance or deprecated. The amount
+// minted must not exceed the issuance caps of the credit class.
+func MintCredits(ctx context.Context, ss api.StateStore, batch *api.Batch, issuer, recipient sdk.AccAddress, amount math.Dec) error {
+	project, err := ss.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return err
+	}
+
+	if err = AssertProjectCanIssue(ctx, ss, project); err != nil {
+		return err
+	}
+
+	class, err := ss.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return err
+	}
+
+	if err = AssertClassCanIssue(ctx, ss, class); err != nil {
+		return err
+	}
+
+	supply, err := ss.BatchSupplyTable().Get(ctx, batch.Key)
+	if err != nil {
+		return err
+	}
+
+	tradable, err := math.NewDecFromString(supply.TradableAmount)
+	if err != nil {
+		return err
+	}
+
+	if tradable, err = tradable.Add(amount); err != nil {
+		return err
+	}
+
+	supply.TradableAmount = tradable.String()
+	if err = ss.BatchSupplyTable().Update(ctx, supply); err != nil {
+		return err
+	}
+
+	if err = AddAggregateSupply(ctx, ss, batch.Key, amount, math.NewDecFromInt64(0), math.NewDecFromInt64(0)); err != nil {
+		return err
+	}
+
+	if err = addTradableBalance(ctx, ss, batch, recipient, amount); err != nil {
+		return err
+	}
+
+	if err = IssueSerials(ctx, ss, batch.Key, recipient, amount, api.SerialStatus_SERIAL_STATUS_TRADABLE); err != nil {
+		return err
+	}
+
+	if err = TrackIssuance(ctx, ss, class, project, issuer, vintageYear(batch.StartDate), amount); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventMint{
+		BatchDenom:     batch.Denom,
+		TradableAmount: amount.String(),
+		RetiredAmount:  "0",
+	}); err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventTransfer{
+		Sender:         issuer.String(),
+		Recipient:      recipient.String(),
+		BatchDenom:     batch.Denom,
+		TradableAmount: amount.String(),
+	})
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// ExpirePendingBatches is a BeginBlock function that removes pending batches
+// that have not been approved or rejected before their expiration.
+func (k Keeper) ExpirePendingBatches(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	min, blockTime := timestamppb.New(time.Unix(0, 1)), timestamppb.New(sdkCtx.BlockTime())
+	fromKey := api.PendingBatchExpirationIndexKey{}.WithExpiration(min)
+	toKey := api.PendingBatchExpirationIndexKey{}.WithExpiration(blockTime)
+
+	it, err := k.stateStore.PendingBatchTable().ListRange(ctx, fromKey, toKey)
+	if err != nil {
+		return err
+	}
+	for it.Next() {
+		pending, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventExpirePendingBatch{
+			PendingBatchId: pending.Id,
+		}); err != nil {
+			it.Close()
+			return err
+		}
+	}
+	it.Close()
+
+	return k.stateStore.PendingBatchTable().DeleteRange(ctx, fromKey, toKey)
+}
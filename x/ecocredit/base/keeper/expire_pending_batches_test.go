@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+)
+
+func TestExpirePendingBatches(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	insertPendingBatch := func(expiration time.Time) uint64 {
+		id, err := s.stateStore.PendingBatchTable().InsertReturningID(s.ctx, &api.PendingBatch{
+			ClassKey:   1,
+			ProjectKey: 1,
+			Issuer:     s.addr,
+			Issuance: []*api.PendingBatch_Issuance{
+				{Recipient: s.addr2.String(), TradableAmount: "10"},
+			},
+			StartDate:  timestamppb.New(start),
+			EndDate:    timestamppb.New(start),
+			Expiration: timestamppb.New(expiration),
+		})
+		require.NoError(t, err)
+		return id
+	}
+
+	expiredID := insertPendingBatch(start.Add(time.Hour))
+	pendingID := insertPendingBatch(start.Add(2 * time.Hour))
+
+	// nothing is expired before the first expiration
+	s.setBlockTime(start)
+	require.NoError(t, s.k.ExpirePendingBatches(s.ctx))
+
+	_, err := s.stateStore.PendingBatchTable().Get(s.ctx, expiredID)
+	require.NoError(t, err)
+
+	// pending batches are expired at their expiration
+	s.setBlockTime(start.Add(time.Hour))
+	require.NoError(t, s.k.ExpirePendingBatches(s.ctx))
+
+	_, err = s.stateStore.PendingBatchTable().Get(s.ctx, expiredID)
+	require.ErrorIs(t, err, ormerrors.NotFound)
+
+	_, err = s.stateStore.PendingBatchTable().Get(s.ctx, pendingID)
+	require.NoError(t, err)
+
+	events := s.sdkCtx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "regen.ecocredit.v1.EventExpirePendingBatch", events[0].Type)
+}
@@ -0,0 +1,78 @@
+Feature: Msg/AcknowledgeBridge
+
+  A bridge request can be acknowledged:
+  - when the bridge request exists
+  - when the service is an allowed bridge service of the target chain
+  - when the bridge request is pending
+  - the bridge request status is updated
+
+  Background:
+    Given the target "polygon" is an allowed bridge chain
+    And bob is an allowed bridge service for "polygon"
+
+  Rule: The bridge request must exist
+
+    Scenario: the bridge request exists
+      Given a credit batch exists with a batch contract entry
+      And alice has bridged credit amount "4" from the credit batch to "polygon"
+      When bob attempts to acknowledge bridge request 1
+      Then expect no error
+
+    Scenario: the bridge request does not exist
+      When bob attempts to acknowledge bridge request 1
+      Then expect the error "bridge request with id 1: not found"
+
+  Rule: The service must be an allowed bridge service of the target chain
+
+    Scenario: the service is not an allowed bridge service
+      Given a credit batch exists with a batch contract entry
+      And alice has bridged credit amount "4" from the credit batch to "polygon"
+      When alice attempts to acknowledge bridge request 1
+      Then expect error contains "is not an authorized bridge service for polygon: unauthorized"
+
+  Rule: The bridge request must be pending
+
+    Scenario: the bridge request has been acknowledged
+      Given a credit batch exists with a batch contract entry
+      And alice has bridged credit amount "4" from the credit batch to "polygon"
+      And bob has acknowledged bridge request 1
+      When bob attempts to acknowledge bridge request 1
+      Then expect the error "bridge request 1 is not pending: BRIDGE_REQUEST_STATUS_COMPLETED: invalid request"
+
+    Scenario: the bridge request has been failed
+      Given a credit batch exists with a batch contract entry
+      And alice has bridged credit amount "4" from the credit batch to "polygon"
+      And bob has failed bridge request 1
+      When bob attempts to acknowledge bridge request 1
+      Then expect the error "bridge request 1 is not pending: BRIDGE_REQUEST_STATUS_FAILED: invalid request"
+
+  Rule: The bridge request status is updated
+
+    Scenario: the bridge request status is completed
+      Given a credit batch exists with a batch contract entry
+      And alice has bridged credit amount "4" from the credit batch to "polygon"
+      When bob attempts to acknowledge bridge request 1
+      Then expect bridge request 1 with status "BRIDGE_REQUEST_STATUS_COMPLETED"
+      And expect alice batch balance
+      """
+      {
+        "tradable_amount": "6",
+        "retired_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Scenario: EventAcknowledgeBridge is emitted
+      Given a credit batch exists with a batch contract entry
+      And alice has bridged credit amount "4" from the credit batch to "polygon"
+      When bob attempts to acknowledge bridge request 1
+      Then expect event with properties
+      """
+      {
+        "request_id": 1
+      }
+      """
@@ -0,0 +1,57 @@
+Feature: Msg/AddAllowedBridgeContract
+
+  A bridge contract can be added to the allowed bridge contracts of a chain:
+  - when the authority is the governance account
+  - when the chain is an allowed bridge chain
+  - when the bridge contract doesn't already exist in the state
+
+
+  Rule: The authority address is the governance account
+
+    Background:
+      Given the authority address "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+      And the chain name "polygon"
+
+    Scenario: the authority is the governance account
+      When alice attempts to add allowed bridge contract with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "chain_name": "polygon",
+        "contract": "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d"
+      }
+      """
+      Then expect no error
+
+    Scenario: the authority is not the governance account
+      When alice attempts to add allowed bridge contract with properties
+      """
+      {
+        "authority": "regen1fua8speyxgempgy06gpfs0p4z32zznkqakm57s",
+        "chain_name": "polygon",
+        "contract": "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d"
+      }
+      """
+      Then expect the error contains "invalid authority"
+
+
+  Rule: The chain must be an allowed bridge chain
+
+    Scenario: the chain is an allowed bridge chain
+      Given the chain name "polygon"
+      When alice attempts to add bridge contract "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d" for chain name "Polygon"
+      Then expect no error
+      And expect bridge contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" for chain name "polygon" to exist
+
+    Scenario: the chain is not an allowed bridge chain
+      When alice attempts to add bridge contract "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d" for chain name "polygon"
+      Then expect the error "polygon is not an allowed bridge chain: invalid request"
+
+
+  Rule: The bridge contract must not already exist
+
+    Scenario: bridge contract already exists in state
+      Given the chain name "polygon"
+      And allowed bridge contract "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d" for chain name "polygon"
+      When alice attempts to add bridge contract "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d" for chain name "polygon"
+      Then expect the error contains "could not insert bridge contract 0x06012c8cf97BEaD5deAe237070F9587f8E7A266d for chain name polygon"
@@ -0,0 +1,70 @@
+Feature: Msg/AddNationalRegistry
+
+  A national registry can be added:
+  - when the authority is a governance account address
+  - when the national registry does not exist for the country
+  - the national registry is added
+
+  Rule: The authority must be governance account address
+
+    Scenario: The authority is a governance account address
+      When alice attempts to add national registry with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "country": "KE",
+        "registry": "regen156d26rl52y3wl865pr5x9q2vqetuw9kf0642sa"
+      }
+      """
+      Then expect no error
+
+    Scenario: The authority is not a governance account address
+      When alice attempts to add national registry with properties
+      """
+      {
+        "authority": "regen156d26rl52y3wl865pr5x9q2vqetuw9kf0642sa",
+        "country": "KE",
+        "registry": "regen156d26rl52y3wl865pr5x9q2vqetuw9kf0642sa"
+      }
+      """
+      Then expect error contains "expected gov account as only signer for proposal message"
+
+  Rule: The national registry does not exist for the country
+
+    Background:
+      Given national registry "regen156d26rl52y3wl865pr5x9q2vqetuw9kf0642sa" for country "KE"
+
+    Scenario: The national registry already exists for the country
+      When alice attempts to add national registry with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "country": "KE",
+        "registry": "regen156d26rl52y3wl865pr5x9q2vqetuw9kf0642sa"
+      }
+      """
+      Then expect the error "national registry regen156d26rl52y3wl865pr5x9q2vqetuw9kf0642sa already exists for country KE: invalid request"
+
+    Scenario: The national registry exists for another country
+      When alice attempts to add national registry with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "country": "CO",
+        "registry": "regen156d26rl52y3wl865pr5x9q2vqetuw9kf0642sa"
+      }
+      """
+      Then expect no error
+
+  Rule: The national registry is added
+
+    Scenario: The national registry is added
+      When alice attempts to add national registry with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "country": "KE",
+        "registry": "regen156d26rl52y3wl865pr5x9q2vqetuw9kf0642sa"
+      }
+      """
+      Then expect national registry "regen156d26rl52y3wl865pr5x9q2vqetuw9kf0642sa" for country "KE"
@@ -0,0 +1,72 @@
+Feature: Msg/Approve
+
+  An allowance can be approved for a credit batch or a credit class:
+  - when the credit batch or credit class exists
+  - when the amount does not exceed the credit type precision
+  - the allowance overwrites an existing allowance
+
+  Rule: The credit batch or credit class must exist
+
+    Background:
+      Given a credit batch
+
+    Scenario: the credit batch exists
+      When alice attempts to approve bob for batch "C01-001-20200101-20210101-001" with amount "10"
+      Then expect no error
+
+    Scenario: the credit batch does not exist
+      When alice attempts to approve bob for batch "C01-001-20200101-20210101-002" with amount "10"
+      Then expect the error "could not get batch with denom C01-001-20200101-20210101-002: not found: invalid request"
+
+    Scenario: the credit class exists
+      When alice attempts to approve bob for class "C01" with amount "10"
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to approve bob for class "C02" with amount "10"
+      Then expect the error "could not get class with id C02: not found: invalid request"
+
+  Rule: The decimal places in amount must not exceed credit type precision
+
+    Background:
+      Given a credit type with precision "6"
+      And a credit batch
+
+    Scenario: the decimal places in amount is less than or equal to credit type precision
+      When alice attempts to approve bob for batch "C01-001-20200101-20210101-001" with amount "10.123456"
+      Then expect no error
+
+    Scenario: the decimal places in amount is greater than credit type precision
+      When alice attempts to approve bob for class "C01" with amount "10.1234567"
+      Then expect the error "10.1234567 exceeds maximum decimal places: 6: invalid request"
+
+  Rule: The allowance is set
+
+    Background:
+      Given a credit batch
+
+    Scenario: the batch allowance is set
+      When alice attempts to approve bob for batch "C01-001-20200101-20210101-001" with amount "10"
+      Then expect bob batch allowance "10"
+
+    Scenario: the class allowance is set
+      When alice attempts to approve bob for class "C01" with amount "10"
+      Then expect bob class allowance "10"
+
+    Scenario: an existing allowance is overwritten
+      Given alice approved bob for batch with amount "10"
+      When alice attempts to approve bob for batch "C01-001-20200101-20210101-001" with amount "5"
+      Then expect bob batch allowance "5"
+
+  Rule: Event is emitted
+
+    Scenario: EventApprove is emitted
+      Given a credit batch
+      When alice attempts to approve bob for class "C01" with amount "10"
+      Then expect event with properties
+      """
+      {
+        "class_id": "C01",
+        "amount": "10"
+      }
+      """
@@ -0,0 +1,79 @@
+Feature: Msg/ApproveBatch
+
+  A pending batch can be approved:
+  - when the pending batch exists
+  - when the approver is the admin or a verifier of the credit class
+  - when the credit batch can be created
+  - the credit batch is created and the pending batch is removed
+  - the response includes the batch denom
+
+  Background:
+    Given a credit class with id "C01" admin alice and issuer bob
+    And a project with id "C01-001"
+    And the credit class requires batch approval
+    And bob has proposed a batch with project id "C01-001" and tradable amount "10"
+
+  Rule: The pending batch must exist
+
+    Scenario: the pending batch exists
+      When alice attempts to approve pending batch "1"
+      Then expect no error
+
+    Scenario: the pending batch does not exist
+      When alice attempts to approve pending batch "2"
+      Then expect the error "could not get pending batch with id 2: not found: invalid request"
+
+  Rule: The approver must be the admin or a verifier of the credit class
+
+    Scenario: the approver is a verifier of the credit class
+      Given carol is a verifier of the credit class
+      When carol attempts to approve pending batch "1"
+      Then expect no error
+
+    Scenario: the approver is not the admin or a verifier of the credit class
+      When bob attempts to approve pending batch "1"
+      Then expect error contains "is not the admin or a verifier of credit class C01: unauthorized"
+
+  Rule: The credit batch must satisfy the requirements of a credit batch
+
+    Scenario: the issuer is no longer an allowed credit class issuer
+      Given bob is no longer an issuer of the credit class
+      When alice attempts to approve pending batch "1"
+      Then expect error contains "is not an issuer for the class: unauthorized"
+      And expect pending batch with id "1"
+
+    Scenario: the project is no longer active
+      Given the project has status "PROJECT_STATUS_SUSPENDED"
+      When alice attempts to approve pending batch "1"
+      Then expect the error "cannot issue credits for project C01-001 with status PROJECT_STATUS_SUSPENDED: invalid request"
+
+  Rule: The credit batch is created and the pending batch is removed
+
+    Scenario: the credit batch is created
+      When alice attempts to approve pending batch "1"
+      Then expect no pending batch with id "1"
+      And expect bob has a tradable balance of "10" in batch "C01-001-20200101-20210101-001"
+      And expect the response
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001"
+      }
+      """
+
+    Scenario: the credit batch is created after batch approval is no longer required
+      Given the credit class does not require batch approval
+      When alice attempts to approve pending batch "1"
+      Then expect no error
+      And expect no pending batch with id "1"
+
+  Rule: Event is emitted
+
+    Scenario: EventApproveBatch is emitted
+      When alice attempts to approve pending batch "1"
+      Then expect event with properties
+      """
+      {
+        "pending_batch_id": "1",
+        "batch_denom": "C01-001-20200101-20210101-001"
+      }
+      """
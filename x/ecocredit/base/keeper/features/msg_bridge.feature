@@ -2,14 +2,18 @@ Feature: Msg/Bridge
 
   Credits can be bridged to another chain:
   - when a batch contract entry exists
+  - when the batch contract is an allowed bridge contract of the target
   - the credits are cancelled and the owner balance is updated
   - the credits are cancelled and the total supply is updated
+  - the credits are locked if the credit batch is linked to a contract on the target chain
+  - a pending bridge request is recorded
   - the bridge target is in the list of allowed bridge chains
 
   Rule: The batch contract entry must exist
 
     Scenario: the batch contract entry exists
       Given a credit batch exists with a batch contract entry
+      And the batch contract is an allowed bridge contract
       And the target is an allowed chain
       And alice owns tradable credits from the credit batch
       When alice attempts to bridge credits from the credit batch
@@ -20,12 +24,32 @@ Feature: Msg/Bridge
       And the target is an allowed chain
       And alice owns tradable credits from the credit batch
       When alice attempts to bridge credits from the credit batch
-      Then expect the error "only credits previously bridged from another chain are supported: invalid request"
+      Then expect the error "only credits previously bridged from another chain or from credit batches linked to a contract are supported: invalid request"
+
+  Rule: The batch contract must be an allowed bridge contract of the target
+
+    Scenario: the batch contract is an allowed bridge contract
+      Given a credit batch exists
+      And the target is an allowed chain
+      And batch has batch contract entry with contract address "0x6887246668a3b87f54deb3b94ba47a6f63f32985"
+      And the batch contract is an allowed bridge contract
+      And alice owns tradable credits from the credit batch
+      When alice attempts to bridge credits from the credit batch
+      Then expect no error
+
+    Scenario: the batch contract is not an allowed bridge contract
+      Given a credit batch exists
+      And the target is an allowed chain
+      And batch has batch contract entry with contract address "0x6887246668a3b87f54deb3b94ba47a6f63f32985"
+      And alice owns tradable credits from the credit batch
+      When alice attempts to bridge credits from the credit batch
+      Then expect the error "0x6887246668a3b87f54deb3b94ba47a6f63f32985 is not an authorized bridge contract for polygon: unauthorized"
 
   Rule: The credits are cancelled and the owner balance is updated
 
     Scenario: the owner balance is updated
       Given a credit batch exists with a batch contract entry
+      And the batch contract is an allowed bridge contract
       And the target is an allowed chain
       And alice has the batch balance
       """
@@ -51,6 +75,7 @@ Feature: Msg/Bridge
 
     Scenario: the total supply is updated
       Given a credit batch exists with a batch contract entry
+      And the batch contract is an allowed bridge contract
       And the target is an allowed chain
       And alice owns tradable credit amount "10" from the credit batch
       And the batch supply
@@ -73,12 +98,96 @@ Feature: Msg/Bridge
 
     # no failing scenario - state transitions only occur upon successful message execution
 
+  Rule: The credits are locked if the credit batch is linked to a contract on the target chain
+
+    Scenario: the credits are locked in the bridge escrow account
+      Given a credit batch exists with a native batch contract entry for target "polygon"
+      And the batch contract is an allowed bridge contract
+      And the target is an allowed chain
+      And alice has the batch balance
+      """
+      {
+        "retired_amount": "0",
+        "tradable_amount": "10",
+        "escrowed_amount": "0"
+      }
+      """
+      When alice attempts to bridge credit amount "4" from the credit batch
+      Then expect alice batch balance
+      """
+      {
+        "retired_amount": "0",
+        "tradable_amount": "6",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect bridge escrow batch balance for target "polygon"
+      """
+      {
+        "retired_amount": "0",
+        "tradable_amount": "4",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect batch supply
+      """
+      {
+        "retired_amount": "0",
+        "tradable_amount": "10",
+        "cancelled_amount": "0"
+      }
+      """
+
+    Scenario: the credit batch is linked to a contract on another chain
+      Given a credit batch exists with a native batch contract entry for target "ethereum"
+      And the batch contract is an allowed bridge contract
+      And the target is an allowed chain
+      And alice owns tradable credits from the credit batch
+      When alice attempts to bridge credits from the credit batch
+      Then expect the error "credits from credit batch C01-001-20200101-20210101-001 can only be bridged to ethereum: invalid request"
+
+  Rule: A pending bridge request is recorded
+
+    Scenario: a bridge request is recorded
+      Given a credit batch exists
+      And the target is an allowed chain
+      And batch has batch contract entry with contract address "0x6887246668a3b87f54deb3b94ba47a6f63f32985"
+      And the batch contract is an allowed bridge contract
+      And alice owns tradable credit amount "10" from the credit batch
+      When alice attempts to bridge credit amount "10" from the credit batch to "0x71C7656EC7ab88b098defB751B7401B5f6d8976F"
+      Then expect the response request ids "1"
+      And expect bridge request 1 with properties
+      """
+      {
+        "batch_key": 1,
+        "target": "polygon",
+        "recipient": "0x71C7656EC7ab88b098defB751B7401B5f6d8976F",
+        "contract": "0x6887246668a3b87f54deb3b94ba47a6f63f32985",
+        "amount": "10",
+        "status": 1
+      }
+      """
+      And expect bridge request 1 owner alice
+
+    Scenario: a new bridge request is recorded each time credits are bridged
+      Given a credit batch exists
+      And the target is an allowed chain
+      And batch has batch contract entry with contract address "0x6887246668a3b87f54deb3b94ba47a6f63f32985"
+      And the batch contract is an allowed bridge contract
+      And alice owns tradable credit amount "10" from the credit batch
+      And alice has bridged credit amount "4" from the credit batch
+      When alice attempts to bridge credit amount "6" from the credit batch to "0x71C7656EC7ab88b098defB751B7401B5f6d8976F"
+      Then expect the response request ids "2"
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
   Rule: Event is emitted
 
     Scenario: EventBridge is emitted
       Given a credit batch exists
       And the target is an allowed chain
       And batch has batch contract entry with contract address "0x6887246668a3b87f54deb3b94ba47a6f63f32985"
+      And the batch contract is an allowed bridge contract
       And alice owns tradable credit amount "10" from the credit batch
       And the batch supply
       """
@@ -95,7 +204,9 @@ Feature: Msg/Bridge
         "target": "polygon",
         "recipient": "0x71C7656EC7ab88b098defB751B7401B5f6d8976F",
         "contract": "0x6887246668a3b87f54deb3b94ba47a6f63f32985",
-        "amount": "10"
+        "amount": "10",
+        "request_id": 1,
+        "batch_denom": "C01-001-20200101-20210101-001"
       }
       """
 
@@ -106,12 +217,14 @@ Feature: Msg/Bridge
 
     Scenario: the bridge chain is allowed
       Given a credit batch exists with a batch contract entry
+      And the batch contract is an allowed bridge contract
       And alice owns tradable credits from the credit batch
       When alice attempts to bridge credits from the credit batch with target "polygon"
       Then expect no error
 
     Scenario: the bridge chain is not allowed
       Given a credit batch exists with a batch contract entry
+      And the batch contract is an allowed bridge contract
       And alice owns tradable credits from the credit batch
       When alice attempts to bridge credits from the credit batch with target "solana"
       Then expect the error "solana is not an authorized bridge target: unauthorized"
\ No newline at end of file
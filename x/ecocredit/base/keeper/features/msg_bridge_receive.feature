@@ -1,15 +1,21 @@
 Feature: Msg/BridgeReceive
 
   Credits can be bridged from another chain:
+  - when the issuer is an allowed bridge service of the source chain
+  - when the contract is an allowed bridge contract of the source chain
   - when the credit class exists
   - when the issuer is an approved credit class issuer
+  - when the credit class is not closed to issuance or deprecated
+  - credit batches are created regardless of the batch approval setting of the credit class
   - when the issuer is the issuer of the existing credit batch
   - when a project from the same class with the same reference id does not exist
+  - when the jurisdiction of a new project exists if strict jurisdictions are enabled
   - when a batch from the same class with the same contract does not exist
   - the project is added using the information provided
   - the credit batch is added using the information provided
   - the recipient batch balance is updated
   - the batch supply is updated
+  - when the bridged amount does not exceed the issuance caps of the credit class
 
   # see Msg/CreateProject, Msg/CreateBatch, and Msg/MintBatchCredits for additional tests
 
@@ -19,6 +25,8 @@ Feature: Msg/BridgeReceive
       Given a credit type with abbreviation "C"
       And a credit class with id "C01" and issuer alice
       And allowed bridge chain "polygon"
+      And allowed bridge services alice and bob
+      And allowed bridge contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
 
     Scenario: credit class exists
       When alice attempts to bridge credits with class id "C01"
@@ -28,12 +36,72 @@ Feature: Msg/BridgeReceive
       When alice attempts to bridge credits with class id "C02"
       Then expect the error "credit class with id C02: not found"
 
+  Rule: The credit class must not be closed to issuance
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And allowed bridge chain "polygon"
+      And allowed bridge services alice and bob
+      And allowed bridge contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
+
+    Scenario: credit class is closed to new projects
+      Given the credit class has status "CLASS_STATUS_CLOSED_TO_NEW_PROJECTS"
+      And a project with id "C01-001" and reference id "VCS-001"
+      When alice attempts to bridge credits with class id "C01" and project reference id "VCS-001"
+      Then expect no error
+
+    Scenario Outline: credit class is closed to issuance
+      Given the credit class has status "<status>"
+      When alice attempts to bridge credits with class id "C01"
+      Then expect the error "cannot issue credits within credit class C01 with status <status>: invalid request"
+
+      Examples:
+        | status                          |
+        | CLASS_STATUS_CLOSED_TO_ISSUANCE |
+        | CLASS_STATUS_DEPRECATED         |
+
+  Rule: Bridged credits do not require batch approval
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And allowed bridge chain "polygon"
+      And allowed bridge services alice and bob
+      And allowed bridge contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
+
+    Scenario: the credit class requires batch approval
+      Given the credit class requires batch approval
+      When alice attempts to bridge credits with class id "C01"
+      Then expect no error
+      And expect total credit batches "1"
+
+  Rule: The jurisdiction of a new project is checked against ISO 3166 when enabled
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And allowed bridge chain "polygon"
+      And allowed bridge services alice and bob
+      And allowed bridge contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
+      And strict jurisdictions are enabled
+
+    Scenario: the project jurisdiction exists
+      When alice attempts to bridge credits with project jurisdiction "US-WA"
+      Then expect no error
+
+    Scenario: the project jurisdiction does not exist
+      When alice attempts to bridge credits with project jurisdiction "ZZ-999"
+      Then expect the error "jurisdiction: country code ZZ is not a valid ISO 3166-1 alpha-2 code: parse error: invalid request"
+
   Rule: The issuer must be an allowed credit class issuer
 
     Background:
       Given a credit type with abbreviation "C"
       And a credit class with id "C01" and issuer alice
       And allowed bridge chain "polygon"
+      And allowed bridge services alice and bob
+      And allowed bridge contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
 
     Scenario: the issuer is not an allowed credit class issuer
       When alice attempts to bridge credits with class id "C01"
@@ -49,6 +117,8 @@ Feature: Msg/BridgeReceive
       Given a credit type with abbreviation "C"
       And a credit class with id "C01" and issuer alice
       And allowed bridge chain "polygon"
+      And allowed bridge services alice and bob
+      And allowed bridge contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
       And a project with id "C01-001"
       And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
       And the batch contract
@@ -74,6 +144,8 @@ Feature: Msg/BridgeReceive
       Given a credit type with abbreviation "C"
       And a credit class with id "C01" and issuer alice
       And allowed bridge chain "polygon"
+      And allowed bridge services alice and bob
+      And allowed bridge contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
       And a project with id "C01-001" and reference id "VCS-001"
 
     Scenario: a project from the same class with a different reference id
@@ -95,6 +167,8 @@ Feature: Msg/BridgeReceive
       Given a credit type with abbreviation "C"
       And a credit class with id "C01" and issuer alice
       And allowed bridge chain "polygon"
+      And allowed bridge services alice and bob
+      And allowed bridge contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
       And a project with id "C01-001"
       And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
 
@@ -120,6 +194,8 @@ Feature: Msg/BridgeReceive
       Given a credit type with abbreviation "C"
       And a credit class with id "C01" and issuer alice
       And allowed bridge chain "polygon"
+      And allowed bridge services alice and bob
+      And allowed bridge contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
 
     Scenario: the project properties are added
       When alice attempts to bridge credits with project properties
@@ -148,6 +224,8 @@ Feature: Msg/BridgeReceive
       Given a credit type with abbreviation "C"
       And a credit class with id "C01" and issuer alice
       And allowed bridge chain "polygon"
+      And allowed bridge services alice and bob
+      And allowed bridge contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
 
     Scenario: the batch properties are added
       When alice attempts to bridge credits with batch properties
@@ -176,6 +254,8 @@ Feature: Msg/BridgeReceive
       Given a credit type with abbreviation "C"
       And a credit class with id "C01" and issuer alice
       And allowed bridge chain "polygon"
+      And allowed bridge services alice and bob
+      And allowed bridge contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
 
     Scenario: balance updated from issuance with single item
       When alice attempts to bridge credits to bob with tradable amount "10"
@@ -196,6 +276,8 @@ Feature: Msg/BridgeReceive
       Given a credit type with abbreviation "C"
       And a credit class with id "C01" and issuer alice
       And allowed bridge chain "polygon"
+      And allowed bridge services alice and bob
+      And allowed bridge contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
 
     Scenario: supply updated from issuance
       When alice attempts to bridge credits to bob with tradable amount "10"
@@ -216,6 +298,8 @@ Feature: Msg/BridgeReceive
       Given a credit type with abbreviation "C"
       And a credit class with id "C01" and issuer alice
       And allowed bridge chain "polygon"
+      And allowed bridge services alice and bob
+      And allowed bridge contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
 
     Scenario: the OriginTx source is in the AllowedBridgeChain table
       When alice attempts to bridge credits with OriginTx Source "polygon"
@@ -223,4 +307,83 @@ Feature: Msg/BridgeReceive
 
     Scenario: the OriginTx source is not in the AllowedBridgeChain table
       When alice attempts to bridge credits with OriginTx Source "solana"
-      Then expect the error "solana is not an authorized bridge source: unauthorized"
\ No newline at end of file
+      Then expect the error "solana is not an authorized bridge source: unauthorized"
+
+  Rule: The bridged amount must not exceed the issuance caps of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And allowed bridge chain "polygon"
+      And allowed bridge services alice and bob
+      And allowed bridge contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
+
+    Scenario: the issuer cap is exceeded when issuing a new credit batch
+      Given the issuer alice has max issuance "100" and issued amount "95"
+      When alice attempts to bridge credits with tradable amount "10"
+      Then expect error contains "cannot issue 10 credits with 5 of 100 credits remaining: limit exceeded"
+
+    Scenario: the project vintage cap is exceeded when issuing a new credit batch
+      Given the credit class has max project vintage issuance "5"
+      When alice attempts to bridge credits with tradable amount "10"
+      Then expect the error "project C01-001 in vintage year 2020: cannot issue 10 credits with 5 of 5 credits remaining: limit exceeded"
+
+    Scenario: the issuer cap is exceeded when minting to an existing credit batch
+      Given a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+      And the batch contract
+      """
+      {
+        "batch_key": 1,
+        "class_key": 1,
+        "contract": "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
+      }
+      """
+      And the issuer alice has max issuance "100" and issued amount "95"
+      When alice attempts to bridge credits with tradable amount "10"
+      Then expect error contains "cannot issue 10 credits with 5 of 100 credits remaining: limit exceeded"
+
+    Scenario: the issuance caps are not exceeded
+      Given the issuer alice has max issuance "100" and issued amount "90"
+      And the credit class has max project vintage issuance "10"
+      When alice attempts to bridge credits with tradable amount "10"
+      Then expect no error
+
+  Rule: The issuer must be an allowed bridge service of the source chain
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And allowed bridge chain "polygon"
+      And allowed bridge contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
+
+    Scenario: the issuer is an allowed bridge service
+      Given allowed bridge services alice and bob
+      When alice attempts to bridge credits with class id "C01"
+      Then expect no error
+
+    Scenario: the issuer is not an allowed bridge service
+      When alice attempts to bridge credits with class id "C01"
+      Then expect error contains "is not an authorized bridge service for polygon: unauthorized"
+
+  Rule: The contract must be an allowed bridge contract of the source chain
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And allowed bridge chain "polygon"
+      And allowed bridge services alice and bob
+
+    Scenario: the contract is an allowed bridge contract
+      Given allowed bridge contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
+      When alice attempts to bridge credits with contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
+      Then expect no error
+
+    Scenario: the contract is an allowed bridge contract encoded in lowercase
+      Given allowed bridge contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
+      When alice attempts to bridge credits with contract "0x0e65079a29d7793ab5ca500c2d88e60ee99ba606"
+      Then expect no error
+
+    Scenario: the contract is not an allowed bridge contract
+      When alice attempts to bridge credits with contract "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606"
+      Then expect the error "0x0E65079a29d7793ab5CA500c2d88e60EE99Ba606 is not an authorized bridge contract for polygon: unauthorized"
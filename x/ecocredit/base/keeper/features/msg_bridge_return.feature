@@ -0,0 +1,312 @@
+Feature: Msg/BridgeReturn
+
+  Credits from a credit batch linked to a contract can be returned from another chain:
+  - when the origin tx source is an allowed bridge chain
+  - when the issuer is an allowed bridge service of the origin tx source
+  - when the credit batch is linked to a contract
+  - when the origin tx source is the chain the credit batch is linked to
+  - when the origin tx contract is the contract the credit batch is linked to
+  - when the origin tx contract is an allowed bridge contract of the origin tx source
+  - when the issuer is an issuer of the credit class
+  - when the origin tx has not already been processed
+  - when the bridge escrow account holds enough credits
+  - the credits are sent from the bridge escrow account to the recipient
+  - the credits are returned when received through Msg/BridgeReceive
+
+  Background:
+    Given a credit type with abbreviation "C" and precision 6
+    And a credit class with id "C01" and issuer alice
+    And a project with id "C01-001" in credit class "C01"
+    And a credit batch with denom "C01-001-20200101-20210101-001" from project "C01-001"
+    And a credit batch with denom "C01-001-20200101-20210101-002" from project "C01-001"
+    And the chain "polygon" is an allowed bridge chain
+    And the chain "ethereum" is an allowed bridge chain
+    And alice is an allowed bridge service for chain "polygon"
+    And alice is an allowed bridge service for chain "ethereum"
+    And the contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" is an allowed bridge contract for chain "polygon"
+    And credit batch "C01-001-20200101-20210101-001" is linked to contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" on target "polygon"
+    And the bridge escrow account of target "polygon" holds "10" credits from credit batch "C01-001-20200101-20210101-001"
+
+  Rule: The origin tx source must be an allowed bridge chain
+
+    Scenario: the origin tx source is not an allowed bridge chain
+      When alice attempts to return credits to bob with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "4",
+        "origin_tx": {
+          "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+          "source": "solana",
+          "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+        }
+      }
+      """
+      Then expect the error "solana is not an authorized bridge source: unauthorized"
+
+  Rule: The issuer must be an allowed bridge service of the origin tx source
+
+    Scenario: the issuer is not an allowed bridge service
+      When bob attempts to return credits to bob with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "4",
+        "origin_tx": {
+          "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+          "source": "polygon",
+          "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+        }
+      }
+      """
+      Then expect error contains "is not an authorized bridge service for polygon: unauthorized"
+
+  Rule: The credit batch must be linked to a contract
+
+    Scenario: the credit batch is not linked to a contract
+      When alice attempts to return credits to bob with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-002",
+        "amount": "4",
+        "origin_tx": {
+          "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+          "source": "polygon",
+          "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+        }
+      }
+      """
+      Then expect the error "credit batch C01-001-20200101-20210101-002 is not linked to a contract: invalid request"
+
+  Rule: The origin tx source must be the chain the credit batch is linked to
+
+    Scenario: the origin tx source is another chain
+      When alice attempts to return credits to bob with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "4",
+        "origin_tx": {
+          "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+          "source": "ethereum",
+          "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+        }
+      }
+      """
+      Then expect the error "credits from credit batch C01-001-20200101-20210101-001 can only be returned from polygon: invalid request"
+
+  Rule: The origin tx contract must be the contract the credit batch is linked to
+
+    Scenario: the origin tx contract is another contract
+      When alice attempts to return credits to bob with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "4",
+        "origin_tx": {
+          "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+          "source": "polygon",
+          "contract": "0x6887246668a3b87f54deb3b94ba47a6f63f32985"
+        }
+      }
+      """
+      Then expect the error "origin tx contract 0x6887246668a3b87f54deb3b94ba47a6f63f32985 does not match contract 0x06012c8cf97bead5deae237070f9587f8e7a266d of credit batch C01-001-20200101-20210101-001: invalid request"
+
+  Rule: The origin tx contract must be an allowed bridge contract of the origin tx source
+
+    Scenario: the origin tx contract is no longer an allowed bridge contract
+      Given the contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" is removed from the allowed bridge contracts for chain "polygon"
+      When alice attempts to return credits to bob with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "4",
+        "origin_tx": {
+          "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+          "source": "polygon",
+          "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+        }
+      }
+      """
+      Then expect the error "0x06012c8cf97bead5deae237070f9587f8e7a266d is not an authorized bridge contract for polygon: unauthorized"
+
+  Rule: The issuer must be an issuer of the credit class
+
+    Scenario: the issuer is not an issuer of the credit class
+      Given bob is an allowed bridge service for chain "polygon"
+      When bob attempts to return credits to bob with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "4",
+        "origin_tx": {
+          "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+          "source": "polygon",
+          "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+        }
+      }
+      """
+      Then expect error contains "is not an issuer for the class: unauthorized"
+
+  Rule: The origin tx must not already be processed
+
+    Scenario: the origin tx has already been processed
+      Given alice has returned credits to bob with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "4",
+        "origin_tx": {
+          "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+          "source": "polygon",
+          "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+        }
+      }
+      """
+      When alice attempts to return credits to bob with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "4",
+        "origin_tx": {
+          "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+          "source": "polygon",
+          "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+        }
+      }
+      """
+      Then expect the error "credits already bridged with tx id: 0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e: invalid request"
+
+  Rule: The bridge escrow account must hold enough credits
+
+    Scenario: the bridge escrow account holds enough credits
+      When alice attempts to return credits to bob with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "10",
+        "origin_tx": {
+          "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+          "source": "polygon",
+          "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+        }
+      }
+      """
+      Then expect no error
+
+    Scenario: the bridge escrow account does not hold enough credits
+      When alice attempts to return credits to bob with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "11",
+        "origin_tx": {
+          "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+          "source": "polygon",
+          "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+        }
+      }
+      """
+      Then expect the error "tradable balance: 10, send tradable amount 11: insufficient credit balance"
+
+  Rule: The credits are sent from the bridge escrow account to the recipient
+
+    Scenario: the balances are updated
+      When alice attempts to return credits to bob with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "4",
+        "origin_tx": {
+          "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+          "source": "polygon",
+          "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+        }
+      }
+      """
+      Then expect bob has a tradable balance of "4" from credit batch "C01-001-20200101-20210101-001"
+      And expect the bridge escrow account of target "polygon" has a tradable balance of "6" from credit batch "C01-001-20200101-20210101-001"
+
+    Scenario: the credits are returned in separate transactions
+      Given alice has returned credits to bob with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "4",
+        "origin_tx": {
+          "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+          "source": "polygon",
+          "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+        }
+      }
+      """
+      When alice attempts to return credits to bob with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "6",
+        "origin_tx": {
+          "id": "0x8b70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+          "source": "polygon",
+          "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+        }
+      }
+      """
+      Then expect bob has a tradable balance of "10" from credit batch "C01-001-20200101-20210101-001"
+      And expect the bridge escrow account of target "polygon" has a tradable balance of "0" from credit batch "C01-001-20200101-20210101-001"
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The credits are returned when received through Msg/BridgeReceive
+
+    Scenario: the credits are received from the linked contract
+      When alice attempts to bridge receive credits to bob with properties
+      """
+      {
+        "class_id": "C01",
+        "project": {
+          "reference_id": "VCS-001",
+          "jurisdiction": "US-WA",
+          "metadata": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf"
+        },
+        "batch": {
+          "amount": "4",
+          "start_date": "2020-01-01T00:00:00Z",
+          "end_date": "2021-01-01T00:00:00Z",
+          "metadata": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf"
+        },
+        "origin_tx": {
+          "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+          "source": "polygon",
+          "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+        }
+      }
+      """
+      Then expect no error
+      And expect bob has a tradable balance of "4" from credit batch "C01-001-20200101-20210101-001"
+      And expect the bridge escrow account of target "polygon" has a tradable balance of "6" from credit batch "C01-001-20200101-20210101-001"
+
+  Rule: Event is emitted
+
+    Scenario: EventBridgeReturn is emitted
+      Given bobs address "regen10yhlcvh88sux4zmf67udhg5f5z2803z6jm0d25"
+      When alice attempts to return credits to bob with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "4",
+        "origin_tx": {
+          "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+          "source": "polygon",
+          "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+        }
+      }
+      """
+      Then expect event with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "recipient": "regen10yhlcvh88sux4zmf67udhg5f5z2803z6jm0d25",
+        "amount": "4"
+      }
+      """
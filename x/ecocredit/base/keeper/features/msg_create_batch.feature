@@ -3,6 +3,9 @@ Feature: Msg/CreateBatch
   Credits can be issued in batches:
   - when the project exists
   - when the issuer is an allowed credit class issuer
+  - when the project is active or the project status has never been updated
+  - when the credit class is not closed to issuance or deprecated
+  - when the credit class does not require batch approval
   - when the decimal places in issuance amount does not exceed credit type precision
   - when the origin tx is unique within the scope of a credit class
   - when the contract is unique within the scope of a credit class
@@ -43,6 +46,142 @@ Feature: Msg/CreateBatch
       When bob attempts to create a batch with project id "C01-001"
       Then expect error contains "is not an issuer for the class: unauthorized"
 
+  Rule: The project must be active or the project status must never have been updated
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and issuer alice
+      And a project with project id "C01-001"
+
+    Scenario: the project status has never been updated
+      When alice attempts to create a batch with project id "C01-001"
+      Then expect no error
+
+    Scenario: the project is active
+      Given the project has status "PROJECT_STATUS_ACTIVE"
+      When alice attempts to create a batch with project id "C01-001"
+      Then expect no error
+
+    Scenario Outline: the project is not active
+      Given the project has status "<status>"
+      When alice attempts to create a batch with project id "C01-001"
+      Then expect the error "cannot issue credits for project C01-001 with status <status>: invalid request"
+
+      Examples:
+        | status                    |
+        | PROJECT_STATUS_PROPOSED   |
+        | PROJECT_STATUS_REGISTERED |
+        | PROJECT_STATUS_VALIDATED  |
+        | PROJECT_STATUS_SUSPENDED  |
+        | PROJECT_STATUS_RETIRED    |
+
+  Rule: The credit class must not be closed to issuance
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and issuer alice
+      And a project with project id "C01-001"
+
+    Scenario Outline: the credit class is open to issuance
+      Given the credit class has status "<status>"
+      When alice attempts to create a batch with project id "C01-001"
+      Then expect no error
+
+      Examples:
+        | status                              |
+        | CLASS_STATUS_ACTIVE                 |
+        | CLASS_STATUS_CLOSED_TO_NEW_PROJECTS |
+
+    Scenario Outline: the credit class is closed to issuance
+      Given the credit class has status "<status>"
+      When alice attempts to create a batch with project id "C01-001"
+      Then expect the error "cannot issue credits within credit class C01 with status <status>: invalid request"
+
+      Examples:
+        | status                          |
+        | CLASS_STATUS_CLOSED_TO_ISSUANCE |
+        | CLASS_STATUS_DEPRECATED         |
+
+  Rule: The credit class must not require batch approval
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and issuer alice
+      And a project with project id "C01-001"
+
+    Scenario: the credit class does not require batch approval
+      When alice attempts to create a batch with project id "C01-001"
+      Then expect no error
+
+    Scenario: the credit class requires batch approval
+      Given the credit class requires batch approval
+      When alice attempts to create a batch with project id "C01-001"
+      Then expect the error "credit class C01 requires batch approval: credit batches must be proposed: invalid request"
+
+  Rule: The issued amount must not exceed the issuance caps of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and issuer alice
+      And a project with project id "C01-001"
+
+    Scenario: the issuer cap is not exceeded
+      Given the issuer alice has max issuance "100" and issued amount "50"
+      When alice attempts to create a batch with project id "C01-001" and issuance
+      """
+      [
+        {
+          "recipient": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+          "tradable_amount": "30",
+          "retired_amount": "20",
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+      """
+      Then expect no error
+      And expect the issuer alice has issued amount "100"
+
+    Scenario: the issuer cap is exceeded
+      Given the issuer alice has max issuance "100" and issued amount "50"
+      When alice attempts to create a batch with project id "C01-001" and issuance
+      """
+      [
+        {
+          "recipient": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+          "tradable_amount": "30",
+          "retired_amount": "21",
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+      """
+      Then expect error contains "cannot issue 51 credits with 50 of 100 credits remaining: limit exceeded"
+
+    Scenario: the project vintage cap is not exceeded
+      Given the credit class has max project vintage issuance "100"
+      And the project has issued amount "50" in vintage year "2020"
+      When alice attempts to create a batch with project id "C01-001" and tradable amount "50"
+      Then expect no error
+      And expect the project has issued amount "100" in vintage year "2020"
+
+    Scenario: the project vintage cap is exceeded
+      Given the credit class has max project vintage issuance "100"
+      And the project has issued amount "50" in vintage year "2020"
+      When alice attempts to create a batch with project id "C01-001" and tradable amount "51"
+      Then expect the error "project C01-001 in vintage year 2020: cannot issue 51 credits with 50 of 100 credits remaining: limit exceeded"
+
+    Scenario: the project vintage cap does not apply to other vintage years
+      Given the credit class has max project vintage issuance "100"
+      And the project has issued amount "100" in vintage year "2019"
+      When alice attempts to create a batch with project id "C01-001" and tradable amount "100"
+      Then expect no error
+      And expect the project has issued amount "100" in vintage year "2020"
+
+    Scenario: the buffer pool contribution counts towards the issuance caps
+      Given the credit class has buffer percentage "20"
+      And the credit class has max project vintage issuance "100"
+      When alice attempts to create a batch with project id "C01-001" and tradable amount "101"
+      Then expect the error "project C01-001 in vintage year 2020: cannot issue 101 credits with 100 of 100 credits remaining: limit exceeded"
+
   Rule: The decimal places in issuance amount must not exceed credit type precision
 
     Background:
@@ -119,6 +258,29 @@ Feature: Msg/CreateBatch
       """
       Then expect no error
 
+    Scenario: the origin tx is recorded with the credit batch
+      When alice attempts to create a batch with project id "C01-001" and origin tx
+      """
+      {
+        "id": "0x64",
+        "source": "polygon",
+        "contract": "0x40",
+        "note": "bridged from polygon"
+      }
+      """
+      Then expect origin tx index
+      """
+      {
+        "class_key": 1,
+        "id": "0x64",
+        "source": "polygon",
+        "batch_key": 1,
+        "mint": false,
+        "contract": "0x40",
+        "note": "bridged from polygon"
+      }
+      """
+
   Rule: The contract must be unique within the scope of the credit class
 
     Background:
@@ -313,6 +475,78 @@ Feature: Msg/CreateBatch
 
     # no failing scenario - state transitions only occur upon successful message execution
 
+  Rule: A percentage of the tradable credits is sent to the buffer pool
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and issuer alice
+      And a project with project id "C01-001"
+      And the credit class has buffer percentage "20"
+
+    Scenario: buffer pool contribution withheld from issuance with single item
+      When alice attempts to create a batch with project id "C01-001" and issuance
+      """
+      [
+        {
+          "recipient": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+          "tradable_amount": "100",
+          "retired_amount": "100",
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+      """
+      Then expect recipient batch balance with address "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+      """
+      {
+        "tradable_amount": "80",
+        "retired_amount": "100",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect buffer pool tradable balance "20"
+      And expect batch supply
+      """
+      {
+        "tradable_amount": "100",
+        "retired_amount": "100",
+        "cancelled_amount": "0"
+      }
+      """
+
+    Scenario: buffer pool contribution withheld from issuance with multiple items
+      When alice attempts to create a batch with project id "C01-001" and issuance
+      """
+      [
+        {
+          "recipient": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+          "tradable_amount": "100"
+        },
+        {
+          "recipient": "regen1c3lpjaq0ytdtsrnjqzmtj3hceavl8fe2vtkj7f",
+          "tradable_amount": "9"
+        }
+      ]
+      """
+      Then expect recipient batch balance with address "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+      """
+      {
+        "tradable_amount": "80",
+        "retired_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect recipient batch balance with address "regen1c3lpjaq0ytdtsrnjqzmtj3hceavl8fe2vtkj7f"
+      """
+      {
+        "tradable_amount": "8",
+        "retired_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect buffer pool tradable balance "21"
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
   Rule: the batch sequence is updated
 
     Background:
@@ -3,6 +3,7 @@ Feature: CreateProject
   Projects can be created:
   - when the credit class exists
   - when the admin is an allowed credit class issuer
+  - when the credit class is open to new projects
   - when the non-empty reference id is unique within the scope of the credit class
   - the project sequence is updated
   - the project properties are added
@@ -36,6 +37,32 @@ Feature: CreateProject
       When bob attempts to create a project with class id "C01"
       Then expect error contains "is not an issuer for the class: unauthorized"
 
+  Rule: The credit class must be open to new projects
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and issuer alice
+
+    Scenario: the credit class status has never been updated
+      When alice attempts to create a project with class id "C01"
+      Then expect no error
+
+    Scenario: the credit class is active
+      Given credit class "C01" has status "CLASS_STATUS_ACTIVE"
+      When alice attempts to create a project with class id "C01"
+      Then expect no error
+
+    Scenario Outline: the credit class is not open to new projects
+      Given credit class "C01" has status "<status>"
+      When alice attempts to create a project with class id "C01"
+      Then expect the error "cannot create projects within credit class C01 with status <status>: invalid request"
+
+      Examples:
+        | status                              |
+        | CLASS_STATUS_CLOSED_TO_NEW_PROJECTS |
+        | CLASS_STATUS_CLOSED_TO_ISSUANCE     |
+        | CLASS_STATUS_DEPRECATED             |
+
   Rule: A non-empty reference id must be unique within the scope of a credit class
 
     Background:
@@ -0,0 +1,146 @@
+Feature: Msg/FailBridge
+
+  A bridge request can be failed:
+  - when the bridge request exists
+  - when the service is an allowed bridge service of the target chain
+  - when the bridge request is pending
+  - the cancelled credits are restored to the owner
+  - the locked credits are returned to the owner
+  - the bridge request status is updated
+
+  Background:
+    Given the target "polygon" is an allowed bridge chain
+    And bob is an allowed bridge service for "polygon"
+
+  Rule: The bridge request must exist
+
+    Scenario: the bridge request exists
+      Given a credit batch exists with a batch contract entry
+      And alice has bridged credit amount "4" from the credit batch to "polygon"
+      When bob attempts to fail bridge request 1
+      Then expect no error
+
+    Scenario: the bridge request does not exist
+      When bob attempts to fail bridge request 1
+      Then expect the error "bridge request with id 1: not found"
+
+  Rule: The service must be an allowed bridge service of the target chain
+
+    Scenario: the service is not an allowed bridge service
+      Given a credit batch exists with a batch contract entry
+      And alice has bridged credit amount "4" from the credit batch to "polygon"
+      When alice attempts to fail bridge request 1
+      Then expect error contains "is not an authorized bridge service for polygon: unauthorized"
+
+    Scenario: the service is an allowed bridge service of another chain
+      Given the target "ethereum" is an allowed bridge chain
+      And carol is an allowed bridge service for "ethereum"
+      And a credit batch exists with a batch contract entry
+      And alice has bridged credit amount "4" from the credit batch to "polygon"
+      When carol attempts to fail bridge request 1
+      Then expect error contains "is not an authorized bridge service for polygon: unauthorized"
+
+  Rule: The bridge request must be pending
+
+    Scenario: the bridge request has been acknowledged
+      Given a credit batch exists with a batch contract entry
+      And alice has bridged credit amount "4" from the credit batch to "polygon"
+      And bob has acknowledged bridge request 1
+      When bob attempts to fail bridge request 1
+      Then expect the error "bridge request 1 is not pending: BRIDGE_REQUEST_STATUS_COMPLETED: invalid request"
+
+    Scenario: the bridge request has been failed
+      Given a credit batch exists with a batch contract entry
+      And alice has bridged credit amount "4" from the credit batch to "polygon"
+      And bob has failed bridge request 1
+      When bob attempts to fail bridge request 1
+      Then expect the error "bridge request 1 is not pending: BRIDGE_REQUEST_STATUS_FAILED: invalid request"
+
+  Rule: The cancelled credits are restored to the owner
+
+    Scenario: the owner balance and the batch supply are restored
+      Given a credit batch exists with a batch contract entry
+      And alice has bridged credit amount "4" from the credit batch to "polygon"
+      When bob attempts to fail bridge request 1
+      Then expect alice batch balance
+      """
+      {
+        "tradable_amount": "10",
+        "retired_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect batch supply
+      """
+      {
+        "tradable_amount": "10",
+        "retired_amount": "0",
+        "cancelled_amount": "0"
+      }
+      """
+      And expect project supply
+      """
+      {
+        "tradable_amount": "10",
+        "retired_amount": "0",
+        "cancelled_amount": "0"
+      }
+      """
+
+    Scenario: the serial numbers of the owner are restored
+      Given a serialized credit batch exists with a batch contract entry
+      And alice has bridged credit amount "4" from the credit batch to "polygon"
+      When bob attempts to fail bridge request 1
+      Then expect alice owns "10" tradable serial numbers
+      And expect alice owns "0" cancelled serial numbers
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The locked credits are returned to the owner
+
+    Scenario: the credits are returned from the bridge escrow account
+      Given a credit batch exists with a native batch contract entry for target "polygon"
+      And alice has bridged credit amount "4" from the credit batch to "polygon"
+      When bob attempts to fail bridge request 1
+      Then expect alice batch balance
+      """
+      {
+        "tradable_amount": "10",
+        "retired_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect bridge escrow batch balance for target "polygon"
+      """
+      {
+        "tradable_amount": "0",
+        "retired_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The bridge request status is updated
+
+    Scenario: the bridge request status is failed
+      Given a credit batch exists with a batch contract entry
+      And alice has bridged credit amount "4" from the credit batch to "polygon"
+      When bob attempts to fail bridge request 1
+      Then expect bridge request 1 with status "BRIDGE_REQUEST_STATUS_FAILED"
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Scenario: EventFailBridge is emitted
+      Given a credit batch exists with a batch contract entry
+      And alice has bridged credit amount "4" from the credit batch to "polygon"
+      When bob attempts to fail bridge request 1 with reason "relay transaction reverted"
+      Then expect event with properties
+      """
+      {
+        "request_id": 1,
+        "reason": "relay transaction reverted"
+      }
+      """
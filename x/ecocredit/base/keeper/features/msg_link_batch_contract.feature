@@ -0,0 +1,91 @@
+Feature: Msg/LinkBatchContract
+
+  A credit batch can be linked to a contract on another chain:
+  - when the credit batch exists
+  - when the admin is the admin of the credit class
+  - when the target is an allowed bridge chain
+  - when the contract is an allowed bridge contract of the target
+  - when the credit batch is not already linked to a contract
+  - when the contract is not already linked to a credit batch within the credit class
+  - the batch contract and native batch contract entries are saved
+
+  Background:
+    Given a credit class with id "C01" and admin alice
+    And a project with id "C01-001" in credit class "C01"
+    And a credit batch with denom "C01-001-20200101-20210101-001" from project "C01-001"
+    And a credit batch with denom "C01-001-20200101-20210101-002" from project "C01-001"
+    And the chain "polygon" is an allowed bridge chain
+    And the contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" is an allowed bridge contract for chain "polygon"
+    And the contract "0x6887246668a3b87f54deb3b94ba47a6f63f32985" is an allowed bridge contract for chain "polygon"
+
+  Rule: The credit batch must exist
+
+    Scenario: the credit batch exists
+      When alice attempts to link credit batch "C01-001-20200101-20210101-001" to contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" on target "polygon"
+      Then expect no error
+
+    Scenario: the credit batch does not exist
+      When alice attempts to link credit batch "C01-001-20200101-20210101-003" to contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" on target "polygon"
+      Then expect the error "could not get batch with denom C01-001-20200101-20210101-003: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to link credit batch "C01-001-20200101-20210101-001" to contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" on target "polygon"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The target must be an allowed bridge chain
+
+    Scenario: the target is not an allowed bridge chain
+      When alice attempts to link credit batch "C01-001-20200101-20210101-001" to contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" on target "solana"
+      Then expect the error "solana is not an authorized bridge target: unauthorized"
+
+  Rule: The contract must be an allowed bridge contract of the target
+
+    Scenario: the contract is not an allowed bridge contract
+      When alice attempts to link credit batch "C01-001-20200101-20210101-001" to contract "0x71c7656ec7ab88b098defb751b7401b5f6d8976f" on target "polygon"
+      Then expect the error "0x71c7656ec7ab88b098defb751b7401b5f6d8976f is not an authorized bridge contract for polygon: unauthorized"
+
+    Scenario: the contract is an allowed bridge contract encoded with the checksum
+      When alice attempts to link credit batch "C01-001-20200101-20210101-001" to contract "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d" on target "polygon"
+      Then expect no error
+
+  Rule: The credit batch must not already be linked to a contract
+
+    Scenario: the credit batch was created by a bridge operation
+      Given credit batch "C01-001-20200101-20210101-001" has batch contract "0x6887246668a3b87f54deb3b94ba47a6f63f32985"
+      When alice attempts to link credit batch "C01-001-20200101-20210101-001" to contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" on target "polygon"
+      Then expect the error "credit batch C01-001-20200101-20210101-001 is already linked to contract 0x6887246668a3b87f54deb3b94ba47a6f63f32985: invalid request"
+
+    Scenario: the credit batch has already been linked
+      Given alice has linked credit batch "C01-001-20200101-20210101-001" to contract "0x6887246668a3b87f54deb3b94ba47a6f63f32985" on target "polygon"
+      When alice attempts to link credit batch "C01-001-20200101-20210101-001" to contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" on target "polygon"
+      Then expect the error "credit batch C01-001-20200101-20210101-001 is already linked to contract 0x6887246668a3b87f54deb3b94ba47a6f63f32985: invalid request"
+
+  Rule: The contract must not already be linked to a credit batch within the credit class
+
+    Scenario: the contract is linked to another credit batch
+      Given alice has linked credit batch "C01-001-20200101-20210101-002" to contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" on target "polygon"
+      When alice attempts to link credit batch "C01-001-20200101-20210101-001" to contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" on target "polygon"
+      Then expect the error "contract 0x06012c8cf97bead5deae237070f9587f8e7a266d is already linked to a credit batch within credit class C01: invalid request"
+
+  Rule: The batch contract and native batch contract entries are saved
+
+    Scenario: the credit batch is linked
+      When alice attempts to link credit batch "C01-001-20200101-20210101-001" to contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" on target "Polygon"
+      Then expect credit batch "C01-001-20200101-20210101-001" is linked to contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" on target "polygon"
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Scenario: EventLinkBatchContract is emitted
+      When alice attempts to link credit batch "C01-001-20200101-20210101-001" to contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" on target "polygon"
+      Then expect event with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "target": "polygon",
+        "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+      }
+      """
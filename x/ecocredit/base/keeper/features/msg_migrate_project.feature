@@ -0,0 +1,199 @@
+Feature: Msg/MigrateProject
+
+  A project can be migrated to a different credit class:
+  - when the project exists
+  - when the admin is the admin of the current credit class
+  - when the new credit class exists
+  - when the new class admin is the admin of the new credit class
+  - when the new credit class is not the current credit class
+  - when the new credit class is open to new projects
+  - when the new credit class has the same credit type
+  - when the reference id is unique within the new credit class
+  - the project is assigned a new project id from the new credit class
+  - the previous project id is stored as an alias
+  - existing credit batch denoms are not changed
+  - the credit batches of the project are within the new credit class
+  - the bridge entries of the credit batches are moved to the new credit class
+
+  Background:
+    Given a credit class with id "C01" and admin alice
+    And a credit class with id "C02" and admin bob
+    And a credit class with id "BIO01" and admin bob
+    And a project with id "C01-001" in credit class "C01"
+
+  Rule: The project must exist
+
+    Scenario: the project exists
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect no error
+
+    Scenario: the project does not exist
+      When alice and bob attempt to migrate project "C01-002" to credit class "C02"
+      Then expect the error "could not get project with id C01-002: not found: invalid request"
+
+  Rule: The admin must be the admin of the current credit class
+
+    Scenario: the admin is not the admin of the current credit class
+      When bob and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The new credit class must exist
+
+    Scenario: the new credit class does not exist
+      When alice and bob attempt to migrate project "C01-001" to credit class "C03"
+      Then expect the error "could not get class with id C03: not found: invalid request"
+
+  Rule: The new class admin must be the admin of the new credit class
+
+    Scenario: the new class admin is not the admin of the new credit class
+      When alice and alice attempt to migrate project "C01-001" to credit class "C02"
+      Then expect error contains "is not the admin of credit class C02: unauthorized"
+
+  Rule: The new credit class must not be the current credit class
+
+    Scenario: the new credit class is the current credit class
+      When alice and alice attempt to migrate project "C01-001" to credit class "C01"
+      Then expect the error "project C01-001 is already in credit class C01: invalid request"
+
+  Rule: The new credit class must be open to new projects
+
+    Scenario: the new credit class is closed to new projects
+      Given credit class "C02" has status "CLASS_STATUS_CLOSED_TO_NEW_PROJECTS"
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect the error "cannot create projects within credit class C02 with status CLASS_STATUS_CLOSED_TO_NEW_PROJECTS: invalid request"
+
+  Rule: The new credit class must have the same credit type
+
+    Scenario: the new credit class has a different credit type
+      When alice and bob attempt to migrate project "C01-001" to credit class "BIO01"
+      Then expect the error "credit type of credit class BIO01 does not match credit type of credit class C01: expected C, got BIO: invalid request"
+
+  Rule: The new credit class must have the same serialization if credits have been issued
+
+    Scenario: the new credit class has a different serialization and no credits have been issued
+      Given credit class "C02" is serialized
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect no error
+
+    Scenario: the new credit class has a different serialization and credits have been issued
+      Given credit class "C02" is serialized
+      And credits have been issued within project "C01-001"
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect the error "serialization of credit class C02 does not match serialization of credit class C01: invalid request"
+
+  Rule: The reference id must be unique within the new credit class
+
+    Background:
+      Given project "C01-001" has reference id "VCS-001"
+
+    Scenario: the reference id is unique within the new credit class
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect no error
+
+    Scenario: the reference id is not unique within the new credit class
+      Given a project with id "C02-001" in credit class "C02"
+      And project "C02-001" has reference id "VCS-001"
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect the error "a project with reference id VCS-001 already exists within this credit class: invalid request"
+
+  Rule: The project is assigned a new project id from the new credit class
+
+    Scenario: the first project in the new credit class
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect the response project id "C02-001"
+      And expect project "C02-001" in credit class "C02"
+      And expect the project sequence of credit class "C02" is "2"
+
+    Scenario: a project already exists in the new credit class
+      Given a project with id "C02-001" in credit class "C02"
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect the response project id "C02-002"
+      And expect project "C02-002" in credit class "C02"
+      And expect the project sequence of credit class "C02" is "3"
+
+    Scenario: the project sequence of the current credit class is not changed
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect the project sequence of credit class "C01" is "2"
+
+  Rule: The previous project id is stored as an alias
+
+    Scenario: the alias is stored
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect alias "C01-001" for project "C02-001"
+
+    Scenario: the alias is kept when the project is migrated again
+      Given a credit class with id "C03" and admin alice
+      And alice and bob have migrated project "C01-001" to credit class "C02"
+      When bob and alice attempt to migrate project "C02-001" to credit class "C03"
+      Then expect alias "C01-001" for project "C03-001"
+      And expect alias "C02-001" for project "C03-001"
+
+    Scenario: the project can be queried by the alias
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect querying project "C01-001" returns project "C02-001"
+
+  Rule: The project is listed under the new credit class
+
+    Scenario: the project is listed under the new credit class
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect querying projects by credit class "C02" returns "1" project
+      And expect querying projects by credit class "C01" returns "0" project
+
+  Rule: Existing credit batch denoms are not changed
+
+    Scenario: the credit batch keeps its denom
+      Given a credit batch with denom "C01-001-20200101-20210101-001" from project "C01-001"
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect credit batch "C01-001-20200101-20210101-001" from project "C02-001"
+
+  Rule: The credit batches of the project are within the new credit class
+
+    Scenario: the credit class of the credit batch is the new credit class
+      Given a credit batch with denom "C01-001-20200101-20210101-001" from project "C01-001"
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect the credit class of credit batch "C01-001-20200101-20210101-001" is "C02"
+
+  Rule: The bridge entries of the credit batches are moved to the new credit class
+
+    Background:
+      Given a credit batch with denom "C01-001-20200101-20210101-001" from project "C01-001"
+      And credit batch "C01-001-20200101-20210101-001" was bridged with origin tx "0x1234" and contract "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+
+    Scenario: the batch contract is moved to the new credit class
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect no error
+      And expect contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" within credit class "C02"
+      And expect no contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" within credit class "C01"
+
+    Scenario: the origin tx is moved to the new credit class
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect no error
+      And expect origin tx "0x1234" within credit class "C02"
+      And expect no origin tx "0x1234" within credit class "C01"
+
+    Scenario: the contract is already linked to a credit batch within the new credit class
+      Given a project with id "C02-001" in credit class "C02"
+      And a credit batch with denom "C02-001-20200101-20210101-001" from project "C02-001"
+      And credit batch "C02-001-20200101-20210101-001" was bridged with origin tx "0x5678" and contract "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect the error "contract 0x06012c8cf97bead5deae237070f9587f8e7a266d is already linked to a credit batch within credit class C02: invalid request"
+
+    Scenario: the origin tx already exists within the new credit class
+      Given a project with id "C02-001" in credit class "C02"
+      And a credit batch with denom "C02-001-20200101-20210101-001" from project "C02-001"
+      And credit batch "C02-001-20200101-20210101-001" was bridged with origin tx "0x1234" and contract "0x0e65079a29d7793ab5ca500c2d88e60ee99ba606"
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect the error "origin tx with id 0x1234 and source polygon already exists within credit class C02: invalid request"
+
+  Rule: Event is emitted
+
+    Scenario: EventMigrateProject is emitted
+      When alice and bob attempt to migrate project "C01-001" to credit class "C02"
+      Then expect event with properties
+      """
+      {
+        "old_project_id": "C01-001",
+        "new_project_id": "C02-001",
+        "new_class_id": "C02"
+      }
+      """
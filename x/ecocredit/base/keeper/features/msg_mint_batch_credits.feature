@@ -4,6 +4,8 @@ Feature: Msg/MintBatchCredits
   - when the credit batch exists
   - when the credit batch is open
   - when the issuer is the issuer of the credit batch
+  - when the project is active or the project status has never been updated
+  - when the credit class is not closed to issuance or deprecated
   - when the origin tx id and source is unique within the scope of the credit class
   - when the contract is unique within the scope of the credit class
   - the recipient batch balance is updated
@@ -58,6 +60,96 @@ Feature: Msg/MintBatchCredits
       When bob attempts to mint credits with batch denom "C01-001-20200101-20210101-001"
       Then expect the error "only the account that issued the batch can mint additional credits: unauthorized"
 
+  Rule: The project must be active or the project status must never have been updated
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+
+    Scenario: the project status has never been updated
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the project is active
+      Given the project has status "PROJECT_STATUS_ACTIVE"
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario Outline: the project is not active
+      Given the project has status "<status>"
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001"
+      Then expect the error "cannot issue credits for project C01-001 with status <status>: invalid request"
+
+      Examples:
+        | status                    |
+        | PROJECT_STATUS_PROPOSED   |
+        | PROJECT_STATUS_REGISTERED |
+        | PROJECT_STATUS_VALIDATED  |
+        | PROJECT_STATUS_SUSPENDED  |
+        | PROJECT_STATUS_RETIRED    |
+
+  Rule: The credit class must not be closed to issuance
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+
+    Scenario Outline: the credit class is open to issuance
+      Given the credit class has status "<status>"
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+      Examples:
+        | status                              |
+        | CLASS_STATUS_ACTIVE                 |
+        | CLASS_STATUS_CLOSED_TO_NEW_PROJECTS |
+
+    Scenario Outline: the credit class is closed to issuance
+      Given the credit class has status "<status>"
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001"
+      Then expect the error "cannot issue credits within credit class C01 with status <status>: invalid request"
+
+      Examples:
+        | status                          |
+        | CLASS_STATUS_CLOSED_TO_ISSUANCE |
+        | CLASS_STATUS_DEPRECATED         |
+
+  Rule: The minted amount must not exceed the issuance caps of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" issuer alice and start date "2020-01-01"
+
+    Scenario: the issuer cap is not exceeded
+      Given the issuer alice has max issuance "100" and issued amount "50"
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001" and tradable amount "50"
+      Then expect no error
+      And expect the issuer alice has issued amount "100"
+
+    Scenario: the issuer cap is exceeded
+      Given the issuer alice has max issuance "100" and issued amount "50"
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001" and tradable amount "51"
+      Then expect error contains "cannot issue 51 credits with 50 of 100 credits remaining: limit exceeded"
+
+    Scenario: the project vintage cap is not exceeded
+      Given the credit class has max project vintage issuance "100"
+      And the project has issued amount "50" in vintage year "2020"
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001" and tradable amount "50"
+      Then expect no error
+      And expect the project has issued amount "100" in vintage year "2020"
+
+    Scenario: the project vintage cap is exceeded
+      Given the credit class has max project vintage issuance "100"
+      And the project has issued amount "50" in vintage year "2020"
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001" and tradable amount "51"
+      Then expect the error "project C01-001 in vintage year 2020: cannot issue 51 credits with 50 of 100 credits remaining: limit exceeded"
+
   Rule: The origin tx must be unique within the scope of the credit class
 
     Background:
@@ -102,6 +194,35 @@ Feature: Msg/MintBatchCredits
       """
       Then expect no error
 
+  Rule: The origin tx is recorded with the credit batch
+
+    Scenario: the origin tx is recorded with the credit batch
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001" and origin tx
+      """
+      {
+        "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+        "source": "polygon",
+        "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d",
+        "note": "bridged from polygon"
+      }
+      """
+      Then expect origin tx index
+      """
+      {
+        "class_key": 1,
+        "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+        "source": "polygon",
+        "batch_key": 1,
+        "mint": true,
+        "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d",
+        "note": "bridged from polygon"
+      }
+      """
+
   Rule: The recipient batch balance is updated
 
     Scenario: balance updated from issuance with single item
@@ -121,6 +242,27 @@ Feature: Msg/MintBatchCredits
 
     # no failing scenario - state transitions only occur upon successful message execution
 
+  Rule: A percentage of the tradable credits is sent to the buffer pool
+
+    Scenario: buffer pool contribution withheld from issuance
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+      And the credit class has buffer percentage "25"
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001" recipient bob and tradable amount "10"
+      Then expect bob batch balance
+      """
+      {
+        "tradable_amount": "8",
+        "retired_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect buffer pool tradable balance "2"
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
   Rule: The batch supply is updated
 
     Scenario: supply updated from issuance
@@ -0,0 +1,90 @@
+Feature: Msg/ProposeBatch
+
+  Credits can be proposed in batches:
+  - when the project exists
+  - when the credit class requires batch approval
+  - when the issuer is an allowed credit class issuer
+  - when the project is active or the project status has never been updated
+  - when the decimal places in issuance amount does not exceed credit type precision
+  - the pending batch is added with an expiration based on the expiry period
+  - the response includes the pending batch id
+
+  Background:
+    Given a credit type with abbreviation "C" and precision "6"
+    And a credit class with class id "C01" and issuer alice
+    And a project with project id "C01-001"
+    And the credit class requires batch approval with expiry period "604800s"
+
+  Rule: The project must exist
+
+    Scenario: the project exists
+      When alice attempts to propose a batch with project id "C01-001"
+      Then expect no error
+
+    Scenario: the project does not exist
+      When alice attempts to propose a batch with project id "C01-002"
+      Then expect the error "could not get project with id C01-002: not found: invalid request"
+
+  Rule: The credit class must require batch approval
+
+    Scenario: the credit class does not require batch approval
+      Given the credit class does not require batch approval
+      When alice attempts to propose a batch with project id "C01-001"
+      Then expect the error "credit class C01 does not require batch approval: invalid request"
+
+  Rule: The issuer must be an allowed credit class issuer
+
+    Scenario: the issuer is not an allowed credit class issuer
+      When bob attempts to propose a batch with project id "C01-001"
+      Then expect error contains "is not an issuer for the class: unauthorized"
+
+  Rule: The project must be active or the project status must never have been updated
+
+    Scenario: the project is active
+      Given the project has status "PROJECT_STATUS_ACTIVE"
+      When alice attempts to propose a batch with project id "C01-001"
+      Then expect no error
+
+    Scenario: the project is suspended
+      Given the project has status "PROJECT_STATUS_SUSPENDED"
+      When alice attempts to propose a batch with project id "C01-001"
+      Then expect the error "cannot issue credits for project C01-001 with status PROJECT_STATUS_SUSPENDED: invalid request"
+
+  Rule: The decimal places in issuance amount must not exceed credit type precision
+
+    Scenario: the decimal places in tradable amount do not exceed precision
+      When alice attempts to propose a batch with project id "C01-001" and tradable amount "10.500000"
+      Then expect no error
+
+    Scenario: the decimal places in tradable amount exceed precision
+      When alice attempts to propose a batch with project id "C01-001" and tradable amount "10.5000000"
+      Then expect the error "10.5000000 exceeds maximum decimal places: 6: invalid request"
+
+  Rule: The pending batch is added
+
+    Scenario: the pending batch is added
+      Given the block time "2022-01-01T00:00:00Z"
+      When alice attempts to propose a batch with project id "C01-001" and tradable amount "10"
+      Then expect pending batch with id "1" and expiration "2022-01-08T00:00:00Z"
+      And expect the response
+      """
+      {
+        "pending_batch_id": "1"
+      }
+      """
+
+    Scenario: credits are not issued
+      When alice attempts to propose a batch with project id "C01-001" and tradable amount "10"
+      Then expect no credit batches
+
+  Rule: Event is emitted
+
+    Scenario: EventProposeBatch is emitted
+      When alice attempts to propose a batch with project id "C01-001"
+      Then expect event with properties
+      """
+      {
+        "pending_batch_id": "1",
+        "project_id": "C01-001"
+      }
+      """
@@ -0,0 +1,52 @@
+Feature: Msg/RejectBatch
+
+  A pending batch can be rejected:
+  - when the pending batch exists
+  - when the approver is the admin or a verifier of the credit class
+  - the pending batch is removed without creating a credit batch
+
+  Background:
+    Given a credit class with id "C01" admin alice and issuer bob
+    And a project with id "C01-001"
+    And the credit class requires batch approval
+    And bob has proposed a batch with project id "C01-001" and tradable amount "10"
+
+  Rule: The pending batch must exist
+
+    Scenario: the pending batch exists
+      When alice attempts to reject pending batch "1"
+      Then expect no error
+
+    Scenario: the pending batch does not exist
+      When alice attempts to reject pending batch "2"
+      Then expect the error "could not get pending batch with id 2: not found: invalid request"
+
+  Rule: The approver must be the admin or a verifier of the credit class
+
+    Scenario: the approver is a verifier of the credit class
+      Given carol is a verifier of the credit class
+      When carol attempts to reject pending batch "1"
+      Then expect no error
+
+    Scenario: the approver is not the admin or a verifier of the credit class
+      When bob attempts to reject pending batch "1"
+      Then expect error contains "is not the admin or a verifier of credit class C01: unauthorized"
+
+  Rule: The pending batch is removed
+
+    Scenario: the pending batch is removed
+      When alice attempts to reject pending batch "1"
+      Then expect no pending batch with id "1"
+      And expect no credit batches
+
+  Rule: Event is emitted
+
+    Scenario: EventRejectBatch is emitted
+      When alice attempts to reject pending batch "1" with reason "missing verification report"
+      Then expect event with properties
+      """
+      {
+        "pending_batch_id": "1",
+        "reason": "missing verification report"
+      }
+      """
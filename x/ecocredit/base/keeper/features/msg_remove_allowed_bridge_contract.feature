@@ -0,0 +1,47 @@
+Feature: Msg/RemoveAllowedBridgeContract
+
+  A bridge contract can be removed from the allowed bridge contracts of a chain:
+  - when the authority is the governance account
+  - when the bridge contract already exists in the state
+
+
+  Rule: The authority address is the governance account
+
+    Background:
+      Given the authority address "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+      And the chain name "polygon"
+      And allowed bridge contract "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d" for chain name "polygon"
+
+    Scenario: the authority is the governance account
+      When alice attempts to remove allowed bridge contract with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "chain_name": "polygon",
+        "contract": "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d"
+      }
+      """
+      Then expect no error
+
+    Scenario: the authority is not the governance account
+      When alice attempts to remove allowed bridge contract with properties
+      """
+      {
+        "authority": "regen1fua8speyxgempgy06gpfs0p4z32zznkqakm57s",
+        "chain_name": "polygon",
+        "contract": "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d"
+      }
+      """
+      Then expect the error contains "invalid authority"
+
+
+  Rule: The bridge contract must exist
+
+    Scenario: the bridge contract exists in state
+      Given the chain name "polygon"
+      And allowed bridge contract "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d" for chain name "polygon"
+      When alice attempts to remove bridge contract "0x06012c8cf97BEaD5deAe237070F9587f8E7A266d" for chain name "Polygon"
+      Then expect no error
+      And expect bridge contract "0x06012c8cf97bead5deae237070f9587f8e7a266d" for chain name "polygon" to not exist
+
+  # no failing scenario, ORM delete does not error when the entry does not exist
@@ -0,0 +1,47 @@
+Feature: Msg/RemoveAllowedBridgeService
+
+  A bridge service can be removed from the allowed bridge services of a chain:
+  - when the authority is the governance account
+  - when the bridge service already exists in the state
+
+
+  Rule: The authority address is the governance account
+
+    Background:
+      Given the authority address "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+      And the chain name "polygon"
+      And allowed bridge service "regen1d466m547y09dgs6xvca7uxs5k2m2pmgspa9kal" for chain name "polygon"
+
+    Scenario: the authority is the governance account
+      When alice attempts to remove allowed bridge service with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "chain_name": "polygon",
+        "address": "regen1d466m547y09dgs6xvca7uxs5k2m2pmgspa9kal"
+      }
+      """
+      Then expect no error
+
+    Scenario: the authority is not the governance account
+      When alice attempts to remove allowed bridge service with properties
+      """
+      {
+        "authority": "regen1fua8speyxgempgy06gpfs0p4z32zznkqakm57s",
+        "chain_name": "polygon",
+        "address": "regen1d466m547y09dgs6xvca7uxs5k2m2pmgspa9kal"
+      }
+      """
+      Then expect the error contains "invalid authority"
+
+
+  Rule: The bridge service must exist
+
+    Scenario: the bridge service exists in state
+      Given the chain name "polygon"
+      And allowed bridge service "regen1d466m547y09dgs6xvca7uxs5k2m2pmgspa9kal" for chain name "polygon"
+      When alice attempts to remove bridge service "regen1d466m547y09dgs6xvca7uxs5k2m2pmgspa9kal" for chain name "Polygon"
+      Then expect no error
+      And expect bridge service "regen1d466m547y09dgs6xvca7uxs5k2m2pmgspa9kal" for chain name "polygon" to not exist
+
+  # no failing scenario, ORM delete does not error when the entry does not exist
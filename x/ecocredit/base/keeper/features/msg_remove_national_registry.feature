@@ -0,0 +1,59 @@
+Feature: Msg/RemoveNationalRegistry
+
+  A national registry can be removed:
+  - when the authority is a governance account address
+  - when the national registry exists for the country
+  - the national registry is removed
+
+  Background:
+    Given national registry "regen156d26rl52y3wl865pr5x9q2vqetuw9kf0642sa" for country "KE"
+
+  Rule: The authority must be governance account address
+
+    Scenario: The authority is a governance account address
+      When alice attempts to remove national registry with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "country": "KE",
+        "registry": "regen156d26rl52y3wl865pr5x9q2vqetuw9kf0642sa"
+      }
+      """
+      Then expect no error
+
+    Scenario: The authority is not a governance account address
+      When alice attempts to remove national registry with properties
+      """
+      {
+        "authority": "regen156d26rl52y3wl865pr5x9q2vqetuw9kf0642sa",
+        "country": "KE",
+        "registry": "regen156d26rl52y3wl865pr5x9q2vqetuw9kf0642sa"
+      }
+      """
+      Then expect error contains "expected gov account as only signer for proposal message"
+
+  Rule: The national registry exists for the country
+
+    Scenario: The national registry does not exist for the country
+      When alice attempts to remove national registry with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "country": "CO",
+        "registry": "regen156d26rl52y3wl865pr5x9q2vqetuw9kf0642sa"
+      }
+      """
+      Then expect the error "national registry regen156d26rl52y3wl865pr5x9q2vqetuw9kf0642sa for country CO: not found"
+
+  Rule: The national registry is removed
+
+    Scenario: The national registry is removed
+      When alice attempts to remove national registry with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "country": "KE",
+        "registry": "regen156d26rl52y3wl865pr5x9q2vqetuw9kf0642sa"
+      }
+      """
+      Then expect no national registry "regen156d26rl52y3wl865pr5x9q2vqetuw9kf0642sa" for country "KE"
@@ -107,6 +107,55 @@ Feature: Msg/Retire
 
     # no failing scenario - state transitions only occur upon successful message execution
 
+  Rule: The retirement is recorded
+
+    Scenario: the retirement is recorded
+      Given a credit batch
+      And alice owns tradable credit amount "10"
+      When alice attempts to retire credit amount "10" from "US-WA"
+      Then expect retirement with properties
+      """
+      {
+        "id": 1,
+        "amount": "10",
+        "jurisdiction": "US-WA"
+      }
+      """
+
+    Scenario: the retirement is recorded with reason and beneficiary
+      Given a credit batch
+      And alice owns tradable credit amount "10"
+      When alice attempts to retire credit amount "10" from "US-WA" with reason "offsetting 2022 emissions" and beneficiary "Acme Inc."
+      Then expect retirement with properties
+      """
+      {
+        "id": 1,
+        "amount": "10",
+        "jurisdiction": "US-WA",
+        "reason": "offsetting 2022 emissions",
+        "beneficiary": "Acme Inc."
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The retirement certificate is minted
+
+    Scenario: the retirement certificate is minted
+      Given a credit batch
+      And alice owns tradable credit amount "10"
+      When alice attempts to retire credit amount "10" from "US-WA"
+      Then expect alice owns retirement certificate with properties
+      """
+      {
+        "retirement_id": 1,
+        "nft_class_id": "ecocredit:C01",
+        "nft_id": "retirement-1"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
   Rule: Event is emitted
 
     Scenario: EventRetire is emitted
@@ -130,4 +179,21 @@ Feature: Msg/Retire
         "amount": "10",
         "jurisdiction": "US-WA"
       }
-      """
\ No newline at end of file
+      """
+
+    Scenario: EventRetire is emitted with reason and beneficiary
+      Given a credit batch with denom "C01-001-20200101-20210101-001"
+      And alice's address "regen15406g34dl5v9780tx2q3vtjdpkdgq4hhegdtm9"
+      And alice owns tradable credit amount "10"
+      When alice attempts to retire credit amount "10" from "US-WA" with reason "offsetting 2022 emissions" and beneficiary "Acme Inc."
+      Then expect event with properties
+      """
+      {
+        "owner": "regen15406g34dl5v9780tx2q3vtjdpkdgq4hhegdtm9",
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "10",
+        "jurisdiction": "US-WA",
+        "reason": "offsetting 2022 emissions",
+        "beneficiary": "Acme Inc."
+      }
+      """
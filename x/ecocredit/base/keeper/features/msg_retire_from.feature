@@ -0,0 +1,47 @@
+Feature: Msg/RetireFrom
+
+  Credits can be retired on behalf of the credit owner:
+  - when the spender has an allowance for the credit batch or credit class
+  - when the amount does not exceed the allowance
+  - the allowance is decreased by the amount retired
+  - the owner balance is updated and the retirement is recorded for the owner
+
+  Background:
+    Given a credit batch
+    And alice owns tradable credit amount "10"
+
+  Rule: The spender must have an allowance greater than or equal to the amount to retire
+
+    Scenario: the spender has no allowance
+      When bob attempts to retire from alice credit amount "5"
+      Then expect error contains "for credit batch C01-001-20200101-20210101-001: insufficient allowance"
+
+    Scenario: the spender has a class allowance less than the amount to retire
+      Given alice approved bob for class with amount "4"
+      When bob attempts to retire from alice credit amount "5"
+      Then expect error contains "allowance for credit batch C01-001-20200101-20210101-001: 4, amount 5: insufficient allowance"
+
+    Scenario: the spender has a batch allowance greater than or equal to the amount to retire
+      Given alice approved bob for batch with amount "5"
+      When bob attempts to retire from alice credit amount "5"
+      Then expect no error
+
+  Rule: The allowance is decreased
+
+    Scenario: the batch allowance is decreased
+      Given alice approved bob for batch with amount "8"
+      When bob attempts to retire from alice credit amount "5"
+      Then expect bob batch allowance "3"
+
+    Scenario: the class allowance is removed when fully spent
+      Given alice approved bob for class with amount "5"
+      When bob attempts to retire from alice credit amount "5"
+      Then expect bob has no class allowance
+
+  Rule: The owner balance is updated and the retirement is recorded
+
+    Scenario: the owner balance is updated and the retirement is recorded
+      Given alice approved bob for class with amount "8"
+      When bob attempts to retire from alice credit amount "5"
+      Then expect alice tradable balance "5" and retired balance "5"
+      And expect retirement owned by alice with amount "5"
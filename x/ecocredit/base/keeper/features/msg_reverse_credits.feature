@@ -0,0 +1,77 @@
+Feature: Msg/ReverseCredits
+
+  Credits can be reversed from the buffer pool of a credit class:
+  - when the signer is the admin of the credit class or the governance authority
+  - when the credit batch is from a project of the credit class
+  - when the buffer pool has enough tradable credits
+  - the buffer pool balance is decreased
+  - the batch supply tradable amount is decreased and cancelled amount is increased
+  - a reversal record is stored
+
+  Background:
+    Given a credit batch from a credit class with admin alice
+    And the buffer pool owns tradable credit amount "20"
+
+  Rule: The signer must be the admin of the credit class or the governance authority
+
+    Scenario: the signer is the admin of the credit class
+      When alice attempts to reverse credits with amount "5"
+      Then expect no error
+
+    Scenario: the signer is the governance authority
+      When the governance authority attempts to reverse credits with amount "5"
+      Then expect no error
+
+    Scenario: the signer is not the admin of the credit class or the governance authority
+      When bob attempts to reverse credits with amount "5"
+      Then expect error contains "is not the admin of credit class C01 or the governance authority: unauthorized"
+
+  Rule: The credit batch must be from a project of the credit class
+
+    Scenario: the credit batch is from a project of the credit class
+      When alice attempts to reverse credits with amount "5"
+      Then expect no error
+
+    Scenario: the credit batch is from a project of another credit class
+      Given the project of the credit batch is in credit class "C02"
+      When alice attempts to reverse credits with amount "5"
+      Then expect error contains "credit batch C01-001-20200101-20210101-001 is not from credit class C01: invalid request"
+
+  Rule: The buffer pool must have enough tradable credits
+
+    Scenario: the buffer pool has enough tradable credits
+      When alice attempts to reverse credits with amount "20"
+      Then expect no error
+
+    Scenario: the buffer pool does not have enough tradable credits
+      When alice attempts to reverse credits with amount "21"
+      Then expect error contains "buffer pool tradable balance: 20, reverse amount 21: insufficient credit balance"
+
+  Rule: The buffer pool balance and batch supply are updated
+
+    Scenario: the buffer pool balance is updated
+      When alice attempts to reverse credits with amount "5"
+      Then expect buffer pool tradable balance "15"
+
+    Scenario: the batch supply is updated
+      When alice attempts to reverse credits with amount "5"
+      Then expect batch supply tradable amount "95" and cancelled amount "5"
+
+  Rule: A reversal record is stored
+
+    Scenario: the reversal record is stored
+      When alice attempts to reverse credits with amount "5"
+      Then expect reversal record with name "2023 Tahoe fire" and amount "5"
+
+  Rule: Events are emitted
+
+    Scenario: EventReverseCredits is emitted
+      When alice attempts to reverse credits with amount "5"
+      Then expect event reverse credits with properties
+      """
+      {
+        "reversal_id": 1,
+        "class_id": "C01",
+        "name": "2023 Tahoe fire"
+      }
+      """
@@ -0,0 +1,40 @@
+Feature: Msg/RevokeApproval
+
+  An allowance can be revoked:
+  - when the allowance exists
+  - the allowance is removed
+
+  Background:
+    Given a credit batch
+
+  Rule: The allowance must exist
+
+    Scenario: the batch allowance exists
+      Given alice approved bob for batch with amount "10"
+      When alice attempts to revoke approval of bob for batch "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the batch allowance does not exist
+      When alice attempts to revoke approval of bob for batch "C01-001-20200101-20210101-001"
+      Then expect error contains "with credit batch C01-001-20200101-20210101-001: not found: not found"
+
+    Scenario: the class allowance exists
+      Given alice approved bob for class with amount "10"
+      When alice attempts to revoke approval of bob for class "C01"
+      Then expect no error
+
+    Scenario: the class allowance does not exist
+      When alice attempts to revoke approval of bob for class "C01"
+      Then expect error contains "with credit class C01: not found: not found"
+
+  Rule: The allowance is removed
+
+    Scenario: the batch allowance is removed
+      Given alice approved bob for batch with amount "10"
+      When alice attempts to revoke approval of bob for batch "C01-001-20200101-20210101-001"
+      Then expect bob has no batch allowance
+
+    Scenario: the class allowance is removed
+      Given alice approved bob for class with amount "10"
+      When alice attempts to revoke approval of bob for class "C01"
+      Then expect bob has no class allowance
@@ -176,6 +176,15 @@ Feature: Msg/Send
 
     # no failing scenario - state transitions only occur upon successful message execution
 
+  Rule: The recipient is indexed as a holder of the project
+
+    Scenario: the recipient is indexed as a holder of the project
+      Given a credit batch
+      And alice owns tradable credit amount "10"
+      When alice attempts to send credits to bob with tradable amount "10"
+      Then expect bob is a holder of the project
+      And expect bob has an indexed balance within the credit class and project
+
   Rule: The batch supply is updated
 
     Scenario: the batch supply is updated
@@ -222,6 +231,23 @@ Feature: Msg/Send
 
     # no failing scenario - state transitions only occur upon successful message execution
 
+  Rule: The retirement is recorded
+
+    Scenario: the retirement is recorded
+      Given a credit batch
+      And alice owns tradable credit amount "10"
+      When alice attempts to send credits to bob with retired amount "10" from "US-WA"
+      Then expect retirement with properties
+      """
+      {
+        "id": 1,
+        "amount": "10",
+        "jurisdiction": "US-WA"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
   Rule: Events are emitted
 
     Background:
@@ -0,0 +1,65 @@
+Feature: Msg/SendFrom
+
+  Credits can be sent on behalf of the credit owner:
+  - when the spender has an allowance for the credit batch or credit class
+  - when the amount does not exceed the allowance
+  - the allowance for the credit batch is used before the allowance for the credit class
+  - the allowance is decreased by the tradable and retired amount sent
+  - the owner and recipient balances are updated
+
+  Background:
+    Given a credit batch
+    And alice owns tradable credit amount "10"
+
+  Rule: The spender must have an allowance greater than or equal to the amount to send
+
+    Scenario: the spender has no allowance
+      When bob attempts to send from alice to charlie tradable amount "5" and retired amount "0"
+      Then expect error contains "for credit batch C01-001-20200101-20210101-001: insufficient allowance"
+
+    Scenario: the spender has a batch allowance less than the amount to send
+      Given alice approved bob for batch with amount "4"
+      When bob attempts to send from alice to charlie tradable amount "3" and retired amount "2"
+      Then expect error contains "allowance for credit batch C01-001-20200101-20210101-001: 4, amount 5: insufficient allowance"
+
+    Scenario: the spender has a batch allowance greater than or equal to the amount to send
+      Given alice approved bob for batch with amount "5"
+      When bob attempts to send from alice to charlie tradable amount "3" and retired amount "2"
+      Then expect no error
+
+    Scenario: the spender has a class allowance greater than or equal to the amount to send
+      Given alice approved bob for class with amount "5"
+      When bob attempts to send from alice to charlie tradable amount "5" and retired amount "0"
+      Then expect no error
+
+  Rule: The allowance is decreased
+
+    Scenario: the batch allowance is decreased
+      Given alice approved bob for batch with amount "8"
+      When bob attempts to send from alice to charlie tradable amount "3" and retired amount "2"
+      Then expect bob batch allowance "3"
+
+    Scenario: the batch allowance is removed when fully spent
+      Given alice approved bob for batch with amount "5"
+      When bob attempts to send from alice to charlie tradable amount "3" and retired amount "2"
+      Then expect bob has no batch allowance
+
+    Scenario: the batch allowance is used before the class allowance
+      Given alice approved bob for batch with amount "8"
+      And alice approved bob for class with amount "8"
+      When bob attempts to send from alice to charlie tradable amount "5" and retired amount "0"
+      Then expect bob batch allowance "3"
+      And expect bob class allowance "8"
+
+    Scenario: the class allowance is decreased
+      Given alice approved bob for class with amount "8"
+      When bob attempts to send from alice to charlie tradable amount "5" and retired amount "0"
+      Then expect bob class allowance "3"
+
+  Rule: The owner and recipient balances are updated
+
+    Scenario: the owner and recipient balances are updated
+      Given alice approved bob for batch with amount "10"
+      When bob attempts to send from alice to charlie tradable amount "3" and retired amount "2"
+      Then expect alice tradable balance "5"
+      And expect charlie tradable balance "3" and retired balance "2"
@@ -0,0 +1,48 @@
+Feature: Msg/SendLocked
+
+  Credits can be sent to a recipient and locked according to a lock schedule:
+  - when the sender has enough tradable credits
+  - when the end time of the lock schedule is after the block time
+  - the tradable balance of the sender is decreased
+  - the locked balance of the recipient is increased
+  - a credit lock is created for each credit batch
+
+  Background:
+    Given a credit batch
+    And alice owns tradable credit amount "10"
+    And the block time "2020-01-01"
+
+  Rule: The sender must have enough tradable credits
+
+    Scenario: the sender has enough tradable credits
+      When alice attempts to send bob amount "10" locked until "2021-01-01"
+      Then expect no error
+
+    Scenario: the sender does not have enough tradable credits
+      When alice attempts to send bob amount "11" locked until "2021-01-01"
+      Then expect error contains "tradable balance: 10, send locked amount 11: insufficient credit balance"
+
+    Scenario: the sender does not have any credits
+      When bob attempts to send alice amount "5" locked until "2021-01-01"
+      Then expect error contains "you do not have any credits from batch C01-001-20200101-20210101-001"
+
+  Rule: The end time must be after the block time
+
+    Scenario: the end time is after the block time
+      When alice attempts to send bob amount "5" locked until "2020-01-02"
+      Then expect no error
+
+    Scenario: the end time is equal to the block time
+      When alice attempts to send bob amount "5" locked until "2020-01-01"
+      Then expect error contains "end time 2020-01-01 00:00:00 +0000 UTC must be after block time 2020-01-01 00:00:00 +0000 UTC"
+
+  Rule: The balances are updated and a credit lock is created
+
+    Scenario: the balances are updated
+      When alice attempts to send bob amount "4" locked until "2021-01-01"
+      Then expect alice tradable balance "6"
+      And expect bob tradable balance "0" and locked balance "4"
+
+    Scenario: the credit lock is created
+      When alice attempts to send bob amount "4" locked until "2021-01-01"
+      Then expect bob credit lock with amount "4" and unlocked amount "0"
@@ -0,0 +1,233 @@
+Feature: Msg/SetArticle6Authorization
+
+  The Article 6 authorization of a credit batch or retirement can be set:
+  - when the credit batch exists
+  - when the signer is the admin of the credit class or a national registry of the host country
+  - when the retirement exists and is a retirement of credits from the credit batch
+  - when the letter of authorization is anchored in the data module
+  - when the host jurisdiction exists if strict jurisdictions are enabled
+  - the authorization is set and overwrites an existing authorization
+  - the response includes an event
+
+  Background:
+    Given a credit batch with denom "C01-001-20200101-20210101-001" and class admin alice
+    And bob is a national registry of "KE"
+
+  Rule: The credit batch must exist
+
+    Scenario: the credit batch does not exist
+      When alice attempts to set authorization with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-002",
+        "host_jurisdiction": "KE",
+        "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+        "adjustment_status": "ADJUSTMENT_STATUS_APPLIED",
+        "authorized_use": "AUTHORIZED_USE_NDC"
+      }
+      """
+      Then expect the error "could not get batch with denom C01-001-20200101-20210101-002: not found: invalid request"
+
+  Rule: The signer must be the class admin or a national registry of the host country
+
+    Background:
+      Given the letter of authorization is anchored
+
+    Scenario: the signer is the class admin
+      When alice attempts to set authorization with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "host_jurisdiction": "KE",
+        "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+        "adjustment_status": "ADJUSTMENT_STATUS_APPLIED",
+        "authorized_use": "AUTHORIZED_USE_NDC"
+      }
+      """
+      Then expect no error
+
+    Scenario: the signer is a national registry of the host country
+      When bob attempts to set authorization with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "host_jurisdiction": "KE-30",
+        "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+        "adjustment_status": "ADJUSTMENT_STATUS_APPLIED",
+        "authorized_use": "AUTHORIZED_USE_NDC"
+      }
+      """
+      Then expect no error
+
+    Scenario: the signer is a national registry of another country
+      When bob attempts to set authorization with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "host_jurisdiction": "CO",
+        "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+        "adjustment_status": "ADJUSTMENT_STATUS_APPLIED",
+        "authorized_use": "AUTHORIZED_USE_NDC"
+      }
+      """
+      Then expect error contains "is not the admin of credit class C01 or a national registry of CO: unauthorized"
+
+  Rule: The retirement must be a retirement of credits from the credit batch
+
+    Background:
+      Given the letter of authorization is anchored
+
+    Scenario: the retirement is a retirement of credits from the credit batch
+      Given a retirement of credits from the credit batch
+      When alice attempts to set authorization with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "retirement_id": 1,
+        "host_jurisdiction": "KE",
+        "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+        "adjustment_status": "ADJUSTMENT_STATUS_PENDING",
+        "authorized_use": "AUTHORIZED_USE_CORSIA"
+      }
+      """
+      Then expect no error
+
+    Scenario: the retirement does not exist
+      When alice attempts to set authorization with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "retirement_id": 1,
+        "host_jurisdiction": "KE",
+        "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+        "adjustment_status": "ADJUSTMENT_STATUS_PENDING",
+        "authorized_use": "AUTHORIZED_USE_CORSIA"
+      }
+      """
+      Then expect the error "could not get retirement with id 1: not found: invalid request"
+
+    Scenario: the retirement is a retirement of credits from another credit batch
+      Given a retirement of credits from another credit batch
+      When alice attempts to set authorization with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "retirement_id": 1,
+        "host_jurisdiction": "KE",
+        "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+        "adjustment_status": "ADJUSTMENT_STATUS_PENDING",
+        "authorized_use": "AUTHORIZED_USE_CORSIA"
+      }
+      """
+      Then expect the error "retirement 1 is not a retirement of credits from batch C01-001-20200101-20210101-001: invalid request"
+
+  Rule: The letter of authorization must be anchored
+
+    Scenario: the letter of authorization is not anchored
+      Given the letter of authorization is not anchored
+      When alice attempts to set authorization with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "host_jurisdiction": "KE",
+        "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+        "adjustment_status": "ADJUSTMENT_STATUS_APPLIED",
+        "authorized_use": "AUTHORIZED_USE_NDC"
+      }
+      """
+      Then expect the error "authorization iri regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf has not been anchored: not found"
+
+  Rule: The host jurisdiction must exist if strict jurisdictions are enabled
+
+    Scenario: the host jurisdiction does not exist
+      Given strict jurisdictions are enabled
+      When alice attempts to set authorization with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "host_jurisdiction": "KE-99",
+        "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+        "adjustment_status": "ADJUSTMENT_STATUS_APPLIED",
+        "authorized_use": "AUTHORIZED_USE_NDC"
+      }
+      """
+      Then expect the error "jurisdiction: subdivision code KE-99 is not a valid ISO 3166-2 code: parse error: invalid request"
+
+  Rule: The authorization is set
+
+    Background:
+      Given the letter of authorization is anchored
+
+    Scenario: the authorization is set
+      When bob attempts to set authorization with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "host_jurisdiction": "KE",
+        "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+        "adjustment_status": "ADJUSTMENT_STATUS_APPLIED",
+        "authorized_use": "AUTHORIZED_USE_NDC"
+      }
+      """
+      Then expect bob authorization with properties
+      """
+      {
+        "host_jurisdiction": "KE",
+        "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+        "adjustment_status": "ADJUSTMENT_STATUS_APPLIED",
+        "authorized_use": "AUTHORIZED_USE_NDC"
+      }
+      """
+
+    Scenario: an existing authorization is overwritten
+      Given alice set authorization with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "host_jurisdiction": "KE",
+        "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+        "adjustment_status": "ADJUSTMENT_STATUS_PENDING",
+        "authorized_use": "AUTHORIZED_USE_NDC"
+      }
+      """
+      When bob attempts to set authorization with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "host_jurisdiction": "KE",
+        "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+        "adjustment_status": "ADJUSTMENT_STATUS_APPLIED",
+        "authorized_use": "AUTHORIZED_USE_NDC"
+      }
+      """
+      Then expect bob authorization with properties
+      """
+      {
+        "host_jurisdiction": "KE",
+        "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+        "adjustment_status": "ADJUSTMENT_STATUS_APPLIED",
+        "authorized_use": "AUTHORIZED_USE_NDC"
+      }
+      """
+
+  Rule: Event is emitted
+
+    Scenario: EventSetArticle6Authorization is emitted
+      Given the letter of authorization is anchored
+      When alice attempts to set authorization with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "host_jurisdiction": "KE",
+        "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+        "adjustment_status": "ADJUSTMENT_STATUS_APPLIED",
+        "authorized_use": "AUTHORIZED_USE_NDC"
+      }
+      """
+      Then expect event with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "adjustment_status": "ADJUSTMENT_STATUS_APPLIED"
+      }
+      """
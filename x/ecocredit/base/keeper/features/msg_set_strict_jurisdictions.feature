@@ -0,0 +1,91 @@
+Feature: Msg/SetStrictJurisdictions
+
+  Strict jurisdiction validation can be enabled/disabled:
+  - when the authority is a governance account
+  - the strict jurisdictions setting is updated
+  - project and retirement jurisdictions are checked against ISO 3166 when enabled
+
+  Rule: The authority is a governance account
+
+    Scenario: the authority is a governance account
+      When alice attempts to set strict jurisdictions with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "enabled": true
+      }
+      """
+      Then expect no error
+      And expect strict jurisdictions flag to be "true"
+
+    Scenario: the authority is not a governance account
+      When alice attempts to set strict jurisdictions with properties
+      """
+      {
+        "authority": "regen1fua8speyxgempgy06gpfs0p4z32zznkqakm57s",
+        "enabled": true
+      }
+      """
+      Then expect error contains "expected gov account as only signer for proposal message"
+
+  Rule: The strict jurisdictions setting is updated
+
+    Scenario: strict jurisdictions are enabled
+      When alice attempts to set strict jurisdictions with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "enabled": true
+      }
+      """
+      Then expect strict jurisdictions flag to be "true"
+
+    Scenario: strict jurisdictions are disabled
+      When alice attempts to set strict jurisdictions with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "enabled": false
+      }
+      """
+      Then expect strict jurisdictions flag to be "false"
+
+  Rule: Project jurisdictions are checked against ISO 3166 when enabled
+
+    Background:
+      Given a credit class with issuer alice
+
+    Scenario Outline: project jurisdiction with strict jurisdictions disabled
+      When alice attempts to create a project with jurisdiction "<jurisdiction>"
+      Then expect no error
+
+      Examples:
+        | jurisdiction |
+        | US-WA        |
+        | ZZ-999       |
+
+    Scenario: project jurisdiction exists with strict jurisdictions enabled
+      Given strict jurisdictions are enabled
+      When alice attempts to create a project with jurisdiction "US-WA 98225"
+      Then expect no error
+
+    Scenario: project jurisdiction does not exist with strict jurisdictions enabled
+      Given strict jurisdictions are enabled
+      When alice attempts to create a project with jurisdiction "ZZ-999"
+      Then expect the error "jurisdiction: country code ZZ is not a valid ISO 3166-1 alpha-2 code: parse error: invalid request"
+
+  Rule: Retirement jurisdictions are checked against ISO 3166 when enabled
+
+    Background:
+      Given a credit class with issuer alice
+      And alice owns tradable credits from a credit batch
+
+    Scenario: retirement jurisdiction exists with strict jurisdictions enabled
+      Given strict jurisdictions are enabled
+      When alice attempts to retire credits with jurisdiction "US-WA"
+      Then expect no error
+
+    Scenario: retirement jurisdiction does not exist with strict jurisdictions enabled
+      Given strict jurisdictions are enabled
+      When alice attempts to retire credits with jurisdiction "US-999"
+      Then expect the error "jurisdiction: subdivision code US-999 is not a valid ISO 3166-2 code: parse error: invalid request"
@@ -0,0 +1,103 @@
+Feature: Msg/Unwrap
+
+  Wrapped credits can be unwrapped by the owner:
+  - when the credit batch exists
+  - when the owner has a wrapped token balance greater than or equal to the amount to unwrap
+  - when the decimal places in amount to unwrap does not exceed credit type precision
+  - the wrapped tokens are burned
+  - the owner credit balance is updated
+  - the escrow balance of the wrap submodule is updated
+
+  Rule: The credit batch must exist
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+
+    Scenario: the credit batch exists
+      Given a credit batch with denom "C01-001-20200101-20210101-001"
+      And alice has wrapped credit amount "10"
+      When alice attempts to unwrap credit amount "10"
+      Then expect no error
+
+    Scenario: the credit batch does not exist
+      When alice attempts to unwrap credit amount "10"
+      Then expect the error "could not get batch with denom C01-001-20200101-20210101-001: not found: invalid request"
+
+  Rule: The owner must have a wrapped token balance greater than or equal to the amount to unwrap
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+      And alice has wrapped credit amount "10"
+
+    Scenario Outline: wrapped token balance greater than or equal to amount to unwrap
+      When alice attempts to unwrap credit amount "<amount>"
+      Then expect no error
+
+      Examples:
+        | description  | amount |
+        | greater than | 5      |
+        | equal to     | 10     |
+
+    Scenario: wrapped token balance less than amount to unwrap
+      When alice attempts to unwrap credit amount "15"
+      Then expect error contains "insufficient funds"
+
+  Rule: The decimal places in amount to unwrap must not exceed credit type precision
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+      And alice has wrapped credit amount "10"
+
+    Scenario Outline: the decimal places in amount is less than or equal to credit type precision
+      When alice attempts to unwrap credit amount "<amount>"
+      Then expect no error
+
+      Examples:
+        | description | amount   |
+        | less than   | 9.12345  |
+        | equal to    | 9.123456 |
+
+    Scenario: the decimal places in amount is greater than credit type precision
+      When alice attempts to unwrap credit amount "9.1234567"
+      Then expect the error "amount: 9.1234567 exceeds maximum decimal places: 6: invalid request"
+
+  Rule: The wrapped tokens are burned and the credit balances of the owner and the wrap submodule are updated
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+      And alice has wrapped credit amount "10"
+
+    Scenario: some credits are unwrapped
+      When alice attempts to unwrap credit amount "2.5"
+      Then expect alice wrapped token balance "7500000eco.uC01-001-20200101-20210101-001"
+      And expect alice tradable credit amount "2.5"
+      And expect wrap submodule tradable credit amount "7.5"
+
+    Scenario: all credits are unwrapped
+      When alice attempts to unwrap credit amount "10"
+      Then expect alice wrapped token balance ""
+      And expect alice tradable credit amount "10"
+      And expect wrap submodule tradable credit amount "0"
+
+  Rule: Event is emitted
+
+    Background:
+      Given alice's address "regen15406g34dl5v9780tx2q3vtjdpkdgq4hhegdtm9"
+      And a credit type with abbreviation "C" and precision "6"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+      And alice has wrapped credit amount "10"
+
+    Scenario: EventUnwrap is emitted
+      When alice attempts to unwrap credit amount "2.5"
+      Then expect event with properties
+      """
+      {
+        "owner": "regen15406g34dl5v9780tx2q3vtjdpkdgq4hhegdtm9",
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "2.5",
+        "denom": "eco.uC01-001-20200101-20210101-001"
+      }
+      """
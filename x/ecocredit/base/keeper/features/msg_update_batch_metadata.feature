@@ -0,0 +1,88 @@
+Feature: Msg/UpdateBatchMetadata
+
+  The metadata of a credit batch can be updated:
+  - when the credit batch exists
+  - when the issuer is the issuer of the credit batch
+  - when the class admin co-signs the update if the credit class requires it
+  - the metadata is updated and the change is recorded in the metadata history
+
+  Background:
+    Given a credit class with id "C01" and admin alice
+    And a project with id "C01-001"
+    And a credit batch with denom "C01-001-20200101-20210101-001" issuer bob and metadata "regen:report-v1.rdf"
+
+  Rule: The credit batch must exist
+
+    Scenario: the credit batch exists
+      When bob attempts to update the metadata of batch "C01-001-20200101-20210101-001" to "regen:report-v2.rdf"
+      Then expect no error
+
+    Scenario: the credit batch does not exist
+      When bob attempts to update the metadata of batch "C01-001-20200101-20210101-002" to "regen:report-v2.rdf"
+      Then expect the error "could not get batch with denom C01-001-20200101-20210101-002: not found: invalid request"
+
+  Rule: The issuer must be the issuer of the credit batch
+
+    Scenario: the issuer is not the issuer of the credit batch
+      When carol attempts to update the metadata of batch "C01-001-20200101-20210101-001" to "regen:report-v2.rdf"
+      Then expect error contains "is not the issuer of batch C01-001-20200101-20210101-001: unauthorized"
+
+  Rule: The class admin must co-sign the update if the credit class requires it
+
+    Scenario: the credit class does not require a co-signature
+      When bob attempts to update the metadata of batch "C01-001-20200101-20210101-001" to "regen:report-v2.rdf"
+      Then expect no error
+
+    Scenario: the credit class requires a co-signature and the class admin co-signs
+      Given the credit class requires a co-signature
+      When bob attempts to update the metadata of batch "C01-001-20200101-20210101-001" to "regen:report-v2.rdf" with class admin alice
+      Then expect no error
+
+    Scenario: the credit class requires a co-signature and the class admin does not co-sign
+      Given the credit class requires a co-signature
+      When bob attempts to update the metadata of batch "C01-001-20200101-20210101-001" to "regen:report-v2.rdf"
+      Then expect the error "credit class C01 requires the class admin to co-sign batch metadata updates: unauthorized"
+
+    Scenario: the co-signer is not the class admin
+      When bob attempts to update the metadata of batch "C01-001-20200101-20210101-001" to "regen:report-v2.rdf" with class admin carol
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+    Scenario: the issuer is the class admin
+      Given a credit batch with denom "C01-001-20200101-20210101-002" issuer alice and metadata "regen:report-v1.rdf"
+      And the credit class requires a co-signature
+      When alice attempts to update the metadata of batch "C01-001-20200101-20210101-002" to "regen:report-v2.rdf"
+      Then expect no error
+
+  Rule: The metadata is updated and the change is recorded
+
+    Scenario: the metadata is updated
+      When bob attempts to update the metadata of batch "C01-001-20200101-20210101-001" to "regen:report-v2.rdf"
+      Then expect batch with denom "C01-001-20200101-20210101-001" and metadata "regen:report-v2.rdf"
+
+    Scenario: the metadata changes are recorded
+      When bob attempts to update the metadata of batch "C01-001-20200101-20210101-001" to "regen:report-v2.rdf"
+      And bob attempts to update the metadata of batch "C01-001-20200101-20210101-001" to "regen:report-v3.rdf"
+      Then expect batch with denom "C01-001-20200101-20210101-001" and metadata history
+      """
+      [
+        {
+          "old_metadata": "regen:report-v1.rdf",
+          "new_metadata": "regen:report-v2.rdf"
+        },
+        {
+          "old_metadata": "regen:report-v2.rdf",
+          "new_metadata": "regen:report-v3.rdf"
+        }
+      ]
+      """
+
+  Rule: Event is emitted
+
+    Scenario: EventUpdateBatchMetadata is emitted
+      When bob attempts to update the metadata of batch "C01-001-20200101-20210101-001" to "regen:report-v2.rdf"
+      Then expect event with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001"
+      }
+      """
@@ -0,0 +1,62 @@
+Feature: Msg/UpdateClassBatchApproval
+
+  The batch approval setting of a credit class can be updated:
+  - when the credit class exists
+  - when the admin is the admin of the credit class
+  - the batch approval setting is saved when approval is required
+  - the batch approval setting is removed when approval is not required
+
+  Background:
+    Given a credit class with id "C01" and admin alice
+
+  Rule: The credit class must exist
+
+    Scenario: the credit class exists
+      When alice attempts to require batch approval for credit class "C01" with expiry period "604800s"
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to require batch approval for credit class "C02" with expiry period "604800s"
+      Then expect the error "could not get credit class with id C02: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to require batch approval for credit class "C01" with expiry period "604800s"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to require batch approval for credit class "C01" with expiry period "604800s"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The batch approval setting is updated
+
+    Scenario: batch approval is required
+      When alice attempts to require batch approval for credit class "C01" with expiry period "604800s"
+      Then expect batch approval for credit class "C01" with expiry period "604800s"
+
+    Scenario: the expiry period is updated
+      Given alice has required batch approval for credit class "C01" with expiry period "604800s"
+      When alice attempts to require batch approval for credit class "C01" with expiry period "86400s"
+      Then expect batch approval for credit class "C01" with expiry period "86400s"
+
+    Scenario: batch approval is no longer required
+      Given alice has required batch approval for credit class "C01" with expiry period "604800s"
+      When alice attempts to remove batch approval for credit class "C01"
+      Then expect no batch approval for credit class "C01"
+
+    Scenario: batch approval was never required
+      When alice attempts to remove batch approval for credit class "C01"
+      Then expect no error
+      And expect no batch approval for credit class "C01"
+
+  Rule: Event is emitted
+
+    Scenario: EventUpdateClassBatchApproval is emitted
+      When alice attempts to require batch approval for credit class "C01" with expiry period "604800s"
+      Then expect event with properties
+      """
+      {
+        "class_id": "C01"
+      }
+      """
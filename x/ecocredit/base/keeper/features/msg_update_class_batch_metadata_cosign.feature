@@ -0,0 +1,59 @@
+Feature: Msg/UpdateClassBatchMetadataCosign
+
+  The batch metadata co-signature setting of a credit class can be updated:
+  - when the credit class exists
+  - when the admin is the admin of the credit class
+  - the setting is saved when a co-signature is required
+  - the setting is removed when a co-signature is not required
+
+  Background:
+    Given a credit class with id "C01" and admin alice
+
+  Rule: The credit class must exist
+
+    Scenario: the credit class exists
+      When alice attempts to require a co-signature for credit class "C01"
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to require a co-signature for credit class "C02"
+      Then expect the error "could not get credit class with id C02: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to require a co-signature for credit class "C01"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The co-signature setting is updated
+
+    Scenario: a co-signature is required
+      When alice attempts to require a co-signature for credit class "C01"
+      Then expect credit class "C01" requires a co-signature
+
+    Scenario: a co-signature is required when already required
+      Given alice has required a co-signature for credit class "C01"
+      When alice attempts to require a co-signature for credit class "C01"
+      Then expect no error
+      And expect credit class "C01" requires a co-signature
+
+    Scenario: a co-signature is no longer required
+      Given alice has required a co-signature for credit class "C01"
+      When alice attempts to remove the co-signature requirement for credit class "C01"
+      Then expect credit class "C01" does not require a co-signature
+
+    Scenario: a co-signature was never required
+      When alice attempts to remove the co-signature requirement for credit class "C01"
+      Then expect no error
+      And expect credit class "C01" does not require a co-signature
+
+  Rule: Event is emitted
+
+    Scenario: EventUpdateClassBatchMetadataCosign is emitted
+      When alice attempts to require a co-signature for credit class "C01"
+      Then expect event with properties
+      """
+      {
+        "class_id": "C01"
+      }
+      """
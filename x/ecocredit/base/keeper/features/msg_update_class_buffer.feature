@@ -0,0 +1,64 @@
+Feature: Msg/UpdateClassBuffer
+
+  The buffer percentage of a credit class can be updated:
+  - when the credit class exists
+  - when the admin is the admin of the credit class
+  - the buffer percentage is updated
+  - the buffer pool is disabled when the buffer percentage is zero
+
+  Background:
+    Given a credit type with abbreviation "C"
+    And a credit class with class id "C01" and admin alice
+
+  Rule: The credit class must exist
+
+    Scenario: the credit class exists
+      When alice attempts to update class buffer with class id "C01" and buffer percentage "20"
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to update class buffer with class id "C02" and buffer percentage "20"
+      Then expect the error "could not get credit class with id C02: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to update class buffer with class id "C01" and buffer percentage "20"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to update class buffer with class id "C01" and buffer percentage "20"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The buffer percentage is updated
+
+    Scenario: the buffer percentage is set
+      When alice attempts to update class buffer with class id "C01" and buffer percentage "12.5"
+      Then expect class buffer percentage "12.5"
+
+    Scenario: the buffer percentage is updated
+      Given the credit class has buffer percentage "20"
+      When alice attempts to update class buffer with class id "C01" and buffer percentage "10"
+      Then expect class buffer percentage "10"
+
+    Scenario: the buffer pool is disabled
+      Given the credit class has buffer percentage "20"
+      When alice attempts to update class buffer with class id "C01" and buffer percentage "0"
+      Then expect no class buffer
+
+    Scenario: the buffer pool is disabled when not previously enabled
+      When alice attempts to update class buffer with class id "C01" and buffer percentage "0"
+      Then expect no error
+      And expect no class buffer
+
+  Rule: Event is emitted
+
+    Scenario: EventUpdateClassBuffer is emitted
+      When alice attempts to update class buffer with class id "C01" and buffer percentage "20"
+      Then expect event update class buffer with properties
+      """
+      {
+        "class_id": "C01",
+        "buffer_percentage": "20"
+      }
+      """
@@ -4,6 +4,8 @@ Feature: Msg/UpdateClassIssuers
   - when the credit class exists
   - when the admin is the admin of the credit class
   - the credit class issuers are updated
+  - when the issuer of an issuer cap is an issuer of the credit class
+  - the issuance caps are updated
 
   Rule: The credit class must exist
 
@@ -82,6 +84,112 @@ Feature: Msg/UpdateClassIssuers
 
     # no failing scenario - state transitions only occur upon successful message execution
 
+  Rule: The issuer of an issuer cap must be an issuer of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" admin alice and issuers
+      """
+      [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ]
+      """
+
+    Scenario: the issuer is an issuer of the credit class
+      When alice attempts to update class issuers with class id "C01" and issuer caps
+      """
+      [
+        {
+          "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+          "max_issuance": "1000"
+        }
+      ]
+      """
+      Then expect no error
+
+    Scenario: the issuer is added in the same message
+      When alice attempts to update class issuers with class id "C01" add issuers and issuer caps
+      """
+      {
+        "add_issuers": [
+          "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+        ],
+        "issuer_caps": [
+          {
+            "issuer": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+            "max_issuance": "1000"
+          }
+        ]
+      }
+      """
+      Then expect no error
+
+    Scenario: the issuer is not an issuer of the credit class
+      When alice attempts to update class issuers with class id "C01" and issuer caps
+      """
+      [
+        {
+          "issuer": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+          "max_issuance": "1000"
+        }
+      ]
+      """
+      Then expect the error "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4 is not an issuer of credit class C01: invalid request"
+
+  Rule: The issuance caps are updated
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" admin alice and issuers
+      """
+      [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ]
+      """
+
+    Scenario: the issuer cap is set
+      When alice attempts to update class issuers with class id "C01" and issuer caps
+      """
+      [
+        {
+          "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+          "max_issuance": "1000"
+        }
+      ]
+      """
+      Then expect issuer "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6" of credit class "C01" with max issuance "1000"
+
+    Scenario: the issuer cap is removed
+      Given alice has updated class issuers with class id "C01" and issuer caps
+      """
+      [
+        {
+          "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+          "max_issuance": "1000"
+        }
+      ]
+      """
+      When alice attempts to update class issuers with class id "C01" and issuer caps
+      """
+      [
+        {
+          "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+        }
+      ]
+      """
+      Then expect issuer "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6" of credit class "C01" with max issuance ""
+
+    Scenario: the project vintage cap is set
+      When alice attempts to update class issuers with class id "C01" and project vintage cap "500"
+      Then expect credit class with class id "C01" and project vintage cap "500"
+
+    Scenario: the project vintage cap is removed
+      Given alice has updated class issuers with class id "C01" and project vintage cap "500"
+      When alice attempts to update class issuers with class id "C01" and project vintage cap ""
+      Then expect credit class with class id "C01" and project vintage cap ""
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
   Rule: Event is emitted
 
     Background:
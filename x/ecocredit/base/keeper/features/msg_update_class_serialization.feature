@@ -0,0 +1,82 @@
+Feature: Msg/UpdateClassSerialization
+
+  The serialization setting of a credit class can be updated:
+  - when the credit class exists
+  - when the admin is the admin of the credit class
+  - when no credits have been issued within the credit class
+  - when the credit type of the credit class has integer precision
+  - the setting is saved when serialization is enabled
+  - the setting is removed when serialization is disabled
+
+  Background:
+    Given a credit type with abbreviation "BIO" and precision 0
+    And a credit type with abbreviation "C" and precision 6
+    And a credit class with id "BIO01" credit type "BIO" and admin alice
+    And a credit class with id "C01" credit type "C" and admin alice
+
+  Rule: The credit class must exist
+
+    Scenario: the credit class exists
+      When alice attempts to enable serialization for credit class "BIO01"
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to enable serialization for credit class "BIO02"
+      Then expect the error "could not get credit class with id BIO02: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to enable serialization for credit class "BIO01"
+      Then expect error contains "is not the admin of credit class BIO01: unauthorized"
+
+  Rule: No credits can have been issued within the credit class
+
+    Scenario: credits have been issued within the credit class
+      Given credits have been issued within credit class "BIO01"
+      When alice attempts to enable serialization for credit class "BIO01"
+      Then expect the error "cannot update serialization of credit class BIO01: credits have already been issued: invalid request"
+
+  Rule: The credit type of the credit class must have integer precision
+
+    Scenario: the credit type has a non-zero precision
+      When alice attempts to enable serialization for credit class "C01"
+      Then expect the error "cannot serialize credit class C01: credit type C has a precision of 6, expected 0: invalid request"
+
+    Scenario: serialization is disabled for a credit type with a non-zero precision
+      When alice attempts to disable serialization for credit class "C01"
+      Then expect no error
+
+  Rule: The serialization setting is updated
+
+    Scenario: serialization is enabled
+      When alice attempts to enable serialization for credit class "BIO01"
+      Then expect credit class "BIO01" is serialized
+
+    Scenario: serialization is enabled when already enabled
+      Given alice has enabled serialization for credit class "BIO01"
+      When alice attempts to enable serialization for credit class "BIO01"
+      Then expect no error
+      And expect credit class "BIO01" is serialized
+
+    Scenario: serialization is disabled
+      Given alice has enabled serialization for credit class "BIO01"
+      When alice attempts to disable serialization for credit class "BIO01"
+      Then expect credit class "BIO01" is not serialized
+
+    Scenario: serialization was never enabled
+      When alice attempts to disable serialization for credit class "BIO01"
+      Then expect no error
+      And expect credit class "BIO01" is not serialized
+
+  Rule: Event is emitted
+
+    Scenario: EventUpdateClassSerialization is emitted
+      When alice attempts to enable serialization for credit class "BIO01"
+      Then expect event with properties
+      """
+      {
+        "class_id": "BIO01",
+        "serialized": true
+      }
+      """
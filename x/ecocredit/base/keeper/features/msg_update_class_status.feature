@@ -0,0 +1,66 @@
+Feature: Msg/UpdateClassStatus
+
+  The status of a credit class can be updated:
+  - when the credit class exists
+  - when the signer is the admin of the credit class or the governance authority
+  - when the status is different from the current status
+  - the credit class status is updated
+
+  Background:
+    Given a credit class with id "C01" and admin alice
+
+  Rule: The credit class must exist
+
+    Scenario: the credit class exists
+      When alice attempts to update the status of credit class "C01" to "CLASS_STATUS_DEPRECATED"
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to update the status of credit class "C02" to "CLASS_STATUS_DEPRECATED"
+      Then expect the error "could not get credit class with id C02: not found: invalid request"
+
+  Rule: The signer must be the admin of the credit class or the governance authority
+
+    Scenario: the signer is the admin of the credit class
+      When alice attempts to update the status of credit class "C01" to "CLASS_STATUS_DEPRECATED"
+      Then expect no error
+
+    Scenario: the signer is the governance authority
+      When the authority attempts to update the status of credit class "C01" to "CLASS_STATUS_DEPRECATED"
+      Then expect no error
+
+    Scenario: the signer is not the admin of the credit class or the governance authority
+      When bob attempts to update the status of credit class "C01" to "CLASS_STATUS_DEPRECATED"
+      Then expect error contains "is not the admin of credit class C01 or the governance authority: unauthorized"
+
+  Rule: The status must be different from the current status
+
+    Scenario: the status is the same as the current status
+      Given credit class "C01" has status "CLASS_STATUS_CLOSED_TO_ISSUANCE"
+      When alice attempts to update the status of credit class "C01" to "CLASS_STATUS_CLOSED_TO_ISSUANCE"
+      Then expect the error "credit class C01 already has status CLASS_STATUS_CLOSED_TO_ISSUANCE: invalid request"
+
+  Rule: The credit class status is updated
+
+    Scenario: the status is set for the first time
+      When alice attempts to update the status of credit class "C01" to "CLASS_STATUS_CLOSED_TO_NEW_PROJECTS"
+      Then expect credit class "C01" has status "CLASS_STATUS_CLOSED_TO_NEW_PROJECTS"
+
+    Scenario: the status is updated
+      Given credit class "C01" has status "CLASS_STATUS_DEPRECATED"
+      When alice attempts to update the status of credit class "C01" to "CLASS_STATUS_ACTIVE"
+      Then expect credit class "C01" has status "CLASS_STATUS_ACTIVE"
+
+  Rule: Event is emitted
+
+    Scenario: EventUpdateClassStatus is emitted
+      Given credit class "C01" has status "CLASS_STATUS_CLOSED_TO_NEW_PROJECTS"
+      When alice attempts to update the status of credit class "C01" to "CLASS_STATUS_DEPRECATED"
+      Then expect event with properties
+      """
+      {
+        "class_id": "C01",
+        "previous_status": "CLASS_STATUS_CLOSED_TO_NEW_PROJECTS",
+        "status": "CLASS_STATUS_DEPRECATED"
+      }
+      """
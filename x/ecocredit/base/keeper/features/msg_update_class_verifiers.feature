@@ -0,0 +1,98 @@
+Feature: Msg/UpdateClassVerifiers
+
+  The verifiers of a credit class can be updated:
+  - when the credit class exists
+  - when the admin is the admin of the credit class
+  - the credit class verifiers are updated
+
+  Rule: The credit class must exist
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: the credit class exists
+      When alice attempts to update class verifiers with class id "C01"
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to update class verifiers with class id "C02"
+      Then expect the error "could not get credit class with id C02: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to update class verifiers with class id "C01"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to update class verifiers with class id "C01"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The credit class verifiers are updated
+
+    Background:
+      Given a credit type with abbreviation "C"
+
+    Scenario: the credit class verifiers are added
+      And a credit class with class id "C01" admin alice and verifiers
+      """
+      [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ]
+      """
+      When alice attempts to update class verifiers with class id "C01" and add verifiers
+      """
+      [
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      ]
+      """
+      Then expect credit class with class id "C01" and verifiers
+      """
+      [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      ]
+      """
+
+    Scenario: the credit class verifiers are removed
+      And a credit class with class id "C01" admin alice and verifiers
+      """
+      [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      ]
+      """
+      When alice attempts to update class verifiers with class id "C01" and remove verifiers
+      """
+      [
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      ]
+      """
+      Then expect credit class with class id "C01" and verifiers
+      """
+      [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ]
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: EventUpdateClassVerifiers is emitted
+      When alice attempts to update class verifiers with class id "C01"
+      Then expect event with properties
+      """
+      {
+        "class_id": "C01"
+      }
+      """
\ No newline at end of file
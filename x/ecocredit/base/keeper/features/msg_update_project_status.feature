@@ -0,0 +1,99 @@
+Feature: Msg/UpdateProjectStatus
+
+  The status of a project can be updated:
+  - when the project exists
+  - when the signer is the admin or a verifier of the credit class
+  - when the project is not retired
+  - when the status is different from the current status
+  - the project status is updated and the status change is recorded
+
+  Background:
+    Given a project with id "C01-001" and credit class admin alice
+
+  Rule: The project must exist
+
+    Scenario: the project exists
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_ACTIVE"
+      Then expect no error
+
+    Scenario: the project does not exist
+      When alice attempts to update the status of project "C01-002" to "PROJECT_STATUS_ACTIVE"
+      Then expect the error "could not get project with id C01-002: not found: invalid request"
+
+  Rule: The signer must be the admin or a verifier of the credit class
+
+    Scenario: the signer is the admin of the credit class
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_ACTIVE"
+      Then expect no error
+
+    Scenario: the signer is a verifier of the credit class
+      Given bob is a verifier of the credit class
+      When bob attempts to update the status of project "C01-001" to "PROJECT_STATUS_ACTIVE"
+      Then expect no error
+
+    Scenario: the signer is not the admin or a verifier of the credit class
+      When bob attempts to update the status of project "C01-001" to "PROJECT_STATUS_ACTIVE"
+      Then expect error contains "is not the admin or a verifier of credit class C01: unauthorized"
+
+  Rule: The status of a retired project cannot be updated
+
+    Scenario: the project is not retired
+      Given the project has status "PROJECT_STATUS_SUSPENDED"
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_ACTIVE"
+      Then expect no error
+
+    Scenario: the project is retired
+      Given the project has status "PROJECT_STATUS_RETIRED"
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_ACTIVE"
+      Then expect the error "project C01-001 is retired and its status cannot be updated: invalid request"
+
+  Rule: The status must be different from the current status
+
+    Scenario: the status is different from the current status
+      Given the project has status "PROJECT_STATUS_VALIDATED"
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_ACTIVE"
+      Then expect no error
+
+    Scenario: the status is the same as the current status
+      Given the project has status "PROJECT_STATUS_ACTIVE"
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_ACTIVE"
+      Then expect the error "project C01-001 already has status PROJECT_STATUS_ACTIVE: invalid request"
+
+  Rule: The project status is updated and the status change is recorded
+
+    Scenario: the project status is updated
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_REGISTERED"
+      Then expect project with id "C01-001" and status "PROJECT_STATUS_REGISTERED"
+
+    Scenario: the status changes are recorded
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_ACTIVE" with reason "validated"
+      And alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_SUSPENDED" with reason "reversal event"
+      Then expect project with id "C01-001" and status history
+      """
+      [
+        {
+          "status": "PROJECT_STATUS_ACTIVE",
+          "reason": "validated"
+        },
+        {
+          "status": "PROJECT_STATUS_SUSPENDED",
+          "reason": "reversal event"
+        }
+      ]
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Scenario: EventUpdateProjectStatus is emitted
+      Given the project has status "PROJECT_STATUS_VALIDATED"
+      When alice attempts to update the status of project "C01-001" to "PROJECT_STATUS_ACTIVE"
+      Then expect event with properties
+      """
+      {
+        "project_id": "C01-001",
+        "previous_status": "PROJECT_STATUS_VALIDATED",
+        "status": "PROJECT_STATUS_ACTIVE"
+      }
+      """
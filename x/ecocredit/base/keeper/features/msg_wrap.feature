@@ -0,0 +1,143 @@
+Feature: Msg/Wrap
+
+  Credits can be wrapped by the owner:
+  - when the credit batch exists
+  - when the owner has a tradable credit balance greater than or equal to the amount to wrap
+  - when the decimal places in amount to wrap does not exceed credit type precision
+  - the owner credit balance is updated
+  - the escrow balance of the wrap submodule is updated
+  - the denom metadata of the wrapped denom is set when credits are first wrapped
+  - the wrapped tokens are minted and sent to the owner
+  - the response includes the wrapped denom and token amount
+
+  Rule: The credit batch must exist
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+
+    Scenario: the credit batch exists
+      Given a credit batch with denom "C01-001-20200101-20210101-001"
+      And alice owns tradable credit amount "10"
+      When alice attempts to wrap credit amount "10"
+      Then expect no error
+
+    Scenario: the credit batch does not exist
+      When alice attempts to wrap credit amount "10"
+      Then expect the error "could not get batch with denom C01-001-20200101-20210101-001: not found: invalid request"
+
+  Rule: The owner must have a tradable credit balance greater than or equal to the amount to wrap
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+
+    Scenario Outline: tradable balance greater than or equal to amount to wrap
+      Given alice owns tradable credit amount "10"
+      When alice attempts to wrap credit amount "<amount>"
+      Then expect no error
+
+      Examples:
+        | description  | amount |
+        | greater than | 5      |
+        | equal to     | 10     |
+
+    Scenario: tradable balance less than amount to wrap
+      Given alice owns tradable credit amount "10"
+      When alice attempts to wrap credit amount "15"
+      Then expect the error "tradable balance: 10, amount 15: insufficient credit balance"
+
+    Scenario: no credit balance
+      When alice attempts to wrap credit amount "10"
+      Then expect error contains "does not have any credits from batch C01-001-20200101-20210101-001: insufficient credit balance"
+
+  Rule: The decimal places in amount to wrap must not exceed credit type precision
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+      And alice owns tradable credit amount "10"
+
+    Scenario Outline: the decimal places in amount is less than or equal to credit type precision
+      When alice attempts to wrap credit amount "<amount>"
+      Then expect no error
+
+      Examples:
+        | description | amount   |
+        | less than   | 9.12345  |
+        | equal to    | 9.123456 |
+
+    Scenario: the decimal places in amount is greater than credit type precision
+      When alice attempts to wrap credit amount "9.1234567"
+      Then expect the error "amount: 9.1234567 exceeds maximum decimal places: 6: invalid request"
+
+  Rule: The credit balances of the owner and the wrap submodule are updated
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+      And alice owns tradable credit amount "10"
+
+    Scenario: credits are wrapped
+      When alice attempts to wrap credit amount "2.5"
+      Then expect alice tradable credit amount "7.5"
+      And expect wrap submodule tradable credit amount "2.5"
+
+    Scenario: credits are wrapped more than once
+      When alice attempts to wrap credit amount "2.5"
+      And alice attempts to wrap credit amount "5"
+      Then expect alice tradable credit amount "2.5"
+      And expect wrap submodule tradable credit amount "7.5"
+
+  Rule: The denom metadata is set when credits from the batch are first wrapped
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+      And alice owns tradable credit amount "10"
+
+    Scenario: credits are wrapped for the first time
+      When alice attempts to wrap credit amount "2.5"
+      Then expect denom metadata with base "eco.uC01-001-20200101-20210101-001" and display "eco.C01-001-20200101-20210101-001"
+
+    Scenario: credits are wrapped more than once
+      When alice attempts to wrap credit amount "2.5"
+      And alice attempts to wrap credit amount "5"
+      Then expect denom metadata to be set once
+
+  Rule: The wrapped tokens are minted and sent to the owner
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+      And alice owns tradable credit amount "10"
+
+    Scenario: wrapped tokens are received
+      When alice attempts to wrap credit amount "2.5"
+      Then expect alice wrapped token balance "2500000eco.uC01-001-20200101-20210101-001"
+      And expect the response
+      """
+      {
+        "denom": "eco.uC01-001-20200101-20210101-001",
+        "amount": "2500000"
+      }
+      """
+
+  Rule: Event is emitted
+
+    Background:
+      Given alice's address "regen15406g34dl5v9780tx2q3vtjdpkdgq4hhegdtm9"
+      And a credit type with abbreviation "C" and precision "6"
+      And a credit batch with denom "C01-001-20200101-20210101-001"
+      And alice owns tradable credit amount "10"
+
+    Scenario: EventWrap is emitted
+      When alice attempts to wrap credit amount "2.5"
+      Then expect event with properties
+      """
+      {
+        "owner": "regen15406g34dl5v9780tx2q3vtjdpkdgq4hhegdtm9",
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "2.5",
+        "denom": "eco.uC01-001-20200101-20210101-001"
+      }
+      """
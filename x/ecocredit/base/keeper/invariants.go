@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/math"
 )
 
 // BatchSupplyInvariant checks
-// - calculated total tradable balance of each credit batch matches the total tradable supply
+// - calculated total tradable balance (including escrowed, locked, and wrapped credits and credits
+// in baskets) of each credit batch matches the total tradable supply
 // - calculated total retired balance of each credit batch matches the total retired supply
 func BatchSupplyInvariant(ctx context.Context, k Keeper, basketBalances map[uint64]math.Dec) (msg string, broken bool) {
 	// sum of tradeable ecocredits with credits locked in baskets
@@ -52,6 +55,11 @@ func BatchSupplyInvariant(ctx context.Context, k Keeper, basketBalances map[uint
 		// escrowed balance
 		balanceFunc("tradable", batchIDToBalanceTradable, balance.BatchKey, balance.EscrowedAmount)
 
+		// locked balance (empty for balances that never held locked credits)
+		if balance.LockedAmount != "" {
+			balanceFunc("tradable", batchIDToBalanceTradable, balance.BatchKey, balance.LockedAmount)
+		}
+
 		// retired balance
 		balanceFunc("retired", batchIDToBalanceRetired, balance.BatchKey, balance.RetiredAmount)
 	}
@@ -109,3 +117,373 @@ func BatchSupplyInvariant(ctx context.Context, k Keeper, basketBalances map[uint
 
 	return msg, broken
 }
+
+// CreditLockInvariant checks
+// - calculated total remaining amount of the locks of each owner and credit batch matches the locked balance
+func CreditLockInvariant(ctx context.Context, k Keeper) (msg string, broken bool) {
+	type balanceKey struct {
+		owner    string
+		batchKey uint64
+	}
+	remaining := make(map[balanceKey]math.Dec) // map owner and batch key => remaining locked amount
+
+	itr, err := k.stateStore.CreditLockTable().List(ctx, api.CreditLockPrimaryKey{})
+	if err != nil {
+		return err.Error(), true
+	}
+	defer itr.Close()
+
+	for itr.Next() {
+		lock, err := itr.Value()
+		if err != nil {
+			return err.Error(), true
+		}
+
+		amount, err := math.NewPositiveDecFromString(lock.Amount)
+		if err != nil {
+			return fmt.Sprintf("	error while parsing amount of credit lock %d: %s\n", lock.Id, err), true
+		}
+
+		unlocked, err := math.NewNonNegativeDecFromString(lock.UnlockedAmount)
+		if err != nil {
+			return fmt.Sprintf("	error while parsing unlocked amount of credit lock %d: %s\n", lock.Id, err), true
+		}
+
+		left, err := math.SafeSubBalance(amount, unlocked)
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("	unlocked amount %v exceeds amount %v of credit lock %d\n", unlocked, amount, lock.Id)
+			continue
+		}
+
+		key := balanceKey{owner: string(lock.Owner), batchKey: lock.BatchKey}
+		if val, ok := remaining[key]; ok {
+			left, err = math.SafeAddBalance(val, left)
+			if err != nil {
+				return err.Error(), true
+			}
+		}
+		remaining[key] = left
+	}
+
+	bItr, err := k.stateStore.BatchBalanceTable().List(ctx, api.BatchBalancePrimaryKey{})
+	if err != nil {
+		return msg + err.Error(), true
+	}
+	defer bItr.Close()
+
+	for bItr.Next() {
+		balance, err := bItr.Value()
+		if err != nil {
+			return err.Error(), true
+		}
+
+		if balance.LockedAmount == "" {
+			continue
+		}
+
+		locked, err := math.NewNonNegativeDecFromString(balance.LockedAmount)
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("	error while parsing locked balance for batch id: %d\n", balance.BatchKey)
+			continue
+		}
+
+		key := balanceKey{owner: string(balance.Address), batchKey: balance.BatchKey}
+		expected, ok := remaining[key]
+		if !ok {
+			expected = math.NewDecFromInt64(0)
+		}
+		delete(remaining, key)
+
+		if expected.Cmp(locked) != math.EqualTo {
+			broken = true
+			msg += fmt.Sprintf("	locked balance of %s is incorrect for %d credit batch, expected %v, got %v\n",
+				sdk.AccAddress(balance.Address), balance.BatchKey, expected, locked)
+		}
+	}
+
+	for key, amount := range remaining {
+		if !amount.IsZero() {
+			broken = true
+			msg += fmt.Sprintf("	locked balance of %s is not found for %d credit batch\n", sdk.AccAddress(key.owner), key.batchKey)
+		}
+	}
+
+	return msg, broken
+}
+
+// CertificateSupplyInvariant checks
+// - each retirement certificate references an existing retirement and x/nft token
+// - calculated total amount of certificates of each credit batch does not exceed the total retired supply
+func CertificateSupplyInvariant(ctx context.Context, k Keeper) (msg string, broken bool) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	batchKeyToCertified := make(map[uint64]math.Dec) // map batch key => certified amount
+
+	itr, err := k.stateStore.RetirementCertificateTable().List(ctx, api.RetirementCertificatePrimaryKey{})
+	if err != nil {
+		return err.Error(), true
+	}
+	defer itr.Close()
+
+	for itr.Next() {
+		certificate, err := itr.Value()
+		if err != nil {
+			return err.Error(), true
+		}
+
+		if !k.nftKeeper.HasNFT(sdkCtx, certificate.NftClassId, certificate.NftId) {
+			broken = true
+			msg += fmt.Sprintf("	nft %s/%s not found for retirement certificate %d\n", certificate.NftClassId, certificate.NftId, certificate.RetirementId)
+		}
+
+		retirement, err := k.stateStore.RetirementTable().Get(ctx, certificate.RetirementId)
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("	retirement %d not found for retirement certificate: %v\n", certificate.RetirementId, err)
+			continue
+		}
+
+		amt, err := math.NewNonNegativeDecFromString(retirement.Amount)
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("	error while parsing amount of retirement %d: %v\n", retirement.Id, err)
+			continue
+		}
+
+		if val, ok := batchKeyToCertified[retirement.BatchKey]; ok {
+			result, err := math.SafeAddBalance(val, amt)
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("	error adding certified amount for credit batch %d: %v\n", retirement.BatchKey, err)
+				continue
+			}
+			batchKeyToCertified[retirement.BatchKey] = result
+		} else {
+			batchKeyToCertified[retirement.BatchKey] = amt
+		}
+	}
+
+	for batchKey, certified := range batchKeyToCertified {
+		supply, err := k.stateStore.BatchSupplyTable().Get(ctx, batchKey)
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("	retired supply is not found for %d credit batch\n", batchKey)
+			continue
+		}
+
+		retired, err := math.NewNonNegativeDecFromString(supply.RetiredAmount)
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("	error while parsing retired supply for batch id: %d\n", batchKey)
+			continue
+		}
+
+		if certified.Cmp(retired) == math.GreaterThan {
+			broken = true
+			msg += fmt.Sprintf("	certified amount exceeds retired supply for %d credit batch, retired %v, certified %v\n", batchKey, retired, certified)
+		}
+	}
+
+	return msg, broken
+}
+
+// AggregateSupplyInvariant checks
+// - calculated total tradable, retired, and cancelled supply of the credit batches of each project
+// matches the aggregated supply of the project
+// - calculated total tradable, retired, and cancelled supply of the credit batches of each credit class
+// matches the aggregated supply of the credit class
+// - calculated total tradable, retired, and cancelled supply of the credit batches of each credit type
+// matches the aggregated supply of the credit type
+func AggregateSupplyInvariant(ctx context.Context, k Keeper) (msg string, broken bool) {
+	projectKeyToSupply := make(map[uint64]*aggregatedSupply) // map project key => supply
+	classKeyToSupply := make(map[uint64]*aggregatedSupply)   // map class key => supply
+	creditTypeToSupply := make(map[string]*aggregatedSupply) // map credit type abbreviation => supply
+	projectKeyToClass := make(map[uint64]*api.Class)         // map project key => credit class
+
+	itr, err := k.stateStore.BatchSupplyTable().List(ctx, api.BatchSupplyPrimaryKey{})
+	if err != nil {
+		return err.Error(), true
+	}
+	defer itr.Close()
+
+	for itr.Next() {
+		supply, err := itr.Value()
+		if err != nil {
+			return err.Error(), true
+		}
+
+		batch, err := k.stateStore.BatchTable().Get(ctx, supply.BatchKey)
+		if err != nil {
+			return fmt.Sprintf("	credit batch %d not found for batch supply: %s\n", supply.BatchKey, err), true
+		}
+
+		class, ok := projectKeyToClass[batch.ProjectKey]
+		if !ok {
+			project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+			if err != nil {
+				return fmt.Sprintf("	project %d not found for credit batch %d: %s\n", batch.ProjectKey, batch.Key, err), true
+			}
+			class, err = k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+			if err != nil {
+				return fmt.Sprintf("	credit class %d not found for project %d: %s\n", project.ClassKey, project.Key, err), true
+			}
+			projectKeyToClass[batch.ProjectKey] = class
+		}
+
+		if _, ok := projectKeyToSupply[batch.ProjectKey]; !ok {
+			projectKeyToSupply[batch.ProjectKey] = newAggregatedSupply()
+		}
+		if _, ok := classKeyToSupply[class.Key]; !ok {
+			classKeyToSupply[class.Key] = newAggregatedSupply()
+		}
+		if _, ok := creditTypeToSupply[class.CreditTypeAbbrev]; !ok {
+			creditTypeToSupply[class.CreditTypeAbbrev] = newAggregatedSupply()
+		}
+
+		for _, totals := range []*aggregatedSupply{
+			projectKeyToSupply[batch.ProjectKey],
+			classKeyToSupply[class.Key],
+			creditTypeToSupply[class.CreditTypeAbbrev],
+		} {
+			if err := totals.add(supply.TradableAmount, supply.RetiredAmount, supply.CancelledAmount); err != nil {
+				broken = true
+				msg += fmt.Sprintf("	error adding supply of %d credit batch: %s\n", batch.Key, err)
+			}
+		}
+	}
+
+	pItr, err := k.stateStore.ProjectSupplyTable().List(ctx, api.ProjectSupplyPrimaryKey{})
+	if err != nil {
+		return msg + err.Error(), true
+	}
+	defer pItr.Close()
+
+	for pItr.Next() {
+		supply, err := pItr.Value()
+		if err != nil {
+			return err.Error(), true
+		}
+		expected, ok := projectKeyToSupply[supply.ProjectKey]
+		if !ok {
+			expected = newAggregatedSupply()
+		}
+		delete(projectKeyToSupply, supply.ProjectKey)
+		if m := expected.compare(supply.TradableAmount, supply.RetiredAmount, supply.CancelledAmount); m != "" {
+			broken = true
+			msg += fmt.Sprintf("	%s for %d project\n", m, supply.ProjectKey)
+		}
+	}
+
+	cItr, err := k.stateStore.ClassSupplyTable().List(ctx, api.ClassSupplyPrimaryKey{})
+	if err != nil {
+		return msg + err.Error(), true
+	}
+	defer cItr.Close()
+
+	for cItr.Next() {
+		supply, err := cItr.Value()
+		if err != nil {
+			return err.Error(), true
+		}
+		expected, ok := classKeyToSupply[supply.ClassKey]
+		if !ok {
+			expected = newAggregatedSupply()
+		}
+		delete(classKeyToSupply, supply.ClassKey)
+		if m := expected.compare(supply.TradableAmount, supply.RetiredAmount, supply.CancelledAmount); m != "" {
+			broken = true
+			msg += fmt.Sprintf("	%s for %d credit class\n", m, supply.ClassKey)
+		}
+	}
+
+	tItr, err := k.stateStore.CreditTypeSupplyTable().List(ctx, api.CreditTypeSupplyPrimaryKey{})
+	if err != nil {
+		return msg + err.Error(), true
+	}
+	defer tItr.Close()
+
+	for tItr.Next() {
+		supply, err := tItr.Value()
+		if err != nil {
+			return err.Error(), true
+		}
+		expected, ok := creditTypeToSupply[supply.CreditTypeAbbrev]
+		if !ok {
+			expected = newAggregatedSupply()
+		}
+		delete(creditTypeToSupply, supply.CreditTypeAbbrev)
+		if m := expected.compare(supply.TradableAmount, supply.RetiredAmount, supply.CancelledAmount); m != "" {
+			broken = true
+			msg += fmt.Sprintf("	%s for %s credit type\n", m, supply.CreditTypeAbbrev)
+		}
+	}
+
+	for projectKey, supply := range projectKeyToSupply {
+		if !supply.isZero() {
+			broken = true
+			msg += fmt.Sprintf("	aggregated supply is not found for %d project\n", projectKey)
+		}
+	}
+	for classKey, supply := range classKeyToSupply {
+		if !supply.isZero() {
+			broken = true
+			msg += fmt.Sprintf("	aggregated supply is not found for %d credit class\n", classKey)
+		}
+	}
+	for abbrev, supply := range creditTypeToSupply {
+		if !supply.isZero() {
+			broken = true
+			msg += fmt.Sprintf("	aggregated supply is not found for %s credit type\n", abbrev)
+		}
+	}
+
+	return msg, broken
+}
+
+// aggregatedSupply is the calculated total supply of a set of credit batches.
+type aggregatedSupply struct {
+	tradable, retired, cancelled math.Dec
+}
+
+func newAggregatedSupply() *aggregatedSupply {
+	zero := math.NewDecFromInt64(0)
+	return &aggregatedSupply{tradable: zero, retired: zero, cancelled: zero}
+}
+
+func (s *aggregatedSupply) add(tradable, retired, cancelled string) error {
+	t, r, c, err := parseSupplyAmounts(tradable, retired, cancelled)
+	if err != nil {
+		return err
+	}
+	if s.tradable, err = math.SafeAddBalance(s.tradable, t); err != nil {
+		return err
+	}
+	if s.retired, err = math.SafeAddBalance(s.retired, r); err != nil {
+		return err
+	}
+	s.cancelled, err = math.SafeAddBalance(s.cancelled, c)
+	return err
+}
+
+func (s *aggregatedSupply) compare(tradable, retired, cancelled string) string {
+	t, r, c, err := parseSupplyAmounts(tradable, retired, cancelled)
+	if err != nil {
+		return fmt.Sprintf("error while parsing aggregated supply: %s", err)
+	}
+	var msg string
+	if s.tradable.Cmp(t) != math.EqualTo {
+		msg += fmt.Sprintf("tradable supply is incorrect, expected %v, got %v ", s.tradable, t)
+	}
+	if s.retired.Cmp(r) != math.EqualTo {
+		msg += fmt.Sprintf("retired supply is incorrect, expected %v, got %v ", s.retired, r)
+	}
+	if s.cancelled.Cmp(c) != math.EqualTo {
+		msg += fmt.Sprintf("cancelled supply is incorrect, expected %v, got %v ", s.cancelled, c)
+	}
+	return msg
+}
+
+func (s *aggregatedSupply) isZero() bool {
+	return s.tradable.IsZero() && s.retired.IsZero() && s.cancelled.IsZero()
+}
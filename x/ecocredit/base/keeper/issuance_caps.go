@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// vintageYear returns the vintage year of a credit batch, i.e. the year (in
+// UTC) of the start date of the credit batch.
+func vintageYear(startDate *timestamppb.Timestamp) uint32 {
+	return uint32(startDate.AsTime().UTC().Year())
+}
+
+// TrackIssuance adds the amount of issued credits to the amount issued by the
+// issuer within the credit class and to the amount issued for the project
+// within the vintage year. Returns ErrMaxLimit if an issuance cap of the
+// credit class is exceeded.
+func TrackIssuance(ctx context.Context, ss api.StateStore, class *api.Class, project *api.Project, issuer sdk.AccAddress, vintage uint32, amount math.Dec) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	// the issuer may no longer be a class issuer when minting additional
+	// credits, in which case the issuer cap no longer applies
+	classIssuer, err := ss.ClassIssuerTable().Get(ctx, class.Key, issuer)
+	if err != nil && !ormerrors.IsNotFound(err) {
+		return err
+	}
+	if classIssuer != nil {
+		issued, err := addIssuedAmount(classIssuer.IssuedAmount, amount, classIssuer.MaxIssuance)
+		if err != nil {
+			return ecocredit.ErrMaxLimit.Wrapf(
+				"issuer %s in credit class %s: %s", issuer, class.Id, err,
+			)
+		}
+		classIssuer.IssuedAmount = issued
+		if err = ss.ClassIssuerTable().Update(ctx, classIssuer); err != nil {
+			return err
+		}
+	}
+
+	maxIssuance, err := getMaxProjectVintageIssuance(ctx, ss, class.Key)
+	if err != nil {
+		return err
+	}
+
+	vintageIssuance, err := getProjectVintageIssuance(ctx, ss, project.Key, vintage)
+	if err != nil {
+		return err
+	}
+
+	issued, err := addIssuedAmount(vintageIssuance.IssuedAmount, amount, maxIssuance)
+	if err != nil {
+		return ecocredit.ErrMaxLimit.Wrapf(
+			"project %s in vintage year %d: %s", project.Id, vintage, err,
+		)
+	}
+	vintageIssuance.IssuedAmount = issued
+
+	return ss.ProjectVintageIssuanceTable().Save(ctx, vintageIssuance)
+}
+
+// getMaxProjectVintageIssuance returns the maximum amount of credits that can
+// be issued for a project within a vintage year or an empty string if the
+// credit class has no cap.
+func getMaxProjectVintageIssuance(ctx context.Context, ss api.StateStore, classKey uint64) (string, error) {
+	issuanceCap, err := ss.ClassIssuanceCapTable().Get(ctx, classKey)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return issuanceCap.MaxProjectVintageIssuance, nil
+}
+
+// getProjectVintageIssuance returns the amount of credits issued for a project
+// within a vintage year or a zero amount if no credits have been issued.
+func getProjectVintageIssuance(ctx context.Context, ss api.StateStore, projectKey uint64, vintage uint32) (*api.ProjectVintageIssuance, error) {
+	vintageIssuance, err := ss.ProjectVintageIssuanceTable().Get(ctx, projectKey, vintage)
+	if err != nil {
+		if !ormerrors.IsNotFound(err) {
+			return nil, err
+		}
+		vintageIssuance = &api.ProjectVintageIssuance{
+			ProjectKey:   projectKey,
+			VintageYear:  vintage,
+			IssuedAmount: "0",
+		}
+	}
+
+	return vintageIssuance, nil
+}
+
+// addIssuedAmount adds the amount to the issued amount and returns an error if
+// the new issued amount exceeds the max issuance. An empty max issuance means
+// there is no cap.
+func addIssuedAmount(issuedAmount string, amount math.Dec, maxIssuance string) (string, error) {
+	issued, err := math.NewNonNegativeDecFromString(issuedAmount)
+	if err != nil {
+		return "", err
+	}
+
+	newIssued, err := issued.Add(amount)
+	if err != nil {
+		return "", err
+	}
+
+	if maxIssuance != "" {
+		remaining, err := remainingIssuance(maxIssuance, issued.String())
+		if err != nil {
+			return "", err
+		}
+		if amount.Cmp(remaining) == 1 {
+			return "", fmt.Errorf(
+				"cannot issue %s credits with %s of %s credits remaining", amount, remaining, maxIssuance,
+			)
+		}
+	}
+
+	return newIssued.String(), nil
+}
+
+// remainingIssuance returns the amount of credits that can still be issued
+// given the max issuance and the issued amount. The remaining amount is zero if
+// the max issuance was lowered below the issued amount.
+func remainingIssuance(maxIssuance, issuedAmount string) (math.Dec, error) {
+	maxDec, err := math.NewNonNegativeDecFromString(maxIssuance)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	issued, err := math.NewNonNegativeDecFromString(issuedAmount)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	if issued.Cmp(maxDec) == 1 {
+		return math.NewDecFromInt64(0), nil
+	}
+
+	return maxDec.Sub(issued)
+}
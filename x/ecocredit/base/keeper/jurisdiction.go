@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// AssertValidJurisdiction checks that the jurisdiction exists in the ISO 3166
+// datasets embedded in the module if strict jurisdiction validation is enabled.
+// The format of the jurisdiction is always checked in ValidateBasic. The check
+// is applied whenever a project, retirement, or host jurisdiction is written,
+// including projects created when credits are bridged or received over IBC.
+// Project jurisdictions cannot be updated after the project is created.
+func AssertValidJurisdiction(ctx context.Context, ss api.StateStore, jurisdiction string) error {
+	strict, err := ss.StrictJurisdictionsTable().Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !strict.Enabled {
+		return nil
+	}
+
+	if err := base.ValidateJurisdictionStrict(jurisdiction); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("jurisdiction: %s", err)
+	}
+
+	return nil
+}
@@ -18,6 +18,8 @@ var (
 type Keeper struct {
 	stateStore    api.StateStore
 	bankKeeper    ecocredit.BankKeeper
+	nftKeeper     ecocredit.NFTKeeper
+	dataKeeper    ecocredit.DataKeeper
 	moduleAddress sdk.AccAddress
 
 	basketStore basketapi.StateStore
@@ -31,6 +33,8 @@ type Keeper struct {
 func NewKeeper(
 	ss api.StateStore,
 	bk ecocredit.BankKeeper,
+	nk ecocredit.NFTKeeper,
+	dk ecocredit.DataKeeper,
 	ma sdk.AccAddress,
 	basketStore basketapi.StateStore,
 	marketStore marketplaceapi.StateStore,
@@ -39,6 +43,8 @@ func NewKeeper(
 	return Keeper{
 		stateStore:    ss,
 		bankKeeper:    bk,
+		nftKeeper:     nk,
+		dataKeeper:    dk,
 		moduleAddress: ma,
 		basketStore:   basketStore,
 		authority:     authority,
@@ -14,6 +14,8 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
 	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
 	"github.com/cosmos/cosmos-sdk/orm/testing/ormtest"
@@ -21,6 +23,9 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
 
 	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
 	marketplaceapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
@@ -45,6 +50,8 @@ type baseSuite struct {
 	addr       sdk.AccAddress
 	addr2      sdk.AccAddress
 	bankKeeper *mocks.MockBankKeeper
+	dataKeeper *mocks.MockDataKeeper
+	nftKeeper  nftkeeper.Keeper
 	storeKey   *storetypes.KVStoreKey
 	sdkCtx     sdk.Context
 	authority  sdk.AccAddress
@@ -63,6 +70,8 @@ func setupBase(t gocuke.TestingT) *baseSuite {
 	cms := store.NewCommitMultiStore(db)
 	s.storeKey = sdk.NewKVStoreKey("test")
 	cms.MountStoreWithDB(s.storeKey, storetypes.StoreTypeIAVL, db)
+	nftKey := sdk.NewKVStoreKey(nftkeeper.StoreKey)
+	cms.MountStoreWithDB(nftKey, storetypes.StoreTypeIAVL, db)
 	assert.NilError(t, cms.LoadLatestVersion())
 	ormCtx := ormtable.WrapContextDefault(ormtest.NewMemoryBackend())
 	s.sdkCtx = sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger()).WithContext(ormCtx)
@@ -72,6 +81,12 @@ func setupBase(t gocuke.TestingT) *baseSuite {
 	s.ctrl = gomock.NewController(t)
 	assert.NilError(t, err)
 	s.bankKeeper = mocks.NewMockBankKeeper(s.ctrl)
+	s.dataKeeper = mocks.NewMockDataKeeper(s.ctrl)
+
+	accountKeeper := mocks.NewMockAccountKeeper(s.ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(nft.ModuleName).Return(authtypes.NewModuleAddress(nft.ModuleName))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	s.nftKeeper = nftkeeper.NewKeeper(nftKey, cdc, accountKeeper, s.bankKeeper)
 
 	_, _, moduleAddress := testdata.KeyTestPubAddr()
 	s.authority, err = sdk.AccAddressFromBech32("regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68")
@@ -83,7 +98,7 @@ func setupBase(t gocuke.TestingT) *baseSuite {
 	marketStore, err := marketplaceapi.NewStateStore(s.db)
 	assert.NilError(t, err)
 
-	s.k = NewKeeper(s.stateStore, s.bankKeeper, moduleAddress, basketStore, marketStore, s.authority)
+	s.k = NewKeeper(s.stateStore, s.bankKeeper, s.nftKeeper, s.dataKeeper, moduleAddress, basketStore, marketStore, s.authority)
 	_, _, s.addr = testdata.KeyTestPubAddr()
 	_, _, s.addr2 = testdata.KeyTestPubAddr()
 
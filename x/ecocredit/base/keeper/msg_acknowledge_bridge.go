@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// AcknowledgeBridge marks a pending bridge request as completed.
+func (k Keeper) AcknowledgeBridge(ctx context.Context, req *types.MsgAcknowledgeBridge) (*types.MsgAcknowledgeBridgeResponse, error) {
+	request, err := k.getPendingBridgeRequest(ctx, req.Service, req.RequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Status = uint32(api.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_COMPLETED)
+	if err = k.stateStore.BridgeRequestTable().Update(ctx, request); err != nil {
+		return nil, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventAcknowledgeBridge{
+		RequestId: request.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcknowledgeBridgeResponse{}, nil
+}
+
+// getPendingBridgeRequest returns the bridge request with the given id and
+// ensures the bridge request is pending and the service is an allowed bridge
+// service of the target chain of the bridge request.
+func (k Keeper) getPendingBridgeRequest(ctx context.Context, service string, requestID uint64) (*api.BridgeRequest, error) {
+	serviceAddr, err := sdk.AccAddressFromBech32(service)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := k.stateStore.BridgeRequestTable().Get(ctx, requestID)
+	if err != nil {
+		if ormerrors.NotFound.Is(err) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("bridge request with id %d", requestID)
+		}
+		return nil, err
+	}
+
+	if err = k.assertBridgeService(ctx, request.Target, serviceAddr); err != nil {
+		return nil, err
+	}
+
+	if request.Status != uint32(api.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_PENDING) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"bridge request %d is not pending: %s", requestID, api.BridgeRequestStatus(request.Status),
+		)
+	}
+
+	return request, nil
+}
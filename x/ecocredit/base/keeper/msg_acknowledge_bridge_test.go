@@ -0,0 +1,142 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type acknowledgeBridgeSuite struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	batchKey uint64
+	res      *types.MsgAcknowledgeBridgeResponse
+	err      error
+}
+
+func TestAcknowledgeBridge(t *testing.T) {
+	gocuke.NewRunner(t, &acknowledgeBridgeSuite{}).Path("./features/msg_acknowledge_bridge.feature").Run()
+}
+
+func (s *acknowledgeBridgeSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *acknowledgeBridgeSuite) TheTargetIsAnAllowedBridgeChain(a string) {
+	_, err := s.k.AddAllowedBridgeChain(s.ctx, &types.MsgAddAllowedBridgeChain{
+		Authority: s.authority.String(),
+		ChainName: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *acknowledgeBridgeSuite) BobIsAnAllowedBridgeServiceFor(a string) {
+	_, err := s.k.AddAllowedBridgeService(s.ctx, &types.MsgAddAllowedBridgeService{
+		Authority: s.authority.String(),
+		ChainName: a,
+		Address:   s.bob.String(),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *acknowledgeBridgeSuite) ACreditBatchExistsWithABatchContractEntry() {
+	s.batchKey = bridgedBatchSetup(s.t, s.ctx, s.stateStore, s.alice, false)
+}
+
+func (s *acknowledgeBridgeSuite) AliceHasBridgedCreditAmountFromTheCreditBatchTo(a, b string) {
+	_, err := s.k.Bridge(s.ctx, &types.MsgBridge{
+		Owner:     s.alice.String(),
+		Target:    b,
+		Recipient: "0x71C7656EC7ab88b098defB751B7401B5f6d8976F",
+		Credits: []*types.Credits{
+			{
+				BatchDenom: testBatchDenom,
+				Amount:     a,
+			},
+		},
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *acknowledgeBridgeSuite) BobHasAcknowledgedBridgeRequest(a int64) {
+	s.BobAttemptsToAcknowledgeBridgeRequest(a)
+	require.NoError(s.t, s.err)
+}
+
+func (s *acknowledgeBridgeSuite) BobHasFailedBridgeRequest(a int64) {
+	_, err := s.k.FailBridge(s.ctx, &types.MsgFailBridge{
+		Service:   s.bob.String(),
+		RequestId: uint64(a),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *acknowledgeBridgeSuite) BobAttemptsToAcknowledgeBridgeRequest(a int64) {
+	s.acknowledgeBridge(s.bob, uint64(a))
+}
+
+func (s *acknowledgeBridgeSuite) AliceAttemptsToAcknowledgeBridgeRequest(a int64) {
+	s.acknowledgeBridge(s.alice, uint64(a))
+}
+
+func (s *acknowledgeBridgeSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *acknowledgeBridgeSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *acknowledgeBridgeSuite) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *acknowledgeBridgeSuite) ExpectBridgeRequestWithStatus(a int64, b string) {
+	request, err := s.stateStore.BridgeRequestTable().Get(s.ctx, uint64(a))
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, api.BridgeRequestStatus(request.Status).String())
+}
+
+func (s *acknowledgeBridgeSuite) ExpectAliceBatchBalance(a gocuke.DocString) {
+	expected := &api.BatchBalance{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.alice, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
+	require.Equal(s.t, expected.RetiredAmount, balance.RetiredAmount)
+	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
+}
+
+func (s *acknowledgeBridgeSuite) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventAcknowledgeBridge
+	err := jsonpb.UnmarshalString(a.Content, &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *acknowledgeBridgeSuite) acknowledgeBridge(service sdk.AccAddress, requestID uint64) {
+	s.res, s.err = s.k.AcknowledgeBridge(s.ctx, &types.MsgAcknowledgeBridge{
+		Service:   service.String(),
+		RequestId: requestID,
+	})
+}
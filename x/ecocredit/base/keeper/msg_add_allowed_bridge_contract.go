@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func (k Keeper) AddAllowedBridgeContract(ctx context.Context, req *types.MsgAddAllowedBridgeContract) (*types.MsgAddAllowedBridgeContractResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority: expected %s, got %s", k.authority, req.Authority)
+	}
+
+	chainName := strings.ToLower(req.ChainName)
+
+	exists, err := k.stateStore.AllowedBridgeChainTable().Has(ctx, chainName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not an allowed bridge chain", req.ChainName)
+	}
+
+	err = k.stateStore.AllowedBridgeContractTable().Insert(ctx, &api.AllowedBridgeContract{
+		ChainName: chainName,
+		Contract:  strings.ToLower(req.Contract),
+	})
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not insert bridge contract %s for chain name %s: %s", req.Contract, chainName, err.Error())
+	}
+
+	return &types.MsgAddAllowedBridgeContractResponse{}, nil
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type addAllowedBridgeContractSuite struct {
+	*baseSuite
+	err error
+}
+
+func TestAddAllowedBridgeContract(t *testing.T) {
+	gocuke.NewRunner(t, &addAllowedBridgeContractSuite{}).Path("./features/msg_add_allowed_bridge_contract.feature").Run()
+}
+
+func (s *addAllowedBridgeContractSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+}
+
+func (s *addAllowedBridgeContractSuite) TheAuthorityAddress(a string) {
+	addr, err := sdk.AccAddressFromBech32(a)
+	require.NoError(s.t, err)
+	s.authority = addr
+}
+
+func (s *addAllowedBridgeContractSuite) TheChainName(a string) {
+	_, err := s.k.AddAllowedBridgeChain(s.ctx, &types.MsgAddAllowedBridgeChain{
+		Authority: s.authority.String(),
+		ChainName: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *addAllowedBridgeContractSuite) AllowedBridgeContractForChainName(a, b string) {
+	_, err := s.k.AddAllowedBridgeContract(s.ctx, &types.MsgAddAllowedBridgeContract{
+		Authority: s.authority.String(),
+		ChainName: b,
+		Contract:  a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *addAllowedBridgeContractSuite) AliceAttemptsToAddAllowedBridgeContractWithProperties(a gocuke.DocString) {
+	var msg *types.MsgAddAllowedBridgeContract
+	err := json.Unmarshal([]byte(a.Content), &msg)
+	require.NoError(s.t, err)
+
+	_, s.err = s.k.AddAllowedBridgeContract(s.ctx, msg)
+}
+
+func (s *addAllowedBridgeContractSuite) AliceAttemptsToAddBridgeContractForChainName(a, b string) {
+	_, s.err = s.k.AddAllowedBridgeContract(s.ctx, &types.MsgAddAllowedBridgeContract{
+		Authority: s.authority.String(),
+		ChainName: b,
+		Contract:  a,
+	})
+}
+
+func (s *addAllowedBridgeContractSuite) ExpectBridgeContractForChainNameToExist(a, b string) {
+	found, err := s.stateStore.AllowedBridgeContractTable().Has(s.ctx, b, a)
+	require.NoError(s.t, err)
+	require.True(s.t, found)
+}
+
+func (s *addAllowedBridgeContractSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *addAllowedBridgeContractSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *addAllowedBridgeContractSuite) ExpectTheErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
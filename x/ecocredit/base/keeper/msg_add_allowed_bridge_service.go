@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func (k Keeper) AddAllowedBridgeService(ctx context.Context, req *types.MsgAddAllowedBridgeService) (*types.MsgAddAllowedBridgeServiceResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority: expected %s, got %s", k.authority, req.Authority)
+	}
+
+	chainName := strings.ToLower(req.ChainName)
+
+	exists, err := k.stateStore.AllowedBridgeChainTable().Has(ctx, chainName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not an allowed bridge chain", req.ChainName)
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.stateStore.AllowedBridgeServiceTable().Insert(ctx, &api.AllowedBridgeService{
+		ChainName: chainName,
+		Address:   address,
+	})
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not insert bridge service %s for chain name %s: %s", req.Address, chainName, err.Error())
+	}
+
+	return &types.MsgAddAllowedBridgeServiceResponse{}, nil
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type addAllowedBridgeServiceSuite struct {
+	*baseSuite
+	err error
+}
+
+func TestAddAllowedBridgeService(t *testing.T) {
+	gocuke.NewRunner(t, &addAllowedBridgeServiceSuite{}).Path("./features/msg_add_allowed_bridge_service.feature").Run()
+}
+
+func (s *addAllowedBridgeServiceSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+}
+
+func (s *addAllowedBridgeServiceSuite) TheAuthorityAddress(a string) {
+	addr, err := sdk.AccAddressFromBech32(a)
+	require.NoError(s.t, err)
+	s.authority = addr
+}
+
+func (s *addAllowedBridgeServiceSuite) TheChainName(a string) {
+	_, err := s.k.AddAllowedBridgeChain(s.ctx, &types.MsgAddAllowedBridgeChain{
+		Authority: s.authority.String(),
+		ChainName: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *addAllowedBridgeServiceSuite) AllowedBridgeServiceForChainName(a, b string) {
+	_, err := s.k.AddAllowedBridgeService(s.ctx, &types.MsgAddAllowedBridgeService{
+		Authority: s.authority.String(),
+		ChainName: b,
+		Address:   a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *addAllowedBridgeServiceSuite) AliceAttemptsToAddAllowedBridgeServiceWithProperties(a gocuke.DocString) {
+	var msg *types.MsgAddAllowedBridgeService
+	err := json.Unmarshal([]byte(a.Content), &msg)
+	require.NoError(s.t, err)
+
+	_, s.err = s.k.AddAllowedBridgeService(s.ctx, msg)
+}
+
+func (s *addAllowedBridgeServiceSuite) AliceAttemptsToAddBridgeServiceForChainName(a, b string) {
+	_, s.err = s.k.AddAllowedBridgeService(s.ctx, &types.MsgAddAllowedBridgeService{
+		Authority: s.authority.String(),
+		ChainName: b,
+		Address:   a,
+	})
+}
+
+func (s *addAllowedBridgeServiceSuite) ExpectBridgeServiceForChainNameToExist(a, b string) {
+	addr, err := sdk.AccAddressFromBech32(a)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.AllowedBridgeServiceTable().Has(s.ctx, b, addr)
+	require.NoError(s.t, err)
+	require.True(s.t, found)
+}
+
+func (s *addAllowedBridgeServiceSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *addAllowedBridgeServiceSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *addAllowedBridgeServiceSuite) ExpectTheErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// AddNationalRegistry is a governance method that authorizes an account to act
+// on behalf of the national registry of a country.
+func (k Keeper) AddNationalRegistry(ctx context.Context, req *types.MsgAddNationalRegistry) (*types.MsgAddNationalRegistryResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority: expected %s, got %s", k.authority, req.Authority)
+	}
+
+	registryAddr, err := sdk.AccAddressFromBech32(req.Registry)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := k.stateStore.NationalRegistryTable().Has(ctx, req.Country, registryAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if found {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"national registry %s already exists for country %s", req.Registry, req.Country,
+		)
+	}
+
+	if err := k.stateStore.NationalRegistryTable().Insert(ctx, &api.NationalRegistry{
+		Country: req.Country,
+		Address: registryAddr,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddNationalRegistryResponse{}, nil
+}
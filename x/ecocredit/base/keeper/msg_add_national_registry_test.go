@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type addNationalRegistry struct {
+	*baseSuite
+	err error
+}
+
+func TestAddNationalRegistry(t *testing.T) {
+	gocuke.NewRunner(t, &addNationalRegistry{}).Path("./features/msg_add_national_registry.feature").Run()
+}
+
+func (s *addNationalRegistry) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+}
+
+func (s *addNationalRegistry) NationalRegistryForCountry(a, b string) {
+	registry, err := sdk.AccAddressFromBech32(a)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.NationalRegistryTable().Insert(s.ctx, &api.NationalRegistry{
+		Country: b,
+		Address: registry,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *addNationalRegistry) AliceAttemptsToAddNationalRegistryWithProperties(a gocuke.DocString) {
+	var msg *types.MsgAddNationalRegistry
+
+	err := json.Unmarshal([]byte(a.Content), &msg)
+	require.NoError(s.t, err)
+
+	_, s.err = s.k.AddNationalRegistry(s.ctx, msg)
+}
+
+func (s *addNationalRegistry) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *addNationalRegistry) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *addNationalRegistry) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *addNationalRegistry) ExpectNationalRegistryForCountry(a, b string) {
+	registry, err := sdk.AccAddressFromBech32(a)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.NationalRegistryTable().Has(s.ctx, b, registry)
+	require.NoError(s.t, err)
+	require.True(s.t, found)
+}
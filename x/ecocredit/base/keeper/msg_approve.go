@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// Approve sets the amount of tradable credits from a credit batch or from any
+// credit batch within a credit class that a spender is allowed to send or
+// retire on behalf of the credit owner.
+func (k Keeper) Approve(ctx context.Context, req *types.MsgApprove) (*types.MsgApproveResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	spender, err := sdk.AccAddressFromBech32(req.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.BatchDenom != "" {
+		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+		}
+
+		creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		amount, err := math.NewPositiveFixedDecFromString(req.Amount, creditType.Precision)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+
+		if err := k.stateStore.BatchAllowanceTable().Save(ctx, &api.BatchAllowance{
+			Owner:    owner,
+			Spender:  spender,
+			BatchKey: batch.Key,
+			Amount:   amount.String(),
+		}); err != nil {
+			return nil, err
+		}
+	} else {
+		class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+		}
+
+		creditType, err := k.stateStore.CreditTypeTable().Get(ctx, class.CreditTypeAbbrev)
+		if err != nil {
+			return nil, err
+		}
+
+		amount, err := math.NewPositiveFixedDecFromString(req.Amount, creditType.Precision)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+
+		if err := k.stateStore.ClassAllowanceTable().Save(ctx, &api.ClassAllowance{
+			Owner:    owner,
+			Spender:  spender,
+			ClassKey: class.Key,
+			Amount:   amount.String(),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventApprove{
+		Owner:      req.Owner,
+		Spender:    req.Spender,
+		BatchDenom: req.BatchDenom,
+		ClassId:    req.ClassId,
+		Amount:     req.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveResponse{}, nil
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// ApproveBatch approves a pending batch and creates the proposed credit batch.
+// Only the admin or a verifier of the credit class can approve a pending batch.
+// The credit batch is created with the same checks as MsgCreateBatch, except
+// for the batch approval setting of the credit class.
+func (k Keeper) ApproveBatch(ctx context.Context, req *types.MsgApproveBatch) (*types.MsgApproveBatchResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	approver, err := sdk.AccAddressFromBech32(req.Approver)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, _, err := k.getPendingBatch(ctx, req.PendingBatchId, approver)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().Get(ctx, pending.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate, endDate := pending.StartDate.AsTime(), pending.EndDate.AsTime()
+	res, err := k.createBatch(ctx, &types.MsgCreateBatch{
+		Issuer:    sdk.AccAddress(pending.Issuer).String(),
+		ProjectId: project.Id,
+		Issuance:  batchIssuance(pending.Issuance),
+		Metadata:  pending.Metadata,
+		StartDate: &startDate,
+		EndDate:   &endDate,
+		Open:      pending.Open,
+		OriginTx:  batchOriginTx(pending.OriginTx),
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.stateStore.PendingBatchTable().Delete(ctx, pending); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventApproveBatch{
+		PendingBatchId: pending.Id,
+		BatchDenom:     res.BatchDenom,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveBatchResponse{BatchDenom: res.BatchDenom}, nil
+}
+
+// getPendingBatch gets the pending batch and the credit class of the pending
+// batch and makes sure that the approver is the admin or a verifier of the
+// credit class.
+func (k Keeper) getPendingBatch(ctx context.Context, id uint64, approver sdk.AccAddress) (*api.PendingBatch, *api.Class, error) {
+	pending, err := k.stateStore.PendingBatchTable().Get(ctx, id)
+	if err != nil {
+		return nil, nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get pending batch with id %d: %s", id, err,
+		)
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, pending.ClassKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = k.assertClassAdminOrVerifier(ctx, class, approver); err != nil {
+		return nil, nil, err
+	}
+
+	return pending, class, nil
+}
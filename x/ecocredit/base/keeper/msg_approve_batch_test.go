@@ -0,0 +1,232 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// pendingBatchSuite contains the steps shared by the approve batch and reject
+// batch features.
+type pendingBatchSuite struct {
+	*baseSuite
+	alice      sdk.AccAddress
+	bob        sdk.AccAddress
+	carol      sdk.AccAddress
+	classKey   uint64
+	projectKey uint64
+	err        error
+}
+
+func (s *pendingBatchSuite) setup(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+	_, _, s.carol = testdata.KeyTestPubAddr()
+}
+
+func (s *pendingBatchSuite) ACreditClassWithIdAdminAliceAndIssuerBob(a string) {
+	err := s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: "C",
+		Name:         "carbon",
+		Unit:         "tonne",
+		Precision:    6,
+	})
+	require.NoError(s.t, err)
+
+	s.classKey, err = s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+		ClassKey: s.classKey,
+		Issuer:   s.bob,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *pendingBatchSuite) AProjectWithId(a string) {
+	var err error
+	s.projectKey, err = s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: s.classKey,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *pendingBatchSuite) TheCreditClassRequiresBatchApproval() {
+	err := s.stateStore.ClassBatchApprovalTable().Insert(s.ctx, &api.ClassBatchApproval{
+		ClassKey:     s.classKey,
+		ExpiryPeriod: durationpb.New(time.Hour),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *pendingBatchSuite) TheCreditClassDoesNotRequireBatchApproval() {
+	err := s.stateStore.ClassBatchApprovalTable().Delete(s.ctx, &api.ClassBatchApproval{
+		ClassKey: s.classKey,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *pendingBatchSuite) BobHasProposedABatchWithProjectIdAndTradableAmount(a, b string) {
+	startDate, err := regentypes.ParseDate("start date", "2020-01-01")
+	require.NoError(s.t, err)
+
+	endDate, err := regentypes.ParseDate("end date", "2021-01-01")
+	require.NoError(s.t, err)
+
+	_, err = s.k.ProposeBatch(s.ctx, &types.MsgProposeBatch{
+		Issuer:    s.bob.String(),
+		ProjectId: a,
+		Issuance: []*types.BatchIssuance{
+			{
+				Recipient:      s.bob.String(),
+				TradableAmount: b,
+			},
+		},
+		StartDate: &startDate,
+		EndDate:   &endDate,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *pendingBatchSuite) CarolIsAVerifierOfTheCreditClass() {
+	err := s.stateStore.ClassVerifierTable().Insert(s.ctx, &api.ClassVerifier{
+		ClassKey: s.classKey,
+		Verifier: s.carol,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *pendingBatchSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *pendingBatchSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *pendingBatchSuite) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *pendingBatchSuite) ExpectPendingBatchWithId(a string) {
+	found, err := s.stateStore.PendingBatchTable().Has(s.ctx, s.parseID(a))
+	require.NoError(s.t, err)
+	require.True(s.t, found)
+}
+
+func (s *pendingBatchSuite) ExpectNoPendingBatchWithId(a string) {
+	found, err := s.stateStore.PendingBatchTable().Has(s.ctx, s.parseID(a))
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *pendingBatchSuite) ExpectNoCreditBatches() {
+	it, err := s.stateStore.BatchTable().List(s.ctx, api.BatchPrimaryKey{})
+	require.NoError(s.t, err)
+	defer it.Close()
+
+	require.False(s.t, it.Next())
+}
+
+func (s *pendingBatchSuite) parseID(id string) uint64 {
+	res, err := strconv.ParseUint(id, 10, 64)
+	require.NoError(s.t, err)
+	return res
+}
+
+type approveBatchSuite struct {
+	pendingBatchSuite
+	res *types.MsgApproveBatchResponse
+}
+
+func TestApproveBatch(t *testing.T) {
+	gocuke.NewRunner(t, &approveBatchSuite{}).Path("./features/msg_approve_batch.feature").Run()
+}
+
+func (s *approveBatchSuite) Before(t gocuke.TestingT) {
+	s.setup(t)
+}
+
+func (s *approveBatchSuite) BobIsNoLongerAnIssuerOfTheCreditClass() {
+	err := s.stateStore.ClassIssuerTable().Delete(s.ctx, &api.ClassIssuer{
+		ClassKey: s.classKey,
+		Issuer:   s.bob,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *approveBatchSuite) TheProjectHasStatus(a string) {
+	err := s.stateStore.ProjectLifecycleTable().Insert(s.ctx, &api.ProjectLifecycle{
+		ProjectKey: s.projectKey,
+		Status:     uint32(api.ProjectStatus_value[a]),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *approveBatchSuite) AliceAttemptsToApprovePendingBatch(a string) {
+	s.approveBatch(s.alice, a)
+}
+
+func (s *approveBatchSuite) BobAttemptsToApprovePendingBatch(a string) {
+	s.approveBatch(s.bob, a)
+}
+
+func (s *approveBatchSuite) CarolAttemptsToApprovePendingBatch(a string) {
+	s.approveBatch(s.carol, a)
+}
+
+func (s *approveBatchSuite) ExpectBobHasATradableBalanceOfInBatch(a, b string) {
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, b)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.bob, batch.Key)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, balance.TradableAmount)
+}
+
+func (s *approveBatchSuite) ExpectTheResponse(a gocuke.DocString) {
+	var res types.MsgApproveBatchResponse
+	err := jsonpb.UnmarshalString(a.Content, &res)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, &res, s.res)
+}
+
+func (s *approveBatchSuite) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventApproveBatch
+	err := jsonpb.UnmarshalString(a.Content, &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *approveBatchSuite) approveBatch(approver sdk.AccAddress, id string) {
+	s.res, s.err = s.k.ApproveBatch(s.ctx, &types.MsgApproveBatch{
+		Approver:       approver.String(),
+		PendingBatchId: s.parseID(id),
+	})
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type approve struct {
+	*baseSuite
+	alice            sdk.AccAddress
+	bob              sdk.AccAddress
+	creditTypeAbbrev string
+	precision        uint32
+	classKey         uint64
+	batchKey         uint64
+	res              *types.MsgApproveResponse
+	err              error
+}
+
+func TestApprove(t *testing.T) {
+	gocuke.NewRunner(t, &approve{}).Path("./features/msg_approve.feature").Run()
+}
+
+func (s *approve) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+	s.creditTypeAbbrev = "C"
+}
+
+func (s *approve) ACreditTypeWithPrecision(a string) {
+	precision, err := strconv.ParseUint(a, 10, 32)
+	require.NoError(s.t, err)
+
+	s.precision = uint32(precision)
+}
+
+func (s *approve) ACreditBatch() {
+	err := s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: s.creditTypeAbbrev,
+		Precision:    s.precision,
+	})
+	require.NoError(s.t, err)
+
+	cKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               testClassID,
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	s.classKey = cKey
+
+	pKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       testProjectID,
+		ClassKey: cKey,
+	})
+	require.NoError(s.t, err)
+
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: pKey,
+		Denom:      testBatchDenom,
+	})
+	require.NoError(s.t, err)
+
+	s.batchKey = bKey
+}
+
+func (s *approve) AliceApprovedBobForBatchWithAmount(a string) {
+	err := s.stateStore.BatchAllowanceTable().Insert(s.ctx, &api.BatchAllowance{
+		Owner:    s.alice,
+		Spender:  s.bob,
+		BatchKey: s.batchKey,
+		Amount:   a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *approve) AliceAttemptsToApproveBobForBatchWithAmount(a, b string) {
+	s.res, s.err = s.k.Approve(s.ctx, &types.MsgApprove{
+		Owner:      s.alice.String(),
+		Spender:    s.bob.String(),
+		BatchDenom: a,
+		Amount:     b,
+	})
+}
+
+func (s *approve) AliceAttemptsToApproveBobForClassWithAmount(a, b string) {
+	s.res, s.err = s.k.Approve(s.ctx, &types.MsgApprove{
+		Owner:   s.alice.String(),
+		Spender: s.bob.String(),
+		ClassId: a,
+		Amount:  b,
+	})
+}
+
+func (s *approve) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *approve) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *approve) ExpectBobBatchAllowance(a string) {
+	allowance, err := s.stateStore.BatchAllowanceTable().Get(s.ctx, s.alice, s.bob, s.batchKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, allowance.Amount)
+}
+
+func (s *approve) ExpectBobClassAllowance(a string) {
+	allowance, err := s.stateStore.ClassAllowanceTable().Get(s.ctx, s.alice, s.bob, s.classKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, allowance.Amount)
+}
+
+func (s *approve) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventApprove
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+	event.Owner = s.alice.String()
+	event.Spender = s.bob.String()
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
@@ -5,14 +5,23 @@ import (
 	"fmt"
 	"strings"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
 	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
 )
 
-// Bridge cancel credits, removing them from the supply and balance of the holder
+// Bridge cancel credits, removing them from the supply and balance of the holder,
+// or locks credits in the bridge escrow account of the target chain if the
+// credits are from a credit batch issued on Regen Ledger and linked to a contract
+// on the target chain. A pending bridge request is recorded for each credit
+// batch so that the credits can be returned if the bridge service fails to
+// relay them.
 func (k Keeper) Bridge(ctx context.Context, req *types.MsgBridge) (*types.MsgBridgeResponse, error) {
 
 	exists, err := k.stateStore.AllowedBridgeChainTable().Has(ctx, strings.ToLower(req.Target))
@@ -23,44 +32,98 @@ func (k Keeper) Bridge(ctx context.Context, req *types.MsgBridge) (*types.MsgBri
 		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not an authorized bridge target", req.Target)
 	}
 
-	_, err = k.Cancel(ctx, &types.MsgCancel{
-		Owner:   req.Owner,
-		Credits: req.Credits,
-		Reason:  fmt.Sprintf("bridge-%s", req.Target),
-	})
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
 	if err != nil {
 		return nil, err
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	requestIDs := make([]uint64, 0, len(req.Credits))
 	for _, credit := range req.Credits {
 		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
 		if err != nil {
-			return nil, err // we already know the batch exists from Cancel
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
 		}
 
 		// if no batch contract entry is found then we error because we only support
 		// bridging credits from credit batches that were created as a result of a
-		// bridge operation (i.e. only previously bridged credits)
+		// bridge operation (i.e. previously bridged credits) or credit batches that
+		// were linked to a contract by the admin of the credit class
 		batchContract, err := k.stateStore.BatchContractTable().Get(ctx, batch.Key)
 		if err != nil {
 			if ormerrors.IsNotFound(err) {
 				return nil, sdkerrors.ErrInvalidRequest.Wrap(
-					"only credits previously bridged from another chain are supported",
+					"only credits previously bridged from another chain or from credit batches linked to a contract are supported",
 				)
 			}
 			return nil, err
 		}
 
-		if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventBridge{
-			Target:    req.Target,
+		if err = k.assertBridgeContract(ctx, req.Target, batchContract.Contract); err != nil {
+			return nil, err
+		}
+
+		nativeContract, err := k.stateStore.NativeBatchContractTable().Get(ctx, batch.Key)
+		if err != nil && !ormerrors.IsNotFound(err) {
+			return nil, err
+		}
+
+		if nativeContract != nil {
+			if nativeContract.Target != strings.ToLower(req.Target) {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+					"credits from credit batch %s can only be bridged to %s", batch.Denom, nativeContract.Target,
+				)
+			}
+
+			// credits issued on Regen Ledger are locked in the bridge escrow
+			// account rather than cancelled so that they can be unlocked when
+			// returned (see Msg/BridgeReturn)
+			if err = k.sendEcocredits(sdkCtx, &types.MsgSend_SendCredits{
+				BatchDenom:     credit.BatchDenom,
+				TradableAmount: credit.Amount,
+				RetiredAmount:  "0",
+			}, base.BridgeEscrowAddress(req.Target), owner); err != nil {
+				return nil, err
+			}
+		} else {
+			if _, err = k.Cancel(ctx, &types.MsgCancel{
+				Owner:   req.Owner,
+				Credits: []*types.Credits{credit},
+				Reason:  fmt.Sprintf("bridge-%s", req.Target),
+			}); err != nil {
+				return nil, err
+			}
+		}
+
+		// record a pending bridge request so that the credits can be returned
+		// to the owner if the bridge service fails to relay the credits (see
+		// Msg/FailBridge)
+		requestID, err := k.stateStore.BridgeRequestTable().InsertReturningID(ctx, &api.BridgeRequest{
+			Owner:     owner,
+			BatchKey:  batch.Key,
+			Target:    strings.ToLower(req.Target),
 			Recipient: req.Recipient,
 			Contract:  batchContract.Contract,
 			Amount:    credit.Amount,
+			Status:    uint32(api.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_PENDING),
+			Timestamp: timestamppb.New(sdkCtx.BlockTime()),
+		})
+		if err != nil {
+			return nil, err
+		}
+		requestIDs = append(requestIDs, requestID)
+
+		if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventBridge{
+			Target:     req.Target,
+			Recipient:  req.Recipient,
+			Contract:   batchContract.Contract,
+			Amount:     credit.Amount,
+			RequestId:  requestID,
+			BatchDenom: batch.Denom,
 		}); err != nil {
 			return nil, err
 		}
 	}
 
-	return &types.MsgBridgeResponse{}, nil
+	return &types.MsgBridgeResponse{RequestIds: requestIDs}, nil
 }
@@ -12,7 +12,10 @@ import (
 	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
 )
 
-// BridgeReceive bridges credits received from another chain.
+// BridgeReceive bridges credits received from another chain. Credit batches
+// created by a bridge operation do not require batch approval, as the credits
+// were issued on the origin chain and the issuer is an allowed issuer of the
+// credit class.
 func (k Keeper) BridgeReceive(ctx context.Context, req *types.MsgBridgeReceive) (*types.MsgBridgeReceiveResponse, error) {
 
 	exists, err := k.stateStore.AllowedBridgeChainTable().Has(ctx, strings.ToLower(req.OriginTx.Source))
@@ -23,6 +26,21 @@ func (k Keeper) BridgeReceive(ctx context.Context, req *types.MsgBridgeReceive)
 		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not an authorized bridge source", req.OriginTx.Source)
 	}
 
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	// only an allowed bridge service of the source chain can bridge credits
+	// from an allowed bridge contract of the source chain
+	if err = k.assertBridgeService(ctx, req.OriginTx.Source, issuer); err != nil {
+		return nil, err
+	}
+
+	if err = k.assertBridgeContract(ctx, req.OriginTx.Source, req.OriginTx.Contract); err != nil {
+		return nil, err
+	}
+
 	// check class id and get class information (specifically class key)
 	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
 	if err != nil {
@@ -32,6 +50,11 @@ func (k Keeper) BridgeReceive(ctx context.Context, req *types.MsgBridgeReceive)
 		return nil, err
 	}
 
+	// check the credit class status before creating projects or issuing credits
+	if err = AssertClassCanIssue(ctx, k.stateStore, class); err != nil {
+		return nil, err
+	}
+
 	// check if batch contract entry exists
 	batchContract, err := k.stateStore.BatchContractTable().GetByClassKeyContract(ctx, class.Key, req.OriginTx.Contract)
 	if err != nil {
@@ -44,11 +67,12 @@ func (k Keeper) BridgeReceive(ctx context.Context, req *types.MsgBridgeReceive)
 	var response *types.MsgBridgeReceiveResponse
 
 	// if batch contract entry with matching contract exists, and therefore a
-	// project exists, dynamically mint credits to the existing credit batch,
-	// otherwise search for an existing project based on credit class id and
-	// project reference id and, if the project exists, create a credit batch
-	// under the existing project, otherwise, create a new project and then a
-	// new credit batch under the new project
+	// project exists, dynamically mint credits to the existing credit batch (or
+	// unlock credits if the credit batch was issued on Regen Ledger and linked to
+	// the contract), otherwise search for an existing project based on credit
+	// class id and project reference id and, if the project exists, create a
+	// credit batch under the existing project, otherwise, create a new project
+	// and then a new credit batch under the new project
 	if batchContract != nil {
 
 		// get batch information (specifically batch denom)
@@ -63,18 +87,35 @@ func (k Keeper) BridgeReceive(ctx context.Context, req *types.MsgBridgeReceive)
 			return nil, err
 		}
 
-		// mint credits to the existing credit batch
-		_, err = k.MintBatchCredits(ctx, &types.MsgMintBatchCredits{
-			Issuer:     req.Issuer,
-			BatchDenom: batch.Denom,
-			Issuance: []*types.BatchIssuance{
-				{
-					Recipient:      req.Batch.Recipient,
-					TradableAmount: req.Batch.Amount,
+		native, err := k.stateStore.NativeBatchContractTable().Has(ctx, batch.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		if native {
+			// unlock credits from a credit batch issued on Regen Ledger that
+			// were previously locked when bridged to the source chain
+			_, err = k.BridgeReturn(ctx, &types.MsgBridgeReturn{
+				Issuer:     req.Issuer,
+				BatchDenom: batch.Denom,
+				Recipient:  req.Batch.Recipient,
+				Amount:     req.Batch.Amount,
+				OriginTx:   req.OriginTx,
+			})
+		} else {
+			// mint credits to the existing credit batch
+			_, err = k.MintBatchCredits(ctx, &types.MsgMintBatchCredits{
+				Issuer:     req.Issuer,
+				BatchDenom: batch.Denom,
+				Issuance: []*types.BatchIssuance{
+					{
+						Recipient:      req.Batch.Recipient,
+						TradableAmount: req.Batch.Amount,
+					},
 				},
-			},
-			OriginTx: req.OriginTx,
-		})
+				OriginTx: req.OriginTx,
+			})
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -116,8 +157,9 @@ func (k Keeper) BridgeReceive(ctx context.Context, req *types.MsgBridgeReceive)
 			project = &api.Project{Id: projectRes.ProjectId}
 		}
 
-		// create a new credit batch with the information provided
-		batchRes, err := k.CreateBatch(ctx, &types.MsgCreateBatch{
+		// create a new credit batch with the information provided, bypassing
+		// batch approval of the credit class
+		batchRes, err := k.createBatch(ctx, &types.MsgCreateBatch{
 			Issuer:    req.Issuer,
 			ProjectId: project.Id,
 			Issuance: []*types.BatchIssuance{
@@ -131,7 +173,7 @@ func (k Keeper) BridgeReceive(ctx context.Context, req *types.MsgBridgeReceive)
 			EndDate:   req.Batch.EndDate,
 			Open:      true,
 			OriginTx:  req.OriginTx,
-		})
+		}, true)
 		if err != nil {
 			return nil, err
 		}
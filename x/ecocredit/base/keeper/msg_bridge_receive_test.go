@@ -10,6 +10,7 @@ import (
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/regen-network/gocuke"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/regen-network/regen-ledger/x/ecocredit/base"
 
@@ -96,6 +97,29 @@ func (s *bridgeReceiveSuite) ACreditClassWithIdAndIssuerAlice(a string) {
 	s.classKey = cKey
 }
 
+func (s *bridgeReceiveSuite) TheCreditClassHasStatus(a string) {
+	err := s.k.stateStore.ClassLifecycleTable().Insert(s.ctx, &api.ClassLifecycle{
+		ClassKey: s.classKey,
+		Status:   uint32(api.ClassStatus_value[a]),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *bridgeReceiveSuite) TheCreditClassRequiresBatchApproval() {
+	err := s.k.stateStore.ClassBatchApprovalTable().Insert(s.ctx, &api.ClassBatchApproval{
+		ClassKey:     s.classKey,
+		ExpiryPeriod: durationpb.New(time.Hour),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *bridgeReceiveSuite) StrictJurisdictionsAreEnabled() {
+	err := s.k.stateStore.StrictJurisdictionsTable().Save(s.ctx, &api.StrictJurisdictions{
+		Enabled: true,
+	})
+	require.NoError(s.t, err)
+}
+
 func (s *bridgeReceiveSuite) AProjectWithId(a string) {
 	pKey, err := s.k.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
 		Id:       a,
@@ -183,6 +207,26 @@ func (s *bridgeReceiveSuite) AllowedBridgeChain(a string) {
 	require.NoError(s.t, err)
 }
 
+func (s *bridgeReceiveSuite) AllowedBridgeServicesAliceAndBob() {
+	for _, addr := range []sdk.AccAddress{s.alice, s.bob} {
+		_, err := s.k.AddAllowedBridgeService(s.ctx, &types.MsgAddAllowedBridgeService{
+			Authority: s.authority.String(),
+			ChainName: s.originTx.Source,
+			Address:   addr.String(),
+		})
+		require.NoError(s.t, err)
+	}
+}
+
+func (s *bridgeReceiveSuite) AllowedBridgeContract(a string) {
+	_, err := s.k.AddAllowedBridgeContract(s.ctx, &types.MsgAddAllowedBridgeContract{
+		Authority: s.authority.String(),
+		ChainName: s.originTx.Source,
+		Contract:  a,
+	})
+	require.NoError(s.t, err)
+}
+
 func (s *bridgeReceiveSuite) AliceAttemptsToBridgeCreditsWithClassId(a string) {
 	s.res, s.err = s.k.BridgeReceive(s.ctx, &types.MsgBridgeReceive{
 		Issuer:  s.alice.String(),
@@ -317,6 +361,26 @@ func (s *bridgeReceiveSuite) AliceAttemptsToBridgeCreditsWithProjectProperties(a
 	require.NoError(s.t, s.err)
 }
 
+func (s *bridgeReceiveSuite) AliceAttemptsToBridgeCreditsWithProjectJurisdiction(a string) {
+	s.res, s.err = s.k.BridgeReceive(s.ctx, &types.MsgBridgeReceive{
+		Issuer:  s.alice.String(),
+		ClassId: s.classID,
+		Project: &types.MsgBridgeReceive_Project{
+			ReferenceId:  s.referenceID,
+			Jurisdiction: a,
+			Metadata:     s.metadata,
+		},
+		Batch: &types.MsgBridgeReceive_Batch{
+			Recipient: s.bob.String(),
+			Amount:    s.tradableAmount,
+			StartDate: s.startDate,
+			EndDate:   s.endDate,
+			Metadata:  s.metadata,
+		},
+		OriginTx: s.originTx,
+	})
+}
+
 func (s *bridgeReceiveSuite) AliceAttemptsToBridgeCreditsWithBatchProperties(a gocuke.DocString) {
 	var batch types.MsgBridgeReceive_Batch
 	err := jsonpb.UnmarshalString(a.Content, &batch)
@@ -365,6 +429,44 @@ func (s *bridgeReceiveSuite) AliceAttemptsToBridgeCreditsToBobWithTradableAmount
 	require.NoError(s.t, s.err)
 }
 
+func (s *bridgeReceiveSuite) AliceAttemptsToBridgeCreditsWithTradableAmount(a string) {
+	s.res, s.err = s.k.BridgeReceive(s.ctx, &types.MsgBridgeReceive{
+		Issuer:  s.alice.String(),
+		ClassId: s.classID,
+		Project: &types.MsgBridgeReceive_Project{
+			ReferenceId:  s.referenceID,
+			Jurisdiction: s.jurisdiction,
+			Metadata:     s.metadata,
+		},
+		Batch: &types.MsgBridgeReceive_Batch{
+			Recipient: s.bob.String(),
+			Amount:    a,
+			StartDate: s.startDate,
+			EndDate:   s.endDate,
+			Metadata:  s.metadata,
+		},
+		OriginTx: s.originTx,
+	})
+}
+
+func (s *bridgeReceiveSuite) TheIssuerAliceHasMaxIssuanceAndIssuedAmount(a, b string) {
+	err := s.k.stateStore.ClassIssuerTable().Update(s.ctx, &api.ClassIssuer{
+		ClassKey:     s.classKey,
+		Issuer:       s.alice,
+		MaxIssuance:  a,
+		IssuedAmount: b,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *bridgeReceiveSuite) TheCreditClassHasMaxProjectVintageIssuance(a string) {
+	err := s.k.stateStore.ClassIssuanceCapTable().Insert(s.ctx, &api.ClassIssuanceCap{
+		ClassKey:                  s.classKey,
+		MaxProjectVintageIssuance: a,
+	})
+	require.NoError(s.t, err)
+}
+
 func (s *bridgeReceiveSuite) AliceAttemptsToBridgeCreditsWithOrigintxSource(a string) {
 	originTx := s.originTx
 	originTx.Source = a
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// BridgeReturn unlocks credits from a credit batch issued on Regen Ledger that
+// are returned from the chain the credit batch is linked to, sending the credits
+// from the bridge escrow account to the recipient.
+func (k Keeper) BridgeReturn(ctx context.Context, req *types.MsgBridgeReturn) (*types.MsgBridgeReturnResponse, error) {
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := k.stateStore.AllowedBridgeChainTable().Has(ctx, strings.ToLower(req.OriginTx.Source))
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not an authorized bridge source", req.OriginTx.Source)
+	}
+
+	if err = k.assertBridgeService(ctx, req.OriginTx.Source, issuer); err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	nativeContract, err := k.stateStore.NativeBatchContractTable().Get(ctx, batch.Key)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"credit batch %s is not linked to a contract", req.BatchDenom,
+			)
+		}
+		return nil, err
+	}
+
+	if nativeContract.Target != strings.ToLower(req.OriginTx.Source) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"credits from credit batch %s can only be returned from %s", req.BatchDenom, nativeContract.Target,
+		)
+	}
+
+	batchContract, err := k.stateStore.BatchContractTable().Get(ctx, batch.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(batchContract.Contract, req.OriginTx.Contract) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"origin tx contract %s does not match contract %s of credit batch %s",
+			req.OriginTx.Contract, batchContract.Contract, req.BatchDenom,
+		)
+	}
+
+	// the linked contract may have been removed from the allowed bridge
+	// contracts after the credit batch was linked
+	if err = k.assertBridgeContract(ctx, req.OriginTx.Source, req.OriginTx.Contract); err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.assertClassIssuer(ctx, project.ClassKey, issuer); err != nil {
+		return nil, err
+	}
+
+	// the origin tx index is shared with issued and minted credits so that the
+	// same transaction cannot be processed more than once
+	if err = k.stateStore.OriginTxIndexTable().Insert(ctx, &api.OriginTxIndex{
+		ClassKey:  project.ClassKey,
+		Id:        req.OriginTx.Id,
+		Source:    req.OriginTx.Source,
+		BatchKey:  batch.Key,
+		Contract:  req.OriginTx.Contract,
+		Note:      req.OriginTx.Note,
+		Timestamp: timestamppb.New(sdk.UnwrapSDKContext(ctx).BlockTime()),
+	}); err != nil {
+		if ormerrors.AlreadyExists.Is(err) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("credits already bridged with tx id: %s", req.OriginTx.Id)
+		}
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err = k.sendEcocredits(sdkCtx, &types.MsgSend_SendCredits{
+		BatchDenom:     req.BatchDenom,
+		TradableAmount: req.Amount,
+		RetiredAmount:  "0",
+	}, recipient, base.BridgeEscrowAddress(nativeContract.Target)); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventBridgeReturn{
+		BatchDenom: req.BatchDenom,
+		Recipient:  req.Recipient,
+		Amount:     req.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBridgeReturnResponse{}, nil
+}
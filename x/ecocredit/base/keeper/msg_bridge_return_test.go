@@ -0,0 +1,254 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type bridgeReturn struct {
+	*baseSuite
+	alice sdk.AccAddress
+	bob   sdk.AccAddress
+	res   *types.MsgBridgeReturnResponse
+	err   error
+}
+
+func TestBridgeReturn(t *testing.T) {
+	gocuke.NewRunner(t, &bridgeReturn{}).Path("./features/msg_bridge_return.feature").Run()
+}
+
+func (s *bridgeReturn) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *bridgeReturn) ACreditTypeWithAbbreviationAndPrecision(a string, b int64) {
+	err := s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+		Unit:         "unit",
+		Precision:    uint32(b),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *bridgeReturn) ACreditClassWithIdAndIssuerAlice(a string) {
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: base.GetCreditTypeAbbrevFromClassID(a),
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+		ClassKey: classKey,
+		Issuer:   s.alice,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *bridgeReturn) AProjectWithIdInCreditClass(a, b string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: class.Key,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *bridgeReturn) ACreditBatchWithDenomFromProject(a, b string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	batchKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom:      a,
+		ProjectKey: project.Key,
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:        batchKey,
+		TradableAmount:  "0",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *bridgeReturn) TheChainIsAnAllowedBridgeChain(a string) {
+	err := s.stateStore.AllowedBridgeChainTable().Insert(s.ctx, &api.AllowedBridgeChain{
+		ChainName: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *bridgeReturn) AliceIsAnAllowedBridgeServiceForChain(a string) {
+	s.allowedBridgeService(a, s.alice)
+}
+
+func (s *bridgeReturn) BobIsAnAllowedBridgeServiceForChain(a string) {
+	s.allowedBridgeService(a, s.bob)
+}
+
+func (s *bridgeReturn) TheContractIsAnAllowedBridgeContractForChain(a, b string) {
+	err := s.stateStore.AllowedBridgeContractTable().Insert(s.ctx, &api.AllowedBridgeContract{
+		ChainName: b,
+		Contract:  a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *bridgeReturn) TheContractIsRemovedFromTheAllowedBridgeContractsForChain(a, b string) {
+	err := s.stateStore.AllowedBridgeContractTable().Delete(s.ctx, &api.AllowedBridgeContract{
+		ChainName: b,
+		Contract:  a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *bridgeReturn) CreditBatchIsLinkedToContractOnTarget(a, b, c string) {
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	project, err := s.stateStore.ProjectTable().Get(s.ctx, batch.ProjectKey)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BatchContractTable().Insert(s.ctx, &api.BatchContract{
+		BatchKey: batch.Key,
+		ClassKey: project.ClassKey,
+		Contract: b,
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.NativeBatchContractTable().Insert(s.ctx, &api.NativeBatchContract{
+		BatchKey: batch.Key,
+		Target:   c,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *bridgeReturn) TheBridgeEscrowAccountOfTargetHoldsCreditsFromCreditBatch(a, b, c string) {
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, c)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       batch.Key,
+		Address:        base.BridgeEscrowAddress(a),
+		TradableAmount: b,
+		RetiredAmount:  "0",
+		EscrowedAmount: "0",
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BatchSupplyTable().Update(s.ctx, &api.BatchSupply{
+		BatchKey:        batch.Key,
+		TradableAmount:  b,
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *bridgeReturn) BobsAddress(a string) {
+	addr, err := sdk.AccAddressFromBech32(a)
+	require.NoError(s.t, err)
+	s.bob = addr
+}
+
+func (s *bridgeReturn) AliceHasReturnedCreditsToBobWithProperties(a gocuke.DocString) {
+	s.bridgeReturn(s.alice, a)
+	require.NoError(s.t, s.err)
+}
+
+func (s *bridgeReturn) AliceAttemptsToReturnCreditsToBobWithProperties(a gocuke.DocString) {
+	s.bridgeReturn(s.alice, a)
+}
+
+func (s *bridgeReturn) BobAttemptsToReturnCreditsToBobWithProperties(a gocuke.DocString) {
+	s.bridgeReturn(s.bob, a)
+}
+
+func (s *bridgeReturn) AliceAttemptsToBridgeReceiveCreditsToBobWithProperties(a gocuke.DocString) {
+	msg := &types.MsgBridgeReceive{}
+	err := jsonpb.UnmarshalString(a.Content, msg)
+	require.NoError(s.t, err)
+
+	msg.Issuer = s.alice.String()
+	msg.Batch.Recipient = s.bob.String()
+
+	_, s.err = s.k.BridgeReceive(s.ctx, msg)
+}
+
+func (s *bridgeReturn) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *bridgeReturn) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *bridgeReturn) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *bridgeReturn) ExpectBobHasATradableBalanceOfFromCreditBatch(a, b string) {
+	s.expectTradableBalance(s.bob, a, b)
+}
+
+func (s *bridgeReturn) ExpectTheBridgeEscrowAccountOfTargetHasATradableBalanceOfFromCreditBatch(a, b, c string) {
+	s.expectTradableBalance(base.BridgeEscrowAddress(a), b, c)
+}
+
+func (s *bridgeReturn) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventBridgeReturn
+	err := jsonpb.UnmarshalString(a.Content, &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *bridgeReturn) expectTradableBalance(addr sdk.AccAddress, amount, batchDenom string) {
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, batchDenom)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, addr, batch.Key)
+	require.NoError(s.t, err)
+	require.Equal(s.t, amount, balance.TradableAmount)
+}
+
+func (s *bridgeReturn) allowedBridgeService(chainName string, addr sdk.AccAddress) {
+	err := s.stateStore.AllowedBridgeServiceTable().Insert(s.ctx, &api.AllowedBridgeService{
+		ChainName: chainName,
+		Address:   addr,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *bridgeReturn) bridgeReturn(issuer sdk.AccAddress, properties gocuke.DocString) {
+	msg := &types.MsgBridgeReturn{}
+	err := jsonpb.UnmarshalString(properties.Content, msg)
+	require.NoError(s.t, err)
+
+	msg.Issuer = issuer.String()
+	msg.Recipient = s.bob.String()
+
+	s.res, s.err = s.k.BridgeReturn(s.ctx, msg)
+}
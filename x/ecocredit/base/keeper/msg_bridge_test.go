@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"encoding/json"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/jsonpb"
@@ -13,6 +15,7 @@ import (
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
 	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
 )
 
@@ -68,6 +71,16 @@ func (s *bridgeSuite) ACreditBatchExistsWithABatchContractEntry() {
 	require.NoError(s.t, err)
 }
 
+func (s *bridgeSuite) ACreditBatchExistsWithANativeBatchContractEntryForTarget(a string) {
+	s.ACreditBatchExistsWithABatchContractEntry()
+
+	err := s.k.stateStore.NativeBatchContractTable().Insert(s.ctx, &api.NativeBatchContract{
+		BatchKey: s.batchKey,
+		Target:   a,
+	})
+	require.NoError(s.t, err)
+}
+
 func (s *bridgeSuite) TheTargetIsAnAllowedChain() {
 	_, err := s.k.AddAllowedBridgeChain(s.ctx, &types.MsgAddAllowedBridgeChain{
 		Authority: s.authority.String(),
@@ -76,6 +89,14 @@ func (s *bridgeSuite) TheTargetIsAnAllowedChain() {
 	require.NoError(s.t, err)
 }
 
+func (s *bridgeSuite) TheBatchContractIsAnAllowedBridgeContract() {
+	err := s.k.stateStore.AllowedBridgeContractTable().Insert(s.ctx, &api.AllowedBridgeContract{
+		ChainName: s.target,
+		Contract:  strings.ToLower(s.contract),
+	})
+	require.NoError(s.t, err)
+}
+
 func (s *bridgeSuite) ACreditBatchExistsWithoutABatchContractEntry() {
 	s.creditBatchSetup()
 }
@@ -176,6 +197,19 @@ func (s *bridgeSuite) ExpectAliceBatchBalance(a gocuke.DocString) {
 	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
 }
 
+func (s *bridgeSuite) ExpectBridgeEscrowBatchBalanceForTarget(a string, b gocuke.DocString) {
+	expected := &api.BatchBalance{}
+	err := jsonpb.UnmarshalString(b.Content, expected)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, base.BridgeEscrowAddress(a), s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.RetiredAmount, balance.RetiredAmount)
+	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
+	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
+}
+
 func (s *bridgeSuite) ExpectBatchSupply(a gocuke.DocString) {
 	expected := &api.BatchSupply{}
 	err := jsonpb.UnmarshalString(a.Content, expected)
@@ -214,7 +248,7 @@ func (s *bridgeSuite) creditBatchSetup() {
 	})
 	require.NoError(s.t, err)
 
-	err = s.k.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+	err = s.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
 		BatchKey:        bKey,
 		TradableAmount:  s.tradableAmount,
 		RetiredAmount:   "0",
@@ -239,6 +273,42 @@ func (s *bridgeSuite) AliceAttemptsToBridgeCreditAmountFromTheCreditBatchTo(a, b
 	})
 }
 
+func (s *bridgeSuite) AliceHasBridgedCreditAmountFromTheCreditBatch(a string) {
+	s.AliceAttemptsToBridgeCreditAmountFromTheCreditBatch(a)
+	require.NoError(s.t, s.err)
+}
+
+func (s *bridgeSuite) ExpectTheResponseRequestIds(a string) {
+	ids := make([]string, 0, len(s.res.RequestIds))
+	for _, id := range s.res.RequestIds {
+		ids = append(ids, strconv.FormatUint(id, 10))
+	}
+	require.Equal(s.t, a, strings.Join(ids, ","))
+}
+
+func (s *bridgeSuite) ExpectBridgeRequestWithProperties(a int64, b gocuke.DocString) {
+	expected := &api.BridgeRequest{}
+	err := jsonpb.UnmarshalString(b.Content, expected)
+	require.NoError(s.t, err)
+
+	request, err := s.stateStore.BridgeRequestTable().Get(s.ctx, uint64(a))
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.BatchKey, request.BatchKey)
+	require.Equal(s.t, expected.Target, request.Target)
+	require.Equal(s.t, expected.Recipient, request.Recipient)
+	require.Equal(s.t, expected.Contract, request.Contract)
+	require.Equal(s.t, expected.Amount, request.Amount)
+	require.Equal(s.t, expected.Status, request.Status)
+	require.Equal(s.t, s.sdkCtx.BlockTime().Unix(), request.Timestamp.AsTime().Unix())
+}
+
+func (s *bridgeSuite) ExpectBridgeRequestOwnerAlice(a int64) {
+	request, err := s.stateStore.BridgeRequestTable().Get(s.ctx, uint64(a))
+	require.NoError(s.t, err)
+	require.Equal(s.t, s.alice.Bytes(), request.Owner)
+}
+
 func (s *bridgeSuite) ExpectEventWithProperties(a gocuke.DocString) {
 	var event types.EventBridge
 	err := json.Unmarshal([]byte(a.Content), &event)
@@ -64,6 +64,7 @@ func (k Keeper) Cancel(ctx context.Context, req *types.MsgCancel) (*types.MsgCan
 			TradableAmount: userBalTradable.String(),
 			RetiredAmount:  userBalance.RetiredAmount,
 			EscrowedAmount: userBalance.EscrowedAmount,
+			LockedAmount:   userBalance.LockedAmount,
 		}); err != nil {
 			return nil, err
 		}
@@ -77,6 +78,14 @@ func (k Keeper) Cancel(ctx context.Context, req *types.MsgCancel) (*types.MsgCan
 			return nil, err
 		}
 
+		if err = CancelAggregateSupply(ctx, k.stateStore, batch.Key, amtToCancelDec); err != nil {
+			return nil, err
+		}
+
+		if err = CancelSerials(ctx, k.stateStore, batch.Key, owner, amtToCancelDec); err != nil {
+			return nil, err
+		}
+
 		if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventCancel{
 			Owner:      owner.String(),
 			BatchDenom: credit.BatchDenom,
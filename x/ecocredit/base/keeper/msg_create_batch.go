@@ -18,8 +18,21 @@ import (
 )
 
 // CreateBatch creates a new batch of credits.
+// Credits can only be issued for a project with an active status or a project without a status.
+// Credits cannot be issued within a credit class that is closed to issuance or deprecated.
 // Credits in the batch must not have more decimal places than the credit type's specified precision.
+// If the credit class has a buffer pool, a percentage of the tradable credits of each issuance is
+// sent to the buffer pool instead of the recipient. The total amount of credits issued must not
+// exceed the issuance caps of the credit class. Credit batches cannot be created directly for a
+// credit class that requires batch approval.
 func (k Keeper) CreateBatch(ctx context.Context, req *types.MsgCreateBatch) (*types.MsgCreateBatchResponse, error) {
+	return k.createBatch(ctx, req, false)
+}
+
+// createBatch creates a new batch of credits. The batch approval setting of the credit class is
+// only checked if the credit batch has not been approved by the admin or a verifier of the class
+// or received from another chain.
+func (k Keeper) createBatch(ctx context.Context, req *types.MsgCreateBatch, approved bool) (*types.MsgCreateBatchResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	project, err := k.stateStore.ProjectTable().GetById(ctx, req.ProjectId)
@@ -27,11 +40,25 @@ func (k Keeper) CreateBatch(ctx context.Context, req *types.MsgCreateBatch) (*ty
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
 	}
 
+	if err = AssertProjectCanIssue(ctx, k.stateStore, project); err != nil {
+		return nil, err
+	}
+
 	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
 	if err != nil {
 		return nil, err
 	}
 
+	if err = AssertClassCanIssue(ctx, k.stateStore, class); err != nil {
+		return nil, err
+	}
+
+	if !approved {
+		if err = k.assertBatchApprovalNotRequired(ctx, class); err != nil {
+			return nil, err
+		}
+	}
+
 	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
 	if err != nil {
 		return nil, err
@@ -76,6 +103,12 @@ func (k Keeper) CreateBatch(ctx context.Context, req *types.MsgCreateBatch) (*ty
 
 	tradableSupply, retiredSupply := math.NewDecFromInt64(0), math.NewDecFromInt64(0)
 
+	bufferPercentage, err := k.getBufferPercentage(ctx, class.Key)
+	if err != nil {
+		return nil, err
+	}
+	bufferPool := base.BufferPoolAddress(class.Id)
+
 	// set module address string once for better performance
 	moduleAddrString := k.moduleAddress.String()
 
@@ -87,6 +120,16 @@ func (k Keeper) CreateBatch(ctx context.Context, req *types.MsgCreateBatch) (*ty
 		}
 		tradableAmount, retiredAmount := decs[0], decs[1]
 
+		// withhold the buffer pool contribution from the tradable amount
+		bufferAmount, err := getBufferAmount(tradableAmount, bufferPercentage, maxDecimalPlaces)
+		if err != nil {
+			return nil, err
+		}
+		recipientTradable, err := math.SafeSubBalance(tradableAmount, bufferAmount)
+		if err != nil {
+			return nil, err
+		}
+
 		recipient, _ := sdk.AccAddressFromBech32(issuance.Recipient)
 
 		// get the current batch balance of the recipient account
@@ -113,7 +156,7 @@ func (k Keeper) CreateBatch(ctx context.Context, req *types.MsgCreateBatch) (*ty
 		}
 
 		// add tradable amount and retired amount to existing batch balance
-		tradableBalance, err = tradableBalance.Add(tradableAmount)
+		tradableBalance, err = tradableBalance.Add(recipientTradable)
 		if err != nil {
 			return nil, err
 		}
@@ -131,9 +174,26 @@ func (k Keeper) CreateBatch(ctx context.Context, req *types.MsgCreateBatch) (*ty
 			TradableAmount: tradableBalance.String(),
 			RetiredAmount:  retiredBalance.String(),
 			EscrowedAmount: balance.EscrowedAmount,
+			LockedAmount:   balance.LockedAmount,
 		}); err != nil {
 			return nil, err
 		}
+		if err = IndexBatchBalance(ctx, k.stateStore, recipient, batchKey); err != nil {
+			return nil, err
+		}
+		if err = IssueSerials(ctx, k.stateStore, batchKey, recipient, recipientTradable, api.SerialStatus_SERIAL_STATUS_TRADABLE); err != nil {
+			return nil, err
+		}
+		if err = IssueSerials(ctx, k.stateStore, batchKey, recipient, retiredAmount, api.SerialStatus_SERIAL_STATUS_RETIRED); err != nil {
+			return nil, err
+		}
+
+		// send the buffer pool contribution to the buffer pool of the class
+		if !bufferAmount.IsZero() {
+			if err = k.sendToBufferPool(ctx, bufferPool, batchKey, batchDenom, bufferAmount); err != nil {
+				return nil, err
+			}
+		}
 
 		// update tradable supply (updated in state at the end of the loop)
 		if !tradableAmount.IsZero() {
@@ -149,12 +209,24 @@ func (k Keeper) CreateBatch(ctx context.Context, req *types.MsgCreateBatch) (*ty
 			if err != nil {
 				return nil, err
 			}
+			if _, err = RecordRetirement(ctx, k.stateStore, k.nftKeeper, &api.Retirement{
+				Owner:        recipient,
+				BatchKey:     batchKey,
+				Amount:       retiredAmount.String(),
+				Jurisdiction: issuance.RetirementJurisdiction,
+				Reason:       issuance.RetirementReason,
+				Beneficiary:  issuance.RetirementBeneficiary,
+			}, batchDenom); err != nil {
+				return nil, err
+			}
 			// emit retired event only if retired amount is positive
 			if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventRetire{
 				Owner:        issuance.Recipient,
 				BatchDenom:   batchDenom,
 				Amount:       retiredAmount.String(),
 				Jurisdiction: issuance.RetirementJurisdiction,
+				Reason:       issuance.RetirementReason,
+				Beneficiary:  issuance.RetirementBeneficiary,
 			}); err != nil {
 				return nil, err
 			}
@@ -172,7 +244,7 @@ func (k Keeper) CreateBatch(ctx context.Context, req *types.MsgCreateBatch) (*ty
 			Sender:         moduleAddrString, // ecocredit module
 			Recipient:      issuance.Recipient,
 			BatchDenom:     batchDenom,
-			TradableAmount: tradableAmount.String(),
+			TradableAmount: recipientTradable.String(),
 			RetiredAmount:  retiredAmount.String(),
 		}); err != nil {
 			return nil, err
@@ -181,6 +253,14 @@ func (k Keeper) CreateBatch(ctx context.Context, req *types.MsgCreateBatch) (*ty
 		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgCreateBatch issuance iteration")
 	}
 
+	issuedAmount, err := tradableSupply.Add(retiredSupply)
+	if err != nil {
+		return nil, err
+	}
+	if err = TrackIssuance(ctx, k.stateStore, class, project, issuer, vintageYear(startDate), issuedAmount); err != nil {
+		return nil, err
+	}
+
 	if err = k.stateStore.BatchSupplyTable().Insert(ctx, &api.BatchSupply{
 		BatchKey:        batchKey,
 		TradableAmount:  tradableSupply.String(),
@@ -190,11 +270,19 @@ func (k Keeper) CreateBatch(ctx context.Context, req *types.MsgCreateBatch) (*ty
 		return nil, err
 	}
 
+	if err = AddAggregateSupply(ctx, k.stateStore, batchKey, tradableSupply, retiredSupply, math.NewDecFromInt64(0)); err != nil {
+		return nil, err
+	}
+
 	if req.OriginTx != nil {
 		if err = k.stateStore.OriginTxIndexTable().Insert(ctx, &api.OriginTxIndex{
-			ClassKey: project.ClassKey,
-			Id:       req.OriginTx.Id,
-			Source:   req.OriginTx.Source,
+			ClassKey:  project.ClassKey,
+			Id:        req.OriginTx.Id,
+			Source:    req.OriginTx.Source,
+			BatchKey:  batchKey,
+			Contract:  req.OriginTx.Contract,
+			Note:      req.OriginTx.Note,
+			Timestamp: issuanceDate,
 		}); err != nil {
 			if ormerrors.AlreadyExists.Is(err) {
 				return nil, sdkerrors.ErrInvalidRequest.Wrapf(
@@ -11,6 +11,7 @@ import (
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/regen-network/gocuke"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -144,6 +145,22 @@ func (s *createBatchSuite) AProjectWithProjectId(a string) {
 	s.projectKey = pKey
 }
 
+func (s *createBatchSuite) TheProjectHasStatus(a string) {
+	err := s.k.stateStore.ProjectLifecycleTable().Insert(s.ctx, &api.ProjectLifecycle{
+		ProjectKey: s.projectKey,
+		Status:     uint32(api.ProjectStatus_value[a]),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *createBatchSuite) TheCreditClassHasStatus(a string) {
+	err := s.k.stateStore.ClassLifecycleTable().Insert(s.ctx, &api.ClassLifecycle{
+		ClassKey: s.classKey,
+		Status:   uint32(api.ClassStatus_value[a]),
+	})
+	require.NoError(s.t, err)
+}
+
 func (s *createBatchSuite) ABatchSequenceWithProjectIdAndNextSequence(a string, b string) {
 	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
 	require.NoError(s.t, err)
@@ -332,6 +349,80 @@ func (s *createBatchSuite) ExpectRecipientBatchBalanceWithAddress(a string, b go
 	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
 }
 
+func (s *createBatchSuite) TheCreditClassHasBufferPercentage(a string) {
+	err := s.k.stateStore.ClassBufferTable().Insert(s.ctx, &api.ClassBuffer{
+		ClassKey:   s.classKey,
+		Percentage: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *createBatchSuite) TheCreditClassRequiresBatchApproval() {
+	err := s.k.stateStore.ClassBatchApprovalTable().Insert(s.ctx, &api.ClassBatchApproval{
+		ClassKey:     s.classKey,
+		ExpiryPeriod: durationpb.New(time.Hour),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *createBatchSuite) TheIssuerAliceHasMaxIssuanceAndIssuedAmount(a, b string) {
+	err := s.k.stateStore.ClassIssuerTable().Update(s.ctx, &api.ClassIssuer{
+		ClassKey:     s.classKey,
+		Issuer:       s.alice,
+		MaxIssuance:  a,
+		IssuedAmount: b,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *createBatchSuite) TheCreditClassHasMaxProjectVintageIssuance(a string) {
+	err := s.k.stateStore.ClassIssuanceCapTable().Insert(s.ctx, &api.ClassIssuanceCap{
+		ClassKey:                  s.classKey,
+		MaxProjectVintageIssuance: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *createBatchSuite) TheProjectHasIssuedAmountInVintageYear(a, b string) {
+	vintage, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectVintageIssuanceTable().Insert(s.ctx, &api.ProjectVintageIssuance{
+		ProjectKey:   s.projectKey,
+		VintageYear:  uint32(vintage),
+		IssuedAmount: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *createBatchSuite) ExpectTheIssuerAliceHasIssuedAmount(a string) {
+	classIssuer, err := s.k.stateStore.ClassIssuerTable().Get(s.ctx, s.classKey, s.alice)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, classIssuer.IssuedAmount)
+}
+
+func (s *createBatchSuite) ExpectTheProjectHasIssuedAmountInVintageYear(a, b string) {
+	vintage, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	vintageIssuance, err := s.k.stateStore.ProjectVintageIssuanceTable().Get(s.ctx, s.projectKey, uint32(vintage))
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, vintageIssuance.IssuedAmount)
+}
+
+func (s *createBatchSuite) ExpectBufferPoolTradableBalance(a string) {
+	class, err := s.stateStore.ClassTable().Get(s.ctx, s.classKey)
+	require.NoError(s.t, err)
+
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, s.res.BatchDenom)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, base.BufferPoolAddress(class.Id), batch.Key)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, a, balance.TradableAmount)
+}
+
 func (s *createBatchSuite) ExpectBatchSupply(a gocuke.DocString) {
 	var expected api.BatchSupply
 	err := jsonpb.UnmarshalString(a.Content, &expected)
@@ -381,6 +472,21 @@ func (s *createBatchSuite) ExpectBatchProperties(a gocuke.DocString) {
 	require.Equal(s.t, expected, *coreBatch)
 }
 
+func (s *createBatchSuite) ExpectOriginTxIndex(a gocuke.DocString) {
+	expected := &api.OriginTxIndex{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	index, err := s.k.stateStore.OriginTxIndexTable().Get(s.ctx, expected.ClassKey, expected.Id, expected.Source)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.BatchKey, index.BatchKey)
+	require.Equal(s.t, expected.Mint, index.Mint)
+	require.Equal(s.t, expected.Contract, index.Contract)
+	require.Equal(s.t, expected.Note, index.Note)
+	require.NotNil(s.t, index.Timestamp)
+}
+
 func (s *createBatchSuite) ExpectBatchContract(a gocuke.DocString) {
 	var expected types.BatchContract
 	err := jsonpb.UnmarshalString(a.Content, &expected)
@@ -12,7 +12,9 @@ import (
 	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
 )
 
-// CreateProject creates a new project for a specific credit class.
+// CreateProject creates a new project for a specific credit class. Projects
+// cannot be created within a credit class that is closed to new projects,
+// closed to issuance, or deprecated.
 func (k Keeper) CreateProject(ctx context.Context, req *types.MsgCreateProject) (*types.MsgCreateProjectResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	classInfo, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
@@ -30,6 +32,14 @@ func (k Keeper) CreateProject(ctx context.Context, req *types.MsgCreateProject)
 		return nil, err
 	}
 
+	if err = k.assertClassOpenToNewProjects(ctx, classInfo); err != nil {
+		return nil, err
+	}
+
+	if err = AssertValidJurisdiction(ctx, k.stateStore, req.Jurisdiction); err != nil {
+		return nil, err
+	}
+
 	projectID, err := k.genProjectID(ctx, classInfo.Key, classInfo.Id)
 	if err != nil {
 		return nil, err
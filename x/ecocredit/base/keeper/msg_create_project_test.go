@@ -75,6 +75,17 @@ func (s *createProjectSuite) AProjectSequenceWithClassIdAndNextSequence(a, b str
 	require.NoError(s.t, err)
 }
 
+func (s *createProjectSuite) CreditClassHasStatus(a, b string) {
+	class, err := s.k.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ClassLifecycleTable().Insert(s.ctx, &api.ClassLifecycle{
+		ClassKey: class.Key,
+		Status:   uint32(api.ClassStatus_value[b]),
+	})
+	require.NoError(s.t, err)
+}
+
 func (s *createProjectSuite) AProjectWithProjectIdAndReferenceId(a, b string) {
 	classID := base.GetClassIDFromProjectID(a)
 
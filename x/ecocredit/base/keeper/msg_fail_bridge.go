@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// FailBridge marks a pending bridge request as failed and returns the credits
+// to the owner. Locked credits are sent back from the bridge escrow account of
+// the target chain and cancelled credits are restored to the tradable balance
+// of the owner.
+func (k Keeper) FailBridge(ctx context.Context, req *types.MsgFailBridge) (*types.MsgFailBridgeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	request, err := k.getPendingBridgeRequest(ctx, req.Service, req.RequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().Get(ctx, request.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// a credit batch linked to a contract on another chain can never have had
+	// credits bridged by cancellation because only credit batches without a
+	// batch contract entry can be linked (see Msg/LinkBatchContract)
+	native, err := k.stateStore.NativeBatchContractTable().Has(ctx, batch.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	if native {
+		if err = k.sendEcocredits(sdkCtx, &types.MsgSend_SendCredits{
+			BatchDenom:     batch.Denom,
+			TradableAmount: request.Amount,
+			RetiredAmount:  "0",
+		}, request.Owner, base.BridgeEscrowAddress(request.Target)); err != nil {
+			return nil, err
+		}
+	} else {
+		if err = k.restoreCancelledCredits(ctx, batch, request.Owner, request.Amount); err != nil {
+			return nil, err
+		}
+	}
+
+	request.Status = uint32(api.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_FAILED)
+	if err = k.stateStore.BridgeRequestTable().Update(ctx, request); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventFailBridge{
+		RequestId: request.Id,
+		Reason:    req.Reason,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFailBridgeResponse{}, nil
+}
+
+// restoreCancelledCredits moves credits cancelled by the owner back into the
+// tradable supply and the tradable balance of the owner.
+func (k Keeper) restoreCancelledCredits(ctx context.Context, batch *api.Batch, owner sdk.AccAddress, amount string) error {
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+	if err != nil {
+		return err
+	}
+
+	amountDec, err := math.NewNonNegativeFixedDecFromString(amount, creditType.Precision)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return RestoreCancelledCredits(ctx, k.stateStore, batch, owner, amountDec)
+}
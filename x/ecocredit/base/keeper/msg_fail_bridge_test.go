@@ -0,0 +1,322 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type failBridgeSuite struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	carol    sdk.AccAddress
+	batchKey uint64
+	res      *types.MsgFailBridgeResponse
+	err      error
+}
+
+func TestFailBridge(t *testing.T) {
+	gocuke.NewRunner(t, &failBridgeSuite{}).Path("./features/msg_fail_bridge.feature").Run()
+}
+
+func (s *failBridgeSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+	s.carol = sdk.AccAddress("carol")
+}
+
+func (s *failBridgeSuite) TheTargetIsAnAllowedBridgeChain(a string) {
+	_, err := s.k.AddAllowedBridgeChain(s.ctx, &types.MsgAddAllowedBridgeChain{
+		Authority: s.authority.String(),
+		ChainName: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *failBridgeSuite) BobIsAnAllowedBridgeServiceFor(a string) {
+	s.addAllowedBridgeService(a, s.bob)
+}
+
+func (s *failBridgeSuite) CarolIsAnAllowedBridgeServiceFor(a string) {
+	s.addAllowedBridgeService(a, s.carol)
+}
+
+func (s *failBridgeSuite) ACreditBatchExistsWithABatchContractEntry() {
+	s.batchKey = bridgedBatchSetup(s.t, s.ctx, s.stateStore, s.alice, false)
+}
+
+func (s *failBridgeSuite) ASerializedCreditBatchExistsWithABatchContractEntry() {
+	s.batchKey = bridgedBatchSetup(s.t, s.ctx, s.stateStore, s.alice, true)
+}
+
+func (s *failBridgeSuite) ACreditBatchExistsWithANativeBatchContractEntryForTarget(a string) {
+	s.ACreditBatchExistsWithABatchContractEntry()
+
+	err := s.stateStore.NativeBatchContractTable().Insert(s.ctx, &api.NativeBatchContract{
+		BatchKey: s.batchKey,
+		Target:   a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *failBridgeSuite) AliceHasBridgedCreditAmountFromTheCreditBatchTo(a, b string) {
+	_, err := s.k.Bridge(s.ctx, &types.MsgBridge{
+		Owner:     s.alice.String(),
+		Target:    b,
+		Recipient: "0x71C7656EC7ab88b098defB751B7401B5f6d8976F",
+		Credits: []*types.Credits{
+			{
+				BatchDenom: testBatchDenom,
+				Amount:     a,
+			},
+		},
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *failBridgeSuite) BobHasAcknowledgedBridgeRequest(a int64) {
+	_, err := s.k.AcknowledgeBridge(s.ctx, &types.MsgAcknowledgeBridge{
+		Service:   s.bob.String(),
+		RequestId: uint64(a),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *failBridgeSuite) BobHasFailedBridgeRequest(a int64) {
+	s.BobAttemptsToFailBridgeRequest(a)
+	require.NoError(s.t, s.err)
+}
+
+func (s *failBridgeSuite) BobAttemptsToFailBridgeRequest(a int64) {
+	s.failBridge(s.bob, uint64(a), "")
+}
+
+func (s *failBridgeSuite) BobAttemptsToFailBridgeRequestWithReason(a int64, b string) {
+	s.failBridge(s.bob, uint64(a), b)
+}
+
+func (s *failBridgeSuite) AliceAttemptsToFailBridgeRequest(a int64) {
+	s.failBridge(s.alice, uint64(a), "")
+}
+
+func (s *failBridgeSuite) CarolAttemptsToFailBridgeRequest(a int64) {
+	s.failBridge(s.carol, uint64(a), "")
+}
+
+func (s *failBridgeSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *failBridgeSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *failBridgeSuite) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *failBridgeSuite) ExpectAliceBatchBalance(a gocuke.DocString) {
+	s.expectBatchBalance(s.alice, a)
+}
+
+func (s *failBridgeSuite) ExpectBridgeEscrowBatchBalanceForTarget(a string, b gocuke.DocString) {
+	s.expectBatchBalance(base.BridgeEscrowAddress(a), b)
+}
+
+func (s *failBridgeSuite) ExpectBatchSupply(a gocuke.DocString) {
+	expected := &api.BatchSupply{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	supply, err := s.stateStore.BatchSupplyTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.TradableAmount, supply.TradableAmount)
+	require.Equal(s.t, expected.RetiredAmount, supply.RetiredAmount)
+	require.Equal(s.t, expected.CancelledAmount, supply.CancelledAmount)
+}
+
+func (s *failBridgeSuite) ExpectProjectSupply(a gocuke.DocString) {
+	expected := &api.ProjectSupply{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	batch, err := s.stateStore.BatchTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+
+	supply, err := s.stateStore.ProjectSupplyTable().Get(s.ctx, batch.ProjectKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.TradableAmount, supply.TradableAmount)
+	require.Equal(s.t, expected.RetiredAmount, supply.RetiredAmount)
+	require.Equal(s.t, expected.CancelledAmount, supply.CancelledAmount)
+}
+
+func (s *failBridgeSuite) ExpectAliceOwnsTradableSerialNumbers(a string) {
+	s.expectSerialCount(api.SerialStatus_SERIAL_STATUS_TRADABLE, a)
+}
+
+func (s *failBridgeSuite) ExpectAliceOwnsCancelledSerialNumbers(a string) {
+	s.expectSerialCount(api.SerialStatus_SERIAL_STATUS_CANCELLED, a)
+}
+
+func (s *failBridgeSuite) ExpectBridgeRequestWithStatus(a int64, b string) {
+	request, err := s.stateStore.BridgeRequestTable().Get(s.ctx, uint64(a))
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, api.BridgeRequestStatus(request.Status).String())
+}
+
+func (s *failBridgeSuite) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventFailBridge
+	err := jsonpb.UnmarshalString(a.Content, &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *failBridgeSuite) addAllowedBridgeService(chainName string, address sdk.AccAddress) {
+	_, err := s.k.AddAllowedBridgeService(s.ctx, &types.MsgAddAllowedBridgeService{
+		Authority: s.authority.String(),
+		ChainName: chainName,
+		Address:   address.String(),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *failBridgeSuite) failBridge(service sdk.AccAddress, requestID uint64, reason string) {
+	s.res, s.err = s.k.FailBridge(s.ctx, &types.MsgFailBridge{
+		Service:   service.String(),
+		RequestId: requestID,
+		Reason:    reason,
+	})
+}
+
+func (s *failBridgeSuite) expectBatchBalance(address sdk.AccAddress, a gocuke.DocString) {
+	expected := &api.BatchBalance{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, address, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
+	require.Equal(s.t, expected.RetiredAmount, balance.RetiredAmount)
+	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
+}
+
+func (s *failBridgeSuite) expectSerialCount(status api.SerialStatus, a string) {
+	it, err := s.stateStore.SerialRangeTable().List(s.ctx, api.SerialRangeOwnerBatchKeyStatusIndexKey{}.WithOwnerBatchKeyStatus(
+		s.alice, s.batchKey, uint32(status),
+	))
+	require.NoError(s.t, err)
+	defer it.Close()
+
+	var count uint64
+	for it.Next() {
+		serialRange, err := it.Value()
+		require.NoError(s.t, err)
+		count += serialRange.End - serialRange.Start + 1
+	}
+
+	expected, err := math.NewNonNegativeDecFromString(a)
+	require.NoError(s.t, err)
+	require.Equal(s.t, expected.String(), math.NewDecFromInt64(int64(count)).String())
+}
+
+// bridgedBatchSetup inserts a credit batch with a batch contract entry allowed
+// for polygon and a tradable balance of 10 credits owned by the owner and
+// returns the batch key.
+func bridgedBatchSetup(t gocuke.TestingT, ctx context.Context, ss api.StateStore, owner sdk.AccAddress, serialized bool) uint64 {
+	err := ss.CreditTypeTable().Insert(ctx, &api.CreditType{
+		Abbreviation: "C",
+		Name:         "carbon",
+		Unit:         "metric ton C02 equivalent",
+	})
+	require.NoError(t, err)
+
+	classKey, err := ss.ClassTable().InsertReturningID(ctx, &api.Class{
+		Id:               testClassID,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(t, err)
+
+	if serialized {
+		err = ss.ClassSerializationTable().Insert(ctx, &api.ClassSerialization{ClassKey: classKey})
+		require.NoError(t, err)
+	}
+
+	projectKey, err := ss.ProjectTable().InsertReturningID(ctx, &api.Project{
+		Id:       testProjectID,
+		ClassKey: classKey,
+	})
+	require.NoError(t, err)
+
+	batchKey, err := ss.BatchTable().InsertReturningID(ctx, &api.Batch{
+		ProjectKey: projectKey,
+		Denom:      testBatchDenom,
+	})
+	require.NoError(t, err)
+
+	err = ss.BatchContractTable().Insert(ctx, &api.BatchContract{
+		BatchKey: batchKey,
+		ClassKey: classKey,
+		Contract: "0x6887246668a3b87f54deb3b94ba47a6f63f32985",
+	})
+	require.NoError(t, err)
+
+	err = ss.AllowedBridgeContractTable().Insert(ctx, &api.AllowedBridgeContract{
+		ChainName: "polygon",
+		Contract:  "0x6887246668a3b87f54deb3b94ba47a6f63f32985",
+	})
+	require.NoError(t, err)
+
+	err = ss.BatchSupplyTable().Insert(ctx, &api.BatchSupply{
+		BatchKey:        batchKey,
+		TradableAmount:  "10",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(t, err)
+
+	err = ss.ProjectSupplyTable().Insert(ctx, &api.ProjectSupply{
+		ProjectKey:      projectKey,
+		TradableAmount:  "10",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(t, err)
+
+	err = ss.BatchBalanceTable().Insert(ctx, &api.BatchBalance{
+		BatchKey:       batchKey,
+		Address:        owner,
+		TradableAmount: "10",
+		RetiredAmount:  "0",
+		EscrowedAmount: "0",
+	})
+	require.NoError(t, err)
+
+	if serialized {
+		err = IssueSerials(ctx, ss, batchKey, owner, math.NewDecFromInt64(10), api.SerialStatus_SERIAL_STATUS_TRADABLE)
+		require.NoError(t, err)
+	}
+
+	return batchKey
+}
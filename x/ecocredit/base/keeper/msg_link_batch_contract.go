@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// LinkBatchContract links a credit batch issued on Regen Ledger to a contract
+// on an allowed bridge chain so that credits from the credit batch can be
+// bridged to the chain using the lock model.
+func (k Keeper) LinkBatchContract(ctx context.Context, req *types.MsgLinkBatchContract) (*types.MsgLinkBatchContractResponse, error) {
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get batch with denom %s: %s", req.BatchDenom, err,
+		)
+	}
+
+	project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	admin := sdk.AccAddress(class.Admin)
+	if !reqAddr.Equals(admin) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, class.Id,
+		)
+	}
+
+	target := strings.ToLower(req.Target)
+	exists, err := k.stateStore.AllowedBridgeChainTable().Has(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is not an authorized bridge target", req.Target)
+	}
+
+	if err = k.assertBridgeContract(ctx, req.Target, req.Contract); err != nil {
+		return nil, err
+	}
+
+	// credit batches created by a bridge operation are already linked to the
+	// contract on the source chain
+	batchContract, err := k.stateStore.BatchContractTable().Get(ctx, batch.Key)
+	if err == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"credit batch %s is already linked to contract %s", req.BatchDenom, batchContract.Contract,
+		)
+	}
+	if !ormerrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	// a contract is unique within the scope of a credit class
+	exists, err = k.stateStore.BatchContractTable().HasByClassKeyContract(ctx, class.Key, req.Contract)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"contract %s is already linked to a credit batch within credit class %s", req.Contract, class.Id,
+		)
+	}
+
+	if err = k.stateStore.BatchContractTable().Insert(ctx, &api.BatchContract{
+		BatchKey: batch.Key,
+		ClassKey: class.Key,
+		Contract: req.Contract,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = k.stateStore.NativeBatchContractTable().Insert(ctx, &api.NativeBatchContract{
+		BatchKey: batch.Key,
+		Target:   target,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventLinkBatchContract{
+		BatchDenom: req.BatchDenom,
+		Target:     req.Target,
+		Contract:   req.Contract,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgLinkBatchContractResponse{}, nil
+}
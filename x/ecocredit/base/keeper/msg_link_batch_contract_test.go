@@ -0,0 +1,153 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type linkBatchContract struct {
+	*baseSuite
+	alice sdk.AccAddress
+	bob   sdk.AccAddress
+	res   *types.MsgLinkBatchContractResponse
+	err   error
+}
+
+func TestLinkBatchContract(t *testing.T) {
+	gocuke.NewRunner(t, &linkBatchContract{}).Path("./features/msg_link_batch_contract.feature").Run()
+}
+
+func (s *linkBatchContract) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *linkBatchContract) ACreditClassWithIdAndAdminAlice(a string) {
+	err := s.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:    a,
+		Admin: s.alice,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *linkBatchContract) AProjectWithIdInCreditClass(a, b string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: class.Key,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *linkBatchContract) ACreditBatchWithDenomFromProject(a, b string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BatchTable().Insert(s.ctx, &api.Batch{
+		Denom:      a,
+		ProjectKey: project.Key,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *linkBatchContract) TheChainIsAnAllowedBridgeChain(a string) {
+	err := s.stateStore.AllowedBridgeChainTable().Insert(s.ctx, &api.AllowedBridgeChain{
+		ChainName: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *linkBatchContract) TheContractIsAnAllowedBridgeContractForChain(a, b string) {
+	err := s.stateStore.AllowedBridgeContractTable().Insert(s.ctx, &api.AllowedBridgeContract{
+		ChainName: b,
+		Contract:  a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *linkBatchContract) CreditBatchHasBatchContract(a, b string) {
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	project, err := s.stateStore.ProjectTable().Get(s.ctx, batch.ProjectKey)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BatchContractTable().Insert(s.ctx, &api.BatchContract{
+		BatchKey: batch.Key,
+		ClassKey: project.ClassKey,
+		Contract: b,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *linkBatchContract) AliceHasLinkedCreditBatchToContractOnTarget(a, b, c string) {
+	s.linkBatchContract(s.alice, a, b, c)
+	require.NoError(s.t, s.err)
+}
+
+func (s *linkBatchContract) AliceAttemptsToLinkCreditBatchToContractOnTarget(a, b, c string) {
+	s.linkBatchContract(s.alice, a, b, c)
+}
+
+func (s *linkBatchContract) BobAttemptsToLinkCreditBatchToContractOnTarget(a, b, c string) {
+	s.linkBatchContract(s.bob, a, b, c)
+}
+
+func (s *linkBatchContract) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *linkBatchContract) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *linkBatchContract) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *linkBatchContract) ExpectCreditBatchIsLinkedToContractOnTarget(a, b, c string) {
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	batchContract, err := s.stateStore.BatchContractTable().Get(s.ctx, batch.Key)
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, batchContract.Contract)
+
+	nativeContract, err := s.stateStore.NativeBatchContractTable().Get(s.ctx, batch.Key)
+	require.NoError(s.t, err)
+	require.Equal(s.t, c, nativeContract.Target)
+}
+
+func (s *linkBatchContract) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventLinkBatchContract
+	err := jsonpb.UnmarshalString(a.Content, &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *linkBatchContract) linkBatchContract(admin sdk.AccAddress, batchDenom, contract, target string) {
+	s.res, s.err = s.k.LinkBatchContract(s.ctx, &types.MsgLinkBatchContract{
+		Admin:      admin.String(),
+		BatchDenom: batchDenom,
+		Target:     target,
+		Contract:   contract,
+	})
+}
@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// MigrateProject moves a project to a different credit class with the same
+// credit type. The project is assigned a new project id generated from the
+// sequence of the new credit class and the previous project id is stored as an
+// alias. Existing credit batches keep their denoms, and their batch contract
+// and origin tx index entries are moved to the new credit class.
+func (k Keeper) MigrateProject(ctx context.Context, req *types.MsgMigrateProject) (*types.MsgMigrateProjectResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	newClassAdmin, err := sdk.AccAddressFromBech32(req.NewClassAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !admin.Equals(sdk.AccAddress(class.Admin)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, class.Id,
+		)
+	}
+
+	newClass, err := k.stateStore.ClassTable().GetById(ctx, req.NewClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.NewClassId, err.Error())
+	}
+
+	if !newClassAdmin.Equals(sdk.AccAddress(newClass.Admin)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.NewClassAdmin, newClass.Id,
+		)
+	}
+
+	if newClass.Key == class.Key {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"project %s is already in credit class %s", project.Id, newClass.Id,
+		)
+	}
+
+	if err = k.assertClassOpenToNewProjects(ctx, newClass); err != nil {
+		return nil, err
+	}
+
+	if newClass.CreditTypeAbbrev != class.CreditTypeAbbrev {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"credit type of credit class %s does not match credit type of credit class %s: expected %s, got %s",
+			newClass.Id, class.Id, class.CreditTypeAbbrev, newClass.CreditTypeAbbrev,
+		)
+	}
+
+	if err = k.assertSameSerialization(ctx, project, class, newClass); err != nil {
+		return nil, err
+	}
+
+	// check if non-empty reference id is unique within the scope of the new credit class
+	if err = k.verifyReferenceID(ctx, newClass.Key, project.ReferenceId); err != nil {
+		return nil, err
+	}
+
+	newProjectID, err := k.genProjectID(ctx, newClass.Key, newClass.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.moveClassSupply(ctx, project.Key, class.Key, newClass.Key); err != nil {
+		return nil, err
+	}
+
+	if err = k.reindexProjectBalances(ctx, project.Key, newClass.Key); err != nil {
+		return nil, err
+	}
+
+	if err = k.reindexProjectBridgeEntries(ctx, project.Key, class.Key, newClass); err != nil {
+		return nil, err
+	}
+
+	oldProjectID := project.Id
+	project.Id = newProjectID
+	project.ClassKey = newClass.Key
+	if err = k.stateStore.ProjectTable().Update(ctx, project); err != nil {
+		return nil, err
+	}
+
+	if err = k.stateStore.ProjectAliasTable().Insert(ctx, &api.ProjectAlias{
+		Alias:      oldProjectID,
+		ProjectKey: project.Key,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventMigrateProject{
+		OldProjectId: oldProjectID,
+		NewProjectId: newProjectID,
+		NewClassId:   newClass.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMigrateProjectResponse{
+		ProjectId: newProjectID,
+	}, nil
+}
+
+// getProjectByIDOrAlias gets a project by project id or, if no project exists
+// with the project id, by a previous project id of a migrated project.
+func (k Keeper) getProjectByIDOrAlias(ctx context.Context, projectID string) (*api.Project, error) {
+	project, err := k.stateStore.ProjectTable().GetById(ctx, projectID)
+	if err == nil || !ormerrors.NotFound.Is(err) {
+		return project, err
+	}
+
+	alias, aliasErr := k.stateStore.ProjectAliasTable().Get(ctx, projectID)
+	if aliasErr != nil {
+		if ormerrors.NotFound.Is(aliasErr) {
+			return nil, err
+		}
+		return nil, aliasErr
+	}
+
+	return k.stateStore.ProjectTable().Get(ctx, alias.ProjectKey)
+}
+
+// reindexProjectBridgeEntries moves the batch contract and origin tx index
+// entries of the credit batches of the project to the new credit class. Both
+// are scoped to a credit class, so bridge operations and duplicate origin tx
+// checks for the credit batches apply within the new credit class.
+func (k Keeper) reindexProjectBridgeEntries(ctx context.Context, projectKey, classKey uint64, newClass *api.Class) error {
+	bItr, err := k.stateStore.BatchTable().List(ctx, api.BatchProjectKeyIndexKey{}.WithProjectKey(projectKey))
+	if err != nil {
+		return err
+	}
+
+	batchKeys := make(map[uint64]bool)
+	for bItr.Next() {
+		batch, err := bItr.Value()
+		if err != nil {
+			bItr.Close()
+			return err
+		}
+		batchKeys[batch.Key] = true
+	}
+	bItr.Close()
+
+	for batchKey := range batchKeys {
+		batchContract, err := k.stateStore.BatchContractTable().Get(ctx, batchKey)
+		if err != nil {
+			if ormerrors.NotFound.Is(err) {
+				continue
+			}
+			return err
+		}
+
+		batchContract.ClassKey = newClass.Key
+		if err = k.stateStore.BatchContractTable().Update(ctx, batchContract); err != nil {
+			if ormerrors.UniqueKeyViolation.Is(err) {
+				return sdkerrors.ErrInvalidRequest.Wrapf(
+					"contract %s is already linked to a credit batch within credit class %s",
+					batchContract.Contract, newClass.Id,
+				)
+			}
+			return err
+		}
+	}
+
+	oItr, err := k.stateStore.OriginTxIndexTable().List(ctx, api.OriginTxIndexClassKeyIdSourceIndexKey{}.WithClassKey(classKey))
+	if err != nil {
+		return err
+	}
+
+	var indexes []*api.OriginTxIndex
+	for oItr.Next() {
+		index, err := oItr.Value()
+		if err != nil {
+			oItr.Close()
+			return err
+		}
+		if batchKeys[index.BatchKey] {
+			indexes = append(indexes, index)
+		}
+	}
+	oItr.Close()
+
+	for _, index := range indexes {
+		if err = k.stateStore.OriginTxIndexTable().Delete(ctx, index); err != nil {
+			return err
+		}
+
+		index.ClassKey = newClass.Key
+		if err = k.stateStore.OriginTxIndexTable().Insert(ctx, index); err != nil {
+			if ormerrors.AlreadyExists.Is(err) {
+				return sdkerrors.ErrInvalidRequest.Wrapf(
+					"origin tx with id %s and source %s already exists within credit class %s",
+					index.Id, index.Source, newClass.Id,
+				)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,322 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type migrateProject struct {
+	*baseSuite
+	alice sdk.AccAddress
+	bob   sdk.AccAddress
+	res   *types.MsgMigrateProjectResponse
+	err   error
+}
+
+func TestMigrateProject(t *testing.T) {
+	gocuke.NewRunner(t, &migrateProject{}).Path("./features/msg_migrate_project.feature").Run()
+}
+
+func (s *migrateProject) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *migrateProject) ACreditClassWithIdAndAdminAlice(a string) {
+	s.creditClassWithAdmin(a, s.alice)
+}
+
+func (s *migrateProject) ACreditClassWithIdAndAdminBob(a string) {
+	s.creditClassWithAdmin(a, s.bob)
+}
+
+func (s *migrateProject) AProjectWithIdInCreditClass(a, b string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		Admin:    s.alice,
+		ClassKey: class.Key,
+	})
+	require.NoError(s.t, err)
+
+	seq, err := s.stateStore.ProjectSequenceTable().Get(s.ctx, class.Key)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.ProjectSequenceTable().Save(s.ctx, &api.ProjectSequence{
+		ClassKey:     class.Key,
+		NextSequence: seq.NextSequence + 1,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *migrateProject) ProjectHasReferenceId(a, b string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	project.ReferenceId = b
+	err = s.stateStore.ProjectTable().Update(s.ctx, project)
+	require.NoError(s.t, err)
+}
+
+func (s *migrateProject) CreditClassHasStatus(a, b string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.ClassLifecycleTable().Insert(s.ctx, &api.ClassLifecycle{
+		ClassKey: class.Key,
+		Status:   uint32(api.ClassStatus_value[b]),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *migrateProject) CreditClassIsSerialized(a string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.ClassSerializationTable().Insert(s.ctx, &api.ClassSerialization{
+		ClassKey: class.Key,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *migrateProject) CreditsHaveBeenIssuedWithinProject(a string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.ProjectSupplyTable().Insert(s.ctx, &api.ProjectSupply{
+		ProjectKey:     project.Key,
+		TradableAmount: "10",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *migrateProject) ACreditBatchWithDenomFromProject(a, b string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BatchTable().Insert(s.ctx, &api.Batch{
+		Denom:      a,
+		ProjectKey: project.Key,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *migrateProject) CreditBatchWasBridgedWithOriginTxAndContract(a, b, c string) {
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	project, err := s.stateStore.ProjectTable().Get(s.ctx, batch.ProjectKey)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.OriginTxIndexTable().Insert(s.ctx, &api.OriginTxIndex{
+		ClassKey: project.ClassKey,
+		Id:       b,
+		Source:   "polygon",
+		BatchKey: batch.Key,
+		Contract: c,
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BatchContractTable().Insert(s.ctx, &api.BatchContract{
+		BatchKey: batch.Key,
+		ClassKey: project.ClassKey,
+		Contract: c,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *migrateProject) AliceAndBobHaveMigratedProjectToCreditClass(a, b string) {
+	s.migrateProject(s.alice, s.bob, a, b)
+	require.NoError(s.t, s.err)
+}
+
+func (s *migrateProject) AliceAndBobAttemptToMigrateProjectToCreditClass(a, b string) {
+	s.migrateProject(s.alice, s.bob, a, b)
+}
+
+func (s *migrateProject) AliceAndAliceAttemptToMigrateProjectToCreditClass(a, b string) {
+	s.migrateProject(s.alice, s.alice, a, b)
+}
+
+func (s *migrateProject) BobAndAliceAttemptToMigrateProjectToCreditClass(a, b string) {
+	s.migrateProject(s.bob, s.alice, a, b)
+}
+
+func (s *migrateProject) BobAndBobAttemptToMigrateProjectToCreditClass(a, b string) {
+	s.migrateProject(s.bob, s.bob, a, b)
+}
+
+func (s *migrateProject) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *migrateProject) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *migrateProject) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *migrateProject) ExpectTheResponseProjectId(a string) {
+	require.NoError(s.t, s.err)
+	require.Equal(s.t, a, s.res.ProjectId)
+}
+
+func (s *migrateProject) ExpectProjectInCreditClass(a, b string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+	require.Equal(s.t, class.Key, project.ClassKey)
+
+	found, err := s.stateStore.ProjectTable().HasByClassKeyId(s.ctx, class.Key, a)
+	require.NoError(s.t, err)
+	require.True(s.t, found)
+}
+
+func (s *migrateProject) ExpectTheProjectSequenceOfCreditClassIs(a, b string) {
+	expected, err := strconv.ParseUint(b, 10, 64)
+	require.NoError(s.t, err)
+
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	seq, err := s.stateStore.ProjectSequenceTable().Get(s.ctx, class.Key)
+	require.NoError(s.t, err)
+	require.Equal(s.t, expected, seq.NextSequence)
+}
+
+func (s *migrateProject) ExpectAliasForProject(a, b string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	alias, err := s.stateStore.ProjectAliasTable().Get(s.ctx, a)
+	require.NoError(s.t, err)
+	require.Equal(s.t, project.Key, alias.ProjectKey)
+}
+
+func (s *migrateProject) ExpectCreditBatchFromProject(a, b string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+	require.Equal(s.t, project.Key, batch.ProjectKey)
+}
+
+func (s *migrateProject) ExpectTheCreditClassOfCreditBatchIs(a, b string) {
+	classID, err := s.k.BatchClassID(s.sdkCtx, a)
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, classID)
+}
+
+func (s *migrateProject) ExpectContractWithinCreditClass(a, b string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.BatchContractTable().HasByClassKeyContract(s.ctx, class.Key, a)
+	require.NoError(s.t, err)
+	require.True(s.t, found)
+}
+
+func (s *migrateProject) ExpectNoContractWithinCreditClass(a, b string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.BatchContractTable().HasByClassKeyContract(s.ctx, class.Key, a)
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *migrateProject) ExpectOriginTxWithinCreditClass(a, b string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.OriginTxIndexTable().Has(s.ctx, class.Key, a, "polygon")
+	require.NoError(s.t, err)
+	require.True(s.t, found)
+}
+
+func (s *migrateProject) ExpectNoOriginTxWithinCreditClass(a, b string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.OriginTxIndexTable().Has(s.ctx, class.Key, a, "polygon")
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *migrateProject) ExpectQueryingProjectReturnsProject(a, b string) {
+	res, err := s.k.Project(s.ctx, &types.QueryProjectRequest{ProjectId: a})
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, res.Project.Id)
+}
+
+func (s *migrateProject) ExpectQueryingProjectsByCreditClassReturnsProject(a, b string) {
+	expected, err := strconv.Atoi(b)
+	require.NoError(s.t, err)
+
+	res, err := s.k.ProjectsByClass(s.ctx, &types.QueryProjectsByClassRequest{ClassId: a})
+	require.NoError(s.t, err)
+	require.Len(s.t, res.Projects, expected)
+
+	for _, project := range res.Projects {
+		require.Equal(s.t, a, project.ClassId)
+	}
+}
+
+func (s *migrateProject) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventMigrateProject
+	err := jsonpb.UnmarshalString(a.Content, &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *migrateProject) creditClassWithAdmin(classID string, admin sdk.AccAddress) {
+	err := s.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               classID,
+		Admin:            admin,
+		CreditTypeAbbrev: base.GetCreditTypeAbbrevFromClassID(classID),
+	})
+	require.NoError(s.t, err)
+
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, classID)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.ProjectSequenceTable().Insert(s.ctx, &api.ProjectSequence{
+		ClassKey:     class.Key,
+		NextSequence: 1,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *migrateProject) migrateProject(admin, newClassAdmin sdk.AccAddress, projectID, newClassID string) {
+	s.res, s.err = s.k.MigrateProject(s.ctx, &types.MsgMigrateProject{
+		Admin:         admin.String(),
+		ProjectId:     projectID,
+		NewClassId:    newClassID,
+		NewClassAdmin: newClassAdmin.String(),
+	})
+}
@@ -3,16 +3,26 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
 	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
-// MintBatchCredits issues additional credits from an open batch.
+// MintBatchCredits issues additional credits from an open batch. Credits can
+// only be issued for a project with an active status or a project without a
+// status, and cannot be issued within a credit class that is closed to
+// issuance or deprecated. If the credit class has a buffer pool, a percentage
+// of the tradable credits of each issuance is sent to the buffer pool instead
+// of the recipient. The total amount of credits minted must not exceed the
+// issuance caps of the credit class.
 func (k Keeper) MintBatchCredits(ctx context.Context, req *types.MsgMintBatchCredits) (*types.MsgMintBatchCreditsResponse, error) {
 	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
 	if err != nil {
@@ -33,10 +43,28 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *types.MsgMintBatchCre
 		return nil, err
 	}
 
+	if err = AssertProjectCanIssue(ctx, k.stateStore, project); err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = AssertClassCanIssue(ctx, k.stateStore, class); err != nil {
+		return nil, err
+	}
+
 	if err = k.stateStore.OriginTxIndexTable().Insert(ctx, &api.OriginTxIndex{
-		ClassKey: project.ClassKey,
-		Id:       req.OriginTx.Id,
-		Source:   req.OriginTx.Source,
+		ClassKey:  project.ClassKey,
+		Id:        req.OriginTx.Id,
+		Source:    req.OriginTx.Source,
+		BatchKey:  batch.Key,
+		Mint:      true,
+		Contract:  req.OriginTx.Contract,
+		Note:      req.OriginTx.Note,
+		Timestamp: timestamppb.New(sdk.UnwrapSDKContext(ctx).BlockTime()),
 	}); err != nil {
 		if ormerrors.AlreadyExists.Is(err) {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("credits already issued with tx id: %s", req.OriginTx.Id)
@@ -49,7 +77,14 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *types.MsgMintBatchCre
 		return nil, err
 	}
 	precision := ct.Precision
+
+	bufferPercentage, err := k.getBufferPercentage(ctx, class.Key)
+	if err != nil {
+		return nil, err
+	}
+	bufferPool := base.BufferPoolAddress(class.Id)
 	moduleAddrString := k.moduleAddress.String()
+	issuedAmount := math.NewDecFromInt64(0)
 	for _, iss := range req.Issuance {
 		sdkCtx := sdk.UnwrapSDKContext(ctx)
 		recipient, err := sdk.AccAddressFromBech32(iss.Recipient)
@@ -62,6 +97,25 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *types.MsgMintBatchCre
 		}
 		tradable, retired := decs[0], decs[1]
 
+		issuedAmount, err = issuedAmount.Add(tradable)
+		if err != nil {
+			return nil, err
+		}
+		issuedAmount, err = issuedAmount.Add(retired)
+		if err != nil {
+			return nil, err
+		}
+
+		// withhold the buffer pool contribution from the tradable amount
+		bufferAmount, err := getBufferAmount(tradable, bufferPercentage, precision)
+		if err != nil {
+			return nil, err
+		}
+		recipientTradable, err := math.SafeSubBalance(tradable, bufferAmount)
+		if err != nil {
+			return nil, err
+		}
+
 		balance, err := utils.GetBalance(ctx, k.stateStore.BatchBalanceTable(), recipient, batch.Key)
 		if err != nil {
 			return nil, err
@@ -87,11 +141,23 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *types.MsgMintBatchCre
 			if err != nil {
 				return nil, err
 			}
+			if _, err := RecordRetirement(ctx, k.stateStore, k.nftKeeper, &api.Retirement{
+				Owner:        recipient,
+				BatchKey:     batch.Key,
+				Amount:       retired.String(),
+				Jurisdiction: iss.RetirementJurisdiction,
+				Reason:       iss.RetirementReason,
+				Beneficiary:  iss.RetirementBeneficiary,
+			}, batch.Denom); err != nil {
+				return nil, err
+			}
 			if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventRetire{
 				Owner:        iss.Recipient,
 				BatchDenom:   req.BatchDenom,
 				Amount:       retired.String(),
 				Jurisdiction: iss.RetirementJurisdiction,
+				Reason:       iss.RetirementReason,
+				Beneficiary:  iss.RetirementBeneficiary,
 			}); err != nil {
 				return nil, err
 			}
@@ -99,7 +165,7 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *types.MsgMintBatchCre
 			supply.RetiredAmount = supplyRetired.String()
 		}
 		if !tradable.IsZero() {
-			balanceTradable, err = balanceTradable.Add(tradable)
+			balanceTradable, err = balanceTradable.Add(recipientTradable)
 			if err != nil {
 				return nil, err
 			}
@@ -111,7 +177,7 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *types.MsgMintBatchCre
 				Sender:         moduleAddrString, // ecocredit module
 				Recipient:      iss.Recipient,
 				BatchDenom:     req.BatchDenom,
-				TradableAmount: tradable.String(),
+				TradableAmount: recipientTradable.String(),
 				RetiredAmount:  retired.String(),
 			}); err != nil {
 				return nil, err
@@ -122,9 +188,28 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *types.MsgMintBatchCre
 		if err := k.stateStore.BatchBalanceTable().Save(ctx, balance); err != nil {
 			return nil, err
 		}
+		if err := IndexBatchBalance(ctx, k.stateStore, recipient, batch.Key); err != nil {
+			return nil, err
+		}
+		if err := IssueSerials(ctx, k.stateStore, batch.Key, recipient, recipientTradable, api.SerialStatus_SERIAL_STATUS_TRADABLE); err != nil {
+			return nil, err
+		}
+		if err := IssueSerials(ctx, k.stateStore, batch.Key, recipient, retired, api.SerialStatus_SERIAL_STATUS_RETIRED); err != nil {
+			return nil, err
+		}
 		if err := k.stateStore.BatchSupplyTable().Update(ctx, supply); err != nil {
 			return nil, err
 		}
+		if err := AddAggregateSupply(ctx, k.stateStore, batch.Key, tradable, retired, math.NewDecFromInt64(0)); err != nil {
+			return nil, err
+		}
+
+		// send the buffer pool contribution to the buffer pool of the class
+		if !bufferAmount.IsZero() {
+			if err := k.sendToBufferPool(ctx, bufferPool, batch.Key, batch.Denom, bufferAmount); err != nil {
+				return nil, err
+			}
+		}
 
 		if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMint{
 			BatchDenom:     batch.Denom,
@@ -135,6 +220,10 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *types.MsgMintBatchCre
 		}
 	}
 
+	if err = TrackIssuance(ctx, k.stateStore, class, project, issuer, vintageYear(batch.StartDate), issuedAmount); err != nil {
+		return nil, err
+	}
+
 	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMintBatchCredits{
 		BatchDenom: batch.Denom,
 		OriginTx:   req.OriginTx,
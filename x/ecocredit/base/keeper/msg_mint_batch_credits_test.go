@@ -10,10 +10,12 @@ import (
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/regen-network/gocuke"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/types/testutil"
 	"github.com/regen-network/regen-ledger/x/ecocredit/base"
 	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
@@ -83,6 +85,22 @@ func (s *mintBatchCredits) AProjectWithId(a string) {
 	s.projectKey = pKey
 }
 
+func (s *mintBatchCredits) TheProjectHasStatus(a string) {
+	err := s.k.stateStore.ProjectLifecycleTable().Insert(s.ctx, &api.ProjectLifecycle{
+		ProjectKey: s.projectKey,
+		Status:     uint32(api.ProjectStatus_value[a]),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *mintBatchCredits) TheCreditClassHasStatus(a string) {
+	err := s.k.stateStore.ClassLifecycleTable().Insert(s.ctx, &api.ClassLifecycle{
+		ClassKey: s.classKey,
+		Status:   uint32(api.ClassStatus_value[a]),
+	})
+	require.NoError(s.t, err)
+}
+
 func (s *mintBatchCredits) ACreditBatchWithDenomAndIssuerAlice(a string) {
 	projectID := base.GetProjectIDFromBatchDenom(a)
 
@@ -139,6 +157,75 @@ func (s *mintBatchCredits) ACreditBatchWithDenomOpenAndIssuerAlice(a, b string)
 	s.batchDenom = a
 }
 
+func (s *mintBatchCredits) ACreditBatchWithDenomIssuerAliceAndStartDate(a, b string) {
+	startDate, err := regentypes.ParseDate("start date", b)
+	require.NoError(s.t, err)
+
+	bKey, err := s.k.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: s.projectKey,
+		Issuer:     s.alice,
+		Denom:      a,
+		StartDate:  timestamppb.New(startDate),
+		Open:       true,
+	})
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:        bKey,
+		TradableAmount:  "0",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(s.t, err)
+
+	s.batchDenom = a
+}
+
+func (s *mintBatchCredits) TheIssuerAliceHasMaxIssuanceAndIssuedAmount(a, b string) {
+	err := s.k.stateStore.ClassIssuerTable().Update(s.ctx, &api.ClassIssuer{
+		ClassKey:     s.classKey,
+		Issuer:       s.alice,
+		MaxIssuance:  a,
+		IssuedAmount: b,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *mintBatchCredits) TheCreditClassHasMaxProjectVintageIssuance(a string) {
+	err := s.k.stateStore.ClassIssuanceCapTable().Insert(s.ctx, &api.ClassIssuanceCap{
+		ClassKey:                  s.classKey,
+		MaxProjectVintageIssuance: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *mintBatchCredits) TheProjectHasIssuedAmountInVintageYear(a, b string) {
+	vintage, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectVintageIssuanceTable().Insert(s.ctx, &api.ProjectVintageIssuance{
+		ProjectKey:   s.projectKey,
+		VintageYear:  uint32(vintage),
+		IssuedAmount: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *mintBatchCredits) ExpectTheIssuerAliceHasIssuedAmount(a string) {
+	classIssuer, err := s.k.stateStore.ClassIssuerTable().Get(s.ctx, s.classKey, s.alice)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, classIssuer.IssuedAmount)
+}
+
+func (s *mintBatchCredits) ExpectTheProjectHasIssuedAmountInVintageYear(a, b string) {
+	vintage, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	vintageIssuance, err := s.k.stateStore.ProjectVintageIssuanceTable().Get(s.ctx, s.projectKey, uint32(vintage))
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, vintageIssuance.IssuedAmount)
+}
+
 func (s *mintBatchCredits) AnOriginTxIndex(a gocuke.DocString) {
 	originTxIndex := &api.OriginTxIndex{}
 	err := jsonpb.UnmarshalString(a.Content, originTxIndex)
@@ -222,6 +309,21 @@ func (s *mintBatchCredits) AliceAttemptsToMintCreditsWithBatchDenomRecipientBobA
 	})
 }
 
+func (s *mintBatchCredits) ExpectOriginTxIndex(a gocuke.DocString) {
+	expected := &api.OriginTxIndex{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	index, err := s.k.stateStore.OriginTxIndexTable().Get(s.ctx, expected.ClassKey, expected.Id, expected.Source)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.BatchKey, index.BatchKey)
+	require.Equal(s.t, expected.Mint, index.Mint)
+	require.Equal(s.t, expected.Contract, index.Contract)
+	require.Equal(s.t, expected.Note, index.Note)
+	require.NotNil(s.t, index.Timestamp)
+}
+
 func (s *mintBatchCredits) ExpectNoError() {
 	require.NoError(s.t, s.err)
 }
@@ -230,6 +332,10 @@ func (s *mintBatchCredits) ExpectTheError(a string) {
 	require.EqualError(s.t, s.err, a)
 }
 
+func (s *mintBatchCredits) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
 func (s *mintBatchCredits) ExpectBobBatchBalance(a gocuke.DocString) {
 	var expected api.BatchBalance
 	err := jsonpb.UnmarshalString(a.Content, &expected)
@@ -246,6 +352,27 @@ func (s *mintBatchCredits) ExpectBobBatchBalance(a gocuke.DocString) {
 	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
 }
 
+func (s *mintBatchCredits) TheCreditClassHasBufferPercentage(a string) {
+	err := s.k.stateStore.ClassBufferTable().Insert(s.ctx, &api.ClassBuffer{
+		ClassKey:   s.classKey,
+		Percentage: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *mintBatchCredits) ExpectBufferPoolTradableBalance(a string) {
+	class, err := s.stateStore.ClassTable().Get(s.ctx, s.classKey)
+	require.NoError(s.t, err)
+
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, s.batchDenom)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, base.BufferPoolAddress(class.Id), batch.Key)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, a, balance.TradableAmount)
+}
+
 func (s *mintBatchCredits) ExpectBatchSupply(a gocuke.DocString) {
 	var expected api.BatchSupply
 	err := jsonpb.UnmarshalString(a.Content, &expected)
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// ProposeBatch proposes a new batch of credits for a credit class that requires
+// batch approval. The proposed credit batch is stored as a pending batch until
+// it is approved or rejected by the admin or a verifier of the credit class, or
+// until it expires at the end of the expiry period of the credit class.
+func (k Keeper) ProposeBatch(ctx context.Context, req *types.MsgProposeBatch) (*types.MsgProposeBatchResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	project, err := k.stateStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	if err = AssertProjectCanIssue(ctx, k.stateStore, project); err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = AssertClassCanIssue(ctx, k.stateStore, class); err != nil {
+		return nil, err
+	}
+
+	batchApproval, err := k.stateStore.ClassBatchApprovalTable().Get(ctx, class.Key)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"credit class %s does not require batch approval", class.Id,
+			)
+		}
+		return nil, err
+	}
+
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.assertClassIssuer(ctx, class.Key, issuer); err != nil {
+		return nil, err
+	}
+
+	creditType, err := k.stateStore.CreditTypeTable().Get(ctx, class.CreditTypeAbbrev)
+	if err != nil {
+		return nil, err
+	}
+
+	// check decimal places when proposed rather than when approved
+	for _, issuance := range req.Issuance {
+		_, err := utils.GetNonNegativeFixedDecs(creditType.Precision, issuance.TradableAmount, issuance.RetiredAmount)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
+	expiration := sdkCtx.BlockTime().Add(batchApproval.ExpiryPeriod.AsDuration())
+	id, err := k.stateStore.PendingBatchTable().InsertReturningID(ctx, &api.PendingBatch{
+		ClassKey:   class.Key,
+		ProjectKey: project.Key,
+		Issuer:     issuer,
+		Issuance:   pendingBatchIssuance(req.Issuance),
+		Metadata:   req.Metadata,
+		StartDate:  timestamppb.New(*req.StartDate),
+		EndDate:    timestamppb.New(*req.EndDate),
+		Open:       req.Open,
+		OriginTx:   pendingBatchOriginTx(req.OriginTx),
+		Expiration: timestamppb.New(expiration),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventProposeBatch{
+		PendingBatchId: id,
+		ProjectId:      project.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeBatchResponse{PendingBatchId: id}, nil
+}
@@ -0,0 +1,201 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type proposeBatchSuite struct {
+	*baseSuite
+	alice            sdk.AccAddress
+	bob              sdk.AccAddress
+	creditTypeAbbrev string
+	classKey         uint64
+	projectKey       uint64
+	res              *types.MsgProposeBatchResponse
+	err              error
+}
+
+func TestProposeBatch(t *testing.T) {
+	gocuke.NewRunner(t, &proposeBatchSuite{}).Path("./features/msg_propose_batch.feature").Run()
+}
+
+func (s *proposeBatchSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *proposeBatchSuite) ACreditTypeWithAbbreviationAndPrecision(a, b string) {
+	precision, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+		Precision:    uint32(precision),
+	})
+	require.NoError(s.t, err)
+
+	s.creditTypeAbbrev = a
+}
+
+func (s *proposeBatchSuite) ACreditClassWithClassIdAndIssuerAlice(a string) {
+	var err error
+	s.classKey, err = s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+		ClassKey: s.classKey,
+		Issuer:   s.alice,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *proposeBatchSuite) AProjectWithProjectId(a string) {
+	var err error
+	s.projectKey, err = s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: s.classKey,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *proposeBatchSuite) TheCreditClassRequiresBatchApprovalWithExpiryPeriod(a string) {
+	expiryPeriod, err := time.ParseDuration(a)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.ClassBatchApprovalTable().Insert(s.ctx, &api.ClassBatchApproval{
+		ClassKey:     s.classKey,
+		ExpiryPeriod: durationpb.New(expiryPeriod),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *proposeBatchSuite) TheCreditClassDoesNotRequireBatchApproval() {
+	err := s.stateStore.ClassBatchApprovalTable().Delete(s.ctx, &api.ClassBatchApproval{
+		ClassKey: s.classKey,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *proposeBatchSuite) TheProjectHasStatus(a string) {
+	err := s.stateStore.ProjectLifecycleTable().Insert(s.ctx, &api.ProjectLifecycle{
+		ProjectKey: s.projectKey,
+		Status:     uint32(api.ProjectStatus_value[a]),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *proposeBatchSuite) TheBlockTime(a string) {
+	blockTime, err := time.Parse(time.RFC3339, a)
+	require.NoError(s.t, err)
+
+	s.setBlockTime(blockTime)
+}
+
+func (s *proposeBatchSuite) AliceAttemptsToProposeABatchWithProjectId(a string) {
+	s.proposeBatch(s.alice, a, "10")
+}
+
+func (s *proposeBatchSuite) BobAttemptsToProposeABatchWithProjectId(a string) {
+	s.proposeBatch(s.bob, a, "10")
+}
+
+func (s *proposeBatchSuite) AliceAttemptsToProposeABatchWithProjectIdAndTradableAmount(a, b string) {
+	s.proposeBatch(s.alice, a, b)
+}
+
+func (s *proposeBatchSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *proposeBatchSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *proposeBatchSuite) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *proposeBatchSuite) ExpectPendingBatchWithIdAndExpiration(a, b string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	expiration, err := time.Parse(time.RFC3339, b)
+	require.NoError(s.t, err)
+
+	pending, err := s.stateStore.PendingBatchTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+	require.Equal(s.t, s.classKey, pending.ClassKey)
+	require.Equal(s.t, s.projectKey, pending.ProjectKey)
+	require.Equal(s.t, s.alice.Bytes(), pending.Issuer)
+	require.Len(s.t, pending.Issuance, 1)
+	require.Equal(s.t, expiration, pending.Expiration.AsTime())
+}
+
+func (s *proposeBatchSuite) ExpectTheResponse(a gocuke.DocString) {
+	var res types.MsgProposeBatchResponse
+	err := jsonpb.UnmarshalString(a.Content, &res)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, &res, s.res)
+}
+
+func (s *proposeBatchSuite) ExpectNoCreditBatches() {
+	it, err := s.stateStore.BatchTable().List(s.ctx, api.BatchPrimaryKey{})
+	require.NoError(s.t, err)
+	defer it.Close()
+
+	require.False(s.t, it.Next())
+}
+
+func (s *proposeBatchSuite) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventProposeBatch
+	err := jsonpb.UnmarshalString(a.Content, &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *proposeBatchSuite) proposeBatch(issuer sdk.AccAddress, projectID, tradableAmount string) {
+	startDate, err := regentypes.ParseDate("start date", "2020-01-01")
+	require.NoError(s.t, err)
+
+	endDate, err := regentypes.ParseDate("end date", "2021-01-01")
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.ProposeBatch(s.ctx, &types.MsgProposeBatch{
+		Issuer:    issuer.String(),
+		ProjectId: projectID,
+		Issuance: []*types.BatchIssuance{
+			{
+				Recipient:      s.bob.String(),
+				TradableAmount: tradableAmount,
+			},
+		},
+		StartDate: &startDate,
+		EndDate:   &endDate,
+	})
+}
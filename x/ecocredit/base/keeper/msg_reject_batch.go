@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// RejectBatch rejects a pending batch and removes it from state. Only the admin
+// or a verifier of the credit class can reject a pending batch.
+func (k Keeper) RejectBatch(ctx context.Context, req *types.MsgRejectBatch) (*types.MsgRejectBatchResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	approver, err := sdk.AccAddressFromBech32(req.Approver)
+	if err != nil {
+		return nil, err
+	}
+
+	pending, _, err := k.getPendingBatch(ctx, req.PendingBatchId, approver)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.stateStore.PendingBatchTable().Delete(ctx, pending); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventRejectBatch{
+		PendingBatchId: pending.Id,
+		Reason:         req.Reason,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRejectBatchResponse{}, nil
+}
@@ -0,0 +1,64 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/testutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type rejectBatchSuite struct {
+	pendingBatchSuite
+	res *types.MsgRejectBatchResponse
+}
+
+func TestRejectBatch(t *testing.T) {
+	gocuke.NewRunner(t, &rejectBatchSuite{}).Path("./features/msg_reject_batch.feature").Run()
+}
+
+func (s *rejectBatchSuite) Before(t gocuke.TestingT) {
+	s.setup(t)
+}
+
+func (s *rejectBatchSuite) AliceAttemptsToRejectPendingBatch(a string) {
+	s.rejectBatch(s.alice, a, "")
+}
+
+func (s *rejectBatchSuite) BobAttemptsToRejectPendingBatch(a string) {
+	s.rejectBatch(s.bob, a, "")
+}
+
+func (s *rejectBatchSuite) CarolAttemptsToRejectPendingBatch(a string) {
+	s.rejectBatch(s.carol, a, "")
+}
+
+func (s *rejectBatchSuite) AliceAttemptsToRejectPendingBatchWithReason(a, b string) {
+	s.rejectBatch(s.alice, a, b)
+}
+
+func (s *rejectBatchSuite) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventRejectBatch
+	err := jsonpb.UnmarshalString(a.Content, &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *rejectBatchSuite) rejectBatch(approver sdk.AccAddress, id, reason string) {
+	s.res, s.err = s.k.RejectBatch(s.ctx, &types.MsgRejectBatch{
+		Approver:       approver.String(),
+		PendingBatchId: s.parseID(id),
+		Reason:         reason,
+	})
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func (k Keeper) RemoveAllowedBridgeContract(ctx context.Context, req *types.MsgRemoveAllowedBridgeContract) (*types.MsgRemoveAllowedBridgeContractResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority: expected %s, got %s", k.authority, req.Authority)
+	}
+
+	chainName := strings.ToLower(req.ChainName)
+
+	err := k.stateStore.AllowedBridgeContractTable().Delete(ctx, &api.AllowedBridgeContract{
+		ChainName: chainName,
+		Contract:  strings.ToLower(req.Contract),
+	})
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not delete bridge contract %s for chain name %s: %s", req.Contract, chainName, err.Error())
+	}
+
+	return &types.MsgRemoveAllowedBridgeContractResponse{}, nil
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type removeAllowedBridgeContractSuite struct {
+	*baseSuite
+	err error
+}
+
+func TestRemoveAllowedBridgeContract(t *testing.T) {
+	gocuke.NewRunner(t, &removeAllowedBridgeContractSuite{}).Path("./features/msg_remove_allowed_bridge_contract.feature").Run()
+}
+
+func (s *removeAllowedBridgeContractSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+}
+
+func (s *removeAllowedBridgeContractSuite) TheAuthorityAddress(a string) {
+	addr, err := sdk.AccAddressFromBech32(a)
+	require.NoError(s.t, err)
+	s.authority = addr
+}
+
+func (s *removeAllowedBridgeContractSuite) TheChainName(a string) {
+	_, err := s.k.AddAllowedBridgeChain(s.ctx, &types.MsgAddAllowedBridgeChain{
+		Authority: s.authority.String(),
+		ChainName: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *removeAllowedBridgeContractSuite) AllowedBridgeContractForChainName(a, b string) {
+	_, err := s.k.AddAllowedBridgeContract(s.ctx, &types.MsgAddAllowedBridgeContract{
+		Authority: s.authority.String(),
+		ChainName: b,
+		Contract:  a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *removeAllowedBridgeContractSuite) AliceAttemptsToRemoveAllowedBridgeContractWithProperties(a gocuke.DocString) {
+	var msg *types.MsgRemoveAllowedBridgeContract
+	err := json.Unmarshal([]byte(a.Content), &msg)
+	require.NoError(s.t, err)
+
+	_, s.err = s.k.RemoveAllowedBridgeContract(s.ctx, msg)
+}
+
+func (s *removeAllowedBridgeContractSuite) AliceAttemptsToRemoveBridgeContractForChainName(a, b string) {
+	_, s.err = s.k.RemoveAllowedBridgeContract(s.ctx, &types.MsgRemoveAllowedBridgeContract{
+		Authority: s.authority.String(),
+		ChainName: b,
+		Contract:  a,
+	})
+}
+
+func (s *removeAllowedBridgeContractSuite) ExpectBridgeContractForChainNameToNotExist(a, b string) {
+	found, err := s.stateStore.AllowedBridgeContractTable().Has(s.ctx, b, a)
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *removeAllowedBridgeContractSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *removeAllowedBridgeContractSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *removeAllowedBridgeContractSuite) ExpectTheErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type removeAllowedBridgeServiceSuite struct {
+	*baseSuite
+	err error
+}
+
+func TestRemoveAllowedBridgeService(t *testing.T) {
+	gocuke.NewRunner(t, &removeAllowedBridgeServiceSuite{}).Path("./features/msg_remove_allowed_bridge_service.feature").Run()
+}
+
+func (s *removeAllowedBridgeServiceSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+}
+
+func (s *removeAllowedBridgeServiceSuite) TheAuthorityAddress(a string) {
+	addr, err := sdk.AccAddressFromBech32(a)
+	require.NoError(s.t, err)
+	s.authority = addr
+}
+
+func (s *removeAllowedBridgeServiceSuite) TheChainName(a string) {
+	_, err := s.k.AddAllowedBridgeChain(s.ctx, &types.MsgAddAllowedBridgeChain{
+		Authority: s.authority.String(),
+		ChainName: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *removeAllowedBridgeServiceSuite) AllowedBridgeServiceForChainName(a, b string) {
+	_, err := s.k.AddAllowedBridgeService(s.ctx, &types.MsgAddAllowedBridgeService{
+		Authority: s.authority.String(),
+		ChainName: b,
+		Address:   a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *removeAllowedBridgeServiceSuite) AliceAttemptsToRemoveAllowedBridgeServiceWithProperties(a gocuke.DocString) {
+	var msg *types.MsgRemoveAllowedBridgeService
+	err := json.Unmarshal([]byte(a.Content), &msg)
+	require.NoError(s.t, err)
+
+	_, s.err = s.k.RemoveAllowedBridgeService(s.ctx, msg)
+}
+
+func (s *removeAllowedBridgeServiceSuite) AliceAttemptsToRemoveBridgeServiceForChainName(a, b string) {
+	_, s.err = s.k.RemoveAllowedBridgeService(s.ctx, &types.MsgRemoveAllowedBridgeService{
+		Authority: s.authority.String(),
+		ChainName: b,
+		Address:   a,
+	})
+}
+
+func (s *removeAllowedBridgeServiceSuite) ExpectBridgeServiceForChainNameToNotExist(a, b string) {
+	addr, err := sdk.AccAddressFromBech32(a)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.AllowedBridgeServiceTable().Has(s.ctx, b, addr)
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *removeAllowedBridgeServiceSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *removeAllowedBridgeServiceSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *removeAllowedBridgeServiceSuite) ExpectTheErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// RemoveNationalRegistry is a governance method that removes an account from
+// the national registries of a country.
+func (k Keeper) RemoveNationalRegistry(ctx context.Context, req *types.MsgRemoveNationalRegistry) (*types.MsgRemoveNationalRegistryResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority: expected %s, got %s", k.authority, req.Authority)
+	}
+
+	registryAddr, err := sdk.AccAddressFromBech32(req.Registry)
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := k.stateStore.NationalRegistryTable().Get(ctx, req.Country, registryAddr)
+	if err != nil {
+		if ormerrors.NotFound.Is(err) {
+			return nil, sdkerrors.ErrNotFound.Wrapf(
+				"national registry %s for country %s", req.Registry, req.Country,
+			)
+		}
+		return nil, err
+	}
+
+	if err := k.stateStore.NationalRegistryTable().Delete(ctx, registry); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveNationalRegistryResponse{}, nil
+}
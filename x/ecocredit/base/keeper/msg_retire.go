@@ -20,86 +20,120 @@ func (k Keeper) Retire(ctx context.Context, req *types.MsgRetire) (*types.MsgRet
 	owner, _ := sdk.AccAddressFromBech32(req.Owner)
 
 	for _, credit := range req.Credits {
-		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
-		if err != nil {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
-		}
-		creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
-		if err != nil {
+		if err := k.retireCredits(ctx, owner, credit, req.Jurisdiction, req.Reason, req.Beneficiary); err != nil {
 			return nil, err
 		}
-		userBalance, err := k.stateStore.BatchBalanceTable().Get(ctx, owner, batch.Key)
-		if err != nil {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get %s balance for %s: %s", batch.Denom, owner.String(), err.Error())
-		}
 
-		decs, err := utils.GetNonNegativeFixedDecs(creditType.Precision, credit.Amount, userBalance.TradableAmount)
-		if err != nil {
-			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
-		}
-		amtToRetire, userTradableBalance := decs[0], decs[1]
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgRetire credit iteration")
+	}
+	return &types.MsgRetireResponse{}, nil
+}
 
-		userTradableBalance, err = math.SafeSubBalance(userTradableBalance, amtToRetire)
-		if err != nil {
-			return nil, ecocredit.ErrInsufficientCredits.Wrapf(
-				"tradable balance: %s, retire amount %s", decs[1], amtToRetire,
-			)
-		}
-		userRetiredBalance, err := math.NewNonNegativeFixedDecFromString(userBalance.RetiredAmount, creditType.Precision)
-		if err != nil {
-			return nil, err
-		}
-		userRetiredBalance, err = userRetiredBalance.Add(amtToRetire)
-		if err != nil {
-			return nil, err
-		}
-		batchSupply, err := k.stateStore.BatchSupplyTable().Get(ctx, batch.Key)
-		if err != nil {
-			return nil, err
-		}
-		decs, err = utils.GetNonNegativeFixedDecs(creditType.Precision, batchSupply.RetiredAmount, batchSupply.TradableAmount)
-		if err != nil {
-			return nil, err
-		}
-		supplyRetired, supplyTradable := decs[0], decs[1]
-		supplyRetired, err = supplyRetired.Add(amtToRetire)
-		if err != nil {
-			return nil, err
-		}
-		supplyTradable, err = math.SafeSubBalance(supplyTradable, amtToRetire)
-		if err != nil {
-			return nil, err
-		}
+// retireCredits retires tradable credits of the owner and records the
+// retirement.
+func (k Keeper) retireCredits(ctx context.Context, owner sdk.AccAddress, credit *types.Credits, jurisdiction, reason, beneficiary string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-		if err = k.stateStore.BatchBalanceTable().Update(ctx, &api.BatchBalance{
-			BatchKey:       batch.Key,
-			Address:        owner,
-			TradableAmount: userTradableBalance.String(),
-			RetiredAmount:  userRetiredBalance.String(),
-			EscrowedAmount: userBalance.EscrowedAmount,
-		}); err != nil {
-			return nil, err
-		}
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
+	}
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+	if err != nil {
+		return err
+	}
+	userBalance, err := k.stateStore.BatchBalanceTable().Get(ctx, owner, batch.Key)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("could not get %s balance for %s: %s", batch.Denom, owner.String(), err.Error())
+	}
 
-		if err = k.stateStore.BatchSupplyTable().Update(ctx, &api.BatchSupply{
-			BatchKey:        batch.Key,
-			TradableAmount:  supplyTradable.String(),
-			RetiredAmount:   supplyRetired.String(),
-			CancelledAmount: batchSupply.CancelledAmount,
-		}); err != nil {
-			return nil, err
-		}
+	decs, err := utils.GetNonNegativeFixedDecs(creditType.Precision, credit.Amount, userBalance.TradableAmount)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	amtToRetire, userTradableBalance := decs[0], decs[1]
 
-		if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventRetire{
-			Owner:        req.Owner,
-			BatchDenom:   credit.BatchDenom,
-			Amount:       credit.Amount,
-			Jurisdiction: req.Jurisdiction,
-		}); err != nil {
-			return nil, err
-		}
+	userTradableBalance, err = math.SafeSubBalance(userTradableBalance, amtToRetire)
+	if err != nil {
+		return ecocredit.ErrInsufficientCredits.Wrapf(
+			"tradable balance: %s, retire amount %s", decs[1], amtToRetire,
+		)
+	}
+	userRetiredBalance, err := math.NewNonNegativeFixedDecFromString(userBalance.RetiredAmount, creditType.Precision)
+	if err != nil {
+		return err
+	}
+	userRetiredBalance, err = userRetiredBalance.Add(amtToRetire)
+	if err != nil {
+		return err
+	}
+	batchSupply, err := k.stateStore.BatchSupplyTable().Get(ctx, batch.Key)
+	if err != nil {
+		return err
+	}
+	decs, err = utils.GetNonNegativeFixedDecs(creditType.Precision, batchSupply.RetiredAmount, batchSupply.TradableAmount)
+	if err != nil {
+		return err
+	}
+	supplyRetired, supplyTradable := decs[0], decs[1]
+	supplyRetired, err = supplyRetired.Add(amtToRetire)
+	if err != nil {
+		return err
+	}
+	supplyTradable, err = math.SafeSubBalance(supplyTradable, amtToRetire)
+	if err != nil {
+		return err
+	}
 
-		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgRetire credit iteration")
+	if err = k.stateStore.BatchBalanceTable().Update(ctx, &api.BatchBalance{
+		BatchKey:       batch.Key,
+		Address:        owner,
+		TradableAmount: userTradableBalance.String(),
+		RetiredAmount:  userRetiredBalance.String(),
+		EscrowedAmount: userBalance.EscrowedAmount,
+		LockedAmount:   userBalance.LockedAmount,
+	}); err != nil {
+		return err
 	}
-	return &types.MsgRetireResponse{}, nil
+
+	if err = k.stateStore.BatchSupplyTable().Update(ctx, &api.BatchSupply{
+		BatchKey:        batch.Key,
+		TradableAmount:  supplyTradable.String(),
+		RetiredAmount:   supplyRetired.String(),
+		CancelledAmount: batchSupply.CancelledAmount,
+	}); err != nil {
+		return err
+	}
+
+	if err = RetireAggregateSupply(ctx, k.stateStore, batch.Key, amtToRetire); err != nil {
+		return err
+	}
+
+	if err = RetireSerials(ctx, k.stateStore, batch.Key, owner, amtToRetire); err != nil {
+		return err
+	}
+
+	if _, err = RecordRetirement(ctx, k.stateStore, k.nftKeeper, &api.Retirement{
+		Owner:        owner,
+		BatchKey:     batch.Key,
+		Amount:       amtToRetire.String(),
+		Jurisdiction: jurisdiction,
+		Reason:       reason,
+		Beneficiary:  beneficiary,
+	}, batch.Denom); err != nil {
+		return err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventRetire{
+		Owner:        owner.String(),
+		BatchDenom:   credit.BatchDenom,
+		Amount:       credit.Amount,
+		Jurisdiction: jurisdiction,
+		Reason:       reason,
+		Beneficiary:  beneficiary,
+	}); err != nil {
+		return err
+	}
+
+	return nil
 }
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// RetireFrom retires credits of the credit owner on behalf of the credit owner
+// and deducts the amount retired from the allowance of the spender.
+// WARNING: retiring credits is permanent. Retired credits cannot be un-retired.
+func (k Keeper) RetireFrom(ctx context.Context, req *types.MsgRetireFrom) (*types.MsgRetireFromResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	spender, err := sdk.AccAddressFromBech32(req.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, credit := range req.Credits {
+		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
+		}
+
+		creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		decs, err := utils.GetNonNegativeFixedDecs(creditType.Precision, credit.Amount)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+
+		if err := k.spendAllowance(ctx, owner, spender, batch, decs[0]); err != nil {
+			return nil, err
+		}
+
+		if err := k.retireCredits(ctx, owner, credit, req.Jurisdiction, req.Reason, req.Beneficiary); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgRetireFrom credit iteration")
+	}
+
+	return &types.MsgRetireFromResponse{}, nil
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type retireFrom struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	classKey uint64
+	batchKey uint64
+	res      *types.MsgRetireFromResponse
+	err      error
+}
+
+func TestRetireFrom(t *testing.T) {
+	gocuke.NewRunner(t, &retireFrom{}).Path("./features/msg_retire_from.feature").Run()
+}
+
+func (s *retireFrom) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *retireFrom) ACreditBatch() {
+	s.classKey, s.batchKey = allowanceBatchSetup(s.t, s.baseSuite)
+}
+
+func (s *retireFrom) AliceOwnsTradableCreditAmount(a string) {
+	err := s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        s.alice,
+		TradableAmount: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *retireFrom) AliceApprovedBobForBatchWithAmount(a string) {
+	err := s.stateStore.BatchAllowanceTable().Insert(s.ctx, &api.BatchAllowance{
+		Owner:    s.alice,
+		Spender:  s.bob,
+		BatchKey: s.batchKey,
+		Amount:   a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *retireFrom) AliceApprovedBobForClassWithAmount(a string) {
+	err := s.stateStore.ClassAllowanceTable().Insert(s.ctx, &api.ClassAllowance{
+		Owner:    s.alice,
+		Spender:  s.bob,
+		ClassKey: s.classKey,
+		Amount:   a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *retireFrom) BobAttemptsToRetireFromAliceCreditAmount(a string) {
+	s.res, s.err = s.k.RetireFrom(s.ctx, &types.MsgRetireFrom{
+		Spender: s.bob.String(),
+		Owner:   s.alice.String(),
+		Credits: []*types.Credits{
+			{
+				BatchDenom: testBatchDenom,
+				Amount:     a,
+			},
+		},
+		Jurisdiction: "US-WA",
+		Beneficiary:  "Acme Inc.",
+	})
+}
+
+func (s *retireFrom) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *retireFrom) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *retireFrom) ExpectBobBatchAllowance(a string) {
+	allowance, err := s.stateStore.BatchAllowanceTable().Get(s.ctx, s.alice, s.bob, s.batchKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, allowance.Amount)
+}
+
+func (s *retireFrom) ExpectBobClassAllowance(a string) {
+	allowance, err := s.stateStore.ClassAllowanceTable().Get(s.ctx, s.alice, s.bob, s.classKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, allowance.Amount)
+}
+
+func (s *retireFrom) ExpectBobHasNoClassAllowance() {
+	_, err := s.stateStore.ClassAllowanceTable().Get(s.ctx, s.alice, s.bob, s.classKey)
+	require.ErrorIs(s.t, err, ormerrors.NotFound)
+}
+
+func (s *retireFrom) ExpectAliceTradableBalanceAndRetiredBalance(a, b string) {
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.alice, s.batchKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, balance.TradableAmount)
+	require.Equal(s.t, b, balance.RetiredAmount)
+}
+
+func (s *retireFrom) ExpectRetirementOwnedByAliceWithAmount(a string) {
+	retirement, err := s.stateStore.RetirementTable().Get(s.ctx, 1)
+	require.NoError(s.t, err)
+	require.Equal(s.t, s.alice.Bytes(), retirement.Owner)
+	require.Equal(s.t, a, retirement.Amount)
+	require.Equal(s.t, "Acme Inc.", retirement.Beneficiary)
+}
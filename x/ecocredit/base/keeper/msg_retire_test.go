@@ -239,6 +239,22 @@ func (s *retire) AliceAttemptsToRetireCreditAmountFrom(a string, b string) {
 	require.NoError(s.t, s.err)
 }
 
+func (s *retire) AliceAttemptsToRetireCreditAmountFromWithReasonAndBeneficiary(a, b, c, d string) {
+	s.res, s.err = s.k.Retire(s.ctx, &types.MsgRetire{
+		Owner: s.alice.String(),
+		Credits: []*types.Credits{
+			{
+				BatchDenom: s.batchDenom,
+				Amount:     a,
+			},
+		},
+		Jurisdiction: b,
+		Reason:       c,
+		Beneficiary:  d,
+	})
+	require.NoError(s.t, s.err)
+}
+
 func (s *retire) ExpectEventWithProperties(a gocuke.DocString) {
 	var event types.EventRetire
 	err := json.Unmarshal([]byte(a.Content), &event)
@@ -252,6 +268,38 @@ func (s *retire) ExpectEventWithProperties(a gocuke.DocString) {
 	require.NoError(s.t, err)
 }
 
+func (s *retire) ExpectRetirementWithProperties(a gocuke.DocString) {
+	expected := &api.Retirement{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	retirement, err := s.stateStore.RetirementTable().Get(s.ctx, expected.Id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, s.alice.Bytes(), retirement.Owner)
+	require.Equal(s.t, s.batchKey, retirement.BatchKey)
+	require.Equal(s.t, expected.Amount, retirement.Amount)
+	require.Equal(s.t, expected.Jurisdiction, retirement.Jurisdiction)
+	require.Equal(s.t, expected.Reason, retirement.Reason)
+	require.Equal(s.t, expected.Beneficiary, retirement.Beneficiary)
+	require.NotNil(s.t, retirement.Timestamp)
+}
+
+func (s *retire) ExpectAliceOwnsRetirementCertificateWithProperties(a gocuke.DocString) {
+	expected := &api.RetirementCertificate{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	certificate, err := s.stateStore.RetirementCertificateTable().Get(s.ctx, expected.RetirementId)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.NftClassId, certificate.NftClassId)
+	require.Equal(s.t, expected.NftId, certificate.NftId)
+
+	owner := s.nftKeeper.GetOwner(s.sdkCtx, certificate.NftClassId, certificate.NftId)
+	require.Equal(s.t, s.alice, owner)
+}
+
 func (s *retire) projectSetup() {
 	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
 		Abbreviation: s.creditTypeAbbrev,
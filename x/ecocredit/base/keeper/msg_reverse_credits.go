@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// ReverseCredits cancels credits from the buffer pool of the class for a
+// reversal event and stores a record of the reversal.
+func (k Keeper) ReverseCredits(ctx context.Context, req *types.MsgReverseCredits) (*types.MsgReverseCreditsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	signer, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit class with id %s: %s", req.ClassId, err,
+		)
+	}
+
+	if !signer.Equals(sdk.AccAddress(class.Admin)) && !signer.Equals(k.authority) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s or the governance authority", req.Signer, req.ClassId,
+		)
+	}
+
+	bufferPool := base.BufferPoolAddress(class.Id)
+	bufferPoolString := bufferPool.String()
+
+	reversedCredits := make([]*api.Reversal_Credits, 0, len(req.Credits))
+	for _, credit := range req.Credits {
+		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
+		}
+
+		project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if project.ClassKey != class.Key {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"credit batch %s is not from credit class %s", batch.Denom, class.Id,
+			)
+		}
+
+		creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		poolBalance, err := utils.GetBalance(ctx, k.stateStore.BatchBalanceTable(), bufferPool, batch.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		batchSupply, err := k.stateStore.BatchSupplyTable().Get(ctx, batch.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		decs, err := utils.GetNonNegativeFixedDecs(creditType.Precision, credit.Amount, batchSupply.TradableAmount, poolBalance.TradableAmount, batchSupply.CancelledAmount)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+
+		amount, supplyTradable, poolTradable, supplyCancelled := decs[0], decs[1], decs[2], decs[3]
+
+		poolTradable, err = math.SafeSubBalance(poolTradable, amount)
+		if err != nil {
+			return nil, ecocredit.ErrInsufficientCredits.Wrapf(
+				"buffer pool tradable balance: %s, reverse amount %s", decs[2], amount,
+			)
+		}
+
+		supplyTradable, err = math.SafeSubBalance(supplyTradable, amount)
+		if err != nil {
+			return nil, err
+		}
+
+		supplyCancelled, err = math.SafeAddBalance(supplyCancelled, amount)
+		if err != nil {
+			return nil, err
+		}
+
+		poolBalance.TradableAmount = poolTradable.String()
+		if err = k.stateStore.BatchBalanceTable().Save(ctx, poolBalance); err != nil {
+			return nil, err
+		}
+
+		batchSupply.TradableAmount = supplyTradable.String()
+		batchSupply.CancelledAmount = supplyCancelled.String()
+		if err = k.stateStore.BatchSupplyTable().Update(ctx, batchSupply); err != nil {
+			return nil, err
+		}
+
+		if err = CancelAggregateSupply(ctx, k.stateStore, batch.Key, amount); err != nil {
+			return nil, err
+		}
+
+		if err = CancelSerials(ctx, k.stateStore, batch.Key, bufferPool, amount); err != nil {
+			return nil, err
+		}
+
+		reversedCredits = append(reversedCredits, &api.Reversal_Credits{
+			BatchKey: batch.Key,
+			Amount:   amount.String(),
+		})
+
+		if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventCancel{
+			Owner:      bufferPoolString,
+			BatchDenom: batch.Denom,
+			Amount:     amount.String(),
+			Reason:     req.Reason,
+		}); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgReverseCredits credit iteration")
+	}
+
+	reversalID, err := k.stateStore.ReversalTable().InsertReturningID(ctx, &api.Reversal{
+		ClassKey:  class.Key,
+		Name:      req.Name,
+		Reason:    req.Reason,
+		Signer:    signer,
+		Credits:   reversedCredits,
+		Timestamp: timestamppb.New(sdkCtx.BlockTime()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventReverseCredits{
+		ReversalId: reversalID,
+		ClassId:    class.Id,
+		Name:       req.Name,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReverseCreditsResponse{ReversalId: reversalID}, nil
+}
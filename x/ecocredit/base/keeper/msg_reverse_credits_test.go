@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type reverseCredits struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	classKey uint64
+	batchKey uint64
+	res      *types.MsgReverseCreditsResponse
+	err      error
+}
+
+func TestReverseCredits(t *testing.T) {
+	gocuke.NewRunner(t, &reverseCredits{}).Path("./features/msg_reverse_credits.feature").Run()
+}
+
+func (s *reverseCredits) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *reverseCredits) ACreditBatchFromACreditClassWithAdminAlice() {
+	s.classKey, s.batchKey = allowanceBatchSetup(s.t, s.baseSuite)
+
+	class, err := s.stateStore.ClassTable().Get(s.ctx, s.classKey)
+	require.NoError(s.t, err)
+
+	class.Admin = s.alice
+	require.NoError(s.t, s.stateStore.ClassTable().Update(s.ctx, class))
+
+	supply, err := s.stateStore.BatchSupplyTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+
+	supply.TradableAmount = "100"
+	err = s.stateStore.BatchSupplyTable().Save(s.ctx, supply)
+	require.NoError(s.t, err)
+}
+
+func (s *reverseCredits) TheProjectOfTheCreditBatchIsInCreditClass(a string) {
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(s.t, err)
+
+	batch, err := s.stateStore.BatchTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+
+	project, err := s.stateStore.ProjectTable().Get(s.ctx, batch.ProjectKey)
+	require.NoError(s.t, err)
+
+	project.ClassKey = classKey
+	require.NoError(s.t, s.stateStore.ProjectTable().Update(s.ctx, project))
+}
+
+func (s *reverseCredits) TheBufferPoolOwnsTradableCreditAmount(a string) {
+	err := s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        base.BufferPoolAddress(testClassID),
+		TradableAmount: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *reverseCredits) AliceAttemptsToReverseCreditsWithAmount(a string) {
+	s.reverseCredits(s.alice, a)
+}
+
+func (s *reverseCredits) BobAttemptsToReverseCreditsWithAmount(a string) {
+	s.reverseCredits(s.bob, a)
+}
+
+func (s *reverseCredits) TheGovernanceAuthorityAttemptsToReverseCreditsWithAmount(a string) {
+	s.reverseCredits(s.authority, a)
+}
+
+func (s *reverseCredits) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *reverseCredits) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *reverseCredits) ExpectBufferPoolTradableBalance(a string) {
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, base.BufferPoolAddress(testClassID), s.batchKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, balance.TradableAmount)
+}
+
+func (s *reverseCredits) ExpectBatchSupplyTradableAmountAndCancelledAmount(a, b string) {
+	supply, err := s.stateStore.BatchSupplyTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, supply.TradableAmount)
+	require.Equal(s.t, b, supply.CancelledAmount)
+}
+
+func (s *reverseCredits) ExpectReversalRecordWithNameAndAmount(a, b string) {
+	require.NoError(s.t, s.err)
+
+	reversal, err := s.stateStore.ReversalTable().Get(s.ctx, s.res.ReversalId)
+	require.NoError(s.t, err)
+	require.Equal(s.t, s.classKey, reversal.ClassKey)
+	require.Equal(s.t, a, reversal.Name)
+	require.Equal(s.t, s.alice.Bytes(), reversal.Signer)
+	require.Len(s.t, reversal.Credits, 1)
+	require.Equal(s.t, s.batchKey, reversal.Credits[0].BatchKey)
+	require.Equal(s.t, b, reversal.Credits[0].Amount)
+}
+
+func (s *reverseCredits) ExpectEventReverseCreditsWithProperties(a gocuke.DocString) {
+	var event types.EventReverseCredits
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *reverseCredits) reverseCredits(signer sdk.AccAddress, amount string) {
+	s.res, s.err = s.k.ReverseCredits(s.ctx, &types.MsgReverseCredits{
+		Signer:  signer.String(),
+		ClassId: testClassID,
+		Name:    "2023 Tahoe fire",
+		Credits: []*types.Credits{
+			{BatchDenom: testBatchDenom, Amount: amount},
+		},
+	})
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// RevokeApproval removes an allowance previously approved by the credit owner.
+func (k Keeper) RevokeApproval(ctx context.Context, req *types.MsgRevokeApproval) (*types.MsgRevokeApprovalResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	spender, err := sdk.AccAddressFromBech32(req.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.BatchDenom != "" {
+		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+		}
+
+		allowance, err := k.stateStore.BatchAllowanceTable().Get(ctx, owner, spender, batch.Key)
+		if err != nil {
+			return nil, sdkerrors.ErrNotFound.Wrapf("allowance for %s with credit batch %s: %s", req.Spender, req.BatchDenom, err.Error())
+		}
+
+		if err := k.stateStore.BatchAllowanceTable().Delete(ctx, allowance); err != nil {
+			return nil, err
+		}
+	} else {
+		class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+		}
+
+		allowance, err := k.stateStore.ClassAllowanceTable().Get(ctx, owner, spender, class.Key)
+		if err != nil {
+			return nil, sdkerrors.ErrNotFound.Wrapf("allowance for %s with credit class %s: %s", req.Spender, req.ClassId, err.Error())
+		}
+
+		if err := k.stateStore.ClassAllowanceTable().Delete(ctx, allowance); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventRevokeApproval{
+		Owner:      req.Owner,
+		Spender:    req.Spender,
+		BatchDenom: req.BatchDenom,
+		ClassId:    req.ClassId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeApprovalResponse{}, nil
+}
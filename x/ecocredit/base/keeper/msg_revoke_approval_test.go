@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type revokeApproval struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	classKey uint64
+	batchKey uint64
+	res      *types.MsgRevokeApprovalResponse
+	err      error
+}
+
+func TestRevokeApproval(t *testing.T) {
+	gocuke.NewRunner(t, &revokeApproval{}).Path("./features/msg_revoke_approval.feature").Run()
+}
+
+func (s *revokeApproval) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *revokeApproval) ACreditBatch() {
+	cKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id: testClassID,
+	})
+	require.NoError(s.t, err)
+
+	s.classKey = cKey
+
+	pKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       testProjectID,
+		ClassKey: cKey,
+	})
+	require.NoError(s.t, err)
+
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: pKey,
+		Denom:      testBatchDenom,
+	})
+	require.NoError(s.t, err)
+
+	s.batchKey = bKey
+}
+
+func (s *revokeApproval) AliceApprovedBobForBatchWithAmount(a string) {
+	err := s.stateStore.BatchAllowanceTable().Insert(s.ctx, &api.BatchAllowance{
+		Owner:    s.alice,
+		Spender:  s.bob,
+		BatchKey: s.batchKey,
+		Amount:   a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *revokeApproval) AliceApprovedBobForClassWithAmount(a string) {
+	err := s.stateStore.ClassAllowanceTable().Insert(s.ctx, &api.ClassAllowance{
+		Owner:    s.alice,
+		Spender:  s.bob,
+		ClassKey: s.classKey,
+		Amount:   a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *revokeApproval) AliceAttemptsToRevokeApprovalOfBobForBatch(a string) {
+	s.res, s.err = s.k.RevokeApproval(s.ctx, &types.MsgRevokeApproval{
+		Owner:      s.alice.String(),
+		Spender:    s.bob.String(),
+		BatchDenom: a,
+	})
+}
+
+func (s *revokeApproval) AliceAttemptsToRevokeApprovalOfBobForClass(a string) {
+	s.res, s.err = s.k.RevokeApproval(s.ctx, &types.MsgRevokeApproval{
+		Owner:   s.alice.String(),
+		Spender: s.bob.String(),
+		ClassId: a,
+	})
+}
+
+func (s *revokeApproval) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *revokeApproval) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *revokeApproval) ExpectBobHasNoBatchAllowance() {
+	_, err := s.stateStore.BatchAllowanceTable().Get(s.ctx, s.alice, s.bob, s.batchKey)
+	require.ErrorIs(s.t, err, ormerrors.NotFound)
+}
+
+func (s *revokeApproval) ExpectBobHasNoClassAllowance() {
+	_, err := s.stateStore.ClassAllowanceTable().Get(s.ctx, s.alice, s.bob, s.classKey)
+	require.ErrorIs(s.t, err, ormerrors.NotFound)
+}
@@ -121,9 +121,13 @@ func (k Keeper) sendEcocredits(sdkCtx sdk.Context, credit *types.MsgSend_SendCre
 		TradableAmount: toTradableBalance.String(),
 		RetiredAmount:  toRetiredBalance.String(),
 		EscrowedAmount: toBalance.EscrowedAmount,
+		LockedAmount:   toBalance.LockedAmount,
 	}); err != nil {
 		return err
 	}
+	if err := IndexBatchBalance(ctx, k.stateStore, to, batch.Key); err != nil {
+		return err
+	}
 
 	// update the "from" balance
 	if err := k.stateStore.BatchBalanceTable().Update(ctx, &api.BatchBalance{
@@ -132,9 +136,17 @@ func (k Keeper) sendEcocredits(sdkCtx sdk.Context, credit *types.MsgSend_SendCre
 		TradableAmount: fromTradableBalance.String(),
 		RetiredAmount:  fromRetiredBalance.String(),
 		EscrowedAmount: fromBalance.EscrowedAmount,
+		LockedAmount:   fromBalance.LockedAmount,
 	}); err != nil {
 		return err
 	}
+	if err := TransferSerials(ctx, k.stateStore, batch.Key, from, to, sendAmtTradable, api.SerialStatus_SERIAL_STATUS_TRADABLE); err != nil {
+		return err
+	}
+	if err := TransferSerials(ctx, k.stateStore, batch.Key, from, to, sendAmtRetired, api.SerialStatus_SERIAL_STATUS_RETIRED); err != nil {
+		return err
+	}
+
 	// update the "retired" supply only if credits were retired
 	if didRetire {
 		if err := k.stateStore.BatchSupplyTable().Update(ctx, &api.BatchSupply{
@@ -145,11 +157,26 @@ func (k Keeper) sendEcocredits(sdkCtx sdk.Context, credit *types.MsgSend_SendCre
 		}); err != nil {
 			return err
 		}
+		if err := RetireAggregateSupply(ctx, k.stateStore, batch.Key, sendAmtRetired); err != nil {
+			return err
+		}
+		if _, err = RecordRetirement(ctx, k.stateStore, k.nftKeeper, &api.Retirement{
+			Owner:        to,
+			BatchKey:     batch.Key,
+			Amount:       sendAmtRetired.String(),
+			Jurisdiction: credit.RetirementJurisdiction,
+			Reason:       credit.RetirementReason,
+			Beneficiary:  credit.RetirementBeneficiary,
+		}, batch.Denom); err != nil {
+			return err
+		}
 		if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventRetire{
 			Owner:        to.String(),
 			BatchDenom:   credit.BatchDenom,
 			Amount:       sendAmtRetired.String(),
 			Jurisdiction: credit.RetirementJurisdiction,
+			Reason:       credit.RetirementReason,
+			Beneficiary:  credit.RetirementBeneficiary,
 		}); err != nil {
 			return err
 		}
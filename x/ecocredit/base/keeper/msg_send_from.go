@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// SendFrom sends credits from the credit owner to a recipient on behalf of the
+// credit owner and deducts the amount sent from the allowance of the spender.
+func (k Keeper) SendFrom(ctx context.Context, req *types.MsgSendFrom) (*types.MsgSendFromResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	spender, err := sdk.AccAddressFromBech32(req.Spender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, credit := range req.Credits {
+		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
+		}
+
+		creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		decs, err := utils.GetNonNegativeFixedDecs(creditType.Precision, credit.TradableAmount, credit.RetiredAmount)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+
+		amount, err := decs[0].Add(decs[1])
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.spendAllowance(ctx, owner, spender, batch, amount); err != nil {
+			return nil, err
+		}
+
+		if err := k.sendEcocredits(sdkCtx, credit, recipient, owner); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgSendFrom credit iteration")
+	}
+
+	return &types.MsgSendFromResponse{}, nil
+}
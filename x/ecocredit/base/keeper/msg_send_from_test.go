@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type sendFrom struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	charlie  sdk.AccAddress
+	classKey uint64
+	batchKey uint64
+	res      *types.MsgSendFromResponse
+	err      error
+}
+
+func TestSendFrom(t *testing.T) {
+	gocuke.NewRunner(t, &sendFrom{}).Path("./features/msg_send_from.feature").Run()
+}
+
+func (s *sendFrom) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+	_, _, s.charlie = testdata.KeyTestPubAddr()
+}
+
+func (s *sendFrom) ACreditBatch() {
+	s.classKey, s.batchKey = allowanceBatchSetup(s.t, s.baseSuite)
+}
+
+func (s *sendFrom) AliceOwnsTradableCreditAmount(a string) {
+	err := s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        s.alice,
+		TradableAmount: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *sendFrom) AliceApprovedBobForBatchWithAmount(a string) {
+	err := s.stateStore.BatchAllowanceTable().Insert(s.ctx, &api.BatchAllowance{
+		Owner:    s.alice,
+		Spender:  s.bob,
+		BatchKey: s.batchKey,
+		Amount:   a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *sendFrom) AliceApprovedBobForClassWithAmount(a string) {
+	err := s.stateStore.ClassAllowanceTable().Insert(s.ctx, &api.ClassAllowance{
+		Owner:    s.alice,
+		Spender:  s.bob,
+		ClassKey: s.classKey,
+		Amount:   a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *sendFrom) BobAttemptsToSendFromAliceToCharlieTradableAmountAndRetiredAmount(a, b string) {
+	s.res, s.err = s.k.SendFrom(s.ctx, &types.MsgSendFrom{
+		Spender:   s.bob.String(),
+		Owner:     s.alice.String(),
+		Recipient: s.charlie.String(),
+		Credits: []*types.MsgSend_SendCredits{
+			{
+				BatchDenom:             testBatchDenom,
+				TradableAmount:         a,
+				RetiredAmount:          b,
+				RetirementJurisdiction: "US-WA",
+			},
+		},
+	})
+}
+
+func (s *sendFrom) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *sendFrom) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *sendFrom) ExpectBobBatchAllowance(a string) {
+	allowance, err := s.stateStore.BatchAllowanceTable().Get(s.ctx, s.alice, s.bob, s.batchKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, allowance.Amount)
+}
+
+func (s *sendFrom) ExpectBobClassAllowance(a string) {
+	allowance, err := s.stateStore.ClassAllowanceTable().Get(s.ctx, s.alice, s.bob, s.classKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, allowance.Amount)
+}
+
+func (s *sendFrom) ExpectBobHasNoBatchAllowance() {
+	_, err := s.stateStore.BatchAllowanceTable().Get(s.ctx, s.alice, s.bob, s.batchKey)
+	require.ErrorIs(s.t, err, ormerrors.NotFound)
+}
+
+func (s *sendFrom) ExpectAliceTradableBalance(a string) {
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.alice, s.batchKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, balance.TradableAmount)
+}
+
+func (s *sendFrom) ExpectCharlieTradableBalanceAndRetiredBalance(a, b string) {
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.charlie, s.batchKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, balance.TradableAmount)
+	require.Equal(s.t, b, balance.RetiredAmount)
+}
+
+// allowanceBatchSetup inserts a credit type, credit class, project, credit
+// batch and batch supply and returns the class key and the batch key.
+func allowanceBatchSetup(t gocuke.TestingT, s *baseSuite) (uint64, uint64) {
+	err := s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: "C",
+	})
+	require.NoError(t, err)
+
+	cKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               testClassID,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(t, err)
+
+	pKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       testProjectID,
+		ClassKey: cKey,
+	})
+	require.NoError(t, err)
+
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: pKey,
+		Denom:      testBatchDenom,
+	})
+	require.NoError(t, err)
+
+	err = s.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:        bKey,
+		TradableAmount:  "10",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(t, err)
+
+	return cKey, bKey
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// SendLocked sends tradable credits to a recipient where they are locked
+// according to a lock schedule.
+func (k Keeper) SendLocked(ctx context.Context, req *types.MsgSendLocked) (*types.MsgSendLockedResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := sdkCtx.BlockTime()
+	if !req.EndTime.After(blockTime) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"end time %s must be after block time %s", req.EndTime, blockTime,
+		)
+	}
+
+	startTime := blockTime
+	if req.StartTime != nil {
+		startTime = *req.StartTime
+	}
+
+	// credits of a cliff lock do not unlock until the end time
+	unlockTime := startTime
+	if req.LockType == types.LockType_LOCK_TYPE_CLIFF {
+		unlockTime = *req.EndTime
+	}
+
+	lockIDs := make([]uint64, 0, len(req.Credits))
+	for _, credit := range req.Credits {
+		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, credit.BatchDenom)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", credit.BatchDenom, err.Error())
+		}
+
+		creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		amount, err := math.NewPositiveFixedDecFromString(credit.Amount, creditType.Precision)
+		if err != nil {
+			return nil, err
+		}
+
+		fromBalance, err := k.stateStore.BatchBalanceTable().Get(ctx, sender, batch.Key)
+		if err != nil {
+			if ormerrors.IsNotFound(err) {
+				return nil, ecocredit.ErrInsufficientCredits.Wrapf("you do not have any credits from batch %s", batch.Denom)
+			}
+			return nil, err
+		}
+
+		fromTradable, err := math.NewDecFromString(fromBalance.TradableAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		fromTradable, err = math.SafeSubBalance(fromTradable, amount)
+		if err != nil {
+			return nil, ecocredit.ErrInsufficientCredits.Wrapf(
+				"tradable balance: %s, send locked amount %s", fromBalance.TradableAmount, amount,
+			)
+		}
+
+		fromBalance.TradableAmount = fromTradable.String()
+		if err = k.stateStore.BatchBalanceTable().Update(ctx, fromBalance); err != nil {
+			return nil, err
+		}
+
+		toBalance, err := utils.GetBalance(ctx, k.stateStore.BatchBalanceTable(), recipient, batch.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		toLocked, err := utils.GetLockedAmount(toBalance)
+		if err != nil {
+			return nil, err
+		}
+
+		toLocked, err = math.SafeAddBalance(toLocked, amount)
+		if err != nil {
+			return nil, err
+		}
+
+		toBalance.LockedAmount = toLocked.String()
+		if err = k.stateStore.BatchBalanceTable().Save(ctx, toBalance); err != nil {
+			return nil, err
+		}
+		if err = IndexBatchBalance(ctx, k.stateStore, recipient, batch.Key); err != nil {
+			return nil, err
+		}
+		if err = TransferSerials(ctx, k.stateStore, batch.Key, sender, recipient, amount, api.SerialStatus_SERIAL_STATUS_TRADABLE); err != nil {
+			return nil, err
+		}
+
+		lockID, err := k.stateStore.CreditLockTable().InsertReturningID(ctx, &api.CreditLock{
+			Owner:          recipient,
+			BatchKey:       batch.Key,
+			LockType:       api.LockType(req.LockType),
+			Amount:         amount.String(),
+			UnlockedAmount: "0",
+			StartTime:      timestamppb.New(startTime),
+			EndTime:        timestamppb.New(*req.EndTime),
+			UnlockTime:     timestamppb.New(unlockTime),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		lockIDs = append(lockIDs, lockID)
+
+		if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventLock{
+			LockId:     lockID,
+			Sender:     req.Sender,
+			Recipient:  req.Recipient,
+			BatchDenom: batch.Denom,
+			Amount:     amount.String(),
+		}); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgSendLocked credit iteration")
+	}
+
+	return &types.MsgSendLockedResponse{LockIds: lockIDs}, nil
+}
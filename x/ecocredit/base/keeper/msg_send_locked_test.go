@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type sendLocked struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	batchKey uint64
+	res      *types.MsgSendLockedResponse
+	err      error
+}
+
+func TestSendLocked(t *testing.T) {
+	gocuke.NewRunner(t, &sendLocked{}).Path("./features/msg_send_locked.feature").Run()
+}
+
+func (s *sendLocked) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *sendLocked) ACreditBatch() {
+	_, s.batchKey = allowanceBatchSetup(s.t, s.baseSuite)
+}
+
+func (s *sendLocked) AliceOwnsTradableCreditAmount(a string) {
+	err := s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        s.alice,
+		TradableAmount: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *sendLocked) TheBlockTime(a string) {
+	blockTime, err := regentypes.ParseDate("block time", a)
+	require.NoError(s.t, err)
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func (s *sendLocked) AliceAttemptsToSendBobAmountLockedUntil(a, b string) {
+	s.sendLocked(s.alice, s.bob, a, b)
+}
+
+func (s *sendLocked) BobAttemptsToSendAliceAmountLockedUntil(a, b string) {
+	s.sendLocked(s.bob, s.alice, a, b)
+}
+
+func (s *sendLocked) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *sendLocked) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *sendLocked) ExpectAliceTradableBalance(a string) {
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.alice, s.batchKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, balance.TradableAmount)
+}
+
+func (s *sendLocked) ExpectBobTradableBalanceAndLockedBalance(a, b string) {
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.bob, s.batchKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, balance.TradableAmount)
+	require.Equal(s.t, b, balance.LockedAmount)
+}
+
+func (s *sendLocked) ExpectBobCreditLockWithAmountAndUnlockedAmount(a, b string) {
+	require.NoError(s.t, s.err)
+	require.Len(s.t, s.res.LockIds, 1)
+
+	lock, err := s.stateStore.CreditLockTable().Get(s.ctx, s.res.LockIds[0])
+	require.NoError(s.t, err)
+	require.Equal(s.t, s.bob.Bytes(), lock.Owner)
+	require.Equal(s.t, s.batchKey, lock.BatchKey)
+	require.Equal(s.t, a, lock.Amount)
+	require.Equal(s.t, b, lock.UnlockedAmount)
+	require.Equal(s.t, s.sdkCtx.BlockTime(), lock.StartTime.AsTime())
+
+	// credits of a cliff lock unlock at the end time
+	require.Equal(s.t, lock.EndTime.AsTime(), lock.UnlockTime.AsTime())
+}
+
+func (s *sendLocked) sendLocked(sender, recipient sdk.AccAddress, amount, endTime string) {
+	end, err := regentypes.ParseDate("end time", endTime)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.SendLocked(s.ctx, &types.MsgSendLocked{
+		Sender:    sender.String(),
+		Recipient: recipient.String(),
+		Credits: []*types.Credits{
+			{BatchDenom: testBatchDenom, Amount: amount},
+		},
+		LockType: types.LockType_LOCK_TYPE_CLIFF,
+		EndTime:  &end,
+	})
+}
@@ -250,6 +250,19 @@ func (s *send) ExpectBobBatchBalance(a gocuke.DocString) {
 	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
 }
 
+func (s *send) ExpectBobIsAHolderOfTheProject() {
+	found, err := s.stateStore.ProjectHolderTable().Has(s.ctx, s.projectKey, s.bob)
+	require.NoError(s.t, err)
+	require.True(s.t, found)
+}
+
+func (s *send) ExpectBobHasAnIndexedBalanceWithinTheCreditClassAndProject() {
+	index, err := s.stateStore.BatchBalanceIndexTable().Get(s.ctx, s.bob, s.batchKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, s.classKey, index.ClassKey)
+	require.Equal(s.t, s.projectKey, index.ProjectKey)
+}
+
 func (s *send) ExpectBatchSupply(a gocuke.DocString) {
 	expected := &api.BatchSupply{}
 	err := jsonpb.UnmarshalString(a.Content, expected)
@@ -283,6 +296,22 @@ func (s *send) AliceAttemptsToSendCreditsToBobWithRetiredAmountFrom(a, b string)
 	require.NoError(s.t, s.err)
 }
 
+func (s *send) ExpectRetirementWithProperties(a gocuke.DocString) {
+	expected := &api.Retirement{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	retirement, err := s.stateStore.RetirementTable().Get(s.ctx, expected.Id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, s.bob.Bytes(), retirement.Owner)
+	require.Equal(s.t, s.batchKey, retirement.BatchKey)
+	require.Equal(s.t, expected.Amount, retirement.Amount)
+	require.Equal(s.t, expected.Jurisdiction, retirement.Jurisdiction)
+	require.Equal(s.t, expected.Reason, retirement.Reason)
+	require.Equal(s.t, expected.Beneficiary, retirement.Beneficiary)
+}
+
 func (s *send) ExpectEventRetireWithProperties(a gocuke.DocString) {
 	var event types.EventRetire
 	err := json.Unmarshal([]byte(a.Content), &event)
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// SetArticle6Authorization sets the Article 6 authorization of a credit batch
+// or of a retirement of credits from a credit batch. Only the admin of the
+// credit class or a national registry of the host country can set the
+// authorization, and the letter of authorization must be anchored in the data
+// module.
+func (k Keeper) SetArticle6Authorization(ctx context.Context, req *types.MsgSetArticle6Authorization) (*types.MsgSetArticle6AuthorizationResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	signer, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get batch with denom %s: %s", req.BatchDenom, err,
+		)
+	}
+
+	project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// the host jurisdiction is formatted <country-code>[-<region-code>...]
+	country := req.HostJurisdiction[:2]
+
+	if !signer.Equals(sdk.AccAddress(class.Admin)) {
+		isRegistry, err := k.stateStore.NationalRegistryTable().Has(ctx, country, signer)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isRegistry {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf(
+				"%s is not the admin of credit class %s or a national registry of %s",
+				req.Signer, class.Id, country,
+			)
+		}
+	}
+
+	if err = AssertValidJurisdiction(ctx, k.stateStore, req.HostJurisdiction); err != nil {
+		return nil, err
+	}
+
+	if req.RetirementId != 0 {
+		retirement, err := k.stateStore.RetirementTable().Get(ctx, req.RetirementId)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"could not get retirement with id %d: %s", req.RetirementId, err,
+			)
+		}
+
+		if retirement.BatchKey != batch.Key {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"retirement %d is not a retirement of credits from batch %s",
+				req.RetirementId, req.BatchDenom,
+			)
+		}
+	}
+
+	anchored, err := k.dataKeeper.HasAnchor(ctx, req.AuthorizationIri)
+	if err != nil {
+		return nil, err
+	}
+
+	if !anchored {
+		return nil, sdkerrors.ErrNotFound.Wrapf(
+			"authorization iri %s has not been anchored", req.AuthorizationIri,
+		)
+	}
+
+	if err = k.stateStore.Article6AuthorizationTable().Save(ctx, &api.Article6Authorization{
+		BatchKey:         batch.Key,
+		RetirementId:     req.RetirementId,
+		HostJurisdiction: req.HostJurisdiction,
+		AuthorizationIri: req.AuthorizationIri,
+		AdjustmentStatus: api.AdjustmentStatus(req.AdjustmentStatus),
+		AuthorizedUse:    api.AuthorizedUse(req.AuthorizedUse),
+		Authorizer:       signer,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventSetArticle6Authorization{
+		BatchDenom:       req.BatchDenom,
+		RetirementId:     req.RetirementId,
+		AdjustmentStatus: req.AdjustmentStatus,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetArticle6AuthorizationResponse{}, nil
+}
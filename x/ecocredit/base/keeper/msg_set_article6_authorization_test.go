@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/golang/mock/gomock"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type setArticle6Authorization struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	batchKey uint64
+	err      error
+}
+
+func TestSetArticle6Authorization(t *testing.T) {
+	gocuke.NewRunner(t, &setArticle6Authorization{}).Path("./features/msg_set_article6_authorization.feature").Run()
+}
+
+func (s *setArticle6Authorization) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *setArticle6Authorization) ACreditBatchWithDenomAndClassAdminAlice(a string) {
+	cKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               testClassID,
+		Admin:            s.alice,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(s.t, err)
+
+	pKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       testProjectID,
+		ClassKey: cKey,
+	})
+	require.NoError(s.t, err)
+
+	s.batchKey, err = s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: pKey,
+		Denom:      a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *setArticle6Authorization) BobIsANationalRegistryOf(a string) {
+	err := s.stateStore.NationalRegistryTable().Insert(s.ctx, &api.NationalRegistry{
+		Country: a,
+		Address: s.bob,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *setArticle6Authorization) ARetirementOfCreditsFromTheCreditBatch() {
+	s.insertRetirement(s.batchKey)
+}
+
+func (s *setArticle6Authorization) ARetirementOfCreditsFromAnotherCreditBatch() {
+	s.insertRetirement(s.batchKey + 1)
+}
+
+func (s *setArticle6Authorization) TheLetterOfAuthorizationIsAnchored() {
+	s.dataKeeper.EXPECT().HasAnchor(gomock.Any(), gomock.Any()).Return(true, nil).AnyTimes()
+}
+
+func (s *setArticle6Authorization) TheLetterOfAuthorizationIsNotAnchored() {
+	s.dataKeeper.EXPECT().HasAnchor(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+}
+
+func (s *setArticle6Authorization) StrictJurisdictionsAreEnabled() {
+	err := s.stateStore.StrictJurisdictionsTable().Save(s.ctx, &api.StrictJurisdictions{
+		Enabled: true,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *setArticle6Authorization) AliceSetAuthorizationWithProperties(a gocuke.DocString) {
+	s.setArticle6Authorization(s.alice, a)
+	require.NoError(s.t, s.err)
+}
+
+func (s *setArticle6Authorization) AliceAttemptsToSetAuthorizationWithProperties(a gocuke.DocString) {
+	s.setArticle6Authorization(s.alice, a)
+}
+
+func (s *setArticle6Authorization) BobAttemptsToSetAuthorizationWithProperties(a gocuke.DocString) {
+	s.setArticle6Authorization(s.bob, a)
+}
+
+func (s *setArticle6Authorization) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *setArticle6Authorization) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *setArticle6Authorization) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *setArticle6Authorization) ExpectBobAuthorizationWithProperties(a gocuke.DocString) {
+	require.NoError(s.t, s.err)
+
+	var expected types.Article6Authorization
+	err := jsonpb.UnmarshalString(a.Content, &expected)
+	require.NoError(s.t, err)
+
+	authorization, err := s.stateStore.Article6AuthorizationTable().Get(s.ctx, s.batchKey, expected.RetirementId)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.HostJurisdiction, authorization.HostJurisdiction)
+	require.Equal(s.t, expected.AuthorizationIri, authorization.AuthorizationIri)
+	require.Equal(s.t, expected.AdjustmentStatus.String(), authorization.AdjustmentStatus.String())
+	require.Equal(s.t, expected.AuthorizedUse.String(), authorization.AuthorizedUse.String())
+	require.Equal(s.t, s.bob.Bytes(), authorization.Authorizer)
+}
+
+func (s *setArticle6Authorization) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventSetArticle6Authorization
+	err := jsonpb.UnmarshalString(a.Content, &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *setArticle6Authorization) setArticle6Authorization(signer sdk.AccAddress, a gocuke.DocString) {
+	var msg types.MsgSetArticle6Authorization
+	err := jsonpb.UnmarshalString(a.Content, &msg)
+	require.NoError(s.t, err)
+
+	msg.Signer = signer.String()
+
+	_, s.err = s.k.SetArticle6Authorization(s.ctx, &msg)
+}
+
+func (s *setArticle6Authorization) insertRetirement(batchKey uint64) {
+	err := s.stateStore.RetirementTable().Insert(s.ctx, &api.Retirement{
+		Owner:    s.alice,
+		BatchKey: batchKey,
+		Amount:   "10",
+	})
+	require.NoError(s.t, err)
+}
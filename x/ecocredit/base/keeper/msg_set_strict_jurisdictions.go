@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// SetStrictJurisdictions is a governance method that enables or disables the
+// validation of jurisdictions against the ISO 3166 datasets.
+func (k Keeper) SetStrictJurisdictions(ctx context.Context, req *types.MsgSetStrictJurisdictions) (*types.MsgSetStrictJurisdictionsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority: expected %s, got %s", k.authority, req.Authority)
+	}
+
+	if err := k.stateStore.StrictJurisdictionsTable().Save(ctx, &api.StrictJurisdictions{
+		Enabled: req.Enabled,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetStrictJurisdictionsResponse{}, nil
+}
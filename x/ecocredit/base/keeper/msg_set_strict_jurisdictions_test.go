@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type setStrictJurisdictionsSuite struct {
+	*baseSuite
+	alice      sdk.AccAddress
+	batchKey   uint64
+	batchDenom string
+	err        error
+}
+
+func TestSetStrictJurisdictions(t *testing.T) {
+	gocuke.NewRunner(t, &setStrictJurisdictionsSuite{}).Path("./features/msg_set_strict_jurisdictions.feature").Run()
+}
+
+func (s *setStrictJurisdictionsSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.batchDenom = testBatchDenom
+}
+
+func (s *setStrictJurisdictionsSuite) ACreditClassWithIssuerAlice() {
+	err := s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: "C",
+		Name:         "carbon",
+	})
+	require.NoError(s.t, err)
+
+	cKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               testClassID,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+		ClassKey: cKey,
+		Issuer:   s.alice,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *setStrictJurisdictionsSuite) AliceOwnsTradableCreditsFromACreditBatch() {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, testClassID)
+	require.NoError(s.t, err)
+
+	pKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       testProjectID,
+		ClassKey: class.Key,
+	})
+	require.NoError(s.t, err)
+
+	s.batchKey, err = s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: pKey,
+		Denom:      s.batchDenom,
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:        s.batchKey,
+		TradableAmount:  "10",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        s.alice,
+		TradableAmount: "10",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *setStrictJurisdictionsSuite) StrictJurisdictionsAreEnabled() {
+	err := s.stateStore.StrictJurisdictionsTable().Save(s.ctx, &api.StrictJurisdictions{
+		Enabled: true,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *setStrictJurisdictionsSuite) AliceAttemptsToSetStrictJurisdictionsWithProperties(a gocuke.DocString) {
+	var msg *types.MsgSetStrictJurisdictions
+
+	err := json.Unmarshal([]byte(a.Content), &msg)
+	require.NoError(s.t, err)
+
+	_, s.err = s.k.SetStrictJurisdictions(s.ctx, msg)
+}
+
+func (s *setStrictJurisdictionsSuite) AliceAttemptsToCreateAProjectWithJurisdiction(a string) {
+	_, s.err = s.k.CreateProject(s.ctx, &types.MsgCreateProject{
+		Admin:        s.alice.String(),
+		ClassId:      testClassID,
+		Jurisdiction: a,
+	})
+}
+
+func (s *setStrictJurisdictionsSuite) AliceAttemptsToRetireCreditsWithJurisdiction(a string) {
+	_, s.err = s.k.Retire(s.ctx, &types.MsgRetire{
+		Owner: s.alice.String(),
+		Credits: []*types.Credits{
+			{
+				BatchDenom: s.batchDenom,
+				Amount:     "10",
+			},
+		},
+		Jurisdiction: a,
+	})
+}
+
+func (s *setStrictJurisdictionsSuite) ExpectStrictJurisdictionsFlagToBe(a string) {
+	enabled, err := strconv.ParseBool(a)
+	require.NoError(s.t, err)
+
+	res, err := s.stateStore.StrictJurisdictionsTable().Get(s.ctx)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, enabled, res.Enabled)
+}
+
+func (s *setStrictJurisdictionsSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *setStrictJurisdictionsSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *setStrictJurisdictionsSuite) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
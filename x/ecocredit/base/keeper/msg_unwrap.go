@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// Unwrap burns wrapped tokens held by the owner and moves the equivalent amount
+// of credits from the escrow balance of the wrap submodule back to the tradable
+// balance of the owner.
+func (k Keeper) Unwrap(ctx context.Context, req *types.MsgUnwrap) (*types.MsgUnwrapResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := math.NewPositiveFixedDecFromString(req.Amount, creditType.Precision)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("amount: %s", err)
+	}
+
+	denom, _, err := base.FormatWrappedDenom(batch.Denom, creditType.Precision)
+	if err != nil {
+		return nil, err
+	}
+
+	coin, err := creditAmountToWrappedCoin(amount, creditType.Precision, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	coins := sdk.NewCoins(coin)
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, owner, base.WrapSubModuleName, coins); err != nil {
+		return nil, err
+	}
+	if err = k.bankKeeper.BurnCoins(sdkCtx, base.WrapSubModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err = TransferCredits(ctx, k.stateStore, batch, wrapAddress, owner, amount); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventUnwrap{
+		Owner:      req.Owner,
+		BatchDenom: batch.Denom,
+		Amount:     req.Amount,
+		Denom:      denom,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnwrapResponse{}, nil
+}
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type unwrapSuite struct {
+	*baseSuite
+	alice             sdk.AccAddress
+	creditTypeAbbrev  string
+	precision         uint32
+	batchDenom        string
+	batchKey          uint64
+	aliceTokenBalance sdk.Coins
+	res               *types.MsgUnwrapResponse
+	err               error
+}
+
+func TestUnwrap(t *testing.T) {
+	gocuke.NewRunner(t, &unwrapSuite{}).Path("./features/msg_unwrap.feature").Run()
+}
+
+func (s *unwrapSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.batchDenom = testBatchDenom
+	s.aliceTokenBalance = sdk.NewCoins()
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gomock.Any(), gomock.Any(), base.WrapSubModuleName, gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, _ sdk.AccAddress, _ string, coins sdk.Coins) error {
+			// simulate token balance update unavailable with mocks
+			balance, hasNeg := s.aliceTokenBalance.SafeSub(coins...)
+			if hasNeg {
+				return sdkerrors.ErrInsufficientFunds.Wrapf("%s is smaller than %s", s.aliceTokenBalance, coins)
+			}
+			s.aliceTokenBalance = balance
+			return nil
+		}).
+		AnyTimes() // not expected on failed attempt
+
+	s.bankKeeper.EXPECT().
+		BurnCoins(gomock.Any(), base.WrapSubModuleName, gomock.Any()).
+		Return(nil).
+		AnyTimes() // not expected on failed attempt
+}
+
+func (s *unwrapSuite) AlicesAddress(a string) {
+	addr, err := sdk.AccAddressFromBech32(a)
+	require.NoError(s.t, err)
+	s.alice = addr
+}
+
+func (s *unwrapSuite) ACreditTypeWithAbbreviationAndPrecision(a, b string) {
+	precision, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Precision:    uint32(precision),
+	})
+	require.NoError(s.t, err)
+
+	s.creditTypeAbbrev = a
+	s.precision = uint32(precision)
+}
+
+func (s *unwrapSuite) ACreditBatchWithDenom(a string) {
+	cKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               testClassID,
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	pKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       testProjectID,
+		ClassKey: cKey,
+	})
+	require.NoError(s.t, err)
+
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: pKey,
+		Denom:      a,
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:        bKey,
+		TradableAmount:  "10",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(s.t, err)
+
+	s.batchDenom = a
+	s.batchKey = bKey
+}
+
+func (s *unwrapSuite) AliceHasWrappedCreditAmount(a string) {
+	err := s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        wrapAddress,
+		TradableAmount: a,
+		RetiredAmount:  "0",
+		EscrowedAmount: "0",
+	})
+	require.NoError(s.t, err)
+
+	amount, err := math.NewPositiveFixedDecFromString(a, s.precision)
+	require.NoError(s.t, err)
+
+	denom, _, err := base.FormatWrappedDenom(s.batchDenom, s.precision)
+	require.NoError(s.t, err)
+
+	coin, err := creditAmountToWrappedCoin(amount, s.precision, denom)
+	require.NoError(s.t, err)
+
+	s.aliceTokenBalance = s.aliceTokenBalance.Add(coin)
+}
+
+func (s *unwrapSuite) AliceAttemptsToUnwrapCreditAmount(a string) {
+	s.res, s.err = s.k.Unwrap(s.ctx, &types.MsgUnwrap{
+		Owner:      s.alice.String(),
+		BatchDenom: s.batchDenom,
+		Amount:     a,
+	})
+}
+
+func (s *unwrapSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *unwrapSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *unwrapSuite) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *unwrapSuite) ExpectAliceWrappedTokenBalance(a string) {
+	expected, err := sdk.ParseCoinsNormalized(a)
+	require.NoError(s.t, err)
+
+	require.True(s.t, expected.IsEqual(s.aliceTokenBalance))
+}
+
+func (s *unwrapSuite) ExpectAliceTradableCreditAmount(a string) {
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.alice, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, a, balance.TradableAmount)
+}
+
+func (s *unwrapSuite) ExpectWrapSubmoduleTradableCreditAmount(a string) {
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, wrapAddress, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, a, balance.TradableAmount)
+}
+
+func (s *unwrapSuite) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventUnwrap
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
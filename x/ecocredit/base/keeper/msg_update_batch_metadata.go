@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// UpdateBatchMetadata updates the metadata of a credit batch and records the
+// update in the metadata history of the credit batch. Only the issuer of the
+// credit batch can update the metadata. If the credit class requires a
+// co-signature, the admin of the credit class must also sign the message
+// unless the issuer is the admin of the credit class.
+func (k Keeper) UpdateBatchMetadata(ctx context.Context, req *types.MsgUpdateBatchMetadata) (*types.MsgUpdateBatchMetadataResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get batch with denom %s: %s", req.BatchDenom, err,
+		)
+	}
+
+	if !sdk.AccAddress(batch.Issuer).Equals(issuer) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the issuer of batch %s", req.Issuer, req.BatchDenom,
+		)
+	}
+
+	project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.assertBatchMetadataCosign(ctx, class, issuer, req.ClassAdmin); err != nil {
+		return nil, err
+	}
+
+	oldMetadata := batch.Metadata
+	batch.Metadata = req.NewMetadata
+	if err = k.stateStore.BatchTable().Update(ctx, batch); err != nil {
+		return nil, err
+	}
+
+	if err = k.stateStore.BatchMetadataChangeTable().Insert(ctx, &api.BatchMetadataChange{
+		BatchKey:    batch.Key,
+		OldMetadata: oldMetadata,
+		NewMetadata: req.NewMetadata,
+		Signer:      issuer,
+		Timestamp:   timestamppb.New(sdkCtx.BlockTime()),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventUpdateBatchMetadata{
+		BatchDenom: batch.Denom,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateBatchMetadataResponse{}, nil
+}
+
+// assertBatchMetadataCosign makes sure that the class admin co-signing the
+// metadata update is the admin of the credit class and that the admin of the
+// credit class co-signed the update if the credit class requires it.
+func (k Keeper) assertBatchMetadataCosign(ctx context.Context, class *api.Class, issuer sdk.AccAddress, classAdmin string) error {
+	admin := sdk.AccAddress(class.Admin)
+
+	if classAdmin != "" {
+		classAdminAddr, err := sdk.AccAddressFromBech32(classAdmin)
+		if err != nil {
+			return err
+		}
+		if !classAdminAddr.Equals(admin) {
+			return sdkerrors.ErrUnauthorized.Wrapf(
+				"%s is not the admin of credit class %s", classAdmin, class.Id,
+			)
+		}
+		return nil
+	}
+
+	// the issuer does not need a co-signature if the issuer is the class admin
+	if issuer.Equals(admin) {
+		return nil
+	}
+
+	required, err := k.stateStore.ClassBatchMetadataCosignTable().Has(ctx, class.Key)
+	if err != nil {
+		return err
+	}
+
+	if required {
+		return sdkerrors.ErrUnauthorized.Wrapf(
+			"credit class %s requires the class admin to co-sign batch metadata updates", class.Id,
+		)
+	}
+
+	return nil
+}
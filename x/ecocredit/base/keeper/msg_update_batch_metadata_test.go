@@ -0,0 +1,174 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type updateBatchMetadata struct {
+	*baseSuite
+	alice      sdk.AccAddress
+	bob        sdk.AccAddress
+	carol      sdk.AccAddress
+	classKey   uint64
+	projectKey uint64
+	res        *types.MsgUpdateBatchMetadataResponse
+	err        error
+}
+
+func TestUpdateBatchMetadata(t *testing.T) {
+	gocuke.NewRunner(t, &updateBatchMetadata{}).Path("./features/msg_update_batch_metadata.feature").Run()
+}
+
+func (s *updateBatchMetadata) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+	_, _, s.carol = testdata.KeyTestPubAddr()
+}
+
+func (s *updateBatchMetadata) ACreditClassWithIdAndAdminAlice(a string) {
+	var err error
+	s.classKey, err = s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateBatchMetadata) AProjectWithId(a string) {
+	var err error
+	s.projectKey, err = s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: s.classKey,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateBatchMetadata) ACreditBatchWithDenomIssuerBobAndMetadata(a, b string) {
+	s.insertBatch(a, s.bob, b)
+}
+
+func (s *updateBatchMetadata) ACreditBatchWithDenomIssuerAliceAndMetadata(a, b string) {
+	s.insertBatch(a, s.alice, b)
+}
+
+func (s *updateBatchMetadata) TheCreditClassRequiresACosignature() {
+	err := s.stateStore.ClassBatchMetadataCosignTable().Insert(s.ctx, &api.ClassBatchMetadataCosign{
+		ClassKey: s.classKey,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateBatchMetadata) AliceAttemptsToUpdateTheMetadataOfBatchTo(a, b string) {
+	s.updateBatchMetadata(s.alice, a, b, "")
+}
+
+func (s *updateBatchMetadata) BobAttemptsToUpdateTheMetadataOfBatchTo(a, b string) {
+	s.updateBatchMetadata(s.bob, a, b, "")
+}
+
+func (s *updateBatchMetadata) CarolAttemptsToUpdateTheMetadataOfBatchTo(a, b string) {
+	s.updateBatchMetadata(s.carol, a, b, "")
+}
+
+func (s *updateBatchMetadata) BobAttemptsToUpdateTheMetadataOfBatchToWithClassAdminAlice(a, b string) {
+	s.updateBatchMetadata(s.bob, a, b, s.alice.String())
+}
+
+func (s *updateBatchMetadata) BobAttemptsToUpdateTheMetadataOfBatchToWithClassAdminCarol(a, b string) {
+	s.updateBatchMetadata(s.bob, a, b, s.carol.String())
+}
+
+func (s *updateBatchMetadata) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateBatchMetadata) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateBatchMetadata) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateBatchMetadata) ExpectBatchWithDenomAndMetadata(a, b string) {
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, batch.Metadata)
+}
+
+func (s *updateBatchMetadata) ExpectBatchWithDenomAndMetadataHistory(a string, b gocuke.DocString) {
+	var expected []struct {
+		OldMetadata string `json:"old_metadata"`
+		NewMetadata string `json:"new_metadata"`
+	}
+	err := json.Unmarshal([]byte(b.Content), &expected)
+	require.NoError(s.t, err)
+
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	it, err := s.stateStore.BatchMetadataChangeTable().List(s.ctx, api.BatchMetadataChangeBatchKeyIndexKey{}.WithBatchKey(batch.Key))
+	require.NoError(s.t, err)
+	defer it.Close()
+
+	var changes []*api.BatchMetadataChange
+	for it.Next() {
+		change, err := it.Value()
+		require.NoError(s.t, err)
+		changes = append(changes, change)
+	}
+
+	require.Len(s.t, changes, len(expected))
+	for i, change := range changes {
+		require.Equal(s.t, expected[i].OldMetadata, change.OldMetadata)
+		require.Equal(s.t, expected[i].NewMetadata, change.NewMetadata)
+		require.Equal(s.t, s.bob.Bytes(), change.Signer)
+		require.NotNil(s.t, change.Timestamp)
+	}
+}
+
+func (s *updateBatchMetadata) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventUpdateBatchMetadata
+	err := jsonpb.UnmarshalString(a.Content, &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *updateBatchMetadata) insertBatch(denom string, issuer sdk.AccAddress, metadata string) {
+	err := s.stateStore.BatchTable().Insert(s.ctx, &api.Batch{
+		ProjectKey: s.projectKey,
+		Issuer:     issuer,
+		Denom:      denom,
+		Metadata:   metadata,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateBatchMetadata) updateBatchMetadata(issuer sdk.AccAddress, denom, metadata, classAdmin string) {
+	s.res, s.err = s.k.UpdateBatchMetadata(s.ctx, &types.MsgUpdateBatchMetadata{
+		Issuer:      issuer.String(),
+		BatchDenom:  denom,
+		NewMetadata: metadata,
+		ClassAdmin:  classAdmin,
+	})
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// UpdateClassBatchApproval updates whether credit batches issued within a
+// credit class must be approved by the admin or a verifier of the credit class.
+// Pending batches that were proposed while approval was required can still be
+// approved or rejected after approval is no longer required.
+func (k Keeper) UpdateClassBatchApproval(ctx context.Context, req *types.MsgUpdateClassBatchApproval) (*types.MsgUpdateClassBatchApprovalResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit class with id %s: %s", req.ClassId, err,
+		)
+	}
+
+	admin := sdk.AccAddress(class.Admin)
+	if !reqAddr.Equals(admin) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, req.ClassId,
+		)
+	}
+
+	if req.RequireApproval {
+		if err = k.stateStore.ClassBatchApprovalTable().Save(ctx, &api.ClassBatchApproval{
+			ClassKey:     class.Key,
+			ExpiryPeriod: regentypes.GogoToProtobufDuration(req.ExpiryPeriod),
+		}); err != nil {
+			return nil, err
+		}
+	} else {
+		found, err := k.stateStore.ClassBatchApprovalTable().Has(ctx, class.Key)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			if err = k.stateStore.ClassBatchApprovalTable().Delete(ctx, &api.ClassBatchApproval{
+				ClassKey: class.Key,
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventUpdateClassBatchApproval{
+		ClassId: req.ClassId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateClassBatchApprovalResponse{}, nil
+}
@@ -0,0 +1,123 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type updateClassBatchApproval struct {
+	*baseSuite
+	alice sdk.AccAddress
+	bob   sdk.AccAddress
+	res   *types.MsgUpdateClassBatchApprovalResponse
+	err   error
+}
+
+func TestUpdateClassBatchApproval(t *testing.T) {
+	gocuke.NewRunner(t, &updateClassBatchApproval{}).Path("./features/msg_update_class_batch_approval.feature").Run()
+}
+
+func (s *updateClassBatchApproval) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *updateClassBatchApproval) ACreditClassWithIdAndAdminAlice(a string) {
+	err := s.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassBatchApproval) AliceHasRequiredBatchApprovalForCreditClassWithExpiryPeriod(a, b string) {
+	s.AliceAttemptsToRequireBatchApprovalForCreditClassWithExpiryPeriod(a, b)
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassBatchApproval) AliceAttemptsToRequireBatchApprovalForCreditClassWithExpiryPeriod(a, b string) {
+	s.updateClassBatchApproval(s.alice, a, true, b)
+}
+
+func (s *updateClassBatchApproval) BobAttemptsToRequireBatchApprovalForCreditClassWithExpiryPeriod(a, b string) {
+	s.updateClassBatchApproval(s.bob, a, true, b)
+}
+
+func (s *updateClassBatchApproval) AliceAttemptsToRemoveBatchApprovalForCreditClass(a string) {
+	s.updateClassBatchApproval(s.alice, a, false, "")
+}
+
+func (s *updateClassBatchApproval) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassBatchApproval) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateClassBatchApproval) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateClassBatchApproval) ExpectBatchApprovalForCreditClassWithExpiryPeriod(a, b string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	batchApproval, err := s.stateStore.ClassBatchApprovalTable().Get(s.ctx, class.Key)
+	require.NoError(s.t, err)
+
+	expiryPeriod, err := time.ParseDuration(b)
+	require.NoError(s.t, err)
+	require.Equal(s.t, expiryPeriod, batchApproval.ExpiryPeriod.AsDuration())
+}
+
+func (s *updateClassBatchApproval) ExpectNoBatchApprovalForCreditClass(a string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.ClassBatchApprovalTable().Has(s.ctx, class.Key)
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *updateClassBatchApproval) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventUpdateClassBatchApproval
+	err := jsonpb.UnmarshalString(a.Content, &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassBatchApproval) updateClassBatchApproval(admin sdk.AccAddress, classID string, requireApproval bool, expiryPeriod string) {
+	msg := &types.MsgUpdateClassBatchApproval{
+		Admin:           admin.String(),
+		ClassId:         classID,
+		RequireApproval: requireApproval,
+	}
+
+	if expiryPeriod != "" {
+		d, err := time.ParseDuration(expiryPeriod)
+		require.NoError(s.t, err)
+		msg.ExpiryPeriod = gogotypes.DurationProto(d)
+	}
+
+	s.res, s.err = s.k.UpdateClassBatchApproval(s.ctx, msg)
+}
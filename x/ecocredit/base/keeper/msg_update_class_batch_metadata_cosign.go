@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// UpdateClassBatchMetadataCosign updates whether the admin of a credit class
+// must co-sign updates to the metadata of the credit batches of the credit
+// class.
+func (k Keeper) UpdateClassBatchMetadataCosign(ctx context.Context, req *types.MsgUpdateClassBatchMetadataCosign) (*types.MsgUpdateClassBatchMetadataCosignResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit class with id %s: %s", req.ClassId, err,
+		)
+	}
+
+	admin := sdk.AccAddress(class.Admin)
+	if !reqAddr.Equals(admin) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, req.ClassId,
+		)
+	}
+
+	cosign := &api.ClassBatchMetadataCosign{ClassKey: class.Key}
+	if req.RequireCosign {
+		if err = k.stateStore.ClassBatchMetadataCosignTable().Save(ctx, cosign); err != nil {
+			return nil, err
+		}
+	} else {
+		found, err := k.stateStore.ClassBatchMetadataCosignTable().Has(ctx, class.Key)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			if err = k.stateStore.ClassBatchMetadataCosignTable().Delete(ctx, cosign); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventUpdateClassBatchMetadataCosign{
+		ClassId: req.ClassId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateClassBatchMetadataCosignResponse{}, nil
+}
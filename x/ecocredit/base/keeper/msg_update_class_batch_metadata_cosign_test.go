@@ -0,0 +1,109 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type updateClassBatchMetadataCosign struct {
+	*baseSuite
+	alice sdk.AccAddress
+	bob   sdk.AccAddress
+	res   *types.MsgUpdateClassBatchMetadataCosignResponse
+	err   error
+}
+
+func TestUpdateClassBatchMetadataCosign(t *testing.T) {
+	gocuke.NewRunner(t, &updateClassBatchMetadataCosign{}).Path("./features/msg_update_class_batch_metadata_cosign.feature").Run()
+}
+
+func (s *updateClassBatchMetadataCosign) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *updateClassBatchMetadataCosign) ACreditClassWithIdAndAdminAlice(a string) {
+	err := s.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassBatchMetadataCosign) AliceHasRequiredACosignatureForCreditClass(a string) {
+	s.AliceAttemptsToRequireACosignatureForCreditClass(a)
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassBatchMetadataCosign) AliceAttemptsToRequireACosignatureForCreditClass(a string) {
+	s.updateClassBatchMetadataCosign(s.alice, a, true)
+}
+
+func (s *updateClassBatchMetadataCosign) BobAttemptsToRequireACosignatureForCreditClass(a string) {
+	s.updateClassBatchMetadataCosign(s.bob, a, true)
+}
+
+func (s *updateClassBatchMetadataCosign) AliceAttemptsToRemoveTheCosignatureRequirementForCreditClass(a string) {
+	s.updateClassBatchMetadataCosign(s.alice, a, false)
+}
+
+func (s *updateClassBatchMetadataCosign) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassBatchMetadataCosign) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateClassBatchMetadataCosign) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateClassBatchMetadataCosign) ExpectCreditClassRequiresACosignature(a string) {
+	require.True(s.t, s.requiresCosign(a))
+}
+
+func (s *updateClassBatchMetadataCosign) ExpectCreditClassDoesNotRequireACosignature(a string) {
+	require.False(s.t, s.requiresCosign(a))
+}
+
+func (s *updateClassBatchMetadataCosign) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventUpdateClassBatchMetadataCosign
+	err := jsonpb.UnmarshalString(a.Content, &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassBatchMetadataCosign) requiresCosign(classID string) bool {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, classID)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.ClassBatchMetadataCosignTable().Has(s.ctx, class.Key)
+	require.NoError(s.t, err)
+	return found
+}
+
+func (s *updateClassBatchMetadataCosign) updateClassBatchMetadataCosign(admin sdk.AccAddress, classID string, requireCosign bool) {
+	s.res, s.err = s.k.UpdateClassBatchMetadataCosign(s.ctx, &types.MsgUpdateClassBatchMetadataCosign{
+		Admin:         admin.String(),
+		ClassId:       classID,
+		RequireCosign: requireCosign,
+	})
+}
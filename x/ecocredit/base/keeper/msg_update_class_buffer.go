@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// UpdateClassBuffer updates the buffer percentage of the class. A buffer
+// percentage of zero removes the buffer pool configuration of the class.
+func (k Keeper) UpdateClassBuffer(ctx context.Context, req *types.MsgUpdateClassBuffer) (*types.MsgUpdateClassBufferResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	classInfo, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit class with id %s: %s", req.ClassId, err,
+		)
+	}
+
+	admin := sdk.AccAddress(classInfo.Admin)
+	if !reqAddr.Equals(admin) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, req.ClassId,
+		)
+	}
+
+	percentage, err := math.NewNonNegativeDecFromString(req.BufferPercentage)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("buffer percentage: %s", err)
+	}
+
+	if percentage.IsZero() {
+		err = k.stateStore.ClassBufferTable().Delete(ctx, &api.ClassBuffer{ClassKey: classInfo.Key})
+		if err != nil && !ormerrors.IsNotFound(err) {
+			return nil, err
+		}
+	} else {
+		if err = k.stateStore.ClassBufferTable().Save(ctx, &api.ClassBuffer{
+			ClassKey:   classInfo.Key,
+			Percentage: percentage.String(),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventUpdateClassBuffer{
+		ClassId:          req.ClassId,
+		BufferPercentage: percentage.String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateClassBufferResponse{}, nil
+}
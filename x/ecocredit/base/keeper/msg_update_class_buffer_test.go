@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type updateClassBuffer struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	classKey uint64
+	res      *types.MsgUpdateClassBufferResponse
+	err      error
+}
+
+func TestUpdateClassBuffer(t *testing.T) {
+	gocuke.NewRunner(t, &updateClassBuffer{}).Path("./features/msg_update_class_buffer.feature").Run()
+}
+
+func (s *updateClassBuffer) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *updateClassBuffer) ACreditTypeWithAbbreviation(a string) {
+	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassBuffer) ACreditClassWithClassIdAndAdminAlice(a string) {
+	cKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: base.GetCreditTypeAbbrevFromClassID(a),
+	})
+	require.NoError(s.t, err)
+
+	s.classKey = cKey
+}
+
+func (s *updateClassBuffer) TheCreditClassHasBufferPercentage(a string) {
+	err := s.k.stateStore.ClassBufferTable().Insert(s.ctx, &api.ClassBuffer{
+		ClassKey:   s.classKey,
+		Percentage: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassBuffer) AliceAttemptsToUpdateClassBufferWithClassIdAndBufferPercentage(a, b string) {
+	s.res, s.err = s.k.UpdateClassBuffer(s.ctx, &types.MsgUpdateClassBuffer{
+		Admin:            s.alice.String(),
+		ClassId:          a,
+		BufferPercentage: b,
+	})
+}
+
+func (s *updateClassBuffer) BobAttemptsToUpdateClassBufferWithClassIdAndBufferPercentage(a, b string) {
+	s.res, s.err = s.k.UpdateClassBuffer(s.ctx, &types.MsgUpdateClassBuffer{
+		Admin:            s.bob.String(),
+		ClassId:          a,
+		BufferPercentage: b,
+	})
+}
+
+func (s *updateClassBuffer) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassBuffer) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateClassBuffer) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateClassBuffer) ExpectClassBufferPercentage(a string) {
+	classBuffer, err := s.k.stateStore.ClassBufferTable().Get(s.ctx, s.classKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, classBuffer.Percentage)
+}
+
+func (s *updateClassBuffer) ExpectNoClassBuffer() {
+	_, err := s.k.stateStore.ClassBufferTable().Get(s.ctx, s.classKey)
+	require.ErrorIs(s.t, err, ormerrors.NotFound)
+}
+
+func (s *updateClassBuffer) ExpectEventUpdateClassBufferWithProperties(a gocuke.DocString) {
+	var event types.EventUpdateClassBuffer
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
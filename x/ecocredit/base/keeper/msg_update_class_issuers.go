@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -12,6 +13,8 @@ import (
 )
 
 // UpdateClassIssuers updates a class's issuers by either adding more issuers, or removing issuers from the class issuer store.
+// The issuance caps of the class issuers and the issuance cap of each project within each vintage year are updated after
+// issuers have been added and removed.
 func (k Keeper) UpdateClassIssuers(ctx context.Context, req *types.MsgUpdateClassIssuers) (*types.MsgUpdateClassIssuersResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
@@ -65,6 +68,44 @@ func (k Keeper) UpdateClassIssuers(ctx context.Context, req *types.MsgUpdateClas
 		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgUpdateClassIssuers issuer iteration")
 	}
 
+	// update issuer caps
+	for _, issuerCap := range req.IssuerCaps {
+		issuerAcc, err := sdk.AccAddressFromBech32(issuerCap.Issuer)
+		if err != nil {
+			return nil, err
+		}
+		classIssuer, err := k.stateStore.ClassIssuerTable().Get(ctx, class.Key, issuerAcc)
+		if err != nil {
+			if ormerrors.IsNotFound(err) {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+					"%s is not an issuer of credit class %s", issuerCap.Issuer, req.ClassId,
+				)
+			}
+			return nil, err
+		}
+		classIssuer.MaxIssuance = issuerCap.MaxIssuance
+		if err = k.stateStore.ClassIssuerTable().Update(ctx, classIssuer); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgUpdateClassIssuers issuer iteration")
+	}
+
+	// update project vintage cap
+	if req.ProjectVintageCap != nil {
+		if req.ProjectVintageCap.MaxIssuance == "" {
+			err = k.stateStore.ClassIssuanceCapTable().Delete(ctx, &api.ClassIssuanceCap{ClassKey: class.Key})
+		} else {
+			err = k.stateStore.ClassIssuanceCapTable().Save(ctx, &api.ClassIssuanceCap{
+				ClassKey:                  class.Key,
+				MaxProjectVintageIssuance: req.ProjectVintageCap.MaxIssuance,
+			})
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventUpdateClassIssuers{
 		ClassId: req.ClassId,
 	}); err != nil {
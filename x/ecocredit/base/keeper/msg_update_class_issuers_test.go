@@ -8,6 +8,7 @@ import (
 	"github.com/regen-network/gocuke"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
@@ -122,6 +123,49 @@ func (s *updateClassIssuers) AliceAttemptsToUpdateClassIssuersWithClassIdAndRemo
 	})
 }
 
+func (s *updateClassIssuers) AliceAttemptsToUpdateClassIssuersWithClassIdAndIssuerCaps(a string, b gocuke.DocString) {
+	var issuerCaps []*types.MsgUpdateClassIssuers_IssuerCap
+	err := json.Unmarshal([]byte(b.Content), &issuerCaps)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.UpdateClassIssuers(s.ctx, &types.MsgUpdateClassIssuers{
+		Admin:      s.alice.String(),
+		ClassId:    a,
+		IssuerCaps: issuerCaps,
+	})
+}
+
+func (s *updateClassIssuers) AliceHasUpdatedClassIssuersWithClassIdAndIssuerCaps(a string, b gocuke.DocString) {
+	s.AliceAttemptsToUpdateClassIssuersWithClassIdAndIssuerCaps(a, b)
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassIssuers) AliceAttemptsToUpdateClassIssuersWithClassIdAddIssuersAndIssuerCaps(a string, b gocuke.DocString) {
+	msg := &types.MsgUpdateClassIssuers{}
+	err := json.Unmarshal([]byte(b.Content), msg)
+	require.NoError(s.t, err)
+
+	msg.Admin = s.alice.String()
+	msg.ClassId = a
+
+	s.res, s.err = s.k.UpdateClassIssuers(s.ctx, msg)
+}
+
+func (s *updateClassIssuers) AliceAttemptsToUpdateClassIssuersWithClassIdAndProjectVintageCap(a, b string) {
+	s.res, s.err = s.k.UpdateClassIssuers(s.ctx, &types.MsgUpdateClassIssuers{
+		Admin:   s.alice.String(),
+		ClassId: a,
+		ProjectVintageCap: &types.MsgUpdateClassIssuers_ProjectVintageCap{
+			MaxIssuance: b,
+		},
+	})
+}
+
+func (s *updateClassIssuers) AliceHasUpdatedClassIssuersWithClassIdAndProjectVintageCap(a, b string) {
+	s.AliceAttemptsToUpdateClassIssuersWithClassIdAndProjectVintageCap(a, b)
+	require.NoError(s.t, s.err)
+}
+
 func (s *updateClassIssuers) ExpectNoError() {
 	require.NoError(s.t, s.err)
 }
@@ -164,3 +208,28 @@ func (s *updateClassIssuers) ExpectEventWithProperties(a gocuke.DocString) {
 	err = testutil.MatchEvent(&event, sdkEvent)
 	require.NoError(s.t, err)
 }
+
+func (s *updateClassIssuers) ExpectIssuerOfCreditClassWithMaxIssuance(a, b, c string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	issuer, err := sdk.AccAddressFromBech32(a)
+	require.NoError(s.t, err)
+
+	classIssuer, err := s.stateStore.ClassIssuerTable().Get(s.ctx, class.Key, issuer)
+	require.NoError(s.t, err)
+	require.Equal(s.t, c, classIssuer.MaxIssuance)
+}
+
+func (s *updateClassIssuers) ExpectCreditClassWithClassIdAndProjectVintageCap(a, b string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	issuanceCap, err := s.stateStore.ClassIssuanceCapTable().Get(s.ctx, class.Key)
+	if b == "" {
+		require.ErrorIs(s.t, err, ormerrors.NotFound)
+		return
+	}
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, issuanceCap.MaxProjectVintageIssuance)
+}
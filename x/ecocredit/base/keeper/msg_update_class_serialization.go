@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// UpdateClassSerialization updates whether or not the credits issued within a
+// credit class are identified by serial number ranges.
+func (k Keeper) UpdateClassSerialization(ctx context.Context, req *types.MsgUpdateClassSerialization) (*types.MsgUpdateClassSerializationResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit class with id %s: %s", req.ClassId, err,
+		)
+	}
+
+	admin := sdk.AccAddress(class.Admin)
+	if !reqAddr.Equals(admin) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, req.ClassId,
+		)
+	}
+
+	// serial number ranges are allocated at issuance and therefore the setting
+	// cannot be changed once credits have been issued within the credit class
+	issued, err := k.stateStore.ClassSupplyTable().Has(ctx, class.Key)
+	if err != nil {
+		return nil, err
+	}
+	if issued {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"cannot update serialization of credit class %s: credits have already been issued", req.ClassId,
+		)
+	}
+
+	serialization := &api.ClassSerialization{ClassKey: class.Key}
+	if req.Serialized {
+		creditType, err := k.stateStore.CreditTypeTable().Get(ctx, class.CreditTypeAbbrev)
+		if err != nil {
+			return nil, err
+		}
+		if creditType.Precision != 0 {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"cannot serialize credit class %s: credit type %s has a precision of %d, expected 0",
+				req.ClassId, creditType.Abbreviation, creditType.Precision,
+			)
+		}
+		if err = k.stateStore.ClassSerializationTable().Save(ctx, serialization); err != nil {
+			return nil, err
+		}
+	} else {
+		found, err := k.stateStore.ClassSerializationTable().Has(ctx, class.Key)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			if err = k.stateStore.ClassSerializationTable().Delete(ctx, serialization); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventUpdateClassSerialization{
+		ClassId:    req.ClassId,
+		Serialized: req.Serialized,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateClassSerializationResponse{}, nil
+}
+
+// assertSameSerialization asserts that a project with issued credits is not
+// moved between a serialized and a non-serialized credit class, which would
+// leave the credits of the project with missing or stale serial numbers.
+func (k Keeper) assertSameSerialization(ctx context.Context, project *api.Project, class, newClass *api.Class) error {
+	issued, err := k.stateStore.ProjectSupplyTable().Has(ctx, project.Key)
+	if err != nil || !issued {
+		return err
+	}
+
+	serialized, err := k.stateStore.ClassSerializationTable().Has(ctx, class.Key)
+	if err != nil {
+		return err
+	}
+
+	newSerialized, err := k.stateStore.ClassSerializationTable().Has(ctx, newClass.Key)
+	if err != nil {
+		return err
+	}
+
+	if serialized != newSerialized {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"serialization of credit class %s does not match serialization of credit class %s",
+			newClass.Id, class.Id,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,132 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type updateClassSerialization struct {
+	*baseSuite
+	alice sdk.AccAddress
+	bob   sdk.AccAddress
+	res   *types.MsgUpdateClassSerializationResponse
+	err   error
+}
+
+func TestUpdateClassSerialization(t *testing.T) {
+	gocuke.NewRunner(t, &updateClassSerialization{}).Path("./features/msg_update_class_serialization.feature").Run()
+}
+
+func (s *updateClassSerialization) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *updateClassSerialization) ACreditTypeWithAbbreviationAndPrecision(a string, b int64) {
+	err := s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+		Unit:         "unit",
+		Precision:    uint32(b),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassSerialization) ACreditClassWithIdCreditTypeAndAdminAlice(a, b string) {
+	err := s.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: b,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassSerialization) CreditsHaveBeenIssuedWithinCreditClass(a string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.ClassSupplyTable().Insert(s.ctx, &api.ClassSupply{
+		ClassKey:        class.Key,
+		TradableAmount:  "10",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassSerialization) AliceHasEnabledSerializationForCreditClass(a string) {
+	s.AliceAttemptsToEnableSerializationForCreditClass(a)
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassSerialization) AliceAttemptsToEnableSerializationForCreditClass(a string) {
+	s.updateClassSerialization(s.alice, a, true)
+}
+
+func (s *updateClassSerialization) BobAttemptsToEnableSerializationForCreditClass(a string) {
+	s.updateClassSerialization(s.bob, a, true)
+}
+
+func (s *updateClassSerialization) AliceAttemptsToDisableSerializationForCreditClass(a string) {
+	s.updateClassSerialization(s.alice, a, false)
+}
+
+func (s *updateClassSerialization) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassSerialization) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateClassSerialization) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateClassSerialization) ExpectCreditClassIsSerialized(a string) {
+	require.True(s.t, s.isSerialized(a))
+}
+
+func (s *updateClassSerialization) ExpectCreditClassIsNotSerialized(a string) {
+	require.False(s.t, s.isSerialized(a))
+}
+
+func (s *updateClassSerialization) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventUpdateClassSerialization
+	err := jsonpb.UnmarshalString(a.Content, &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassSerialization) isSerialized(classID string) bool {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, classID)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.ClassSerializationTable().Has(s.ctx, class.Key)
+	require.NoError(s.t, err)
+	return found
+}
+
+func (s *updateClassSerialization) updateClassSerialization(admin sdk.AccAddress, classID string, serialized bool) {
+	s.res, s.err = s.k.UpdateClassSerialization(s.ctx, &types.MsgUpdateClassSerialization{
+		Admin:      admin.String(),
+		ClassId:    classID,
+		Serialized: serialized,
+	})
+}
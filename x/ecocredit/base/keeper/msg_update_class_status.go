@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// UpdateClassStatus updates the status of a credit class. Only the admin of the
+// credit class or the governance authority can update the status.
+func (k Keeper) UpdateClassStatus(ctx context.Context, req *types.MsgUpdateClassStatus) (*types.MsgUpdateClassStatusResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	signer, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit class with id %s: %s", req.ClassId, err,
+		)
+	}
+
+	if !signer.Equals(sdk.AccAddress(class.Admin)) && !signer.Equals(k.authority) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s or the governance authority", req.Signer, req.ClassId,
+		)
+	}
+
+	previousStatus, err := getClassStatus(ctx, k.stateStore, class.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	status := api.ClassStatus(req.Status)
+	if status == previousStatus {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"credit class %s already has status %s", req.ClassId, status,
+		)
+	}
+
+	if err = k.stateStore.ClassLifecycleTable().Save(ctx, &api.ClassLifecycle{
+		ClassKey: class.Key,
+		Status:   uint32(status),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventUpdateClassStatus{
+		ClassId:        req.ClassId,
+		PreviousStatus: types.ClassStatus(previousStatus),
+		Status:         req.Status,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateClassStatusResponse{}, nil
+}
@@ -0,0 +1,109 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type updateClassStatus struct {
+	*baseSuite
+	alice sdk.AccAddress
+	bob   sdk.AccAddress
+	res   *types.MsgUpdateClassStatusResponse
+	err   error
+}
+
+func TestUpdateClassStatus(t *testing.T) {
+	gocuke.NewRunner(t, &updateClassStatus{}).Path("./features/msg_update_class_status.feature").Run()
+}
+
+func (s *updateClassStatus) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *updateClassStatus) ACreditClassWithIdAndAdminAlice(a string) {
+	err := s.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassStatus) CreditClassHasStatus(a, b string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.ClassLifecycleTable().Insert(s.ctx, &api.ClassLifecycle{
+		ClassKey: class.Key,
+		Status:   uint32(api.ClassStatus_value[b]),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassStatus) AliceAttemptsToUpdateTheStatusOfCreditClassTo(a, b string) {
+	s.updateClassStatus(s.alice, a, b)
+}
+
+func (s *updateClassStatus) BobAttemptsToUpdateTheStatusOfCreditClassTo(a, b string) {
+	s.updateClassStatus(s.bob, a, b)
+}
+
+func (s *updateClassStatus) TheAuthorityAttemptsToUpdateTheStatusOfCreditClassTo(a, b string) {
+	s.updateClassStatus(s.authority, a, b)
+}
+
+func (s *updateClassStatus) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassStatus) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateClassStatus) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateClassStatus) ExpectCreditClassHasStatus(a, b string) {
+	require.NoError(s.t, s.err)
+
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	lifecycle, err := s.stateStore.ClassLifecycleTable().Get(s.ctx, class.Key)
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, api.ClassStatus(lifecycle.Status).String())
+}
+
+func (s *updateClassStatus) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventUpdateClassStatus
+	err := jsonpb.UnmarshalString(a.Content, &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassStatus) updateClassStatus(signer sdk.AccAddress, classID, status string) {
+	s.res, s.err = s.k.UpdateClassStatus(s.ctx, &types.MsgUpdateClassStatus{
+		Signer:  signer.String(),
+		ClassId: classID,
+		Status:  types.ClassStatus(types.ClassStatus_value[status]),
+	})
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// UpdateClassVerifiers updates a class's verifiers by either adding more verifiers, or removing verifiers from the class verifier store.
+func (k Keeper) UpdateClassVerifiers(ctx context.Context, req *types.MsgUpdateClassVerifiers) (*types.MsgUpdateClassVerifiersResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit class with id %s: %s", req.ClassId, err,
+		)
+	}
+
+	admin := sdk.AccAddress(class.Admin)
+	if !reqAddr.Equals(admin) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, req.ClassId,
+		)
+	}
+
+	// remove verifiers
+	for _, verifier := range req.RemoveVerifiers {
+		verifierAcc, err := sdk.AccAddressFromBech32(verifier)
+		if err != nil {
+			return nil, err
+		}
+		if err = k.stateStore.ClassVerifierTable().Delete(ctx, &api.ClassVerifier{
+			ClassKey: class.Key,
+			Verifier: verifierAcc,
+		}); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgUpdateClassVerifiers verifier iteration")
+	}
+
+	// add the new verifiers
+	for _, verifier := range req.AddVerifiers {
+		verifierAcc, err := sdk.AccAddressFromBech32(verifier)
+		if err != nil {
+			return nil, err
+		}
+		if err = k.stateStore.ClassVerifierTable().Insert(ctx, &api.ClassVerifier{
+			ClassKey: class.Key,
+			Verifier: verifierAcc,
+		}); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/MsgUpdateClassVerifiers verifier iteration")
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventUpdateClassVerifiers{
+		ClassId: req.ClassId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateClassVerifiersResponse{}, nil
+}
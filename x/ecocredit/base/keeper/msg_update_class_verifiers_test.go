@@ -0,0 +1,166 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type updateClassVerifiers struct {
+	*baseSuite
+	alice sdk.AccAddress
+	bob   sdk.AccAddress
+	res   *types.MsgUpdateClassVerifiersResponse
+	err   error
+}
+
+func TestUpdateClassVerifiers(t *testing.T) {
+	gocuke.NewRunner(t, &updateClassVerifiers{}).Path("./features/msg_update_class_verifiers.feature").Run()
+}
+
+func (s *updateClassVerifiers) Before(t gocuke.TestingT) {
+	// TODO: move to init function in the root directory of the module #1243
+	cfg := sdk.GetConfig()
+	cfg.SetBech32PrefixForAccount("regen", "regenpub")
+
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *updateClassVerifiers) ACreditTypeWithAbbreviation(a string) {
+	// TODO: Save for now but credit type should not exist prior to unit test #893
+	err := s.k.stateStore.CreditTypeTable().Save(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassVerifiers) ACreditClassWithClassIdAndAdminAlice(a string) {
+	creditTypeAbbrev := base.GetCreditTypeAbbrevFromClassID(a)
+
+	err := s.k.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassVerifiers) ACreditClassWithClassIdAdminAliceAndVerifiers(a string, b gocuke.DocString) {
+	creditTypeAbbrev := base.GetCreditTypeAbbrevFromClassID(a)
+
+	cKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	var verifiers []string
+	err = json.Unmarshal([]byte(b.Content), &verifiers)
+	require.NoError(s.t, err)
+
+	for _, verifier := range verifiers {
+		bz, err := sdk.AccAddressFromBech32(verifier)
+		require.NoError(s.t, err)
+
+		err = s.k.stateStore.ClassVerifierTable().Insert(s.ctx, &api.ClassVerifier{
+			ClassKey: cKey,
+			Verifier: bz,
+		})
+		require.NoError(s.t, err)
+	}
+}
+
+func (s *updateClassVerifiers) AliceAttemptsToUpdateClassVerifiersWithClassId(a string) {
+	s.res, s.err = s.k.UpdateClassVerifiers(s.ctx, &types.MsgUpdateClassVerifiers{
+		Admin:   s.alice.String(),
+		ClassId: a,
+	})
+}
+
+func (s *updateClassVerifiers) BobAttemptsToUpdateClassVerifiersWithClassId(a string) {
+	s.res, s.err = s.k.UpdateClassVerifiers(s.ctx, &types.MsgUpdateClassVerifiers{
+		Admin:   s.bob.String(),
+		ClassId: a,
+	})
+}
+
+func (s *updateClassVerifiers) AliceAttemptsToUpdateClassVerifiersWithClassIdAndAddVerifiers(a string, b gocuke.DocString) {
+	var addVerifiers []string
+	err := json.Unmarshal([]byte(b.Content), &addVerifiers)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.UpdateClassVerifiers(s.ctx, &types.MsgUpdateClassVerifiers{
+		Admin:        s.alice.String(),
+		ClassId:      a,
+		AddVerifiers: addVerifiers,
+	})
+}
+
+func (s *updateClassVerifiers) AliceAttemptsToUpdateClassVerifiersWithClassIdAndRemoveVerifiers(a string, b gocuke.DocString) {
+	var removeVerifiers []string
+	err := json.Unmarshal([]byte(b.Content), &removeVerifiers)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.UpdateClassVerifiers(s.ctx, &types.MsgUpdateClassVerifiers{
+		Admin:           s.alice.String(),
+		ClassId:         a,
+		RemoveVerifiers: removeVerifiers,
+	})
+}
+
+func (s *updateClassVerifiers) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassVerifiers) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateClassVerifiers) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateClassVerifiers) ExpectCreditClassWithClassIdAndVerifiers(a string, b gocuke.DocString) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	var verifiers []string
+	err = json.Unmarshal([]byte(b.Content), &verifiers)
+	require.NoError(s.t, err)
+
+	for _, verifier := range verifiers {
+		bx, err := sdk.AccAddressFromBech32(verifier)
+		require.NoError(s.t, err)
+
+		found, err := s.stateStore.ClassVerifierTable().Has(s.ctx, class.Key, bx)
+		require.NoError(s.t, err)
+
+		require.True(s.t, found)
+	}
+}
+
+func (s *updateClassVerifiers) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventUpdateClassVerifiers
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
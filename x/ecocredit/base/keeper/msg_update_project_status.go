@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// UpdateProjectStatus updates the lifecycle status of a project and records
+// the status change in the status history of the project. Only the admin or a
+// verifier of the credit class can update the status, and the status of a
+// retired project cannot be updated.
+func (k Keeper) UpdateProjectStatus(ctx context.Context, req *types.MsgUpdateProjectStatus) (*types.MsgUpdateProjectStatusResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	signer, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get project with id %s: %s", req.ProjectId, err,
+		)
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.assertClassAdminOrVerifier(ctx, class, signer); err != nil {
+		return nil, err
+	}
+
+	previousStatus, err := getProjectStatus(ctx, k.stateStore, project.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	if previousStatus == api.ProjectStatus_PROJECT_STATUS_RETIRED {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"project %s is retired and its status cannot be updated", req.ProjectId,
+		)
+	}
+
+	status := api.ProjectStatus(req.Status)
+	if status == previousStatus {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"project %s already has status %s", req.ProjectId, status,
+		)
+	}
+
+	if err = k.stateStore.ProjectLifecycleTable().Save(ctx, &api.ProjectLifecycle{
+		ProjectKey: project.Key,
+		Status:     uint32(status),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = k.stateStore.ProjectStatusChangeTable().Insert(ctx, &api.ProjectStatusChange{
+		ProjectKey: project.Key,
+		Status:     status,
+		Signer:     signer,
+		Reason:     req.Reason,
+		Timestamp:  timestamppb.New(sdkCtx.BlockTime()),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventUpdateProjectStatus{
+		ProjectId:      req.ProjectId,
+		PreviousStatus: types.ProjectStatus(previousStatus),
+		Status:         req.Status,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateProjectStatusResponse{}, nil
+}
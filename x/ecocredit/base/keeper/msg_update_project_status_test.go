@@ -0,0 +1,154 @@
+//nolint:revive,stylecheck
+package keeper
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type updateProjectStatus struct {
+	*baseSuite
+	alice      sdk.AccAddress
+	bob        sdk.AccAddress
+	classKey   uint64
+	projectKey uint64
+	res        *types.MsgUpdateProjectStatusResponse
+	err        error
+}
+
+func TestUpdateProjectStatus(t *testing.T) {
+	gocuke.NewRunner(t, &updateProjectStatus{}).Path("./features/msg_update_project_status.feature").Run()
+}
+
+func (s *updateProjectStatus) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *updateProjectStatus) AProjectWithIdAndCreditClassAdminAlice(a string) {
+	var err error
+	s.classKey, err = s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               testClassID,
+		Admin:            s.alice,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(s.t, err)
+
+	s.projectKey, err = s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: s.classKey,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectStatus) BobIsAVerifierOfTheCreditClass() {
+	err := s.stateStore.ClassVerifierTable().Insert(s.ctx, &api.ClassVerifier{
+		ClassKey: s.classKey,
+		Verifier: s.bob,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectStatus) TheProjectHasStatus(a string) {
+	err := s.stateStore.ProjectLifecycleTable().Insert(s.ctx, &api.ProjectLifecycle{
+		ProjectKey: s.projectKey,
+		Status:     uint32(types.ProjectStatus_value[a]),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectStatus) AliceAttemptsToUpdateTheStatusOfProjectTo(a, b string) {
+	s.updateProjectStatus(s.alice, a, b, "")
+}
+
+func (s *updateProjectStatus) BobAttemptsToUpdateTheStatusOfProjectTo(a, b string) {
+	s.updateProjectStatus(s.bob, a, b, "")
+}
+
+func (s *updateProjectStatus) AliceAttemptsToUpdateTheStatusOfProjectToWithReason(a, b, c string) {
+	s.updateProjectStatus(s.alice, a, b, c)
+}
+
+func (s *updateProjectStatus) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateProjectStatus) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateProjectStatus) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateProjectStatus) ExpectProjectWithIdAndStatus(a, b string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	lifecycle, err := s.stateStore.ProjectLifecycleTable().Get(s.ctx, project.Key)
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, api.ProjectStatus(lifecycle.Status).String())
+}
+
+func (s *updateProjectStatus) ExpectProjectWithIdAndStatusHistory(a string, b gocuke.DocString) {
+	var expected []struct {
+		Status string `json:"status"`
+		Reason string `json:"reason"`
+	}
+	err := json.Unmarshal([]byte(b.Content), &expected)
+	require.NoError(s.t, err)
+
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	it, err := s.stateStore.ProjectStatusChangeTable().List(s.ctx, api.ProjectStatusChangeProjectKeyIndexKey{}.WithProjectKey(project.Key))
+	require.NoError(s.t, err)
+	defer it.Close()
+
+	var changes []*api.ProjectStatusChange
+	for it.Next() {
+		change, err := it.Value()
+		require.NoError(s.t, err)
+		changes = append(changes, change)
+	}
+
+	require.Len(s.t, changes, len(expected))
+	for i, change := range changes {
+		require.Equal(s.t, expected[i].Status, change.Status.String())
+		require.Equal(s.t, expected[i].Reason, change.Reason)
+		require.Equal(s.t, s.alice.Bytes(), change.Signer)
+		require.NotNil(s.t, change.Timestamp)
+	}
+}
+
+func (s *updateProjectStatus) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventUpdateProjectStatus
+	err := jsonpb.UnmarshalString(a.Content, &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectStatus) updateProjectStatus(signer sdk.AccAddress, projectID, status, reason string) {
+	s.res, s.err = s.k.UpdateProjectStatus(s.ctx, &types.MsgUpdateProjectStatus{
+		Signer:    signer.String(),
+		ProjectId: projectID,
+		Status:    types.ProjectStatus(types.ProjectStatus_value[status]),
+		Reason:    reason,
+	})
+}
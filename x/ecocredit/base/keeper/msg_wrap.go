@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// Wrap moves tradable credits from the balance of the owner into the escrow
+// balance of the wrap submodule and mints the equivalent amount of wrapped
+// tokens to the owner. The denom metadata of the wrapped denom is registered
+// with the bank module when credits from the credit batch are first wrapped.
+func (k Keeper) Wrap(ctx context.Context, req *types.MsgWrap) (*types.MsgWrapResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := math.NewPositiveFixedDecFromString(req.Amount, creditType.Precision)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("amount: %s", err)
+	}
+
+	denom, displayDenom, err := base.FormatWrappedDenom(batch.Denom, creditType.Precision)
+	if err != nil {
+		return nil, err
+	}
+
+	coin, err := creditAmountToWrappedCoin(amount, creditType.Precision, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// the denom metadata is registered when credits from the credit batch are
+	// first moved into the escrow of the wrap submodule
+	wrapped, err := k.stateStore.BatchBalanceTable().Has(ctx, wrapAddress, batch.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	// wrapped credits are held in escrow by the wrap submodule
+	if err = TransferCredits(ctx, k.stateStore, batch, owner, wrapAddress, amount); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if !wrapped {
+		k.bankKeeper.SetDenomMetaData(sdkCtx, wrappedDenomMetadata(batch.Denom, denom, displayDenom, creditType.Precision))
+	}
+
+	coins := sdk.NewCoins(coin)
+	if err = k.bankKeeper.MintCoins(sdkCtx, base.WrapSubModuleName, coins); err != nil {
+		return nil, err
+	}
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, base.WrapSubModuleName, owner, coins); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventWrap{
+		Owner:      req.Owner,
+		BatchDenom: batch.Denom,
+		Amount:     req.Amount,
+		Denom:      denom,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWrapResponse{
+		Denom:  denom,
+		Amount: coin.Amount.String(),
+	}, nil
+}
+
+// wrappedDenomMetadata returns the bank denom metadata of the wrapped denom of
+// a credit batch. The display denom unit uses the precision of the credit type.
+func wrappedDenomMetadata(batchDenom, denom, displayDenom string, precision uint32) banktypes.Metadata {
+	// denomination units must be in ascending order and the first
+	// denomination unit must be the base
+	denomUnits := []*banktypes.DenomUnit{{Denom: displayDenom, Exponent: precision}}
+	if precision != 0 {
+		denomUnits = []*banktypes.DenomUnit{
+			{Denom: denom},
+			{Denom: displayDenom, Exponent: precision},
+		}
+	}
+
+	return banktypes.Metadata{
+		DenomUnits:  denomUnits,
+		Description: fmt.Sprintf("Wrapped credits from credit batch %s", batchDenom),
+		Base:        denom,
+		Display:     displayDenom,
+		Name:        batchDenom,
+		Symbol:      batchDenom,
+	}
+}
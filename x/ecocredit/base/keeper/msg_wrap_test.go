@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/golang/mock/gomock"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/testutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+type wrapSuite struct {
+	*baseSuite
+	alice             sdk.AccAddress
+	creditTypeAbbrev  string
+	batchDenom        string
+	batchKey          uint64
+	denomMetadata     []banktypes.Metadata
+	aliceTokenBalance sdk.Coins
+	res               *types.MsgWrapResponse
+	err               error
+}
+
+func TestWrap(t *testing.T) {
+	gocuke.NewRunner(t, &wrapSuite{}).Path("./features/msg_wrap.feature").Run()
+}
+
+func (s *wrapSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.batchDenom = testBatchDenom
+	s.aliceTokenBalance = sdk.NewCoins()
+
+	s.bankKeeper.EXPECT().
+		SetDenomMetaData(gomock.Any(), gomock.Any()).
+		Do(func(_ sdk.Context, metadata banktypes.Metadata) {
+			s.denomMetadata = append(s.denomMetadata, metadata)
+		}).
+		AnyTimes() // not expected on failed attempt
+
+	s.bankKeeper.EXPECT().
+		MintCoins(gomock.Any(), base.WrapSubModuleName, gomock.Any()).
+		Return(nil).
+		AnyTimes() // not expected on failed attempt
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gomock.Any(), base.WrapSubModuleName, gomock.Any(), gomock.Any()).
+		Do(func(_ sdk.Context, _ string, _ sdk.AccAddress, coins sdk.Coins) {
+			// simulate token balance update unavailable with mocks
+			s.aliceTokenBalance = s.aliceTokenBalance.Add(coins...)
+		}).
+		Return(nil).
+		AnyTimes() // not expected on failed attempt
+}
+
+func (s *wrapSuite) AlicesAddress(a string) {
+	addr, err := sdk.AccAddressFromBech32(a)
+	require.NoError(s.t, err)
+	s.alice = addr
+}
+
+func (s *wrapSuite) ACreditTypeWithAbbreviationAndPrecision(a, b string) {
+	precision, err := strconv.ParseUint(b, 10, 32)
+	require.NoError(s.t, err)
+
+	err = s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Precision:    uint32(precision),
+	})
+	require.NoError(s.t, err)
+
+	s.creditTypeAbbrev = a
+}
+
+func (s *wrapSuite) ACreditBatchWithDenom(a string) {
+	cKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               testClassID,
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	pKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       testProjectID,
+		ClassKey: cKey,
+	})
+	require.NoError(s.t, err)
+
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: pKey,
+		Denom:      a,
+	})
+	require.NoError(s.t, err)
+
+	err = s.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:        bKey,
+		TradableAmount:  "10",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(s.t, err)
+
+	s.batchDenom = a
+	s.batchKey = bKey
+}
+
+func (s *wrapSuite) AliceOwnsTradableCreditAmount(a string) {
+	err := s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        s.alice,
+		TradableAmount: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *wrapSuite) AliceAttemptsToWrapCreditAmount(a string) {
+	s.res, s.err = s.k.Wrap(s.ctx, &types.MsgWrap{
+		Owner:      s.alice.String(),
+		BatchDenom: s.batchDenom,
+		Amount:     a,
+	})
+}
+
+func (s *wrapSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *wrapSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *wrapSuite) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *wrapSuite) ExpectAliceTradableCreditAmount(a string) {
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.alice, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, a, balance.TradableAmount)
+}
+
+func (s *wrapSuite) ExpectWrapSubmoduleTradableCreditAmount(a string) {
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, wrapAddress, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, a, balance.TradableAmount)
+}
+
+func (s *wrapSuite) ExpectDenomMetadataWithBaseAndDisplay(a, b string) {
+	require.Len(s.t, s.denomMetadata, 1)
+
+	metadata := s.denomMetadata[0]
+	require.NoError(s.t, metadata.Validate())
+	require.Equal(s.t, a, metadata.Base)
+	require.Equal(s.t, b, metadata.Display)
+	require.Equal(s.t, s.batchDenom, metadata.Name)
+}
+
+func (s *wrapSuite) ExpectDenomMetadataToBeSetOnce() {
+	require.Len(s.t, s.denomMetadata, 1)
+}
+
+func (s *wrapSuite) ExpectAliceWrappedTokenBalance(a string) {
+	expected, err := sdk.ParseCoinsNormalized(a)
+	require.NoError(s.t, err)
+
+	require.True(s.t, expected.IsEqual(s.aliceTokenBalance))
+}
+
+func (s *wrapSuite) ExpectTheResponse(a gocuke.DocString) {
+	res := &types.MsgWrapResponse{}
+	err := jsonpb.UnmarshalString(a.Content, res)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, res, s.res)
+}
+
+func (s *wrapSuite) ExpectEventWithProperties(a gocuke.DocString) {
+	var event types.EventWrap
+	err := json.Unmarshal([]byte(a.Content), &event)
+	require.NoError(s.t, err)
+
+	sdkEvent, found := testutil.GetEvent(&event, s.sdkCtx.EventManager().Events())
+	require.True(s.t, found)
+
+	err = testutil.MatchEvent(&event, sdkEvent)
+	require.NoError(s.t, err)
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+)
+
+// getProjectStatus returns the status of the project or an unspecified status
+// if the status of the project has never been updated.
+func getProjectStatus(ctx context.Context, ss api.StateStore, projectKey uint64) (api.ProjectStatus, error) {
+	lifecycle, err := ss.ProjectLifecycleTable().Get(ctx, projectKey)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return api.ProjectStatus_PROJECT_STATUS_UNSPECIFIED, nil
+		}
+		return api.ProjectStatus_PROJECT_STATUS_UNSPECIFIED, err
+	}
+
+	return api.ProjectStatus(lifecycle.Status), nil
+}
+
+// AssertProjectCanIssue makes sure that credits can be issued for the project.
+// Credits can only be issued for a project with an active status or a project
+// whose status has never been updated. Returns ErrInvalidRequest otherwise.
+func AssertProjectCanIssue(ctx context.Context, ss api.StateStore, project *api.Project) error {
+	status, err := getProjectStatus(ctx, ss, project.Key)
+	if err != nil {
+		return err
+	}
+
+	if status != api.ProjectStatus_PROJECT_STATUS_UNSPECIFIED && status != api.ProjectStatus_PROJECT_STATUS_ACTIVE {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"cannot issue credits for project %s with status %s", project.Id, status,
+		)
+	}
+
+	return nil
+}
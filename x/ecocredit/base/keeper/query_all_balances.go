@@ -42,6 +42,7 @@ func (k Keeper) AllBalances(ctx context.Context, req *types.QueryAllBalancesRequ
 			TradableAmount: balance.TradableAmount,
 			RetiredAmount:  balance.RetiredAmount,
 			EscrowedAmount: balance.EscrowedAmount,
+			LockedAmount:   balance.LockedAmount,
 		})
 	}
 	res.Pagination, err = ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
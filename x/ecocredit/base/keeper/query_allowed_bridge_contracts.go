@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// AllowedBridgeContracts queries all allowed bridge contracts of a bridge chain.
+func (k Keeper) AllowedBridgeContracts(ctx context.Context, req *types.QueryAllowedBridgeContractsRequest) (*types.QueryAllowedBridgeContractsResponse, error) {
+	it, err := k.stateStore.AllowedBridgeContractTable().List(
+		ctx,
+		api.AllowedBridgeContractPrimaryKey{}.WithChainName(strings.ToLower(req.ChainName)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	contracts := make([]string, 0)
+	for it.Next() {
+		entry, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		contracts = append(contracts, entry.Contract)
+	}
+
+	return &types.QueryAllowedBridgeContractsResponse{Contracts: contracts}, nil
+}
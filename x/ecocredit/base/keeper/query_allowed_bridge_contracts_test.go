@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQueryAllowedBridgeContracts(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	contracts := []*api.AllowedBridgeContract{
+		{ChainName: "polygon", Contract: "0x06012c8cf97bead5deae237070f9587f8e7a266d"},
+		{ChainName: "polygon", Contract: "0x6887246668a3b87f54deb3b94ba47a6f63f32985"},
+		{ChainName: "ethereum", Contract: "0x06012c8cf97bead5deae237070f9587f8e7a266d"},
+	}
+	for _, contract := range contracts {
+		err := s.stateStore.AllowedBridgeContractTable().Insert(s.ctx, contract)
+		require.NoError(t, err)
+	}
+
+	res, err := s.k.AllowedBridgeContracts(s.ctx, &types.QueryAllowedBridgeContractsRequest{ChainName: "Polygon"})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{
+		"0x06012c8cf97bead5deae237070f9587f8e7a266d",
+		"0x6887246668a3b87f54deb3b94ba47a6f63f32985",
+	}, res.Contracts)
+
+	res, err = s.k.AllowedBridgeContracts(s.ctx, &types.QueryAllowedBridgeContractsRequest{ChainName: "solana"})
+	require.NoError(t, err)
+	require.Empty(t, res.Contracts)
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// AllowedBridgeServices queries all allowed bridge services of a bridge chain.
+func (k Keeper) AllowedBridgeServices(ctx context.Context, req *types.QueryAllowedBridgeServicesRequest) (*types.QueryAllowedBridgeServicesResponse, error) {
+	it, err := k.stateStore.AllowedBridgeServiceTable().List(
+		ctx,
+		api.AllowedBridgeServicePrimaryKey{}.WithChainName(strings.ToLower(req.ChainName)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	addresses := make([]string, 0)
+	for it.Next() {
+		entry, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		addresses = append(addresses, sdk.AccAddress(entry.Address).String())
+	}
+
+	return &types.QueryAllowedBridgeServicesResponse{Addresses: addresses}, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQueryAllowedBridgeServices(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	services := []*api.AllowedBridgeService{
+		{ChainName: "polygon", Address: s.addr},
+		{ChainName: "polygon", Address: s.addr2},
+		{ChainName: "ethereum", Address: s.addr},
+	}
+	for _, service := range services {
+		err := s.stateStore.AllowedBridgeServiceTable().Insert(s.ctx, service)
+		require.NoError(t, err)
+	}
+
+	res, err := s.k.AllowedBridgeServices(s.ctx, &types.QueryAllowedBridgeServicesRequest{ChainName: "Polygon"})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{s.addr.String(), s.addr2.String()}, res.Addresses)
+
+	res, err = s.k.AllowedBridgeServices(s.ctx, &types.QueryAllowedBridgeServicesRequest{ChainName: "solana"})
+	require.NoError(t, err)
+	require.Empty(t, res.Addresses)
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// Article6AuthorizationsByBatch queries the Article 6 authorizations of a
+// credit batch and of retirements of credits from the credit batch.
+func (k Keeper) Article6AuthorizationsByBatch(ctx context.Context, req *types.QueryArticle6AuthorizationsByBatchRequest) (*types.QueryArticle6AuthorizationsByBatchResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	it, err := k.stateStore.Article6AuthorizationTable().List(
+		ctx,
+		api.Article6AuthorizationBatchKeyRetirementIdIndexKey{}.WithBatchKey(batch.Key),
+		ormlist.Paginate(pg),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	authorizations := make([]*types.Article6AuthorizationInfo, 0)
+	for it.Next() {
+		authorization, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		authorizations = append(authorizations, &types.Article6AuthorizationInfo{
+			BatchDenom:       batch.Denom,
+			RetirementId:     authorization.RetirementId,
+			HostJurisdiction: authorization.HostJurisdiction,
+			AuthorizationIri: authorization.AuthorizationIri,
+			AdjustmentStatus: types.AdjustmentStatus(authorization.AdjustmentStatus),
+			AuthorizedUse:    types.AuthorizedUse(authorization.AuthorizedUse),
+			Authorizer:       sdk.AccAddress(authorization.Authorizer).String(),
+		})
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryArticle6AuthorizationsByBatchResponse{
+		Authorizations: authorizations,
+		Pagination:     pr,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_Article6AuthorizationsByBatch(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert two batches
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: testBatchDenom,
+	})
+	assert.NilError(t, err)
+	otherKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: "C01-001-20200101-20210101-002",
+	})
+	assert.NilError(t, err)
+
+	// insert authorizations of the batch, a retirement from the batch, and the
+	// other batch
+	authorizations := []*api.Article6Authorization{
+		{BatchKey: bKey, RetirementId: 0, AdjustmentStatus: api.AdjustmentStatus_ADJUSTMENT_STATUS_APPLIED},
+		{BatchKey: bKey, RetirementId: 1, AdjustmentStatus: api.AdjustmentStatus_ADJUSTMENT_STATUS_PENDING},
+		{BatchKey: otherKey, RetirementId: 0, AdjustmentStatus: api.AdjustmentStatus_ADJUSTMENT_STATUS_NOT_APPLIED},
+	}
+	for _, authorization := range authorizations {
+		authorization.HostJurisdiction = "KE"
+		authorization.AuthorizationIri = "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf"
+		authorization.AuthorizedUse = api.AuthorizedUse_AUTHORIZED_USE_NDC
+		authorization.Authorizer = s.addr
+		assert.NilError(t, s.stateStore.Article6AuthorizationTable().Insert(s.ctx, authorization))
+	}
+
+	// query authorizations by batch
+	res, err := s.k.Article6AuthorizationsByBatch(s.ctx, &types.QueryArticle6AuthorizationsByBatchRequest{
+		BatchDenom: testBatchDenom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Authorizations))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, testBatchDenom, res.Authorizations[0].BatchDenom)
+	assert.Equal(t, uint64(0), res.Authorizations[0].RetirementId)
+	assert.Equal(t, "KE", res.Authorizations[0].HostJurisdiction)
+	assert.Equal(t, types.AdjustmentStatus_ADJUSTMENT_STATUS_APPLIED, res.Authorizations[0].AdjustmentStatus)
+	assert.Equal(t, types.AuthorizedUse_AUTHORIZED_USE_NDC, res.Authorizations[0].AuthorizedUse)
+	assert.Equal(t, s.addr.String(), res.Authorizations[0].Authorizer)
+
+	// query authorizations by batch with an unknown denom
+	_, err = s.k.Article6AuthorizationsByBatch(s.ctx, &types.QueryArticle6AuthorizationsByBatchRequest{
+		BatchDenom: "C01-001-20200101-20210101-003",
+	})
+	assert.ErrorContains(t, err, sdkerrors.ErrInvalidRequest.Error())
+}
@@ -34,6 +34,7 @@ func (k Keeper) Balance(ctx context.Context, req *types.QueryBalanceRequest) (*t
 		TradableAmount: balance.TradableAmount,
 		RetiredAmount:  balance.RetiredAmount,
 		EscrowedAmount: balance.EscrowedAmount,
+		LockedAmount:   balance.LockedAmount,
 	}
 
 	return &types.QueryBalanceResponse{Balance: &info}, nil
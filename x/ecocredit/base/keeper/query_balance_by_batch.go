@@ -39,6 +39,7 @@ func (k Keeper) BalancesByBatch(ctx context.Context, req *types.QueryBalancesByB
 			TradableAmount: bal.TradableAmount,
 			RetiredAmount:  bal.RetiredAmount,
 			EscrowedAmount: bal.EscrowedAmount,
+			LockedAmount:   bal.LockedAmount,
 		})
 	}
 	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
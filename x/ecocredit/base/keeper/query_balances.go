@@ -46,6 +46,7 @@ func (k Keeper) Balances(ctx context.Context, req *types.QueryBalancesRequest) (
 			TradableAmount: balance.TradableAmount,
 			RetiredAmount:  balance.RetiredAmount,
 			EscrowedAmount: balance.EscrowedAmount,
+			LockedAmount:   balance.LockedAmount,
 		}
 
 		balances = append(balances, &info)
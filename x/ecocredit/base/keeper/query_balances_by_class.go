@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// BalancesByClass queries all credit balances the given account is holding
+// within the given credit class.
+func (k Keeper) BalancesByClass(ctx context.Context, req *types.QueryBalancesByClassRequest) (*types.QueryBalancesByClassResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.BatchBalanceIndexTable().List(
+		ctx,
+		api.BatchBalanceIndexAddressClassKeyBatchKeyIndexKey{}.WithAddressClassKey(addr, class.Key),
+		ormlist.Paginate(pg),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	balances := make([]*types.BatchBalanceInfo, 0, 8) // pre-allocate some cap space
+	for it.Next() {
+		index, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.batchBalanceInfo(ctx, addr, index.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+
+		balances = append(balances, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBalancesByClassResponse{Balances: balances, Pagination: pr}, nil
+}
+
+// batchBalanceInfo returns the balance of the account for the credit batch.
+func (k Keeper) batchBalanceInfo(ctx context.Context, addr sdk.AccAddress, batchKey uint64) (*types.BatchBalanceInfo, error) {
+	balance, err := k.stateStore.BatchBalanceTable().Get(ctx, addr, batchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().Get(ctx, batchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BatchBalanceInfo{
+		Address:        addr.String(),
+		BatchDenom:     batch.Denom,
+		TradableAmount: balance.TradableAmount,
+		RetiredAmount:  balance.RetiredAmount,
+		EscrowedAmount: balance.EscrowedAmount,
+		LockedAmount:   balance.LockedAmount,
+	}, nil
+}
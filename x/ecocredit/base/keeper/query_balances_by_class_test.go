@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_BalancesByClass(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	balances := s.setupIndexedBalances()
+
+	// query balances for s.addr within credit class C01
+	res, err := s.k.BalancesByClass(s.ctx, &types.QueryBalancesByClassRequest{
+		Address:    s.addr.String(),
+		ClassId:    "C01",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Balances))
+	s.assertBalanceEqual(res.Balances[0], balances[0])
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// query balances for s.addr within credit class C01 in reverse order
+	res, err = s.k.BalancesByClass(s.ctx, &types.QueryBalancesByClassRequest{
+		Address:    s.addr.String(),
+		ClassId:    "C01",
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Balances))
+	s.assertBalanceEqual(res.Balances[0], balances[1])
+
+	// query balances for s.addr within credit class C02
+	res, err = s.k.BalancesByClass(s.ctx, &types.QueryBalancesByClassRequest{
+		Address: s.addr.String(),
+		ClassId: "C02",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Balances))
+	s.assertBalanceEqual(res.Balances[0], balances[2])
+
+	// query balances for address with no balance within credit class C02
+	res, err = s.k.BalancesByClass(s.ctx, &types.QueryBalancesByClassRequest{
+		Address: s.addr2.String(),
+		ClassId: "C02",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Balances))
+
+	// query balances within credit class that does not exist
+	_, err = s.k.BalancesByClass(s.ctx, &types.QueryBalancesByClassRequest{
+		Address: s.addr.String(),
+		ClassId: "C03",
+	})
+	assert.ErrorContains(t, err, "could not get class with id C03")
+}
+
+// setupIndexedBalances creates credit classes C01 and C02, projects C01-001,
+// C01-002, and C02-001, a credit batch within each project, and indexed
+// balances for s.addr within each credit batch and for s.addr2 within the
+// credit batch of project C01-001.
+func (s *baseSuite) setupIndexedBalances() []*api.BatchBalance {
+	classKey1, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: "C01", CreditTypeAbbrev: "C"})
+	assert.NilError(s.t, err)
+	classKey2, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: "C02", CreditTypeAbbrev: "C"})
+	assert.NilError(s.t, err)
+
+	projectKey1, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{Id: "C01-001", ClassKey: classKey1})
+	assert.NilError(s.t, err)
+	projectKey2, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{Id: "C01-002", ClassKey: classKey1})
+	assert.NilError(s.t, err)
+	projectKey3, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{Id: "C02-001", ClassKey: classKey2})
+	assert.NilError(s.t, err)
+
+	bKey1, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{ProjectKey: projectKey1, Denom: "C01-001-20200101-20210101-001"})
+	assert.NilError(s.t, err)
+	bKey2, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{ProjectKey: projectKey2, Denom: "C01-002-20200101-20210101-001"})
+	assert.NilError(s.t, err)
+	bKey3, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{ProjectKey: projectKey3, Denom: "C02-001-20200101-20210101-001"})
+	assert.NilError(s.t, err)
+
+	balances := []*api.BatchBalance{
+		{Address: s.addr, BatchKey: bKey1, TradableAmount: "15", RetiredAmount: "5", EscrowedAmount: "1"},
+		{Address: s.addr, BatchKey: bKey2, TradableAmount: "19", RetiredAmount: "20", EscrowedAmount: "0"},
+		{Address: s.addr, BatchKey: bKey3, TradableAmount: "7", RetiredAmount: "0", EscrowedAmount: "3"},
+		{Address: s.addr2, BatchKey: bKey1, TradableAmount: "2.5", RetiredAmount: "1", EscrowedAmount: "0"},
+	}
+	for _, balance := range balances {
+		assert.NilError(s.t, s.stateStore.BatchBalanceTable().Insert(s.ctx, balance))
+		assert.NilError(s.t, IndexBatchBalance(s.ctx, s.stateStore, balance.Address, balance.BatchKey))
+	}
+
+	return balances
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// BalancesByProject queries all credit balances the given account is holding
+// within the given project.
+func (k Keeper) BalancesByProject(ctx context.Context, req *types.QueryBalancesByProjectRequest) (*types.QueryBalancesByProjectResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.getProjectByIDOrAlias(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.BatchBalanceIndexTable().List(
+		ctx,
+		api.BatchBalanceIndexAddressProjectKeyBatchKeyIndexKey{}.WithAddressProjectKey(addr, project.Key),
+		ormlist.Paginate(pg),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	balances := make([]*types.BatchBalanceInfo, 0, 8) // pre-allocate some cap space
+	for it.Next() {
+		index, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.batchBalanceInfo(ctx, addr, index.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+
+		balances = append(balances, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBalancesByProjectResponse{Balances: balances, Pagination: pr}, nil
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_BalancesByProject(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	balances := s.setupIndexedBalances()
+
+	// query balances for s.addr within project C01-001
+	res, err := s.k.BalancesByProject(s.ctx, &types.QueryBalancesByProjectRequest{
+		Address:    s.addr.String(),
+		ProjectId:  "C01-001",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Balances))
+	s.assertBalanceEqual(res.Balances[0], balances[0])
+	assert.Equal(t, uint64(1), res.Pagination.Total)
+
+	// query balances for s.addr2 within project C01-001
+	res, err = s.k.BalancesByProject(s.ctx, &types.QueryBalancesByProjectRequest{
+		Address:   s.addr2.String(),
+		ProjectId: "C01-001",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Balances))
+	s.assertBalanceEqual(res.Balances[0], balances[3])
+
+	// query balances for s.addr2 within project C02-001
+	res, err = s.k.BalancesByProject(s.ctx, &types.QueryBalancesByProjectRequest{
+		Address:   s.addr2.String(),
+		ProjectId: "C02-001",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Balances))
+
+	// query balances using the alias of a migrated project
+	assert.NilError(t, s.stateStore.ProjectAliasTable().Insert(s.ctx, &api.ProjectAlias{
+		Alias:      "C03-001",
+		ProjectKey: 1,
+	}))
+	res, err = s.k.BalancesByProject(s.ctx, &types.QueryBalancesByProjectRequest{
+		Address:   s.addr.String(),
+		ProjectId: "C03-001",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Balances))
+	s.assertBalanceEqual(res.Balances[0], balances[0])
+
+	// query balances within project that does not exist
+	_, err = s.k.BalancesByProject(s.ctx, &types.QueryBalancesByProjectRequest{
+		Address:   s.addr.String(),
+		ProjectId: "C01-003",
+	})
+	assert.ErrorContains(t, err, "could not get project with id C01-003")
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// BatchByOriginTx queries the credit batch that was created or had credits
+// minted by a transaction from another chain or registry.
+func (k Keeper) BatchByOriginTx(ctx context.Context, req *types.QueryBatchByOriginTxRequest) (*types.QueryBatchByOriginTxResponse, error) {
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	index, err := k.stateStore.OriginTxIndexTable().Get(ctx, class.Key, req.Id, req.Source)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get origin tx with id %s and source %s: %s", req.Id, req.Source, err.Error(),
+		)
+	}
+
+	// origin tx index entries created before the batch key was recorded are only
+	// linked to a credit batch when the credit batch could be determined during
+	// the upgrade (see v4.MigrateState)
+	if index.BatchKey == 0 {
+		return nil, sdkerrors.ErrNotFound.Wrapf(
+			"origin tx with id %s and source %s is not linked to a credit batch", req.Id, req.Source,
+		)
+	}
+
+	batch, err := k.stateStore.BatchTable().Get(ctx, index.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	info := types.BatchInfo{
+		Issuer:       sdk.AccAddress(batch.Issuer).String(),
+		ProjectId:    project.Id,
+		Denom:        batch.Denom,
+		Metadata:     batch.Metadata,
+		StartDate:    regentypes.ProtobufToGogoTimestamp(batch.StartDate),
+		EndDate:      regentypes.ProtobufToGogoTimestamp(batch.EndDate),
+		IssuanceDate: regentypes.ProtobufToGogoTimestamp(batch.IssuanceDate),
+		Open:         batch.Open,
+	}
+
+	return &types.QueryBatchByOriginTxResponse{
+		Batch: &info,
+		OriginTx: &types.OriginTx{
+			Id:       index.Id,
+			Source:   index.Source,
+			Contract: index.Contract,
+			Note:     index.Note,
+		},
+		Mint:      index.Mint,
+		Timestamp: regentypes.ProtobufToGogoTimestamp(index.Timestamp),
+	}, nil
+}
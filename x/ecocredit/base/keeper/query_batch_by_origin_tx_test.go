@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_BatchByOriginTx(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert class, project, and batch
+	assert.NilError(t, s.stateStore.ClassTable().Insert(s.ctx, &api.Class{Id: "C01", CreditTypeAbbrev: "C"}))
+	assert.NilError(t, s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{Id: "C01-001", ClassKey: 1}))
+	assert.NilError(t, s.stateStore.BatchTable().Insert(s.ctx, &api.Batch{
+		Issuer:     s.addr,
+		ProjectKey: 1,
+		Denom:      testBatchDenom,
+		Open:       true,
+	}))
+
+	timestamp := timestamppb.New(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC))
+
+	// insert origin tx index entries for the creation of the batch, a mint, and
+	// an entry created before the batch key was recorded
+	assert.NilError(t, s.stateStore.OriginTxIndexTable().Insert(s.ctx, &api.OriginTxIndex{
+		ClassKey:  1,
+		Id:        "0x1",
+		Source:    "polygon",
+		BatchKey:  1,
+		Contract:  "0x06012c8cf97bead5deae237070f9587f8e7a266d",
+		Note:      "bridged from polygon",
+		Timestamp: timestamp,
+	}))
+	assert.NilError(t, s.stateStore.OriginTxIndexTable().Insert(s.ctx, &api.OriginTxIndex{
+		ClassKey:  1,
+		Id:        "0x2",
+		Source:    "polygon",
+		BatchKey:  1,
+		Mint:      true,
+		Timestamp: timestamp,
+	}))
+	assert.NilError(t, s.stateStore.OriginTxIndexTable().Insert(s.ctx, &api.OriginTxIndex{
+		ClassKey: 1,
+		Id:       "0x3",
+		Source:   "polygon",
+	}))
+
+	// query batch created by origin tx
+	res, err := s.k.BatchByOriginTx(s.ctx, &types.QueryBatchByOriginTxRequest{
+		ClassId: "C01",
+		Source:  "polygon",
+		Id:      "0x1",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, testBatchDenom, res.Batch.Denom)
+	assert.Equal(t, "C01-001", res.Batch.ProjectId)
+	assert.Equal(t, s.addr.String(), res.Batch.Issuer)
+	assert.Equal(t, false, res.Mint)
+	assert.Equal(t, "0x06012c8cf97bead5deae237070f9587f8e7a266d", res.OriginTx.Contract)
+	assert.Equal(t, "bridged from polygon", res.OriginTx.Note)
+	assert.Equal(t, timestamp.Seconds, res.Timestamp.Seconds)
+
+	// query batch minted to by origin tx
+	res, err = s.k.BatchByOriginTx(s.ctx, &types.QueryBatchByOriginTxRequest{
+		ClassId: "C01",
+		Source:  "polygon",
+		Id:      "0x2",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, testBatchDenom, res.Batch.Denom)
+	assert.Equal(t, true, res.Mint)
+
+	// query origin tx not linked to a credit batch
+	_, err = s.k.BatchByOriginTx(s.ctx, &types.QueryBatchByOriginTxRequest{
+		ClassId: "C01",
+		Source:  "polygon",
+		Id:      "0x3",
+	})
+	assert.ErrorContains(t, err, "origin tx with id 0x3 and source polygon is not linked to a credit batch")
+
+	// query origin tx that does not exist
+	_, err = s.k.BatchByOriginTx(s.ctx, &types.QueryBatchByOriginTxRequest{
+		ClassId: "C01",
+		Source:  "polygon",
+		Id:      "0x4",
+	})
+	assert.ErrorContains(t, err, "could not get origin tx with id 0x4 and source polygon")
+
+	// query origin tx within credit class that does not exist
+	_, err = s.k.BatchByOriginTx(s.ctx, &types.QueryBatchByOriginTxRequest{
+		ClassId: "C02",
+		Source:  "polygon",
+		Id:      "0x1",
+	})
+	assert.ErrorContains(t, err, "could not get class with id C02")
+}
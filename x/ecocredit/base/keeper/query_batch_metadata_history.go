@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// BatchMetadataHistory queries the metadata changes of a credit batch in the
+// order they occurred.
+func (k Keeper) BatchMetadataHistory(ctx context.Context, request *types.QueryBatchMetadataHistoryRequest) (*types.QueryBatchMetadataHistoryResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, request.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", request.BatchDenom, err.Error())
+	}
+
+	it, err := k.stateStore.BatchMetadataChangeTable().List(ctx, api.BatchMetadataChangeBatchKeyIndexKey{}.WithBatchKey(batch.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	changes := make([]*types.BatchMetadataChangeInfo, 0)
+	for it.Next() {
+		change, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, &types.BatchMetadataChangeInfo{
+			OldMetadata: change.OldMetadata,
+			NewMetadata: change.NewMetadata,
+			Signer:      sdk.AccAddress(change.Signer).String(),
+			Timestamp:   regentypes.ProtobufToGogoTimestamp(change.Timestamp),
+		})
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBatchMetadataHistoryResponse{
+		Changes:    changes,
+		Pagination: pr,
+	}, nil
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_BatchMetadataHistory(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert batches
+	batchKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom:    testBatchDenom,
+		Metadata: "regen:report-v3.rdf",
+	})
+	assert.NilError(t, err)
+	batchKey2, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: "C01-001-20200101-20210101-002",
+	})
+	assert.NilError(t, err)
+
+	// insert two metadata changes for the first batch
+	assert.NilError(t, s.stateStore.BatchMetadataChangeTable().Insert(s.ctx, &api.BatchMetadataChange{
+		BatchKey:    batchKey,
+		OldMetadata: "regen:report-v1.rdf",
+		NewMetadata: "regen:report-v2.rdf",
+		Signer:      s.addr,
+		Timestamp:   timestamppb.Now(),
+	}))
+	assert.NilError(t, s.stateStore.BatchMetadataChangeTable().Insert(s.ctx, &api.BatchMetadataChange{
+		BatchKey:    batchKey,
+		OldMetadata: "regen:report-v2.rdf",
+		NewMetadata: "regen:report-v3.rdf",
+		Signer:      s.addr,
+		Timestamp:   timestamppb.Now(),
+	}))
+
+	// insert metadata change for the second batch
+	assert.NilError(t, s.stateStore.BatchMetadataChangeTable().Insert(s.ctx, &api.BatchMetadataChange{
+		BatchKey:    batchKey2,
+		NewMetadata: "regen:report-v1.rdf",
+		Signer:      s.addr2,
+		Timestamp:   timestamppb.Now(),
+	}))
+
+	// query metadata history of the first batch
+	res, err := s.k.BatchMetadataHistory(s.ctx, &types.QueryBatchMetadataHistoryRequest{
+		BatchDenom: testBatchDenom,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Changes))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, "regen:report-v1.rdf", res.Changes[0].OldMetadata)
+	assert.Equal(t, "regen:report-v2.rdf", res.Changes[0].NewMetadata)
+	assert.Equal(t, s.addr.String(), res.Changes[0].Signer)
+	assert.Assert(t, res.Changes[0].Timestamp != nil)
+	assert.Equal(t, "regen:report-v2.rdf", res.Changes[1].OldMetadata)
+	assert.Equal(t, "regen:report-v3.rdf", res.Changes[1].NewMetadata)
+
+	// query metadata history with pagination
+	res, err = s.k.BatchMetadataHistory(s.ctx, &types.QueryBatchMetadataHistoryRequest{
+		BatchDenom: testBatchDenom,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Changes))
+	assert.Equal(t, "regen:report-v2.rdf", res.Changes[0].NewMetadata)
+
+	// query metadata history of unknown batch
+	_, err = s.k.BatchMetadataHistory(s.ctx, &types.QueryBatchMetadataHistoryRequest{
+		BatchDenom: "C01-001-20200101-20210101-003",
+	})
+	assert.ErrorContains(t, err, "could not get batch with denom C01-001-20200101-20210101-003")
+}
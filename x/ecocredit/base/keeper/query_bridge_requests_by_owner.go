@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// BridgeRequestsByOwner queries all bridge requests of an account.
+func (k Keeper) BridgeRequestsByOwner(ctx context.Context, req *types.QueryBridgeRequestsByOwnerRequest) (*types.QueryBridgeRequestsByOwnerResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.BridgeRequestTable().List(
+		ctx,
+		api.BridgeRequestOwnerIndexKey{}.WithOwner(owner),
+		ormlist.Paginate(pg),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	requests, err := k.bridgeRequestInfos(ctx, it)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBridgeRequestsByOwnerResponse{
+		BridgeRequests: requests,
+		Pagination:     pr,
+	}, nil
+}
+
+// bridgeRequestInfos converts the bridge requests of the iterator into the
+// human-readable bridge request information.
+func (k Keeper) bridgeRequestInfos(ctx context.Context, it api.BridgeRequestIterator) ([]*types.BridgeRequestInfo, error) {
+	requests := make([]*types.BridgeRequestInfo, 0)
+	for it.Next() {
+		request, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		batch, err := k.stateStore.BatchTable().Get(ctx, request.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+
+		requests = append(requests, &types.BridgeRequestInfo{
+			Id:         request.Id,
+			Owner:      sdk.AccAddress(request.Owner).String(),
+			BatchDenom: batch.Denom,
+			Target:     request.Target,
+			Recipient:  request.Recipient,
+			Contract:   request.Contract,
+			Amount:     request.Amount,
+			Status:     types.BridgeRequestStatus(request.Status),
+			Timestamp:  regentypes.ProtobufToGogoTimestamp(request.Timestamp),
+		})
+	}
+	return requests, nil
+}
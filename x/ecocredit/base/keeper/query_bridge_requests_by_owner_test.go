@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_BridgeRequestsByOwner(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: testBatchDenom,
+	})
+	assert.NilError(t, err)
+
+	// insert bridge requests of the account and another account
+	requests := []*api.BridgeRequest{
+		{Owner: s.addr, BatchKey: bKey, Target: "polygon", Amount: "10", Status: uint32(api.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_PENDING), Timestamp: timestamppb.Now()},
+		{Owner: s.addr2, BatchKey: bKey, Target: "polygon", Amount: "5", Status: uint32(api.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_PENDING), Timestamp: timestamppb.Now()},
+		{Owner: s.addr, BatchKey: bKey, Target: "polygon", Amount: "3", Status: uint32(api.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_FAILED), Timestamp: timestamppb.Now()},
+	}
+	for _, request := range requests {
+		_, err = s.stateStore.BridgeRequestTable().InsertReturningID(s.ctx, request)
+		assert.NilError(t, err)
+	}
+
+	// query bridge requests by owner
+	res, err := s.k.BridgeRequestsByOwner(s.ctx, &types.QueryBridgeRequestsByOwnerRequest{
+		Owner:      s.addr.String(),
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.BridgeRequests))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, uint64(1), res.BridgeRequests[0].Id)
+	assert.Equal(t, s.addr.String(), res.BridgeRequests[0].Owner)
+	assert.Equal(t, testBatchDenom, res.BridgeRequests[0].BatchDenom)
+	assert.Equal(t, types.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_PENDING, res.BridgeRequests[0].Status)
+	assert.Equal(t, uint64(3), res.BridgeRequests[1].Id)
+	assert.Equal(t, types.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_FAILED, res.BridgeRequests[1].Status)
+
+	// query bridge requests by owner with an invalid address
+	_, err = s.k.BridgeRequestsByOwner(s.ctx, &types.QueryBridgeRequestsByOwnerRequest{Owner: "foo"})
+	assert.ErrorContains(t, err, sdkerrors.ErrInvalidAddress.Error())
+}
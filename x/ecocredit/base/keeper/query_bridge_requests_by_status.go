@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// BridgeRequestsByStatus queries all bridge requests with the given status.
+func (k Keeper) BridgeRequestsByStatus(ctx context.Context, req *types.QueryBridgeRequestsByStatusRequest) (*types.QueryBridgeRequestsByStatusResponse, error) {
+	if req.Status == types.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_UNSPECIFIED {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("status cannot be unspecified")
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.BridgeRequestTable().List(
+		ctx,
+		api.BridgeRequestStatusIndexKey{}.WithStatus(uint32(req.Status)),
+		ormlist.Paginate(pg),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	requests, err := k.bridgeRequestInfos(ctx, it)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBridgeRequestsByStatusResponse{
+		BridgeRequests: requests,
+		Pagination:     pr,
+	}, nil
+}
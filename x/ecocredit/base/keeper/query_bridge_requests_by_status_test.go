@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_BridgeRequestsByStatus(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: testBatchDenom,
+	})
+	assert.NilError(t, err)
+
+	// insert bridge requests with different statuses
+	statuses := []api.BridgeRequestStatus{
+		api.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_PENDING,
+		api.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_COMPLETED,
+		api.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_PENDING,
+	}
+	for _, status := range statuses {
+		_, err = s.stateStore.BridgeRequestTable().InsertReturningID(s.ctx, &api.BridgeRequest{
+			Owner:     s.addr,
+			BatchKey:  bKey,
+			Target:    "polygon",
+			Amount:    "10",
+			Status:    uint32(status),
+			Timestamp: timestamppb.Now(),
+		})
+		assert.NilError(t, err)
+	}
+
+	// query pending bridge requests
+	res, err := s.k.BridgeRequestsByStatus(s.ctx, &types.QueryBridgeRequestsByStatusRequest{
+		Status:     types.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_PENDING,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.BridgeRequests))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, uint64(1), res.BridgeRequests[0].Id)
+	assert.Equal(t, uint64(3), res.BridgeRequests[1].Id)
+
+	// query failed bridge requests
+	res, err = s.k.BridgeRequestsByStatus(s.ctx, &types.QueryBridgeRequestsByStatusRequest{
+		Status: types.BridgeRequestStatus_BRIDGE_REQUEST_STATUS_FAILED,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.BridgeRequests))
+
+	// query bridge requests with an unspecified status
+	_, err = s.k.BridgeRequestsByStatus(s.ctx, &types.QueryBridgeRequestsByStatusRequest{})
+	assert.ErrorContains(t, err, "status cannot be unspecified")
+}
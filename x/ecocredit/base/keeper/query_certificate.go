@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// Certificate queries a retirement certificate by x/nft class and token id and
+// resolves the certificate to its underlying retirement.
+func (k Keeper) Certificate(ctx context.Context, req *types.QueryCertificateRequest) (*types.QueryCertificateResponse, error) {
+	certificate, err := k.stateStore.RetirementCertificateTable().GetByNftClassIdNftId(ctx, req.NftClassId, req.NftId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get certificate with class id %s and id %s: %s", req.NftClassId, req.NftId, err.Error(),
+		)
+	}
+
+	info, err := k.certificateInfo(ctx, certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCertificateResponse{
+		Certificate: info,
+	}, nil
+}
+
+// certificateInfo converts a retirement certificate into human-readable
+// certificate information, including the current owner of the x/nft token and
+// the underlying retirement.
+func (k Keeper) certificateInfo(ctx context.Context, certificate *api.RetirementCertificate) (*types.CertificateInfo, error) {
+	retirement, err := k.stateStore.RetirementTable().Get(ctx, certificate.RetirementId)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().Get(ctx, retirement.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := k.nftKeeper.GetOwner(sdk.UnwrapSDKContext(ctx), certificate.NftClassId, certificate.NftId)
+
+	return &types.CertificateInfo{
+		NftClassId: certificate.NftClassId,
+		NftId:      certificate.NftId,
+		Owner:      owner.String(),
+		Retirement: retirementInfo(retirement, batch.Denom),
+	}, nil
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_Certificate(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert class, project, and batch
+	assert.NilError(t, s.stateStore.ClassTable().Insert(s.ctx, &api.Class{Id: "C01", CreditTypeAbbrev: "C"}))
+	assert.NilError(t, s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{Id: "C01-001", ClassKey: 1}))
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: 1,
+		Denom:      testBatchDenom,
+	})
+	assert.NilError(t, err)
+
+	retirement := &api.Retirement{
+		Owner:        s.addr,
+		BatchKey:     bKey,
+		Amount:       "10",
+		Jurisdiction: "US-WA",
+		Beneficiary:  "Acme Inc.",
+	}
+
+	// record retirement and mint certificate
+	id, err := RecordRetirement(s.ctx, s.stateStore, s.nftKeeper, retirement, testBatchDenom)
+	assert.NilError(t, err)
+
+	// query certificate by nft class id and nft id
+	res, err := s.k.Certificate(s.ctx, &types.QueryCertificateRequest{
+		NftClassId: "ecocredit:C01",
+		NftId:      "retirement-1",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "ecocredit:C01", res.Certificate.NftClassId)
+	assert.Equal(t, "retirement-1", res.Certificate.NftId)
+	assert.Equal(t, s.addr.String(), res.Certificate.Owner)
+	assert.Equal(t, id, res.Certificate.Retirement.Id)
+	assert.Equal(t, s.addr.String(), res.Certificate.Retirement.Owner)
+	assert.Equal(t, testBatchDenom, res.Certificate.Retirement.BatchDenom)
+	assert.Equal(t, retirement.Amount, res.Certificate.Retirement.Amount)
+	assert.Equal(t, retirement.Beneficiary, res.Certificate.Retirement.Beneficiary)
+
+	// transfer certificate and query the new owner
+	err = s.nftKeeper.Transfer(s.sdkCtx, "ecocredit:C01", "retirement-1", s.addr2)
+	assert.NilError(t, err)
+
+	res, err = s.k.Certificate(s.ctx, &types.QueryCertificateRequest{
+		NftClassId: "ecocredit:C01",
+		NftId:      "retirement-1",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, s.addr2.String(), res.Certificate.Owner)
+	assert.Equal(t, s.addr.String(), res.Certificate.Retirement.Owner)
+
+	// query certificate by unknown nft id
+	_, err = s.k.Certificate(s.ctx, &types.QueryCertificateRequest{
+		NftClassId: "ecocredit:C01",
+		NftId:      "retirement-2",
+	})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
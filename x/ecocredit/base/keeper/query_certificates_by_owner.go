@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// CertificatesByOwner queries all retirement certificates currently owned by a
+// given account. Pagination is applied to all x/nft tokens owned by the
+// account, of which only retirement certificates are returned.
+func (k Keeper) CertificatesByOwner(ctx context.Context, req *types.QueryCertificatesByOwnerRequest) (*types.QueryCertificatesByOwnerResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	res, err := k.nftKeeper.NFTs(ctx, &nft.QueryNFTsRequest{
+		Owner:      req.Owner,
+		Pagination: req.Pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	certificates := make([]*types.CertificateInfo, 0, len(res.Nfts))
+	for _, token := range res.Nfts {
+		certificate, err := k.stateStore.RetirementCertificateTable().GetByNftClassIdNftId(ctx, token.ClassId, token.Id)
+		if err != nil {
+			// the token is not a retirement certificate
+			if ormerrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		info, err := k.certificateInfo(ctx, certificate)
+		if err != nil {
+			return nil, err
+		}
+
+		certificates = append(certificates, info)
+	}
+
+	return &types.QueryCertificatesByOwnerResponse{
+		Certificates: certificates,
+		Pagination:   res.Pagination,
+	}, nil
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_CertificatesByOwner(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert class, project, and batch
+	assert.NilError(t, s.stateStore.ClassTable().Insert(s.ctx, &api.Class{Id: "C01", CreditTypeAbbrev: "C"}))
+	assert.NilError(t, s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{Id: "C01-001", ClassKey: 1}))
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: 1,
+		Denom:      testBatchDenom,
+	})
+	assert.NilError(t, err)
+
+	// record two retirements with s.addr as the owner
+	_, err = RecordRetirement(s.ctx, s.stateStore, s.nftKeeper, &api.Retirement{
+		Owner:        s.addr,
+		BatchKey:     bKey,
+		Amount:       "10",
+		Jurisdiction: "US-WA",
+	}, testBatchDenom)
+	assert.NilError(t, err)
+	_, err = RecordRetirement(s.ctx, s.stateStore, s.nftKeeper, &api.Retirement{
+		Owner:        s.addr,
+		BatchKey:     bKey,
+		Amount:       "20",
+		Jurisdiction: "US-OR",
+	}, testBatchDenom)
+	assert.NilError(t, err)
+
+	// record retirement with s.addr2 as the owner
+	_, err = RecordRetirement(s.ctx, s.stateStore, s.nftKeeper, &api.Retirement{
+		Owner:        s.addr2,
+		BatchKey:     bKey,
+		Amount:       "30",
+		Jurisdiction: "US-WA",
+	}, testBatchDenom)
+	assert.NilError(t, err)
+
+	// mint an unrelated nft to s.addr2
+	assert.NilError(t, s.nftKeeper.SaveClass(s.sdkCtx, nft.Class{Id: "other"}))
+	assert.NilError(t, s.nftKeeper.Mint(s.sdkCtx, nft.NFT{ClassId: "other", Id: "token-1"}, s.addr2))
+
+	// query certificates by owner s.addr
+	res, err := s.k.CertificatesByOwner(s.ctx, &types.QueryCertificatesByOwnerRequest{
+		Owner:      s.addr.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Certificates))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, "ecocredit:C01", res.Certificates[0].NftClassId)
+	assert.Equal(t, "retirement-1", res.Certificates[0].NftId)
+	assert.Equal(t, s.addr.String(), res.Certificates[0].Owner)
+	assert.Equal(t, testBatchDenom, res.Certificates[0].Retirement.BatchDenom)
+	assert.Equal(t, "10", res.Certificates[0].Retirement.Amount)
+
+	// query certificates by owner s.addr2 (unrelated nft is not included)
+	res, err = s.k.CertificatesByOwner(s.ctx, &types.QueryCertificatesByOwnerRequest{
+		Owner: s.addr2.String(),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Certificates))
+	assert.Equal(t, "retirement-3", res.Certificates[0].NftId)
+	assert.Equal(t, "30", res.Certificates[0].Retirement.Amount)
+
+	// query certificates by an invalid address
+	_, err = s.k.CertificatesByOwner(s.ctx, &types.QueryCertificatesByOwnerRequest{Owner: "foobar"})
+	assert.ErrorContains(t, err, sdkerrors.ErrInvalidAddress.Error())
+}
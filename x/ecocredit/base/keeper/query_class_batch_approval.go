@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// ClassBatchApproval queries whether credit batches issued within a credit
+// class must be approved and the expiry period of pending batches.
+func (k Keeper) ClassBatchApproval(ctx context.Context, req *types.QueryClassBatchApprovalRequest) (*types.QueryClassBatchApprovalResponse, error) {
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	batchApproval, err := k.stateStore.ClassBatchApprovalTable().Get(ctx, class.Key)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return &types.QueryClassBatchApprovalResponse{}, nil
+		}
+		return nil, err
+	}
+
+	return &types.QueryClassBatchApprovalResponse{
+		RequireApproval: true,
+		ExpiryPeriod:    gogotypes.DurationProto(batchApproval.ExpiryPeriod.AsDuration()),
+	}, nil
+}
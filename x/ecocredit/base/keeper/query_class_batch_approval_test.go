@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_ClassBatchApproval(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert class
+	cKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: testClassID})
+	assert.NilError(t, err)
+
+	// query class that does not require batch approval
+	res, err := s.k.ClassBatchApproval(s.ctx, &types.QueryClassBatchApprovalRequest{ClassId: testClassID})
+	assert.NilError(t, err)
+	assert.Equal(t, false, res.RequireApproval)
+	assert.Assert(t, res.ExpiryPeriod == nil)
+
+	// insert batch approval
+	err = s.stateStore.ClassBatchApprovalTable().Insert(s.ctx, &api.ClassBatchApproval{
+		ClassKey:     cKey,
+		ExpiryPeriod: durationpb.New(24 * time.Hour),
+	})
+	assert.NilError(t, err)
+
+	// query class that requires batch approval
+	res, err = s.k.ClassBatchApproval(s.ctx, &types.QueryClassBatchApprovalRequest{ClassId: testClassID})
+	assert.NilError(t, err)
+	assert.Equal(t, true, res.RequireApproval)
+	assert.Equal(t, int64(86400), res.ExpiryPeriod.Seconds)
+
+	// query unknown class
+	_, err = s.k.ClassBatchApproval(s.ctx, &types.QueryClassBatchApprovalRequest{ClassId: "C02"})
+	assert.ErrorContains(t, err, "could not get class with id C02")
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// ClassBatchMetadataCosign queries whether the admin of a credit class must
+// co-sign updates to the metadata of the credit batches of the credit class.
+func (k Keeper) ClassBatchMetadataCosign(ctx context.Context, req *types.QueryClassBatchMetadataCosignRequest) (*types.QueryClassBatchMetadataCosignResponse, error) {
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	required, err := k.stateStore.ClassBatchMetadataCosignTable().Has(ctx, class.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassBatchMetadataCosignResponse{RequireCosign: required}, nil
+}
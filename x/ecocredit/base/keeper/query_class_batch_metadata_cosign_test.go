@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_ClassBatchMetadataCosign(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert class
+	cKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: testClassID})
+	assert.NilError(t, err)
+
+	// query class that does not require a co-signature
+	res, err := s.k.ClassBatchMetadataCosign(s.ctx, &types.QueryClassBatchMetadataCosignRequest{ClassId: testClassID})
+	assert.NilError(t, err)
+	assert.Equal(t, false, res.RequireCosign)
+
+	// insert co-signature requirement
+	err = s.stateStore.ClassBatchMetadataCosignTable().Insert(s.ctx, &api.ClassBatchMetadataCosign{ClassKey: cKey})
+	assert.NilError(t, err)
+
+	// query class that requires a co-signature
+	res, err = s.k.ClassBatchMetadataCosign(s.ctx, &types.QueryClassBatchMetadataCosignRequest{ClassId: testClassID})
+	assert.NilError(t, err)
+	assert.Equal(t, true, res.RequireCosign)
+
+	// query unknown class
+	_, err = s.k.ClassBatchMetadataCosign(s.ctx, &types.QueryClassBatchMetadataCosignRequest{ClassId: "C02"})
+	assert.ErrorContains(t, err, "could not get class with id C02")
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// ClassBuffer queries the buffer pool address and buffer percentage of a
+// credit class.
+func (k Keeper) ClassBuffer(ctx context.Context, req *types.QueryClassBufferRequest) (*types.QueryClassBufferResponse, error) {
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	percentage, err := k.getBufferPercentage(ctx, class.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassBufferResponse{
+		Address:          base.BufferPoolAddress(class.Id).String(),
+		BufferPercentage: percentage.String(),
+	}, nil
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_ClassBuffer(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert classes
+	cKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: "C01"})
+	assert.NilError(t, err)
+	_, err = s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: "C02"})
+	assert.NilError(t, err)
+
+	// set buffer percentage for the first class
+	assert.NilError(t, s.stateStore.ClassBufferTable().Insert(s.ctx, &api.ClassBuffer{
+		ClassKey:   cKey,
+		Percentage: "12.5",
+	}))
+
+	// query class buffer of the first class
+	res, err := s.k.ClassBuffer(s.ctx, &types.QueryClassBufferRequest{ClassId: "C01"})
+	assert.NilError(t, err)
+	assert.Equal(t, base.BufferPoolAddress("C01").String(), res.Address)
+	assert.Equal(t, "12.5", res.BufferPercentage)
+
+	// query class buffer of the second class without a buffer
+	res, err = s.k.ClassBuffer(s.ctx, &types.QueryClassBufferRequest{ClassId: "C02"})
+	assert.NilError(t, err)
+	assert.Equal(t, base.BufferPoolAddress("C02").String(), res.Address)
+	assert.Equal(t, "0", res.BufferPercentage)
+
+	// query class buffer of unknown class
+	_, err = s.k.ClassBuffer(s.ctx, &types.QueryClassBufferRequest{ClassId: "C03"})
+	assert.ErrorContains(t, err, "could not get class with id C03")
+}
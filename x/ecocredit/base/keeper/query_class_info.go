@@ -18,11 +18,17 @@ func (k Keeper) Class(ctx context.Context, request *types.QueryClassRequest) (*t
 
 	admin := sdk.AccAddress(class.Admin)
 
+	status, err := getClassStatus(ctx, k.stateStore, class.Key)
+	if err != nil {
+		return nil, err
+	}
+
 	info := types.ClassInfo{
 		Id:               class.Id,
 		Admin:            admin.String(),
 		Metadata:         class.Metadata,
 		CreditTypeAbbrev: class.CreditTypeAbbrev,
+		Status:           types.ClassStatus(status),
 	}
 
 	return &types.QueryClassResponse{Class: &info}, nil
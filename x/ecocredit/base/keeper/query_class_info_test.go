@@ -32,6 +32,19 @@ func TestQuery_ClassInfo(t *testing.T) {
 	assert.Equal(t, s.addr.String(), res.Class.Admin)
 	assert.Equal(t, class.Metadata, res.Class.Metadata)
 	assert.Equal(t, class.CreditTypeAbbrev, res.Class.CreditTypeAbbrev)
+	assert.Equal(t, types.ClassStatus_CLASS_STATUS_UNSPECIFIED, res.Class.Status)
+
+	// update class status
+	err = s.stateStore.ClassLifecycleTable().Insert(s.ctx, &api.ClassLifecycle{
+		ClassKey: 1,
+		Status:   uint32(api.ClassStatus_CLASS_STATUS_CLOSED_TO_ISSUANCE),
+	})
+	assert.NilError(t, err)
+
+	// query class with updated status
+	res, err = s.k.Class(s.ctx, &types.QueryClassRequest{ClassId: class.Id})
+	assert.NilError(t, err)
+	assert.Equal(t, types.ClassStatus_CLASS_STATUS_CLOSED_TO_ISSUANCE, res.Class.Status)
 
 	// query class by an unknown class id
 	_, err = s.k.Class(s.ctx, &types.QueryClassRequest{ClassId: "C02"})
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// ClassSerialization queries whether the credits issued within a credit class
+// are identified by serial number ranges.
+func (k Keeper) ClassSerialization(ctx context.Context, req *types.QueryClassSerializationRequest) (*types.QueryClassSerializationResponse, error) {
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	serialized, err := k.stateStore.ClassSerializationTable().Has(ctx, class.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassSerializationResponse{Serialized: serialized}, nil
+}
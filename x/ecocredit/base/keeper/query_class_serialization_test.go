@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_ClassSerialization(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert class
+	cKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: testClassID})
+	assert.NilError(t, err)
+
+	// query class that is not serialized
+	res, err := s.k.ClassSerialization(s.ctx, &types.QueryClassSerializationRequest{ClassId: testClassID})
+	assert.NilError(t, err)
+	assert.Equal(t, false, res.Serialized)
+
+	// insert serialization
+	err = s.stateStore.ClassSerializationTable().Insert(s.ctx, &api.ClassSerialization{ClassKey: cKey})
+	assert.NilError(t, err)
+
+	// query class that is serialized
+	res, err = s.k.ClassSerialization(s.ctx, &types.QueryClassSerializationRequest{ClassId: testClassID})
+	assert.NilError(t, err)
+	assert.Equal(t, true, res.Serialized)
+
+	// query unknown class
+	_, err = s.k.ClassSerialization(s.ctx, &types.QueryClassSerializationRequest{ClassId: "C02"})
+	assert.ErrorContains(t, err, "could not get class with id C02")
+}
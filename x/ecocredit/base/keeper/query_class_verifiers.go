@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// ClassVerifiers returns a list of addresses that are allowed to update the status of projects from the given class.
+func (k Keeper) ClassVerifiers(ctx context.Context, request *types.QueryClassVerifiersRequest) (*types.QueryClassVerifiersResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	classInfo, err := k.stateStore.ClassTable().GetById(ctx, request.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", request.ClassId, err.Error())
+	}
+
+	it, err := k.stateStore.ClassVerifierTable().List(ctx, api.ClassVerifierClassKeyVerifierIndexKey{}.WithClassKey(classInfo.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	verifiers := make([]string, 0)
+	for it.Next() {
+		val, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		verifiers = append(verifiers, sdk.AccAddress(val.Verifier).String())
+	}
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryClassVerifiersResponse{
+		Verifiers:  verifiers,
+		Pagination: pr,
+	}, nil
+}
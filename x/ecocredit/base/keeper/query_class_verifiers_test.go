@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_ClassVerifiers(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// make a class with 2 verifiers.
+	err := s.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               "C01",
+		Admin:            s.addr,
+		CreditTypeAbbrev: "C",
+	})
+	assert.NilError(t, err)
+	assert.NilError(t, s.stateStore.ClassVerifierTable().Insert(s.ctx, &api.ClassVerifier{
+		ClassKey: 1,
+		Verifier: s.addr,
+	}))
+	assert.NilError(t, s.stateStore.ClassVerifierTable().Insert(s.ctx, &api.ClassVerifier{
+		ClassKey: 1,
+		Verifier: s.addr2,
+	}))
+
+	// base request
+	res, err := s.k.ClassVerifiers(s.ctx, &types.QueryClassVerifiersRequest{ClassId: "C01"})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Verifiers))
+
+	// bad request
+	_, err = s.k.ClassVerifiers(s.ctx, &types.QueryClassVerifiersRequest{ClassId: "F01"})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	// paginated request
+	res, err = s.k.ClassVerifiers(s.ctx, &types.QueryClassVerifiersRequest{
+		ClassId:    "C01",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Verifiers))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+}
@@ -11,8 +11,13 @@ import (
 	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
 )
 
-// Classes queries for all credit classes with pagination.
+// Classes queries for all credit classes with pagination. If a status is set,
+// only credit classes with the given status are returned.
 func (k Keeper) Classes(ctx context.Context, request *types.QueryClassesRequest) (*types.QueryClassesResponse, error) {
+	if request.Status != types.ClassStatus_CLASS_STATUS_UNSPECIFIED {
+		return k.classesByStatus(ctx, request)
+	}
+
 	pg, err := ormutil.GogoPageReqToPulsarPageReq(request.Pagination)
 	if err != nil {
 		return nil, err
@@ -31,16 +36,12 @@ func (k Keeper) Classes(ctx context.Context, request *types.QueryClassesRequest)
 			return nil, err
 		}
 
-		admin := sdk.AccAddress(class.Admin)
-
-		info := types.ClassInfo{
-			Id:               class.Id,
-			Admin:            admin.String(),
-			Metadata:         class.Metadata,
-			CreditTypeAbbrev: class.CreditTypeAbbrev,
+		info, err := k.classInfo(ctx, class)
+		if err != nil {
+			return nil, err
 		}
 
-		classes = append(classes, &info)
+		classes = append(classes, info)
 	}
 
 	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
@@ -53,3 +54,65 @@ func (k Keeper) Classes(ctx context.Context, request *types.QueryClassesRequest)
 		Pagination: pr,
 	}, err
 }
+
+// classesByStatus queries for all credit classes with the status of the
+// request with pagination.
+func (k Keeper) classesByStatus(ctx context.Context, request *types.QueryClassesRequest) (*types.QueryClassesResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	status := uint32(request.Status)
+	it, err := k.stateStore.ClassLifecycleTable().List(ctx, api.ClassLifecycleStatusIndexKey{}.WithStatus(status), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	classes := make([]*types.ClassInfo, 0)
+	for it.Next() {
+		lifecycle, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		class, err := k.stateStore.ClassTable().Get(ctx, lifecycle.ClassKey)
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.classInfo(ctx, class)
+		if err != nil {
+			return nil, err
+		}
+
+		classes = append(classes, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClassesResponse{
+		Classes:    classes,
+		Pagination: pr,
+	}, nil
+}
+
+// classInfo returns the human-readable credit class information.
+func (k Keeper) classInfo(ctx context.Context, class *api.Class) (*types.ClassInfo, error) {
+	status, err := getClassStatus(ctx, k.stateStore, class.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ClassInfo{
+		Id:               class.Id,
+		Admin:            sdk.AccAddress(class.Admin).String(),
+		Metadata:         class.Metadata,
+		CreditTypeAbbrev: class.CreditTypeAbbrev,
+		Status:           types.ClassStatus(status),
+	}, nil
+}
@@ -38,11 +38,17 @@ func (k Keeper) ClassesByAdmin(ctx context.Context, req *types.QueryClassesByAdm
 			return nil, err
 		}
 
+		status, err := getClassStatus(ctx, k.stateStore, class.Key)
+		if err != nil {
+			return nil, err
+		}
+
 		info := types.ClassInfo{
 			Id:               class.Id,
 			Admin:            adminString,
 			Metadata:         class.Metadata,
 			CreditTypeAbbrev: class.CreditTypeAbbrev,
+			Status:           types.ClassStatus(status),
 		}
 
 		classes = append(classes, &info)
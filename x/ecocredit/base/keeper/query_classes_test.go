@@ -38,5 +38,30 @@ func TestQuery_Classes(t *testing.T) {
 	assert.Equal(t, s.addr.String(), res.Classes[0].Admin)
 	assert.Equal(t, class.Metadata, res.Classes[0].Metadata)
 	assert.Equal(t, class.CreditTypeAbbrev, res.Classes[0].CreditTypeAbbrev)
+	assert.Equal(t, types.ClassStatus_CLASS_STATUS_UNSPECIFIED, res.Classes[0].Status)
 	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// update credit class status
+	assert.NilError(t, s.stateStore.ClassLifecycleTable().Insert(s.ctx, &api.ClassLifecycle{
+		ClassKey: 2,
+		Status:   uint32(api.ClassStatus_CLASS_STATUS_DEPRECATED),
+	}))
+
+	// query credit classes by deprecated status
+	res, err = s.k.Classes(s.ctx, &types.QueryClassesRequest{
+		Status:     types.ClassStatus_CLASS_STATUS_DEPRECATED,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Classes))
+	assert.Equal(t, "C02", res.Classes[0].Id)
+	assert.Equal(t, types.ClassStatus_CLASS_STATUS_DEPRECATED, res.Classes[0].Status)
+	assert.Equal(t, uint64(1), res.Pagination.Total)
+
+	// query credit classes by active status
+	res, err = s.k.Classes(s.ctx, &types.QueryClassesRequest{
+		Status: types.ClassStatus_CLASS_STATUS_ACTIVE,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Classes))
 }
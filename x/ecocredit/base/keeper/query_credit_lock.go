@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// CreditLock queries a credit lock by id.
+func (k Keeper) CreditLock(ctx context.Context, req *types.QueryCreditLockRequest) (*types.QueryCreditLockResponse, error) {
+	lock, err := k.stateStore.CreditLockTable().Get(ctx, req.Id)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get credit lock with id %d: %s", req.Id, err.Error())
+	}
+
+	batch, err := k.stateStore.BatchTable().Get(ctx, lock.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCreditLockResponse{
+		Lock: creditLockInfo(lock, batch.Denom),
+	}, nil
+}
+
+// creditLockInfo converts a credit lock into human-readable credit lock
+// information.
+func creditLockInfo(lock *api.CreditLock, batchDenom string) *types.CreditLockInfo {
+	return &types.CreditLockInfo{
+		Id:             lock.Id,
+		Owner:          sdk.AccAddress(lock.Owner).String(),
+		BatchDenom:     batchDenom,
+		LockType:       types.LockType(lock.LockType),
+		Amount:         lock.Amount,
+		UnlockedAmount: lock.UnlockedAmount,
+		StartTime:      regentypes.ProtobufToGogoTimestamp(lock.StartTime),
+		EndTime:        regentypes.ProtobufToGogoTimestamp(lock.EndTime),
+	}
+}
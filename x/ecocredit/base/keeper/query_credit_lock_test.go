@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_CreditLock(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: testBatchDenom,
+	})
+	assert.NilError(t, err)
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	id, err := s.stateStore.CreditLockTable().InsertReturningID(s.ctx, &api.CreditLock{
+		Owner:          s.addr,
+		BatchKey:       bKey,
+		LockType:       api.LockType_LOCK_TYPE_LINEAR,
+		Amount:         "10",
+		UnlockedAmount: "2",
+		StartTime:      timestamppb.New(start),
+		EndTime:        timestamppb.New(end),
+	})
+	assert.NilError(t, err)
+
+	// query credit lock by id
+	res, err := s.k.CreditLock(s.ctx, &types.QueryCreditLockRequest{Id: id})
+	assert.NilError(t, err)
+	assert.Equal(t, id, res.Lock.Id)
+	assert.Equal(t, s.addr.String(), res.Lock.Owner)
+	assert.Equal(t, testBatchDenom, res.Lock.BatchDenom)
+	assert.Equal(t, types.LockType_LOCK_TYPE_LINEAR, res.Lock.LockType)
+	assert.Equal(t, "10", res.Lock.Amount)
+	assert.Equal(t, "2", res.Lock.UnlockedAmount)
+	assert.Equal(t, start.Unix(), res.Lock.StartTime.Seconds)
+	assert.Equal(t, end.Unix(), res.Lock.EndTime.Seconds)
+
+	// query credit lock by unknown id
+	_, err = s.k.CreditLock(s.ctx, &types.QueryCreditLockRequest{Id: 2})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// CreditLocksByBatch queries all credit locks for a given credit batch.
+func (k Keeper) CreditLocksByBatch(ctx context.Context, req *types.QueryCreditLocksByBatchRequest) (*types.QueryCreditLocksByBatchResponse, error) {
+	if err := base.ValidateBatchDenom(req.BatchDenom); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("batch denom: %s", err)
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.CreditLockTable().List(ctx, api.CreditLockBatchKeyIndexKey{}.WithBatchKey(batch.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	locks := make([]*types.CreditLockInfo, 0, 8)
+	for it.Next() {
+		lock, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		locks = append(locks, creditLockInfo(lock, batch.Denom))
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCreditLocksByBatchResponse{
+		Locks:      locks,
+		Pagination: pr,
+	}, nil
+}
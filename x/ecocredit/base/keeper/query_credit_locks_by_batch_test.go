@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_CreditLocksByBatch(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert batches
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: testBatchDenom,
+	})
+	assert.NilError(t, err)
+	bKey2, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: "C01-001-20200101-20210101-002",
+	})
+	assert.NilError(t, err)
+
+	// insert two locks from the first batch
+	assert.NilError(t, s.stateStore.CreditLockTable().Insert(s.ctx, &api.CreditLock{
+		Owner:    s.addr,
+		BatchKey: bKey,
+		Amount:   "10",
+	}))
+	assert.NilError(t, s.stateStore.CreditLockTable().Insert(s.ctx, &api.CreditLock{
+		Owner:    s.addr2,
+		BatchKey: bKey,
+		Amount:   "20",
+	}))
+
+	// insert lock from the second batch
+	assert.NilError(t, s.stateStore.CreditLockTable().Insert(s.ctx, &api.CreditLock{
+		Owner:    s.addr,
+		BatchKey: bKey2,
+		Amount:   "30",
+	}))
+
+	// query credit locks by the first batch
+	res, err := s.k.CreditLocksByBatch(s.ctx, &types.QueryCreditLocksByBatchRequest{
+		BatchDenom: testBatchDenom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Locks))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, testBatchDenom, res.Locks[0].BatchDenom)
+	assert.Equal(t, "10", res.Locks[0].Amount)
+
+	// query credit locks by unknown batch denom
+	_, err = s.k.CreditLocksByBatch(s.ctx, &types.QueryCreditLocksByBatchRequest{
+		BatchDenom: "C01-001-20200101-20210101-003",
+	})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	// query credit locks by invalid batch denom
+	_, err = s.k.CreditLocksByBatch(s.ctx, &types.QueryCreditLocksByBatchRequest{BatchDenom: "foo"})
+	assert.ErrorContains(t, err, "batch denom: expected format")
+}
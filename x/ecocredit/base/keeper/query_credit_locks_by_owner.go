@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// CreditLocksByOwner queries all credit locks for a given owner address.
+func (k Keeper) CreditLocksByOwner(ctx context.Context, req *types.QueryCreditLocksByOwnerRequest) (*types.QueryCreditLocksByOwnerResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.CreditLockTable().List(ctx, api.CreditLockOwnerBatchKeyIndexKey{}.WithOwner(owner), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	locks := make([]*types.CreditLockInfo, 0, 8)
+	for it.Next() {
+		lock, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		batch, err := k.stateStore.BatchTable().Get(ctx, lock.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+
+		locks = append(locks, creditLockInfo(lock, batch.Denom))
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCreditLocksByOwnerResponse{
+		Locks:      locks,
+		Pagination: pr,
+	}, nil
+}
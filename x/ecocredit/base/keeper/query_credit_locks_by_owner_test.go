@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_CreditLocksByOwner(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: testBatchDenom,
+	})
+	assert.NilError(t, err)
+
+	// insert two locks owned by the first address
+	assert.NilError(t, s.stateStore.CreditLockTable().Insert(s.ctx, &api.CreditLock{
+		Owner:    s.addr,
+		BatchKey: bKey,
+		Amount:   "10",
+	}))
+	assert.NilError(t, s.stateStore.CreditLockTable().Insert(s.ctx, &api.CreditLock{
+		Owner:    s.addr,
+		BatchKey: bKey,
+		Amount:   "20",
+	}))
+
+	// insert lock owned by the second address
+	assert.NilError(t, s.stateStore.CreditLockTable().Insert(s.ctx, &api.CreditLock{
+		Owner:    s.addr2,
+		BatchKey: bKey,
+		Amount:   "30",
+	}))
+
+	// query credit locks by the first address
+	res, err := s.k.CreditLocksByOwner(s.ctx, &types.QueryCreditLocksByOwnerRequest{
+		Owner:      s.addr.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Locks))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, s.addr.String(), res.Locks[0].Owner)
+	assert.Equal(t, testBatchDenom, res.Locks[0].BatchDenom)
+	assert.Equal(t, "10", res.Locks[0].Amount)
+
+	// query credit locks by invalid address
+	_, err = s.k.CreditLocksByOwner(s.ctx, &types.QueryCreditLocksByOwnerRequest{Owner: "foo"})
+	assert.ErrorContains(t, err, "decoding bech32 failed")
+}
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// HoldersByProject queries all accounts holding or having held credits issued
+// within the given project along with their total balances across the credit
+// batches of the project.
+func (k Keeper) HoldersByProject(ctx context.Context, req *types.QueryHoldersByProjectRequest) (*types.QueryHoldersByProjectResponse, error) {
+	project, err := k.getProjectByIDOrAlias(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.ProjectHolderTable().List(
+		ctx,
+		api.ProjectHolderProjectKeyAddressIndexKey{}.WithProjectKey(project.Key),
+		ormlist.Paginate(pg),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	holders := make([]*types.ProjectHolderInfo, 0, 8) // pre-allocate some cap space
+	for it.Next() {
+		holder, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.projectHolderInfo(ctx, holder.Address, project.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		holders = append(holders, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryHoldersByProjectResponse{Holders: holders, Pagination: pr}, nil
+}
+
+// projectHolderInfo returns the total balance of the account across the credit
+// batches of the project.
+func (k Keeper) projectHolderInfo(ctx context.Context, addr sdk.AccAddress, projectKey uint64) (*types.ProjectHolderInfo, error) {
+	it, err := k.stateStore.BatchBalanceIndexTable().List(
+		ctx,
+		api.BatchBalanceIndexAddressProjectKeyBatchKeyIndexKey{}.WithAddressProjectKey(addr, projectKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	// tradable, retired, escrowed, and locked totals
+	var totals [4]math.Dec
+	for i := range totals {
+		totals[i] = math.NewDecFromInt64(0)
+	}
+
+	for it.Next() {
+		index, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		balance, err := k.stateStore.BatchBalanceTable().Get(ctx, addr, index.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+
+		amounts := [4]string{balance.TradableAmount, balance.RetiredAmount, balance.EscrowedAmount, balance.LockedAmount}
+		for i, amount := range amounts {
+			if amount == "" {
+				continue // locked amount is empty for balances that never held locked credits
+			}
+			dec, err := math.NewNonNegativeDecFromString(amount)
+			if err != nil {
+				return nil, err
+			}
+			totals[i], err = math.SafeAddBalance(totals[i], dec)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &types.ProjectHolderInfo{
+		Address:        addr.String(),
+		TradableAmount: totals[0].String(),
+		RetiredAmount:  totals[1].String(),
+		EscrowedAmount: totals[2].String(),
+		LockedAmount:   totals[3].String(),
+	}, nil
+}
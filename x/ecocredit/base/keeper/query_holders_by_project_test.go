@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_HoldersByProject(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	s.setupIndexedBalances()
+
+	// holders are ordered by address
+	first, second := s.addr, s.addr2
+	if bytes.Compare(first, second) > 0 {
+		first, second = second, first
+	}
+	expected := map[string]*types.ProjectHolderInfo{
+		s.addr.String(): {
+			Address:        s.addr.String(),
+			TradableAmount: "15",
+			RetiredAmount:  "5",
+			EscrowedAmount: "1",
+			LockedAmount:   "0",
+		},
+		s.addr2.String(): {
+			Address:        s.addr2.String(),
+			TradableAmount: "2.5",
+			RetiredAmount:  "1",
+			EscrowedAmount: "0",
+			LockedAmount:   "0",
+		},
+	}
+
+	// query holders of project C01-001
+	res, err := s.k.HoldersByProject(s.ctx, &types.QueryHoldersByProjectRequest{
+		ProjectId:  "C01-001",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Holders))
+	assert.DeepEqual(t, expected[sdk.AccAddress(first).String()], res.Holders[0])
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	// query holders of project C01-001 in reverse order
+	res, err = s.k.HoldersByProject(s.ctx, &types.QueryHoldersByProjectRequest{
+		ProjectId:  "C01-001",
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Holders))
+	assert.DeepEqual(t, expected[sdk.AccAddress(second).String()], res.Holders[0])
+
+	// query holders of project C02-001
+	res, err = s.k.HoldersByProject(s.ctx, &types.QueryHoldersByProjectRequest{
+		ProjectId: "C02-001",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Holders))
+	assert.Equal(t, s.addr.String(), res.Holders[0].Address)
+	assert.Equal(t, "7", res.Holders[0].TradableAmount)
+
+	// query holders of project that does not exist
+	_, err = s.k.HoldersByProject(s.ctx, &types.QueryHoldersByProjectRequest{
+		ProjectId: "C01-003",
+	})
+	assert.ErrorContains(t, err, "could not get project with id C01-003")
+}
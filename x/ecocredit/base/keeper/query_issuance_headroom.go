@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// IssuanceHeadroom queries the remaining amount of credits an issuer can issue
+// for a project within a vintage year. Remaining amounts are empty when there
+// is no cap.
+func (k Keeper) IssuanceHeadroom(ctx context.Context, req *types.QueryIssuanceHeadroomRequest) (*types.QueryIssuanceHeadroomResponse, error) {
+	if req.VintageYear == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("vintage year cannot be zero")
+	}
+
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("issuer: %s", err.Error())
+	}
+
+	project, err := k.stateStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", req.ProjectId, err.Error())
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	classIssuer, err := k.stateStore.ClassIssuerTable().Get(ctx, class.Key, issuer)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not an issuer of credit class %s", req.Issuer, class.Id)
+		}
+		return nil, err
+	}
+
+	issuerIssued, err := math.NewNonNegativeDecFromString(classIssuer.IssuedAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	maxIssuance, err := getMaxProjectVintageIssuance(ctx, k.stateStore, class.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	vintageIssuance, err := getProjectVintageIssuance(ctx, k.stateStore, project.Key, req.VintageYear)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryIssuanceHeadroomResponse{
+		IssuerMaxIssuance:          classIssuer.MaxIssuance,
+		IssuerIssuedAmount:         issuerIssued.String(),
+		ProjectVintageMaxIssuance:  maxIssuance,
+		ProjectVintageIssuedAmount: vintageIssuance.IssuedAmount,
+	}
+
+	var remaining *math.Dec
+
+	if classIssuer.MaxIssuance != "" {
+		issuerRemaining, err := remainingIssuance(classIssuer.MaxIssuance, res.IssuerIssuedAmount)
+		if err != nil {
+			return nil, err
+		}
+		res.IssuerRemaining = issuerRemaining.String()
+		remaining = &issuerRemaining
+	}
+
+	if maxIssuance != "" {
+		vintageRemaining, err := remainingIssuance(maxIssuance, vintageIssuance.IssuedAmount)
+		if err != nil {
+			return nil, err
+		}
+		res.ProjectVintageRemaining = vintageRemaining.String()
+		if remaining == nil || vintageRemaining.Cmp(*remaining) == -1 {
+			remaining = &vintageRemaining
+		}
+	}
+
+	if remaining != nil {
+		res.Remaining = remaining.String()
+	}
+
+	return res, nil
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_IssuanceHeadroom(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert class with issuer
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: "C01"})
+	assert.NilError(t, err)
+	assert.NilError(t, s.stateStore.ClassIssuerTable().Insert(s.ctx, &api.ClassIssuer{
+		ClassKey: classKey,
+		Issuer:   s.addr,
+	}))
+
+	// insert project
+	projectKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       "C01-001",
+		ClassKey: classKey,
+	})
+	assert.NilError(t, err)
+
+	// query headroom without caps
+	res, err := s.k.IssuanceHeadroom(s.ctx, &types.QueryIssuanceHeadroomRequest{
+		ProjectId:   "C01-001",
+		Issuer:      s.addr.String(),
+		VintageYear: 2020,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "", res.IssuerMaxIssuance)
+	assert.Equal(t, "0", res.IssuerIssuedAmount)
+	assert.Equal(t, "", res.IssuerRemaining)
+	assert.Equal(t, "", res.ProjectVintageMaxIssuance)
+	assert.Equal(t, "0", res.ProjectVintageIssuedAmount)
+	assert.Equal(t, "", res.ProjectVintageRemaining)
+	assert.Equal(t, "", res.Remaining)
+
+	// set caps and issued amounts
+	assert.NilError(t, s.stateStore.ClassIssuerTable().Update(s.ctx, &api.ClassIssuer{
+		ClassKey:     classKey,
+		Issuer:       s.addr,
+		MaxIssuance:  "1000",
+		IssuedAmount: "400",
+	}))
+	assert.NilError(t, s.stateStore.ClassIssuanceCapTable().Insert(s.ctx, &api.ClassIssuanceCap{
+		ClassKey:                  classKey,
+		MaxProjectVintageIssuance: "500",
+	}))
+	assert.NilError(t, s.stateStore.ProjectVintageIssuanceTable().Insert(s.ctx, &api.ProjectVintageIssuance{
+		ProjectKey:   projectKey,
+		VintageYear:  2020,
+		IssuedAmount: "100",
+	}))
+
+	// query headroom limited by the project vintage cap
+	res, err = s.k.IssuanceHeadroom(s.ctx, &types.QueryIssuanceHeadroomRequest{
+		ProjectId:   "C01-001",
+		Issuer:      s.addr.String(),
+		VintageYear: 2020,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "1000", res.IssuerMaxIssuance)
+	assert.Equal(t, "400", res.IssuerIssuedAmount)
+	assert.Equal(t, "600", res.IssuerRemaining)
+	assert.Equal(t, "500", res.ProjectVintageMaxIssuance)
+	assert.Equal(t, "100", res.ProjectVintageIssuedAmount)
+	assert.Equal(t, "400", res.ProjectVintageRemaining)
+	assert.Equal(t, "400", res.Remaining)
+
+	// query headroom of another vintage year limited by the issuer cap
+	res, err = s.k.IssuanceHeadroom(s.ctx, &types.QueryIssuanceHeadroomRequest{
+		ProjectId:   "C01-001",
+		Issuer:      s.addr.String(),
+		VintageYear: 2021,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "0", res.ProjectVintageIssuedAmount)
+	assert.Equal(t, "500", res.ProjectVintageRemaining)
+	assert.Equal(t, "500", res.Remaining)
+
+	// query headroom with zero vintage year
+	_, err = s.k.IssuanceHeadroom(s.ctx, &types.QueryIssuanceHeadroomRequest{
+		ProjectId: "C01-001",
+		Issuer:    s.addr.String(),
+	})
+	assert.ErrorContains(t, err, "vintage year cannot be zero")
+
+	// query headroom of an account that is not a class issuer
+	_, err = s.k.IssuanceHeadroom(s.ctx, &types.QueryIssuanceHeadroomRequest{
+		ProjectId:   "C01-001",
+		Issuer:      s.addr2.String(),
+		VintageYear: 2020,
+	})
+	assert.ErrorContains(t, err, "is not an issuer of credit class C01")
+
+	// query headroom of unknown project
+	_, err = s.k.IssuanceHeadroom(s.ctx, &types.QueryIssuanceHeadroomRequest{
+		ProjectId:   "C01-002",
+		Issuer:      s.addr.String(),
+		VintageYear: 2020,
+	})
+	assert.ErrorContains(t, err, "could not get project with id C01-002")
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// NationalRegistries queries all national registries.
+func (k Keeper) NationalRegistries(ctx context.Context, req *types.QueryNationalRegistriesRequest) (*types.QueryNationalRegistriesResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.NationalRegistryTable().List(ctx, api.NationalRegistryPrimaryKey{}, ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	registries := make([]*types.NationalRegistryInfo, 0)
+	for it.Next() {
+		registry, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		registries = append(registries, &types.NationalRegistryInfo{
+			Country: registry.Country,
+			Address: sdk.AccAddress(registry.Address).String(),
+		})
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryNationalRegistriesResponse{
+		Registries: registries,
+		Pagination: pr,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_NationalRegistries(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	assert.NilError(t, s.stateStore.NationalRegistryTable().Insert(s.ctx, &api.NationalRegistry{
+		Country: "KE",
+		Address: s.addr,
+	}))
+	assert.NilError(t, s.stateStore.NationalRegistryTable().Insert(s.ctx, &api.NationalRegistry{
+		Country: "CO",
+		Address: s.addr2,
+	}))
+
+	res, err := s.k.NationalRegistries(s.ctx, &types.QueryNationalRegistriesRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Registries))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, "CO", res.Registries[0].Country)
+	assert.Equal(t, s.addr2.String(), res.Registries[0].Address)
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// PendingBatch queries a pending batch by id.
+func (k Keeper) PendingBatch(ctx context.Context, req *types.QueryPendingBatchRequest) (*types.QueryPendingBatchResponse, error) {
+	pending, err := k.stateStore.PendingBatchTable().Get(ctx, req.Id)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get pending batch with id %d: %s", req.Id, err.Error())
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, pending.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := k.pendingBatchInfo(ctx, pending, class.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingBatchResponse{PendingBatch: info}, nil
+}
+
+// pendingBatchInfo converts a pending batch into human-readable pending batch
+// information.
+func (k Keeper) pendingBatchInfo(ctx context.Context, pending *api.PendingBatch, classID string) (*types.PendingBatchInfo, error) {
+	project, err := k.stateStore.ProjectTable().Get(ctx, pending.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.PendingBatchInfo{
+		Id:         pending.Id,
+		ClassId:    classID,
+		ProjectId:  project.Id,
+		Issuer:     sdk.AccAddress(pending.Issuer).String(),
+		Issuance:   batchIssuance(pending.Issuance),
+		Metadata:   pending.Metadata,
+		StartDate:  regentypes.ProtobufToGogoTimestamp(pending.StartDate),
+		EndDate:    regentypes.ProtobufToGogoTimestamp(pending.EndDate),
+		Open:       pending.Open,
+		OriginTx:   batchOriginTx(pending.OriginTx),
+		Expiration: regentypes.ProtobufToGogoTimestamp(pending.Expiration),
+	}, nil
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_PendingBatch(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert class and project
+	cKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: testClassID})
+	assert.NilError(t, err)
+	pKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{Id: testProjectID, ClassKey: cKey})
+	assert.NilError(t, err)
+
+	// insert pending batch
+	startDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	id, err := s.stateStore.PendingBatchTable().InsertReturningID(s.ctx, &api.PendingBatch{
+		ClassKey:   cKey,
+		ProjectKey: pKey,
+		Issuer:     s.addr,
+		Issuance: []*api.PendingBatch_Issuance{
+			{Recipient: s.addr2.String(), TradableAmount: "10", RetiredAmount: "5", RetirementJurisdiction: "US-WA"},
+		},
+		Metadata:   "data",
+		StartDate:  timestamppb.New(startDate),
+		EndDate:    timestamppb.New(endDate),
+		OriginTx:   &api.PendingBatch_OriginTx{Id: "0x1234", Source: "polygon"},
+		Expiration: timestamppb.New(endDate),
+	})
+	assert.NilError(t, err)
+
+	// query pending batch by id
+	res, err := s.k.PendingBatch(s.ctx, &types.QueryPendingBatchRequest{Id: id})
+	assert.NilError(t, err)
+	assert.Equal(t, id, res.PendingBatch.Id)
+	assert.Equal(t, testClassID, res.PendingBatch.ClassId)
+	assert.Equal(t, testProjectID, res.PendingBatch.ProjectId)
+	assert.Equal(t, s.addr.String(), res.PendingBatch.Issuer)
+	assert.Equal(t, 1, len(res.PendingBatch.Issuance))
+	assert.Equal(t, s.addr2.String(), res.PendingBatch.Issuance[0].Recipient)
+	assert.Equal(t, "10", res.PendingBatch.Issuance[0].TradableAmount)
+	assert.Equal(t, "5", res.PendingBatch.Issuance[0].RetiredAmount)
+	assert.Equal(t, "US-WA", res.PendingBatch.Issuance[0].RetirementJurisdiction)
+	assert.Equal(t, "data", res.PendingBatch.Metadata)
+	assert.Equal(t, startDate.Unix(), res.PendingBatch.StartDate.Seconds)
+	assert.Equal(t, endDate.Unix(), res.PendingBatch.EndDate.Seconds)
+	assert.Equal(t, "0x1234", res.PendingBatch.OriginTx.Id)
+	assert.Equal(t, "polygon", res.PendingBatch.OriginTx.Source)
+	assert.Equal(t, endDate.Unix(), res.PendingBatch.Expiration.Seconds)
+
+	// query pending batch by unknown id
+	_, err = s.k.PendingBatch(s.ctx, &types.QueryPendingBatchRequest{Id: 2})
+	assert.ErrorContains(t, err, "could not get pending batch with id 2")
+}
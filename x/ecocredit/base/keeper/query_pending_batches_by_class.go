@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// PendingBatchesByClass queries all pending batches for a given credit class.
+func (k Keeper) PendingBatchesByClass(ctx context.Context, req *types.QueryPendingBatchesByClassRequest) (*types.QueryPendingBatchesByClassResponse, error) {
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.PendingBatchTable().List(ctx, api.PendingBatchClassKeyIndexKey{}.WithClassKey(class.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	pendingBatches := make([]*types.PendingBatchInfo, 0, 8)
+	for it.Next() {
+		pending, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.pendingBatchInfo(ctx, pending, class.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		pendingBatches = append(pendingBatches, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingBatchesByClassResponse{
+		PendingBatches: pendingBatches,
+		Pagination:     pr,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_PendingBatchesByClass(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert classes and project
+	cKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: testClassID})
+	assert.NilError(t, err)
+	cKey2, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: "C02"})
+	assert.NilError(t, err)
+	pKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{Id: testProjectID, ClassKey: cKey})
+	assert.NilError(t, err)
+
+	// insert two pending batches for the class and one for another class
+	for _, classKey := range []uint64{cKey, cKey, cKey2} {
+		err = s.stateStore.PendingBatchTable().Insert(s.ctx, &api.PendingBatch{
+			ClassKey:   classKey,
+			ProjectKey: pKey,
+			Issuer:     s.addr,
+			Issuance: []*api.PendingBatch_Issuance{
+				{Recipient: s.addr2.String(), TradableAmount: "10"},
+			},
+			StartDate:  timestamppb.Now(),
+			EndDate:    timestamppb.Now(),
+			Expiration: timestamppb.Now(),
+		})
+		assert.NilError(t, err)
+	}
+
+	// query pending batches by class
+	res, err := s.k.PendingBatchesByClass(s.ctx, &types.QueryPendingBatchesByClassRequest{
+		ClassId:    testClassID,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.PendingBatches))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, testClassID, res.PendingBatches[0].ClassId)
+	assert.Equal(t, testProjectID, res.PendingBatches[0].ProjectId)
+
+	// query pending batches by class with pagination
+	res, err = s.k.PendingBatchesByClass(s.ctx, &types.QueryPendingBatchesByClassRequest{
+		ClassId:    testClassID,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.PendingBatches))
+
+	// query pending batches by unknown class
+	_, err = s.k.PendingBatchesByClass(s.ctx, &types.QueryPendingBatchesByClassRequest{ClassId: "C03"})
+	assert.ErrorContains(t, err, "could not get class with id C03")
+}
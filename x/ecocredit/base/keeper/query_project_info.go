@@ -9,9 +9,10 @@ import (
 	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
 )
 
-// Project queries project info from the given project name.
+// Project queries project info from the given project name. The project name
+// can also be a previous project id of a migrated project.
 func (k Keeper) Project(ctx context.Context, request *types.QueryProjectRequest) (*types.QueryProjectResponse, error) {
-	project, err := k.stateStore.ProjectTable().GetById(ctx, request.ProjectId)
+	project, err := k.getProjectByIDOrAlias(ctx, request.ProjectId)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", request.ProjectId, err.Error())
 	}
@@ -23,6 +24,11 @@ func (k Keeper) Project(ctx context.Context, request *types.QueryProjectRequest)
 		return nil, err
 	}
 
+	status, err := getProjectStatus(ctx, k.stateStore, project.Key)
+	if err != nil {
+		return nil, err
+	}
+
 	info := types.ProjectInfo{
 		Id:           project.Id,
 		Admin:        admin.String(),
@@ -30,6 +36,7 @@ func (k Keeper) Project(ctx context.Context, request *types.QueryProjectRequest)
 		Jurisdiction: project.Jurisdiction,
 		Metadata:     project.Metadata,
 		ReferenceId:  project.ReferenceId,
+		Status:       types.ProjectStatus(status),
 	}
 
 	return &types.QueryProjectResponse{Project: &info}, nil
@@ -41,8 +41,34 @@ func TestQuery_Project(t *testing.T) {
 	assert.Equal(t, project.Jurisdiction, res.Project.Jurisdiction)
 	assert.Equal(t, project.Metadata, res.Project.Metadata)
 	assert.Equal(t, project.ReferenceId, res.Project.ReferenceId)
+	assert.Equal(t, types.ProjectStatus_PROJECT_STATUS_UNSPECIFIED, res.Project.Status)
+
+	// update project status
+	err = s.stateStore.ProjectLifecycleTable().Insert(s.ctx, &api.ProjectLifecycle{
+		ProjectKey: 1,
+		Status:     uint32(api.ProjectStatus_PROJECT_STATUS_ACTIVE),
+	})
+	assert.NilError(t, err)
+
+	// query project with updated status
+	res, err = s.k.Project(s.ctx, &types.QueryProjectRequest{ProjectId: "C01-001"})
+	assert.NilError(t, err)
+	assert.Equal(t, types.ProjectStatus_PROJECT_STATUS_ACTIVE, res.Project.Status)
 
 	// query project by unknown project id
 	_, err = s.k.Project(s.ctx, &types.QueryProjectRequest{ProjectId: "F01"})
 	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	// insert alias of migrated project
+	err = s.stateStore.ProjectAliasTable().Insert(s.ctx, &api.ProjectAlias{
+		Alias:      "C02-001",
+		ProjectKey: 1,
+	})
+	assert.NilError(t, err)
+
+	// query project by "C02-001" alias
+	res, err = s.k.Project(s.ctx, &types.QueryProjectRequest{ProjectId: "C02-001"})
+	assert.NilError(t, err)
+	assert.Equal(t, project.Id, res.Project.Id)
+	assert.Equal(t, "C01", res.Project.ClassId)
 }
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_ProjectStatusHistory(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert projects
+	projectKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       "C01-001",
+		ClassKey: 1,
+	})
+	assert.NilError(t, err)
+	projectKey2, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       "C01-002",
+		ClassKey: 1,
+	})
+	assert.NilError(t, err)
+
+	// insert two status changes for the first project
+	assert.NilError(t, s.stateStore.ProjectStatusChangeTable().Insert(s.ctx, &api.ProjectStatusChange{
+		ProjectKey: projectKey,
+		Status:     api.ProjectStatus_PROJECT_STATUS_ACTIVE,
+		Signer:     s.addr,
+		Reason:     "validated",
+		Timestamp:  timestamppb.Now(),
+	}))
+	assert.NilError(t, s.stateStore.ProjectStatusChangeTable().Insert(s.ctx, &api.ProjectStatusChange{
+		ProjectKey: projectKey,
+		Status:     api.ProjectStatus_PROJECT_STATUS_SUSPENDED,
+		Signer:     s.addr2,
+		Reason:     "reversal event",
+		Timestamp:  timestamppb.Now(),
+	}))
+
+	// insert status change for the second project
+	assert.NilError(t, s.stateStore.ProjectStatusChangeTable().Insert(s.ctx, &api.ProjectStatusChange{
+		ProjectKey: projectKey2,
+		Status:     api.ProjectStatus_PROJECT_STATUS_PROPOSED,
+		Signer:     s.addr,
+		Timestamp:  timestamppb.Now(),
+	}))
+
+	// query status history of the first project
+	res, err := s.k.ProjectStatusHistory(s.ctx, &types.QueryProjectStatusHistoryRequest{
+		ProjectId:  "C01-001",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Changes))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, types.ProjectStatus_PROJECT_STATUS_ACTIVE, res.Changes[0].Status)
+	assert.Equal(t, s.addr.String(), res.Changes[0].Signer)
+	assert.Equal(t, "validated", res.Changes[0].Reason)
+	assert.Assert(t, res.Changes[0].Timestamp != nil)
+
+	// query status history of unknown project
+	_, err = s.k.ProjectStatusHistory(s.ctx, &types.QueryProjectStatusHistoryRequest{ProjectId: "C01-003"})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
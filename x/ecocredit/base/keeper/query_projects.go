@@ -38,6 +38,11 @@ func (k Keeper) Projects(ctx context.Context, request *types.QueryProjectsReques
 			return nil, err
 		}
 
+		status, err := getProjectStatus(ctx, k.stateStore, project.Key)
+		if err != nil {
+			return nil, err
+		}
+
 		info := types.ProjectInfo{
 			Id:           project.Id,
 			Admin:        admin.String(),
@@ -45,6 +50,7 @@ func (k Keeper) Projects(ctx context.Context, request *types.QueryProjectsReques
 			Jurisdiction: project.Jurisdiction,
 			Metadata:     project.Metadata,
 			ReferenceId:  project.ReferenceId,
+			Status:       types.ProjectStatus(status),
 		}
 
 		projects = append(projects, &info)
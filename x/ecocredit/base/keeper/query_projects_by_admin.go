@@ -41,6 +41,11 @@ func (k Keeper) ProjectsByAdmin(ctx context.Context, req *types.QueryProjectsByA
 			return nil, err
 		}
 
+		status, err := getProjectStatus(ctx, k.stateStore, project.Key)
+		if err != nil {
+			return nil, err
+		}
+
 		projects = append(projects, &types.ProjectInfo{
 			Id:           project.Id,
 			Admin:        req.Admin,
@@ -48,6 +53,7 @@ func (k Keeper) ProjectsByAdmin(ctx context.Context, req *types.QueryProjectsByA
 			Jurisdiction: project.Jurisdiction,
 			Metadata:     project.Metadata,
 			ReferenceId:  project.ReferenceId,
+			Status:       types.ProjectStatus(status),
 		})
 	}
 
@@ -44,6 +44,11 @@ func (k Keeper) ProjectsByClass(ctx context.Context, request *types.QueryProject
 			return nil, err
 		}
 
+		status, err := getProjectStatus(ctx, k.stateStore, project.Key)
+		if err != nil {
+			return nil, err
+		}
+
 		info := types.ProjectInfo{
 			Id:           project.Id,
 			Admin:        admin.String(),
@@ -51,6 +56,7 @@ func (k Keeper) ProjectsByClass(ctx context.Context, request *types.QueryProject
 			Jurisdiction: project.Jurisdiction,
 			Metadata:     project.Metadata,
 			ReferenceId:  project.ReferenceId,
+			Status:       types.ProjectStatus(status),
 		}
 
 		projects = append(projects, &info)
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// ProjectsByJurisdiction queries all projects located within a given
+// jurisdiction, including projects within nested jurisdictions (e.g. querying
+// by "US" returns projects with jurisdiction "US-WA" and "US-WA 98225").
+func (k Keeper) ProjectsByJurisdiction(ctx context.Context, request *types.QueryProjectsByJurisdictionRequest) (*types.QueryProjectsByJurisdictionResponse, error) {
+	from, to, err := base.JurisdictionRange(request.Jurisdiction)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("jurisdiction: %s", err)
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var it api.ProjectIterator
+	if from == to {
+		// a full jurisdiction with a postal code only matches itself
+		it, err = k.stateStore.ProjectTable().List(ctx, api.ProjectJurisdictionIndexKey{}.WithJurisdiction(from), ormlist.Paginate(pg))
+	} else {
+		it, err = k.stateStore.ProjectTable().ListRange(
+			ctx,
+			api.ProjectJurisdictionIndexKey{}.WithJurisdiction(from),
+			api.ProjectJurisdictionIndexKey{}.WithJurisdiction(to),
+			ormlist.Paginate(pg),
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	projects := make([]*types.ProjectInfo, 0)
+	for it.Next() {
+		project, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		admin := sdk.AccAddress(project.Admin)
+
+		class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+		if err != nil {
+			return nil, err
+		}
+
+		status, err := getProjectStatus(ctx, k.stateStore, project.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		info := types.ProjectInfo{
+			Id:           project.Id,
+			Admin:        admin.String(),
+			ClassId:      class.Id,
+			Jurisdiction: project.Jurisdiction,
+			Metadata:     project.Metadata,
+			ReferenceId:  project.ReferenceId,
+			Status:       types.ProjectStatus(status),
+		}
+
+		projects = append(projects, &info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProjectsByJurisdictionResponse{
+		Projects:   projects,
+		Pagination: pr,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_ProjectsByJurisdiction(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert class
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id: testClassID,
+	})
+	assert.NilError(t, err)
+
+	// insert projects with nested and neighbouring jurisdictions
+	for i, jurisdiction := range []string{"US-WA", "US-WA 98225", "US-WA 98225 1", "US-OR", "USA", "CA-BC", "US"} {
+		assert.NilError(t, s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+			Id:           fmt.Sprintf("%s-%03d", testClassID, i+1),
+			Admin:        s.addr,
+			ClassKey:     classKey,
+			Jurisdiction: jurisdiction,
+		}))
+	}
+
+	// query projects by country code
+	res, err := s.k.ProjectsByJurisdiction(s.ctx, &types.QueryProjectsByJurisdictionRequest{
+		Jurisdiction: "US",
+		Pagination:   &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Projects))
+	assert.Equal(t, uint64(5), res.Pagination.Total)
+	assert.Equal(t, "US", res.Projects[0].Jurisdiction)
+	assert.Equal(t, testClassID, res.Projects[0].ClassId)
+	assert.Equal(t, s.addr.String(), res.Projects[0].Admin)
+
+	// query projects by subdivision code
+	res, err = s.k.ProjectsByJurisdiction(s.ctx, &types.QueryProjectsByJurisdictionRequest{
+		Jurisdiction: "US-WA",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 3, len(res.Projects))
+	assert.Equal(t, "US-WA", res.Projects[0].Jurisdiction)
+	assert.Equal(t, "US-WA 98225", res.Projects[1].Jurisdiction)
+	assert.Equal(t, "US-WA 98225 1", res.Projects[2].Jurisdiction)
+
+	// query projects by full jurisdiction
+	res, err = s.k.ProjectsByJurisdiction(s.ctx, &types.QueryProjectsByJurisdictionRequest{
+		Jurisdiction: "US-WA 98225",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Projects))
+	assert.Equal(t, "US-WA 98225", res.Projects[0].Jurisdiction)
+
+	// query projects by jurisdiction with no projects
+	res, err = s.k.ProjectsByJurisdiction(s.ctx, &types.QueryProjectsByJurisdictionRequest{
+		Jurisdiction: "FR",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Projects))
+
+	// query projects by invalid jurisdiction
+	_, err = s.k.ProjectsByJurisdiction(s.ctx, &types.QueryProjectsByJurisdictionRequest{
+		Jurisdiction: "foo",
+	})
+	assert.ErrorContains(t, err, sdkerrors.ErrInvalidRequest.Error())
+}
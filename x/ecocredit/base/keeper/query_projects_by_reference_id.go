@@ -43,6 +43,11 @@ func (k Keeper) ProjectsByReferenceId(ctx context.Context, req *types.QueryProje
 			return nil, err
 		}
 
+		status, err := getProjectStatus(ctx, k.stateStore, project.Key)
+		if err != nil {
+			return nil, err
+		}
+
 		info := &types.ProjectInfo{
 			Id:           project.Id,
 			Admin:        sdk.AccAddress(project.Admin).String(),
@@ -50,6 +55,7 @@ func (k Keeper) ProjectsByReferenceId(ctx context.Context, req *types.QueryProje
 			Jurisdiction: project.Jurisdiction,
 			Metadata:     project.Metadata,
 			ReferenceId:  project.ReferenceId,
+			Status:       types.ProjectStatus(status),
 		}
 
 		projects = append(projects, info)
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// ProjectsByStatus queries all projects with a given status. Projects whose
+// status has never been updated have an unspecified status and cannot be
+// queried by status.
+func (k Keeper) ProjectsByStatus(ctx context.Context, request *types.QueryProjectsByStatusRequest) (*types.QueryProjectsByStatusResponse, error) {
+	if request.Status == types.ProjectStatus_PROJECT_STATUS_UNSPECIFIED {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("status cannot be unspecified")
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	status := uint32(request.Status)
+	it, err := k.stateStore.ProjectLifecycleTable().List(ctx, api.ProjectLifecycleStatusIndexKey{}.WithStatus(status), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	projects := make([]*types.ProjectInfo, 0)
+	for it.Next() {
+		lifecycle, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		project, err := k.stateStore.ProjectTable().Get(ctx, lifecycle.ProjectKey)
+		if err != nil {
+			return nil, err
+		}
+
+		class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+		if err != nil {
+			return nil, err
+		}
+
+		info := types.ProjectInfo{
+			Id:           project.Id,
+			Admin:        sdk.AccAddress(project.Admin).String(),
+			ClassId:      class.Id,
+			Jurisdiction: project.Jurisdiction,
+			Metadata:     project.Metadata,
+			ReferenceId:  project.ReferenceId,
+			Status:       request.Status,
+		}
+
+		projects = append(projects, &info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProjectsByStatusResponse{
+		Projects:   projects,
+		Pagination: pr,
+	}, nil
+}
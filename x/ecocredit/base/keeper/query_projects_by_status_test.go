@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_ProjectsByStatus(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert class
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id: "C01",
+	})
+	assert.NilError(t, err)
+
+	// insert three projects, two active and one suspended
+	statuses := []api.ProjectStatus{
+		api.ProjectStatus_PROJECT_STATUS_ACTIVE,
+		api.ProjectStatus_PROJECT_STATUS_SUSPENDED,
+		api.ProjectStatus_PROJECT_STATUS_ACTIVE,
+	}
+	for i, status := range statuses {
+		projectKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+			Id:           base.FormatProjectID("C01", uint64(i+1)),
+			Admin:        s.addr,
+			ClassKey:     classKey,
+			Jurisdiction: "US-CA",
+		})
+		assert.NilError(t, err)
+
+		assert.NilError(t, s.stateStore.ProjectLifecycleTable().Insert(s.ctx, &api.ProjectLifecycle{
+			ProjectKey: projectKey,
+			Status:     uint32(status),
+		}))
+	}
+
+	// insert project without a status
+	assert.NilError(t, s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       "C01-004",
+		ClassKey: classKey,
+	}))
+
+	// query active projects
+	res, err := s.k.ProjectsByStatus(s.ctx, &types.QueryProjectsByStatusRequest{
+		Status:     types.ProjectStatus_PROJECT_STATUS_ACTIVE,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Projects))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, "C01-001", res.Projects[0].Id)
+	assert.Equal(t, "C01", res.Projects[0].ClassId)
+	assert.Equal(t, s.addr.String(), res.Projects[0].Admin)
+	assert.Equal(t, "US-CA", res.Projects[0].Jurisdiction)
+	assert.Equal(t, types.ProjectStatus_PROJECT_STATUS_ACTIVE, res.Projects[0].Status)
+
+	// query suspended projects
+	res, err = s.k.ProjectsByStatus(s.ctx, &types.QueryProjectsByStatusRequest{
+		Status: types.ProjectStatus_PROJECT_STATUS_SUSPENDED,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Projects))
+	assert.Equal(t, "C01-002", res.Projects[0].Id)
+
+	// query retired projects
+	res, err = s.k.ProjectsByStatus(s.ctx, &types.QueryProjectsByStatusRequest{
+		Status: types.ProjectStatus_PROJECT_STATUS_RETIRED,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Projects))
+
+	// query unspecified status
+	_, err = s.k.ProjectsByStatus(s.ctx, &types.QueryProjectsByStatusRequest{})
+	assert.ErrorContains(t, err, "status cannot be unspecified")
+}
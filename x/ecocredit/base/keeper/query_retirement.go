@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// Retirement queries a retirement record by id.
+func (k Keeper) Retirement(ctx context.Context, req *types.QueryRetirementRequest) (*types.QueryRetirementResponse, error) {
+	retirement, err := k.stateStore.RetirementTable().Get(ctx, req.Id)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get retirement with id %d: %s", req.Id, err.Error())
+	}
+
+	batch, err := k.stateStore.BatchTable().Get(ctx, retirement.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRetirementResponse{
+		Retirement: retirementInfo(retirement, batch.Denom),
+	}, nil
+}
+
+// retirementInfo converts a retirement record into human-readable retirement
+// information.
+func retirementInfo(retirement *api.Retirement, batchDenom string) *types.RetirementInfo {
+	return &types.RetirementInfo{
+		Id:           retirement.Id,
+		Owner:        sdk.AccAddress(retirement.Owner).String(),
+		BatchDenom:   batchDenom,
+		Amount:       retirement.Amount,
+		Jurisdiction: retirement.Jurisdiction,
+		Timestamp:    regentypes.ProtobufToGogoTimestamp(retirement.Timestamp),
+		Reason:       retirement.Reason,
+		Beneficiary:  retirement.Beneficiary,
+	}
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// RetirementSummary queries the total amount of credits retired by an account
+// within a calendar year, grouped by credit type and retirement jurisdiction.
+// Retirements made before the upgrade that introduced retirement records are
+// not included because the year of those retirements is not recorded in state.
+func (k Keeper) RetirementSummary(ctx context.Context, req *types.QueryRetirementSummaryRequest) (*types.QueryRetirementSummaryResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	if req.Year == 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("year cannot be empty")
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	key := api.RetirementSummaryOwnerYearCreditTypeAbbrevJurisdictionIndexKey{}.WithOwnerYear(owner, req.Year)
+	if req.CreditTypeAbbrev != "" {
+		key = key.WithOwnerYearCreditTypeAbbrev(owner, req.Year, req.CreditTypeAbbrev)
+	}
+
+	it, err := k.stateStore.RetirementSummaryTable().List(ctx, key, ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	summaries := make([]*types.RetirementSummaryInfo, 0, 8)
+	for it.Next() {
+		summary, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		summaries = append(summaries, &types.RetirementSummaryInfo{
+			Owner:            owner.String(),
+			Year:             summary.Year,
+			CreditTypeAbbrev: summary.CreditTypeAbbrev,
+			Jurisdiction:     summary.Jurisdiction,
+			Amount:           summary.Amount,
+		})
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRetirementSummaryResponse{
+		Summaries:  summaries,
+		Pagination: pr,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_RetirementSummary(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert carbon and biodiversity classes, projects, and batches
+	assert.NilError(t, s.stateStore.ClassTable().Insert(s.ctx, &api.Class{Id: "C01", CreditTypeAbbrev: "C"}))
+	assert.NilError(t, s.stateStore.ClassTable().Insert(s.ctx, &api.Class{Id: "BIO01", CreditTypeAbbrev: "BIO"}))
+	assert.NilError(t, s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{Id: "C01-001", ClassKey: 1}))
+	assert.NilError(t, s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{Id: "BIO01-001", ClassKey: 2}))
+	assert.NilError(t, s.stateStore.BatchTable().Insert(s.ctx, &api.Batch{ProjectKey: 1, Denom: "C01-001-20200101-20210101-001"}))
+	assert.NilError(t, s.stateStore.BatchTable().Insert(s.ctx, &api.Batch{ProjectKey: 2, Denom: "BIO01-001-20200101-20210101-001"}))
+
+	retire := func(year int, batchKey uint64, denom, amount, jurisdiction string) {
+		ctx := sdk.WrapSDKContext(s.sdkCtx.WithBlockTime(time.Date(year, 6, 1, 0, 0, 0, 0, time.UTC)))
+		_, err := RecordRetirement(ctx, s.stateStore, s.nftKeeper, &api.Retirement{
+			Owner:        s.addr,
+			BatchKey:     batchKey,
+			Amount:       amount,
+			Jurisdiction: jurisdiction,
+		}, denom)
+		assert.NilError(t, err)
+	}
+
+	retire(2022, 1, "C01-001-20200101-20210101-001", "10", "US-WA")
+	retire(2022, 1, "C01-001-20200101-20210101-001", "2.5", "US-WA")
+	retire(2022, 1, "C01-001-20200101-20210101-001", "4", "US-OR")
+	retire(2022, 2, "BIO01-001-20200101-20210101-001", "3", "US-WA")
+	retire(2023, 1, "C01-001-20200101-20210101-001", "7", "US-WA")
+
+	// query retirement summary of s.addr in 2022
+	res, err := s.k.RetirementSummary(s.ctx, &types.QueryRetirementSummaryRequest{
+		Owner:      s.addr.String(),
+		Year:       2022,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(3), res.Pagination.Total)
+	assert.DeepEqual(t, []*types.RetirementSummaryInfo{
+		{Owner: s.addr.String(), Year: 2022, CreditTypeAbbrev: "BIO", Jurisdiction: "US-WA", Amount: "3"},
+		{Owner: s.addr.String(), Year: 2022, CreditTypeAbbrev: "C", Jurisdiction: "US-OR", Amount: "4"},
+		{Owner: s.addr.String(), Year: 2022, CreditTypeAbbrev: "C", Jurisdiction: "US-WA", Amount: "12.5"},
+	}, res.Summaries)
+
+	// query retirement summary of s.addr in 2022 filtered by credit type
+	res, err = s.k.RetirementSummary(s.ctx, &types.QueryRetirementSummaryRequest{
+		Owner:            s.addr.String(),
+		Year:             2022,
+		CreditTypeAbbrev: "BIO",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Summaries))
+	assert.Equal(t, "3", res.Summaries[0].Amount)
+
+	// query retirement summary of s.addr in 2023
+	res, err = s.k.RetirementSummary(s.ctx, &types.QueryRetirementSummaryRequest{
+		Owner: s.addr.String(),
+		Year:  2023,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Summaries))
+	assert.Equal(t, "7", res.Summaries[0].Amount)
+
+	// query retirement summary of account without retirements
+	res, err = s.k.RetirementSummary(s.ctx, &types.QueryRetirementSummaryRequest{
+		Owner: s.addr2.String(),
+		Year:  2022,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Summaries))
+
+	// query retirement summary without year
+	_, err = s.k.RetirementSummary(s.ctx, &types.QueryRetirementSummaryRequest{
+		Owner: s.addr.String(),
+	})
+	assert.ErrorContains(t, err, "year cannot be empty")
+
+	// query retirement summary with invalid owner
+	_, err = s.k.RetirementSummary(s.ctx, &types.QueryRetirementSummaryRequest{
+		Owner: "foo",
+		Year:  2022,
+	})
+	assert.ErrorContains(t, err, "invalid address")
+}
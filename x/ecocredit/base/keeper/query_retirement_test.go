@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_Retirement(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	timestamp, err := regentypes.ParseDate("timestamp", "2022-01-01")
+	assert.NilError(t, err)
+
+	// insert batch
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: testBatchDenom,
+	})
+	assert.NilError(t, err)
+
+	retirement := &api.Retirement{
+		Owner:        s.addr,
+		BatchKey:     bKey,
+		Amount:       "10",
+		Jurisdiction: "US-WA",
+		Timestamp:    timestamppb.New(timestamp),
+		Reason:       "offsetting 2022 emissions",
+		Beneficiary:  "Acme Inc.",
+	}
+
+	// insert retirement
+	id, err := s.stateStore.RetirementTable().InsertReturningID(s.ctx, retirement)
+	assert.NilError(t, err)
+
+	// query retirement by id
+	res, err := s.k.Retirement(s.ctx, &types.QueryRetirementRequest{Id: id})
+	assert.NilError(t, err)
+	assert.Equal(t, id, res.Retirement.Id)
+	assert.Equal(t, s.addr.String(), res.Retirement.Owner)
+	assert.Equal(t, testBatchDenom, res.Retirement.BatchDenom)
+	assert.Equal(t, retirement.Amount, res.Retirement.Amount)
+	assert.Equal(t, retirement.Jurisdiction, res.Retirement.Jurisdiction)
+	assert.Equal(t, retirement.Reason, res.Retirement.Reason)
+	assert.Equal(t, retirement.Beneficiary, res.Retirement.Beneficiary)
+	assert.DeepEqual(t, regentypes.ProtobufToGogoTimestamp(retirement.Timestamp), res.Retirement.Timestamp)
+
+	// query retirement by unknown id
+	_, err = s.k.Retirement(s.ctx, &types.QueryRetirementRequest{Id: 2})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
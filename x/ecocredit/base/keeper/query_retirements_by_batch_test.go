@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_RetirementsByBatch(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert batches
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: testBatchDenom,
+	})
+	assert.NilError(t, err)
+	bKey2, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: "C01-001-20200101-20210101-002",
+	})
+	assert.NilError(t, err)
+
+	// insert two retirements from the first batch
+	assert.NilError(t, s.stateStore.RetirementTable().Insert(s.ctx, &api.Retirement{
+		Owner:        s.addr,
+		BatchKey:     bKey,
+		Amount:       "10",
+		Jurisdiction: "US-WA",
+	}))
+	assert.NilError(t, s.stateStore.RetirementTable().Insert(s.ctx, &api.Retirement{
+		Owner:        s.addr2,
+		BatchKey:     bKey,
+		Amount:       "20",
+		Jurisdiction: "US-OR",
+	}))
+
+	// insert retirement from the second batch
+	assert.NilError(t, s.stateStore.RetirementTable().Insert(s.ctx, &api.Retirement{
+		Owner:        s.addr,
+		BatchKey:     bKey2,
+		Amount:       "30",
+		Jurisdiction: "US-WA",
+	}))
+
+	// query retirements by the first batch
+	res, err := s.k.RetirementsByBatch(s.ctx, &types.QueryRetirementsByBatchRequest{
+		BatchDenom: testBatchDenom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Retirements))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, s.addr.String(), res.Retirements[0].Owner)
+	assert.Equal(t, testBatchDenom, res.Retirements[0].BatchDenom)
+	assert.Equal(t, "10", res.Retirements[0].Amount)
+
+	// query retirements by unknown batch denom
+	_, err = s.k.RetirementsByBatch(s.ctx, &types.QueryRetirementsByBatchRequest{
+		BatchDenom: "C01-001-20200101-20210101-003",
+	})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	// query retirements by invalid batch denom
+	_, err = s.k.RetirementsByBatch(s.ctx, &types.QueryRetirementsByBatchRequest{BatchDenom: "foo"})
+	assert.ErrorContains(t, err, "batch denom: expected format")
+}
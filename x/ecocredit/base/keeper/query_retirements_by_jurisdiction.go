@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// RetirementsByJurisdiction queries all retirement records within a given
+// jurisdiction, including retirements within nested jurisdictions (e.g.
+// querying by "US" returns retirements with jurisdiction "US-WA" and
+// "US-WA 98225").
+func (k Keeper) RetirementsByJurisdiction(ctx context.Context, req *types.QueryRetirementsByJurisdictionRequest) (*types.QueryRetirementsByJurisdictionResponse, error) {
+	from, to, err := base.JurisdictionRange(req.Jurisdiction)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("jurisdiction: %s", err)
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	var it api.RetirementIterator
+	if from == to {
+		// a full jurisdiction with a postal code only matches itself
+		it, err = k.stateStore.RetirementTable().List(ctx, api.RetirementJurisdictionIndexKey{}.WithJurisdiction(from), ormlist.Paginate(pg))
+	} else {
+		it, err = k.stateStore.RetirementTable().ListRange(
+			ctx,
+			api.RetirementJurisdictionIndexKey{}.WithJurisdiction(from),
+			api.RetirementJurisdictionIndexKey{}.WithJurisdiction(to),
+			ormlist.Paginate(pg),
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	retirements := make([]*types.RetirementInfo, 0, 8)
+	for it.Next() {
+		retirement, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		batch, err := k.stateStore.BatchTable().Get(ctx, retirement.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+
+		retirements = append(retirements, retirementInfo(retirement, batch.Denom))
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRetirementsByJurisdictionResponse{
+		Retirements: retirements,
+		Pagination:  pr,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_RetirementsByJurisdiction(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert batch
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: testBatchDenom,
+	})
+	assert.NilError(t, err)
+
+	// insert retirements with nested and neighbouring jurisdictions
+	for _, jurisdiction := range []string{"US-WA", "US-WA 98225", "US-OR", "CA-BC"} {
+		assert.NilError(t, s.stateStore.RetirementTable().Insert(s.ctx, &api.Retirement{
+			Owner:        s.addr,
+			BatchKey:     bKey,
+			Amount:       "10",
+			Jurisdiction: jurisdiction,
+		}))
+	}
+
+	// query retirements by country code
+	res, err := s.k.RetirementsByJurisdiction(s.ctx, &types.QueryRetirementsByJurisdictionRequest{
+		Jurisdiction: "US",
+		Pagination:   &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Retirements))
+	assert.Equal(t, uint64(3), res.Pagination.Total)
+	assert.Equal(t, "US-OR", res.Retirements[0].Jurisdiction)
+	assert.Equal(t, testBatchDenom, res.Retirements[0].BatchDenom)
+	assert.Equal(t, s.addr.String(), res.Retirements[0].Owner)
+
+	// query retirements by subdivision code
+	res, err = s.k.RetirementsByJurisdiction(s.ctx, &types.QueryRetirementsByJurisdictionRequest{
+		Jurisdiction: "US-WA",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Retirements))
+	assert.Equal(t, "US-WA", res.Retirements[0].Jurisdiction)
+	assert.Equal(t, "US-WA 98225", res.Retirements[1].Jurisdiction)
+
+	// query retirements by invalid jurisdiction
+	_, err = s.k.RetirementsByJurisdiction(s.ctx, &types.QueryRetirementsByJurisdictionRequest{
+		Jurisdiction: "foo",
+	})
+	assert.ErrorContains(t, err, sdkerrors.ErrInvalidRequest.Error())
+}
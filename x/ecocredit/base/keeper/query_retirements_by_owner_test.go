@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_RetirementsByOwner(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert batch
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: testBatchDenom,
+	})
+	assert.NilError(t, err)
+
+	// insert two retirements with s.addr as the owner
+	assert.NilError(t, s.stateStore.RetirementTable().Insert(s.ctx, &api.Retirement{
+		Owner:        s.addr,
+		BatchKey:     bKey,
+		Amount:       "10",
+		Jurisdiction: "US-WA",
+	}))
+	assert.NilError(t, s.stateStore.RetirementTable().Insert(s.ctx, &api.Retirement{
+		Owner:        s.addr,
+		BatchKey:     bKey,
+		Amount:       "20",
+		Jurisdiction: "US-OR",
+	}))
+
+	// insert retirement with s.addr2 as the owner
+	assert.NilError(t, s.stateStore.RetirementTable().Insert(s.ctx, &api.Retirement{
+		Owner:        s.addr2,
+		BatchKey:     bKey,
+		Amount:       "30",
+		Jurisdiction: "US-WA",
+	}))
+
+	// query retirements by owner s.addr
+	res, err := s.k.RetirementsByOwner(s.ctx, &types.QueryRetirementsByOwnerRequest{
+		Owner:      s.addr.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Retirements))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, s.addr.String(), res.Retirements[0].Owner)
+	assert.Equal(t, testBatchDenom, res.Retirements[0].BatchDenom)
+	assert.Equal(t, "10", res.Retirements[0].Amount)
+	assert.Equal(t, "US-WA", res.Retirements[0].Jurisdiction)
+
+	// query retirements by owner s.addr2
+	res, err = s.k.RetirementsByOwner(s.ctx, &types.QueryRetirementsByOwnerRequest{
+		Owner: s.addr2.String(),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Retirements))
+	assert.Equal(t, "30", res.Retirements[0].Amount)
+
+	// query retirements by an invalid address
+	_, err = s.k.RetirementsByOwner(s.ctx, &types.QueryRetirementsByOwnerRequest{Owner: "foobar"})
+	assert.ErrorContains(t, err, sdkerrors.ErrInvalidAddress.Error())
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// Reversal queries a reversal record by id.
+func (k Keeper) Reversal(ctx context.Context, req *types.QueryReversalRequest) (*types.QueryReversalResponse, error) {
+	reversal, err := k.stateStore.ReversalTable().Get(ctx, req.Id)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get reversal with id %d: %s", req.Id, err.Error())
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, reversal.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := k.reversalInfo(ctx, reversal, class.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReversalResponse{Reversal: info}, nil
+}
+
+// reversalInfo converts a reversal into human-readable reversal information.
+func (k Keeper) reversalInfo(ctx context.Context, reversal *api.Reversal, classID string) (*types.ReversalInfo, error) {
+	credits := make([]*types.Credits, len(reversal.Credits))
+	for i, c := range reversal.Credits {
+		batch, err := k.stateStore.BatchTable().Get(ctx, c.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+
+		credits[i] = &types.Credits{
+			BatchDenom: batch.Denom,
+			Amount:     c.Amount,
+		}
+	}
+
+	return &types.ReversalInfo{
+		Id:        reversal.Id,
+		ClassId:   classID,
+		Name:      reversal.Name,
+		Reason:    reversal.Reason,
+		Signer:    sdk.AccAddress(reversal.Signer).String(),
+		Credits:   credits,
+		Timestamp: regentypes.ProtobufToGogoTimestamp(reversal.Timestamp),
+	}, nil
+}
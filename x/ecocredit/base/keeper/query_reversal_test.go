@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_Reversal(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert class and batch
+	cKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: testClassID})
+	assert.NilError(t, err)
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{Denom: testBatchDenom})
+	assert.NilError(t, err)
+
+	// insert reversal
+	id, err := s.stateStore.ReversalTable().InsertReturningID(s.ctx, &api.Reversal{
+		ClassKey: cKey,
+		Name:     "2023 Tahoe fire",
+		Reason:   "wildfire",
+		Signer:   s.addr,
+		Credits: []*api.Reversal_Credits{
+			{BatchKey: bKey, Amount: "10"},
+		},
+		Timestamp: timestamppb.Now(),
+	})
+	assert.NilError(t, err)
+
+	// query reversal by id
+	res, err := s.k.Reversal(s.ctx, &types.QueryReversalRequest{Id: id})
+	assert.NilError(t, err)
+	assert.Equal(t, id, res.Reversal.Id)
+	assert.Equal(t, testClassID, res.Reversal.ClassId)
+	assert.Equal(t, "2023 Tahoe fire", res.Reversal.Name)
+	assert.Equal(t, "wildfire", res.Reversal.Reason)
+	assert.Equal(t, s.addr.String(), res.Reversal.Signer)
+	assert.Equal(t, 1, len(res.Reversal.Credits))
+	assert.Equal(t, testBatchDenom, res.Reversal.Credits[0].BatchDenom)
+	assert.Equal(t, "10", res.Reversal.Credits[0].Amount)
+	assert.Assert(t, res.Reversal.Timestamp != nil)
+
+	// query reversal by unknown id
+	_, err = s.k.Reversal(s.ctx, &types.QueryReversalRequest{Id: 2})
+	assert.ErrorContains(t, err, "could not get reversal with id 2")
+}
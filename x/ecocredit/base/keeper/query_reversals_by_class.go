@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// ReversalsByClass queries all reversal records for a given credit class.
+func (k Keeper) ReversalsByClass(ctx context.Context, req *types.QueryReversalsByClassRequest) (*types.QueryReversalsByClassResponse, error) {
+	class, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", req.ClassId, err.Error())
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.ReversalTable().List(ctx, api.ReversalClassKeyIndexKey{}.WithClassKey(class.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	reversals := make([]*types.ReversalInfo, 0, 8)
+	for it.Next() {
+		reversal, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.reversalInfo(ctx, reversal, class.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		reversals = append(reversals, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReversalsByClassResponse{
+		Reversals:  reversals,
+		Pagination: pr,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_ReversalsByClass(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert classes and batch
+	cKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: "C01"})
+	assert.NilError(t, err)
+	cKey2, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{Id: "C02"})
+	assert.NilError(t, err)
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{Denom: testBatchDenom})
+	assert.NilError(t, err)
+
+	reversal := &api.Reversal{
+		ClassKey: cKey,
+		Name:     "2023 Tahoe fire",
+		Signer:   s.addr,
+		Credits: []*api.Reversal_Credits{
+			{BatchKey: bKey, Amount: "10"},
+		},
+		Timestamp: timestamppb.Now(),
+	}
+
+	// insert two reversals for the first class
+	assert.NilError(t, s.stateStore.ReversalTable().Insert(s.ctx, reversal))
+	reversal.Id = 0
+	reversal.Name = "2024 Tahoe fire"
+	assert.NilError(t, s.stateStore.ReversalTable().Insert(s.ctx, reversal))
+
+	// insert reversal for the second class
+	reversal.Id = 0
+	reversal.ClassKey = cKey2
+	assert.NilError(t, s.stateStore.ReversalTable().Insert(s.ctx, reversal))
+
+	// query reversals by the first class
+	res, err := s.k.ReversalsByClass(s.ctx, &types.QueryReversalsByClassRequest{
+		ClassId:    "C01",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Reversals))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, "C01", res.Reversals[0].ClassId)
+	assert.Equal(t, "2023 Tahoe fire", res.Reversals[0].Name)
+	assert.Equal(t, testBatchDenom, res.Reversals[0].Credits[0].BatchDenom)
+
+	// query reversals by unknown class
+	_, err = s.k.ReversalsByClass(s.ctx, &types.QueryReversalsByClassRequest{ClassId: "C03"})
+	assert.ErrorContains(t, err, "could not get class with id C03")
+}
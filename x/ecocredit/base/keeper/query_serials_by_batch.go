@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// SerialsByBatch queries all serial number ranges of a credit batch ordered by
+// serial number.
+func (k Keeper) SerialsByBatch(ctx context.Context, req *types.QuerySerialsByBatchRequest) (*types.QuerySerialsByBatchResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	it, err := k.stateStore.SerialRangeTable().List(
+		ctx,
+		api.SerialRangeBatchKeyStartIndexKey{}.WithBatchKey(batch.Key),
+		ormlist.Paginate(pg),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	serials := make([]*types.SerialRangeInfo, 0)
+	for it.Next() {
+		serialRange, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		serials = append(serials, serialRangeInfo(serialRange, batch.Denom))
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySerialsByBatchResponse{
+		Serials:    serials,
+		Pagination: pr,
+	}, nil
+}
+
+func serialRangeInfo(serialRange *api.SerialRange, batchDenom string) *types.SerialRangeInfo {
+	return &types.SerialRangeInfo{
+		BatchDenom: batchDenom,
+		Start:      serialRange.Start,
+		End:        serialRange.End,
+		Owner:      sdk.AccAddress(serialRange.Owner).String(),
+		Status:     types.SerialStatus(serialRange.Status),
+	}
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_SerialsByBatch(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert two batches
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: testBatchDenom,
+	})
+	assert.NilError(t, err)
+	otherKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: "C01-001-20200101-20210101-002",
+	})
+	assert.NilError(t, err)
+
+	// insert serial ranges of the batch and the other batch
+	serialRanges := []*api.SerialRange{
+		{BatchKey: bKey, Start: 1, End: 10, Owner: s.addr, Status: uint32(api.SerialStatus_SERIAL_STATUS_TRADABLE)},
+		{BatchKey: bKey, Start: 11, End: 15, Owner: s.addr2, Status: uint32(api.SerialStatus_SERIAL_STATUS_RETIRED)},
+		{BatchKey: otherKey, Start: 1, End: 5, Owner: s.addr, Status: uint32(api.SerialStatus_SERIAL_STATUS_TRADABLE)},
+	}
+	for _, serialRange := range serialRanges {
+		assert.NilError(t, s.stateStore.SerialRangeTable().Insert(s.ctx, serialRange))
+	}
+
+	// query serials by batch
+	res, err := s.k.SerialsByBatch(s.ctx, &types.QuerySerialsByBatchRequest{
+		BatchDenom: testBatchDenom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Serials))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, testBatchDenom, res.Serials[0].BatchDenom)
+	assert.Equal(t, uint64(1), res.Serials[0].Start)
+	assert.Equal(t, uint64(10), res.Serials[0].End)
+	assert.Equal(t, s.addr.String(), res.Serials[0].Owner)
+	assert.Equal(t, types.SerialStatus_SERIAL_STATUS_TRADABLE, res.Serials[0].Status)
+
+	// query serials by batch with an unknown denom
+	_, err = s.k.SerialsByBatch(s.ctx, &types.QuerySerialsByBatchRequest{
+		BatchDenom: "C01-001-20200101-20210101-003",
+	})
+	assert.ErrorContains(t, err, sdkerrors.ErrInvalidRequest.Error())
+}
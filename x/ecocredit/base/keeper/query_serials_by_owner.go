@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// SerialsByOwner queries all serial number ranges owned by an account.
+func (k Keeper) SerialsByOwner(ctx context.Context, req *types.QuerySerialsByOwnerRequest) (*types.QuerySerialsByOwnerResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.SerialRangeTable().List(
+		ctx,
+		api.SerialRangeOwnerBatchKeyStatusIndexKey{}.WithOwner(owner),
+		ormlist.Paginate(pg),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	serials := make([]*types.SerialRangeInfo, 0)
+	for it.Next() {
+		serialRange, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		batch, err := k.stateStore.BatchTable().Get(ctx, serialRange.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+
+		serials = append(serials, serialRangeInfo(serialRange, batch.Denom))
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySerialsByOwnerResponse{
+		Serials:    serials,
+		Pagination: pr,
+	}, nil
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_SerialsByOwner(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert two batches
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: testBatchDenom,
+	})
+	assert.NilError(t, err)
+	otherKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: "C01-001-20200101-20210101-002",
+	})
+	assert.NilError(t, err)
+
+	// insert serial ranges owned by the account and another account
+	serialRanges := []*api.SerialRange{
+		{BatchKey: bKey, Start: 1, End: 10, Owner: s.addr, Status: uint32(api.SerialStatus_SERIAL_STATUS_TRADABLE)},
+		{BatchKey: bKey, Start: 11, End: 15, Owner: s.addr2, Status: uint32(api.SerialStatus_SERIAL_STATUS_RETIRED)},
+		{BatchKey: otherKey, Start: 1, End: 5, Owner: s.addr, Status: uint32(api.SerialStatus_SERIAL_STATUS_CANCELLED)},
+	}
+	for _, serialRange := range serialRanges {
+		assert.NilError(t, s.stateStore.SerialRangeTable().Insert(s.ctx, serialRange))
+	}
+
+	// query serials by owner
+	res, err := s.k.SerialsByOwner(s.ctx, &types.QuerySerialsByOwnerRequest{
+		Owner:      s.addr.String(),
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Serials))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, testBatchDenom, res.Serials[0].BatchDenom)
+	assert.Equal(t, types.SerialStatus_SERIAL_STATUS_TRADABLE, res.Serials[0].Status)
+	assert.Equal(t, "C01-001-20200101-20210101-002", res.Serials[1].BatchDenom)
+	assert.Equal(t, types.SerialStatus_SERIAL_STATUS_CANCELLED, res.Serials[1].Status)
+
+	// query serials by owner with an invalid address
+	_, err = s.k.SerialsByOwner(s.ctx, &types.QuerySerialsByOwnerRequest{Owner: "foo"})
+	assert.ErrorContains(t, err, sdkerrors.ErrInvalidAddress.Error())
+}
@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	"context"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// StrictJurisdictions queries the strict jurisdictions setting.
+func (k Keeper) StrictJurisdictions(ctx context.Context, _ *types.QueryStrictJurisdictionsRequest) (*types.QueryStrictJurisdictionsResponse, error) {
+	result, err := k.stateStore.StrictJurisdictionsTable().Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStrictJurisdictionsResponse{
+		Enabled: result.Enabled,
+	}, nil
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_StrictJurisdictions(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	res, err := s.k.StrictJurisdictions(s.ctx, &types.QueryStrictJurisdictionsRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, res.Enabled, false)
+
+	err = s.stateStore.StrictJurisdictionsTable().Save(s.ctx, &api.StrictJurisdictions{
+		Enabled: true,
+	})
+	assert.NilError(t, err)
+
+	res, err = s.k.StrictJurisdictions(s.ctx, &types.QueryStrictJurisdictionsRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, res.Enabled, true)
+}
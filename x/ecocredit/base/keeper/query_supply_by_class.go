@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// SupplyByClass queries the aggregated supply (tradable, retired, cancelled) of
+// all credit batches issued within a given credit class.
+func (k Keeper) SupplyByClass(ctx context.Context, request *types.QuerySupplyByClassRequest) (*types.QuerySupplyByClassResponse, error) {
+	class, err := k.stateStore.ClassTable().GetById(ctx, request.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get class with id %s: %s", request.ClassId, err.Error())
+	}
+
+	supply, err := k.stateStore.ClassSupplyTable().Get(ctx, class.Key)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			// no credits have been issued within the credit class
+			return &types.QuerySupplyByClassResponse{
+				TradableAmount:  "0",
+				RetiredAmount:   "0",
+				CancelledAmount: "0",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return &types.QuerySupplyByClassResponse{
+		TradableAmount:  supply.TradableAmount,
+		RetiredAmount:   supply.RetiredAmount,
+		CancelledAmount: supply.CancelledAmount,
+	}, nil
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_SupplyByClass(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	tradable := "10.54321"
+	retired := "50.3214"
+	cancelled := "0.3215"
+
+	// make a credit class and some supply
+	assert.NilError(t, s.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               testClassID,
+		CreditTypeAbbrev: "C",
+	}))
+	assert.NilError(t, s.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               "C02",
+		CreditTypeAbbrev: "C",
+	}))
+	assert.NilError(t, s.stateStore.ClassSupplyTable().Insert(s.ctx, &api.ClassSupply{
+		ClassKey:        1,
+		TradableAmount:  tradable,
+		RetiredAmount:   retired,
+		CancelledAmount: cancelled,
+	}))
+
+	// valid query
+	res, err := s.k.SupplyByClass(s.ctx, &types.QuerySupplyByClassRequest{ClassId: testClassID})
+	assert.NilError(t, err)
+	assert.Equal(t, tradable, res.TradableAmount)
+	assert.Equal(t, retired, res.RetiredAmount)
+	assert.Equal(t, cancelled, res.CancelledAmount)
+
+	// valid query without supply
+	res, err = s.k.SupplyByClass(s.ctx, &types.QuerySupplyByClassRequest{ClassId: "C02"})
+	assert.NilError(t, err)
+	assert.Equal(t, "0", res.TradableAmount)
+	assert.Equal(t, "0", res.RetiredAmount)
+	assert.Equal(t, "0", res.CancelledAmount)
+
+	// bad class query
+	_, err = s.k.SupplyByClass(s.ctx, &types.QuerySupplyByClassRequest{ClassId: "C03"})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// SupplyByCreditType queries the aggregated supply (tradable, retired,
+// cancelled) of all credit batches issued within credit classes of a given
+// credit type.
+func (k Keeper) SupplyByCreditType(ctx context.Context, request *types.QuerySupplyByCreditTypeRequest) (*types.QuerySupplyByCreditTypeResponse, error) {
+	creditType, err := k.stateStore.CreditTypeTable().Get(ctx, request.CreditTypeAbbrev)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get credit type with abbreviation %s: %s", request.CreditTypeAbbrev, err.Error())
+	}
+
+	supply, err := k.stateStore.CreditTypeSupplyTable().Get(ctx, creditType.Abbreviation)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			// no credits have been issued within credit classes of the credit type
+			return &types.QuerySupplyByCreditTypeResponse{
+				TradableAmount:  "0",
+				RetiredAmount:   "0",
+				CancelledAmount: "0",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return &types.QuerySupplyByCreditTypeResponse{
+		TradableAmount:  supply.TradableAmount,
+		RetiredAmount:   supply.RetiredAmount,
+		CancelledAmount: supply.CancelledAmount,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_SupplyByCreditType(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	tradable := "10.54321"
+	retired := "50.3214"
+	cancelled := "0.3215"
+
+	// make a credit type and some supply
+	assert.NilError(t, s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: "C",
+		Name:         "carbon",
+		Unit:         "metric ton CO2 equivalent",
+		Precision:    6,
+	}))
+	assert.NilError(t, s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: "BIO",
+		Name:         "biodiversity",
+		Unit:         "acres",
+		Precision:    6,
+	}))
+	assert.NilError(t, s.stateStore.CreditTypeSupplyTable().Insert(s.ctx, &api.CreditTypeSupply{
+		CreditTypeAbbrev: "C",
+		TradableAmount:   tradable,
+		RetiredAmount:    retired,
+		CancelledAmount:  cancelled,
+	}))
+
+	// valid query
+	res, err := s.k.SupplyByCreditType(s.ctx, &types.QuerySupplyByCreditTypeRequest{CreditTypeAbbrev: "C"})
+	assert.NilError(t, err)
+	assert.Equal(t, tradable, res.TradableAmount)
+	assert.Equal(t, retired, res.RetiredAmount)
+	assert.Equal(t, cancelled, res.CancelledAmount)
+
+	// valid query without supply
+	res, err = s.k.SupplyByCreditType(s.ctx, &types.QuerySupplyByCreditTypeRequest{CreditTypeAbbrev: "BIO"})
+	assert.NilError(t, err)
+	assert.Equal(t, "0", res.TradableAmount)
+	assert.Equal(t, "0", res.RetiredAmount)
+	assert.Equal(t, "0", res.CancelledAmount)
+
+	// bad credit type query
+	_, err = s.k.SupplyByCreditType(s.ctx, &types.QuerySupplyByCreditTypeRequest{CreditTypeAbbrev: "F"})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+// SupplyByProject queries the aggregated supply (tradable, retired, cancelled)
+// of all credit batches issued within a given project.
+func (k Keeper) SupplyByProject(ctx context.Context, request *types.QuerySupplyByProjectRequest) (*types.QuerySupplyByProjectResponse, error) {
+	project, err := k.getProjectByIDOrAlias(ctx, request.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", request.ProjectId, err.Error())
+	}
+
+	supply, err := k.stateStore.ProjectSupplyTable().Get(ctx, project.Key)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			// no credits have been issued within the project
+			return &types.QuerySupplyByProjectResponse{
+				TradableAmount:  "0",
+				RetiredAmount:   "0",
+				CancelledAmount: "0",
+			}, nil
+		}
+		return nil, err
+	}
+
+	return &types.QuerySupplyByProjectResponse{
+		TradableAmount:  supply.TradableAmount,
+		RetiredAmount:   supply.RetiredAmount,
+		CancelledAmount: supply.CancelledAmount,
+	}, nil
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+func TestQuery_SupplyByProject(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	tradable := "10.54321"
+	retired := "50.3214"
+	cancelled := "0.3215"
+
+	// make a project and some supply
+	assert.NilError(t, s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       testProjectID,
+		ClassKey: 1,
+	}))
+	assert.NilError(t, s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       "C01-002",
+		ClassKey: 1,
+	}))
+	assert.NilError(t, s.stateStore.ProjectSupplyTable().Insert(s.ctx, &api.ProjectSupply{
+		ProjectKey:      1,
+		TradableAmount:  tradable,
+		RetiredAmount:   retired,
+		CancelledAmount: cancelled,
+	}))
+
+	// valid query
+	res, err := s.k.SupplyByProject(s.ctx, &types.QuerySupplyByProjectRequest{ProjectId: testProjectID})
+	assert.NilError(t, err)
+	assert.Equal(t, tradable, res.TradableAmount)
+	assert.Equal(t, retired, res.RetiredAmount)
+	assert.Equal(t, cancelled, res.CancelledAmount)
+
+	// valid query without supply
+	res, err = s.k.SupplyByProject(s.ctx, &types.QuerySupplyByProjectRequest{ProjectId: "C01-002"})
+	assert.NilError(t, err)
+	assert.Equal(t, "0", res.TradableAmount)
+	assert.Equal(t, "0", res.RetiredAmount)
+	assert.Equal(t, "0", res.CancelledAmount)
+
+	// valid query with alias of migrated project
+	assert.NilError(t, s.stateStore.ProjectAliasTable().Insert(s.ctx, &api.ProjectAlias{
+		Alias:      "C02-001",
+		ProjectKey: 1,
+	}))
+	res, err = s.k.SupplyByProject(s.ctx, &types.QuerySupplyByProjectRequest{ProjectId: "C02-001"})
+	assert.NilError(t, err)
+	assert.Equal(t, tradable, res.TradableAmount)
+
+	// bad project query
+	_, err = s.k.SupplyByProject(s.ctx, &types.QuerySupplyByProjectRequest{ProjectId: "C01-003"})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+)
+
+// AddRetirementSummary adds the amount of the retirement to the retirement
+// summary of the owner for the calendar year of the retirement timestamp, the
+// credit type of the retired credits, and the retirement jurisdiction.
+func AddRetirementSummary(ctx context.Context, ss api.StateStore, retirement *api.Retirement) error {
+	batch, err := ss.BatchTable().Get(ctx, retirement.BatchKey)
+	if err != nil {
+		return err
+	}
+
+	project, err := ss.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return err
+	}
+
+	class, err := ss.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return err
+	}
+
+	year := uint32(retirement.Timestamp.AsTime().UTC().Year())
+
+	summary, err := ss.RetirementSummaryTable().Get(
+		ctx, retirement.Owner, year, class.CreditTypeAbbrev, retirement.Jurisdiction,
+	)
+	if err != nil {
+		if !ormerrors.IsNotFound(err) {
+			return err
+		}
+		summary = &api.RetirementSummary{
+			Owner:            retirement.Owner,
+			Year:             year,
+			CreditTypeAbbrev: class.CreditTypeAbbrev,
+			Jurisdiction:     retirement.Jurisdiction,
+			Amount:           "0",
+		}
+	}
+
+	total, err := math.NewNonNegativeDecFromString(summary.Amount)
+	if err != nil {
+		return err
+	}
+
+	amount, err := math.NewNonNegativeDecFromString(retirement.Amount)
+	if err != nil {
+		return err
+	}
+
+	total, err = math.SafeAddBalance(total, amount)
+	if err != nil {
+		return err
+	}
+
+	summary.Amount = total.String()
+
+	return ss.RetirementSummaryTable().Save(ctx, summary)
+}
@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// IsSerializedBatch returns whether or not the credits of the credit batch are
+// identified by serial number ranges.
+func IsSerializedBatch(ctx context.Context, ss api.StateStore, batchKey uint64) (bool, error) {
+	batch, err := ss.BatchTable().Get(ctx, batchKey)
+	if err != nil {
+		return false, err
+	}
+
+	project, err := ss.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return false, err
+	}
+
+	return ss.ClassSerializationTable().Has(ctx, project.ClassKey)
+}
+
+// IssueSerials allocates the next contiguous range of `amount` serial numbers
+// of the credit batch to the owner with the given status. IssueSerials is a
+// no-op if the credit batch is not serialized.
+func IssueSerials(ctx context.Context, ss api.StateStore, batchKey uint64, owner sdk.AccAddress, amount math.Dec, status api.SerialStatus) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	serialized, err := IsSerializedBatch(ctx, ss, batchKey)
+	if err != nil || !serialized {
+		return err
+	}
+
+	count, err := serialCount(amount)
+	if err != nil {
+		return err
+	}
+
+	var next uint64 = 1
+	seq, err := ss.BatchSerialSequenceTable().Get(ctx, batchKey)
+	if err != nil {
+		if !ormerrors.IsNotFound(err) {
+			return err
+		}
+	} else {
+		next = seq.NextSerial
+	}
+
+	if err := ss.SerialRangeTable().Insert(ctx, &api.SerialRange{
+		BatchKey: batchKey,
+		Start:    next,
+		End:      next + count - 1,
+		Owner:    owner,
+		Status:   uint32(status),
+	}); err != nil {
+		return err
+	}
+
+	return ss.BatchSerialSequenceTable().Save(ctx, &api.BatchSerialSequence{
+		BatchKey:   batchKey,
+		NextSerial: next + count,
+	})
+}
+
+// TransferSerials moves `amount` tradable serial numbers of the credit batch
+// from one owner to another and sets the status of the serial numbers. The
+// ranges of the sender are taken in ascending order and the last range is
+// split if only part of it is moved. TransferSerials is a no-op if the credit
+// batch is not serialized.
+func TransferSerials(ctx context.Context, ss api.StateStore, batchKey uint64, from, to sdk.AccAddress, amount math.Dec, status api.SerialStatus) error {
+	return moveSerials(ctx, ss, batchKey, from, to, amount, api.SerialStatus_SERIAL_STATUS_TRADABLE, status)
+}
+
+// RetireSerials sets the status of `amount` tradable serial numbers of the
+// credit batch held by the owner to retired.
+func RetireSerials(ctx context.Context, ss api.StateStore, batchKey uint64, owner sdk.AccAddress, amount math.Dec) error {
+	return TransferSerials(ctx, ss, batchKey, owner, owner, amount, api.SerialStatus_SERIAL_STATUS_RETIRED)
+}
+
+// CancelSerials sets the status of `amount` tradable serial numbers of the
+// credit batch held by the owner to cancelled.
+func CancelSerials(ctx context.Context, ss api.StateStore, batchKey uint64, owner sdk.AccAddress, amount math.Dec) error {
+	return TransferSerials(ctx, ss, batchKey, owner, owner, amount, api.SerialStatus_SERIAL_STATUS_CANCELLED)
+}
+
+// RestoreSerials sets the status of `amount` cancelled serial numbers of the
+// credit batch held by the owner back to tradable.
+func RestoreSerials(ctx context.Context, ss api.StateStore, batchKey uint64, owner sdk.AccAddress, amount math.Dec) error {
+	return moveSerials(
+		ctx, ss, batchKey, owner, owner, amount,
+		api.SerialStatus_SERIAL_STATUS_CANCELLED, api.SerialStatus_SERIAL_STATUS_TRADABLE,
+	)
+}
+
+// moveSerials moves `amount` serial numbers of the credit batch with status
+// `fromStatus` from one owner to another and sets the status of the serial
+// numbers to `toStatus`.
+func moveSerials(ctx context.Context, ss api.StateStore, batchKey uint64, from, to sdk.AccAddress, amount math.Dec, fromStatus, toStatus api.SerialStatus) error {
+	if amount.IsZero() {
+		return nil
+	}
+
+	serialized, err := IsSerializedBatch(ctx, ss, batchKey)
+	if err != nil || !serialized {
+		return err
+	}
+
+	count, err := serialCount(amount)
+	if err != nil {
+		return err
+	}
+
+	// collect the ranges before updating them to avoid modifying the index
+	// while iterating over it
+	it, err := ss.SerialRangeTable().List(ctx, api.SerialRangeOwnerBatchKeyStatusIndexKey{}.WithOwnerBatchKeyStatus(
+		from, batchKey, uint32(fromStatus),
+	))
+	if err != nil {
+		return err
+	}
+
+	ranges := make([]*api.SerialRange, 0)
+	var found uint64
+	for found < count && it.Next() {
+		serialRange, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		ranges = append(ranges, serialRange)
+		found += serialRange.End - serialRange.Start + 1
+	}
+	it.Close()
+
+	if found < count {
+		return ecocredit.ErrInsufficientCredits.Wrapf(
+			"%s serial numbers: %d, transfer amount %d", serialStatusName(fromStatus), found, count,
+		)
+	}
+
+	remaining := count
+	for _, serialRange := range ranges {
+		size := serialRange.End - serialRange.Start + 1
+		if size > remaining {
+			// the rest of the range remains with the sender
+			if err := ss.SerialRangeTable().Insert(ctx, &api.SerialRange{
+				BatchKey: batchKey,
+				Start:    serialRange.Start + remaining,
+				End:      serialRange.End,
+				Owner:    serialRange.Owner,
+				Status:   serialRange.Status,
+			}); err != nil {
+				return err
+			}
+			serialRange.End = serialRange.Start + remaining - 1
+			size = remaining
+		}
+
+		serialRange.Owner = to
+		serialRange.Status = uint32(toStatus)
+		if err := ss.SerialRangeTable().Update(ctx, serialRange); err != nil {
+			return err
+		}
+
+		remaining -= size
+	}
+
+	return nil
+}
+
+// serialStatusName returns the human-readable name of a serial status.
+func serialStatusName(status api.SerialStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "SERIAL_STATUS_"))
+}
+
+// serialCount returns the number of serial numbers corresponding to an amount
+// of credits. Only whole credits can be serialized.
+func serialCount(amount math.Dec) (uint64, error) {
+	count, err := amount.Int64()
+	if err != nil || count < 0 {
+		return 0, sdkerrors.ErrInvalidRequest.Wrapf(
+			"serialized credits must be whole numbers: %s", amount,
+		)
+	}
+	return uint64(count), nil
+}
@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+)
+
+const (
+	tradable  = api.SerialStatus_SERIAL_STATUS_TRADABLE
+	retired   = api.SerialStatus_SERIAL_STATUS_RETIRED
+	cancelled = api.SerialStatus_SERIAL_STATUS_CANCELLED
+)
+
+func TestSerials(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	alice, bob := s.addr, s.addr2
+
+	batchKey := insertSerialBatch(t, s, true)
+
+	// issue credits
+	require.NoError(t, IssueSerials(s.ctx, s.stateStore, batchKey, alice, dec(t, "10"), tradable))
+	require.NoError(t, IssueSerials(s.ctx, s.stateStore, batchKey, bob, dec(t, "5"), retired))
+	assertSerialRanges(t, s, batchKey, []expSerialRange{
+		{1, 10, alice, tradable},
+		{11, 15, bob, retired},
+	})
+
+	// issuing zero credits does not allocate serial numbers
+	require.NoError(t, IssueSerials(s.ctx, s.stateStore, batchKey, alice, dec(t, "0"), tradable))
+
+	// send part of a range
+	require.NoError(t, TransferSerials(s.ctx, s.stateStore, batchKey, alice, bob, dec(t, "4"), tradable))
+	assertSerialRanges(t, s, batchKey, []expSerialRange{
+		{1, 4, bob, tradable},
+		{5, 10, alice, tradable},
+		{11, 15, bob, retired},
+	})
+
+	// retire a whole range
+	require.NoError(t, RetireSerials(s.ctx, s.stateStore, batchKey, alice, dec(t, "6")))
+
+	// cancel part of a range
+	require.NoError(t, CancelSerials(s.ctx, s.stateStore, batchKey, bob, dec(t, "2")))
+	assertSerialRanges(t, s, batchKey, []expSerialRange{
+		{1, 2, bob, cancelled},
+		{3, 4, bob, tradable},
+		{5, 10, alice, retired},
+		{11, 15, bob, retired},
+	})
+
+	// restore part of the cancelled range
+	require.NoError(t, RestoreSerials(s.ctx, s.stateStore, batchKey, bob, dec(t, "1")))
+	assertSerialRanges(t, s, batchKey, []expSerialRange{
+		{1, 1, bob, tradable},
+		{2, 2, bob, cancelled},
+		{3, 4, bob, tradable},
+		{5, 10, alice, retired},
+		{11, 15, bob, retired},
+	})
+
+	// only cancelled credits can be restored
+	err := RestoreSerials(s.ctx, s.stateStore, batchKey, bob, dec(t, "2"))
+	require.EqualError(t, err, "cancelled serial numbers: 1, transfer amount 2: insufficient credit balance")
+
+	// new credits continue the sequence
+	require.NoError(t, IssueSerials(s.ctx, s.stateStore, batchKey, alice, dec(t, "3"), tradable))
+	seq, err := s.stateStore.BatchSerialSequenceTable().Get(s.ctx, batchKey)
+	require.NoError(t, err)
+	require.Equal(t, uint64(19), seq.NextSerial)
+
+	// retired credits cannot be transferred
+	err = TransferSerials(s.ctx, s.stateStore, batchKey, bob, alice, dec(t, "4"), tradable)
+	require.EqualError(t, err, "tradable serial numbers: 3, transfer amount 4: insufficient credit balance")
+
+	// only whole credits can be serialized
+	err = TransferSerials(s.ctx, s.stateStore, batchKey, bob, alice, dec(t, "1.5"), tradable)
+	require.EqualError(t, err, "serialized credits must be whole numbers: 1.5: invalid request")
+}
+
+func TestSerials_NotSerialized(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	batchKey := insertSerialBatch(t, s, false)
+
+	require.NoError(t, IssueSerials(s.ctx, s.stateStore, batchKey, s.addr, dec(t, "10.5"), tradable))
+	require.NoError(t, TransferSerials(s.ctx, s.stateStore, batchKey, s.addr, s.addr2, dec(t, "4"), tradable))
+	assertSerialRanges(t, s, batchKey, []expSerialRange{})
+}
+
+func TestSerials_SendAndCancel(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	alice, bob := s.addr, s.addr2
+
+	batchKey := insertSerialBatch(t, s, true)
+
+	require.NoError(t, s.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:        batchKey,
+		TradableAmount:  "10",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	}))
+	require.NoError(t, s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       batchKey,
+		Address:        alice,
+		TradableAmount: "10",
+		RetiredAmount:  "0",
+	}))
+	require.NoError(t, AddAggregateSupply(s.ctx, s.stateStore, batchKey, dec(t, "10"), dec(t, "0"), dec(t, "0")))
+	require.NoError(t, IssueSerials(s.ctx, s.stateStore, batchKey, alice, dec(t, "10"), tradable))
+
+	_, err := s.k.Send(s.ctx, &types.MsgSend{
+		Sender:    alice.String(),
+		Recipient: bob.String(),
+		Credits: []*types.MsgSend_SendCredits{
+			{BatchDenom: testBatchDenom, TradableAmount: "3"},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = s.k.Cancel(s.ctx, &types.MsgCancel{
+		Owner: alice.String(),
+		Credits: []*types.Credits{
+			{BatchDenom: testBatchDenom, Amount: "2"},
+		},
+		Reason: "transferred to another registry",
+	})
+	require.NoError(t, err)
+
+	assertSerialRanges(t, s, batchKey, []expSerialRange{
+		{1, 3, bob, tradable},
+		{4, 5, alice, cancelled},
+		{6, 10, alice, tradable},
+	})
+}
+
+// insertSerialBatch inserts a credit batch within a credit class with an
+// integer-precision credit type and optionally enables serialization.
+func insertSerialBatch(t *testing.T, s *baseSuite, serialized bool) uint64 {
+	require.NoError(t, s.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: "C",
+		Name:         "carbon",
+		Unit:         "tonne",
+		Precision:    0,
+	}))
+
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               testClassID,
+		Admin:            s.addr,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(t, err)
+
+	if serialized {
+		require.NoError(t, s.stateStore.ClassSerializationTable().Insert(s.ctx, &api.ClassSerialization{
+			ClassKey: classKey,
+		}))
+	}
+
+	projectKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       testProjectID,
+		ClassKey: classKey,
+	})
+	require.NoError(t, err)
+
+	batchKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: projectKey,
+		Denom:      testBatchDenom,
+	})
+	require.NoError(t, err)
+
+	return batchKey
+}
+
+type expSerialRange struct {
+	start, end uint64
+	owner      sdk.AccAddress
+	status     api.SerialStatus
+}
+
+func assertSerialRanges(t *testing.T, s *baseSuite, batchKey uint64, expected []expSerialRange) {
+	it, err := s.stateStore.SerialRangeTable().List(s.ctx, api.SerialRangeBatchKeyStartIndexKey{}.WithBatchKey(batchKey))
+	require.NoError(t, err)
+	defer it.Close()
+
+	actual := make([]*api.SerialRange, 0)
+	for it.Next() {
+		serialRange, err := it.Value()
+		require.NoError(t, err)
+		actual = append(actual, serialRange)
+	}
+
+	require.Len(t, actual, len(expected))
+	for i, serialRange := range actual {
+		require.Equal(t, expected[i].start, serialRange.Start)
+		require.Equal(t, expected[i].end, serialRange.End)
+		require.Equal(t, expected[i].owner, sdk.AccAddress(serialRange.Owner))
+		require.Equal(t, uint32(expected[i].status), serialRange.Status)
+	}
+}
@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// UnlockCredits is a BeginBlock function that moves credits that have unlocked
+// according to their lock schedule from the locked balance into the tradable
+// balance of the owner and deletes locks that have been fully unlocked. Only
+// locks with an unlock time before or equal to the block time are visited.
+func (k Keeper) UnlockCredits(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	minTime := time.Unix(0, 1)
+
+	// no credits can have unlocked if the block time is not after the min
+	// (e.g. a block header without a time when testing)
+	if !blockTime.After(minTime) {
+		return nil
+	}
+
+	fromKey := api.CreditLockUnlockTimeIndexKey{}.WithUnlockTime(timestamppb.New(minTime))
+	toKey := api.CreditLockUnlockTimeIndexKey{}.WithUnlockTime(timestamppb.New(blockTime))
+
+	// locks are collected before they are updated to avoid writing to the
+	// table while iterating over it
+	it, err := k.stateStore.CreditLockTable().ListRange(ctx, fromKey, toKey)
+	if err != nil {
+		return err
+	}
+
+	var locks []*api.CreditLock
+	for it.Next() {
+		lock, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+
+		locks = append(locks, lock)
+	}
+	it.Close()
+
+	for _, lock := range locks {
+		if err := k.unlockCredits(sdkCtx, lock, blockTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unlockCredits moves the credits of the lock that have unlocked since the
+// last time the lock was processed into the tradable balance of the owner.
+func (k Keeper) unlockCredits(sdkCtx sdk.Context, lock *api.CreditLock, blockTime time.Time) error {
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	batch, err := k.stateStore.BatchTable().Get(ctx, lock.BatchKey)
+	if err != nil {
+		return err
+	}
+
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+	if err != nil {
+		return err
+	}
+
+	amount, err := math.NewDecFromString(lock.Amount)
+	if err != nil {
+		return err
+	}
+
+	unlocked, err := math.NewDecFromString(lock.UnlockedAmount)
+	if err != nil {
+		return err
+	}
+
+	vested, err := vestedAmount(lock, amount, blockTime, creditType.Precision)
+	if err != nil {
+		return err
+	}
+
+	toUnlock, err := math.SafeSubBalance(vested, unlocked)
+	if err != nil {
+		return err
+	}
+
+	if toUnlock.IsZero() {
+		return nil
+	}
+
+	balance, err := k.stateStore.BatchBalanceTable().Get(ctx, lock.Owner, lock.BatchKey)
+	if err != nil {
+		return err
+	}
+
+	locked, err := utils.GetLockedAmount(balance)
+	if err != nil {
+		return err
+	}
+
+	tradable, err := math.NewDecFromString(balance.TradableAmount)
+	if err != nil {
+		return err
+	}
+
+	locked, err = math.SafeSubBalance(locked, toUnlock)
+	if err != nil {
+		return err
+	}
+
+	tradable, err = math.SafeAddBalance(tradable, toUnlock)
+	if err != nil {
+		return err
+	}
+
+	balance.LockedAmount = locked.String()
+	balance.TradableAmount = tradable.String()
+	if err = k.stateStore.BatchBalanceTable().Update(ctx, balance); err != nil {
+		return err
+	}
+
+	if vested.Equal(amount) {
+		err = k.stateStore.CreditLockTable().Delete(ctx, lock)
+	} else {
+		lock.UnlockedAmount = vested.String()
+		err = k.stateStore.CreditLockTable().Update(ctx, lock)
+	}
+	if err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&types.EventUnlock{
+		LockId:     lock.Id,
+		Owner:      sdk.AccAddress(lock.Owner).String(),
+		BatchDenom: batch.Denom,
+		Amount:     toUnlock.String(),
+	})
+}
+
+// vestedAmount returns the total amount of credits of the lock that have
+// unlocked at the given time. Linear locks unlock per second and the amount is
+// truncated to the precision of the credit type.
+func vestedAmount(lock *api.CreditLock, amount math.Dec, blockTime time.Time, precision uint32) (math.Dec, error) {
+	startTime, endTime := lock.StartTime.AsTime(), lock.EndTime.AsTime()
+
+	if !blockTime.Before(endTime) {
+		return amount, nil
+	}
+
+	if lock.LockType != api.LockType_LOCK_TYPE_LINEAR || !blockTime.After(startTime) {
+		return math.NewDecFromInt64(0), nil
+	}
+
+	elapsed := math.NewDecFromInt64(int64(blockTime.Sub(startTime) / time.Second))
+	duration := math.NewDecFromInt64(int64(endTime.Sub(startTime) / time.Second))
+	if duration.IsZero() {
+		return amount, nil
+	}
+
+	// amount * elapsed / duration is calculated in the smallest unit of the
+	// credit type and truncated to avoid unlocking more credits than vested
+	units, err := amount.MulExact(math.NewDecFinite(1, int32(precision)))
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	units, err = units.MulExact(elapsed)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	units, err = units.QuoInteger(duration)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	vested, err := units.MulExact(math.NewDecFinite(1, -int32(precision)))
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	vested, _ = vested.Reduce()
+
+	return vested, nil
+}
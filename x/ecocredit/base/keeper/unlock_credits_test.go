@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+)
+
+func TestUnlockCredits(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, batchKey := allowanceBatchSetup(t, s)
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(100 * time.Second)
+
+	require.NoError(t, s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       batchKey,
+		Address:        s.addr,
+		TradableAmount: "0",
+		LockedAmount:   "30",
+	}))
+
+	cliffID, err := s.stateStore.CreditLockTable().InsertReturningID(s.ctx, &api.CreditLock{
+		Owner:          s.addr,
+		BatchKey:       batchKey,
+		LockType:       api.LockType_LOCK_TYPE_CLIFF,
+		Amount:         "10",
+		UnlockedAmount: "0",
+		StartTime:      timestamppb.New(start),
+		EndTime:        timestamppb.New(end),
+		UnlockTime:     timestamppb.New(end),
+	})
+	require.NoError(t, err)
+
+	linearID, err := s.stateStore.CreditLockTable().InsertReturningID(s.ctx, &api.CreditLock{
+		Owner:          s.addr,
+		BatchKey:       batchKey,
+		LockType:       api.LockType_LOCK_TYPE_LINEAR,
+		Amount:         "20",
+		UnlockedAmount: "0",
+		StartTime:      timestamppb.New(start),
+		EndTime:        timestamppb.New(end),
+		UnlockTime:     timestamppb.New(start),
+	})
+	require.NoError(t, err)
+
+	// nothing is unlocked when the block header does not include a time
+	s.setBlockTime(time.Time{})
+	require.NoError(t, s.k.UnlockCredits(s.ctx))
+	assertLockedBalance(t, s, batchKey, "0", "30")
+
+	// nothing is unlocked before the lock schedules start
+	s.setBlockTime(start)
+	require.NoError(t, s.k.UnlockCredits(s.ctx))
+	assertLockedBalance(t, s, batchKey, "0", "30")
+
+	// a quarter of the linear lock is unlocked and the cliff lock is unchanged
+	s.setBlockTime(start.Add(25 * time.Second))
+	require.NoError(t, s.k.UnlockCredits(s.ctx))
+	assertLockedBalance(t, s, batchKey, "5", "25")
+
+	lock, err := s.stateStore.CreditLockTable().Get(s.ctx, linearID)
+	require.NoError(t, err)
+	require.Equal(t, "5", lock.UnlockedAmount)
+
+	lock, err = s.stateStore.CreditLockTable().Get(s.ctx, cliffID)
+	require.NoError(t, err)
+	require.Equal(t, "0", lock.UnlockedAmount)
+
+	// only the newly vested amount is unlocked
+	s.setBlockTime(start.Add(50 * time.Second))
+	require.NoError(t, s.k.UnlockCredits(s.ctx))
+	assertLockedBalance(t, s, batchKey, "10", "20")
+
+	// all credits are unlocked and the locks are deleted at the end time
+	s.setBlockTime(end)
+	require.NoError(t, s.k.UnlockCredits(s.ctx))
+	assertLockedBalance(t, s, batchKey, "30", "0")
+
+	_, err = s.stateStore.CreditLockTable().Get(s.ctx, cliffID)
+	require.ErrorIs(t, err, ormerrors.NotFound)
+
+	_, err = s.stateStore.CreditLockTable().Get(s.ctx, linearID)
+	require.ErrorIs(t, err, ormerrors.NotFound)
+}
+
+func TestUnlockCredits_Precision(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+	_, batchKey := allowanceBatchSetup(t, s)
+
+	// update the credit type precision so that partial credits can be unlocked
+	creditType, err := s.stateStore.CreditTypeTable().Get(s.ctx, "C")
+	require.NoError(t, err)
+	creditType.Precision = 2
+	require.NoError(t, s.stateStore.CreditTypeTable().Update(s.ctx, creditType))
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	require.NoError(t, s.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       batchKey,
+		Address:        s.addr,
+		TradableAmount: "0",
+		LockedAmount:   "1",
+	}))
+
+	require.NoError(t, s.stateStore.CreditLockTable().Insert(s.ctx, &api.CreditLock{
+		Owner:          s.addr,
+		BatchKey:       batchKey,
+		LockType:       api.LockType_LOCK_TYPE_LINEAR,
+		Amount:         "1",
+		UnlockedAmount: "0",
+		StartTime:      timestamppb.New(start),
+		EndTime:        timestamppb.New(start.Add(3 * time.Second)),
+		UnlockTime:     timestamppb.New(start),
+	}))
+
+	// the vested amount is truncated to the precision of the credit type
+	s.setBlockTime(start.Add(time.Second))
+	require.NoError(t, s.k.UnlockCredits(s.ctx))
+	assertLockedBalance(t, s, batchKey, "0.33", "0.67")
+}
+
+func (s *baseSuite) setBlockTime(blockTime time.Time) {
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func assertLockedBalance(t *testing.T, s *baseSuite, batchKey uint64, tradable, locked string) {
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.addr, batchKey)
+	require.NoError(t, err)
+	require.Equal(t, tradable, balance.TradableAmount)
+	require.Equal(t, locked, balance.LockedAmount)
+}
@@ -2,6 +2,9 @@ package keeper
 
 import (
 	"context"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,6 +12,7 @@ import (
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
@@ -25,6 +29,32 @@ func (k Keeper) assertClassIssuer(goCtx context.Context, classKey uint64, addr s
 	return nil
 }
 
+// assertBridgeService makes sure that the address is an allowed bridge service
+// of the chain. Returns ErrUnauthorized otherwise.
+func (k Keeper) assertBridgeService(ctx context.Context, chainName string, addr sdk.AccAddress) error {
+	found, err := k.stateStore.AllowedBridgeServiceTable().Has(ctx, strings.ToLower(chainName), addr)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s is not an authorized bridge service for %s", addr, chainName)
+	}
+	return nil
+}
+
+// assertBridgeContract makes sure that the contract is an allowed bridge
+// contract of the chain. Returns ErrUnauthorized otherwise.
+func (k Keeper) assertBridgeContract(ctx context.Context, chainName, contract string) error {
+	found, err := k.stateStore.AllowedBridgeContractTable().Has(ctx, strings.ToLower(chainName), strings.ToLower(contract))
+	if err != nil {
+		return err
+	}
+	if !found {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s is not an authorized bridge contract for %s", contract, chainName)
+	}
+	return nil
+}
+
 // AddAndSaveBalance adds 'amt' to the addr's tradable balance.
 func AddAndSaveBalance(ctx context.Context, table api.BatchBalanceTable, addr sdk.AccAddress, batchKey uint64, amt math.Dec) error {
 	bal, err := utils.GetBalance(ctx, table, addr, batchKey)
@@ -102,3 +132,55 @@ func RetireSupply(ctx context.Context, table api.BatchSupplyTable, batchKey uint
 
 	return table.Update(ctx, supply)
 }
+
+// RecordRetirement checks the retirement jurisdiction against the ISO 3166
+// datasets if strict jurisdiction validation is enabled, inserts a retirement
+// record into the retirement table with the current block time, adds the
+// retired amount to the retirement summary of the owner, mints a retirement
+// certificate to the owner, and returns the id of the inserted record.
+func RecordRetirement(ctx context.Context, ss api.StateStore, nk ecocredit.NFTKeeper, retirement *api.Retirement, batchDenom string) (uint64, error) {
+	if err := AssertValidJurisdiction(ctx, ss, retirement.Jurisdiction); err != nil {
+		return 0, err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	retirement.Timestamp = timestamppb.New(blockTime)
+
+	id, err := ss.RetirementTable().InsertReturningID(ctx, retirement)
+	if err != nil {
+		return 0, err
+	}
+
+	retirement.Id = id
+	if err := AddRetirementSummary(ctx, ss, retirement); err != nil {
+		return 0, err
+	}
+
+	if err := MintRetirementCertificate(ctx, ss, nk, retirement, batchDenom); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// BatchClassID returns the id of the credit class of the project of the credit
+// batch. Unlike the credit class id in the batch denom, the credit class id is
+// updated when the project is migrated to another credit class.
+func (k Keeper) BatchClassID(ctx sdk.Context, batchDenom string) (string, error) {
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, batchDenom)
+	if err != nil {
+		return "", sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", batchDenom, err)
+	}
+
+	project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return "", err
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return "", err
+	}
+
+	return class.Id, nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// wrapAddress is the address of the wrap submodule account. The tradable
+// balance of the account holds wrapped credits in escrow, and the account mints
+// and burns wrapped tokens.
+var wrapAddress = authtypes.NewModuleAddress(base.WrapSubModuleName)
+
+// creditAmountToWrappedCoin calculates the amount of wrapped tokens using the
+// following formula: coinAmount = creditAmount * (1 * 10^precision)
+func creditAmountToWrappedCoin(amount math.Dec, precision uint32, denom string) (sdk.Coin, error) {
+	multiplier := math.NewDecFinite(1, int32(precision))
+	tokenAmount, err := multiplier.MulExact(amount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	amountInt, err := tokenAmount.BigInt()
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(denom, sdk.NewIntFromBigInt(amountInt)), nil
+}
@@ -0,0 +1,5 @@
+package base
+
+import "github.com/regen-network/regen-ledger/x/ecocredit"
+
+const WrapSubModuleName = ecocredit.ModuleName + "-wrap"
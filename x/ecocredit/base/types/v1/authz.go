@@ -0,0 +1,191 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// Validate checks if CreditLimit is valid.
+func (l *CreditLimit) Validate() error {
+	if err := validateAllowanceScope(l.BatchDenom, l.ClassId); err != nil {
+		return err
+	}
+
+	if l.Amount == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("amount cannot be empty")
+	}
+
+	if _, err := math.NewPositiveDecFromString(l.Amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateCreditLimits validates the credit limits of SendCreditsAuthorization
+// and RetireCreditsAuthorization.
+func validateCreditLimits(field string, limits []*CreditLimit) error {
+	if len(limits) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s cannot be empty", field)
+	}
+
+	seen := make(map[string]bool, len(limits))
+	for i, limit := range limits {
+		limitIndex := fmt.Sprintf("%s[%d]", field, i)
+
+		if err := limit.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "%s", limitIndex)
+		}
+
+		key := limit.BatchDenom + "/" + limit.ClassId
+		if seen[key] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: duplicate limit", limitIndex)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// validateAllowedRecipients validates the allowed recipients of
+// SendCreditsAuthorization.
+func validateAllowedRecipients(recipients []string) error {
+	for i, recipient := range recipients {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("allowed recipients[%d]: %s", i, err)
+		}
+	}
+
+	return nil
+}
+
+// validateAllowedJurisdictions validates the allowed jurisdictions of
+// SendCreditsAuthorization and RetireCreditsAuthorization.
+func validateAllowedJurisdictions(jurisdictions []string) error {
+	for i, jurisdiction := range jurisdictions {
+		if err := base.ValidateJurisdiction(jurisdiction); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("allowed jurisdictions[%d]: %s", i, err)
+		}
+	}
+
+	return nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// isJurisdictionAllowed checks whether the jurisdiction is one of the allowed
+// jurisdictions or a sub-national jurisdiction of one of them. An empty list
+// of allowed jurisdictions allows any jurisdiction.
+func isJurisdictionAllowed(allowed []string, jurisdiction string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+
+	for _, a := range allowed {
+		if jurisdiction == a ||
+			strings.HasPrefix(jurisdiction, a+"-") ||
+			strings.HasPrefix(jurisdiction, a+" ") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// BatchClassFunc returns the id of the credit class of a credit batch.
+type BatchClassFunc func(ctx sdk.Context, batchDenom string) (string, error)
+
+// batchClassFunc resolves the credit class of a credit batch when applying a
+// credit class limit.
+var batchClassFunc BatchClassFunc = classIDFromBatchDenom
+
+// classIDFromBatchDenom resolves the credit class of a credit batch from the
+// batch denom, which no longer matches the credit class of the credit batch
+// once the project of the credit batch has been migrated to another credit
+// class.
+func classIDFromBatchDenom(_ sdk.Context, batchDenom string) (string, error) {
+	return base.GetClassIDFromBatchDenom(batchDenom), nil
+}
+
+// SetBatchClassFunc sets the function used by SendCreditsAuthorization and
+// RetireCreditsAuthorization to resolve the credit class of a credit batch.
+// The ecocredit module sets a function that resolves the credit class from the
+// project of the credit batch.
+func SetBatchClassFunc(f BatchClassFunc) {
+	batchClassFunc = f
+}
+
+// spendCreditLimit subtracts the amount of credits from the limit that applies
+// to the credit batch and returns the updated list of limits. A batch limit is
+// used before a class limit and a limit is removed from the list when it has
+// been fully spent. The provided limits are not modified.
+func spendCreditLimit(ctx sdk.Context, limits []*CreditLimit, batchDenom string, amount math.Dec) ([]*CreditLimit, error) {
+	idx := -1
+	for i, limit := range limits {
+		if limit.BatchDenom != "" && limit.BatchDenom == batchDenom {
+			idx = i
+			break
+		}
+	}
+
+	if idx == -1 {
+		classID, err := batchClassFunc(ctx, batchDenom)
+		if err != nil {
+			return nil, err
+		}
+		for i, limit := range limits {
+			if limit.ClassId != "" && limit.ClassId == classID {
+				idx = i
+				break
+			}
+		}
+	}
+
+	if idx == -1 {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("no limit for credit batch %s", batchDenom)
+	}
+
+	limitAmount, err := math.NewDecFromString(limits[idx].Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining, err := math.SafeSubBalance(limitAmount, amount)
+	if err != nil {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf(
+			"requested amount %s of credit batch %s is more than the limit %s",
+			amount, batchDenom, limitAmount,
+		)
+	}
+
+	updated := make([]*CreditLimit, 0, len(limits))
+	for i, limit := range limits {
+		if i != idx {
+			updated = append(updated, limit)
+			continue
+		}
+		if remaining.IsZero() {
+			continue
+		}
+		updated = append(updated, &CreditLimit{
+			BatchDenom: limit.BatchDenom,
+			ClassId:    limit.ClassId,
+			Amount:     remaining.String(),
+		})
+	}
+
+	return updated, nil
+}
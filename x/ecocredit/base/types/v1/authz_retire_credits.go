@@ -0,0 +1,67 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ authz.Authorization = &RetireCreditsAuthorization{}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a RetireCreditsAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgRetire{})
+}
+
+// Accept implements Authorization.Accept.
+func (a RetireCreditsAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mRetire, ok := msg.(*MsgRetire)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if !isJurisdictionAllowed(a.AllowedJurisdictions, mRetire.Jurisdiction) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf(
+			"retirement jurisdiction %s is not allowed", mRetire.Jurisdiction,
+		)
+	}
+
+	limits := a.RetireLimits
+	for _, credit := range mRetire.Credits {
+		ctx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/RetireCreditsAuthorization credit iteration")
+
+		amount, err := math.NewPositiveDecFromString(credit.Amount)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		limits, err = spendCreditLimit(ctx, limits, credit.BatchDenom, amount)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+	}
+
+	if len(limits) == 0 {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{
+		Accept: true,
+		Updated: &RetireCreditsAuthorization{
+			RetireLimits:         limits,
+			AllowedJurisdictions: a.AllowedJurisdictions,
+		},
+	}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a RetireCreditsAuthorization) ValidateBasic() error {
+	if err := validateCreditLimits("retire limits", a.RetireLimits); err != nil {
+		return err
+	}
+
+	return validateAllowedJurisdictions(a.AllowedJurisdictions)
+}
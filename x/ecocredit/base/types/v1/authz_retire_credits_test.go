@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+type retireCreditsAuthorization struct {
+	t     gocuke.TestingT
+	ctx   sdk.Context
+	authz *RetireCreditsAuthorization
+	res   authz.AcceptResponse
+	err   error
+}
+
+func TestRetireCreditsAuthorization(t *testing.T) {
+	gocuke.NewRunner(t, &retireCreditsAuthorization{}).Path("./features/authz_retire_credits.feature").Run()
+}
+
+func (s *retireCreditsAuthorization) Before(t gocuke.TestingT) {
+	s.t = t
+	s.ctx = sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+}
+
+func (s *retireCreditsAuthorization) TheAuthorization(a gocuke.DocString) {
+	s.authz = &RetireCreditsAuthorization{}
+	err := jsonpb.UnmarshalString(a.Content, s.authz)
+	require.NoError(s.t, err)
+}
+
+func (s *retireCreditsAuthorization) TheAuthorizationIsValidated() {
+	s.err = s.authz.ValidateBasic()
+}
+
+func (s *retireCreditsAuthorization) TheAuthorizationAcceptsTheMessage(a gocuke.DocString) {
+	msg := &MsgRetire{}
+	err := jsonpb.UnmarshalString(a.Content, msg)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.authz.Accept(s.ctx, msg)
+}
+
+func (s *retireCreditsAuthorization) TheAuthorizationAcceptsAMessageOfAnotherType() {
+	s.res, s.err = s.authz.Accept(s.ctx, &MsgSend{})
+}
+
+func (s *retireCreditsAuthorization) ExpectTheMessageIsAccepted() {
+	require.True(s.t, s.res.Accept)
+	require.False(s.t, s.res.Delete)
+}
+
+func (s *retireCreditsAuthorization) ExpectTheMessageIsAcceptedAndTheAuthorizationIsDeleted() {
+	require.True(s.t, s.res.Accept)
+	require.True(s.t, s.res.Delete)
+	require.Nil(s.t, s.res.Updated)
+}
+
+func (s *retireCreditsAuthorization) ExpectTheUpdatedAuthorization(a gocuke.DocString) {
+	expected := &RetireCreditsAuthorization{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected, s.res.Updated)
+}
+
+func (s *retireCreditsAuthorization) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *retireCreditsAuthorization) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *retireCreditsAuthorization) ExpectGasConsumed(a string) {
+	gas, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, gas, s.ctx.GasMeter().GasConsumed())
+}
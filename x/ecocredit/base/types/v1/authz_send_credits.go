@@ -0,0 +1,88 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ authz.Authorization = &SendCreditsAuthorization{}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a SendCreditsAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSend{})
+}
+
+// Accept implements Authorization.Accept.
+func (a SendCreditsAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mSend, ok := msg.(*MsgSend)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if len(a.AllowedRecipients) > 0 && !containsString(a.AllowedRecipients, mSend.Recipient) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf(
+			"recipient %s is not allowed", mSend.Recipient,
+		)
+	}
+
+	limits := a.SpendLimits
+	for _, credit := range mSend.Credits {
+		ctx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/SendCreditsAuthorization credit iteration")
+
+		tradableAmount, err := math.NewNonNegativeDecFromString(credit.TradableAmount)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		retiredAmount, err := math.NewNonNegativeDecFromString(credit.RetiredAmount)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		if !retiredAmount.IsZero() && !isJurisdictionAllowed(a.AllowedJurisdictions, credit.RetirementJurisdiction) {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf(
+				"retirement jurisdiction %s is not allowed", credit.RetirementJurisdiction,
+			)
+		}
+
+		amount, err := math.SafeAddBalance(tradableAmount, retiredAmount)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		limits, err = spendCreditLimit(ctx, limits, credit.BatchDenom, amount)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+	}
+
+	if len(limits) == 0 {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{
+		Accept: true,
+		Updated: &SendCreditsAuthorization{
+			SpendLimits:          limits,
+			AllowedRecipients:    a.AllowedRecipients,
+			AllowedJurisdictions: a.AllowedJurisdictions,
+		},
+	}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a SendCreditsAuthorization) ValidateBasic() error {
+	if err := validateCreditLimits("spend limits", a.SpendLimits); err != nil {
+		return err
+	}
+
+	if err := validateAllowedRecipients(a.AllowedRecipients); err != nil {
+		return err
+	}
+
+	return validateAllowedJurisdictions(a.AllowedJurisdictions)
+}
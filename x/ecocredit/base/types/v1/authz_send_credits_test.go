@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+type sendCreditsAuthorization struct {
+	t     gocuke.TestingT
+	ctx   sdk.Context
+	authz *SendCreditsAuthorization
+	res   authz.AcceptResponse
+	err   error
+}
+
+func TestSendCreditsAuthorization(t *testing.T) {
+	gocuke.NewRunner(t, &sendCreditsAuthorization{}).Path("./features/authz_send_credits.feature").Run()
+}
+
+func (s *sendCreditsAuthorization) Before(t gocuke.TestingT) {
+	s.t = t
+	s.ctx = sdk.Context{}.WithGasMeter(sdk.NewInfiniteGasMeter())
+	SetBatchClassFunc(classIDFromBatchDenom)
+}
+
+func (s *sendCreditsAuthorization) TheCreditBatchIsInCreditClass(a, b string) {
+	SetBatchClassFunc(func(ctx sdk.Context, batchDenom string) (string, error) {
+		if batchDenom == a {
+			return b, nil
+		}
+		return classIDFromBatchDenom(ctx, batchDenom)
+	})
+}
+
+func (s *sendCreditsAuthorization) TheAuthorization(a gocuke.DocString) {
+	s.authz = &SendCreditsAuthorization{}
+	err := jsonpb.UnmarshalString(a.Content, s.authz)
+	require.NoError(s.t, err)
+}
+
+func (s *sendCreditsAuthorization) TheAuthorizationIsValidated() {
+	s.err = s.authz.ValidateBasic()
+}
+
+func (s *sendCreditsAuthorization) TheAuthorizationAcceptsTheMessage(a gocuke.DocString) {
+	msg := &MsgSend{}
+	err := jsonpb.UnmarshalString(a.Content, msg)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.authz.Accept(s.ctx, msg)
+}
+
+func (s *sendCreditsAuthorization) TheAuthorizationAcceptsAMessageOfAnotherType() {
+	s.res, s.err = s.authz.Accept(s.ctx, &MsgRetire{})
+}
+
+func (s *sendCreditsAuthorization) ExpectTheMessageIsAccepted() {
+	require.True(s.t, s.res.Accept)
+	require.False(s.t, s.res.Delete)
+}
+
+func (s *sendCreditsAuthorization) ExpectTheMessageIsAcceptedAndTheAuthorizationIsDeleted() {
+	require.True(s.t, s.res.Accept)
+	require.True(s.t, s.res.Delete)
+	require.Nil(s.t, s.res.Updated)
+}
+
+func (s *sendCreditsAuthorization) ExpectTheUpdatedAuthorization(a gocuke.DocString) {
+	expected := &SendCreditsAuthorization{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected, s.res.Updated)
+}
+
+func (s *sendCreditsAuthorization) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *sendCreditsAuthorization) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *sendCreditsAuthorization) ExpectGasConsumed(a string) {
+	gas, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, gas, s.ctx.GasMeter().GasConsumed())
+}
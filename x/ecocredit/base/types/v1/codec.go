@@ -1,11 +1,14 @@
 package v1
 
 import (
+	"github.com/gogo/protobuf/proto"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
@@ -13,6 +16,14 @@ import (
 func RegisterTypes(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*govv1beta1.Content)(nil), &CreditTypeProposal{})
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&SendCreditsAuthorization{},
+		&RetireCreditsAuthorization{},
+	)
+
+	// retirement certificate data is packed into x/nft tokens
+	registry.RegisterImplementations((*proto.Message)(nil), &RetirementCertificateData{})
 }
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
@@ -39,6 +50,40 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateClassFee{}, "regen/MsgUpdateClassFee", nil)
 	cdc.RegisterConcrete(&MsgAddAllowedBridgeChain{}, "regen/MsgAddAllowedBridgeChain", nil)
 	cdc.RegisterConcrete(&MsgRemoveAllowedBridgeChain{}, "regen/MsgRemoveAllowedBridgeChain", nil)
+	cdc.RegisterConcrete(&MsgApprove{}, "regen/MsgApprove", nil)
+	cdc.RegisterConcrete(&MsgRevokeApproval{}, "regen/MsgRevokeApproval", nil)
+	cdc.RegisterConcrete(&MsgSendFrom{}, "regen/MsgSendFrom", nil)
+	cdc.RegisterConcrete(&MsgRetireFrom{}, "regen/MsgRetireFrom", nil)
+	cdc.RegisterConcrete(&MsgSendLocked{}, "regen/MsgSendLocked", nil)
+	cdc.RegisterConcrete(&MsgUpdateClassBuffer{}, "regen/MsgUpdateClassBuffer", nil)
+	cdc.RegisterConcrete(&MsgReverseCredits{}, "regen/MsgReverseCredits", nil)
+	cdc.RegisterConcrete(&MsgUpdateClassVerifiers{}, "regen/MsgUpdateClassVerifiers", nil)
+	cdc.RegisterConcrete(&MsgUpdateProjectStatus{}, "regen/MsgUpdateProjectStatus", nil)
+	cdc.RegisterConcrete(&MsgUpdateClassBatchApproval{}, "regen/MsgUpdateClassBatchApproval", nil)
+	cdc.RegisterConcrete(&MsgProposeBatch{}, "regen/MsgProposeBatch", nil)
+	cdc.RegisterConcrete(&MsgApproveBatch{}, "regen/MsgApproveBatch", nil)
+	cdc.RegisterConcrete(&MsgRejectBatch{}, "regen/MsgRejectBatch", nil)
+	cdc.RegisterConcrete(&MsgUpdateBatchMetadata{}, "regen/MsgUpdateBatchMetadata", nil)
+	cdc.RegisterConcrete(&MsgUpdateClassBatchMetadataCosign{}, "regen/MsgUpdateClassBatchMetadataCosign", nil)
+	cdc.RegisterConcrete(&MsgUpdateClassStatus{}, "regen/MsgUpdateClassStatus", nil)
+	cdc.RegisterConcrete(&MsgMigrateProject{}, "regen/MsgMigrateProject", nil)
+	cdc.RegisterConcrete(&MsgSetStrictJurisdictions{}, "regen/MsgSetStrictJurisdictions", nil)
+	cdc.RegisterConcrete(&MsgAddNationalRegistry{}, "regen/MsgAddNationalRegistry", nil)
+	cdc.RegisterConcrete(&MsgRemoveNationalRegistry{}, "regen/MsgRemoveNationalRegistry", nil)
+	cdc.RegisterConcrete(&MsgSetArticle6Authorization{}, "regen/MsgSetArticle6Authorization", nil)
+	cdc.RegisterConcrete(&MsgUpdateClassSerialization{}, "regen/MsgUpdateClassSerialization", nil)
+	cdc.RegisterConcrete(&MsgLinkBatchContract{}, "regen/MsgLinkBatchContract", nil)
+	cdc.RegisterConcrete(&MsgBridgeReturn{}, "regen/MsgBridgeReturn", nil)
+	cdc.RegisterConcrete(&MsgAcknowledgeBridge{}, "regen/MsgAcknowledgeBridge", nil)
+	cdc.RegisterConcrete(&MsgFailBridge{}, "regen/MsgFailBridge", nil)
+	cdc.RegisterConcrete(&MsgAddAllowedBridgeService{}, "regen/MsgAddAllowedBridgeService", nil)
+	cdc.RegisterConcrete(&MsgRemoveAllowedBridgeService{}, "regen/MsgRemoveAllowedBridgeService", nil)
+	cdc.RegisterConcrete(&MsgAddAllowedBridgeContract{}, "regen/MsgAddAllowedBridgeContract", nil)
+	cdc.RegisterConcrete(&MsgRemoveAllowedBridgeContract{}, "regen/MsgRemoveAllowedBridgeContract", nil)
+	cdc.RegisterConcrete(&MsgWrap{}, "regen/MsgWrap", nil)
+	cdc.RegisterConcrete(&MsgUnwrap{}, "regen/MsgUnwrap", nil)
+	cdc.RegisterConcrete(&SendCreditsAuthorization{}, "regen/SendCreditsAuthorization", nil)
+	cdc.RegisterConcrete(&RetireCreditsAuthorization{}, "regen/RetireCreditsAuthorization", nil)
 }
 
 var (
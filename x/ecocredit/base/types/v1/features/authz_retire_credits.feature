@@ -0,0 +1,219 @@
+Feature: RetireCreditsAuthorization
+
+  Rule: the authorization is validated
+
+    Scenario: a valid authorization
+      Given the authorization
+      """
+      {
+        "retire_limits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "100"
+          },
+          {
+            "class_id": "C02",
+            "amount": "10.5"
+          }
+        ],
+        "allowed_jurisdictions": [
+          "US"
+        ]
+      }
+      """
+      When the authorization is validated
+      Then expect no error
+
+    Scenario: an error is returned if retire limits is empty
+      Given the authorization
+      """
+      {}
+      """
+      When the authorization is validated
+      Then expect the error "retire limits cannot be empty: invalid request"
+
+    Scenario: an error is returned if a retire limit class id is not formatted
+      Given the authorization
+      """
+      {
+        "retire_limits": [
+          {
+            "class_id": "foo",
+            "amount": "100"
+          }
+        ]
+      }
+      """
+      When the authorization is validated
+      Then expect the error "retire limits[0]: class id: expected format <credit-type-abbrev><class-sequence>: parse error: invalid request"
+
+    Scenario: an error is returned if an allowed jurisdiction is not formatted
+      Given the authorization
+      """
+      {
+        "retire_limits": [
+          {
+            "class_id": "C01",
+            "amount": "100"
+          }
+        ],
+        "allowed_jurisdictions": [
+          "foo"
+        ]
+      }
+      """
+      When the authorization is validated
+      Then expect the error "allowed jurisdictions[0]: expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
+
+  Rule: the authorization accepts a message within its limits
+
+    Background:
+      Given the authorization
+      """
+      {
+        "retire_limits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "100"
+          },
+          {
+            "class_id": "C01",
+            "amount": "50"
+          }
+        ],
+        "allowed_jurisdictions": [
+          "US"
+        ]
+      }
+      """
+
+    Scenario: the limits are decremented
+      When the authorization accepts the message
+      """
+      {
+        "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+        "credits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "10"
+          },
+          {
+            "batch_denom": "C01-001-20200101-20210101-002",
+            "amount": "0.5"
+          }
+        ],
+        "jurisdiction": "US-WA 98225"
+      }
+      """
+      Then expect no error
+      And expect the message is accepted
+      And expect gas consumed "20"
+      And expect the updated authorization
+      """
+      {
+        "retire_limits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "90"
+          },
+          {
+            "class_id": "C01",
+            "amount": "49.5"
+          }
+        ],
+        "allowed_jurisdictions": [
+          "US"
+        ]
+      }
+      """
+
+    Scenario: the authorization is deleted when all limits are fully spent
+      When the authorization accepts the message
+      """
+      {
+        "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+        "credits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "100"
+          },
+          {
+            "batch_denom": "C01-001-20200101-20210101-002",
+            "amount": "50"
+          }
+        ],
+        "jurisdiction": "US"
+      }
+      """
+      Then expect no error
+      And expect the message is accepted and the authorization is deleted
+
+  Rule: the authorization rejects a message outside its limits
+
+    Background:
+      Given the authorization
+      """
+      {
+        "retire_limits": [
+          {
+            "class_id": "C01",
+            "amount": "100"
+          }
+        ],
+        "allowed_jurisdictions": [
+          "US"
+        ]
+      }
+      """
+
+    Scenario: an error is returned if the message type does not match
+      When the authorization accepts a message of another type
+      Then expect the error "type mismatch: invalid type"
+
+    Scenario: an error is returned if the jurisdiction is not allowed
+      When the authorization accepts the message
+      """
+      {
+        "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+        "credits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "10"
+          }
+        ],
+        "jurisdiction": "CA-BC"
+      }
+      """
+      Then expect the error "retirement jurisdiction CA-BC is not allowed: unauthorized"
+
+    Scenario: an error is returned if there is no limit for the credit batch
+      When the authorization accepts the message
+      """
+      {
+        "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+        "credits": [
+          {
+            "batch_denom": "C02-001-20200101-20210101-001",
+            "amount": "10"
+          }
+        ],
+        "jurisdiction": "US"
+      }
+      """
+      Then expect the error "no limit for credit batch C02-001-20200101-20210101-001: unauthorized"
+
+    Scenario: an error is returned if the amount exceeds the limit
+      When the authorization accepts the message
+      """
+      {
+        "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+        "credits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "100.1"
+          }
+        ],
+        "jurisdiction": "US"
+      }
+      """
+      Then expect the error "requested amount 100.1 of credit batch C01-001-20200101-20210101-001 is more than the limit 100: insufficient funds"
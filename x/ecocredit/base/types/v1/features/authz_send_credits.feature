@@ -0,0 +1,467 @@
+Feature: SendCreditsAuthorization
+
+  Rule: the authorization is validated
+
+    Scenario: a valid authorization
+      Given the authorization
+      """
+      {
+        "spend_limits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "100"
+          },
+          {
+            "class_id": "C02",
+            "amount": "10.5"
+          }
+        ]
+      }
+      """
+      When the authorization is validated
+      Then expect no error
+
+    Scenario: a valid authorization with allowed recipients and jurisdictions
+      Given the authorization
+      """
+      {
+        "spend_limits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "100"
+          }
+        ],
+        "allowed_recipients": [
+          "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+        ],
+        "allowed_jurisdictions": [
+          "US-WA"
+        ]
+      }
+      """
+      When the authorization is validated
+      Then expect no error
+
+    Scenario: an error is returned if spend limits is empty
+      Given the authorization
+      """
+      {}
+      """
+      When the authorization is validated
+      Then expect the error "spend limits cannot be empty: invalid request"
+
+    Scenario: an error is returned if a spend limit has no batch denom or class id
+      Given the authorization
+      """
+      {
+        "spend_limits": [
+          {
+            "amount": "100"
+          }
+        ]
+      }
+      """
+      When the authorization is validated
+      Then expect the error "spend limits[0]: batch denom or class id is required: invalid request"
+
+    Scenario: an error is returned if a spend limit has both batch denom and class id
+      Given the authorization
+      """
+      {
+        "spend_limits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "class_id": "C01",
+            "amount": "100"
+          }
+        ]
+      }
+      """
+      When the authorization is validated
+      Then expect the error "spend limits[0]: batch denom and class id cannot both be set: invalid request"
+
+    Scenario: an error is returned if a spend limit amount is empty
+      Given the authorization
+      """
+      {
+        "spend_limits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001"
+          }
+        ]
+      }
+      """
+      When the authorization is validated
+      Then expect the error "spend limits[0]: amount cannot be empty: invalid request"
+
+    Scenario: an error is returned if a spend limit amount is not positive
+      Given the authorization
+      """
+      {
+        "spend_limits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "0"
+          }
+        ]
+      }
+      """
+      When the authorization is validated
+      Then expect the error "spend limits[0]: expected a positive decimal, got 0: invalid decimal string"
+
+    Scenario: an error is returned if spend limits are duplicated
+      Given the authorization
+      """
+      {
+        "spend_limits": [
+          {
+            "class_id": "C01",
+            "amount": "100"
+          },
+          {
+            "class_id": "C01",
+            "amount": "10"
+          }
+        ]
+      }
+      """
+      When the authorization is validated
+      Then expect the error "spend limits[1]: duplicate limit: invalid request"
+
+    Scenario: an error is returned if an allowed recipient is not a bech32 address
+      Given the authorization
+      """
+      {
+        "spend_limits": [
+          {
+            "class_id": "C01",
+            "amount": "100"
+          }
+        ],
+        "allowed_recipients": [
+          "foo"
+        ]
+      }
+      """
+      When the authorization is validated
+      Then expect the error "allowed recipients[0]: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+    Scenario: an error is returned if an allowed jurisdiction is not formatted
+      Given the authorization
+      """
+      {
+        "spend_limits": [
+          {
+            "class_id": "C01",
+            "amount": "100"
+          }
+        ],
+        "allowed_jurisdictions": [
+          "foo"
+        ]
+      }
+      """
+      When the authorization is validated
+      Then expect the error "allowed jurisdictions[0]: expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
+
+  Rule: the authorization accepts a message within its limits
+
+    Background:
+      Given the authorization
+      """
+      {
+        "spend_limits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "100"
+          },
+          {
+            "class_id": "C01",
+            "amount": "50"
+          }
+        ],
+        "allowed_recipients": [
+          "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+        ],
+        "allowed_jurisdictions": [
+          "US-WA"
+        ]
+      }
+      """
+
+    Scenario: the batch limit is decremented
+      When the authorization accepts the message
+      """
+      {
+        "sender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+        "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "credits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "tradable_amount": "10",
+            "retired_amount": "5.5",
+            "retirement_jurisdiction": "US-WA 98225"
+          }
+        ]
+      }
+      """
+      Then expect no error
+      And expect the message is accepted
+      And expect gas consumed "10"
+      And expect the updated authorization
+      """
+      {
+        "spend_limits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "84.5"
+          },
+          {
+            "class_id": "C01",
+            "amount": "50"
+          }
+        ],
+        "allowed_recipients": [
+          "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+        ],
+        "allowed_jurisdictions": [
+          "US-WA"
+        ]
+      }
+      """
+
+    Scenario: the class limit is decremented for a batch without a batch limit
+      When the authorization accepts the message
+      """
+      {
+        "sender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+        "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "credits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-002",
+            "tradable_amount": "20",
+            "retired_amount": "0"
+          }
+        ]
+      }
+      """
+      Then expect no error
+      And expect the message is accepted
+      And expect the updated authorization
+      """
+      {
+        "spend_limits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "100"
+          },
+          {
+            "class_id": "C01",
+            "amount": "30"
+          }
+        ],
+        "allowed_recipients": [
+          "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+        ],
+        "allowed_jurisdictions": [
+          "US-WA"
+        ]
+      }
+      """
+
+    Scenario: the class limit of the current credit class is decremented for a migrated batch
+      Given the credit batch "C02-001-20200101-20210101-001" is in credit class "C01"
+      When the authorization accepts the message
+      """
+      {
+        "sender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+        "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "credits": [
+          {
+            "batch_denom": "C02-001-20200101-20210101-001",
+            "tradable_amount": "20",
+            "retired_amount": "0"
+          }
+        ]
+      }
+      """
+      Then expect no error
+      And expect the message is accepted
+      And expect the updated authorization
+      """
+      {
+        "spend_limits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "100"
+          },
+          {
+            "class_id": "C01",
+            "amount": "30"
+          }
+        ],
+        "allowed_recipients": [
+          "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+        ],
+        "allowed_jurisdictions": [
+          "US-WA"
+        ]
+      }
+      """
+
+    Scenario: a limit is removed when fully spent
+      When the authorization accepts the message
+      """
+      {
+        "sender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+        "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "credits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-002",
+            "tradable_amount": "50",
+            "retired_amount": "0"
+          }
+        ]
+      }
+      """
+      Then expect no error
+      And expect the message is accepted
+      And expect the updated authorization
+      """
+      {
+        "spend_limits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "100"
+          }
+        ],
+        "allowed_recipients": [
+          "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+        ],
+        "allowed_jurisdictions": [
+          "US-WA"
+        ]
+      }
+      """
+
+    Scenario: the authorization is deleted when all limits are fully spent
+      When the authorization accepts the message
+      """
+      {
+        "sender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+        "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "credits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "tradable_amount": "100",
+            "retired_amount": "0"
+          },
+          {
+            "batch_denom": "C01-001-20200101-20210101-002",
+            "tradable_amount": "50",
+            "retired_amount": "0"
+          }
+        ]
+      }
+      """
+      Then expect no error
+      And expect the message is accepted and the authorization is deleted
+
+  Rule: the authorization rejects a message outside its limits
+
+    Background:
+      Given the authorization
+      """
+      {
+        "spend_limits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "amount": "100"
+          }
+        ],
+        "allowed_recipients": [
+          "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+        ],
+        "allowed_jurisdictions": [
+          "US-WA"
+        ]
+      }
+      """
+
+    Scenario: an error is returned if the message type does not match
+      When the authorization accepts a message of another type
+      Then expect the error "type mismatch: invalid type"
+
+    Scenario: an error is returned if the recipient is not allowed
+      When the authorization accepts the message
+      """
+      {
+        "sender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+        "recipient": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+        "credits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "tradable_amount": "10",
+            "retired_amount": "0"
+          }
+        ]
+      }
+      """
+      Then expect the error "recipient regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw is not allowed: unauthorized"
+
+    Scenario: an error is returned if the retirement jurisdiction is not allowed
+      When the authorization accepts the message
+      """
+      {
+        "sender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+        "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "credits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "tradable_amount": "0",
+            "retired_amount": "10",
+            "retirement_jurisdiction": "US-OR"
+          }
+        ]
+      }
+      """
+      Then expect the error "retirement jurisdiction US-OR is not allowed: unauthorized"
+
+    Scenario: an error is returned if there is no limit for the credit batch
+      When the authorization accepts the message
+      """
+      {
+        "sender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+        "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "credits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-002",
+            "tradable_amount": "10",
+            "retired_amount": "0"
+          }
+        ]
+      }
+      """
+      Then expect the error "no limit for credit batch C01-001-20200101-20210101-002: unauthorized"
+
+    Scenario: an error is returned if the amount exceeds the limit
+      When the authorization accepts the message
+      """
+      {
+        "sender": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+        "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "credits": [
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "tradable_amount": "60",
+            "retired_amount": "0"
+          },
+          {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "tradable_amount": "50",
+            "retired_amount": "0"
+          }
+        ]
+      }
+      """
+      Then expect the error "requested amount 50 of credit batch C01-001-20200101-20210101-001 is more than the limit 40: insufficient funds"
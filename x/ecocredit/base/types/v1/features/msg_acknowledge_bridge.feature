@@ -0,0 +1,60 @@
+Feature: MsgAcknowledgeBridge
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "service": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "request_id": 1
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if service is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "service: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if service is not a bech32 address
+    Given the message
+    """
+    {
+      "service": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "service: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if request id is empty
+    Given the message
+    """
+    {
+      "service": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "request id cannot be zero: invalid request"
+
+  Scenario: a valid amino message
+    Given the message
+    """
+    {
+      "service": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "request_id": 1
+    }
+    """
+    When message sign bytes queried
+    Then expect the sign bytes
+    """
+    {
+      "type":"regen/MsgAcknowledgeBridge",
+      "value":{
+        "request_id":"1",
+        "service":"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      }
+    }
+    """
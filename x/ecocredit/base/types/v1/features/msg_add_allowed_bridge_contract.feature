@@ -0,0 +1,122 @@
+Feature: MsgAddAllowedBridgeContract
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "authority": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "chain_name": "polygon",
+      "contract": "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with a contract without letters
+    Given the message
+    """
+    {
+      "authority": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "chain_name": "polygon",
+      "contract": "0x0000000000000000000000000000000000000001"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if authority address is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "invalid authority address: empty address string is not allowed"
+
+  Scenario: an error is returned if authority address is not a valid bech32 address
+    Given the message
+    """
+    {
+      "authority": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid authority address: decoding bech32 failed: invalid bech32 string length 3"
+
+  Scenario: an error is returned if chain name is empty
+    Given the message
+    """
+    {
+      "authority": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "chain_name cannot be empty: invalid request"
+
+  Scenario: an error is returned if contract is empty
+    Given the message
+    """
+    {
+      "authority": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "chain_name": "polygon"
+    }
+    """
+    When the message is validated
+    Then expect the error "contract cannot be empty: invalid request"
+
+  Scenario: an error is returned if contract is not a valid ethereum address
+    Given the message
+    """
+    {
+      "authority": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "chain_name": "polygon",
+      "contract": "0x01"
+    }
+    """
+    When the message is validated
+    Then expect the error "contract must be a valid ethereum address: invalid address"
+
+  Scenario: an error is returned if contract is not checksummed
+    Given the message
+    """
+    {
+      "authority": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "chain_name": "polygon",
+      "contract": "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+    }
+    """
+    When the message is validated
+    Then expect the error "contract must be an EIP-55 checksum address: expected 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed: invalid address"
+
+  Scenario: an error is returned if contract has an invalid checksum
+    Given the message
+    """
+    {
+      "authority": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "chain_name": "polygon",
+      "contract": "0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+    }
+    """
+    When the message is validated
+    Then expect the error "contract must be an EIP-55 checksum address: expected 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed: invalid address"
+
+  Scenario: a valid amino message
+    Given the message
+    """
+    {
+      "authority": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "chain_name": "polygon",
+      "contract": "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+    }
+    """
+    When message sign bytes queried
+    Then expect the sign bytes
+    """
+    {
+      "type":"regen/MsgAddAllowedBridgeContract",
+      "value":{
+        "authority":"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "chain_name":"polygon",
+        "contract":"0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+      }
+    }
+    """
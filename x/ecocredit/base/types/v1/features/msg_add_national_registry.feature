@@ -0,0 +1,75 @@
+Feature: MsgAddNationalRegistry
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "authority": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "country": "KE",
+      "registry": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if authority address is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "invalid authority address: empty address string is not allowed"
+
+  Scenario: an error is returned if country is empty
+    Given the message
+    """
+    {
+      "authority": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "country: empty string is not allowed: invalid request"
+
+  Scenario: an error is returned if country is not a country code
+    Given the message
+    """
+    {
+      "authority": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "country": "Kenya"
+    }
+    """
+    When the message is validated
+    Then expect the error "country: expected format <country-code>: invalid request"
+
+  Scenario: an error is returned if registry is empty
+    Given the message
+    """
+    {
+      "authority": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "country": "KE"
+    }
+    """
+    When the message is validated
+    Then expect the error "registry: empty address string is not allowed: invalid address"
+
+  Scenario: a valid amino message
+    Given the message
+    """
+    {
+      "authority": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "country": "KE",
+      "registry": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+    }
+    """
+    When message sign bytes queried
+    Then expect the sign bytes
+    """
+    {
+      "type":"regen/MsgAddNationalRegistry",
+      "value":{
+        "authority":"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "country":"KE",
+        "registry":"regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      }
+    }
+    """
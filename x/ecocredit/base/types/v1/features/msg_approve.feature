@@ -0,0 +1,141 @@
+Feature: MsgApprove
+
+  Scenario: a valid message with batch denom
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "100"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with class id
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "class_id": "C01",
+      "amount": "100"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if owner is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "owner: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if spender is empty
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "spender: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if spender is the owner
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "spender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "owner and spender cannot be the same account: invalid request"
+
+  Scenario: an error is returned if batch denom and class id are empty
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom or class id is required: invalid request"
+
+  Scenario: an error is returned if batch denom and class id are both set
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "class_id": "C01"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom and class id cannot both be set: invalid request"
+
+  Scenario: an error is returned if batch denom is not formatted
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "batch_denom": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom: expected format <project-id>-<start_date>-<end_date>-<batch_sequence>: parse error: invalid request"
+
+  Scenario: an error is returned if class id is not formatted
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "class_id": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "class id: expected format <credit-type-abbrev><class-sequence>: parse error: invalid request"
+
+  Scenario: an error is returned if amount is not positive
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "class_id": "C01",
+      "amount": "0"
+    }
+    """
+    When the message is validated
+    Then expect the error "amount: expected a positive decimal, got 0: invalid decimal string: invalid request"
+
+  Scenario: a valid amino message
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "class_id": "C01",
+      "amount": "100"
+    }
+    """
+    When message sign bytes queried
+    Then expect the sign bytes
+    """
+    {
+      "type":"regen/MsgApprove",
+      "value":{
+        "amount":"100",
+        "class_id":"C01",
+        "owner":"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "spender":"regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      }
+    }
+    """
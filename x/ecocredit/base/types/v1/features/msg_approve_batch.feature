@@ -0,0 +1,60 @@
+Feature: MsgApproveBatch
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "approver": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "pending_batch_id": 1
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if approver is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "approver: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if approver is not a bech32 address
+    Given the message
+    """
+    {
+      "approver": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "approver: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if pending batch id is empty
+    Given the message
+    """
+    {
+      "approver": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "pending batch id cannot be empty: invalid request"
+
+  Scenario: a valid amino message
+    Given the message
+    """
+    {
+      "approver": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "pending_batch_id": 1
+    }
+    """
+    When message sign bytes queried
+    Then expect the sign bytes
+    """
+    {
+      "type":"regen/MsgApproveBatch",
+      "value":{
+        "approver":"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "pending_batch_id":"1"
+      }
+    }
+    """
@@ -0,0 +1,172 @@
+Feature: MsgBridgeReturn
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "amount": "10",
+      "origin_tx": {
+        "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+        "source": "polygon",
+        "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+      }
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if issuer is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "issuer: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if batch denom is not formatted
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom: expected format <project-id>-<start_date>-<end_date>-<batch_sequence>: parse error: invalid request"
+
+  Scenario: an error is returned if recipient is not a bech32 address
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "recipient": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "recipient: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if amount is empty
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "amount cannot be empty: invalid request"
+
+  Scenario: an error is returned if amount is not positive
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "amount": "-10"
+    }
+    """
+    When the message is validated
+    Then expect the error "amount: expected a positive decimal, got -10: invalid decimal string"
+
+  Scenario: an error is returned if origin tx is empty
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "amount": "10"
+    }
+    """
+    When the message is validated
+    Then expect the error "origin tx cannot be empty: invalid request"
+
+  Scenario: an error is returned if origin tx id is not an ethereum transaction hash
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "amount": "10",
+      "origin_tx": {
+        "id": "foo"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "origin tx id must be a valid ethereum transaction hash: invalid request"
+
+  Scenario: an error is returned if origin tx contract is empty
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "amount": "10",
+      "origin_tx": {
+        "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+        "source": "polygon"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "origin tx contract cannot be empty: invalid request"
+
+  Scenario: an error is returned if origin tx source is empty
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "amount": "10",
+      "origin_tx": {
+        "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+        "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "origin_tx.source cannot be empty: invalid request"
+
+  Scenario: a valid amino message
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "amount": "10",
+      "origin_tx": {
+        "id": "0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+        "source": "polygon",
+        "contract": "0x06012c8cf97bead5deae237070f9587f8e7a266d"
+      }
+    }
+    """
+    When message sign bytes queried
+    Then expect the sign bytes
+    """
+    {
+      "type":"regen/MsgBridgeReturn",
+      "value":{
+        "amount":"10",
+        "batch_denom":"C01-001-20200101-20210101-001",
+        "issuer":"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "origin_tx":{
+          "contract":"0x06012c8cf97bead5deae237070f9587f8e7a266d",
+          "id":"0x7a70692a348e8688f54ab2bdfe87d925d8cc88932520492a11eaa02dc128243e",
+          "source":"polygon"
+        },
+        "recipient":"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      }
+    }
+    """
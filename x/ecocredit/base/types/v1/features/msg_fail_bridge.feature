@@ -0,0 +1,74 @@
+Feature: MsgFailBridge
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "service": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "request_id": 1,
+      "reason": "relay transaction reverted"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if service is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "service: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if service is not a bech32 address
+    Given the message
+    """
+    {
+      "service": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "service: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if request id is empty
+    Given the message
+    """
+    {
+      "service": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "request id cannot be zero: invalid request"
+
+  Scenario: an error is returned if reason exceeds 512 characters
+    Given the message
+    """
+    {
+      "service": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "request_id": 1
+    }
+    """
+    And reason with length "513"
+    When the message is validated
+    Then expect the error "reason: max length 512: limit exceeded"
+
+  Scenario: a valid amino message
+    Given the message
+    """
+    {
+      "service": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "request_id": 1,
+      "reason": "relay transaction reverted"
+    }
+    """
+    When message sign bytes queried
+    Then expect the sign bytes
+    """
+    {
+      "type":"regen/MsgFailBridge",
+      "value":{
+        "reason":"relay transaction reverted","request_id":"1",
+        "service":"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      }
+    }
+    """
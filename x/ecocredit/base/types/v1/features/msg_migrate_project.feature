@@ -0,0 +1,162 @@
+Feature: MsgMigrateProject
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "new_class_id": "C02",
+      "new_class_admin": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with the same admin
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "new_class_id": "C02",
+      "new_class_admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if admin is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "admin: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if admin is not a bech32 address
+    Given the message
+    """
+    {
+      "admin": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "admin: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if project id is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "project id: empty string is not allowed: parse error: invalid request"
+
+  Scenario: an error is returned if project id is not formatted
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "project id: expected format <class-id>-<project-sequence>: parse error: invalid request"
+
+  Scenario: an error is returned if new class id is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001"
+    }
+    """
+    When the message is validated
+    Then expect the error "new class id: empty string is not allowed: parse error: invalid request"
+
+  Scenario: an error is returned if new class id is not formatted
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "new_class_id": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "new class id: expected format <credit-type-abbrev><class-sequence>: parse error: invalid request"
+
+  Scenario: an error is returned if new class admin is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "new_class_id": "C02"
+    }
+    """
+    When the message is validated
+    Then expect the error "new class admin: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if new class admin is not a bech32 address
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "new_class_id": "C02",
+      "new_class_admin": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "new class admin: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: the admin and new class admin are signers
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "new_class_id": "C02",
+      "new_class_admin": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+    }
+    """
+    Then expect the signers "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6 regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+
+  Scenario: the admin is the only signer if the admin is the new class admin
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "new_class_id": "C02",
+      "new_class_admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    Then expect the signers "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+
+  Scenario: a valid amino message
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "new_class_id": "C02",
+      "new_class_admin": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+    }
+    """
+    When message sign bytes queried
+    Then expect the sign bytes
+    """
+    {
+      "type":"regen/MsgMigrateProject",
+      "value":{
+        "admin":"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "new_class_admin":"regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+        "new_class_id":"C02",
+        "project_id":"C01-001"
+      }
+    }
+    """
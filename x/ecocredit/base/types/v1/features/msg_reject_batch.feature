@@ -0,0 +1,76 @@
+Feature: MsgRejectBatch
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "approver": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "pending_batch_id": 1,
+      "reason": "monitoring report incomplete"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message without reason
+    Given the message
+    """
+    {
+      "approver": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "pending_batch_id": 1
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if approver is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "approver: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if pending batch id is empty
+    Given the message
+    """
+    {
+      "approver": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "pending batch id cannot be empty: invalid request"
+
+  Scenario: an error is returned if reason exceeds 512 characters
+    Given the message
+    """
+    {
+      "approver": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "pending_batch_id": 1
+    }
+    """
+    And a reason with length "513"
+    When the message is validated
+    Then expect the error "reason: max length 512: limit exceeded"
+
+  Scenario: a valid amino message
+    Given the message
+    """
+    {
+      "approver": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "pending_batch_id": 1,
+      "reason": "monitoring report incomplete"
+    }
+    """
+    When message sign bytes queried
+    Then expect the sign bytes
+    """
+    {
+      "type":"regen/MsgRejectBatch",
+      "value":{
+        "approver":"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "pending_batch_id":"1",
+        "reason":"monitoring report incomplete"
+      }
+    }
+    """
@@ -0,0 +1,103 @@
+Feature: MsgRetireFrom
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ],
+      "jurisdiction": "US-WA",
+      "reason": "offsetting 2022 emissions",
+      "beneficiary": "Acme Inc."
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if owner is empty
+    Given the message
+    """
+    {
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+    }
+    """
+    When the message is validated
+    Then expect the error "owner: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if spender is empty
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "spender: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if credits is empty
+    Given the message
+    """
+    {
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "credits cannot be empty: invalid request"
+
+  Scenario: an error is returned if jurisdiction is empty
+    Given the message
+    """
+    {
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "jurisdiction: empty string is not allowed: parse error: invalid request"
+
+  Scenario: a valid amino message
+    Given the message
+    """
+    {
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ],
+      "jurisdiction": "US-WA"
+    }
+    """
+    When message sign bytes queried
+    Then expect the sign bytes
+    """
+    {
+      "type":"regen/MsgRetireFrom",
+      "value":{
+        "credits":[
+          {
+            "amount":"100",
+            "batch_denom":"C01-001-20200101-20210101-001"
+          }
+        ],
+        "jurisdiction":"US-WA",
+        "owner":"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "spender":"regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      }
+    }
+    """
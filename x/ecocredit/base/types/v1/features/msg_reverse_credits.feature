@@ -0,0 +1,165 @@
+Feature: MsgReverseCredits
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "name": "2023 Tahoe fire",
+      "reason": "wildfire in the project area",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message without reason
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "name": "2023 Tahoe fire",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if signer is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "signer: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if signer is not a bech32 address
+    Given the message
+    """
+    {
+      "signer": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "signer: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if class id is empty
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "class id: empty string is not allowed: parse error: invalid request"
+
+  Scenario: an error is returned if name is empty
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01"
+    }
+    """
+    When the message is validated
+    Then expect the error "name cannot be empty: invalid request"
+
+  Scenario: an error is returned if name exceeds 256 characters
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01"
+    }
+    """
+    And a name with length "257"
+    When the message is validated
+    Then expect the error "name: max length 256: limit exceeded"
+
+  Scenario: an error is returned if reason exceeds 512 characters
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "name": "2023 Tahoe fire"
+    }
+    """
+    And a reason with length "513"
+    When the message is validated
+    Then expect the error "reason: max length 512: limit exceeded"
+
+  Scenario: an error is returned if credits is empty
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "name": "2023 Tahoe fire"
+    }
+    """
+    When the message is validated
+    Then expect the error "credits cannot be empty: invalid request"
+
+  Scenario: an error is returned if credits batch denom is empty
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "name": "2023 Tahoe fire",
+      "credits": [
+        {}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "credits[0]: batch denom: empty string is not allowed: parse error: invalid request"
+
+  Scenario: a valid amino message
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "name": "2023 Tahoe fire",
+      "reason": "wildfire in the project area",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When message sign bytes queried
+    Then expect the sign bytes
+    """
+    {
+      "type":"regen/MsgReverseCredits",
+      "value":{
+        "class_id":"C01",
+        "credits":[
+          {
+            "amount":"100",
+            "batch_denom":"C01-001-20200101-20210101-001"
+          }
+        ],
+        "name":"2023 Tahoe fire",
+        "reason":"wildfire in the project area",
+        "signer":"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      }
+    }
+    """
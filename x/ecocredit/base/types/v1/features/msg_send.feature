@@ -206,6 +206,44 @@ Feature: MsgSend
     When the message is validated
     Then expect the error "retirement jurisdiction: expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
 
+  Scenario: an error is returned if credits retirement reason exceeds 512 characters
+    Given the message
+    """
+    {
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "retired_amount": "100",
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    And a retirement reason with length "513"
+    When the message is validated
+    Then expect the error "credits[0]: retirement reason: max length 512: limit exceeded"
+
+  Scenario: an error is returned if credits retirement beneficiary exceeds 256 characters
+    Given the message
+    """
+    {
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "retired_amount": "100",
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    And a retirement beneficiary with length "257"
+    When the message is validated
+    Then expect the error "credits[0]: retirement beneficiary: max length 256: limit exceeded"
+
   Scenario: a valid amino message
     Given the message
     """
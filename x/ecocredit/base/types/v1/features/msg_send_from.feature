@@ -0,0 +1,113 @@
+Feature: MsgSendFrom
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1m0qh5y4ejkz3l5n6jlrntxcqx9r0x9xjv4vpcp",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "tradable_amount": "100",
+          "retired_amount": "100",
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if owner is empty
+    Given the message
+    """
+    {
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+    }
+    """
+    When the message is validated
+    Then expect the error "owner: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if spender is empty
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "spender: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if recipient is empty
+    Given the message
+    """
+    {
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "recipient: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if credits is empty
+    Given the message
+    """
+    {
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1m0qh5y4ejkz3l5n6jlrntxcqx9r0x9xjv4vpcp"
+    }
+    """
+    When the message is validated
+    Then expect the error "credits cannot be empty: invalid request"
+
+  Scenario: an error is returned if credits batch denom is empty
+    Given the message
+    """
+    {
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1m0qh5y4ejkz3l5n6jlrntxcqx9r0x9xjv4vpcp",
+      "credits": [
+        {}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "credits[0]: batch denom: empty string is not allowed: parse error: invalid request"
+
+  Scenario: a valid amino message
+    Given the message
+    """
+    {
+      "spender": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1m0qh5y4ejkz3l5n6jlrntxcqx9r0x9xjv4vpcp",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "tradable_amount": "100"
+        }
+      ]
+    }
+    """
+    When message sign bytes queried
+    Then expect the sign bytes
+    """
+    {
+      "type":"regen/MsgSendFrom",
+      "value":{
+        "credits":[
+          {
+            "batch_denom":"C01-001-20200101-20210101-001",
+            "tradable_amount":"100"
+          }
+        ],
+        "owner":"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "recipient":"regen1m0qh5y4ejkz3l5n6jlrntxcqx9r0x9xjv4vpcp",
+        "spender":"regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      }
+    }
+    """
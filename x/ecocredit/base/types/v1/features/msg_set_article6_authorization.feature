@@ -0,0 +1,143 @@
+Feature: MsgSetArticle6Authorization
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "host_jurisdiction": "KE",
+      "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+      "adjustment_status": "ADJUSTMENT_STATUS_APPLIED",
+      "authorized_use": "AUTHORIZED_USE_NDC"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with retirement id
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "retirement_id": 1,
+      "host_jurisdiction": "KE-30",
+      "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+      "adjustment_status": "ADJUSTMENT_STATUS_PENDING",
+      "authorized_use": "AUTHORIZED_USE_CORSIA"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if signer is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "signer: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if batch denom is not formatted
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom: expected format <project-id>-<start_date>-<end_date>-<batch_sequence>: parse error: invalid request"
+
+  Scenario: an error is returned if host jurisdiction is not formatted
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "host_jurisdiction": "Kenya"
+    }
+    """
+    When the message is validated
+    Then expect the error "host jurisdiction: expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
+
+  Scenario: an error is returned if authorization iri is empty
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "host_jurisdiction": "KE"
+    }
+    """
+    When the message is validated
+    Then expect the error "authorization iri: empty string is not allowed: invalid request"
+
+  Scenario: an error is returned if authorization iri is not a regen iri
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "host_jurisdiction": "KE",
+      "authorization_iri": "https://example.com/loa.pdf"
+    }
+    """
+    When the message is validated
+    Then expect the error "authorization iri: expected regen: prefix: invalid request"
+
+  Scenario: an error is returned if adjustment status is unspecified
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "host_jurisdiction": "KE",
+      "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf"
+    }
+    """
+    When the message is validated
+    Then expect the error "adjustment status: cannot be unspecified: invalid request"
+
+  Scenario: an error is returned if authorized use is unspecified
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "host_jurisdiction": "KE",
+      "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+      "adjustment_status": "ADJUSTMENT_STATUS_NOT_APPLIED"
+    }
+    """
+    When the message is validated
+    Then expect the error "authorized use: cannot be unspecified: invalid request"
+
+  Scenario: a valid amino message
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "host_jurisdiction": "KE",
+      "authorization_iri": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+      "adjustment_status": "ADJUSTMENT_STATUS_APPLIED",
+      "authorized_use": "AUTHORIZED_USE_NDC"
+    }
+    """
+    When message sign bytes queried
+    Then expect the sign bytes
+    """
+    {
+      "type":"regen/MsgSetArticle6Authorization",
+      "value":{
+        "adjustment_status":3,
+        "authorization_iri":"regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGyGREWGKjEdXYG.rdf",
+        "authorized_use":1,
+        "batch_denom":"C01-001-20200101-20210101-001",
+        "host_jurisdiction":"KE",
+        "signer":"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      }
+    }
+    """
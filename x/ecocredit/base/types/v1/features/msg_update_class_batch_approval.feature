@@ -0,0 +1,116 @@
+Feature: MsgUpdateClassBatchApproval
+
+  Scenario: a valid message requiring approval
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "require_approval": true,
+      "expiry_period": "604800s"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message not requiring approval
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if admin is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "admin: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if admin is not a bech32 address
+    Given the message
+    """
+    {
+      "admin": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "admin: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if class id is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "class id: empty string is not allowed: parse error: invalid request"
+
+  Scenario: an error is returned if expiry period is empty when approval is required
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "require_approval": true
+    }
+    """
+    When the message is validated
+    Then expect the error "expiry period: cannot be empty: invalid request"
+
+  Scenario: an error is returned if expiry period is not positive
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "require_approval": true,
+      "expiry_period": "0s"
+    }
+    """
+    When the message is validated
+    Then expect the error "expiry period: must be positive: invalid request"
+
+  Scenario: an error is returned if expiry period is set when approval is not required
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "expiry_period": "604800s"
+    }
+    """
+    When the message is validated
+    Then expect the error "expiry period must be empty when approval is not required: invalid request"
+
+  Scenario: a valid amino message
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "require_approval": true,
+      "expiry_period": "604800s"
+    }
+    """
+    When message sign bytes queried
+    Then expect the sign bytes
+    """
+    {
+      "type":"regen/MsgUpdateClassBatchApproval",
+      "value":{
+        "admin":"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "class_id":"C01",
+        "expiry_period":{
+          "seconds":"604800"
+        },
+        "require_approval":true
+      }
+    }
+    """
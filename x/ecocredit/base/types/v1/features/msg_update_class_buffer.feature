@@ -0,0 +1,133 @@
+Feature: MsgUpdateClassBuffer
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "buffer_percentage": "12.5"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message disabling the buffer pool
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "buffer_percentage": "0"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if admin is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "admin: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if admin is not a bech32 address
+    Given the message
+    """
+    {
+      "admin": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "admin: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if class id is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "class id: empty string is not allowed: parse error: invalid request"
+
+  Scenario: an error is returned if class id is not formatted
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "class id: expected format <credit-type-abbrev><class-sequence>: parse error: invalid request"
+
+  Scenario: an error is returned if buffer percentage is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01"
+    }
+    """
+    When the message is validated
+    Then expect the error "buffer percentage cannot be empty: invalid request"
+
+  Scenario: an error is returned if buffer percentage is not a decimal
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "buffer_percentage": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "buffer percentage: parse mantissa: foo: invalid decimal string: invalid decimal string: invalid request"
+
+  Scenario: an error is returned if buffer percentage is negative
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "buffer_percentage": "-1"
+    }
+    """
+    When the message is validated
+    Then expect the error "buffer percentage: expected a non-negative decimal, got -1: invalid decimal string: invalid request"
+
+  Scenario: an error is returned if buffer percentage is greater than 100
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "buffer_percentage": "100.1"
+    }
+    """
+    When the message is validated
+    Then expect the error "buffer percentage: must not be greater than 100: invalid request"
+
+  Scenario: a valid amino message
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "buffer_percentage": "12.5"
+    }
+    """
+    When message sign bytes queried
+    Then expect the sign bytes
+    """
+    {
+      "type":"regen/MsgUpdateClassBuffer",
+      "value":{
+        "admin":"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "buffer_percentage":"12.5",
+        "class_id":"C01"
+      }
+    }
+    """
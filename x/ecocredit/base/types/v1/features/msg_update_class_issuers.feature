@@ -45,6 +45,43 @@ Feature: MsgUpdateClassIssuers
     When the message is validated
     Then expect no error
 
+  Scenario: a valid message with only issuance caps
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "issuer_caps": [
+        {
+          "issuer": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+          "max_issuance": "1000"
+        }
+      ],
+      "project_vintage_cap": {
+        "max_issuance": "500"
+      }
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message removing issuance caps
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "issuer_caps": [
+        {
+          "issuer": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+        }
+      ],
+      "project_vintage_cap": {}
+    }
+    """
+    When the message is validated
+    Then expect no error
+
   Scenario: an error is returned if admin is empty
     Given the message
     """
@@ -93,7 +130,7 @@ Feature: MsgUpdateClassIssuers
     }
     """
     When the message is validated
-    Then expect the error "must specify at least one of add_issuers or remove_issuers: invalid request"
+    Then expect the error "must specify at least one of add_issuers, remove_issuers, issuer_caps, or project_vintage_cap: invalid request"
 
   Scenario: an error is returned if new issuer is not a bech32 address
     Given the message
@@ -153,6 +190,95 @@ Feature: MsgUpdateClassIssuers
     When the message is validated
     Then expect the error "remove_issuers[1]: duplicate issuer: invalid request"
 
+  Scenario: an error is returned if issuer cap issuer is not a bech32 address
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "issuer_caps": [
+        {
+          "issuer": "foo",
+          "max_issuance": "1000"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "issuer_caps[0]: issuer: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if issuer cap issuer is a duplicate
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "issuer_caps": [
+        {
+          "issuer": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+          "max_issuance": "1000"
+        },
+        {
+          "issuer": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+          "max_issuance": "2000"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "issuer_caps[1]: duplicate issuer: invalid request"
+
+  Scenario: an error is returned if issuer cap issuer is being removed
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "remove_issuers": [
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      ],
+      "issuer_caps": [
+        {
+          "issuer": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+          "max_issuance": "1000"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "issuer_caps[0]: cannot set cap for removed issuer: invalid request"
+
+  Scenario: an error is returned if issuer cap max issuance is not positive
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "issuer_caps": [
+        {
+          "issuer": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+          "max_issuance": "0"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "issuer_caps[0]: max issuance: expected a positive decimal, got 0: invalid decimal string: invalid request"
+
+  Scenario: an error is returned if project vintage cap max issuance is not a decimal
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "project_vintage_cap": {
+        "max_issuance": "foo"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "project vintage cap: max issuance: parse mantissa: foo: invalid decimal string: invalid decimal string: invalid request"
+
   Scenario: a valid amino message
     Given the message
     """
@@ -184,3 +310,41 @@ Feature: MsgUpdateClassIssuers
       }
     }
     """
+
+  Scenario: a valid amino message with issuance caps
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "issuer_caps": [
+        {
+          "issuer": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+          "max_issuance": "1000"
+        }
+      ],
+      "project_vintage_cap": {
+        "max_issuance": "500"
+      }
+    }
+    """
+    When message sign bytes queried
+    Then expect the sign bytes
+    """
+    {
+      "type":"regen/MsgUpdateClassIssuers",
+      "value":{
+        "admin":"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "class_id":"C01",
+        "issuer_caps":[
+          {
+            "issuer":"regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+            "max_issuance":"1000"
+          }
+        ],
+        "project_vintage_cap":{
+          "max_issuance":"500"
+        }
+      }
+    }
+    """
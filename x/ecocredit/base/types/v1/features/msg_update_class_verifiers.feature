@@ -0,0 +1,180 @@
+Feature: MsgUpdateClassVerifiers
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "add_verifiers": [
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      ],
+      "remove_verifiers": [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message without add verifiers
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "remove_verifiers": [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message without remove verifiers
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "add_verifiers": [
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if admin is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "admin: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if admin is not a bech32 address
+    Given the message
+    """
+    {
+      "admin": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "admin: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if class id is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "class id: empty string is not allowed: parse error: invalid request"
+
+  Scenario: an error is returned if class id is not formatted
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "class id: expected format <credit-type-abbrev><class-sequence>: parse error: invalid request"
+
+  Scenario: an error is returned if add verifiers and remove verifiers are empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01"
+    }
+    """
+    When the message is validated
+    Then expect the error "must specify at least one of add_verifiers or remove_verifiers: invalid request"
+
+  Scenario: an error is returned if an add verifier is not a bech32 address
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "add_verifiers": [
+        "foo"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "add_verifiers[0]: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if an add verifier is duplicated
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "add_verifiers": [
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "add_verifiers[1]: duplicate verifier: invalid request"
+
+  Scenario: an error is returned if a remove verifier is not a bech32 address
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "remove_verifiers": [
+        "foo"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "remove_verifiers[0]: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if a remove verifier is duplicated
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "remove_verifiers": [
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "remove_verifiers[1]: duplicate verifier: invalid request"
+
+  Scenario: a valid amino message
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "add_verifiers": [
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      ]
+    }
+    """
+    When message sign bytes queried
+    Then expect the sign bytes
+    """
+    {
+      "type":"regen/MsgUpdateClassVerifiers",
+      "value":{
+        "add_verifiers":[
+          "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+        ],
+        "admin":"regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "class_id":"C01"
+      }
+    }
+    """
@@ -78,3 +78,18 @@ Feature: BatchBalance
     """
     When the batch balance is validated
     Then expect the error "escrowed amount: expected a non-negative decimal, got -100: invalid decimal string: parse error"
+
+  Scenario: an error is returned if locked amount is not positive
+    Given the batch balance
+    """
+    {
+      "batch_key": 1,
+      "address": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "tradable_amount": "100",
+      "retired_amount": "100",
+      "escrowed_amount": "100",
+      "locked_amount": "-100"
+    }
+    """
+    When the batch balance is validated
+    Then expect the error "locked amount: expected a non-negative decimal, got -100: invalid decimal string: parse error"
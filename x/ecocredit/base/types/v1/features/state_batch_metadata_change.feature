@@ -0,0 +1,95 @@
+Feature: BatchMetadataChange
+
+  Scenario: a valid batch metadata change
+    Given the batch metadata change
+    """
+    {
+      "id": 1,
+      "batch_key": 1,
+      "old_metadata": "regen:13toVgf5aZqSVSeJQv562xkkeoe3rr3bJWa29PHVKVf77VAkVMcDvVd.rdf",
+      "new_metadata": "regen:13toVh6kS5ZUEWbsgrNBEhGaKt1UjRaY2XuYdRVt9ADgpxUPJmaFSSq.rdf",
+      "signer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "timestamp": "2020-01-01T00:00:00Z"
+    }
+    """
+    When the batch metadata change is validated
+    Then expect no error
+
+  Scenario: a valid batch metadata change without old metadata
+    Given the batch metadata change
+    """
+    {
+      "id": 1,
+      "batch_key": 1,
+      "new_metadata": "regen:13toVh6kS5ZUEWbsgrNBEhGaKt1UjRaY2XuYdRVt9ADgpxUPJmaFSSq.rdf",
+      "signer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "timestamp": "2020-01-01T00:00:00Z"
+    }
+    """
+    When the batch metadata change is validated
+    Then expect no error
+
+  Scenario: an error is returned if id is empty
+    Given the batch metadata change
+    """
+    {}
+    """
+    When the batch metadata change is validated
+    Then expect the error "id cannot be zero: parse error"
+
+  Scenario: an error is returned if batch key is empty
+    Given the batch metadata change
+    """
+    {
+      "id": 1
+    }
+    """
+    When the batch metadata change is validated
+    Then expect the error "batch key cannot be zero: parse error"
+
+  Scenario: an error is returned if old metadata exceeds 256 characters
+    Given the batch metadata change
+    """
+    {
+      "id": 1,
+      "batch_key": 1
+    }
+    """
+    And old metadata with length "257"
+    When the batch metadata change is validated
+    Then expect the error "old metadata cannot be more than 256 characters: parse error"
+
+  Scenario: an error is returned if new metadata exceeds 256 characters
+    Given the batch metadata change
+    """
+    {
+      "id": 1,
+      "batch_key": 1
+    }
+    """
+    And new metadata with length "257"
+    When the batch metadata change is validated
+    Then expect the error "new metadata cannot be more than 256 characters: parse error"
+
+  Scenario: an error is returned if signer is empty
+    Given the batch metadata change
+    """
+    {
+      "id": 1,
+      "batch_key": 1
+    }
+    """
+    When the batch metadata change is validated
+    Then expect the error "signer: empty address string is not allowed: parse error"
+
+  Scenario: an error is returned if timestamp is empty
+    Given the batch metadata change
+    """
+    {
+      "id": 1,
+      "batch_key": 1,
+      "signer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+    }
+    """
+    When the batch metadata change is validated
+    Then expect the error "timestamp cannot be empty: parse error"
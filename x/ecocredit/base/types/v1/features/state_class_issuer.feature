@@ -28,3 +28,40 @@ Feature: ClassIssuer
     """
     When the class issuer is validated
     Then expect the error "issuer: empty address string is not allowed: parse error"
+
+  Scenario: a valid class issuer with an issuance cap
+    Given the class issuer
+    """
+    {
+      "class_key": 1,
+      "issuer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "max_issuance": "1000",
+      "issued_amount": "100"
+    }
+    """
+    When the class issuer is validated
+    Then expect no error
+
+  Scenario: an error is returned if max issuance is not positive
+    Given the class issuer
+    """
+    {
+      "class_key": 1,
+      "issuer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "max_issuance": "0"
+    }
+    """
+    When the class issuer is validated
+    Then expect the error "max issuance: expected a positive decimal, got 0: invalid decimal string: parse error"
+
+  Scenario: an error is returned if issued amount is negative
+    Given the class issuer
+    """
+    {
+      "class_key": 1,
+      "issuer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "issued_amount": "-1"
+    }
+    """
+    When the class issuer is validated
+    Then expect the error "issued amount: expected a non-negative decimal, got -1: invalid decimal string: parse error"
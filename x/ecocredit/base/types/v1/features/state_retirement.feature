@@ -0,0 +1,143 @@
+Feature: Retirement
+
+  Scenario: a valid retirement
+    Given the retirement
+    """
+    {
+      "id": 1,
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "amount": "100",
+      "jurisdiction": "US-WA",
+      "timestamp": "2022-01-01T00:00:00Z"
+    }
+    """
+    When the retirement is validated
+    Then expect no error
+
+  Scenario: an error is returned if id is empty
+    Given the retirement
+    """
+    {}
+    """
+    When the retirement is validated
+    Then expect the error "id cannot be zero: parse error"
+
+  Scenario: an error is returned if owner is empty
+    Given the retirement
+    """
+    {
+      "id": 1
+    }
+    """
+    When the retirement is validated
+    Then expect the error "owner: empty address string is not allowed: parse error"
+
+  Scenario: an error is returned if batch key is empty
+    Given the retirement
+    """
+    {
+      "id": 1,
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+    }
+    """
+    When the retirement is validated
+    Then expect the error "batch key cannot be zero: parse error"
+
+  Scenario: an error is returned if amount is empty
+    Given the retirement
+    """
+    {
+      "id": 1,
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1
+    }
+    """
+    When the retirement is validated
+    Then expect the error "amount: expected a positive decimal, got : invalid decimal string: parse error"
+
+  Scenario: an error is returned if amount is not positive
+    Given the retirement
+    """
+    {
+      "id": 1,
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "amount": "0"
+    }
+    """
+    When the retirement is validated
+    Then expect the error "amount: expected a positive decimal, got 0: invalid decimal string: parse error"
+
+  Scenario: an error is returned if jurisdiction is empty
+    Given the retirement
+    """
+    {
+      "id": 1,
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "amount": "100"
+    }
+    """
+    When the retirement is validated
+    Then expect the error "jurisdiction: empty string is not allowed: parse error"
+
+  Scenario: an error is returned if jurisdiction is not formatted
+    Given the retirement
+    """
+    {
+      "id": 1,
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "amount": "100",
+      "jurisdiction": "foo"
+    }
+    """
+    When the retirement is validated
+    Then expect the error "jurisdiction: expected format <country-code>[-<region-code>[ <postal-code>]]: parse error"
+
+  Scenario: an error is returned if timestamp is empty
+    Given the retirement
+    """
+    {
+      "id": 1,
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "amount": "100",
+      "jurisdiction": "US-WA"
+    }
+    """
+    When the retirement is validated
+    Then expect the error "timestamp cannot be empty: parse error"
+
+  Scenario: an error is returned if reason exceeds 512 characters
+    Given the retirement
+    """
+    {
+      "id": 1,
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "amount": "100",
+      "jurisdiction": "US-WA",
+      "timestamp": "2020-01-01T00:00:00Z"
+    }
+    """
+    And reason with length "513"
+    When the retirement is validated
+    Then expect the error "reason cannot be more than 512 characters: parse error"
+
+  Scenario: an error is returned if beneficiary exceeds 256 characters
+    Given the retirement
+    """
+    {
+      "id": 1,
+      "owner": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "batch_key": 1,
+      "amount": "100",
+      "jurisdiction": "US-WA",
+      "timestamp": "2020-01-01T00:00:00Z"
+    }
+    """
+    And beneficiary with length "257"
+    When the retirement is validated
+    Then expect the error "beneficiary cannot be more than 256 characters: parse error"
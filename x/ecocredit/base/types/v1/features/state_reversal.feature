@@ -0,0 +1,155 @@
+Feature: Reversal
+
+  Scenario: a valid reversal
+    Given the reversal
+    """
+    {
+      "id": 1,
+      "class_key": 1,
+      "name": "2023 Tahoe fire",
+      "reason": "wildfire in the project area",
+      "signer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "credits": [
+        {
+          "batch_key": 1,
+          "amount": "100"
+        }
+      ],
+      "timestamp": "2022-01-01T00:00:00Z"
+    }
+    """
+    When the reversal is validated
+    Then expect no error
+
+  Scenario: an error is returned if id is empty
+    Given the reversal
+    """
+    {}
+    """
+    When the reversal is validated
+    Then expect the error "id cannot be zero: parse error"
+
+  Scenario: an error is returned if class key is empty
+    Given the reversal
+    """
+    {
+      "id": 1
+    }
+    """
+    When the reversal is validated
+    Then expect the error "class key cannot be zero: parse error"
+
+  Scenario: an error is returned if name is empty
+    Given the reversal
+    """
+    {
+      "id": 1,
+      "class_key": 1
+    }
+    """
+    When the reversal is validated
+    Then expect the error "name cannot be empty: parse error"
+
+  Scenario: an error is returned if name exceeds 256 characters
+    Given the reversal
+    """
+    {
+      "id": 1,
+      "class_key": 1
+    }
+    """
+    And name with length "257"
+    When the reversal is validated
+    Then expect the error "name cannot be more than 256 characters: parse error"
+
+  Scenario: an error is returned if reason exceeds 512 characters
+    Given the reversal
+    """
+    {
+      "id": 1,
+      "class_key": 1,
+      "name": "2023 Tahoe fire"
+    }
+    """
+    And reason with length "513"
+    When the reversal is validated
+    Then expect the error "reason cannot be more than 512 characters: parse error"
+
+  Scenario: an error is returned if signer is empty
+    Given the reversal
+    """
+    {
+      "id": 1,
+      "class_key": 1,
+      "name": "2023 Tahoe fire"
+    }
+    """
+    When the reversal is validated
+    Then expect the error "signer: empty address string is not allowed: parse error"
+
+  Scenario: an error is returned if credits is empty
+    Given the reversal
+    """
+    {
+      "id": 1,
+      "class_key": 1,
+      "name": "2023 Tahoe fire",
+      "signer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+    }
+    """
+    When the reversal is validated
+    Then expect the error "credits cannot be empty: parse error"
+
+  Scenario: an error is returned if credits batch key is empty
+    Given the reversal
+    """
+    {
+      "id": 1,
+      "class_key": 1,
+      "name": "2023 Tahoe fire",
+      "signer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "credits": [
+        {}
+      ]
+    }
+    """
+    When the reversal is validated
+    Then expect the error "credits[0]: batch key cannot be zero: parse error"
+
+  Scenario: an error is returned if credits amount is not positive
+    Given the reversal
+    """
+    {
+      "id": 1,
+      "class_key": 1,
+      "name": "2023 Tahoe fire",
+      "signer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "credits": [
+        {
+          "batch_key": 1,
+          "amount": "0"
+        }
+      ]
+    }
+    """
+    When the reversal is validated
+    Then expect the error "credits[0]: amount: expected a positive decimal, got 0: invalid decimal string: parse error"
+
+  Scenario: an error is returned if timestamp is empty
+    Given the reversal
+    """
+    {
+      "id": 1,
+      "class_key": 1,
+      "name": "2023 Tahoe fire",
+      "signer": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y=",
+      "credits": [
+        {
+          "batch_key": 1,
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the reversal is validated
+    Then expect the error "timestamp cannot be empty: parse error"
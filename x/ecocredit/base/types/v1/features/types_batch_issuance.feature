@@ -108,3 +108,29 @@ Feature: BatchIssuance
     """
     When the batch issuance is validated
     Then expect the error "retirement jurisdiction: expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
+
+  Scenario: an error is returned if issuance retirement reason exceeds 512 characters
+    Given the batch issuance
+    """
+    {
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "retired_amount": "100",
+      "retirement_jurisdiction": "US-WA"
+    }
+    """
+    And a retirement reason with length "513"
+    When the batch issuance is validated
+    Then expect the error "retirement reason: max length 512: limit exceeded"
+
+  Scenario: an error is returned if issuance retirement beneficiary exceeds 256 characters
+    Given the batch issuance
+    """
+    {
+      "recipient": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "retired_amount": "100",
+      "retirement_jurisdiction": "US-WA"
+    }
+    """
+    And a retirement beneficiary with length "257"
+    When the batch issuance is validated
+    Then expect the error "retirement beneficiary: max length 256: limit exceeded"
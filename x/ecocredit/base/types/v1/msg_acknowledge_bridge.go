@@ -0,0 +1,39 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var _ legacytx.LegacyMsg = &MsgAcknowledgeBridge{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgAcknowledgeBridge) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgAcknowledgeBridge) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAcknowledgeBridge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAcknowledgeBridge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Service); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("service: %s", err)
+	}
+
+	if m.RequestId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("request id cannot be zero")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgAcknowledgeBridge.
+func (m *MsgAcknowledgeBridge) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Service)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/eth"
+)
+
+var _ legacytx.LegacyMsg = &MsgAddAllowedBridgeContract{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgAddAllowedBridgeContract) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgAddAllowedBridgeContract) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAddAllowedBridgeContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAddAllowedBridgeContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrapf(err, "invalid authority address")
+	}
+	if m.ChainName == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("chain_name cannot be empty")
+	}
+	if m.Contract == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("contract cannot be empty")
+	}
+	if !eth.IsValidAddress(m.Contract) {
+		return sdkerrors.ErrInvalidAddress.Wrap("contract must be a valid ethereum address")
+	}
+	if !eth.IsValidChecksumAddress(m.Contract) {
+		return sdkerrors.ErrInvalidAddress.Wrapf(
+			"contract must be an EIP-55 checksum address: expected %s", eth.ToChecksumAddress(m.Contract),
+		)
+	}
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgAddAllowedBridgeContract.
+func (m *MsgAddAllowedBridgeContract) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var _ legacytx.LegacyMsg = &MsgAddNationalRegistry{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgAddNationalRegistry) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgAddNationalRegistry) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgAddNationalRegistry) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAddNationalRegistry) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Wrapf(err, "invalid authority address")
+	}
+
+	if err := validateCountryCode(m.Country); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("country: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Registry); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("registry: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgAddNationalRegistry.
+func (m *MsgAddNationalRegistry) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
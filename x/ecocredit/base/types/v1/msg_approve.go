@@ -0,0 +1,89 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgApprove{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgApprove) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgApprove) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgApprove) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgApprove) ValidateBasic() error {
+	if err := validateAllowanceParties(m.Owner, m.Spender); err != nil {
+		return err
+	}
+
+	if err := validateAllowanceScope(m.BatchDenom, m.ClassId); err != nil {
+		return err
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Amount); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("amount: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgApprove.
+func (m *MsgApprove) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// validateAllowanceParties validates the owner and spender of an allowance.
+func validateAllowanceParties(owner, spender string) error {
+	if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("owner: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(spender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("spender: %s", err)
+	}
+
+	if owner == spender {
+		return sdkerrors.ErrInvalidRequest.Wrap("owner and spender cannot be the same account")
+	}
+
+	return nil
+}
+
+// validateAllowanceScope validates that an allowance applies to either a
+// credit batch or a credit class.
+func validateAllowanceScope(batchDenom, classID string) error {
+	if batchDenom == "" && classID == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("batch denom or class id is required")
+	}
+
+	if batchDenom != "" && classID != "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("batch denom and class id cannot both be set")
+	}
+
+	if batchDenom != "" {
+		if err := base.ValidateBatchDenom(batchDenom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("batch denom: %s", err)
+		}
+	}
+
+	if classID != "" {
+		if err := base.ValidateClassID(classID); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("class id: %s", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/eth"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgBridgeReturn{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgBridgeReturn) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgBridgeReturn) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgBridgeReturn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgBridgeReturn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("issuer: %s", err)
+	}
+
+	if err := base.ValidateBatchDenom(m.BatchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("batch denom: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("recipient: %s", err)
+	}
+
+	if m.Amount == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("amount cannot be empty")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Amount); err != nil {
+		return errors.Wrap(err, "amount")
+	}
+
+	if m.OriginTx == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("origin tx cannot be empty")
+	}
+
+	if !eth.IsValidTxHash(m.OriginTx.Id) {
+		return sdkerrors.ErrInvalidRequest.Wrap("origin tx id must be a valid ethereum transaction hash")
+	}
+
+	if m.OriginTx.Contract == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("origin tx contract cannot be empty")
+	}
+
+	// basic origin tx validation (includes valid ethereum contract address)
+	return m.OriginTx.Validate()
+}
+
+// GetSigners returns the expected signers for MsgBridgeReturn.
+func (m *MsgBridgeReturn) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Issuer)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgFailBridge{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgFailBridge) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgFailBridge) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgFailBridge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgFailBridge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Service); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("service: %s", err)
+	}
+
+	if m.RequestId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("request id cannot be zero")
+	}
+
+	if len(m.Reason) > base.MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("reason: max length %d", base.MaxNoteLength)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgFailBridge.
+func (m *MsgFailBridge) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Service)
+	return []sdk.AccAddress{addr}
+}
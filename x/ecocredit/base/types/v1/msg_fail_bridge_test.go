@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgFailBridge struct {
+	t         gocuke.TestingT
+	msg       *MsgFailBridge
+	signBytes string
+	err       error
+}
+
+func TestMsgFailBridge(t *testing.T) {
+	gocuke.NewRunner(t, &msgFailBridge{}).Path("./features/msg_fail_bridge.feature").Run()
+}
+
+func (s *msgFailBridge) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgFailBridge) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgFailBridge{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgFailBridge) ReasonWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.Reason = strings.Repeat("x", int(length))
+}
+
+func (s *msgFailBridge) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgFailBridge) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgFailBridge) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *msgFailBridge) MessageSignBytesQueried() {
+	s.signBytes = string(s.msg.GetSignBytes())
+}
+
+func (s *msgFailBridge) ExpectTheSignBytes(expected gocuke.DocString) {
+	buffer := new(bytes.Buffer)
+	require.NoError(s.t, json.Compact(buffer, []byte(expected.Content)))
+	require.Equal(s.t, buffer.String(), s.signBytes)
+}
@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/eth"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgLinkBatchContract{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgLinkBatchContract) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgLinkBatchContract) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgLinkBatchContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgLinkBatchContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("admin: %s", err)
+	}
+
+	if err := base.ValidateBatchDenom(m.BatchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("batch denom: %s", err)
+	}
+
+	if m.Target == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("target cannot be empty")
+	}
+
+	if m.Contract == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("contract cannot be empty")
+	}
+
+	if !eth.IsValidAddress(m.Contract) {
+		return sdkerrors.ErrInvalidAddress.Wrap("contract must be a valid ethereum address")
+	}
+
+	// mixed-case contract addresses must be encoded with the EIP-55 checksum
+	if m.Contract != strings.ToLower(m.Contract) && !eth.IsValidChecksumAddress(m.Contract) {
+		return sdkerrors.ErrInvalidAddress.Wrap("contract has an invalid EIP-55 checksum")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgLinkBatchContract.
+func (m *MsgLinkBatchContract) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,56 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgMigrateProject{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgMigrateProject) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgMigrateProject) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgMigrateProject) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgMigrateProject) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("admin: %s", err)
+	}
+
+	if err := base.ValidateProjectID(m.ProjectId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("project id: %s", err)
+	}
+
+	if err := base.ValidateClassID(m.NewClassId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("new class id: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.NewClassAdmin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("new class admin: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgMigrateProject. The admin of
+// the new credit class is only included once if it is the same account as the
+// admin of the current credit class.
+func (m *MsgMigrateProject) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(m.Admin)
+	if m.NewClassAdmin == m.Admin {
+		return []sdk.AccAddress{admin}
+	}
+
+	newClassAdmin, _ := sdk.AccAddressFromBech32(m.NewClassAdmin)
+	return []sdk.AccAddress{admin, newClassAdmin}
+}
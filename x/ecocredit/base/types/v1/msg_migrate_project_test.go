@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgMigrateProject struct {
+	t         gocuke.TestingT
+	msg       *MsgMigrateProject
+	err       error
+	signBytes string
+}
+
+func TestMsgMigrateProject(t *testing.T) {
+	gocuke.NewRunner(t, &msgMigrateProject{}).Path("./features/msg_migrate_project.feature").Run()
+}
+
+func (s *msgMigrateProject) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgMigrateProject) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgMigrateProject{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgMigrateProject) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgMigrateProject) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgMigrateProject) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *msgMigrateProject) ExpectTheSigners(a string) {
+	var signers []string
+	for _, signer := range s.msg.GetSigners() {
+		signers = append(signers, signer.String())
+	}
+	require.Equal(s.t, strings.Fields(a), signers)
+}
+
+func (s *msgMigrateProject) MessageSignBytesQueried() {
+	s.signBytes = string(s.msg.GetSignBytes())
+}
+
+func (s *msgMigrateProject) ExpectTheSignBytes(expected gocuke.DocString) {
+	buffer := new(bytes.Buffer)
+	require.NoError(s.t, json.Compact(buffer, []byte(expected.Content)))
+	require.Equal(s.t, buffer.String(), s.signBytes)
+}
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgRejectBatch{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgRejectBatch) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgRejectBatch) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRejectBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRejectBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Approver); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("approver: %s", err)
+	}
+
+	if m.PendingBatchId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("pending batch id cannot be empty")
+	}
+
+	if len(m.Reason) > base.MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("reason: max length %d", base.MaxNoteLength)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgRejectBatch.
+func (m *MsgRejectBatch) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Approver)
+	return []sdk.AccAddress{addr}
+}
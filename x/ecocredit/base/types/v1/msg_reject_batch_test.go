@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgRejectBatch struct {
+	t         gocuke.TestingT
+	msg       *MsgRejectBatch
+	err       error
+	signBytes string
+}
+
+func TestMsgRejectBatch(t *testing.T) {
+	gocuke.NewRunner(t, &msgRejectBatch{}).Path("./features/msg_reject_batch.feature").Run()
+}
+
+func (s *msgRejectBatch) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgRejectBatch) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgRejectBatch{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgRejectBatch) AReasonWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.Reason = strings.Repeat("x", int(length))
+}
+
+func (s *msgRejectBatch) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgRejectBatch) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgRejectBatch) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *msgRejectBatch) MessageSignBytesQueried() {
+	s.signBytes = string(s.msg.GetSignBytes())
+}
+
+func (s *msgRejectBatch) ExpectTheSignBytes(expected gocuke.DocString) {
+	buffer := new(bytes.Buffer)
+	require.NoError(s.t, json.Compact(buffer, []byte(expected.Content)))
+	require.Equal(s.t, buffer.String(), s.signBytes)
+}
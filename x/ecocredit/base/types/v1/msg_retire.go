@@ -7,6 +7,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 
+	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/base"
 )
 
@@ -29,25 +30,39 @@ func (m *MsgRetire) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("owner: %s", err)
 	}
 
-	if len(m.Credits) == 0 {
+	return validateRetireCredits(m.Credits, m.Jurisdiction, m.Reason, m.Beneficiary)
+}
+
+// GetSigners returns the expected signers for MsgRetire.
+func (m *MsgRetire) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{addr}
+}
+
+// validateRetireCredits validates the credits and retirement information of
+// MsgRetire and MsgRetireFrom.
+func validateRetireCredits(credits []*Credits, jurisdiction, reason, beneficiary string) error {
+	if len(credits) == 0 {
 		return sdkerrors.ErrInvalidRequest.Wrap("credits cannot be empty")
 	}
 
-	for i, credits := range m.Credits {
-		if err := credits.Validate(); err != nil {
+	for i, credit := range credits {
+		if err := credit.Validate(); err != nil {
 			return errors.Wrapf(err, "credits[%d]", i)
 		}
 	}
 
-	if err := base.ValidateJurisdiction(m.Jurisdiction); err != nil {
+	if err := base.ValidateJurisdiction(jurisdiction); err != nil {
 		return sdkerrors.ErrInvalidRequest.Wrapf("jurisdiction: %s", err)
 	}
 
-	return nil
-}
+	if len(reason) > base.MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("reason: max length %d", base.MaxNoteLength)
+	}
 
-// GetSigners returns the expected signers for MsgRetire.
-func (m *MsgRetire) GetSigners() []sdk.AccAddress {
-	addr, _ := sdk.AccAddressFromBech32(m.Owner)
-	return []sdk.AccAddress{addr}
+	if len(beneficiary) > base.MaxBeneficiaryLength {
+		return ecocredit.ErrMaxLimit.Wrapf("beneficiary: max length %d", base.MaxBeneficiaryLength)
+	}
+
+	return nil
 }
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var _ legacytx.LegacyMsg = &MsgRetireFrom{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgRetireFrom) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgRetireFrom) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRetireFrom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRetireFrom) ValidateBasic() error {
+	if err := validateAllowanceParties(m.Owner, m.Spender); err != nil {
+		return err
+	}
+
+	return validateRetireCredits(m.Credits, m.Jurisdiction, m.Reason, m.Beneficiary)
+}
+
+// GetSigners returns the expected signers for MsgRetireFrom.
+func (m *MsgRetireFrom) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Spender)
+	return []sdk.AccAddress{addr}
+}
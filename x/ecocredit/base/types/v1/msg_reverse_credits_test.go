@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgReverseCredits struct {
+	t         gocuke.TestingT
+	msg       *MsgReverseCredits
+	err       error
+	signBytes string
+}
+
+func TestMsgReverseCredits(t *testing.T) {
+	gocuke.NewRunner(t, &msgReverseCredits{}).Path("./features/msg_reverse_credits.feature").Run()
+}
+
+func (s *msgReverseCredits) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgReverseCredits) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgReverseCredits{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgReverseCredits) ANameWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.Name = strings.Repeat("x", int(length))
+}
+
+func (s *msgReverseCredits) AReasonWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.Reason = strings.Repeat("x", int(length))
+}
+
+func (s *msgReverseCredits) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgReverseCredits) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgReverseCredits) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *msgReverseCredits) MessageSignBytesQueried() {
+	s.signBytes = string(s.msg.GetSignBytes())
+}
+
+func (s *msgReverseCredits) ExpectTheSignBytes(expected gocuke.DocString) {
+	buffer := new(bytes.Buffer)
+	require.NoError(s.t, json.Compact(buffer, []byte(expected.Content)))
+	require.Equal(s.t, buffer.String(), s.signBytes)
+}
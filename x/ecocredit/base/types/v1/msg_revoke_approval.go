@@ -0,0 +1,34 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var _ legacytx.LegacyMsg = &MsgRevokeApproval{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgRevokeApproval) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgRevokeApproval) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRevokeApproval) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRevokeApproval) ValidateBasic() error {
+	if err := validateAllowanceParties(m.Owner, m.Spender); err != nil {
+		return err
+	}
+
+	return validateAllowanceScope(m.BatchDenom, m.ClassId)
+}
+
+// GetSigners returns the expected signers for MsgRevokeApproval.
+func (m *MsgRevokeApproval) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{addr}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 
 	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/base"
 )
 
@@ -34,42 +35,55 @@ func (m *MsgSend) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("recipient: %s", err)
 	}
 
-	if len(m.Credits) == 0 {
+	return validateSendCredits(m.Credits)
+}
+
+// GetSigners returns the expected signers for MsgSend.
+func (m *MsgSend) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// validateSendCredits validates the credits of MsgSend and MsgSendFrom.
+func validateSendCredits(credits []*MsgSend_SendCredits) error {
+	if len(credits) == 0 {
 		return sdkerrors.ErrInvalidRequest.Wrap("credits cannot be empty")
 	}
 
-	for i, credits := range m.Credits {
+	for i, credit := range credits {
 		creditIndex := fmt.Sprintf("credits[%d]", i)
 
-		if err := base.ValidateBatchDenom(credits.BatchDenom); err != nil {
+		if err := base.ValidateBatchDenom(credit.BatchDenom); err != nil {
 			return sdkerrors.ErrInvalidRequest.Wrapf("%s: batch denom: %s", creditIndex, err)
 		}
 
-		if credits.TradableAmount == "" && credits.RetiredAmount == "" {
+		if credit.TradableAmount == "" && credit.RetiredAmount == "" {
 			return sdkerrors.ErrInvalidRequest.Wrap("tradable amount or retired amount required")
 		}
 
-		if _, err := math.NewNonNegativeDecFromString(credits.TradableAmount); err != nil {
+		if _, err := math.NewNonNegativeDecFromString(credit.TradableAmount); err != nil {
 			return err
 		}
 
-		retiredAmount, err := math.NewNonNegativeDecFromString(credits.RetiredAmount)
+		retiredAmount, err := math.NewNonNegativeDecFromString(credit.RetiredAmount)
 		if err != nil {
 			return err
 		}
 
 		if !retiredAmount.IsZero() {
-			if err = base.ValidateJurisdiction(credits.RetirementJurisdiction); err != nil {
+			if err = base.ValidateJurisdiction(credit.RetirementJurisdiction); err != nil {
 				return sdkerrors.ErrInvalidRequest.Wrapf("retirement jurisdiction: %s", err)
 			}
 		}
+
+		if len(credit.RetirementReason) > base.MaxNoteLength {
+			return ecocredit.ErrMaxLimit.Wrapf("%s: retirement reason: max length %d", creditIndex, base.MaxNoteLength)
+		}
+
+		if len(credit.RetirementBeneficiary) > base.MaxBeneficiaryLength {
+			return ecocredit.ErrMaxLimit.Wrapf("%s: retirement beneficiary: max length %d", creditIndex, base.MaxBeneficiaryLength)
+		}
 	}
 
 	return nil
 }
-
-// GetSigners returns the expected signers for MsgSend.
-func (m *MsgSend) GetSigners() []sdk.AccAddress {
-	addr, _ := sdk.AccAddressFromBech32(m.Sender)
-	return []sdk.AccAddress{addr}
-}
@@ -0,0 +1,39 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var _ legacytx.LegacyMsg = &MsgSendFrom{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgSendFrom) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgSendFrom) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSendFrom) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSendFrom) ValidateBasic() error {
+	if err := validateAllowanceParties(m.Owner, m.Spender); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("recipient: %s", err)
+	}
+
+	return validateSendCredits(m.Credits)
+}
+
+// GetSigners returns the expected signers for MsgSendFrom.
+func (m *MsgSendFrom) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Spender)
+	return []sdk.AccAddress{addr}
+}
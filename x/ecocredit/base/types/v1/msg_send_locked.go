@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var _ legacytx.LegacyMsg = &MsgSendLocked{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgSendLocked) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgSendLocked) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSendLocked) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSendLocked) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("sender: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("recipient: %s", err)
+	}
+
+	if m.Sender == m.Recipient {
+		return sdkerrors.ErrInvalidRequest.Wrap("sender and recipient cannot be the same account")
+	}
+
+	if len(m.Credits) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("credits cannot be empty")
+	}
+
+	for i, credit := range m.Credits {
+		if err := credit.Validate(); err != nil {
+			return errors.Wrapf(err, "credits[%d]", i)
+		}
+	}
+
+	if _, ok := LockType_name[int32(m.LockType)]; !ok || m.LockType == LockType_LOCK_TYPE_UNSPECIFIED {
+		return sdkerrors.ErrInvalidRequest.Wrapf("lock type: invalid lock type %d", m.LockType)
+	}
+
+	if m.EndTime == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("end time cannot be empty")
+	}
+
+	if m.StartTime != nil && !m.StartTime.Before(*m.EndTime) {
+		return sdkerrors.ErrInvalidRequest.Wrap("start time must be before end time")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgSendLocked.
+func (m *MsgSendLocked) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
@@ -3,6 +3,8 @@ package v1
 import (
 	"bytes"
 	"encoding/json"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/jsonpb"
@@ -31,6 +33,20 @@ func (s *msgSend) TheMessage(a gocuke.DocString) {
 	require.NoError(s.t, err)
 }
 
+func (s *msgSend) ARetirementReasonWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.Credits[0].RetirementReason = strings.Repeat("x", int(length))
+}
+
+func (s *msgSend) ARetirementBeneficiaryWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.Credits[0].RetirementBeneficiary = strings.Repeat("x", int(length))
+}
+
 func (s *msgSend) TheMessageIsValidated() {
 	s.err = s.msg.ValidateBasic()
 }
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgSetArticle6Authorization{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgSetArticle6Authorization) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgSetArticle6Authorization) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetArticle6Authorization) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetArticle6Authorization) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("signer: %s", err)
+	}
+
+	if err := base.ValidateBatchDenom(m.BatchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("batch denom: %s", err)
+	}
+
+	if err := base.ValidateJurisdiction(m.HostJurisdiction); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("host jurisdiction: %s", err)
+	}
+
+	if err := validateAuthorizationIRI(m.AuthorizationIri); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("authorization iri: %s", err)
+	}
+
+	if err := validateAdjustmentStatus(m.AdjustmentStatus); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("adjustment status: %s", err)
+	}
+
+	if err := validateAuthorizedUse(m.AuthorizedUse); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("authorized use: %s", err)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgSetArticle6Authorization.
+func (m *MsgSetArticle6Authorization) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Signer)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,62 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateBatchMetadata{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgUpdateBatchMetadata) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgUpdateBatchMetadata) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateBatchMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateBatchMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("issuer: %s", err)
+	}
+
+	if err := base.ValidateBatchDenom(m.BatchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("batch denom: %s", err)
+	}
+
+	if len(m.NewMetadata) > base.MaxMetadataLength {
+		return ecocredit.ErrMaxLimit.Wrapf("metadata: max length is %d", base.MaxMetadataLength)
+	}
+
+	if m.ClassAdmin != "" {
+		if _, err := sdk.AccAddressFromBech32(m.ClassAdmin); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("class admin: %s", err)
+		}
+
+		if m.ClassAdmin == m.Issuer {
+			return sdkerrors.ErrInvalidRequest.Wrap("class admin cannot be the same as issuer")
+		}
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateBatchMetadata. The class
+// admin is only a signer when the class admin co-signs the update.
+func (m *MsgUpdateBatchMetadata) GetSigners() []sdk.AccAddress {
+	issuer, _ := sdk.AccAddressFromBech32(m.Issuer)
+	if m.ClassAdmin == "" {
+		return []sdk.AccAddress{issuer}
+	}
+
+	admin, _ := sdk.AccAddressFromBech32(m.ClassAdmin)
+	return []sdk.AccAddress{issuer, admin}
+}
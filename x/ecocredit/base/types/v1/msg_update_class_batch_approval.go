@@ -0,0 +1,49 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateClassBatchApproval{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgUpdateClassBatchApproval) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgUpdateClassBatchApproval) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateClassBatchApproval) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateClassBatchApproval) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("admin: %s", err)
+	}
+
+	if err := base.ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("class id: %s", err)
+	}
+
+	if m.RequireApproval {
+		if err := validateExpiryPeriod(m.ExpiryPeriod); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("expiry period: %s", err)
+		}
+	} else if m.ExpiryPeriod != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("expiry period must be empty when approval is not required")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateClassBatchApproval.
+func (m *MsgUpdateClassBatchApproval) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{addr}
+}
@@ -7,6 +7,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 
+	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit/base"
 )
 
@@ -29,8 +30,10 @@ func (m *MsgUpdateClassIssuers) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("class id: %s", err)
 	}
 
-	if len(m.AddIssuers) == 0 && len(m.RemoveIssuers) == 0 {
-		return sdkerrors.ErrInvalidRequest.Wrap("must specify at least one of add_issuers or remove_issuers")
+	if len(m.AddIssuers) == 0 && len(m.RemoveIssuers) == 0 && len(m.IssuerCaps) == 0 && m.ProjectVintageCap == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(
+			"must specify at least one of add_issuers, remove_issuers, issuer_caps, or project_vintage_cap",
+		)
 	}
 
 	duplicateAddMap := make(map[string]bool)
@@ -63,9 +66,53 @@ func (m *MsgUpdateClassIssuers) ValidateBasic() error {
 		duplicateRemoveMap[issuer] = true
 	}
 
+	duplicateCapMap := make(map[string]bool)
+	for i, issuerCap := range m.IssuerCaps {
+		issuerCapIndex := fmt.Sprintf("issuer_caps[%d]", i)
+
+		if issuerCap == nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: cannot be empty", issuerCapIndex)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(issuerCap.Issuer); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("%s: issuer: %s", issuerCapIndex, err)
+		}
+
+		if _, ok := duplicateCapMap[issuerCap.Issuer]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: duplicate issuer", issuerCapIndex)
+		}
+
+		if _, ok := duplicateRemoveMap[issuerCap.Issuer]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: cannot set cap for removed issuer", issuerCapIndex)
+		}
+
+		if err := validateIssuanceCap(issuerCap.MaxIssuance); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: max issuance: %s", issuerCapIndex, err)
+		}
+
+		duplicateCapMap[issuerCap.Issuer] = true
+	}
+
+	if m.ProjectVintageCap != nil {
+		if err := validateIssuanceCap(m.ProjectVintageCap.MaxIssuance); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("project vintage cap: max issuance: %s", err)
+		}
+	}
+
 	return nil
 }
 
+// validateIssuanceCap checks that the issuance cap is either empty (no cap) or
+// a positive decimal.
+func validateIssuanceCap(maxIssuance string) error {
+	if maxIssuance == "" {
+		return nil
+	}
+
+	_, err := math.NewPositiveDecFromString(maxIssuance)
+	return err
+}
+
 func (m *MsgUpdateClassIssuers) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Admin)
 	return []sdk.AccAddress{addr}
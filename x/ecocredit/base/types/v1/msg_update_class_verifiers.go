@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateClassVerifiers{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgUpdateClassVerifiers) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgUpdateClassVerifiers) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateClassVerifiers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateClassVerifiers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("admin: %s", err)
+	}
+
+	if err := base.ValidateClassID(m.ClassId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("class id: %s", err)
+	}
+
+	if len(m.AddVerifiers) == 0 && len(m.RemoveVerifiers) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("must specify at least one of add_verifiers or remove_verifiers")
+	}
+
+	duplicateAddMap := make(map[string]bool)
+	for i, verifier := range m.AddVerifiers {
+		addVerifierIndex := fmt.Sprintf("add_verifiers[%d]", i)
+
+		if _, err := sdk.AccAddressFromBech32(verifier); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("%s: %s", addVerifierIndex, err)
+		}
+
+		if _, ok := duplicateAddMap[verifier]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: duplicate verifier", addVerifierIndex)
+		}
+
+		duplicateAddMap[verifier] = true
+	}
+
+	duplicateRemoveMap := make(map[string]bool)
+	for i, verifier := range m.RemoveVerifiers {
+		removeVerifierIndex := fmt.Sprintf("remove_verifiers[%d]", i)
+
+		if _, err := sdk.AccAddressFromBech32(verifier); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("%s: %s", removeVerifierIndex, err)
+		}
+
+		if _, ok := duplicateRemoveMap[verifier]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: duplicate verifier", removeVerifierIndex)
+		}
+
+		duplicateRemoveMap[verifier] = true
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateClassVerifiers.
+func (m *MsgUpdateClassVerifiers) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUpdateProjectStatus struct {
+	t         gocuke.TestingT
+	msg       *MsgUpdateProjectStatus
+	err       error
+	signBytes string
+}
+
+func TestMsgUpdateProjectStatus(t *testing.T) {
+	gocuke.NewRunner(t, &msgUpdateProjectStatus{}).Path("./features/msg_update_project_status.feature").Run()
+}
+
+func (s *msgUpdateProjectStatus) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUpdateProjectStatus) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUpdateProjectStatus{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUpdateProjectStatus) AReasonWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.Reason = strings.Repeat("x", int(length))
+}
+
+func (s *msgUpdateProjectStatus) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUpdateProjectStatus) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUpdateProjectStatus) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *msgUpdateProjectStatus) MessageSignBytesQueried() {
+	s.signBytes = string(s.msg.GetSignBytes())
+}
+
+func (s *msgUpdateProjectStatus) ExpectTheSignBytes(expected gocuke.DocString) {
+	buffer := new(bytes.Buffer)
+	require.NoError(s.t, json.Compact(buffer, []byte(expected.Content)))
+	require.Equal(s.t, buffer.String(), s.signBytes)
+}
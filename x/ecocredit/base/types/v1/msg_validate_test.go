@@ -0,0 +1,169 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+// legacyMsg is a pointer to a message type that implements the LegacyMsg
+// interface.
+type legacyMsg[T any] interface {
+	*T
+	legacytx.LegacyMsg
+}
+
+// msgSuite runs the validation and amino sign bytes scenarios of a message
+// feature file.
+type msgSuite[T any, M legacyMsg[T]] struct {
+	t         gocuke.TestingT
+	msg       M
+	err       error
+	signBytes string
+}
+
+func runMsgSuite[T any, M legacyMsg[T]](t *testing.T, path string) {
+	gocuke.NewRunner(t, &msgSuite[T, M]{}).Path(path).Run()
+}
+
+func TestMsgApprove(t *testing.T) {
+	runMsgSuite[MsgApprove](t, "./features/msg_approve.feature")
+}
+
+func TestMsgRetireFrom(t *testing.T) {
+	runMsgSuite[MsgRetireFrom](t, "./features/msg_retire_from.feature")
+}
+
+func TestMsgRevokeApproval(t *testing.T) {
+	runMsgSuite[MsgRevokeApproval](t, "./features/msg_revoke_approval.feature")
+}
+
+func TestMsgSendFrom(t *testing.T) {
+	runMsgSuite[MsgSendFrom](t, "./features/msg_send_from.feature")
+}
+
+func TestMsgSendLocked(t *testing.T) {
+	runMsgSuite[MsgSendLocked](t, "./features/msg_send_locked.feature")
+}
+
+func TestMsgUpdateClassBuffer(t *testing.T) {
+	runMsgSuite[MsgUpdateClassBuffer](t, "./features/msg_update_class_buffer.feature")
+}
+
+func TestMsgUpdateClassVerifiers(t *testing.T) {
+	runMsgSuite[MsgUpdateClassVerifiers](t, "./features/msg_update_class_verifiers.feature")
+}
+
+func TestMsgApproveBatch(t *testing.T) {
+	runMsgSuite[MsgApproveBatch](t, "./features/msg_approve_batch.feature")
+}
+
+func TestMsgProposeBatch(t *testing.T) {
+	runMsgSuite[MsgProposeBatch](t, "./features/msg_propose_batch.feature")
+}
+
+func TestMsgUpdateClassBatchApproval(t *testing.T) {
+	runMsgSuite[MsgUpdateClassBatchApproval](t, "./features/msg_update_class_batch_approval.feature")
+}
+
+func TestMsgUpdateClassBatchMetadataCosign(t *testing.T) {
+	runMsgSuite[MsgUpdateClassBatchMetadataCosign](t, "./features/msg_update_class_batch_metadata_cosign.feature")
+}
+
+func TestMsgUpdateClassStatus(t *testing.T) {
+	runMsgSuite[MsgUpdateClassStatus](t, "./features/msg_update_class_status.feature")
+}
+
+func TestMsgSetStrictJurisdictions(t *testing.T) {
+	runMsgSuite[MsgSetStrictJurisdictions](t, "./features/msg_set_strict_jurisdictions.feature")
+}
+
+func TestMsgAddNationalRegistry(t *testing.T) {
+	runMsgSuite[MsgAddNationalRegistry](t, "./features/msg_add_national_registry.feature")
+}
+
+func TestMsgRemoveNationalRegistry(t *testing.T) {
+	runMsgSuite[MsgRemoveNationalRegistry](t, "./features/msg_remove_national_registry.feature")
+}
+
+func TestMsgSetArticle6Authorization(t *testing.T) {
+	runMsgSuite[MsgSetArticle6Authorization](t, "./features/msg_set_article6_authorization.feature")
+}
+
+func TestMsgUpdateClassSerialization(t *testing.T) {
+	runMsgSuite[MsgUpdateClassSerialization](t, "./features/msg_update_class_serialization.feature")
+}
+
+func TestMsgBridgeReturn(t *testing.T) {
+	runMsgSuite[MsgBridgeReturn](t, "./features/msg_bridge_return.feature")
+}
+
+func TestMsgLinkBatchContract(t *testing.T) {
+	runMsgSuite[MsgLinkBatchContract](t, "./features/msg_link_batch_contract.feature")
+}
+
+func TestMsgAcknowledgeBridge(t *testing.T) {
+	runMsgSuite[MsgAcknowledgeBridge](t, "./features/msg_acknowledge_bridge.feature")
+}
+
+func TestMsgAddAllowedBridgeService(t *testing.T) {
+	runMsgSuite[MsgAddAllowedBridgeService](t, "./features/msg_add_allowed_bridge_service.feature")
+}
+
+func TestMsgRemoveAllowedBridgeService(t *testing.T) {
+	runMsgSuite[MsgRemoveAllowedBridgeService](t, "./features/msg_remove_allowed_bridge_service.feature")
+}
+
+func TestMsgAddAllowedBridgeContract(t *testing.T) {
+	runMsgSuite[MsgAddAllowedBridgeContract](t, "./features/msg_add_allowed_bridge_contract.feature")
+}
+
+func TestMsgRemoveAllowedBridgeContract(t *testing.T) {
+	runMsgSuite[MsgRemoveAllowedBridgeContract](t, "./features/msg_remove_allowed_bridge_contract.feature")
+}
+
+func TestMsgUnwrap(t *testing.T) {
+	runMsgSuite[MsgUnwrap](t, "./features/msg_unwrap.feature")
+}
+
+func TestMsgWrap(t *testing.T) {
+	runMsgSuite[MsgWrap](t, "./features/msg_wrap.feature")
+}
+
+func (s *msgSuite[T, M]) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgSuite[T, M]) TheMessage(a gocuke.DocString) {
+	s.msg = new(T)
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgSuite[T, M]) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgSuite[T, M]) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgSuite[T, M]) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *msgSuite[T, M]) MessageSignBytesQueried() {
+	s.signBytes = string(s.msg.GetSignBytes())
+}
+
+func (s *msgSuite[T, M]) ExpectTheSignBytes(expected gocuke.DocString) {
+	buffer := new(bytes.Buffer)
+	require.NoError(s.t, json.Compact(buffer, []byte(expected.Content)))
+	require.Equal(s.t, buffer.String(), s.signBytes)
+}
@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"strings"
+
+	"github.com/regen-network/regen-ledger/types/eth"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the AllowedBridgeContract state type
+func (m *AllowedBridgeContract) Validate() error {
+	if m.ChainName == "" {
+		return ecocredit.ErrParseFailure.Wrap("chain name cannot be empty")
+	}
+
+	if !eth.IsValidAddress(m.Contract) {
+		return ecocredit.ErrParseFailure.Wrap("contract must be a valid ethereum address")
+	}
+
+	if m.Contract != strings.ToLower(m.Contract) {
+		return ecocredit.ErrParseFailure.Wrap("contract must be lowercase")
+	}
+
+	return nil
+}
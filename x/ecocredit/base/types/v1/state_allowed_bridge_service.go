@@ -0,0 +1,20 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the AllowedBridgeService state type
+func (m *AllowedBridgeService) Validate() error {
+	if m.ChainName == "" {
+		return ecocredit.ErrParseFailure.Wrap("chain name cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Address).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("address: %s", err)
+	}
+
+	return nil
+}
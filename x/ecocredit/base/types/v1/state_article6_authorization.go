@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// Validate performs basic validation of the Article6Authorization state type
+func (m *Article6Authorization) Validate() error {
+	if m.BatchKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("batch key cannot be zero")
+	}
+
+	if err := base.ValidateJurisdiction(m.HostJurisdiction); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("host jurisdiction: %s", err)
+	}
+
+	if err := validateAuthorizationIRI(m.AuthorizationIri); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("authorization iri: %s", err)
+	}
+
+	if err := validateAdjustmentStatus(m.AdjustmentStatus); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("adjustment status: %s", err)
+	}
+
+	if err := validateAuthorizedUse(m.AuthorizedUse); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("authorized use: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Authorizer).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("authorizer: %s", err)
+	}
+
+	return nil
+}
+
+// validateAuthorizationIRI checks that the IRI is formatted as an IRI of data
+// anchored in the data module. Whether the data has actually been anchored is
+// checked when the authorization is set.
+func validateAuthorizationIRI(iri string) error {
+	if iri == "" {
+		return fmt.Errorf("empty string is not allowed")
+	}
+
+	if len(iri) > base.MaxMetadataLength {
+		return fmt.Errorf("must not be longer than %d characters", base.MaxMetadataLength)
+	}
+
+	if !strings.HasPrefix(iri, "regen:") {
+		return fmt.Errorf("expected regen: prefix")
+	}
+
+	return nil
+}
+
+// validateAdjustmentStatus checks that the adjustment status is a known status
+// other than the unspecified status.
+func validateAdjustmentStatus(status AdjustmentStatus) error {
+	if status == AdjustmentStatus_ADJUSTMENT_STATUS_UNSPECIFIED {
+		return fmt.Errorf("cannot be unspecified")
+	}
+
+	if _, ok := AdjustmentStatus_name[int32(status)]; !ok {
+		return fmt.Errorf("unknown adjustment status %d", status)
+	}
+
+	return nil
+}
+
+// validateAuthorizedUse checks that the authorized use is a known use other
+// than the unspecified use.
+func validateAuthorizedUse(use AuthorizedUse) error {
+	if use == AuthorizedUse_AUTHORIZED_USE_UNSPECIFIED {
+		return fmt.Errorf("cannot be unspecified")
+	}
+
+	if _, ok := AuthorizedUse_name[int32(use)]; !ok {
+		return fmt.Errorf("unknown authorized use %d", use)
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the BatchAllowance state type
+func (m *BatchAllowance) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Owner).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("owner: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Spender).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("spender: %s", err)
+	}
+
+	if m.BatchKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("batch key cannot be zero")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Amount); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("amount: %s", err)
+	}
+
+	return nil
+}
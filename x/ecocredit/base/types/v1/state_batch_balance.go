@@ -28,5 +28,12 @@ func (m *BatchBalance) Validate() error {
 		return ecocredit.ErrParseFailure.Wrapf("escrowed amount: %s", err)
 	}
 
+	// locked amount was added in a later revision and may be empty
+	if m.LockedAmount != "" {
+		if _, err := math.NewNonNegativeDecFromString(m.LockedAmount); err != nil {
+			return ecocredit.ErrParseFailure.Wrapf("locked amount: %s", err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,28 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the BatchBalanceIndex state type
+func (m *BatchBalanceIndex) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Address).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("address: %s", err)
+	}
+
+	if m.BatchKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("batch key cannot be zero")
+	}
+
+	if m.ClassKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("class key cannot be zero")
+	}
+
+	if m.ProjectKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("project key cannot be zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// Validate performs basic validation of the BatchMetadataChange state type
+func (m *BatchMetadataChange) Validate() error {
+	if m.Id == 0 {
+		return ecocredit.ErrParseFailure.Wrap("id cannot be zero")
+	}
+
+	if m.BatchKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("batch key cannot be zero")
+	}
+
+	if len(m.OldMetadata) > base.MaxMetadataLength {
+		return ecocredit.ErrParseFailure.Wrapf("old metadata cannot be more than %d characters", base.MaxMetadataLength)
+	}
+
+	if len(m.NewMetadata) > base.MaxMetadataLength {
+		return ecocredit.ErrParseFailure.Wrapf("new metadata cannot be more than %d characters", base.MaxMetadataLength)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Signer).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("signer: %s", err)
+	}
+
+	if m.Timestamp == nil {
+		return ecocredit.ErrParseFailure.Wrap("timestamp cannot be empty")
+	}
+
+	return nil
+}
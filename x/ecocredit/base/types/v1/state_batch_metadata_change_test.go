@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type batchMetadataChange struct {
+	t                   gocuke.TestingT
+	batchMetadataChange *BatchMetadataChange
+	err                 error
+}
+
+func TestBatchMetadataChange(t *testing.T) {
+	gocuke.NewRunner(t, &batchMetadataChange{}).Path("./features/state_batch_metadata_change.feature").Run()
+}
+
+func (s *batchMetadataChange) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *batchMetadataChange) TheBatchMetadataChange(a gocuke.DocString) {
+	s.batchMetadataChange = &BatchMetadataChange{}
+	err := jsonpb.UnmarshalString(a.Content, s.batchMetadataChange)
+	require.NoError(s.t, err)
+}
+
+func (s *batchMetadataChange) OldMetadataWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.batchMetadataChange.OldMetadata = strings.Repeat("x", int(length))
+}
+
+func (s *batchMetadataChange) NewMetadataWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.batchMetadataChange.NewMetadata = strings.Repeat("x", int(length))
+}
+
+func (s *batchMetadataChange) TheBatchMetadataChangeIsValidated() {
+	s.err = s.batchMetadataChange.Validate()
+}
+
+func (s *batchMetadataChange) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *batchMetadataChange) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the BatchSerialSequence state type
+func (m *BatchSerialSequence) Validate() error {
+	if m.BatchKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("batch key cannot be zero")
+	}
+
+	if m.NextSerial == 0 {
+		return ecocredit.ErrParseFailure.Wrap("next serial cannot be zero")
+	}
+
+	return nil
+}
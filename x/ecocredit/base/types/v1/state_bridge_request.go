@@ -0,0 +1,53 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the BridgeRequest state type
+func (m *BridgeRequest) Validate() error {
+	if m.Id == 0 {
+		return ecocredit.ErrParseFailure.Wrap("id cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Owner).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("owner: %s", err)
+	}
+
+	if m.BatchKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("batch key cannot be zero")
+	}
+
+	if m.Target == "" {
+		return ecocredit.ErrParseFailure.Wrap("target cannot be empty")
+	}
+
+	if m.Recipient == "" {
+		return ecocredit.ErrParseFailure.Wrap("recipient cannot be empty")
+	}
+
+	if m.Contract == "" {
+		return ecocredit.ErrParseFailure.Wrap("contract cannot be empty")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Amount); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("amount: %s", err)
+	}
+
+	if m.Status == uint32(BridgeRequestStatus_BRIDGE_REQUEST_STATUS_UNSPECIFIED) {
+		return ecocredit.ErrParseFailure.Wrap("status cannot be unspecified")
+	}
+
+	if _, ok := BridgeRequestStatus_name[int32(m.Status)]; !ok {
+		return ecocredit.ErrParseFailure.Wrapf("unknown status %d", m.Status)
+	}
+
+	if m.Timestamp == nil {
+		return ecocredit.ErrParseFailure.Wrap("timestamp cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"fmt"
+
+	gogotypes "github.com/gogo/protobuf/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the ClassBatchApproval state type
+func (m *ClassBatchApproval) Validate() error {
+	if m.ClassKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("class key cannot be zero")
+	}
+
+	if err := validateExpiryPeriod(m.ExpiryPeriod); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("expiry period: %s", err)
+	}
+
+	return nil
+}
+
+// validateExpiryPeriod checks that the expiry period of pending batches is a
+// valid positive duration.
+func validateExpiryPeriod(period *gogotypes.Duration) error {
+	if period == nil {
+		return fmt.Errorf("cannot be empty")
+	}
+
+	d, err := gogotypes.DurationFromProto(period)
+	if err != nil {
+		return err
+	}
+
+	if d <= 0 {
+		return fmt.Errorf("must be positive")
+	}
+
+	return nil
+}
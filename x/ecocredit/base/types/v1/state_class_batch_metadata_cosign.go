@@ -0,0 +1,14 @@
+package v1
+
+import (
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the ClassBatchMetadataCosign state type
+func (m *ClassBatchMetadataCosign) Validate() error {
+	if m.ClassKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("class key cannot be zero")
+	}
+
+	return nil
+}
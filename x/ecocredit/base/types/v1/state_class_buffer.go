@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"fmt"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// maxBufferPercentage is the maximum buffer percentage of a credit class.
+var maxBufferPercentage = math.NewDecFromInt64(100)
+
+// Validate performs basic validation of the ClassBuffer state type
+func (m *ClassBuffer) Validate() error {
+	if m.ClassKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("class key cannot be zero")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Percentage); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("percentage: %s", err)
+	}
+
+	if err := validateBufferPercentage(m.Percentage); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("percentage: %s", err)
+	}
+
+	return nil
+}
+
+// validateBufferPercentage checks that the buffer percentage is a decimal
+// between 0 and 100.
+func validateBufferPercentage(percentage string) error {
+	dec, err := math.NewNonNegativeDecFromString(percentage)
+	if err != nil {
+		return err
+	}
+
+	if dec.Cmp(maxBufferPercentage) == 1 {
+		return fmt.Errorf("must not be greater than %s", maxBufferPercentage)
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the ClassIssuanceCap state type
+func (m *ClassIssuanceCap) Validate() error {
+	if m.ClassKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("class key cannot be zero")
+	}
+
+	if err := validateIssuanceCap(m.MaxProjectVintageIssuance); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("max project vintage issuance: %s", err)
+	}
+
+	return nil
+}
@@ -2,6 +2,8 @@ package v1
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 )
 
@@ -15,5 +17,15 @@ func (m *ClassIssuer) Validate() error {
 		return ecocredit.ErrParseFailure.Wrapf("issuer: %s", err)
 	}
 
+	if err := validateIssuanceCap(m.MaxIssuance); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("max issuance: %s", err)
+	}
+
+	if m.IssuedAmount != "" {
+		if _, err := math.NewNonNegativeDecFromString(m.IssuedAmount); err != nil {
+			return ecocredit.ErrParseFailure.Wrapf("issued amount: %s", err)
+		}
+	}
+
 	return nil
 }
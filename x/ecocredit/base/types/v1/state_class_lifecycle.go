@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"fmt"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the ClassLifecycle state type
+func (m *ClassLifecycle) Validate() error {
+	if m.ClassKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("class key cannot be zero")
+	}
+
+	if err := validateClassStatus(ClassStatus(m.Status)); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("status: %s", err)
+	}
+
+	return nil
+}
+
+// validateClassStatus checks that the class status is a known status other
+// than the unspecified status.
+func validateClassStatus(status ClassStatus) error {
+	if status == ClassStatus_CLASS_STATUS_UNSPECIFIED {
+		return fmt.Errorf("cannot be unspecified")
+	}
+
+	if _, ok := ClassStatus_name[int32(status)]; !ok {
+		return fmt.Errorf("unknown class status %d", status)
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package v1
+
+import (
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the ClassSerialization state type
+func (m *ClassSerialization) Validate() error {
+	if m.ClassKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("class key cannot be zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the ClassVerifier state type
+func (m *ClassVerifier) Validate() error {
+	if m.ClassKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("class key cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Verifier).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("verifier: %s", err)
+	}
+
+	return nil
+}
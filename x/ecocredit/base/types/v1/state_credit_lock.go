@@ -0,0 +1,67 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the CreditLock state type
+func (m *CreditLock) Validate() error {
+	if m.Id == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("id cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Owner).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("owner: %s", err)
+	}
+
+	if m.BatchKey == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("batch key cannot be zero")
+	}
+
+	if _, ok := LockType_name[int32(m.LockType)]; !ok || m.LockType == LockType_LOCK_TYPE_UNSPECIFIED {
+		return ecocredit.ErrParseFailure.Wrapf("lock type: invalid lock type %d", m.LockType)
+	}
+
+	amount, err := math.NewPositiveDecFromString(m.Amount)
+	if err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("amount: %s", err)
+	}
+
+	unlocked, err := math.NewNonNegativeDecFromString(m.UnlockedAmount)
+	if err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("unlocked amount: %s", err)
+	}
+
+	if unlocked.Cmp(amount) >= 0 {
+		return ecocredit.ErrParseFailure.Wrapf("unlocked amount must be less than amount")
+	}
+
+	if m.StartTime == nil {
+		return ecocredit.ErrParseFailure.Wrapf("start time cannot be empty")
+	}
+
+	if m.EndTime == nil {
+		return ecocredit.ErrParseFailure.Wrapf("end time cannot be empty")
+	}
+
+	if m.EndTime.Compare(*m.StartTime) != 1 {
+		return ecocredit.ErrParseFailure.Wrapf("start time must be before end time")
+	}
+
+	if m.UnlockTime == nil {
+		return ecocredit.ErrParseFailure.Wrapf("unlock time cannot be empty")
+	}
+
+	if m.LockType == LockType_LOCK_TYPE_CLIFF && !m.UnlockTime.Equal(*m.EndTime) {
+		return ecocredit.ErrParseFailure.Wrapf("unlock time must equal end time of a cliff lock")
+	}
+
+	if m.LockType == LockType_LOCK_TYPE_LINEAR && !m.UnlockTime.Equal(*m.StartTime) {
+		return ecocredit.ErrParseFailure.Wrapf("unlock time must equal start time of a linear lock")
+	}
+
+	return nil
+}
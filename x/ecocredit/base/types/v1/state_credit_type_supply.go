@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"cosmossdk.io/errors"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// Validate performs basic validation of the CreditTypeSupply state type
+func (m *CreditTypeSupply) Validate() error {
+	if err := base.ValidateCreditTypeAbbreviation(m.CreditTypeAbbrev); err != nil {
+		return errors.Wrap(err, "credit type abbrev") // returns parse error
+	}
+
+	if _, err := math.NewNonNegativeDecFromString(m.TradableAmount); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("tradable amount: %s", err)
+	}
+
+	if _, err := math.NewNonNegativeDecFromString(m.RetiredAmount); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("retired amount: %s", err)
+	}
+
+	if _, err := math.NewNonNegativeDecFromString(m.CancelledAmount); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("cancelled amount: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"fmt"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var regexCountryCode = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// Validate performs basic validation of the NationalRegistry state type
+func (m *NationalRegistry) Validate() error {
+	if err := validateCountryCode(m.Country); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("country: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Address).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("address: %s", err)
+	}
+
+	return nil
+}
+
+// validateCountryCode checks that the country code is formatted as an ISO
+// 3166-1 alpha-2 country code.
+func validateCountryCode(country string) error {
+	if country == "" {
+		return fmt.Errorf("empty string is not allowed")
+	}
+
+	if !regexCountryCode.MatchString(country) {
+		return fmt.Errorf("expected format <country-code>")
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package v1
+
+import (
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the NativeBatchContract state type
+func (m *NativeBatchContract) Validate() error {
+	if m.BatchKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("batch key cannot be zero")
+	}
+
+	if m.Target == "" {
+		return ecocredit.ErrParseFailure.Wrap("target cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// Validate performs basic validation of the PendingBatch state type
+func (m *PendingBatch) Validate() error {
+	if m.Id == 0 {
+		return ecocredit.ErrParseFailure.Wrap("id cannot be zero")
+	}
+
+	if m.ClassKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("class key cannot be zero")
+	}
+
+	if m.ProjectKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("project key cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Issuer).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("issuer: %s", err)
+	}
+
+	if len(m.Issuance) == 0 {
+		return ecocredit.ErrParseFailure.Wrap("issuance cannot be empty")
+	}
+
+	for i, issuance := range m.Issuance {
+		if err := issuance.toBatchIssuance().Validate(); err != nil {
+			return ecocredit.ErrParseFailure.Wrapf("issuance[%d]: %s", i, err)
+		}
+	}
+
+	if len(m.Metadata) > base.MaxMetadataLength {
+		return ecocredit.ErrParseFailure.Wrapf("metadata cannot be more than %d characters", base.MaxMetadataLength)
+	}
+
+	if m.StartDate == nil {
+		return ecocredit.ErrParseFailure.Wrap("start date cannot be empty")
+	}
+
+	if m.EndDate == nil {
+		return ecocredit.ErrParseFailure.Wrap("end date cannot be empty")
+	}
+
+	if m.StartDate.Compare(*m.EndDate) == 1 {
+		return ecocredit.ErrParseFailure.Wrap("start date cannot be after end date")
+	}
+
+	if m.OriginTx != nil {
+		if err := m.OriginTx.toOriginTx().Validate(); err != nil {
+			return ecocredit.ErrParseFailure.Wrapf("origin tx: %s", err)
+		}
+	}
+
+	if m.Expiration == nil {
+		return ecocredit.ErrParseFailure.Wrap("expiration cannot be empty")
+	}
+
+	return nil
+}
+
+func (m *PendingBatch_Issuance) toBatchIssuance() *BatchIssuance {
+	return &BatchIssuance{
+		Recipient:              m.Recipient,
+		TradableAmount:         m.TradableAmount,
+		RetiredAmount:          m.RetiredAmount,
+		RetirementJurisdiction: m.RetirementJurisdiction,
+		RetirementReason:       m.RetirementReason,
+		RetirementBeneficiary:  m.RetirementBeneficiary,
+	}
+}
+
+func (m *PendingBatch_OriginTx) toOriginTx() *OriginTx {
+	return &OriginTx{
+		Id:       m.Id,
+		Source:   m.Source,
+		Contract: m.Contract,
+		Note:     m.Note,
+	}
+}
@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"cosmossdk.io/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// Validate performs basic validation of the ProjectAlias state type
+func (m *ProjectAlias) Validate() error {
+	if err := base.ValidateProjectID(m.Alias); err != nil {
+		return errors.Wrap(err, "alias") // returns parse error
+	}
+
+	if m.ProjectKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("project key cannot be zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the ProjectHolder state type
+func (m *ProjectHolder) Validate() error {
+	if m.ProjectKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("project key cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Address).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("address: %s", err)
+	}
+
+	return nil
+}
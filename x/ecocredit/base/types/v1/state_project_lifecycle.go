@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"fmt"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the ProjectLifecycle state type
+func (m *ProjectLifecycle) Validate() error {
+	if m.ProjectKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("project key cannot be zero")
+	}
+
+	if err := validateProjectStatus(ProjectStatus(m.Status)); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("status: %s", err)
+	}
+
+	return nil
+}
+
+// validateProjectStatus checks that the project status is a known status other
+// than the unspecified status.
+func validateProjectStatus(status ProjectStatus) error {
+	if status == ProjectStatus_PROJECT_STATUS_UNSPECIFIED {
+		return fmt.Errorf("cannot be unspecified")
+	}
+
+	if _, ok := ProjectStatus_name[int32(status)]; !ok {
+		return fmt.Errorf("unknown project status %d", status)
+	}
+
+	return nil
+}
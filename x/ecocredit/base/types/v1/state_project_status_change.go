@@ -0,0 +1,37 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// Validate performs basic validation of the ProjectStatusChange state type
+func (m *ProjectStatusChange) Validate() error {
+	if m.Id == 0 {
+		return ecocredit.ErrParseFailure.Wrap("id cannot be zero")
+	}
+
+	if m.ProjectKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("project key cannot be zero")
+	}
+
+	if err := validateProjectStatus(m.Status); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("status: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Signer).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("signer: %s", err)
+	}
+
+	if len(m.Reason) > base.MaxNoteLength {
+		return ecocredit.ErrParseFailure.Wrapf("reason cannot be more than %d characters", base.MaxNoteLength)
+	}
+
+	if m.Timestamp == nil {
+		return ecocredit.ErrParseFailure.Wrap("timestamp cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the ProjectSupply state type
+func (m *ProjectSupply) Validate() error {
+	if m.ProjectKey == 0 {
+		return ecocredit.ErrParseFailure.Wrapf("project key cannot be zero")
+	}
+
+	if _, err := math.NewNonNegativeDecFromString(m.TradableAmount); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("tradable amount: %s", err)
+	}
+
+	if _, err := math.NewNonNegativeDecFromString(m.RetiredAmount); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("retired amount: %s", err)
+	}
+
+	if _, err := math.NewNonNegativeDecFromString(m.CancelledAmount); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("cancelled amount: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the ProjectVintageIssuance state type
+func (m *ProjectVintageIssuance) Validate() error {
+	if m.ProjectKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("project key cannot be zero")
+	}
+
+	if m.VintageYear == 0 {
+		return ecocredit.ErrParseFailure.Wrap("vintage year cannot be zero")
+	}
+
+	if _, err := math.NewNonNegativeDecFromString(m.IssuedAmount); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("issued amount: %s", err)
+	}
+
+	return nil
+}
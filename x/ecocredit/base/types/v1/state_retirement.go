@@ -0,0 +1,48 @@
+package v1
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// Validate performs basic validation of the Retirement state type
+func (m *Retirement) Validate() error {
+	if m.Id == 0 {
+		return ecocredit.ErrParseFailure.Wrap("id cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Owner).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("owner: %s", err)
+	}
+
+	if m.BatchKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("batch key cannot be zero")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Amount); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("amount: %s", err)
+	}
+
+	if err := base.ValidateJurisdiction(m.Jurisdiction); err != nil {
+		return errors.Wrap(err, "jurisdiction") // returns parse error
+	}
+
+	if m.Timestamp == nil {
+		return ecocredit.ErrParseFailure.Wrap("timestamp cannot be empty")
+	}
+
+	if len(m.Reason) > base.MaxNoteLength {
+		return ecocredit.ErrParseFailure.Wrapf("reason cannot be more than %d characters", base.MaxNoteLength)
+	}
+
+	if len(m.Beneficiary) > base.MaxBeneficiaryLength {
+		return ecocredit.ErrParseFailure.Wrapf("beneficiary cannot be more than %d characters", base.MaxBeneficiaryLength)
+	}
+
+	return nil
+}
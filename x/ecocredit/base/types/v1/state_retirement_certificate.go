@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the RetirementCertificate state type
+func (m *RetirementCertificate) Validate() error {
+	if m.RetirementId == 0 {
+		return ecocredit.ErrParseFailure.Wrap("retirement id cannot be zero")
+	}
+
+	if err := nft.ValidateClassID(m.NftClassId); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("nft class id: %s", err)
+	}
+
+	if err := nft.ValidateNFTID(m.NftId); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("nft id: %s", err)
+	}
+
+	return nil
+}
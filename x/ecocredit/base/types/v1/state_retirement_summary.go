@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// Validate performs basic validation of the RetirementSummary state type
+func (m *RetirementSummary) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Owner).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("owner: %s", err)
+	}
+
+	if m.Year == 0 {
+		return ecocredit.ErrParseFailure.Wrap("year cannot be zero")
+	}
+
+	if err := base.ValidateCreditTypeAbbreviation(m.CreditTypeAbbrev); err != nil {
+		return errors.Wrap(err, "credit type abbrev") // returns parse error
+	}
+
+	if err := base.ValidateJurisdiction(m.Jurisdiction); err != nil {
+		return errors.Wrap(err, "jurisdiction") // returns parse error
+	}
+
+	if _, err := math.NewNonNegativeDecFromString(m.Amount); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("amount: %s", err)
+	}
+
+	return nil
+}
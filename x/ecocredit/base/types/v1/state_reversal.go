@@ -0,0 +1,56 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// Validate performs basic validation of the Reversal state type
+func (m *Reversal) Validate() error {
+	if m.Id == 0 {
+		return ecocredit.ErrParseFailure.Wrap("id cannot be zero")
+	}
+
+	if m.ClassKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("class key cannot be zero")
+	}
+
+	if m.Name == "" {
+		return ecocredit.ErrParseFailure.Wrap("name cannot be empty")
+	}
+
+	if len(m.Name) > base.MaxReversalNameLength {
+		return ecocredit.ErrParseFailure.Wrapf("name cannot be more than %d characters", base.MaxReversalNameLength)
+	}
+
+	if len(m.Reason) > base.MaxNoteLength {
+		return ecocredit.ErrParseFailure.Wrapf("reason cannot be more than %d characters", base.MaxNoteLength)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Signer).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("signer: %s", err)
+	}
+
+	if len(m.Credits) == 0 {
+		return ecocredit.ErrParseFailure.Wrap("credits cannot be empty")
+	}
+
+	for i, credits := range m.Credits {
+		if credits.BatchKey == 0 {
+			return ecocredit.ErrParseFailure.Wrapf("credits[%d]: batch key cannot be zero", i)
+		}
+
+		if _, err := math.NewPositiveDecFromString(credits.Amount); err != nil {
+			return ecocredit.ErrParseFailure.Wrapf("credits[%d]: amount: %s", i, err)
+		}
+	}
+
+	if m.Timestamp == nil {
+		return ecocredit.ErrParseFailure.Wrap("timestamp cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type reversal struct {
+	t        gocuke.TestingT
+	reversal *Reversal
+	err      error
+}
+
+func TestReversal(t *testing.T) {
+	gocuke.NewRunner(t, &reversal{}).Path("./features/state_reversal.feature").Run()
+}
+
+func (s *reversal) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *reversal) TheReversal(a gocuke.DocString) {
+	s.reversal = &Reversal{}
+	err := jsonpb.UnmarshalString(a.Content, s.reversal)
+	require.NoError(s.t, err)
+}
+
+func (s *reversal) NameWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.reversal.Name = strings.Repeat("x", int(length))
+}
+
+func (s *reversal) ReasonWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.reversal.Reason = strings.Repeat("x", int(length))
+}
+
+func (s *reversal) TheReversalIsValidated() {
+	s.err = s.reversal.Validate()
+}
+
+func (s *reversal) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *reversal) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
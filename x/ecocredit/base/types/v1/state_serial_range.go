@@ -0,0 +1,36 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the SerialRange state type
+func (m *SerialRange) Validate() error {
+	if m.BatchKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("batch key cannot be zero")
+	}
+
+	if m.Start == 0 {
+		return ecocredit.ErrParseFailure.Wrap("start cannot be zero")
+	}
+
+	if m.End < m.Start {
+		return ecocredit.ErrParseFailure.Wrapf("end %d cannot be less than start %d", m.End, m.Start)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sdk.AccAddress(m.Owner).String()); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("owner: %s", err)
+	}
+
+	if m.Status == uint32(SerialStatus_SERIAL_STATUS_UNSPECIFIED) {
+		return ecocredit.ErrParseFailure.Wrap("status cannot be unspecified")
+	}
+
+	if _, ok := SerialStatus_name[int32(m.Status)]; !ok {
+		return ecocredit.ErrParseFailure.Wrapf("unknown status %d", m.Status)
+	}
+
+	return nil
+}
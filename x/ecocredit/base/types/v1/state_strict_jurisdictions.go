@@ -0,0 +1,6 @@
+package v1
+
+// Validate performs basic validation of the StrictJurisdictions state type
+func (m *StrictJurisdictions) Validate() error {
+	return nil
+}
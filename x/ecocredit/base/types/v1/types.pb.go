@@ -9,6 +9,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	types1 "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -184,6 +185,19 @@ type BatchIssuance struct {
 	// sub-national-code and postal-code are optional and can be added for
 	// increased precision.
 	RetirementJurisdiction string `protobuf:"bytes,4,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// retirement_reason is the optional reason for retiring the credits (e.g.
+	// the sustainability claim the retirement is used for) and is only used if
+	// retired_amount is positive. The reason can be up to 512 characters.
+	//
+	// Since Revision 1
+	RetirementReason string `protobuf:"bytes,5,opt,name=retirement_reason,json=retirementReason,proto3" json:"retirement_reason,omitempty"`
+	// retirement_beneficiary is the optional beneficiary on whose behalf the
+	// credits are retired and is only used if retired_amount is positive. The
+	// beneficiary can be either an address or a free-text name and can be up to
+	// 256 characters.
+	//
+	// Since Revision 1
+	RetirementBeneficiary string `protobuf:"bytes,6,opt,name=retirement_beneficiary,json=retirementBeneficiary,proto3" json:"retirement_beneficiary,omitempty"`
 }
 
 func (m *BatchIssuance) Reset()         { *m = BatchIssuance{} }
@@ -247,6 +261,20 @@ func (m *BatchIssuance) GetRetirementJurisdiction() string {
 	return ""
 }
 
+func (m *BatchIssuance) GetRetirementReason() string {
+	if m != nil {
+		return m.RetirementReason
+	}
+	return ""
+}
+
+func (m *BatchIssuance) GetRetirementBeneficiary() string {
+	if m != nil {
+		return m.RetirementBeneficiary
+	}
+	return ""
+}
+
 // OriginTx is the transaction from another chain or registry that triggered
 // the minting of credits.
 type OriginTx struct {
@@ -394,56 +422,161 @@ func (m *CreditTypeProposal) GetCreditType() *CreditType {
 	return nil
 }
 
+// RetirementCertificateData is the data attached to the x/nft token minted as
+// a retirement certificate.
+//
+// Since Revision 1
+type RetirementCertificateData struct {
+	// retirement_id is the unique identifier of the retirement.
+	RetirementId uint64 `protobuf:"varint,1,opt,name=retirement_id,json=retirementId,proto3" json:"retirement_id,omitempty"`
+	// batch_denom is the unique identifier of the credit batch.
+	BatchDenom string `protobuf:"bytes,2,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// amount is the amount of credits retired.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// jurisdiction is the jurisdiction of the beneficiary or buyer of the
+	// retired credits.
+	Jurisdiction string `protobuf:"bytes,4,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	// beneficiary is the beneficiary on whose behalf the credits were retired.
+	Beneficiary string `protobuf:"bytes,5,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// timestamp is the block time at which the credits were retired.
+	Timestamp *types1.Timestamp `protobuf:"bytes,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *RetirementCertificateData) Reset()         { *m = RetirementCertificateData{} }
+func (m *RetirementCertificateData) String() string { return proto.CompactTextString(m) }
+func (*RetirementCertificateData) ProtoMessage()    {}
+func (*RetirementCertificateData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b044b6b740b984f, []int{5}
+}
+func (m *RetirementCertificateData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetirementCertificateData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetirementCertificateData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetirementCertificateData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetirementCertificateData.Merge(m, src)
+}
+func (m *RetirementCertificateData) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetirementCertificateData) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetirementCertificateData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetirementCertificateData proto.InternalMessageInfo
+
+func (m *RetirementCertificateData) GetRetirementId() uint64 {
+	if m != nil {
+		return m.RetirementId
+	}
+	return 0
+}
+
+func (m *RetirementCertificateData) GetBatchDenom() string {
+	if m != nil {
+		return m.BatchDenom
+	}
+	return ""
+}
+
+func (m *RetirementCertificateData) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *RetirementCertificateData) GetJurisdiction() string {
+	if m != nil {
+		return m.Jurisdiction
+	}
+	return ""
+}
+
+func (m *RetirementCertificateData) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
+	}
+	return ""
+}
+
+func (m *RetirementCertificateData) GetTimestamp() *types1.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "regen.ecocredit.v1.Params")
 	proto.RegisterType((*Credits)(nil), "regen.ecocredit.v1.Credits")
 	proto.RegisterType((*BatchIssuance)(nil), "regen.ecocredit.v1.BatchIssuance")
 	proto.RegisterType((*OriginTx)(nil), "regen.ecocredit.v1.OriginTx")
 	proto.RegisterType((*CreditTypeProposal)(nil), "regen.ecocredit.v1.CreditTypeProposal")
+	proto.RegisterType((*RetirementCertificateData)(nil), "regen.ecocredit.v1.RetirementCertificateData")
 }
 
 func init() { proto.RegisterFile("regen/ecocredit/v1/types.proto", fileDescriptor_7b044b6b740b984f) }
 
 var fileDescriptor_7b044b6b740b984f = []byte{
-	// 603 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcf, 0x6e, 0x13, 0x3f,
-	0x10, 0xce, 0x26, 0xfd, 0xf5, 0xd7, 0x4c, 0xd4, 0x50, 0xac, 0xaa, 0x84, 0x0a, 0x6d, 0xa2, 0x48,
-	0x88, 0x48, 0xa8, 0xbb, 0xa4, 0x20, 0x21, 0x71, 0xa9, 0x48, 0x00, 0x09, 0x2e, 0x54, 0x51, 0x2f,
-	0x70, 0x89, 0xbc, 0xf6, 0x90, 0xba, 0xdd, 0xd8, 0x91, 0xed, 0xf4, 0xcf, 0x5b, 0x20, 0x71, 0xe1,
-	0xc8, 0x99, 0x07, 0xe0, 0x19, 0x7a, 0x42, 0x3d, 0x72, 0x02, 0xd4, 0xbe, 0x08, 0x5a, 0xdb, 0xf9,
-	0x83, 0xe0, 0xc8, 0x69, 0x67, 0xbe, 0x6f, 0x3c, 0xdf, 0x37, 0xab, 0x19, 0x88, 0x35, 0x8e, 0x50,
-	0xa6, 0xc8, 0x14, 0xd3, 0xc8, 0x85, 0x4d, 0x4f, 0xba, 0xa9, 0x3d, 0x9f, 0xa0, 0x49, 0x26, 0x5a,
-	0x59, 0x45, 0x88, 0xe3, 0x93, 0x39, 0x9f, 0x9c, 0x74, 0xb7, 0x63, 0xa6, 0xcc, 0x58, 0x99, 0x34,
-	0xa3, 0x06, 0xd3, 0x93, 0x6e, 0x86, 0x96, 0x76, 0x53, 0xa6, 0x84, 0xf4, 0x6f, 0xb6, 0x37, 0x47,
-	0x6a, 0xa4, 0x5c, 0x98, 0x16, 0x51, 0x40, 0xff, 0xa6, 0x64, 0x2c, 0xb5, 0xe8, 0xf9, 0xf6, 0xd7,
-	0x32, 0xac, 0xee, 0x53, 0x4d, 0xc7, 0x86, 0x4c, 0x61, 0xc3, 0xd7, 0x0c, 0x59, 0x4e, 0x8d, 0x19,
-	0xbe, 0x43, 0x6c, 0x44, 0xad, 0x4a, 0xa7, 0xb6, 0x7b, 0x3b, 0xf1, 0xda, 0x49, 0xa1, 0x9d, 0x04,
-	0xed, 0xa4, 0xaf, 0x84, 0xec, 0x3d, 0xb8, 0xf8, 0xde, 0x2c, 0x7d, 0xfe, 0xd1, 0xec, 0x8c, 0x84,
-	0x3d, 0x9c, 0x66, 0x09, 0x53, 0xe3, 0x34, 0x18, 0xf5, 0x9f, 0x1d, 0xc3, 0x8f, 0xc3, 0x6c, 0xc5,
-	0x03, 0x33, 0xa8, 0x7b, 0x91, 0x7e, 0xa1, 0xf1, 0x02, 0x91, 0x1c, 0x01, 0x64, 0xd4, 0x1c, 0xa3,
-	0x75, 0x82, 0xe5, 0x7f, 0x2f, 0x58, 0xf5, 0xed, 0x0b, 0xad, 0x47, 0xb0, 0x45, 0xf3, 0x5c, 0x9d,
-	0x22, 0x0f, 0x33, 0x32, 0x8d, 0xd4, 0x2a, 0x6d, 0x1a, 0x95, 0x56, 0xa5, 0x53, 0x1d, 0x6c, 0x06,
-	0xd6, 0x99, 0xeb, 0x07, 0x8e, 0xdc, 0x87, 0x9b, 0x0e, 0xcf, 0x85, 0xb1, 0x43, 0x94, 0x34, 0xcb,
-	0x91, 0x37, 0x56, 0x5a, 0x51, 0x67, 0x6d, 0xb0, 0x31, 0x27, 0x9e, 0x7b, 0xbc, 0xdd, 0x83, 0xff,
-	0xfb, 0x6e, 0x40, 0x43, 0x9a, 0x50, 0xcb, 0xa8, 0x65, 0x87, 0x43, 0x8e, 0x52, 0x8d, 0x1b, 0x51,
-	0x2b, 0xea, 0x54, 0x07, 0xe0, 0xa0, 0x67, 0x05, 0x42, 0xb6, 0x60, 0x95, 0x8e, 0xd5, 0x54, 0xda,
-	0x46, 0xd9, 0x71, 0x21, 0x6b, 0x7f, 0x89, 0x60, 0xbd, 0x57, 0x94, 0xbd, 0x34, 0x66, 0x4a, 0x25,
-	0x43, 0x72, 0x07, 0xaa, 0x1a, 0x99, 0x98, 0x08, 0x94, 0x36, 0x34, 0x5a, 0x00, 0xe4, 0x1e, 0xdc,
-	0xb0, 0x9a, 0xf2, 0xc2, 0xc0, 0xf0, 0xb7, 0x86, 0xf5, 0x19, 0xfc, 0xd4, 0xa1, 0xe4, 0x2e, 0xd4,
-	0x35, 0x5a, 0xa1, 0x91, 0xcf, 0xea, 0x2a, 0xae, 0x6e, 0x3d, 0xa0, 0xa1, 0xec, 0x31, 0xdc, 0xf2,
-	0xc0, 0x18, 0xa5, 0x1d, 0x1e, 0x4d, 0xb5, 0x30, 0x5c, 0x30, 0x2b, 0x94, 0x74, 0x63, 0x57, 0x07,
-	0x5b, 0x0b, 0xfa, 0xd5, 0x12, 0xdb, 0xce, 0x60, 0xed, 0xb5, 0x16, 0x23, 0x21, 0x0f, 0xce, 0x48,
-	0x1d, 0xca, 0x82, 0x07, 0xaf, 0x65, 0xc1, 0x8b, 0x61, 0x8d, 0x9a, 0x6a, 0x86, 0xb3, 0x61, 0x7d,
-	0x46, 0xb6, 0x61, 0x8d, 0x29, 0x69, 0x35, 0x65, 0x33, 0x37, 0xf3, 0x9c, 0x10, 0x58, 0x91, 0xca,
-	0x62, 0x50, 0x75, 0x71, 0xfb, 0x43, 0x04, 0xc4, 0xff, 0xe1, 0x83, 0xf3, 0x09, 0xee, 0x6b, 0x35,
-	0x51, 0x86, 0xe6, 0x64, 0x13, 0xfe, 0xb3, 0xc2, 0xe6, 0x18, 0x14, 0x7d, 0x42, 0x5a, 0x50, 0xe3,
-	0x68, 0x98, 0x16, 0x13, 0xe7, 0xde, 0x2b, 0x2f, 0x43, 0x64, 0x0f, 0x6a, 0x61, 0xeb, 0x8b, 0x9d,
-	0x71, 0x0e, 0x6a, 0xbb, 0x71, 0xf2, 0xe7, 0x01, 0x26, 0x0b, 0xd1, 0x01, 0xb0, 0x79, 0xfc, 0x64,
-	0xe5, 0xe3, 0xa7, 0x66, 0xa9, 0xf7, 0xe6, 0xe2, 0x2a, 0x8e, 0x2e, 0xaf, 0xe2, 0xe8, 0xe7, 0x55,
-	0x1c, 0xbd, 0xbf, 0x8e, 0x4b, 0x97, 0xd7, 0x71, 0xe9, 0xdb, 0x75, 0x5c, 0x7a, 0xbb, 0xb7, 0xb4,
-	0xa8, 0xae, 0xeb, 0x8e, 0x44, 0x7b, 0xaa, 0xf4, 0x71, 0xc8, 0x72, 0xe4, 0x23, 0xd4, 0xe9, 0xd9,
-	0xd2, 0x8d, 0xba, 0x13, 0x77, 0x2b, 0x5c, 0x1c, 0xfa, 0xaa, 0xbb, 0xd4, 0x87, 0xbf, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x11, 0xc7, 0x3a, 0x07, 0x35, 0x04, 0x00, 0x00,
+	// 748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x25, 0x59, 0xb5, 0x56, 0xb6, 0xea, 0x2e, 0x5c, 0x57, 0x16, 0x0a, 0x4a, 0x50, 0x51,
+	0x54, 0x40, 0x61, 0xb2, 0x72, 0x5b, 0xb4, 0xe8, 0xc5, 0xa8, 0xe4, 0x16, 0x70, 0x2f, 0x35, 0x08,
+	0x5f, 0xda, 0x8b, 0xb0, 0x5c, 0x8e, 0xe8, 0xb5, 0xc9, 0x5d, 0x61, 0x77, 0xe5, 0x9f, 0xb7, 0x28,
+	0xd0, 0x4b, 0x90, 0x53, 0xce, 0x79, 0x12, 0x9f, 0x02, 0x1f, 0x73, 0x4a, 0x02, 0xfb, 0x29, 0x72,
+	0x0b, 0xb8, 0x5c, 0x89, 0x74, 0x62, 0xe4, 0x94, 0x13, 0x77, 0xbe, 0x6f, 0x66, 0x67, 0xe6, 0xdb,
+	0xe1, 0x20, 0x57, 0x42, 0x0c, 0xdc, 0x07, 0x2a, 0xa8, 0x84, 0x88, 0x69, 0xff, 0x62, 0xe4, 0xeb,
+	0xeb, 0x39, 0x28, 0x6f, 0x2e, 0x85, 0x16, 0x18, 0x1b, 0xde, 0x5b, 0xf1, 0xde, 0xc5, 0xa8, 0xeb,
+	0x52, 0xa1, 0x52, 0xa1, 0xfc, 0x90, 0x28, 0xf0, 0x2f, 0x46, 0x21, 0x68, 0x32, 0xf2, 0xa9, 0x60,
+	0x3c, 0x8f, 0xe9, 0x6e, 0xc7, 0x22, 0x16, 0xe6, 0xe8, 0x67, 0x27, 0x8b, 0xf6, 0x62, 0x21, 0xe2,
+	0x04, 0x7c, 0x63, 0x85, 0x8b, 0x99, 0xaf, 0x59, 0x0a, 0x4a, 0x93, 0x74, 0x6e, 0x1d, 0x1e, 0x2b,
+	0x45, 0x69, 0xa2, 0x21, 0xe7, 0x07, 0x2f, 0xaa, 0xa8, 0x71, 0x4c, 0x24, 0x49, 0x15, 0x5e, 0xa0,
+	0xad, 0xdc, 0x67, 0x4a, 0x13, 0xa2, 0xd4, 0x74, 0x06, 0xd0, 0x71, 0xfa, 0xb5, 0x61, 0x6b, 0x7f,
+	0xd7, 0xcb, 0x8b, 0xf3, 0xb2, 0xe2, 0x3c, 0x5b, 0x9c, 0x37, 0x11, 0x8c, 0x8f, 0x7f, 0xb8, 0x79,
+	0xd5, 0xab, 0x3c, 0x7f, 0xdd, 0x1b, 0xc6, 0x4c, 0x9f, 0x2e, 0x42, 0x8f, 0x8a, 0xd4, 0xb7, 0x9d,
+	0xe4, 0x9f, 0x3d, 0x15, 0x9d, 0xdb, 0xe6, 0xb3, 0x00, 0x15, 0xb4, 0xf3, 0x24, 0x93, 0x2c, 0xc7,
+	0x9f, 0x00, 0xf8, 0x0c, 0xa1, 0x90, 0xa8, 0x73, 0xd0, 0x26, 0x61, 0xf5, 0xd3, 0x27, 0x6c, 0xe6,
+	0xd7, 0x67, 0xb9, 0x7e, 0x42, 0x3b, 0x24, 0x49, 0xc4, 0x25, 0x44, 0xb6, 0x47, 0x2a, 0x81, 0x68,
+	0x21, 0x55, 0xa7, 0xd6, 0xaf, 0x0d, 0x9b, 0xc1, 0xb6, 0x65, 0x4d, 0x71, 0x13, 0xcb, 0xe1, 0xef,
+	0xd1, 0x17, 0x06, 0x4f, 0x98, 0xd2, 0x53, 0xe0, 0x24, 0x4c, 0x20, 0xea, 0xd4, 0xfb, 0xce, 0x70,
+	0x3d, 0xd8, 0x5a, 0x11, 0x7f, 0xe4, 0xf8, 0x60, 0x8c, 0x3e, 0x9b, 0x98, 0x06, 0x15, 0xee, 0xa1,
+	0x56, 0x48, 0x34, 0x3d, 0x9d, 0x46, 0xc0, 0x45, 0xda, 0x71, 0xfa, 0xce, 0xb0, 0x19, 0x20, 0x03,
+	0x1d, 0x66, 0x08, 0xde, 0x41, 0x0d, 0x92, 0x8a, 0x05, 0xd7, 0x9d, 0xaa, 0xe1, 0xac, 0x35, 0x78,
+	0x5a, 0x45, 0x9b, 0xe3, 0xcc, 0xed, 0x48, 0xa9, 0x05, 0xe1, 0x14, 0xf0, 0xd7, 0xa8, 0x29, 0x81,
+	0xb2, 0x39, 0x03, 0xae, 0xed, 0x45, 0x05, 0x80, 0xbf, 0x43, 0x9f, 0x6b, 0x49, 0xa2, 0xac, 0x80,
+	0xe9, 0x83, 0x0b, 0xdb, 0x4b, 0xf8, 0x77, 0x83, 0xe2, 0x6f, 0x51, 0x5b, 0x82, 0x66, 0x12, 0xa2,
+	0xa5, 0x5f, 0xcd, 0xf8, 0x6d, 0x5a, 0xd4, 0xba, 0xfd, 0x82, 0xbe, 0xca, 0x81, 0x14, 0xb8, 0x9e,
+	0x9e, 0x2d, 0x24, 0x53, 0x11, 0xa3, 0x9a, 0x09, 0x6e, 0xda, 0x6e, 0x06, 0x3b, 0x05, 0xfd, 0x57,
+	0x89, 0xcd, 0x94, 0x2a, 0x05, 0x4a, 0x20, 0x4a, 0xf0, 0xce, 0x9a, 0x09, 0xd9, 0x2a, 0x88, 0xc0,
+	0xe0, 0xf8, 0x67, 0x54, 0xba, 0x66, 0x1a, 0x02, 0x87, 0x19, 0xa3, 0x8c, 0xc8, 0xeb, 0x4e, 0xc3,
+	0x44, 0x7c, 0x59, 0xb0, 0xe3, 0x82, 0x1c, 0x84, 0x68, 0xfd, 0x6f, 0xc9, 0x62, 0xc6, 0x4f, 0xae,
+	0x70, 0x1b, 0x55, 0x59, 0x64, 0xf5, 0xa8, 0xb2, 0x28, 0x13, 0x54, 0x89, 0x85, 0xa4, 0xb0, 0x14,
+	0x34, 0xb7, 0x70, 0x17, 0xad, 0x53, 0xc1, 0xb5, 0x24, 0x74, 0xd9, 0xf1, 0xca, 0xc6, 0x18, 0xd5,
+	0xb9, 0xd0, 0x60, 0x3b, 0x33, 0xe7, 0xc1, 0xff, 0x0e, 0xc2, 0xf9, 0x2b, 0x9e, 0x5c, 0xcf, 0xe1,
+	0x58, 0x8a, 0xb9, 0x50, 0x24, 0xc1, 0xdb, 0x68, 0x4d, 0x33, 0x9d, 0x80, 0xcd, 0x98, 0x1b, 0xb8,
+	0x8f, 0x5a, 0x11, 0x28, 0x2a, 0xd9, 0xdc, 0x28, 0x94, 0x67, 0x2e, 0x43, 0xf8, 0x00, 0xb5, 0xec,
+	0x9f, 0x95, 0xcd, 0xa5, 0xa9, 0xa0, 0xb5, 0xef, 0x7a, 0x1f, 0x6e, 0x01, 0xaf, 0x48, 0x1a, 0x20,
+	0xba, 0x3a, 0xff, 0x56, 0x7f, 0xf2, 0xac, 0x57, 0x19, 0xbc, 0x75, 0xd0, 0x6e, 0xb0, 0xd2, 0x64,
+	0x02, 0x52, 0xb3, 0x19, 0xa3, 0x44, 0xc3, 0x21, 0xd1, 0x04, 0x7f, 0x83, 0x36, 0x4b, 0x72, 0x5a,
+	0x59, 0xea, 0xc1, 0x46, 0x01, 0x1e, 0x45, 0xef, 0x8f, 0x64, 0xf5, 0x23, 0x23, 0x59, 0x2b, 0x8f,
+	0x24, 0x1e, 0xa0, 0x8d, 0x47, 0xe6, 0xe0, 0x01, 0x96, 0x09, 0x51, 0x7e, 0xc5, 0xfc, 0xdd, 0xcb,
+	0x10, 0xfe, 0x15, 0x35, 0x57, 0x0b, 0xca, 0xbc, 0x72, 0x6b, 0xbf, 0xeb, 0xe5, 0x2b, 0xcc, 0x5b,
+	0xae, 0x30, 0xef, 0x64, 0xe9, 0x11, 0x14, 0xce, 0xe3, 0x7f, 0x6e, 0xee, 0x5c, 0xe7, 0xf6, 0xce,
+	0x75, 0xde, 0xdc, 0xb9, 0xce, 0x7f, 0xf7, 0x6e, 0xe5, 0xf6, 0xde, 0xad, 0xbc, 0xbc, 0x77, 0x2b,
+	0xff, 0x1e, 0x94, 0x16, 0x81, 0x51, 0x74, 0x8f, 0x83, 0xbe, 0x14, 0xf2, 0xdc, 0x5a, 0x09, 0x44,
+	0x31, 0x48, 0xff, 0xaa, 0xb4, 0x03, 0xcd, 0x8e, 0x35, 0x2b, 0x22, 0xdb, 0xb4, 0x0d, 0x93, 0xf9,
+	0xc7, 0x77, 0x03, 0x00, 0x2e, 0xab, 0xac, 0x37, 0xb6, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -573,6 +706,20 @@ func (m *BatchIssuance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RetirementBeneficiary) > 0 {
+		i -= len(m.RetirementBeneficiary)
+		copy(dAtA[i:], m.RetirementBeneficiary)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RetirementBeneficiary)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RetirementReason) > 0 {
+		i -= len(m.RetirementReason)
+		copy(dAtA[i:], m.RetirementReason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RetirementReason)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.RetirementJurisdiction) > 0 {
 		i -= len(m.RetirementJurisdiction)
 		copy(dAtA[i:], m.RetirementJurisdiction)
@@ -704,6 +851,74 @@ func (m *CreditTypeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetirementCertificateData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetirementCertificateData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetirementCertificateData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BatchDenom) > 0 {
+		i -= len(m.BatchDenom)
+		copy(dAtA[i:], m.BatchDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BatchDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RetirementId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RetirementId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -784,6 +999,14 @@ func (m *BatchIssuance) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.RetirementReason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.RetirementBeneficiary)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -833,6 +1056,38 @@ func (m *CreditTypeProposal) Size() (n int) {
 	return n
 }
 
+func (m *RetirementCertificateData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RetirementId != 0 {
+		n += 1 + sovTypes(uint64(m.RetirementId))
+	}
+	l = len(m.BatchDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1280,6 +1535,70 @@ func (m *BatchIssuance) Unmarshal(dAtA []byte) error {
 			}
 			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementBeneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementBeneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1629,6 +1948,239 @@ func (m *CreditTypeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RetirementCertificateData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetirementCertificateData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetirementCertificateData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementId", wireType)
+			}
+			m.RetirementId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetirementId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types1.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
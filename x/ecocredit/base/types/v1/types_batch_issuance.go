@@ -7,6 +7,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/base"
 )
 
@@ -39,5 +40,13 @@ func (i *BatchIssuance) Validate() error {
 		}
 	}
 
+	if len(i.RetirementReason) > base.MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("retirement reason: max length %d", base.MaxNoteLength)
+	}
+
+	if len(i.RetirementBeneficiary) > base.MaxBeneficiaryLength {
+		return ecocredit.ErrMaxLimit.Wrapf("retirement beneficiary: max length %d", base.MaxBeneficiaryLength)
+	}
+
 	return nil
 }
@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/jsonpb"
@@ -28,6 +30,20 @@ func (s *batchIssuance) TheBatchIssuance(a gocuke.DocString) {
 	require.NoError(s.t, err)
 }
 
+func (s *batchIssuance) ARetirementReasonWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.issuance.RetirementReason = strings.Repeat("x", int(length))
+}
+
+func (s *batchIssuance) ARetirementBeneficiaryWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.issuance.RetirementBeneficiary = strings.Repeat("x", int(length))
+}
+
 func (s *batchIssuance) TheBatchIssuanceIsValidated() {
 	s.err = s.issuance.Validate()
 }
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"regexp"
+	"strings"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -33,6 +34,11 @@ func (o *OriginTx) Validate() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("origin_tx.contract must be a valid ethereum address")
 	}
 
+	// mixed-case contract addresses must be encoded with the EIP-55 checksum
+	if len(o.Contract) > 0 && o.Contract != strings.ToLower(o.Contract) && !eth.IsValidChecksumAddress(o.Contract) {
+		return sdkerrors.ErrInvalidAddress.Wrapf("origin_tx.contract has an invalid EIP-55 checksum")
+	}
+
 	if len(o.Note) > base.MaxNoteLength {
 		return sdkerrors.ErrInvalidRequest.Wrapf("origin_tx.note must be at most %d characters long", base.MaxNoteLength)
 	}
@@ -74,6 +74,15 @@ func TestOriginTxValidate(t *testing.T) {
 				Contract: "foo",
 			},
 		},
+		{
+			"invalid contract checksum",
+			"origin_tx.contract has an invalid EIP-55 checksum",
+			OriginTx{
+				Source:   "polygon",
+				Id:       "0x12345",
+				Contract: "0x5AAeb6053F3E94C9b9A09f33669435E7Ef1BeAed",
+			},
+		},
 		{
 			"note too long",
 			"origin_tx.note must",
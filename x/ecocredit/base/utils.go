@@ -8,6 +8,9 @@ import (
 	"time"
 	"unicode"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 )
 
@@ -18,6 +21,14 @@ const (
 
 	// MaxNoteLength defines the max length for note fields.
 	MaxNoteLength = 512
+
+	// MaxBeneficiaryLength defines the max length for retirement beneficiary
+	// fields.
+	MaxBeneficiaryLength = 256
+
+	// MaxReversalNameLength defines the max length for the name of a reversal
+	// event.
+	MaxReversalNameLength = 256
 )
 
 var (
@@ -97,6 +108,65 @@ func FormatBatchDenom(projectID string, batchSeqNo uint64, startDate, endDate *t
 	), nil
 }
 
+// FormatCertificateClassID formats the unique identifier of the x/nft class
+// used for retirement certificates of a credit class. This format may evolve
+// over time, but will maintain backwards compatibility.
+//
+// The current version has the format:
+// ecocredit:<class-id>
+//
+// <class-id> is the unique identifier of the credit class (see FormatClassID)
+//
+// e.g. ecocredit:C01
+func FormatCertificateClassID(classID string) string {
+	return fmt.Sprintf("%s:%s", ecocredit.ModuleName, classID)
+}
+
+// FormatCertificateID formats the unique identifier of the x/nft token minted
+// as a retirement certificate. This format may evolve over time, but will
+// maintain backwards compatibility.
+//
+// The current version has the format:
+// retirement-<retirement-id>
+//
+// <retirement-id> is the table row identifier of the retirement
+//
+// e.g. retirement-1
+func FormatCertificateID(retirementID uint64) string {
+	return fmt.Sprintf("retirement-%d", retirementID)
+}
+
+// BufferPoolAddress returns the address of the buffer pool account of a credit
+// class. The address is derived from the ecocredit module name and the credit
+// class id, and therefore no private key exists for the account.
+func BufferPoolAddress(classID string) sdk.AccAddress {
+	return address.Module(ecocredit.ModuleName, []byte(fmt.Sprintf("buffer-pool/%s", classID)))
+}
+
+// BridgeEscrowAddress returns the address of the account holding credits from
+// credit batches issued on Regen Ledger that have been bridged to the target
+// chain. The address is derived from the ecocredit module name and the target
+// chain, and therefore no private key exists for the account.
+func BridgeEscrowAddress(target string) sdk.AccAddress {
+	return address.Module(ecocredit.ModuleName, []byte(fmt.Sprintf("bridge-escrow/%s", strings.ToLower(target))))
+}
+
+// FormatWrappedDenom formats the bank denom and display denom of wrapped
+// credits from a credit batch (see Msg/Wrap):
+// - denom: eco.<exponent-prefix><batch-denom>
+// - display denom: eco.<batch-denom>
+func FormatWrappedDenom(batchDenom string, exponent uint32) (string, string, error) {
+	exponentPrefix, err := ExponentToPrefix(exponent)
+	if err != nil {
+		return "", "", err
+	}
+
+	denom := fmt.Sprintf("eco.%s%s", exponentPrefix, batchDenom)
+	displayDenom := fmt.Sprintf("eco.%s", batchDenom)
+
+	return denom, displayDenom, nil
+}
+
 // ValidateCreditTypeAbbreviation validates a credit type abbreviation, ensuring it
 // is only 1-3 uppercase letters. The return is nil if the abbreviation is valid.
 func ValidateCreditTypeAbbreviation(abbr string) error {
@@ -155,7 +225,8 @@ func ValidateBatchDenom(denom string) error {
 // the postal code is valid. This is a simple regex check and doesn't check that
 // the country or subdivision codes actually exist. This is because the codes
 // could change at short notice and we don't want to hardfork to keep up-to-date
-// with that information. The return is nil if the jurisdiction is valid.
+// with that information. See ValidateJurisdictionStrict for a check against the
+// embedded ISO 3166 dataset. The return is nil if the jurisdiction is valid.
 func ValidateJurisdiction(jurisdiction string) error {
 	if jurisdiction == "" {
 		return ecocredit.ErrParseFailure.Wrap("empty string is not allowed")
@@ -1,11 +1,15 @@
 package base
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"pgregory.net/rapid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
 func TestUtils(t *testing.T) {
@@ -19,6 +23,11 @@ func TestUtils(t *testing.T) {
 	t.Run("TestGetClassIDFromBatchDenom", rapid.MakeCheck(testGetClassIDFromBatchDenom))
 	t.Run("TestGetProjectIDFromBatchDenom", rapid.MakeCheck(testGetProjectIDFromBatchDenom))
 	t.Run("GetCreditTypeAbbrevFromClassID", rapid.MakeCheck(testGetCreditTypeAbbrevFromClassID))
+	t.Run("TestFormatCertificateClassID", rapid.MakeCheck(testFormatCertificateClassID))
+	t.Run("TestFormatCertificateID", rapid.MakeCheck(testFormatCertificateID))
+	t.Run("TestBufferPoolAddress", rapid.MakeCheck(testBufferPoolAddress))
+	t.Run("TestBridgeEscrowAddress", rapid.MakeCheck(testBridgeEscrowAddress))
+	t.Run("TestFormatWrappedDenom", rapid.MakeCheck(testFormatWrappedDenom))
 }
 
 func testFormatClassID(t *rapid.T) {
@@ -38,6 +47,77 @@ func testInvalidClassID(t *rapid.T) {
 	require.Error(t, ValidateClassID(classID))
 }
 
+func testFormatCertificateClassID(t *rapid.T) {
+	creditTypeAbbrev := genCreditTypeAbbrev.Draw(t, "creditTypeAbbrev")
+	classSeq := rapid.Uint64().Draw(t, "classSeq")
+
+	classID := FormatClassID(creditTypeAbbrev, classSeq)
+	certificateClassID := FormatCertificateClassID(classID)
+
+	t.Log(certificateClassID)
+
+	err := nft.ValidateClassID(certificateClassID)
+	require.NoError(t, err)
+}
+
+func testFormatCertificateID(t *rapid.T) {
+	retirementID := rapid.Uint64().Draw(t, "retirementID")
+
+	certificateID := FormatCertificateID(retirementID)
+
+	t.Log(certificateID)
+
+	err := nft.ValidateNFTID(certificateID)
+	require.NoError(t, err)
+}
+
+func testBufferPoolAddress(t *rapid.T) {
+	creditTypeAbbrev := genCreditTypeAbbrev.Draw(t, "creditTypeAbbrev")
+	classSeq := rapid.Uint64().Draw(t, "classSeq")
+
+	classID := FormatClassID(creditTypeAbbrev, classSeq)
+	otherClassID := FormatClassID(creditTypeAbbrev, classSeq+1)
+
+	addr := BufferPoolAddress(classID)
+
+	t.Log(addr)
+
+	require.NoError(t, sdk.VerifyAddressFormat(addr))
+	require.Equal(t, addr, BufferPoolAddress(classID))
+	require.NotEqual(t, addr, BufferPoolAddress(otherClassID))
+}
+
+func testBridgeEscrowAddress(t *rapid.T) {
+	target := rapid.StringMatching(`[a-z]{1,32}`).Draw(t, "target")
+
+	addr := BridgeEscrowAddress(target)
+
+	require.NoError(t, sdk.VerifyAddressFormat(addr))
+	require.Equal(t, addr, BridgeEscrowAddress(strings.ToUpper(target)))
+	require.NotEqual(t, addr, BridgeEscrowAddress(target+"x"))
+}
+
+func testFormatWrappedDenom(t *rapid.T) {
+	creditTypeAbbrev := genCreditTypeAbbrev.Draw(t, "creditTypeAbbrev")
+	exponent := rapid.SampledFrom([]uint32{0, 1, 2, 3, 6, 9, 12, 15, 18, 21, 24}).Draw(t, "exponent")
+	startDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	endDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	projectID := FormatProjectID(FormatClassID(creditTypeAbbrev, 1), 1)
+	batchDenom, err := FormatBatchDenom(projectID, 1, &startDate, &endDate)
+	require.NoError(t, err)
+
+	denom, displayDenom, err := FormatWrappedDenom(batchDenom, exponent)
+	require.NoError(t, err)
+
+	t.Log(denom)
+
+	require.NoError(t, sdk.ValidateDenom(denom))
+	require.NoError(t, sdk.ValidateDenom(displayDenom))
+	require.Equal(t, "eco."+batchDenom, displayDenom)
+	require.Equal(t, exponent == 0, denom == displayDenom)
+}
+
 func testFormatProjectID(t *rapid.T) {
 	creditTypeAbbrev := genCreditTypeAbbrev.Draw(t, "creditTypeAbbrev")
 	classSeq := rapid.Uint64().Draw(t, "classSeq")
@@ -27,6 +27,8 @@ const (
 	FlagDenomDescription       = "description"
 	FlagRetirementJurisdiction = "retirement-jurisdiction"
 	FlagRetireOnTake           = "retire-on-take"
+	FlagRetirementReason       = "retirement-reason"
+	FlagRetirementBeneficiary  = "retirement-beneficiary"
 )
 
 func TxCreateBasketCmd() *cobra.Command {
@@ -237,6 +239,10 @@ Flags:
     for the credits which will be used only if --retire-on-take flag is true.
 - retire-on-take: boolean that dictates whether the ecocredits received
     in exchange for the basket tokens will be received as retired or tradable credits.
+- retirement-reason: optional reason for the retirement which will be used
+    only if --retire-on-take flag is true.
+- retirement-beneficiary: optional address or name of the beneficiary of the
+    retirement which will be used only if --retire-on-take flag is true.
 		
 		`),
 		Example: `regen tx ecocredit take-from-basket eco.uC.NCT 1000
@@ -258,12 +264,24 @@ regen tx ecocredit take-from-basket eco.uC.NCT 1000 --retire-on-take=true --reti
 				return err
 			}
 
+			retirementReason, err := cmd.Flags().GetString(FlagRetirementReason)
+			if err != nil {
+				return err
+			}
+
+			retirementBeneficiary, err := cmd.Flags().GetString(FlagRetirementBeneficiary)
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgTake{
 				Owner:                  clientCtx.FromAddress.String(),
 				BasketDenom:            args[0],
 				Amount:                 args[1],
 				RetirementJurisdiction: retirementJurisdiction,
 				RetireOnTake:           retireOnTake,
+				RetirementReason:       retirementReason,
+				RetirementBeneficiary:  retirementBeneficiary,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -276,6 +294,8 @@ regen tx ecocredit take-from-basket eco.uC.NCT 1000 --retire-on-take=true --reti
 
 	cmd.Flags().String(FlagRetirementJurisdiction, "", "jurisdiction for the credits which will be used only if --retire-on-take flag is true")
 	cmd.Flags().Bool(FlagRetireOnTake, false, "dictates whether the ecocredits received in exchange for the basket tokens will be received as retired or tradable credits")
+	cmd.Flags().String(FlagRetirementReason, "", "reason for the retirement which will be used only if --retire-on-take flag is true")
+	cmd.Flags().String(FlagRetirementBeneficiary, "", "beneficiary of the retirement which will be used only if --retire-on-take flag is true")
 
 	return txFlags(cmd)
 }
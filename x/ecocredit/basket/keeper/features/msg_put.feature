@@ -3,7 +3,8 @@ Feature: Msg/Put
   Credits can be put into a basket:
   - when the basket exists
   - when the credit batch exists
-  - when the credit class is allowed
+  - when the credit class of the project is allowed
+  - when the credit class is not deprecated
   - when the user has a credit balance
   - when the user has the credit amount
   - when the credit amount does not exceed maximum decimal places
@@ -62,6 +63,35 @@ Feature: Msg/Put
       When alice attempts to put credits from credit batch "A01-20200101-20210101-001" into the basket
       Then expect the error "credit class A01 is not allowed in this basket: invalid request"
 
+    Scenario: credit class of the project is allowed
+      Given alice owns credits from credit batch "C02-001-20200101-20210101-001"
+      And the project of credit batch "C02-001-20200101-20210101-001" is in credit class "C01"
+      When alice attempts to put credits from credit batch "C02-001-20200101-20210101-001" into the basket
+      Then expect no error
+
+    Scenario: credit class of the project is not allowed
+      Given alice owns credits from credit batch "C01-001-20200101-20210101-001"
+      And the project of credit batch "C01-001-20200101-20210101-001" is in credit class "C02"
+      When alice attempts to put credits from credit batch "C01-001-20200101-20210101-001" into the basket
+      Then expect the error "credit class C02 is not allowed in this basket: invalid request"
+
+  Rule: The credit batch must be from a credit class that is not deprecated
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a basket with allowed credit class "C01"
+      And alice owns credits from credit batch "C01-001-20200101-20210101-001"
+
+    Scenario: credit class is closed to issuance
+      Given credit class "C01" has status "CLASS_STATUS_CLOSED_TO_ISSUANCE"
+      When alice attempts to put credits from credit batch "C01-001-20200101-20210101-001" into the basket
+      Then expect no error
+
+    Scenario: credit class is deprecated
+      Given credit class "C01" has status "CLASS_STATUS_DEPRECATED"
+      When alice attempts to put credits from credit batch "C01-001-20200101-20210101-001" into the basket
+      Then expect the error "cannot put a credit from deprecated credit class C01 into a basket: invalid request"
+
   Rule: The user must have a credit balance for the credits being put into the basket
 
     Background:
@@ -19,6 +19,7 @@ type Keeper struct {
 	stateStore    api.StateStore
 	baseStore     baseapi.StateStore
 	bankKeeper    ecocredit.BankKeeper
+	nftKeeper     ecocredit.NFTKeeper
 	moduleAddress sdk.AccAddress
 	authority     sdk.AccAddress
 }
@@ -28,6 +29,7 @@ func NewKeeper(
 	ss api.StateStore,
 	cs baseapi.StateStore,
 	bk ecocredit.BankKeeper,
+	nk ecocredit.NFTKeeper,
 	ma sdk.AccAddress,
 	authority sdk.AccAddress,
 ) Keeper {
@@ -35,6 +37,7 @@ func NewKeeper(
 		stateStore:    ss,
 		baseStore:     cs,
 		bankKeeper:    bk,
+		nftKeeper:     nk,
 		moduleAddress: ma,
 		authority:     authority,
 	}
@@ -12,7 +12,7 @@ import (
 	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	regenmath "github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
-	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	basekeeper "github.com/regen-network/regen-ledger/x/ecocredit/base/keeper"
 	basetypes "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
 	basketsub "github.com/regen-network/regen-ledger/x/ecocredit/basket"
 	types "github.com/regen-network/regen-ledger/x/ecocredit/basket/types/v1"
@@ -115,7 +115,8 @@ func (k Keeper) Put(ctx context.Context, req *types.MsgPut) (*types.MsgPutRespon
 // canBasketAcceptCredit checks that a credit adheres to the specifications of a basket. Specifically, it checks:
 // - batch's start time is within the basket's specified time window or min start date
 // - class is in the basket's allowed class store
-// - type matches the baskets specified credit type.
+// - type matches the baskets specified credit type
+// - class is not deprecated.
 func (k Keeper) canBasketAcceptCredit(ctx context.Context, basket *api.Basket, batch *baseapi.Batch) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	blockTime := sdkCtx.BlockTime()
@@ -144,26 +145,39 @@ func (k Keeper) canBasketAcceptCredit(ctx context.Context, basket *api.Basket, b
 
 	}
 
-	classID := base.GetClassIDFromBatchDenom(batch.Denom)
+	project, err := k.baseStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return err
+	}
+
+	class, err := k.baseStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return err
+	}
 
 	// check credit class match
-	found, err := k.stateStore.BasketClassTable().Has(ctx, basket.Id, classID)
+	found, err := k.stateStore.BasketClassTable().Has(ctx, basket.Id, class.Id)
 	if err != nil {
 		return err
 	}
 	if !found {
-		return errInvalidReq.Wrapf("credit class %s is not allowed in this basket", classID)
+		return errInvalidReq.Wrapf("credit class %s is not allowed in this basket", class.Id)
 	}
 
 	// check credit type match
-	class, err := k.baseStore.ClassTable().GetById(ctx, classID)
-	if err != nil {
-		return err
-	}
 	if class.CreditTypeAbbrev != basket.CreditTypeAbbrev {
 		return errInvalidReq.Wrapf("basket requires credit type %s but a credit with type %s was given", basket.CreditTypeAbbrev, class.CreditTypeAbbrev)
 	}
 
+	// check credit class status
+	lifecycle, err := k.baseStore.ClassLifecycleTable().Get(ctx, class.Key)
+	if err != nil && !ormerrors.IsNotFound(err) {
+		return err
+	}
+	if lifecycle != nil && baseapi.ClassStatus(lifecycle.Status) == baseapi.ClassStatus_CLASS_STATUS_DEPRECATED {
+		return errInvalidReq.Wrapf("cannot put a credit from deprecated credit class %s into a basket", class.Id)
+	}
+
 	return nil
 }
 
@@ -187,6 +201,11 @@ func (k Keeper) transferToBasket(ctx context.Context, sender sdk.AccAddress, amt
 		return err
 	}
 
+	// serial numbers of credits in baskets are held by the basket submodule
+	if err = basekeeper.TransferSerials(ctx, k.baseStore, batch.Key, sender, k.moduleAddress, amt, baseapi.SerialStatus_SERIAL_STATUS_TRADABLE); err != nil {
+		return err
+	}
+
 	// update basket balance with amount sent, adding to the basket's balance.
 	var bal *api.BasketBalance
 	bal, err = k.stateStore.BasketBalanceTable().Get(ctx, basketID, batch.Denom)
@@ -13,6 +13,7 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
@@ -339,6 +340,42 @@ func (s *putSuite) AliceOwnsCreditsFromCreditBatch(a string) {
 	require.NoError(s.t, err)
 }
 
+func (s *putSuite) TheProjectOfCreditBatchIsInCreditClass(a, b string) {
+	batch, err := s.baseStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	project, err := s.baseStore.ProjectTable().Get(s.ctx, batch.ProjectKey)
+	require.NoError(s.t, err)
+
+	class, err := s.baseStore.ClassTable().GetById(s.ctx, b)
+	if ormerrors.IsNotFound(err) {
+		class = &baseapi.Class{
+			Id:               b,
+			CreditTypeAbbrev: base.GetCreditTypeAbbrevFromClassID(b),
+		}
+		class.Key, err = s.baseStore.ClassTable().InsertReturningID(s.ctx, class)
+	}
+	require.NoError(s.t, err)
+
+	project.ClassKey = class.Key
+	err = s.baseStore.ProjectTable().Update(s.ctx, project)
+	require.NoError(s.t, err)
+}
+
+func (s *putSuite) CreditClassHasStatus(a, b string) {
+	class, err := s.baseStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	status, ok := baseapi.ClassStatus_value[b]
+	require.True(s.t, ok)
+
+	err = s.baseStore.ClassLifecycleTable().Insert(s.ctx, &baseapi.ClassLifecycle{
+		ClassKey: class.Key,
+		Status:   uint32(status),
+	})
+	require.NoError(s.t, err)
+}
+
 func (s *putSuite) AliceOwnsCreditsWithStartDate(a string) {
 	startDate, err := regentypes.ParseDate("start-date", a)
 	require.NoError(s.t, err)
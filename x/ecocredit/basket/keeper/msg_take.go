@@ -11,6 +11,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	basekeeper "github.com/regen-network/regen-ledger/x/ecocredit/base/keeper"
@@ -123,6 +124,8 @@ func (k Keeper) Take(ctx context.Context, msg *types.MsgTake) (*types.MsgTakeRes
 				amountCreditsNeeded,
 				retire,
 				retirementJurisdiction,
+				msg.RetirementReason,
+				msg.RetirementBeneficiary,
 			)
 			if err != nil {
 				return nil, err
@@ -153,6 +156,8 @@ func (k Keeper) Take(ctx context.Context, msg *types.MsgTake) (*types.MsgTakeRes
 				balance,
 				retire,
 				retirementJurisdiction,
+				msg.RetirementReason,
+				msg.RetirementBeneficiary,
 			)
 			if err != nil {
 				return nil, err
@@ -188,7 +193,7 @@ func (k Keeper) Take(ctx context.Context, msg *types.MsgTake) (*types.MsgTakeRes
 	}, err
 }
 
-func (k Keeper) addCreditBalance(ctx context.Context, owner sdk.AccAddress, batchDenom string, amount math.Dec, retire bool, jurisdiction string) error {
+func (k Keeper) addCreditBalance(ctx context.Context, owner sdk.AccAddress, batchDenom string, amount math.Dec, retire bool, jurisdiction, reason, beneficiary string) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	batch, err := k.baseStore.BatchTable().GetByDenom(ctx, batchDenom)
 	if err != nil {
@@ -198,6 +203,12 @@ func (k Keeper) addCreditBalance(ctx context.Context, owner sdk.AccAddress, batc
 		if err = basekeeper.AddAndSaveBalance(ctx, k.baseStore.BatchBalanceTable(), owner, batch.Key, amount); err != nil {
 			return err
 		}
+		if err = basekeeper.IndexBatchBalance(ctx, k.baseStore, owner, batch.Key); err != nil {
+			return err
+		}
+		if err = basekeeper.TransferSerials(ctx, k.baseStore, batch.Key, k.moduleAddress, owner, amount, baseapi.SerialStatus_SERIAL_STATUS_TRADABLE); err != nil {
+			return err
+		}
 		return sdkCtx.EventManager().EmitTypedEvent(&basetypes.EventTransfer{
 			Sender:         k.moduleAddress.String(), // basket submodule
 			Recipient:      owner.String(),
@@ -209,9 +220,28 @@ func (k Keeper) addCreditBalance(ctx context.Context, owner sdk.AccAddress, batc
 	if err = basekeeper.RetireAndSaveBalance(ctx, k.baseStore.BatchBalanceTable(), owner, batch.Key, amount); err != nil {
 		return err
 	}
+	if err = basekeeper.IndexBatchBalance(ctx, k.baseStore, owner, batch.Key); err != nil {
+		return err
+	}
 	if err = basekeeper.RetireSupply(ctx, k.baseStore.BatchSupplyTable(), batch.Key, amount); err != nil {
 		return err
 	}
+	if err = basekeeper.RetireAggregateSupply(ctx, k.baseStore, batch.Key, amount); err != nil {
+		return err
+	}
+	if err = basekeeper.TransferSerials(ctx, k.baseStore, batch.Key, k.moduleAddress, owner, amount, baseapi.SerialStatus_SERIAL_STATUS_RETIRED); err != nil {
+		return err
+	}
+	if _, err = basekeeper.RecordRetirement(ctx, k.baseStore, k.nftKeeper, &baseapi.Retirement{
+		Owner:        owner,
+		BatchKey:     batch.Key,
+		Amount:       amount.String(),
+		Jurisdiction: jurisdiction,
+		Reason:       reason,
+		Beneficiary:  beneficiary,
+	}, batchDenom); err != nil {
+		return err
+	}
 	err = sdkCtx.EventManager().EmitTypedEvent(&basetypes.EventTransfer{
 		Sender:        k.moduleAddress.String(), // basket submodule
 		Recipient:     owner.String(),
@@ -226,5 +256,7 @@ func (k Keeper) addCreditBalance(ctx context.Context, owner sdk.AccAddress, batc
 		BatchDenom:   batchDenom,
 		Amount:       amount.String(),
 		Jurisdiction: jurisdiction,
+		Reason:       reason,
+		Beneficiary:  beneficiary,
 	})
 }
@@ -13,8 +13,10 @@ import (
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
 	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/types/testutil"
 	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	basekeeper "github.com/regen-network/regen-ledger/x/ecocredit/base/keeper"
 	basetypes "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
 	"github.com/regen-network/regen-ledger/x/ecocredit/basket"
 	types "github.com/regen-network/regen-ledger/x/ecocredit/basket/types/v1"
@@ -423,6 +425,13 @@ func (s *takeSuite) addBasketClassAndBalance(basketID uint64, creditAmount strin
 	})
 	require.NoError(s.t, err)
 
+	tradable, err := math.NewDecFromString(creditAmount)
+	require.NoError(s.t, err)
+
+	zero := math.NewDecFromInt64(0)
+	err = basekeeper.AddAggregateSupply(s.ctx, s.baseStore, batchKey, tradable, zero, zero)
+	require.NoError(s.t, err)
+
 	err = s.stateStore.BasketBalanceTable().Insert(s.ctx, &api.BasketBalance{
 		BasketId:   basketID,
 		BatchDenom: s.batchDenom,
@@ -142,6 +142,16 @@ func FuzzCreditAmountToBasketCoin(f *testing.F) {
 }
 
 func initBatch(t *testing.T, s *baseSuite, pid uint64, denom string, startDate *timestamppb.Timestamp) {
+	// the project of a batch is required to check if the batch is serialized
+	// (projects are inserted in order so that the project key matches pid)
+	if found, err := s.baseStore.ProjectTable().Has(s.ctx, pid); err == nil && !found {
+		projectKey, err := s.baseStore.ProjectTable().InsertReturningID(s.ctx, &baseapi.Project{
+			Id:       fmt.Sprintf("P%02d", pid),
+			ClassKey: 1,
+		})
+		assert.NilError(t, err)
+		assert.Equal(t, pid, projectKey)
+	}
 	assert.NilError(t, s.baseStore.BatchTable().Insert(s.ctx, &baseapi.Batch{
 		ProjectKey: pid,
 		Denom:      denom,
@@ -5,9 +5,9 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 
-	"github.com/regen-network/regen-ledger/x/ecocredit/basket"
-
+	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	"github.com/regen-network/regen-ledger/x/ecocredit/basket"
 )
 
 var _ legacytx.LegacyMsg = &MsgTake{}
@@ -66,6 +66,14 @@ func (m MsgTake) ValidateBasic() error {
 		}
 	}
 
+	if len(m.RetirementReason) > base.MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("retirement reason: max length %d", base.MaxNoteLength)
+	}
+
+	if len(m.RetirementBeneficiary) > base.MaxBeneficiaryLength {
+		return ecocredit.ErrMaxLimit.Wrapf("retirement beneficiary: max length %d", base.MaxBeneficiaryLength)
+	}
+
 	return nil
 }
 
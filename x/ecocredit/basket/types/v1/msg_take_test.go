@@ -3,6 +3,8 @@ package v1
 import (
 	"bytes"
 	"encoding/json"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/jsonpb"
@@ -31,6 +33,20 @@ func (s *msgTakeSuite) TheMessage(a gocuke.DocString) {
 	require.NoError(s.t, err)
 }
 
+func (s *msgTakeSuite) ARetirementReasonWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.RetirementReason = strings.Repeat("x", int(length))
+}
+
+func (s *msgTakeSuite) ARetirementBeneficiaryWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.RetirementBeneficiary = strings.Repeat("x", int(length))
+}
+
 func (s *msgTakeSuite) TheMessageIsValidated() {
 	s.err = s.msg.ValidateBasic()
 }
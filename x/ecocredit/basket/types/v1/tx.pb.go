@@ -359,6 +359,19 @@ type MsgTake struct {
 	//
 	// Since Revision 1
 	RetirementJurisdiction string `protobuf:"bytes,6,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// retirement_reason is the optional reason for retiring the credits (e.g.
+	// the sustainability claim the retirement is used for) and is only used if
+	// retire_on_take is true. The reason can be up to 512 characters.
+	//
+	// Since Revision 1
+	RetirementReason string `protobuf:"bytes,7,opt,name=retirement_reason,json=retirementReason,proto3" json:"retirement_reason,omitempty"`
+	// retirement_beneficiary is the optional beneficiary on whose behalf the
+	// credits are retired and is only used if retire_on_take is true. The
+	// beneficiary can be either an address or a free-text name and can be up to
+	// 256 characters.
+	//
+	// Since Revision 1
+	RetirementBeneficiary string `protobuf:"bytes,8,opt,name=retirement_beneficiary,json=retirementBeneficiary,proto3" json:"retirement_beneficiary,omitempty"`
 }
 
 func (m *MsgTake) Reset()         { *m = MsgTake{} }
@@ -437,6 +450,20 @@ func (m *MsgTake) GetRetirementJurisdiction() string {
 	return ""
 }
 
+func (m *MsgTake) GetRetirementReason() string {
+	if m != nil {
+		return m.RetirementReason
+	}
+	return ""
+}
+
+func (m *MsgTake) GetRetirementBeneficiary() string {
+	if m != nil {
+		return m.RetirementBeneficiary
+	}
+	return ""
+}
+
 // MsgTakeFromBasketResponse is the Msg/TakeFromBasket response type.
 type MsgTakeResponse struct {
 	// credits are the credits taken out of the basket.
@@ -704,63 +731,65 @@ func init() {
 }
 
 var fileDescriptor_a60f962a3c61f018 = []byte{
-	// 883 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x6f, 0xdb, 0x36,
-	0x14, 0x8f, 0xa2, 0xc4, 0x89, 0x9f, 0x13, 0xa7, 0x65, 0x83, 0x56, 0x15, 0x0a, 0xc7, 0x15, 0x3a,
-	0xd4, 0x6b, 0x1b, 0x69, 0x49, 0xb0, 0x0d, 0xeb, 0x2d, 0x71, 0xb1, 0xc3, 0x50, 0x63, 0x85, 0x96,
-	0xed, 0xb0, 0x3f, 0x10, 0x64, 0xe9, 0x4d, 0xd5, 0x6c, 0x8b, 0x2e, 0x49, 0xd9, 0xc9, 0x75, 0x9f,
-	0xa0, 0x9f, 0x63, 0xa7, 0x7d, 0x81, 0xdd, 0x7b, 0xec, 0x71, 0xa7, 0x6d, 0x48, 0x0e, 0xfb, 0x0e,
-	0xc3, 0x0e, 0x85, 0x48, 0x4a, 0x71, 0x13, 0xc4, 0x6d, 0x73, 0x12, 0xf9, 0x7b, 0xbf, 0xf7, 0xc8,
-	0xc7, 0xdf, 0x8f, 0x14, 0x38, 0x0c, 0x13, 0xcc, 0x3c, 0x8c, 0x68, 0xc4, 0x30, 0x4e, 0x85, 0xd7,
-	0x0f, 0xf9, 0x00, 0x85, 0x37, 0xd9, 0xf1, 0xc4, 0x91, 0x3b, 0x66, 0x54, 0x50, 0x72, 0x5b, 0x72,
-	0xdc, 0x8a, 0xe3, 0x2a, 0x8e, 0x3b, 0xd9, 0xb1, 0x5b, 0x11, 0xe5, 0x23, 0xca, 0x8b, 0x2c, 0xf4,
-	0x26, 0x3b, 0x7d, 0x14, 0xe1, 0x8e, 0x17, 0xd1, 0x34, 0x53, 0xa9, 0xf6, 0x2d, 0x1d, 0x1f, 0xf1,
-	0xa4, 0x28, 0x39, 0xe2, 0x89, 0x0e, 0x6c, 0x26, 0x34, 0xa1, 0x72, 0xe8, 0x15, 0x23, 0x8d, 0x7e,
-	0x34, 0x67, 0x37, 0xc7, 0x63, 0xe4, 0x8a, 0xe6, 0xfc, 0x61, 0x42, 0xbd, 0xc7, 0x93, 0x2e, 0xc3,
-	0x50, 0x20, 0xb1, 0x60, 0x25, 0xca, 0x59, 0x28, 0x28, 0xb3, 0x8c, 0xb6, 0xd1, 0xa9, 0xfb, 0xe5,
-	0x94, 0x10, 0x58, 0xca, 0xc2, 0x11, 0x5a, 0x8b, 0x12, 0x96, 0x63, 0xd2, 0x86, 0x46, 0x8c, 0x3c,
-	0x62, 0xe9, 0x58, 0xa4, 0x34, 0xb3, 0x4c, 0x19, 0x9a, 0x85, 0x48, 0x0b, 0x56, 0xf1, 0x68, 0x4c,
-	0x33, 0xcc, 0x84, 0xb5, 0xd4, 0x36, 0x3a, 0xeb, 0x07, 0x8b, 0x96, 0xe1, 0x57, 0x18, 0x71, 0xe1,
-	0x46, 0x9c, 0xf2, 0xb0, 0x3f, 0xc4, 0x20, 0xcc, 0x05, 0x0d, 0x18, 0x8a, 0x94, 0xa1, 0xb5, 0xdc,
-	0x36, 0x3a, 0xab, 0xfe, 0x75, 0x1d, 0xda, 0xcf, 0x05, 0xf5, 0x65, 0x80, 0x3c, 0x02, 0xa2, 0xba,
-	0x09, 0x8a, 0x1e, 0x82, 0xb0, 0xdf, 0x67, 0x38, 0xb1, 0x6a, 0x72, 0xe1, 0x6b, 0x2a, 0x72, 0x78,
-	0x3c, 0xc6, 0x7d, 0x89, 0x93, 0xfb, 0xb0, 0x11, 0x0e, 0x87, 0x74, 0x8a, 0x71, 0x10, 0x0d, 0x43,
-	0xce, 0x91, 0x5b, 0x2b, 0x6d, 0xb3, 0x53, 0xf7, 0x9b, 0x1a, 0xee, 0x2a, 0x94, 0x3c, 0x85, 0xf5,
-	0x38, 0x14, 0x18, 0x44, 0x2c, 0x15, 0xc8, 0xd2, 0xd0, 0x5a, 0x6d, 0x1b, 0x9d, 0xc6, 0xee, 0x7d,
-	0xf7, 0x52, 0xb5, 0xdc, 0x27, 0xa1, 0xc0, 0xae, 0xa6, 0xfb, 0x6b, 0xf1, 0xcc, 0x8c, 0xfc, 0x04,
-	0xe6, 0xcf, 0x88, 0x56, 0xbd, 0x6d, 0x76, 0x1a, 0xbb, 0xb7, 0x5d, 0x25, 0x5b, 0x91, 0x8a, 0xae,
-	0x96, 0xd5, 0xed, 0xd2, 0x34, 0x3b, 0xf8, 0xe4, 0xd5, 0x5f, 0x5b, 0x0b, 0xbf, 0xfd, 0xbd, 0xd5,
-	0x49, 0x52, 0xf1, 0x3c, 0xef, 0xbb, 0x11, 0x1d, 0x79, 0x5a, 0x63, 0xf5, 0xd9, 0xe6, 0xf1, 0x40,
-	0x8b, 0x55, 0x24, 0x70, 0xbf, 0xa8, 0xfb, 0x78, 0xed, 0xd7, 0x7f, 0x7f, 0x7f, 0x50, 0xea, 0xe2,
-	0x7c, 0x06, 0xd7, 0x2b, 0xf9, 0x7c, 0xe4, 0x63, 0x9a, 0x71, 0x24, 0x77, 0x61, 0x4d, 0xed, 0x34,
-	0x88, 0x31, 0xa3, 0x23, 0xad, 0x65, 0x43, 0x61, 0x4f, 0x0a, 0xc8, 0x79, 0x69, 0x40, 0xad, 0xc7,
-	0x93, 0x67, 0xb9, 0x20, 0x9b, 0xb0, 0x4c, 0xa7, 0x19, 0x96, 0x92, 0xab, 0xc9, 0x85, 0x1a, 0x8b,
-	0x17, 0x6a, 0x90, 0x7d, 0x58, 0x51, 0xe7, 0xc2, 0x2d, 0x53, 0x36, 0x3b, 0xef, 0xc0, 0x0e, 0xe4,
-	0xa8, 0x2b, 0x61, 0xbf, 0xcc, 0x7b, 0x0c, 0x45, 0x33, 0x6a, 0x45, 0xe7, 0x0b, 0x68, 0xaa, 0x1d,
-	0x55, 0x7d, 0x14, 0x02, 0x8e, 0x68, 0x9e, 0x89, 0x80, 0x61, 0x84, 0xe9, 0x04, 0x63, 0xbd, 0xc7,
-	0xa6, 0x82, 0x7d, 0x8d, 0x3a, 0xff, 0x19, 0xb0, 0xd2, 0xe3, 0xc9, 0x61, 0x38, 0xc0, 0xab, 0xb7,
-	0x73, 0x13, 0x6a, 0xaa, 0xac, 0x76, 0xb2, 0x9e, 0x91, 0x3d, 0xb8, 0xa1, 0x7c, 0x39, 0xc2, 0x4c,
-	0x04, 0x43, 0x1a, 0x85, 0xd2, 0xee, 0x85, 0x9f, 0xeb, 0xd2, 0xcf, 0xe4, 0x2c, 0xfc, 0x54, 0x47,
-	0xc9, 0x3d, 0x68, 0x2a, 0x34, 0xa0, 0x59, 0x20, 0xc2, 0x41, 0x69, 0xea, 0x35, 0x85, 0x7e, 0x9d,
-	0xc9, 0xbd, 0x7e, 0x0e, 0xb7, 0x66, 0x4a, 0xff, 0x92, 0xb3, 0x94, 0xc7, 0x69, 0x24, 0xcb, 0x2b,
-	0x53, 0xdf, 0x3c, 0x0b, 0x7f, 0x35, 0x13, 0x7d, 0xeb, 0xdc, 0x0e, 0x61, 0x43, 0xf7, 0x5e, 0x1d,
-	0xdc, 0x8c, 0x32, 0xc6, 0xd5, 0x94, 0x71, 0x28, 0x90, 0x1e, 0x4f, 0xbe, 0x1d, 0x17, 0xd6, 0x56,
-	0x8c, 0x2f, 0x11, 0xc9, 0x1d, 0xa8, 0x87, 0xb9, 0x78, 0x4e, 0x59, 0x2a, 0x8e, 0xf5, 0x01, 0x9f,
-	0x01, 0xe4, 0xa1, 0x72, 0xfe, 0xa2, 0xbc, 0x3d, 0x97, 0x3b, 0x5f, 0xf9, 0xb8, 0x59, 0xb4, 0x70,
-	0x96, 0xec, 0xdc, 0x01, 0xfb, 0xe2, 0x82, 0x65, 0x47, 0xce, 0x0b, 0xb8, 0x56, 0x45, 0xbb, 0xfa,
-	0x4d, 0xba, 0xfc, 0xb5, 0xda, 0x84, 0xe5, 0x59, 0x99, 0xd5, 0x84, 0x6c, 0x41, 0x23, 0xc3, 0x69,
-	0x50, 0xe6, 0x28, 0x95, 0x21, 0xc3, 0xa9, 0x2e, 0x78, 0xee, 0x6a, 0xd9, 0x60, 0x9d, 0x5f, 0xb2,
-	0xdc, 0xce, 0xee, 0xff, 0x26, 0x98, 0x3d, 0x9e, 0x90, 0x1f, 0xa1, 0xa6, 0x9f, 0xce, 0x7b, 0x73,
-	0x4e, 0xb8, 0xba, 0xa1, 0xf6, 0xa3, 0xf7, 0x61, 0x55, 0x32, 0x7e, 0x03, 0x66, 0x71, 0x41, 0xef,
-	0xce, 0x4f, 0x7a, 0x96, 0x0b, 0xfb, 0xe3, 0x77, 0x52, 0xaa, 0xa2, 0xdf, 0xc1, 0x92, 0xf4, 0x9e,
-	0x33, 0x3f, 0xa5, 0xe0, 0xd8, 0x0f, 0xde, 0xcd, 0xa9, 0xea, 0x4e, 0x61, 0xe3, 0xbc, 0x5b, 0xb6,
-	0xe7, 0xa7, 0x9f, 0xa3, 0xdb, 0x9f, 0x7e, 0x10, 0xbd, 0x5a, 0xf8, 0x05, 0xac, 0xbf, 0xed, 0x8b,
-	0x87, 0xef, 0x53, 0x47, 0x93, 0xed, 0xbd, 0x0f, 0x20, 0x97, 0x4b, 0x1e, 0xfc, 0xf0, 0xea, 0xa4,
-	0x65, 0xbc, 0x3e, 0x69, 0x19, 0xff, 0x9c, 0xb4, 0x8c, 0x97, 0xa7, 0xad, 0x85, 0xd7, 0xa7, 0xad,
-	0x85, 0x3f, 0x4f, 0x5b, 0x0b, 0xdf, 0xef, 0xcf, 0x3c, 0xe6, 0xb2, 0xf0, 0x76, 0x86, 0x62, 0x4a,
-	0xd9, 0x40, 0xcf, 0x86, 0x18, 0x27, 0xc8, 0xbc, 0xa3, 0x8b, 0x3f, 0x66, 0xf9, 0xd0, 0x17, 0x3f,
-	0xfe, 0x9a, 0xfc, 0x33, 0xef, 0xbd, 0x09, 0x00, 0x00, 0xff, 0xff, 0xc1, 0x9f, 0xbb, 0x8b, 0x50,
-	0x08, 0x00, 0x00,
+	// 920 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0xdb, 0x36,
+	0x14, 0x8f, 0xa2, 0xc4, 0x8e, 0x9f, 0x13, 0xa7, 0x61, 0xb3, 0x56, 0x35, 0x0a, 0xc7, 0x15, 0x3a,
+	0xd4, 0x6b, 0x1a, 0x79, 0x49, 0xd0, 0x0d, 0xeb, 0x2d, 0x76, 0xb1, 0xc3, 0x50, 0x63, 0x85, 0x96,
+	0xed, 0xb0, 0x3f, 0x10, 0x64, 0xe9, 0x55, 0xd5, 0x6c, 0x93, 0x2e, 0x49, 0xdb, 0xc9, 0x75, 0x9f,
+	0xa0, 0x9f, 0x63, 0xa7, 0x7d, 0x81, 0xdd, 0x7b, 0xec, 0x71, 0xa7, 0x6e, 0x48, 0x0e, 0xfb, 0x12,
+	0x3b, 0x0c, 0x22, 0x69, 0xd9, 0x4d, 0x50, 0xa7, 0xed, 0xc9, 0xe2, 0xef, 0xf7, 0x7b, 0x8f, 0x7c,
+	0xfc, 0x3d, 0x92, 0x06, 0x97, 0x63, 0x82, 0xb4, 0x89, 0x11, 0x8b, 0x38, 0xc6, 0xa9, 0x6c, 0x76,
+	0x43, 0xd1, 0x43, 0xd9, 0x1c, 0xef, 0x37, 0xe5, 0x89, 0x37, 0xe4, 0x4c, 0x32, 0x72, 0x4b, 0x69,
+	0xbc, 0x5c, 0xe3, 0x69, 0x8d, 0x37, 0xde, 0xaf, 0xd6, 0x22, 0x26, 0x06, 0x4c, 0x64, 0x51, 0xd8,
+	0x1c, 0xef, 0x77, 0x51, 0x86, 0xfb, 0xcd, 0x88, 0xa5, 0x54, 0x87, 0x56, 0x6f, 0x1a, 0x7e, 0x20,
+	0x92, 0x2c, 0xe5, 0x40, 0x24, 0x86, 0xd8, 0x4e, 0x58, 0xc2, 0xd4, 0x67, 0x33, 0xfb, 0x32, 0xe8,
+	0xa7, 0x0b, 0x56, 0x73, 0x3a, 0x44, 0xa1, 0x65, 0xee, 0x9f, 0x36, 0x94, 0x3a, 0x22, 0x69, 0x73,
+	0x0c, 0x25, 0x12, 0x07, 0x8a, 0xd1, 0x88, 0x87, 0x92, 0x71, 0xc7, 0xaa, 0x5b, 0x8d, 0x92, 0x3f,
+	0x1d, 0x12, 0x02, 0x2b, 0x34, 0x1c, 0xa0, 0xb3, 0xac, 0x60, 0xf5, 0x4d, 0xea, 0x50, 0x8e, 0x51,
+	0x44, 0x3c, 0x1d, 0xca, 0x94, 0x51, 0xc7, 0x56, 0xd4, 0x3c, 0x44, 0x6a, 0xb0, 0x86, 0x27, 0x43,
+	0x46, 0x91, 0x4a, 0x67, 0xa5, 0x6e, 0x35, 0x36, 0x5a, 0xcb, 0x8e, 0xe5, 0xe7, 0x18, 0xf1, 0xe0,
+	0x7a, 0x9c, 0x8a, 0xb0, 0xdb, 0xc7, 0x20, 0x1c, 0x49, 0x16, 0x70, 0x94, 0x29, 0x47, 0x67, 0xb5,
+	0x6e, 0x35, 0xd6, 0xfc, 0x2d, 0x43, 0x1d, 0x8d, 0x24, 0xf3, 0x15, 0x41, 0x1e, 0x00, 0xd1, 0xd5,
+	0x04, 0x59, 0x0d, 0x41, 0xd8, 0xed, 0x72, 0x1c, 0x3b, 0x05, 0x35, 0xf1, 0x35, 0xcd, 0x1c, 0x9f,
+	0x0e, 0xf1, 0x48, 0xe1, 0xe4, 0x1e, 0x6c, 0x86, 0xfd, 0x3e, 0x9b, 0x60, 0x1c, 0x44, 0xfd, 0x50,
+	0x08, 0x14, 0x4e, 0xb1, 0x6e, 0x37, 0x4a, 0x7e, 0xc5, 0xc0, 0x6d, 0x8d, 0x92, 0x27, 0xb0, 0x11,
+	0x87, 0x12, 0x83, 0x88, 0xa7, 0x12, 0x79, 0x1a, 0x3a, 0x6b, 0x75, 0xab, 0x51, 0x3e, 0xb8, 0xe7,
+	0xbd, 0xd3, 0x2d, 0xef, 0x71, 0x28, 0xb1, 0x6d, 0xe4, 0xfe, 0x7a, 0x3c, 0x37, 0x22, 0xbf, 0x80,
+	0xfd, 0x0c, 0xd1, 0x29, 0xd5, 0xed, 0x46, 0xf9, 0xe0, 0x96, 0xa7, 0x6d, 0xcb, 0x42, 0xd1, 0x33,
+	0xb6, 0x7a, 0x6d, 0x96, 0xd2, 0xd6, 0xe7, 0xaf, 0xde, 0xec, 0x2c, 0xfd, 0xfe, 0xf7, 0x4e, 0x23,
+	0x49, 0xe5, 0xf3, 0x51, 0xd7, 0x8b, 0xd8, 0xa0, 0x69, 0x3c, 0xd6, 0x3f, 0x7b, 0x22, 0xee, 0x19,
+	0xb3, 0xb2, 0x00, 0xe1, 0x67, 0x79, 0x1f, 0xad, 0xff, 0xf6, 0xef, 0x1f, 0xf7, 0xa7, 0xbe, 0xb8,
+	0x5f, 0xc0, 0x56, 0x6e, 0x9f, 0x8f, 0x62, 0xc8, 0xa8, 0x40, 0x72, 0x07, 0xd6, 0xf5, 0x4a, 0x83,
+	0x18, 0x29, 0x1b, 0x18, 0x2f, 0xcb, 0x1a, 0x7b, 0x9c, 0x41, 0xee, 0x4b, 0x0b, 0x0a, 0x1d, 0x91,
+	0x3c, 0x1d, 0x49, 0xb2, 0x0d, 0xab, 0x6c, 0x42, 0x71, 0x6a, 0xb9, 0x1e, 0x5c, 0xca, 0xb1, 0x7c,
+	0x29, 0x07, 0x39, 0x82, 0xa2, 0xde, 0x17, 0xe1, 0xd8, 0x75, 0xfb, 0x8a, 0x0d, 0x6b, 0xa9, 0xaf,
+	0xb6, 0x82, 0xfd, 0x69, 0xdc, 0x23, 0xc8, 0x8a, 0xd1, 0x33, 0xba, 0x5f, 0x41, 0x45, 0xaf, 0x28,
+	0xaf, 0x23, 0x33, 0x70, 0xc0, 0x46, 0x54, 0x06, 0x1c, 0x23, 0x4c, 0xc7, 0x18, 0x9b, 0x35, 0x56,
+	0x34, 0xec, 0x1b, 0xd4, 0x7d, 0xb3, 0x0c, 0xc5, 0x8e, 0x48, 0x8e, 0xc3, 0x1e, 0x7e, 0x7c, 0x39,
+	0x37, 0xa0, 0xa0, 0xd3, 0x9a, 0x4e, 0x36, 0x23, 0x72, 0x08, 0xd7, 0x75, 0x5f, 0x0e, 0x90, 0xca,
+	0xa0, 0xcf, 0xa2, 0x50, 0xb5, 0x7b, 0xd6, 0xcf, 0x25, 0xd5, 0xcf, 0x64, 0x46, 0x3f, 0x31, 0x2c,
+	0xb9, 0x0b, 0x15, 0x8d, 0x06, 0x8c, 0x06, 0x32, 0xec, 0x4d, 0x9b, 0x7a, 0x5d, 0xa3, 0xdf, 0x52,
+	0xb5, 0xd6, 0x2f, 0xe1, 0xe6, 0x5c, 0xea, 0x5f, 0x47, 0x3c, 0x15, 0x71, 0x1a, 0xa9, 0xf4, 0xba,
+	0xa9, 0x6f, 0xcc, 0xe8, 0x6f, 0xe6, 0x58, 0xb2, 0x0b, 0x5b, 0x73, 0x81, 0x1c, 0x43, 0xc1, 0xa8,
+	0x53, 0xd4, 0xe7, 0x60, 0x46, 0xf8, 0x0a, 0x27, 0x0f, 0x61, 0x2e, 0x4d, 0xd0, 0x45, 0x8a, 0xcf,
+	0xd2, 0x28, 0x0d, 0xf9, 0xa9, 0xea, 0xf3, 0x92, 0xff, 0xc9, 0x8c, 0x6d, 0xcd, 0xc8, 0xb7, 0xbc,
+	0x39, 0x86, 0x4d, 0xb3, 0xbf, 0xb9, 0x39, 0x73, 0xee, 0x5b, 0x1f, 0xe7, 0xbe, 0xcb, 0x80, 0x74,
+	0x44, 0xf2, 0xfd, 0x30, 0x3b, 0x3e, 0x5a, 0xf1, 0x35, 0x22, 0xb9, 0x0d, 0xa5, 0x70, 0x24, 0x9f,
+	0x33, 0x9e, 0xca, 0x53, 0x63, 0xe2, 0x0c, 0x20, 0xbb, 0xfa, 0x74, 0x2d, 0xd7, 0xad, 0x85, 0xa7,
+	0x4b, 0x9f, 0x95, 0x4a, 0x56, 0xc2, 0x2c, 0xd8, 0xbd, 0x0d, 0xd5, 0xcb, 0x13, 0x4e, 0x2b, 0x72,
+	0x5f, 0xc0, 0xb5, 0x9c, 0x6d, 0x9b, 0x7b, 0xef, 0xdd, 0x37, 0xe2, 0x36, 0xac, 0xce, 0xb7, 0x92,
+	0x1e, 0x90, 0x1d, 0x28, 0x53, 0x9c, 0x04, 0xd3, 0x18, 0xdd, 0x49, 0x40, 0x71, 0x62, 0x12, 0x5e,
+	0x38, 0xbe, 0x55, 0x70, 0x2e, 0x4e, 0x39, 0x5d, 0xce, 0xc1, 0x7f, 0x36, 0xd8, 0x1d, 0x91, 0x90,
+	0x9f, 0xa1, 0x60, 0xae, 0xe7, 0xbb, 0x0b, 0x76, 0x38, 0xbf, 0x05, 0xaa, 0x0f, 0xde, 0x47, 0x95,
+	0xdb, 0xf8, 0x1d, 0xd8, 0xd9, 0x25, 0x70, 0x67, 0x71, 0xd0, 0xd3, 0x91, 0xac, 0x7e, 0x76, 0xa5,
+	0x24, 0x4f, 0xfa, 0x03, 0xac, 0xa8, 0xfe, 0x76, 0x17, 0x87, 0x64, 0x9a, 0xea, 0xfd, 0xab, 0x35,
+	0x79, 0xde, 0x09, 0x6c, 0x5e, 0xec, 0x96, 0xbd, 0xc5, 0xe1, 0x17, 0xe4, 0xd5, 0x87, 0x1f, 0x24,
+	0xcf, 0x27, 0x7e, 0x01, 0x1b, 0x6f, 0xf7, 0xc5, 0xee, 0xfb, 0xe4, 0x31, 0xe2, 0xea, 0xe1, 0x07,
+	0x88, 0xa7, 0x53, 0xb6, 0x7e, 0x7a, 0x75, 0x56, 0xb3, 0x5e, 0x9f, 0xd5, 0xac, 0x7f, 0xce, 0x6a,
+	0xd6, 0xcb, 0xf3, 0xda, 0xd2, 0xeb, 0xf3, 0xda, 0xd2, 0x5f, 0xe7, 0xb5, 0xa5, 0x1f, 0x8f, 0xe6,
+	0x1e, 0x0c, 0x95, 0x78, 0x8f, 0xa2, 0x9c, 0x30, 0xde, 0x33, 0xa3, 0x3e, 0xc6, 0x09, 0xf2, 0xe6,
+	0xc9, 0xe5, 0xc7, 0x5f, 0x3d, 0x26, 0xd9, 0x9f, 0x8b, 0x82, 0x7a, 0xfd, 0x0f, 0xff, 0x1f, 0x00,
+	0xa5, 0x97, 0x80, 0x5e, 0xb4, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1291,6 +1320,20 @@ func (m *MsgTake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RetirementBeneficiary) > 0 {
+		i -= len(m.RetirementBeneficiary)
+		copy(dAtA[i:], m.RetirementBeneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RetirementBeneficiary)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RetirementReason) > 0 {
+		i -= len(m.RetirementReason)
+		copy(dAtA[i:], m.RetirementReason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RetirementReason)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.RetirementJurisdiction) > 0 {
 		i -= len(m.RetirementJurisdiction)
 		copy(dAtA[i:], m.RetirementJurisdiction)
@@ -1644,6 +1687,14 @@ func (m *MsgTake) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.RetirementReason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RetirementBeneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2564,6 +2615,70 @@ func (m *MsgTake) Unmarshal(dAtA []byte) error {
 			}
 			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementBeneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementBeneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
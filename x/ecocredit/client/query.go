@@ -7,6 +7,7 @@ import (
 
 	baseclient "github.com/regen-network/regen-ledger/x/ecocredit/base/client"
 	basketclient "github.com/regen-network/regen-ledger/x/ecocredit/basket/client"
+	ibcclient "github.com/regen-network/regen-ledger/x/ecocredit/ibc/client"
 	marketclient "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/client"
 )
 
@@ -44,6 +45,46 @@ func QueryCmd(name string) *cobra.Command {
 		baseclient.QueryClassFeeCmd(),
 		baseclient.QueryAllBalances(),
 		baseclient.QueryAllowedBridgeChains(),
+		baseclient.QueryRetirementCmd(),
+		baseclient.QueryRetirementsByOwnerCmd(),
+		baseclient.QueryRetirementsByBatchCmd(),
+		baseclient.QueryCertificateCmd(),
+		baseclient.QueryCertificatesByOwnerCmd(),
+		baseclient.QueryCreditLockCmd(),
+		baseclient.QueryCreditLocksByOwnerCmd(),
+		baseclient.QueryCreditLocksByBatchCmd(),
+		baseclient.QueryClassBufferCmd(),
+		baseclient.QueryReversalCmd(),
+		baseclient.QueryReversalsByClassCmd(),
+		baseclient.QueryClassVerifiersCmd(),
+		baseclient.QueryProjectsByStatusCmd(),
+		baseclient.QueryProjectStatusHistoryCmd(),
+		baseclient.QueryIssuanceHeadroomCmd(),
+		baseclient.QueryClassBatchApprovalCmd(),
+		baseclient.QueryPendingBatchCmd(),
+		baseclient.QueryPendingBatchesByClassCmd(),
+		baseclient.QueryBatchMetadataHistoryCmd(),
+		baseclient.QueryClassBatchMetadataCosignCmd(),
+		baseclient.QueryProjectSupplyCmd(),
+		baseclient.QueryClassSupplyCmd(),
+		baseclient.QueryCreditTypeSupplyCmd(),
+		baseclient.QueryBalancesByClassCmd(),
+		baseclient.QueryBalancesByProjectCmd(),
+		baseclient.QueryHoldersByProjectCmd(),
+		baseclient.QueryRetirementSummaryCmd(),
+		baseclient.QueryBatchByOriginTxCmd(),
+		baseclient.QueryStrictJurisdictionsCmd(),
+		baseclient.QueryProjectsByJurisdictionCmd(),
+		baseclient.QueryRetirementsByJurisdictionCmd(),
+		baseclient.QueryNationalRegistriesCmd(),
+		baseclient.QueryArticle6AuthorizationsByBatchCmd(),
+		baseclient.QueryClassSerializationCmd(),
+		baseclient.QuerySerialsByBatchCmd(),
+		baseclient.QuerySerialsByOwnerCmd(),
+		baseclient.QueryBridgeRequestsByOwnerCmd(),
+		baseclient.QueryBridgeRequestsByStatusCmd(),
+		baseclient.QueryAllowedBridgeServicesCmd(),
+		baseclient.QueryAllowedBridgeContractsCmd(),
 		basketclient.QueryBasketCmd(),
 		basketclient.QueryBasketsCmd(),
 		basketclient.QueryBasketBalanceCmd(),
@@ -54,6 +95,9 @@ func QueryCmd(name string) *cobra.Command {
 		marketclient.QuerySellOrdersBySellerCmd(),
 		marketclient.QuerySellOrdersByBatchCmd(),
 		marketclient.QueryAllowedDenomsCmd(),
+		ibcclient.QueryDenomTraceCmd(),
+		ibcclient.QueryDenomTracesCmd(),
+		ibcclient.QueryEscrowAddressCmd(),
 	)
 	return cmd
 }
@@ -302,6 +302,9 @@ func (s *IntegrationTestSuite) commonTxFlags() []string {
 		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+		// retiring credits mints a retirement certificate, which exceeds the
+		// default gas limit when combined with other operations
+		fmt.Sprintf("--%s=%d", flags.FlagGas, 400000),
 	}
 }
 
@@ -929,7 +929,7 @@ func (s *IntegrationTestSuite) TestTxUpdateIssuers() {
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, admin),
 			},
 			expErr:    true,
-			expErrMsg: "must specify at least one of add_issuers or remove_issuers",
+			expErrMsg: "must specify at least one of add_issuers, remove_issuers, issuer_caps, or project_vintage_cap",
 		},
 		{
 			name: "valid add issuer",
@@ -7,6 +7,7 @@ import (
 
 	baseclient "github.com/regen-network/regen-ledger/x/ecocredit/base/client"
 	basketclient "github.com/regen-network/regen-ledger/x/ecocredit/basket/client"
+	ibcclient "github.com/regen-network/regen-ledger/x/ecocredit/ibc/client"
 	marketclient "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/client"
 )
 
@@ -32,15 +33,41 @@ func TxCmd(name string) *cobra.Command {
 		baseclient.TxCreateProjectCmd(),
 		baseclient.TxGenBatchJSONCmd(),
 		baseclient.TxCreateBatchCmd(),
+		baseclient.TxProposeBatchCmd(),
+		baseclient.TxApproveBatchCmd(),
+		baseclient.TxRejectBatchCmd(),
+		baseclient.TxUpdateBatchMetadataCmd(),
 		baseclient.TxSendCmd(),
 		baseclient.TxSendBulkCmd(),
 		baseclient.TxRetireCmd(),
 		baseclient.TxCancelCmd(),
+		baseclient.TxApproveCmd(),
+		baseclient.TxRevokeApprovalCmd(),
+		baseclient.TxSendFromCmd(),
+		baseclient.TxRetireFromCmd(),
+		baseclient.TxGrantSendCmd(),
+		baseclient.TxGrantRetireCmd(),
+		baseclient.TxSendLockedCmd(),
+		baseclient.TxReverseCreditsCmd(),
 		baseclient.TxUpdateClassMetadataCmd(),
 		baseclient.TxUpdateClassIssuersCmd(),
+		baseclient.TxUpdateClassVerifiersCmd(),
 		baseclient.TxUpdateClassAdminCmd(),
+		baseclient.TxUpdateClassBufferCmd(),
+		baseclient.TxUpdateClassBatchApprovalCmd(),
+		baseclient.TxUpdateClassBatchMetadataCosignCmd(),
+		baseclient.TxUpdateClassStatusCmd(),
+		baseclient.TxMigrateProjectCmd(),
 		baseclient.TxUpdateProjectAdminCmd(),
 		baseclient.TxUpdateProjectMetadataCmd(),
+		baseclient.TxUpdateProjectStatusCmd(),
+		baseclient.TxSetArticle6AuthorizationCmd(),
+		baseclient.TxUpdateClassSerializationCmd(),
+		baseclient.TxLinkBatchContractCmd(),
+		baseclient.TxAcknowledgeBridgeCmd(),
+		baseclient.TxFailBridgeCmd(),
+		baseclient.TxWrapCmd(),
+		baseclient.TxUnwrapCmd(),
 		basketclient.TxCreateBasketCmd(),
 		basketclient.TxPutInBasketCmd(),
 		basketclient.TxTakeFromBasketCmd(),
@@ -50,6 +77,7 @@ func TxCmd(name string) *cobra.Command {
 		marketclient.TxBuyDirectCmd(),
 		marketclient.TxBuyDirectBulkCmd(),
 		marketclient.TxCancelSellOrderCmd(),
+		ibcclient.TxTransferCmd(),
 	)
 	return cmd
 }
@@ -1,10 +1,13 @@
 package ecocredit
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
 //go:generate mockgen -source=expected_keepers.go -package mocks -destination mocks/expected_keepers.go
@@ -42,3 +45,32 @@ type GovKeeper interface {
 	// GetDepositParams queries governance module deposit params
 	GetDepositParams(ctx sdk.Context) govv1.DepositParams
 }
+
+// NFTKeeper defines the expected interface needed to mint and query retirement
+// certificates.
+type NFTKeeper interface {
+	// SaveClass saves a new nft class.
+	SaveClass(ctx sdk.Context, class nft.Class) error
+
+	// HasClass checks if an nft class exists.
+	HasClass(ctx sdk.Context, classID string) bool
+
+	// Mint mints a new nft and sets the receiver as the owner.
+	Mint(ctx sdk.Context, token nft.NFT, receiver sdk.AccAddress) error
+
+	// HasNFT checks if an nft exists.
+	HasNFT(ctx sdk.Context, classID, id string) bool
+
+	// GetOwner returns the owner of an nft.
+	GetOwner(ctx sdk.Context, classID string, nftID string) sdk.AccAddress
+
+	// NFTs queries all nfts of a given class or owner.
+	NFTs(ctx context.Context, req *nft.QueryNFTsRequest) (*nft.QueryNFTsResponse, error)
+}
+
+// DataKeeper defines the expected interface needed to check that data has been
+// anchored in the data module.
+type DataKeeper interface {
+	// HasAnchor checks if data with the IRI has been anchored.
+	HasAnchor(ctx context.Context, iri string) (bool, error)
+}
@@ -17,6 +17,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	ibcapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/ibc/v1"
 	marketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/math"
@@ -24,6 +25,7 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	basetypes "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
 	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket/types/v1"
+	ibctypes "github.com/regen-network/regen-ledger/x/ecocredit/ibc/types/v1"
 	markettypes "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
 )
 
@@ -35,6 +37,8 @@ import (
 // - the tradable amount of each credit batch complies with the credit type precision
 // - the retired amount of each credit batch complies with the credit type precision
 // - the calculated total amount of each credit batch matches the total supply
+// - the aggregated supply of each project, credit class, and credit type matches
+// the total supply of its credit batches
 // An error is returned if any of these validation checks fail.
 func ValidateGenesis(data json.RawMessage) error {
 	db := dbm.NewMemDB()
@@ -237,7 +241,8 @@ func ValidateGenesis(data json.RawMessage) error {
 		return err
 	}
 
-	return nil
+	// verify the aggregated supplies match the supply of the credit batches
+	return validateAggregateSupply(ormCtx, ss)
 }
 
 func validateMsg(m proto.Message) error {
@@ -340,6 +345,210 @@ func validateMsg(m proto.Message) error {
 			return err
 		}
 		return msg.Validate()
+	case *baseapi.Retirement:
+		msg := &basetypes.Retirement{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.RetirementCertificate:
+		msg := &basetypes.RetirementCertificate{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.BatchAllowance:
+		msg := &basetypes.BatchAllowance{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.ClassAllowance:
+		msg := &basetypes.ClassAllowance{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.CreditLock:
+		msg := &basetypes.CreditLock{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.ClassBuffer:
+		msg := &basetypes.ClassBuffer{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.Reversal:
+		msg := &basetypes.Reversal{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.ClassVerifier:
+		msg := &basetypes.ClassVerifier{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.ProjectLifecycle:
+		msg := &basetypes.ProjectLifecycle{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.ProjectStatusChange:
+		msg := &basetypes.ProjectStatusChange{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.ClassIssuanceCap:
+		msg := &basetypes.ClassIssuanceCap{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.ProjectVintageIssuance:
+		msg := &basetypes.ProjectVintageIssuance{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.ClassBatchApproval:
+		msg := &basetypes.ClassBatchApproval{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.PendingBatch:
+		msg := &basetypes.PendingBatch{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.ClassBatchMetadataCosign:
+		msg := &basetypes.ClassBatchMetadataCosign{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.BatchMetadataChange:
+		msg := &basetypes.BatchMetadataChange{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.ProjectAlias:
+		msg := &basetypes.ProjectAlias{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.ClassLifecycle:
+		msg := &basetypes.ClassLifecycle{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.ProjectSupply:
+		msg := &basetypes.ProjectSupply{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.ClassSupply:
+		msg := &basetypes.ClassSupply{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.CreditTypeSupply:
+		msg := &basetypes.CreditTypeSupply{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.BatchBalanceIndex:
+		msg := &basetypes.BatchBalanceIndex{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.ProjectHolder:
+		msg := &basetypes.ProjectHolder{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.RetirementSummary:
+		msg := &basetypes.RetirementSummary{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.StrictJurisdictions:
+		msg := &basetypes.StrictJurisdictions{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.NationalRegistry:
+		msg := &basetypes.NationalRegistry{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.Article6Authorization:
+		msg := &basetypes.Article6Authorization{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.ClassSerialization:
+		msg := &basetypes.ClassSerialization{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.BatchSerialSequence:
+		msg := &basetypes.BatchSerialSequence{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.SerialRange:
+		msg := &basetypes.SerialRange{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.NativeBatchContract:
+		msg := &basetypes.NativeBatchContract{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.BridgeRequest:
+		msg := &basetypes.BridgeRequest{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.AllowedBridgeService:
+		msg := &basetypes.AllowedBridgeService{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *baseapi.AllowedBridgeContract:
+		msg := &basetypes.AllowedBridgeContract{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
 
 	// basket submodule
 	case *basketapi.Basket:
@@ -386,6 +595,32 @@ func validateMsg(m proto.Message) error {
 			return err
 		}
 		return msg.Validate()
+
+	// ibc submodule
+	case *ibcapi.VoucherClass:
+		msg := &ibctypes.VoucherClass{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *ibcapi.VoucherProject:
+		msg := &ibctypes.VoucherProject{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *ibcapi.VoucherBatch:
+		msg := &ibctypes.VoucherBatch{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
+	case *ibcapi.Port:
+		msg := &ibctypes.Port{}
+		if err := ormutil.PulsarToGogoSlow(m, msg); err != nil {
+			return err
+		}
+		return msg.Validate()
 	}
 
 	return nil
@@ -402,6 +637,7 @@ func calculateSupply(ctx context.Context, batchIDToPrecision map[uint64]uint32,
 		tradable := math.NewDecFromInt64(0)
 		retired := math.NewDecFromInt64(0)
 		escrowed := math.NewDecFromInt64(0)
+		locked := math.NewDecFromInt64(0)
 
 		balance, err := bbItr.Value()
 		if err != nil {
@@ -434,6 +670,13 @@ func calculateSupply(ctx context.Context, batchIDToPrecision map[uint64]uint32,
 			}
 		}
 
+		if balance.LockedAmount != "" {
+			locked, err = math.NewNonNegativeFixedDecFromString(balance.LockedAmount, precision)
+			if err != nil {
+				return err
+			}
+		}
+
 		total, err := math.Add(tradable, retired)
 		if err != nil {
 			return err
@@ -444,6 +687,11 @@ func calculateSupply(ctx context.Context, batchIDToPrecision map[uint64]uint32,
 			return err
 		}
 
+		total, err = math.Add(total, locked)
+		if err != nil {
+			return err
+		}
+
 		if supply, ok := batchIDToSupply[balance.BatchKey]; ok {
 			result, err := math.SafeAddBalance(supply, total)
 			if err != nil {
@@ -478,6 +726,224 @@ func validateSupply(batchIDToSupplyCal, batchIDToSupply map[uint64]math.Dec) err
 	return nil
 }
 
+// aggregateSupply is the tradable, retired, and cancelled supply of a project,
+// credit class, or credit type.
+type aggregateSupply [3]math.Dec
+
+func (a *aggregateSupply) add(amounts aggregateSupply) error {
+	for i := range a {
+		sum, err := math.SafeAddBalance(a[i], amounts[i])
+		if err != nil {
+			return err
+		}
+		a[i] = sum
+	}
+	return nil
+}
+
+func (a aggregateSupply) isZero() bool {
+	return a[0].IsZero() && a[1].IsZero() && a[2].IsZero()
+}
+
+func (a aggregateSupply) equal(tradable, retired, cancelled string) (bool, error) {
+	amounts, err := parseAggregateSupply(tradable, retired, cancelled)
+	if err != nil {
+		return false, err
+	}
+	for i := range a {
+		if a[i].Cmp(amounts[i]) != math.EqualTo {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func parseAggregateSupply(tradable, retired, cancelled string) (aggregateSupply, error) {
+	var amounts aggregateSupply
+	for i, amount := range [3]string{tradable, retired, cancelled} {
+		if amount == "" {
+			amounts[i] = math.NewDecFromInt64(0)
+			continue
+		}
+		dec, err := math.NewNonNegativeDecFromString(amount)
+		if err != nil {
+			return aggregateSupply{}, err
+		}
+		amounts[i] = dec
+	}
+	return amounts, nil
+}
+
+// validateAggregateSupply verifies that the aggregated supply of each project,
+// credit class, and credit type matches the sum of the supply of its credit
+// batches. A genesis state without any aggregated supply is valid because the
+// aggregated supply was not tracked before the v4 migration.
+func validateAggregateSupply(ctx context.Context, ss baseapi.StateStore) error {
+	projectSupplies := make(map[uint64]*aggregateSupply)
+	classSupplies := make(map[uint64]*aggregateSupply)
+	creditTypeSupplies := make(map[string]*aggregateSupply)
+
+	zero := math.NewDecFromInt64(0)
+	newSupply := func() *aggregateSupply {
+		return &aggregateSupply{zero, zero, zero}
+	}
+
+	bsItr, err := ss.BatchSupplyTable().List(ctx, baseapi.BatchSupplyPrimaryKey{})
+	if err != nil {
+		return err
+	}
+	defer bsItr.Close()
+
+	for bsItr.Next() {
+		batchSupply, err := bsItr.Value()
+		if err != nil {
+			return err
+		}
+
+		amounts, err := parseAggregateSupply(batchSupply.TradableAmount, batchSupply.RetiredAmount, batchSupply.CancelledAmount)
+		if err != nil {
+			return err
+		}
+
+		batch, err := ss.BatchTable().Get(ctx, batchSupply.BatchKey)
+		if err != nil {
+			return sdkerrors.ErrNotFound.Wrapf("credit batch %d of batch supply: %s", batchSupply.BatchKey, err)
+		}
+
+		project, err := ss.ProjectTable().Get(ctx, batch.ProjectKey)
+		if err != nil {
+			return sdkerrors.ErrNotFound.Wrapf("project %d of credit batch %s: %s", batch.ProjectKey, batch.Denom, err)
+		}
+
+		class, err := ss.ClassTable().Get(ctx, project.ClassKey)
+		if err != nil {
+			return sdkerrors.ErrNotFound.Wrapf("credit class %d of project %s: %s", project.ClassKey, project.Id, err)
+		}
+
+		if projectSupplies[project.Key] == nil {
+			projectSupplies[project.Key] = newSupply()
+		}
+		if classSupplies[class.Key] == nil {
+			classSupplies[class.Key] = newSupply()
+		}
+		if creditTypeSupplies[class.CreditTypeAbbrev] == nil {
+			creditTypeSupplies[class.CreditTypeAbbrev] = newSupply()
+		}
+
+		for _, supply := range []*aggregateSupply{
+			projectSupplies[project.Key],
+			classSupplies[class.Key],
+			creditTypeSupplies[class.CreditTypeAbbrev],
+		} {
+			if err := supply.add(amounts); err != nil {
+				return err
+			}
+		}
+	}
+
+	found := false
+
+	psItr, err := ss.ProjectSupplyTable().List(ctx, baseapi.ProjectSupplyPrimaryKey{})
+	if err != nil {
+		return err
+	}
+	defer psItr.Close()
+
+	for psItr.Next() {
+		found = true
+		supply, err := psItr.Value()
+		if err != nil {
+			return err
+		}
+		expected, ok := projectSupplies[supply.ProjectKey]
+		if !ok {
+			expected = newSupply()
+		}
+		equal, err := expected.equal(supply.TradableAmount, supply.RetiredAmount, supply.CancelledAmount)
+		if err != nil {
+			return err
+		}
+		if !equal {
+			return sdkerrors.ErrInvalidCoins.Wrapf("supply is incorrect for project %d", supply.ProjectKey)
+		}
+		delete(projectSupplies, supply.ProjectKey)
+	}
+
+	csItr, err := ss.ClassSupplyTable().List(ctx, baseapi.ClassSupplyPrimaryKey{})
+	if err != nil {
+		return err
+	}
+	defer csItr.Close()
+
+	for csItr.Next() {
+		found = true
+		supply, err := csItr.Value()
+		if err != nil {
+			return err
+		}
+		expected, ok := classSupplies[supply.ClassKey]
+		if !ok {
+			expected = newSupply()
+		}
+		equal, err := expected.equal(supply.TradableAmount, supply.RetiredAmount, supply.CancelledAmount)
+		if err != nil {
+			return err
+		}
+		if !equal {
+			return sdkerrors.ErrInvalidCoins.Wrapf("supply is incorrect for credit class %d", supply.ClassKey)
+		}
+		delete(classSupplies, supply.ClassKey)
+	}
+
+	ctsItr, err := ss.CreditTypeSupplyTable().List(ctx, baseapi.CreditTypeSupplyPrimaryKey{})
+	if err != nil {
+		return err
+	}
+	defer ctsItr.Close()
+
+	for ctsItr.Next() {
+		found = true
+		supply, err := ctsItr.Value()
+		if err != nil {
+			return err
+		}
+		expected, ok := creditTypeSupplies[supply.CreditTypeAbbrev]
+		if !ok {
+			expected = newSupply()
+		}
+		equal, err := expected.equal(supply.TradableAmount, supply.RetiredAmount, supply.CancelledAmount)
+		if err != nil {
+			return err
+		}
+		if !equal {
+			return sdkerrors.ErrInvalidCoins.Wrapf("supply is incorrect for credit type %s", supply.CreditTypeAbbrev)
+		}
+		delete(creditTypeSupplies, supply.CreditTypeAbbrev)
+	}
+
+	if !found {
+		return nil
+	}
+
+	for projectKey, supply := range projectSupplies {
+		if !supply.isZero() {
+			return sdkerrors.ErrNotFound.Wrapf("supply is not found for project %d", projectKey)
+		}
+	}
+	for classKey, supply := range classSupplies {
+		if !supply.isZero() {
+			return sdkerrors.ErrNotFound.Wrapf("supply is not found for credit class %d", classKey)
+		}
+	}
+	for creditType, supply := range creditTypeSupplies {
+		if !supply.isZero() {
+			return sdkerrors.ErrNotFound.Wrapf("supply is not found for credit type %s", creditType)
+		}
+	}
+
+	return nil
+}
+
 // MergeCreditTypesIntoTarget merges params message into the ormjson.WriteTarget.
 func MergeCreditTypesIntoTarget(messages []basetypes.CreditType, target ormjson.WriteTarget) error {
 	w, err := target.OpenWriter(protoreflect.FullName(gogoproto.MessageName(&messages[0])))
@@ -346,6 +346,31 @@ func TestGenesisValidate(t *testing.T) {
 			false,
 			"",
 		},
+		{
+			"valid: aggregated supply",
+			func(ctx context.Context, ss baseapi.StateStore) {
+				setupAggregateSupply(t, ctx, ss, "100")
+			},
+			false,
+			"",
+		},
+		{
+			"expect error: invalid aggregated supply",
+			func(ctx context.Context, ss baseapi.StateStore) {
+				setupAggregateSupply(t, ctx, ss, "90")
+			},
+			true,
+			"supply is incorrect for project 1",
+		},
+		{
+			"expect error: aggregated supply is missing",
+			func(ctx context.Context, ss baseapi.StateStore) {
+				setupAggregateSupply(t, ctx, ss, "100")
+				require.NoError(t, ss.ClassSupplyTable().Delete(ctx, &baseapi.ClassSupply{ClassKey: 1}))
+			},
+			true,
+			"supply is not found for credit class 1",
+		},
 		{
 			"valid test case, multiple classes and credit types",
 			func(ctx context.Context, ss baseapi.StateStore) {
@@ -1052,3 +1077,61 @@ func TestMergeBasketFeeIntoTarget(t *testing.T) {
 	require.Equal(t, basketFee.Fee.Amount.String(), expected.Fee.Amount)
 	require.Equal(t, basketFee.Fee.Denom, expected.Fee.Denom)
 }
+
+// setupAggregateSupply adds a credit batch with a tradable supply of 100 and
+// the aggregated supply of the project, credit class, and credit type of the
+// credit batch, using the provided tradable amount for the project.
+func setupAggregateSupply(t *testing.T, ctx context.Context, ss baseapi.StateStore, projectTradable string) {
+	admin := sdk.AccAddress("foobar")
+
+	require.NoError(t, ss.CreditTypeTable().Insert(ctx, &baseapi.CreditType{
+		Abbreviation: "C",
+		Name:         "carbon",
+		Unit:         "metric ton C02 equivalent",
+		Precision:    6,
+	}))
+	cKey, err := ss.ClassTable().InsertReturningID(ctx, &baseapi.Class{
+		Id:               "C01",
+		Admin:            admin,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(t, err)
+	pKey, err := ss.ProjectTable().InsertReturningID(ctx, &baseapi.Project{
+		Id:           "C01-001",
+		Admin:        admin,
+		ClassKey:     cKey,
+		Jurisdiction: "AQ",
+	})
+	require.NoError(t, err)
+	bKey, err := ss.BatchTable().InsertReturningID(ctx, &baseapi.Batch{
+		Issuer:       admin,
+		ProjectKey:   pKey,
+		Denom:        "C01-001-00000000-00000000-001",
+		StartDate:    &timestamppb.Timestamp{Seconds: 100},
+		EndDate:      &timestamppb.Timestamp{Seconds: 101},
+		IssuanceDate: &timestamppb.Timestamp{Seconds: 102},
+	})
+	require.NoError(t, err)
+	require.NoError(t, ss.BatchBalanceTable().Insert(ctx, &baseapi.BatchBalance{
+		BatchKey:       bKey,
+		Address:        admin,
+		TradableAmount: "100",
+	}))
+	require.NoError(t, ss.BatchSupplyTable().Insert(ctx, &baseapi.BatchSupply{
+		BatchKey:        bKey,
+		TradableAmount:  "100",
+		CancelledAmount: "0",
+	}))
+	require.NoError(t, ss.ProjectSupplyTable().Insert(ctx, &baseapi.ProjectSupply{
+		ProjectKey:     pKey,
+		TradableAmount: projectTradable,
+	}))
+	require.NoError(t, ss.ClassSupplyTable().Insert(ctx, &baseapi.ClassSupply{
+		ClassKey:       cKey,
+		TradableAmount: "100",
+	}))
+	require.NoError(t, ss.CreditTypeSupplyTable().Insert(ctx, &baseapi.CreditTypeSupply{
+		CreditTypeAbbrev: "C",
+		TradableAmount:   "100",
+	}))
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/ibc/types/v1"
+)
+
+// QueryDenomTraceCmd returns a query command that retrieves the denom trace of
+// a voucher credit batch.
+func QueryDenomTraceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-trace [batch-denom]",
+		Short:   "Retrieve the denom trace of a voucher credit batch",
+		Long:    "Retrieve the IBC path and the original batch denom of a credit batch received from another chain.",
+		Example: "regen q ecocredit denom-trace C02-001-20200101-20210101-001",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			res, err := client.DenomTrace(cmd.Context(), &types.QueryDenomTraceRequest{BatchDenom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// QueryDenomTracesCmd returns a query command that retrieves the denom traces
+// of all voucher credit batches with pagination.
+func QueryDenomTracesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-traces",
+		Short: "Retrieve the denom traces of all voucher credit batches",
+		Long:  "Retrieve the IBC path and the original batch denom of all credit batches received from another chain with pagination flags.",
+		Example: `regen q ecocredit denom-traces
+regen q ecocredit denom-traces --limit 10`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			res, err := client.DenomTraces(cmd.Context(), &types.QueryDenomTracesRequest{Pagination: pagination})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denom-traces")
+	return cmd
+}
+
+// QueryEscrowAddressCmd returns a query command that retrieves the address of
+// the escrow account of a channel.
+func QueryEscrowAddressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "ibc-escrow-address [port-id] [channel-id]",
+		Short:   "Retrieve the address of the escrow account of a channel",
+		Long:    "Retrieve the address of the account holding the credits sent over a channel using the ecocredit IBC application.",
+		Example: "regen q ecocredit ibc-escrow-address ecocredit channel-0",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := types.NewQueryClient(ctx)
+			res, err := client.EscrowAddress(cmd.Context(), &types.QueryEscrowAddressRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
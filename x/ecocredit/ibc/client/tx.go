@@ -0,0 +1,96 @@
+package client
+
+import (
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/ibc/types/v1"
+)
+
+const (
+	FlagPacketTimeoutHeight    = "packet-timeout-height"
+	FlagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+)
+
+// DefaultPacketTimeout is the default duration after which a packet times out
+// when neither a timeout height nor a timeout timestamp is provided.
+const DefaultPacketTimeout = 10 * time.Minute
+
+// TxTransferCmd returns a transaction command that sends credits to an account
+// on another chain.
+func TxTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ibc-transfer [src-port] [src-channel] [receiver] [batch-denom] [amount]",
+		Short: "Transfer credits to an account on another chain",
+		Long: strings.TrimSpace(`Transfer tradable credits to an account on another chain using the ecocredit IBC application.
+
+Credits from credit batches issued on this chain are escrowed while voucher credits returned to
+the chain they were received from are cancelled. The credits are returned to the sender if the
+packet times out or the receiving chain fails to receive the credits.
+
+Timeouts are absolute. The timeout height is formatted as {revision-number}-{revision-height}
+and the timeout timestamp is in nanoseconds. If neither timeout is provided, the packet times
+out 10 minutes after the local time of the client.
+
+Parameters:
+
+- src-port:     the port on which the packet will be sent
+- src-channel:  the channel by which the packet will be sent
+- receiver:     the address of the account receiving the credits on the other chain
+- batch-denom:  the batch denom of the credits
+- amount:       the amount of tradable credits`),
+		Example: `regen tx ecocredit ibc-transfer ecocredit channel-0 regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw C01-001-20200101-20210101-001 10 --from alice
+regen tx ecocredit ibc-transfer ecocredit channel-0 regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw C01-001-20200101-20210101-001 10 --packet-timeout-height 1-1000 --from alice`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			timeoutHeight, err := cmd.Flags().GetString(FlagPacketTimeoutHeight)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(FlagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			if (timeoutHeight == "" || timeoutHeight == "0-0") && timeoutTimestamp == 0 {
+				timeoutTimestamp = uint64(time.Now().Add(DefaultPacketTimeout).UnixNano())
+			}
+
+			msg := types.MsgTransfer{
+				SourcePort:       args[0],
+				SourceChannel:    args[1],
+				Sender:           clientCtx.GetFromAddress().String(),
+				Receiver:         args[2],
+				BatchDenom:       args[3],
+				Amount:           args[4],
+				TimeoutHeight:    timeoutHeight,
+				TimeoutTimestamp: timeoutTimestamp,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagPacketTimeoutHeight, "", "the absolute timeout height on the receiving chain formatted as {revision-number}-{revision-height}")
+	cmd.Flags().Uint64(FlagPacketTimeoutTimestamp, 0, "the absolute timeout timestamp on the receiving chain in nanoseconds")
+
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	return cmd
+}
@@ -0,0 +1,11 @@
+package ibc
+
+import "cosmossdk.io/errors"
+
+var codespace = "ecocredit/ibc"
+
+var (
+	ErrInvalidVersion       = errors.Register(codespace, 2, "invalid ecocredit IBC version")
+	ErrInvalidPacketTimeout = errors.Register(codespace, 3, "invalid packet timeout")
+	ErrTraceNotFound        = errors.Register(codespace, 4, "denom trace not found")
+)
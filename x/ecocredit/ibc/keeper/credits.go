@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// getBatchByFullDenom returns the credit batch on this chain identified by the
+// full denom path, which is either the denom of a credit batch issued on this
+// chain or the trace of a voucher credit batch.
+func (k Keeper) getBatchByFullDenom(ctx context.Context, denom string) (*baseapi.Batch, error) {
+	if !strings.Contains(denom, "/") {
+		return k.baseStore.BatchTable().GetByDenom(ctx, denom)
+	}
+
+	voucher, err := k.stateStore.VoucherBatchTable().GetByTrace(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.baseStore.BatchTable().Get(ctx, voucher.BatchKey)
+}
+
+// getFullDenomPath returns the full denom path of a credit batch on this chain,
+// which is the batch denom for credit batches issued on this chain and the
+// trace for voucher credit batches.
+func (k Keeper) getFullDenomPath(ctx context.Context, batch *baseapi.Batch) (string, error) {
+	voucher, err := k.stateStore.VoucherBatchTable().Get(ctx, batch.Key)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return batch.Denom, nil
+		}
+		return "", err
+	}
+	return voucher.Trace, nil
+}
+
+// getAmount parses the amount of credits using the precision of the credit
+// type of the credit batch.
+func (k Keeper) getAmount(ctx context.Context, batch *baseapi.Batch, amount string) (math.Dec, error) {
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.baseStore, batch.Denom)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	dec, err := math.NewPositiveFixedDecFromString(amount, creditType.Precision)
+	if err != nil {
+		return math.Dec{}, sdkerrors.ErrInvalidRequest.Wrapf("amount: %s", err)
+	}
+
+	return dec, nil
+}
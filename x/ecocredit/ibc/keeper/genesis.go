@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	types "github.com/regen-network/regen-ledger/x/ecocredit/ibc/types/v1"
+)
+
+// InitGenesis sets the port of the ecocredit IBC application and binds the
+// application to the port. Voucher state is imported with the genesis state
+// of the ecocredit module.
+func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) {
+	if err := k.SetPort(sdk.WrapSDKContext(ctx), state.PortId); err != nil {
+		panic(err)
+	}
+
+	// only bind to the port if the port is not already bound, since the port
+	// capability may already be owned from capability InitGenesis
+	if !k.IsBound(ctx, state.PortId) {
+		if err := k.BindPort(ctx, state.PortId); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+}
+
+// ExportGenesis exports the port of the ecocredit IBC application.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	portID, err := k.GetPort(sdk.WrapSDKContext(ctx))
+	if err != nil {
+		panic(err)
+	}
+	return &types.GenesisState{PortId: portID}
+}
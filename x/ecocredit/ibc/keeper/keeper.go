@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/ibc/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/ibc"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/ibc/types/v1"
+)
+
+var (
+	_ types.MsgServer   = Keeper{}
+	_ types.QueryServer = Keeper{}
+)
+
+// Keeper is the ecocredit IBC application keeper.
+type Keeper struct {
+	stateStore    api.StateStore
+	baseStore     baseapi.StateStore
+	ics4Wrapper   ibc.ICS4Wrapper
+	channelKeeper ibc.ChannelKeeper
+	portKeeper    ibc.PortKeeper
+	scopedKeeper  ibc.ScopedKeeper
+	moduleAddress sdk.AccAddress
+}
+
+// NewKeeper returns a new keeper instance.
+func NewKeeper(
+	ss api.StateStore,
+	bs baseapi.StateStore,
+	ics4Wrapper ibc.ICS4Wrapper,
+	channelKeeper ibc.ChannelKeeper,
+	portKeeper ibc.PortKeeper,
+	scopedKeeper ibc.ScopedKeeper,
+) Keeper {
+	return Keeper{
+		stateStore:    ss,
+		baseStore:     bs,
+		ics4Wrapper:   ics4Wrapper,
+		channelKeeper: channelKeeper,
+		portKeeper:    portKeeper,
+		scopedKeeper:  scopedKeeper,
+		moduleAddress: authtypes.NewModuleAddress(ibc.SubModuleName),
+	}
+}
+
+// IsBound checks if the ecocredit IBC application is already bound to the
+// desired port.
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort binds the ecocredit IBC application to the port and claims the
+// returned capability.
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	capability := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(portID))
+}
+
+// GetPort returns the port the ecocredit IBC application is bound to.
+func (k Keeper) GetPort(ctx context.Context) (string, error) {
+	port, err := k.stateStore.PortTable().Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	return port.PortId, nil
+}
+
+// SetPort sets the port the ecocredit IBC application is bound to.
+func (k Keeper) SetPort(ctx context.Context, portID string) error {
+	return k.stateStore.PortTable().Save(ctx, &api.Port{PortId: portID})
+}
+
+// AuthenticateCapability wraps the scoped keeper function to authenticate
+// capabilities owned by the ecocredit IBC application.
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, capability, name)
+}
+
+// ClaimCapability wraps the scoped keeper function to claim capabilities
+// for the ecocredit IBC application.
+func (k Keeper) ClaimCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, capability, name)
+}
@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
+	"github.com/cosmos/cosmos-sdk/orm/testing/ormtest"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/ibc/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+const (
+	testClassID    = "C01"
+	testProjectID  = "C01-001"
+	testBatchDenom = "C01-001-20200101-20210101-001"
+)
+
+type baseSuite struct {
+	t          *testing.T
+	stateStore api.StateStore
+	baseStore  baseapi.StateStore
+	sdkCtx     sdk.Context
+	ctx        context.Context
+	k          Keeper
+	addr       sdk.AccAddress
+	addr2      sdk.AccAddress
+}
+
+func setupBase(t *testing.T) *baseSuite {
+	s := &baseSuite{t: t}
+
+	db, err := ormdb.NewModuleDB(&ecocredit.ModuleSchema, ormdb.ModuleDBOptions{})
+	require.NoError(t, err)
+	s.stateStore, err = api.NewStateStore(db)
+	require.NoError(t, err)
+	s.baseStore, err = baseapi.NewStateStore(db)
+	require.NoError(t, err)
+
+	memDB := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(memDB)
+	cms.MountStoreWithDB(sdk.NewKVStoreKey("test"), storetypes.StoreTypeIAVL, memDB)
+	require.NoError(t, cms.LoadLatestVersion())
+	ormCtx := ormtable.WrapContextDefault(ormtest.NewMemoryBackend())
+	s.sdkCtx = sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger()).WithContext(ormCtx)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	// packet relay and queries do not use the IBC keepers
+	s.k = NewKeeper(s.stateStore, s.baseStore, nil, nil, nil, nil)
+
+	_, _, s.addr = testdata.KeyTestPubAddr()
+	_, _, s.addr2 = testdata.KeyTestPubAddr()
+
+	require.NoError(t, s.baseStore.CreditTypeTable().Insert(s.ctx, &baseapi.CreditType{
+		Abbreviation: "C",
+		Name:         "carbon",
+		Unit:         "metric ton CO2 equivalent",
+		Precision:    6,
+	}))
+
+	return s
+}
+
+// setupBatch adds a credit class, project and credit batch issued on this
+// chain and sets the tradable balance of the owner.
+func (s *baseSuite) setupBatch(owner sdk.AccAddress, tradable string) *baseapi.Batch {
+	classKey, err := s.baseStore.ClassTable().InsertReturningID(s.ctx, &baseapi.Class{
+		Id:               testClassID,
+		Admin:            s.addr,
+		Metadata:         "regen:class-metadata",
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(s.t, err)
+
+	require.NoError(s.t, s.baseStore.ClassSequenceTable().Insert(s.ctx, &baseapi.ClassSequence{
+		CreditTypeAbbrev: "C",
+		NextSequence:     2,
+	}))
+
+	projectKey, err := s.baseStore.ProjectTable().InsertReturningID(s.ctx, &baseapi.Project{
+		Id:           testProjectID,
+		Admin:        s.addr,
+		ClassKey:     classKey,
+		Jurisdiction: "US-WA",
+		Metadata:     "regen:project-metadata",
+	})
+	require.NoError(s.t, err)
+
+	require.NoError(s.t, s.baseStore.ProjectSequenceTable().Insert(s.ctx, &baseapi.ProjectSequence{
+		ClassKey:     classKey,
+		NextSequence: 2,
+	}))
+
+	batch := &baseapi.Batch{
+		Issuer:       s.addr,
+		ProjectKey:   projectKey,
+		Denom:        testBatchDenom,
+		Metadata:     "regen:batch-metadata",
+		StartDate:    timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		EndDate:      timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+		IssuanceDate: timestamppb.New(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	batch.Key, err = s.baseStore.BatchTable().InsertReturningID(s.ctx, batch)
+	require.NoError(s.t, err)
+
+	require.NoError(s.t, s.baseStore.BatchSequenceTable().Insert(s.ctx, &baseapi.BatchSequence{
+		ProjectKey:   projectKey,
+		NextSequence: 2,
+	}))
+
+	require.NoError(s.t, s.baseStore.BatchSupplyTable().Insert(s.ctx, &baseapi.BatchSupply{
+		BatchKey:        batch.Key,
+		TradableAmount:  tradable,
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	}))
+
+	require.NoError(s.t, s.baseStore.BatchBalanceTable().Insert(s.ctx, &baseapi.BatchBalance{
+		BatchKey:       batch.Key,
+		Address:        owner,
+		TradableAmount: tradable,
+		RetiredAmount:  "0",
+		EscrowedAmount: "0",
+	}))
+
+	return batch
+}
+
+func (s *baseSuite) requireTradable(addr sdk.AccAddress, batchKey uint64, expected string) {
+	balance, err := s.baseStore.BatchBalanceTable().Get(s.ctx, addr, batchKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, expected, balance.TradableAmount)
+}
+
+func (s *baseSuite) requireSupply(batchKey uint64, tradable, cancelled string) {
+	supply, err := s.baseStore.BatchSupplyTable().Get(s.ctx, batchKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, tradable, supply.TradableAmount)
+	require.Equal(s.t, cancelled, supply.CancelledAmount)
+}
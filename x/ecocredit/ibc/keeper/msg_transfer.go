@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	basekeeper "github.com/regen-network/regen-ledger/x/ecocredit/base/keeper"
+	"github.com/regen-network/regen-ledger/x/ecocredit/ibc"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/ibc/types/v1"
+)
+
+// Transfer sends tradable credits to an account on another chain. If this
+// chain is the source of the credits with respect to the channel, the credits
+// are escrowed in the escrow account of the channel. Otherwise the credits are
+// voucher credits being returned to the chain they were received from and the
+// credits are cancelled.
+func (k Keeper) Transfer(ctx context.Context, req *types.MsgTransfer) (*types.MsgTransferResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutHeight, err := req.ParseTimeoutHeight()
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.baseStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	amount, err := k.getAmount(ctx, batch, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, found := k.channelKeeper.GetChannel(sdkCtx, req.SourcePort, req.SourceChannel)
+	if !found {
+		return nil, channeltypes.ErrChannelNotFound.Wrapf("port ID (%s) channel ID (%s)", req.SourcePort, req.SourceChannel)
+	}
+
+	sequence, found := k.channelKeeper.GetNextSequenceSend(sdkCtx, req.SourcePort, req.SourceChannel)
+	if !found {
+		return nil, channeltypes.ErrSequenceSendNotFound.Wrapf("port ID (%s) channel ID (%s)", req.SourcePort, req.SourceChannel)
+	}
+
+	channelCap, ok := k.scopedKeeper.GetCapability(sdkCtx, host.ChannelCapabilityPath(req.SourcePort, req.SourceChannel))
+	if !ok {
+		return nil, channeltypes.ErrChannelCapabilityNotFound.Wrap("module does not own channel capability")
+	}
+
+	denom, err := k.getFullDenomPath(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	if ibc.SenderChainIsSource(req.SourcePort, req.SourceChannel, denom) {
+		escrow := ibc.EscrowAddress(req.SourcePort, req.SourceChannel)
+		if err = basekeeper.TransferCredits(ctx, k.baseStore, batch, sender, escrow, amount); err != nil {
+			return nil, err
+		}
+	} else {
+		reason := fmt.Sprintf("ibc transfer over %s/%s", req.SourcePort, req.SourceChannel)
+		if err = basekeeper.CancelCredits(ctx, k.baseStore, batch, sender, amount, reason); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := k.newPacketData(ctx, batch, denom, amount.String(), req.Sender, req.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	packet := channeltypes.NewPacket(
+		data.GetBytes(),
+		sequence,
+		req.SourcePort,
+		req.SourceChannel,
+		channel.GetCounterparty().GetPortID(),
+		channel.GetCounterparty().GetChannelID(),
+		timeoutHeight,
+		req.TimeoutTimestamp,
+	)
+
+	if err = k.ics4Wrapper.SendPacket(sdkCtx, channelCap, packet); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&types.EventSendPacket{
+		Sender:        req.Sender,
+		Receiver:      req.Receiver,
+		BatchDenom:    batch.Denom,
+		Denom:         denom,
+		Amount:        data.Amount,
+		SourcePort:    req.SourcePort,
+		SourceChannel: req.SourceChannel,
+		Sequence:      sequence,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTransferResponse{Sequence: sequence}, nil
+}
+
+// newPacketData returns the packet data for credits from the credit batch,
+// including the credit class, project, and credit batch information needed
+// to create voucher credit batches on the receiving chain.
+func (k Keeper) newPacketData(ctx context.Context, batch *baseapi.Batch, denom, amount, sender, receiver string) (types.EcocreditPacketData, error) {
+	project, err := k.baseStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return types.EcocreditPacketData{}, err
+	}
+
+	class, err := k.baseStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return types.EcocreditPacketData{}, err
+	}
+
+	return types.EcocreditPacketData{
+		Denom:    denom,
+		Amount:   amount,
+		Sender:   sender,
+		Receiver: receiver,
+		Class: &types.EcocreditPacketData_ClassData{
+			CreditTypeAbbrev: class.CreditTypeAbbrev,
+			Metadata:         class.Metadata,
+		},
+		Project: &types.EcocreditPacketData_ProjectData{
+			Jurisdiction: project.Jurisdiction,
+			Metadata:     project.Metadata,
+			ReferenceId:  project.ReferenceId,
+		},
+		Batch: &types.EcocreditPacketData_BatchData{
+			Metadata:     batch.Metadata,
+			StartDate:    regentypes.ProtobufToGogoTimestamp(batch.StartDate),
+			EndDate:      regentypes.ProtobufToGogoTimestamp(batch.EndDate),
+			IssuanceDate: regentypes.ProtobufToGogoTimestamp(batch.IssuanceDate),
+		},
+	}, nil
+}
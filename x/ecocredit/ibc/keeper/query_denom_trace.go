@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/ibc"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/ibc/types/v1"
+)
+
+// DenomTrace queries the denom trace of a voucher credit batch.
+func (k Keeper) DenomTrace(ctx context.Context, request *types.QueryDenomTraceRequest) (*types.QueryDenomTraceResponse, error) {
+	batch, err := k.baseStore.BatchTable().GetByDenom(ctx, request.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", request.BatchDenom, err.Error())
+	}
+
+	voucher, err := k.stateStore.VoucherBatchTable().Get(ctx, batch.Key)
+	if err != nil {
+		return nil, ibc.ErrTraceNotFound.Wrapf("%s is not a voucher credit batch", request.BatchDenom)
+	}
+
+	trace := types.ParseDenomTrace(voucher.Trace)
+
+	return &types.QueryDenomTraceResponse{DenomTrace: &trace}, nil
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/ibc/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/ibc/types/v1"
+)
+
+// DenomTraces queries the denom traces of all voucher credit batches with
+// pagination.
+func (k Keeper) DenomTraces(ctx context.Context, request *types.QueryDenomTracesRequest) (*types.QueryDenomTracesResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.VoucherBatchTable().List(ctx, &api.VoucherBatchPrimaryKey{}, ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	traces := make([]*types.BatchDenomTrace, 0)
+	for it.Next() {
+		voucher, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		batch, err := k.baseStore.BatchTable().Get(ctx, voucher.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+
+		trace := types.ParseDenomTrace(voucher.Trace)
+		traces = append(traces, &types.BatchDenomTrace{
+			BatchDenom: batch.Denom,
+			DenomTrace: &trace,
+		})
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomTracesResponse{
+		DenomTraces: traces,
+		Pagination:  pr,
+	}, nil
+}
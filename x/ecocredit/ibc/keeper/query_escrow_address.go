@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/ibc"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/ibc/types/v1"
+)
+
+// EscrowAddress queries the address of the escrow account of a channel.
+func (k Keeper) EscrowAddress(_ context.Context, request *types.QueryEscrowAddressRequest) (*types.QueryEscrowAddressResponse, error) {
+	if err := host.PortIdentifierValidator(request.PortId); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("port id: %s", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(request.ChannelId); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("channel id: %s", err)
+	}
+
+	return &types.QueryEscrowAddressResponse{
+		EscrowAddress: ibc.EscrowAddress(request.PortId, request.ChannelId).String(),
+	}, nil
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/ibc"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/ibc/types/v1"
+)
+
+func TestQueryDenomTraces(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	s.setupBatch(s.addr, "10")
+
+	data := testPacketData("C02-001-20200101-20210101-001", "10", "sender", s.addr.String())
+	voucherDenom, err := s.k.OnRecvPacket(s.sdkCtx, testPacket(data), data)
+	require.NoError(t, err)
+
+	res, err := s.k.DenomTrace(s.ctx, &types.QueryDenomTraceRequest{BatchDenom: voucherDenom})
+	require.NoError(t, err)
+	require.Equal(t, "ecocredit/channel-0", res.DenomTrace.Path)
+	require.Equal(t, "C02-001-20200101-20210101-001", res.DenomTrace.BaseDenom)
+
+	// credit batches issued on this chain do not have a denom trace
+	_, err = s.k.DenomTrace(s.ctx, &types.QueryDenomTraceRequest{BatchDenom: testBatchDenom})
+	require.ErrorIs(t, err, ibc.ErrTraceNotFound)
+
+	traces, err := s.k.DenomTraces(s.ctx, &types.QueryDenomTracesRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, traces.DenomTraces, 1)
+	require.Equal(t, voucherDenom, traces.DenomTraces[0].BatchDenom)
+	require.Equal(t, uint64(1), traces.Pagination.Total)
+}
+
+func TestQueryEscrowAddress(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	res, err := s.k.EscrowAddress(s.ctx, &types.QueryEscrowAddressRequest{
+		PortId:    ibc.PortID,
+		ChannelId: "channel-0",
+	})
+	require.NoError(t, err)
+	require.Equal(t, ibc.EscrowAddress(ibc.PortID, "channel-0").String(), res.EscrowAddress)
+
+	_, err = s.k.EscrowAddress(s.ctx, &types.QueryEscrowAddressRequest{PortId: ibc.PortID})
+	require.Error(t, err)
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+
+	basekeeper "github.com/regen-network/regen-ledger/x/ecocredit/base/keeper"
+	"github.com/regen-network/regen-ledger/x/ecocredit/ibc"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/ibc/types/v1"
+)
+
+// OnRecvPacket processes the packet data of credits received from another
+// chain and returns the batch denom of the credits on this chain. If this
+// chain is the source of the credits, the credits are returned from the escrow
+// account of the channel. Otherwise voucher credits are minted, creating the
+// voucher credit class, project and credit batch if they do not exist. Voucher
+// credits are minted like other credits, so they cannot be received for a
+// project or within a credit class that cannot issue credits, and cannot
+// exceed the issuance caps of the voucher credit class.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.EcocreditPacketData) (string, error) {
+	if err := data.ValidateBasic(); err != nil {
+		return "", err
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return "", sdkerrors.ErrInvalidAddress.Wrapf("receiver: %s", err)
+	}
+
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	if ibc.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// remove the prefix added by the sending chain when the credits were
+		// originally received from this chain
+		prefix := ibc.DenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		denom := data.Denom[len(prefix):]
+
+		batch, err := k.getBatchByFullDenom(goCtx, denom)
+		if err != nil {
+			return "", ibc.ErrTraceNotFound.Wrapf("%s: %s", denom, err)
+		}
+
+		amount, err := k.getAmount(goCtx, batch, data.Amount)
+		if err != nil {
+			return "", err
+		}
+
+		escrow := ibc.EscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		if err = basekeeper.TransferCredits(goCtx, k.baseStore, batch, escrow, receiver, amount); err != nil {
+			return "", err
+		}
+
+		return batch.Denom, nil
+	}
+
+	// the sending chain is the source of the credits so the denom is prefixed
+	// with the port and channel of this chain to trace the voucher credits
+	prefix := ibc.DenomPrefix(packet.GetDestPort(), packet.GetDestChannel())
+	trace := types.ParseDenomTrace(prefix + data.Denom)
+
+	batch, err := k.getOrCreateVoucherBatch(goCtx, trace, data)
+	if err != nil {
+		return "", err
+	}
+
+	amount, err := k.getAmount(goCtx, batch, data.Amount)
+	if err != nil {
+		return "", err
+	}
+
+	if err = basekeeper.MintCredits(goCtx, k.baseStore, batch, k.moduleAddress, receiver, amount); err != nil {
+		return "", err
+	}
+
+	return batch.Denom, nil
+}
+
+// OnAcknowledgementPacket returns the credits to the sender if the receiving
+// chain failed to receive the credits.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.EcocreditPacketData, ack channeltypes.Acknowledgement) error {
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return k.refundPacket(ctx, packet, data, resp.Error)
+	default:
+		// the credits were successfully received
+		return nil
+	}
+}
+
+// OnTimeoutPacket returns the credits to the sender.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.EcocreditPacketData) error {
+	return k.refundPacket(ctx, packet, data, "packet timed out")
+}
+
+// refundPacket returns credits to the sender from the escrow account of the
+// channel, or restores the voucher credits cancelled when the credits were
+// sent back to the chain the credits were received from.
+func (k Keeper) refundPacket(ctx sdk.Context, packet channeltypes.Packet, data types.EcocreditPacketData, reason string) error {
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("sender: %s", err)
+	}
+
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	batch, err := k.getBatchByFullDenom(goCtx, data.Denom)
+	if err != nil {
+		return ibc.ErrTraceNotFound.Wrapf("%s: %s", data.Denom, err)
+	}
+
+	amount, err := k.getAmount(goCtx, batch, data.Amount)
+	if err != nil {
+		return err
+	}
+
+	if ibc.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		escrow := ibc.EscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
+		if err = basekeeper.TransferCredits(goCtx, k.baseStore, batch, escrow, sender, amount); err != nil {
+			return err
+		}
+	} else {
+		if err = basekeeper.RestoreCancelledCredits(goCtx, k.baseStore, batch, sender, amount); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRefund{
+		Sender:     data.Sender,
+		BatchDenom: batch.Denom,
+		Amount:     amount.String(),
+		Reason:     reason,
+	})
+}
@@ -0,0 +1,265 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/ibc/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	basekeeper "github.com/regen-network/regen-ledger/x/ecocredit/base/keeper"
+	"github.com/regen-network/regen-ledger/x/ecocredit/ibc"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/ibc/types/v1"
+)
+
+func testPacketData(denom, amount, sender, receiver string) types.EcocreditPacketData {
+	date := func(year int) *gogotypes.Timestamp {
+		ts, _ := gogotypes.TimestampProto(time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC))
+		return ts
+	}
+	return types.EcocreditPacketData{
+		Denom:    denom,
+		Amount:   amount,
+		Sender:   sender,
+		Receiver: receiver,
+		Class: &types.EcocreditPacketData_ClassData{
+			CreditTypeAbbrev: "C",
+			Metadata:         "regen:class-metadata",
+		},
+		Project: &types.EcocreditPacketData_ProjectData{
+			Jurisdiction: "US-WA",
+			Metadata:     "regen:project-metadata",
+			ReferenceId:  "VCS-001",
+		},
+		Batch: &types.EcocreditPacketData_BatchData{
+			Metadata:     "regen:batch-metadata",
+			StartDate:    date(2020),
+			EndDate:      date(2021),
+			IssuanceDate: date(2022),
+		},
+	}
+}
+
+func testPacket(data types.EcocreditPacketData) channeltypes.Packet {
+	return channeltypes.Packet{
+		SourcePort:         ibc.PortID,
+		SourceChannel:      "channel-1",
+		DestinationPort:    ibc.PortID,
+		DestinationChannel: "channel-0",
+		Data:               data.GetBytes(),
+	}
+}
+
+func TestOnRecvPacket_Voucher(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// the credits were issued on the sending chain
+	data := testPacketData(testBatchDenom, "10.5", "sender", s.addr.String())
+
+	batchDenom, err := s.k.OnRecvPacket(s.sdkCtx, testPacket(data), data)
+	require.NoError(t, err)
+	require.Equal(t, testBatchDenom, batchDenom)
+
+	batch, err := s.baseStore.BatchTable().GetByDenom(s.ctx, batchDenom)
+	require.NoError(t, err)
+	require.Equal(t, s.k.moduleAddress.Bytes(), batch.Issuer)
+	require.Equal(t, "regen:batch-metadata", batch.Metadata)
+
+	project, err := s.baseStore.ProjectTable().Get(s.ctx, batch.ProjectKey)
+	require.NoError(t, err)
+	require.Equal(t, "US-WA", project.Jurisdiction)
+	require.Equal(t, "VCS-001", project.ReferenceId)
+
+	class, err := s.baseStore.ClassTable().Get(s.ctx, project.ClassKey)
+	require.NoError(t, err)
+	require.Equal(t, "regen:class-metadata", class.Metadata)
+
+	voucher, err := s.stateStore.VoucherBatchTable().Get(s.ctx, batch.Key)
+	require.NoError(t, err)
+	require.Equal(t, "ecocredit/channel-0/"+testBatchDenom, voucher.Trace)
+
+	voucherClass, err := s.stateStore.VoucherClassTable().Get(s.ctx, class.Key)
+	require.NoError(t, err)
+	require.Equal(t, "ecocredit/channel-0/"+testClassID, voucherClass.Trace)
+
+	voucherProject, err := s.stateStore.VoucherProjectTable().Get(s.ctx, project.Key)
+	require.NoError(t, err)
+	require.Equal(t, "ecocredit/channel-0/"+testProjectID, voucherProject.Trace)
+
+	s.requireTradable(s.addr, batch.Key, "10.5")
+	s.requireSupply(batch.Key, "10.5", "0")
+
+	// receiving credits from the same credit batch again reuses the voucher
+	// credit batch
+	data.Receiver = s.addr2.String()
+	batchDenom, err = s.k.OnRecvPacket(s.sdkCtx, testPacket(data), data)
+	require.NoError(t, err)
+	require.Equal(t, testBatchDenom, batchDenom)
+
+	s.requireTradable(s.addr2, batch.Key, "10.5")
+	s.requireSupply(batch.Key, "21.0", "0")
+}
+
+func TestOnRecvPacket_VoucherIssuance(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	data := testPacketData(testBatchDenom, "10", "sender", s.addr.String())
+	batchDenom, err := s.k.OnRecvPacket(s.sdkCtx, testPacket(data), data)
+	require.NoError(t, err)
+
+	batch, err := s.baseStore.BatchTable().GetByDenom(s.ctx, batchDenom)
+	require.NoError(t, err)
+	project, err := s.baseStore.ProjectTable().Get(s.ctx, batch.ProjectKey)
+	require.NoError(t, err)
+
+	// voucher credits cannot be received for a retired project
+	require.NoError(t, s.baseStore.ProjectLifecycleTable().Save(s.ctx, &baseapi.ProjectLifecycle{
+		ProjectKey: project.Key,
+		Status:     uint32(baseapi.ProjectStatus_PROJECT_STATUS_RETIRED),
+	}))
+	_, err = s.k.OnRecvPacket(s.sdkCtx, testPacket(data), data)
+	require.ErrorContains(t, err, "with status PROJECT_STATUS_RETIRED")
+
+	// voucher credits cannot be received within a credit class closed to
+	// issuance
+	require.NoError(t, s.baseStore.ProjectLifecycleTable().Delete(s.ctx, &baseapi.ProjectLifecycle{ProjectKey: project.Key}))
+	require.NoError(t, s.baseStore.ClassLifecycleTable().Save(s.ctx, &baseapi.ClassLifecycle{
+		ClassKey: project.ClassKey,
+		Status:   uint32(baseapi.ClassStatus_CLASS_STATUS_CLOSED_TO_ISSUANCE),
+	}))
+	_, err = s.k.OnRecvPacket(s.sdkCtx, testPacket(data), data)
+	require.ErrorContains(t, err, "with status CLASS_STATUS_CLOSED_TO_ISSUANCE")
+
+	// voucher credits cannot exceed the issuance caps of the credit class
+	require.NoError(t, s.baseStore.ClassLifecycleTable().Delete(s.ctx, &baseapi.ClassLifecycle{ClassKey: project.ClassKey}))
+	require.NoError(t, s.baseStore.ClassIssuanceCapTable().Save(s.ctx, &baseapi.ClassIssuanceCap{
+		ClassKey:                  project.ClassKey,
+		MaxProjectVintageIssuance: "15",
+	}))
+	_, err = s.k.OnRecvPacket(s.sdkCtx, testPacket(data), data)
+	require.ErrorContains(t, err, "cannot issue 10 credits with 5 of 15 credits remaining")
+}
+
+func TestOnRecvPacket_ReturnToSource(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	escrow := ibc.EscrowAddress(ibc.PortID, "channel-0")
+	batch := s.setupBatch(escrow, "10")
+
+	// the credits were issued on this chain and sent over channel-0, which
+	// is channel-1 on the sending chain
+	data := testPacketData("ecocredit/channel-1/"+testBatchDenom, "4", "sender", s.addr.String())
+
+	batchDenom, err := s.k.OnRecvPacket(s.sdkCtx, testPacket(data), data)
+	require.NoError(t, err)
+	require.Equal(t, testBatchDenom, batchDenom)
+
+	s.requireTradable(escrow, batch.Key, "6")
+	s.requireTradable(s.addr, batch.Key, "4")
+	s.requireSupply(batch.Key, "10", "0")
+
+	// no vouchers are created
+	it, err := s.stateStore.VoucherBatchTable().List(s.ctx, api.VoucherBatchPrimaryKey{})
+	require.NoError(t, err)
+	require.False(t, it.Next())
+	it.Close()
+}
+
+func TestOnRecvPacket_Errors(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	escrow := ibc.EscrowAddress(ibc.PortID, "channel-0")
+	s.setupBatch(escrow, "10")
+
+	// the credit batch does not exist on this chain
+	data := testPacketData("ecocredit/channel-1/C02-001-20200101-20210101-001", "4", "sender", s.addr.String())
+	_, err := s.k.OnRecvPacket(s.sdkCtx, testPacket(data), data)
+	require.ErrorIs(t, err, ibc.ErrTraceNotFound)
+
+	// more credits than escrowed
+	data = testPacketData("ecocredit/channel-1/"+testBatchDenom, "11", "sender", s.addr.String())
+	_, err = s.k.OnRecvPacket(s.sdkCtx, testPacket(data), data)
+	require.ErrorContains(t, err, "insufficient credit balance")
+
+	// the receiver is not a valid address
+	data = testPacketData(testBatchDenom, "4", "sender", "foo")
+	_, err = s.k.OnRecvPacket(s.sdkCtx, testPacket(data), data)
+	require.ErrorContains(t, err, "receiver")
+
+	// the credit type does not exist on this chain
+	data = testPacketData(testBatchDenom, "4", "sender", s.addr.String())
+	data.Class.CreditTypeAbbrev = "BIO"
+	_, err = s.k.OnRecvPacket(s.sdkCtx, testPacket(data), data)
+	require.ErrorContains(t, err, "could not get credit type with abbreviation BIO")
+
+	// the project jurisdiction does not exist with strict jurisdictions enabled
+	require.NoError(t, s.baseStore.StrictJurisdictionsTable().Save(s.ctx, &baseapi.StrictJurisdictions{Enabled: true}))
+	data = testPacketData(testBatchDenom, "4", "sender", s.addr.String())
+	data.Project.Jurisdiction = "ZZ-999"
+	_, err = s.k.OnRecvPacket(s.sdkCtx, testPacket(data), data)
+	require.ErrorContains(t, err, "jurisdiction: country code ZZ is not a valid ISO 3166-1 alpha-2 code")
+}
+
+func TestRefundPacket_Escrowed(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// the credits were escrowed when sent over channel-1
+	escrow := ibc.EscrowAddress(ibc.PortID, "channel-1")
+	batch := s.setupBatch(escrow, "10")
+
+	data := testPacketData(testBatchDenom, "10", s.addr.String(), "receiver")
+	require.NoError(t, s.k.OnTimeoutPacket(s.sdkCtx, testPacket(data), data))
+
+	s.requireTradable(escrow, batch.Key, "0")
+	s.requireTradable(s.addr, batch.Key, "10")
+
+	events := s.sdkCtx.EventManager().Events()
+	require.Equal(t, "regen.ecocredit.ibc.v1.EventRefund", events[len(events)-1].Type)
+}
+
+func TestRefundPacket_Cancelled(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// receive voucher credits over channel-0
+	received := testPacketData(testBatchDenom, "10", "sender", s.addr.String())
+	batchDenom, err := s.k.OnRecvPacket(s.sdkCtx, testPacket(received), received)
+	require.NoError(t, err)
+
+	batch, err := s.baseStore.BatchTable().GetByDenom(s.ctx, batchDenom)
+	require.NoError(t, err)
+
+	// the voucher credits were cancelled when sent back over channel-0
+	amount, err := s.k.getAmount(s.ctx, batch, "4")
+	require.NoError(t, err)
+	require.NoError(t, basekeeper.CancelCredits(s.ctx, s.baseStore, batch, s.addr, amount, "ibc transfer"))
+	s.requireSupply(batch.Key, "6", "4")
+
+	sent := testPacketData("ecocredit/channel-0/"+testBatchDenom, "4", s.addr.String(), "receiver")
+	packet := channeltypes.Packet{
+		SourcePort:         ibc.PortID,
+		SourceChannel:      "channel-0",
+		DestinationPort:    ibc.PortID,
+		DestinationChannel: "channel-1",
+		Data:               sent.GetBytes(),
+	}
+	ack := channeltypes.NewErrorAcknowledgement(ibc.ErrTraceNotFound)
+	require.NoError(t, s.k.OnAcknowledgementPacket(s.sdkCtx, packet, sent, ack))
+
+	s.requireTradable(s.addr, batch.Key, "10")
+	s.requireSupply(batch.Key, "10", "0")
+
+	// a successful acknowledgement does not refund the credits
+	ack = channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	require.NoError(t, s.k.OnAcknowledgementPacket(s.sdkCtx, packet, sent, ack))
+	s.requireTradable(s.addr, batch.Key, "10")
+}
@@ -0,0 +1,232 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/ibc/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	regentypes "github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	basekeeper "github.com/regen-network/regen-ledger/x/ecocredit/base/keeper"
+	basetypes "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/ibc/types/v1"
+)
+
+// getOrCreateVoucherBatch returns the voucher credit batch with the trace,
+// creating the voucher credit batch (and the voucher credit class and project
+// if needed) using the information included in the packet data if the voucher
+// credit batch does not exist. The ecocredit IBC application is the admin and
+// the issuer of all voucher credit classes, projects and credit batches.
+func (k Keeper) getOrCreateVoucherBatch(ctx context.Context, trace types.DenomTrace, data types.EcocreditPacketData) (*baseapi.Batch, error) {
+	voucher, err := k.stateStore.VoucherBatchTable().GetByTrace(ctx, trace.GetFullDenomPath())
+	if err == nil {
+		return k.baseStore.BatchTable().Get(ctx, voucher.BatchKey)
+	}
+	if !ormerrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	project, err := k.getOrCreateVoucherProject(ctx, trace, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var seq uint64 = 1
+	batchSeq, err := k.baseStore.BatchSequenceTable().Get(ctx, project.Key)
+	if err != nil {
+		if !ormerrors.IsNotFound(err) {
+			return nil, err
+		}
+	} else {
+		seq = batchSeq.NextSequence
+	}
+	if err = k.baseStore.BatchSequenceTable().Save(ctx, &baseapi.BatchSequence{
+		ProjectKey:   project.Key,
+		NextSequence: seq + 1,
+	}); err != nil {
+		return nil, err
+	}
+
+	startDate := regentypes.GogoToProtobufTimestamp(data.Batch.StartDate)
+	endDate := regentypes.GogoToProtobufTimestamp(data.Batch.EndDate)
+	start, end := startDate.AsTime(), endDate.AsTime()
+
+	denom, err := base.FormatBatchDenom(project.Id, seq, &start, &end)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := &baseapi.Batch{
+		Issuer:       k.moduleAddress,
+		ProjectKey:   project.Key,
+		Denom:        denom,
+		Metadata:     data.Batch.Metadata,
+		StartDate:    startDate,
+		EndDate:      endDate,
+		IssuanceDate: regentypes.GogoToProtobufTimestamp(data.Batch.IssuanceDate),
+	}
+	batch.Key, err = k.baseStore.BatchTable().InsertReturningID(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.baseStore.BatchSupplyTable().Insert(ctx, &baseapi.BatchSupply{
+		BatchKey:        batch.Key,
+		TradableAmount:  "0",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = k.stateStore.VoucherBatchTable().Insert(ctx, &api.VoucherBatch{
+		BatchKey: batch.Key,
+		Trace:    trace.GetFullDenomPath(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&basetypes.EventCreateBatch{
+		BatchDenom: denom,
+	}); err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+// getOrCreateVoucherProject returns the voucher project of the credit batch
+// with the trace, creating the voucher project if it does not exist.
+func (k Keeper) getOrCreateVoucherProject(ctx context.Context, trace types.DenomTrace, data types.EcocreditPacketData) (*baseapi.Project, error) {
+	voucher, err := k.stateStore.VoucherProjectTable().GetByTrace(ctx, trace.ProjectTrace())
+	if err == nil {
+		return k.baseStore.ProjectTable().Get(ctx, voucher.ProjectKey)
+	}
+	if !ormerrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	if err = basekeeper.AssertValidJurisdiction(ctx, k.baseStore, data.Project.Jurisdiction); err != nil {
+		return nil, err
+	}
+
+	class, err := k.getOrCreateVoucherClass(ctx, trace, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var seq uint64 = 1
+	projectSeq, err := k.baseStore.ProjectSequenceTable().Get(ctx, class.Key)
+	if err != nil {
+		if !ormerrors.IsNotFound(err) {
+			return nil, err
+		}
+	} else {
+		seq = projectSeq.NextSequence
+	}
+	if err = k.baseStore.ProjectSequenceTable().Save(ctx, &baseapi.ProjectSequence{
+		ClassKey:     class.Key,
+		NextSequence: seq + 1,
+	}); err != nil {
+		return nil, err
+	}
+
+	project := &baseapi.Project{
+		Id:           base.FormatProjectID(class.Id, seq),
+		Admin:        k.moduleAddress,
+		ClassKey:     class.Key,
+		Jurisdiction: data.Project.Jurisdiction,
+		Metadata:     data.Project.Metadata,
+		ReferenceId:  data.Project.ReferenceId,
+	}
+	project.Key, err = k.baseStore.ProjectTable().InsertReturningID(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.stateStore.VoucherProjectTable().Insert(ctx, &api.VoucherProject{
+		ProjectKey: project.Key,
+		Trace:      trace.ProjectTrace(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&basetypes.EventCreateProject{
+		ProjectId: project.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
+
+// getOrCreateVoucherClass returns the voucher credit class of the credit batch
+// with the trace, creating the voucher credit class if it does not exist. The
+// credit type of the credit class must exist on this chain.
+func (k Keeper) getOrCreateVoucherClass(ctx context.Context, trace types.DenomTrace, data types.EcocreditPacketData) (*baseapi.Class, error) {
+	voucher, err := k.stateStore.VoucherClassTable().GetByTrace(ctx, trace.ClassTrace())
+	if err == nil {
+		return k.baseStore.ClassTable().Get(ctx, voucher.ClassKey)
+	}
+	if !ormerrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	creditType, err := k.baseStore.CreditTypeTable().Get(ctx, data.Class.CreditTypeAbbrev)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get credit type with abbreviation %s: %s", data.Class.CreditTypeAbbrev, err)
+	}
+
+	var seq uint64 = 1
+	classSeq, err := k.baseStore.ClassSequenceTable().Get(ctx, creditType.Abbreviation)
+	if err != nil {
+		if !ormerrors.IsNotFound(err) {
+			return nil, err
+		}
+	} else {
+		seq = classSeq.NextSequence
+	}
+	if err = k.baseStore.ClassSequenceTable().Save(ctx, &baseapi.ClassSequence{
+		CreditTypeAbbrev: creditType.Abbreviation,
+		NextSequence:     seq + 1,
+	}); err != nil {
+		return nil, err
+	}
+
+	class := &baseapi.Class{
+		Id:               base.FormatClassID(creditType.Abbreviation, seq),
+		Admin:            k.moduleAddress,
+		Metadata:         data.Class.Metadata,
+		CreditTypeAbbrev: creditType.Abbreviation,
+	}
+	class.Key, err = k.baseStore.ClassTable().InsertReturningID(ctx, class)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.baseStore.ClassIssuerTable().Insert(ctx, &baseapi.ClassIssuer{
+		ClassKey: class.Key,
+		Issuer:   k.moduleAddress,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = k.stateStore.VoucherClassTable().Insert(ctx, &api.VoucherClass{
+		ClassKey: class.Key,
+		Trace:    trace.ClassTrace(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&basetypes.EventCreateClass{
+		ClassId: class.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return class, nil
+}
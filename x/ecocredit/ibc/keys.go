@@ -0,0 +1,16 @@
+package ibc
+
+import "github.com/regen-network/regen-ledger/x/ecocredit"
+
+const (
+	// SubModuleName is the name of the ecocredit IBC application. The name is
+	// used to scope the capabilities of the application and to route packets,
+	// and therefore must only contain alphanumeric characters.
+	SubModuleName = ecocredit.ModuleName + "ibc"
+
+	// PortID is the default port the ecocredit IBC application binds to.
+	PortID = "ecocredit"
+
+	// Version is the current version of the ecocredit IBC application.
+	Version = "ecocredit-1"
+)
@@ -0,0 +1,193 @@
+package module
+
+import (
+	"math"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v5/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v5/modules/core/exported"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/ibc"
+	"github.com/regen-network/regen-ledger/x/ecocredit/ibc/keeper"
+	types "github.com/regen-network/regen-ledger/x/ecocredit/ibc/types/v1"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule implements the ICS26 interface for the ecocredit IBC application.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the keeper.
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{keeper: k}
+}
+
+// validateChannelParams checks that the channel is unordered, that the channel
+// sequence fits in a uint32, and that the port is the bound port.
+func (im IBCModule) validateChannelParams(ctx sdk.Context, order channeltypes.Order, portID, channelID string) error {
+	channelSequence, err := channeltypes.ParseChannelSequence(channelID)
+	if err != nil {
+		return err
+	}
+	if channelSequence > uint64(math.MaxUint32) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("channel sequence %d is greater than max allowed channels %d", channelSequence, uint64(math.MaxUint32))
+	}
+
+	if order != channeltypes.UNORDERED {
+		return channeltypes.ErrInvalidChannelOrdering.Wrapf("expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+
+	boundPort, err := im.keeper.GetPort(sdk.WrapSDKContext(ctx))
+	if err != nil {
+		return err
+	}
+	if boundPort != portID {
+		return porttypes.ErrInvalidPort.Wrapf("invalid port: %s, expected %s", portID, boundPort)
+	}
+
+	return nil
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im IBCModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	_ []string,
+	portID string,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if err := im.validateChannelParams(ctx, order, portID, channelID); err != nil {
+		return "", err
+	}
+
+	if strings.TrimSpace(version) == "" {
+		version = ibc.Version
+	}
+
+	if version != ibc.Version {
+		return "", ibc.ErrInvalidVersion.Wrapf("got %s, expected %s", version, ibc.Version)
+	}
+
+	if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", err
+	}
+
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	_ []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if err := im.validateChannelParams(ctx, order, portID, channelID); err != nil {
+		return "", err
+	}
+
+	if counterpartyVersion != ibc.Version {
+		return "", ibc.ErrInvalidVersion.Wrapf("invalid counterparty version: got %s, expected %s", counterpartyVersion, ibc.Version)
+	}
+
+	if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", err
+	}
+
+	return ibc.Version, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im IBCModule) OnChanOpenAck(_ sdk.Context, _, _ string, _ string, counterpartyVersion string) error {
+	if counterpartyVersion != ibc.Version {
+		return ibc.ErrInvalidVersion.Wrapf("invalid counterparty version: got %s, expected %s", counterpartyVersion, ibc.Version)
+	}
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanOpenConfirm(_ sdk.Context, _, _ string) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface. Channels cannot be
+// closed by users because escrowed credits would be locked forever.
+func (im IBCModule) OnChanCloseInit(_ sdk.Context, _, _ string) error {
+	return sdkerrors.ErrInvalidRequest.Wrap("user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im IBCModule) OnChanCloseConfirm(_ sdk.Context, _, _ string) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. A successful
+// acknowledgement is returned if the credits were received. State changes are
+// discarded by the core IBC handler if an error acknowledgement is returned.
+func (im IBCModule) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	var batchDenom string
+	data, err := types.UnmarshalPacketData(packet.GetData())
+	if err == nil {
+		batchDenom, err = im.keeper.OnRecvPacket(ctx, packet, data)
+	}
+
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	event := &types.EventReceivePacket{
+		Sender:     data.Sender,
+		Receiver:   data.Receiver,
+		BatchDenom: batchDenom,
+		Denom:      data.Denom,
+		Amount:     data.Amount,
+		Success:    true,
+	}
+	if err != nil {
+		ack = channeltypes.NewErrorAcknowledgement(err)
+		event.Success = false
+		event.Error = err.Error()
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(event); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im IBCModule) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, _ sdk.AccAddress) error {
+	var ack channeltypes.Acknowledgement
+	if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.ErrUnknownRequest.Wrapf("cannot unmarshal ecocredit packet acknowledgement: %s", err)
+	}
+
+	data, err := types.UnmarshalPacketData(packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	return im.keeper.OnAcknowledgementPacket(ctx, packet, data, ack)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) error {
+	data, err := types.UnmarshalPacketData(packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	return im.keeper.OnTimeoutPacket(ctx, packet, data)
+}
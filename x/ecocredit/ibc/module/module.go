@@ -0,0 +1,156 @@
+package module
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdkclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/ibc/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormstore"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/ibc"
+	"github.com/regen-network/regen-ledger/x/ecocredit/ibc/keeper"
+	ibctypes "github.com/regen-network/regen-ledger/x/ecocredit/ibc/types/v1"
+)
+
+var (
+	_ module.AppModule      = &Module{}
+	_ module.AppModuleBasic = Module{}
+)
+
+const (
+	ConsensusVersion = 1 // ConsensusVersion is the module consensus version
+)
+
+// Module implements the AppModule interface for the ecocredit IBC application.
+// Voucher state is stored in the ecocredit module store and the command line
+// interface is provided by the ecocredit module.
+type Module struct {
+	Keeper keeper.Keeper
+}
+
+// NewModule returns a new Module using the store of the ecocredit module.
+func NewModule(
+	storeKey storetypes.StoreKey,
+	ics4Wrapper ibc.ICS4Wrapper,
+	channelKeeper ibc.ChannelKeeper,
+	portKeeper ibc.PortKeeper,
+	scopedKeeper ibc.ScopedKeeper,
+) *Module {
+	db, err := ormstore.NewStoreKeyDB(&ecocredit.ModuleSchema, storeKey, ormdb.ModuleDBOptions{})
+	if err != nil {
+		panic(err)
+	}
+
+	ss, err := api.NewStateStore(db)
+	if err != nil {
+		panic(err)
+	}
+
+	bs, err := baseapi.NewStateStore(db)
+	if err != nil {
+		panic(err)
+	}
+
+	return &Module{
+		Keeper: keeper.NewKeeper(ss, bs, ics4Wrapper, channelKeeper, portKeeper, scopedKeeper),
+	}
+}
+
+/* -------------------- AppModule -------------------- */
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (Module) ConsensusVersion() uint64 { return ConsensusVersion }
+
+// Name implements AppModule/Name.
+func (m Module) Name() string {
+	return ibc.SubModuleName
+}
+
+// Route implements AppModule/Route.
+func (m Module) Route() sdk.Route {
+	return sdk.Route{}
+}
+
+// QuerierRoute implements AppModule/QuerierRoute.
+func (m Module) QuerierRoute() string {
+	return ibc.SubModuleName
+}
+
+// RegisterInvariants implements AppModule/RegisterInvariants.
+func (m Module) RegisterInvariants(_ sdk.InvariantRegistry) {}
+
+// RegisterInterfaces implements AppModule/RegisterInterfaces.
+func (m Module) RegisterInterfaces(registry types.InterfaceRegistry) {
+	ibctypes.RegisterTypes(registry)
+}
+
+// RegisterServices implements AppModule/RegisterServices.
+func (m *Module) RegisterServices(cfg module.Configurator) {
+	ibctypes.RegisterMsgServer(cfg.MsgServer(), m.Keeper)
+	ibctypes.RegisterQueryServer(cfg.QueryServer(), m.Keeper)
+}
+
+// RegisterGRPCGatewayRoutes implements AppModule/RegisterGRPCGatewayRoutes.
+func (m Module) RegisterGRPCGatewayRoutes(clientCtx sdkclient.Context, mux *runtime.ServeMux) {
+	err := ibctypes.RegisterQueryHandlerClient(context.Background(), mux, ibctypes.NewQueryClient(clientCtx))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// RegisterLegacyAminoCodec implements AppModule/RegisterLegacyAminoCodec.
+func (m Module) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	ibctypes.RegisterLegacyAminoCodec(cdc)
+}
+
+// InitGenesis implements AppModule/InitGenesis.
+func (m Module) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, bz json.RawMessage) []abci.ValidatorUpdate {
+	var genesis ibctypes.GenesisState
+	cdc.MustUnmarshalJSON(bz, &genesis)
+	m.Keeper.InitGenesis(ctx, &genesis)
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis implements AppModule/ExportGenesis.
+func (m Module) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(m.Keeper.ExportGenesis(ctx))
+}
+
+// DefaultGenesis implements AppModule/DefaultGenesis.
+func (m Module) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(ibctypes.DefaultGenesisState())
+}
+
+// ValidateGenesis implements AppModule/ValidateGenesis.
+func (m Module) ValidateGenesis(cdc codec.JSONCodec, _ sdkclient.TxEncodingConfig, bz json.RawMessage) error {
+	var genesis ibctypes.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genesis); err != nil {
+		return err
+	}
+	return genesis.Validate()
+}
+
+// GetTxCmd implements AppModule/GetTxCmd. Transactions are provided by the
+// ecocredit module.
+func (m Module) GetTxCmd() *cobra.Command { return nil }
+
+// GetQueryCmd implements AppModule/GetQueryCmd. Queries are provided by the
+// ecocredit module.
+func (m Module) GetQueryCmd() *cobra.Command { return nil }
+
+// LegacyQuerierHandler implements AppModule/LegacyQuerierHandler.
+func (m Module) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier { return nil }
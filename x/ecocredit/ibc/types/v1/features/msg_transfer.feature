@@ -0,0 +1,230 @@
+Feature: MsgTransfer
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "source_port": "ecocredit",
+      "source_channel": "channel-0",
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "receiver": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4anz8jqe",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "10.5",
+      "timeout_height": "1-100"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with timeout timestamp
+    Given the message
+    """
+    {
+      "source_port": "ecocredit",
+      "source_channel": "channel-0",
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "receiver": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4anz8jqe",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "10",
+      "timeout_timestamp": "1577836800000000000"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if source port is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "source port: identifier cannot be blank: invalid identifier: invalid request"
+
+  Scenario: an error is returned if source channel is empty
+    Given the message
+    """
+    {
+      "source_port": "ecocredit"
+    }
+    """
+    When the message is validated
+    Then expect the error "source channel: identifier cannot be blank: invalid identifier: invalid request"
+
+  Scenario: an error is returned if source channel is not formatted
+    Given the message
+    """
+    {
+      "source_port": "ecocredit",
+      "source_channel": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "source channel: identifier foo has invalid length: 3, must be between 8-64 characters: invalid identifier: invalid request"
+
+  Scenario: an error is returned if sender is empty
+    Given the message
+    """
+    {
+      "source_port": "ecocredit",
+      "source_channel": "channel-0"
+    }
+    """
+    When the message is validated
+    Then expect the error "sender: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if sender is not a bech32 address
+    Given the message
+    """
+    {
+      "source_port": "ecocredit",
+      "source_channel": "channel-0",
+      "sender": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "sender: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if receiver is empty
+    Given the message
+    """
+    {
+      "source_port": "ecocredit",
+      "source_channel": "channel-0",
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "receiver cannot be empty: invalid address"
+
+  Scenario: an error is returned if batch denom is empty
+    Given the message
+    """
+    {
+      "source_port": "ecocredit",
+      "source_channel": "channel-0",
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "receiver": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4anz8jqe"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom: empty string is not allowed: parse error: invalid request"
+
+  Scenario: an error is returned if batch denom is not formatted
+    Given the message
+    """
+    {
+      "source_port": "ecocredit",
+      "source_channel": "channel-0",
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "receiver": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4anz8jqe",
+      "batch_denom": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom: expected format <project-id>-<start_date>-<end_date>-<batch_sequence>: parse error: invalid request"
+
+  Scenario: an error is returned if amount is empty
+    Given the message
+    """
+    {
+      "source_port": "ecocredit",
+      "source_channel": "channel-0",
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "receiver": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4anz8jqe",
+      "batch_denom": "C01-001-20200101-20210101-001"
+    }
+    """
+    When the message is validated
+    Then expect the error "amount: expected a positive decimal, got : invalid decimal string: invalid request"
+
+  Scenario: an error is returned if amount is not positive
+    Given the message
+    """
+    {
+      "source_port": "ecocredit",
+      "source_channel": "channel-0",
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "receiver": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4anz8jqe",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "-10"
+    }
+    """
+    When the message is validated
+    Then expect the error "amount: expected a positive decimal, got -10: invalid decimal string: invalid request"
+
+  Scenario: an error is returned if timeout height is not formatted
+    Given the message
+    """
+    {
+      "source_port": "ecocredit",
+      "source_channel": "channel-0",
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "receiver": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4anz8jqe",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "10",
+      "timeout_height": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "timeout height: expected height string format: {revision}-{height}. Got: foo: invalid height: invalid packet timeout"
+
+  Scenario: an error is returned if timeout height and timeout timestamp are empty
+    Given the message
+    """
+    {
+      "source_port": "ecocredit",
+      "source_channel": "channel-0",
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "receiver": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4anz8jqe",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "10"
+    }
+    """
+    When the message is validated
+    Then expect the error "timeout height and timeout timestamp cannot both be 0: invalid packet timeout"
+
+  Scenario: an error is returned if timeout height and timeout timestamp are zero
+    Given the message
+    """
+    {
+      "source_port": "ecocredit",
+      "source_channel": "channel-0",
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "receiver": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4anz8jqe",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "10",
+      "timeout_height": "0-0"
+    }
+    """
+    When the message is validated
+    Then expect the error "timeout height and timeout timestamp cannot both be 0: invalid packet timeout"
+
+  Scenario: a valid amino message
+    Given the message
+    """
+    {
+      "source_port": "ecocredit",
+      "source_channel": "channel-0",
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "receiver": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4anz8jqe",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "10",
+      "timeout_height": "1-100"
+    }
+    """
+    When message sign bytes queried
+    Then expect the sign bytes
+    """
+    {
+      "type": "regen.ibc/MsgTransfer",
+      "value": {
+        "amount": "10",
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "receiver": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4anz8jqe",
+        "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+        "source_channel": "channel-0",
+        "source_port": "ecocredit",
+        "timeout_height": "1-100"
+      }
+    }
+    """
@@ -0,0 +1,45 @@
+Feature: DenomTrace
+
+  Rule: the path is everything before the last forward slash
+
+    Scenario Outline: a full denom path is parsed
+      Given the full denom path "<full-path>"
+      When the full denom path is parsed
+      Then expect the path "<path>"
+      And expect the base denom "<base-denom>"
+      And expect the class trace "<class-trace>"
+      And expect the project trace "<project-trace>"
+      And expect the full denom path "<full-path>"
+
+      Examples:
+        | full-path                                                             | path                                    | base-denom                    | class-trace                                 | project-trace                                   |
+        | C01-001-20200101-20210101-001                                         |                                         | C01-001-20200101-20210101-001 | C01                                         | C01-001                                         |
+        | ecocredit/channel-0/C01-001-20200101-20210101-001                     | ecocredit/channel-0                     | C01-001-20200101-20210101-001 | ecocredit/channel-0/C01                     | ecocredit/channel-0/C01-001                     |
+        | ecocredit/channel-1/ecocredit/channel-0/C01-001-20200101-20210101-001 | ecocredit/channel-1/ecocredit/channel-0 | C01-001-20200101-20210101-001 | ecocredit/channel-1/ecocredit/channel-0/C01 | ecocredit/channel-1/ecocredit/channel-0/C01-001 |
+
+  Rule: the base denom is a batch denom and the path is pairs of ports and channels
+
+    Scenario: a valid denom trace
+      Given the full denom path "ecocredit/channel-0/C01-001-20200101-20210101-001"
+      When the denom trace is validated
+      Then expect no error
+
+    Scenario: a valid denom trace without a path
+      Given the full denom path "C01-001-20200101-20210101-001"
+      When the denom trace is validated
+      Then expect no error
+
+    Scenario: an error is returned if base denom is not formatted
+      Given the full denom path "ecocredit/channel-0/foo"
+      When the denom trace is validated
+      Then expect the error "base denom: expected format <project-id>-<start_date>-<end_date>-<batch_sequence>: parse error: invalid request"
+
+    Scenario: an error is returned if path is not pairs of ports and channels
+      Given the full denom path "ecocredit/C01-001-20200101-20210101-001"
+      When the denom trace is validated
+      Then expect the error "path must be a sequence of port and channel identifiers: invalid request"
+
+    Scenario: an error is returned if path includes an invalid channel
+      Given the full denom path "ecocredit/foo/C01-001-20200101-20210101-001"
+      When the denom trace is validated
+      Then expect the error "path: identifier foo has invalid length: 3, must be between 8-64 characters: invalid identifier: invalid request"
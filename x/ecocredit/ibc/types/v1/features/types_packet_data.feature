@@ -0,0 +1,70 @@
+Feature: EcocreditPacketData
+
+  Background:
+    Given the packet data
+    """
+    {
+      "denom": "ecocredit/channel-0/C01-001-20200101-20210101-001",
+      "amount": "10.5",
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "receiver": "cosmos1depk54cuajgkzea6zpgkq36tnjwdzv4anz8jqe",
+      "class": {
+        "credit_type_abbrev": "C",
+        "metadata": "regen:class-metadata"
+      },
+      "project": {
+        "jurisdiction": "US-WA",
+        "metadata": "regen:project-metadata",
+        "reference_id": "VCS-001"
+      },
+      "batch": {
+        "metadata": "regen:batch-metadata",
+        "start_date": "2020-01-01T00:00:00Z",
+        "end_date": "2021-01-01T00:00:00Z",
+        "issuance_date": "2022-01-01T00:00:00Z"
+      }
+    }
+    """
+
+  Scenario: valid packet data
+    When the packet data is validated
+    Then expect no error
+
+  Scenario: packet data is encoded and decoded
+    When the packet data is encoded and decoded
+    Then expect the decoded packet data to equal the packet data
+
+  Scenario: an error is returned if denom is not a valid denom trace
+    Given the packet data field "denom" is "ecocredit/C01-001-20200101-20210101-001"
+    When the packet data is validated
+    Then expect the error "denom: path must be a sequence of port and channel identifiers: invalid request: invalid request"
+
+  Scenario: an error is returned if amount is not positive
+    Given the packet data field "amount" is "0"
+    When the packet data is validated
+    Then expect the error "amount: expected a positive decimal, got 0: invalid decimal string: invalid request"
+
+  Scenario: an error is returned if sender is empty
+    Given the packet data field "sender" is ""
+    When the packet data is validated
+    Then expect the error "sender cannot be empty: invalid address"
+
+  Scenario: an error is returned if receiver is empty
+    Given the packet data field "receiver" is ""
+    When the packet data is validated
+    Then expect the error "receiver cannot be empty: invalid address"
+
+  Scenario: an error is returned if class is empty
+    Given the packet data field "class" is empty
+    When the packet data is validated
+    Then expect the error "class cannot be empty: invalid request"
+
+  Scenario: an error is returned if project is empty
+    Given the packet data field "project" is empty
+    When the packet data is validated
+    Then expect the error "project cannot be empty: invalid request"
+
+  Scenario: an error is returned if batch is empty
+    Given the packet data field "batch" is empty
+    When the packet data is validated
+    Then expect the error "batch cannot be empty: invalid request"
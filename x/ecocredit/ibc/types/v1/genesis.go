@@ -0,0 +1,18 @@
+package v1
+
+import (
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/ibc"
+)
+
+// DefaultGenesisState returns the default genesis state of the ecocredit IBC
+// application.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{PortId: ibc.PortID}
+}
+
+// Validate performs basic validation of the GenesisState type
+func (m *GenesisState) Validate() error {
+	return host.PortIdentifierValidator(m.PortId)
+}
@@ -0,0 +1,85 @@
+package v1
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	clienttypes "github.com/cosmos/ibc-go/v5/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+	"github.com/regen-network/regen-ledger/x/ecocredit/ibc"
+)
+
+var _ legacytx.LegacyMsg = &MsgTransfer{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgTransfer) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgTransfer) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgTransfer) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(m.SourcePort); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("source port: %s", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(m.SourceChannel); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("source channel: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("sender: %s", err)
+	}
+
+	if m.Receiver == "" {
+		return sdkerrors.ErrInvalidAddress.Wrap("receiver cannot be empty")
+	}
+
+	if err := base.ValidateBatchDenom(m.BatchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("batch denom: %s", err)
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Amount); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("amount: %s", err)
+	}
+
+	timeoutHeight, err := m.ParseTimeoutHeight()
+	if err != nil {
+		return err
+	}
+
+	if timeoutHeight.IsZero() && m.TimeoutTimestamp == 0 {
+		return ibc.ErrInvalidPacketTimeout.Wrap("timeout height and timeout timestamp cannot both be 0")
+	}
+
+	return nil
+}
+
+// ParseTimeoutHeight parses the timeout height of the packet. An empty timeout
+// height is equivalent to a disabled timeout height.
+func (m *MsgTransfer) ParseTimeoutHeight() (clienttypes.Height, error) {
+	if m.TimeoutHeight == "" {
+		return clienttypes.ZeroHeight(), nil
+	}
+
+	height, err := clienttypes.ParseHeight(m.TimeoutHeight)
+	if err != nil {
+		return clienttypes.Height{}, ibc.ErrInvalidPacketTimeout.Wrapf("timeout height: %s", err)
+	}
+
+	return height, nil
+}
+
+// GetSigners returns the expected signers for MsgTransfer.
+func (m *MsgTransfer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgTransfer struct {
+	t         gocuke.TestingT
+	msg       *MsgTransfer
+	err       error
+	signBytes string
+}
+
+func TestMsgTransfer(t *testing.T) {
+	gocuke.NewRunner(t, &msgTransfer{}).Path("./features/msg_transfer.feature").Run()
+}
+
+func (s *msgTransfer) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgTransfer) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgTransfer{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgTransfer) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgTransfer) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgTransfer) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *msgTransfer) MessageSignBytesQueried() {
+	s.signBytes = string(s.msg.GetSignBytes())
+}
+
+func (s *msgTransfer) ExpectTheSignBytes(expected gocuke.DocString) {
+	buffer := new(bytes.Buffer)
+	require.NoError(s.t, json.Compact(buffer, []byte(expected.Content)))
+	require.Equal(s.t, buffer.String(), s.signBytes)
+}
@@ -0,0 +1,21 @@
+package v1
+
+import (
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the Port state type. The port id is
+// empty until the ecocredit IBC application is bound to a port.
+func (m *Port) Validate() error {
+	if m.PortId == "" {
+		return nil
+	}
+
+	if err := host.PortIdentifierValidator(m.PortId); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("port id: %s", err)
+	}
+
+	return nil
+}
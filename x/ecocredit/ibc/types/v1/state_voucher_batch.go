@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// Validate performs basic validation of the VoucherBatch state type
+func (m *VoucherBatch) Validate() error {
+	if m.BatchKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("batch key cannot be zero")
+	}
+
+	trace := ParseDenomTrace(m.Trace)
+	if trace.Path == "" {
+		return ecocredit.ErrParseFailure.Wrap("trace: path cannot be empty")
+	}
+
+	if err := trace.Validate(); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("trace: %s", err)
+	}
+
+	return nil
+}
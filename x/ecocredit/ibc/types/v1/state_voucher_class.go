@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// Validate performs basic validation of the VoucherClass state type
+func (m *VoucherClass) Validate() error {
+	if m.ClassKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("class key cannot be zero")
+	}
+
+	trace := ParseDenomTrace(m.Trace)
+	if trace.Path == "" {
+		return ecocredit.ErrParseFailure.Wrap("trace: path cannot be empty")
+	}
+
+	if err := base.ValidateClassID(trace.BaseDenom); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("trace: class id: %s", err)
+	}
+
+	return nil
+}
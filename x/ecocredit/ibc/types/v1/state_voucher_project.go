@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// Validate performs basic validation of the VoucherProject state type
+func (m *VoucherProject) Validate() error {
+	if m.ProjectKey == 0 {
+		return ecocredit.ErrParseFailure.Wrap("project key cannot be zero")
+	}
+
+	trace := ParseDenomTrace(m.Trace)
+	if trace.Path == "" {
+		return ecocredit.ErrParseFailure.Wrap("trace: path cannot be empty")
+	}
+
+	if err := base.ValidateProjectID(trace.BaseDenom); err != nil {
+		return ecocredit.ErrParseFailure.Wrapf("trace: project id: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v5/modules/core/24-host"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// ParseDenomTrace parses the full denom path of a credit batch into a denom
+// trace. Batch denoms never contain a forward slash and therefore everything
+// before the last forward slash is the path.
+func ParseDenomTrace(denom string) DenomTrace {
+	i := strings.LastIndex(denom, "/")
+	if i < 0 {
+		return DenomTrace{BaseDenom: denom}
+	}
+	return DenomTrace{
+		Path:      denom[:i],
+		BaseDenom: denom[i+1:],
+	}
+}
+
+// GetFullDenomPath returns the full denom path of the credit batch (i.e. the
+// path followed by the base denom).
+func (m DenomTrace) GetFullDenomPath() string {
+	return m.withPath(m.BaseDenom)
+}
+
+// ClassTrace returns the trace of the credit class of the credit batch (i.e.
+// the path followed by the credit class id on the chain the credit batch was
+// issued on).
+func (m DenomTrace) ClassTrace() string {
+	return m.withPath(base.GetClassIDFromBatchDenom(m.BaseDenom))
+}
+
+// ProjectTrace returns the trace of the project of the credit batch (i.e. the
+// path followed by the project id on the chain the credit batch was issued on).
+func (m DenomTrace) ProjectTrace() string {
+	return m.withPath(base.GetProjectIDFromBatchDenom(m.BaseDenom))
+}
+
+func (m DenomTrace) withPath(id string) string {
+	if m.Path == "" {
+		return id
+	}
+	return m.Path + "/" + id
+}
+
+// Validate performs basic validation of the DenomTrace type
+func (m DenomTrace) Validate() error {
+	if err := base.ValidateBatchDenom(m.BaseDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("base denom: %s", err)
+	}
+
+	if m.Path == "" {
+		return nil
+	}
+
+	identifiers := strings.Split(m.Path, "/")
+	if len(identifiers)%2 != 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("path must be a sequence of port and channel identifiers")
+	}
+
+	for i := 0; i < len(identifiers); i += 2 {
+		if err := host.PortIdentifierValidator(identifiers[i]); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("path: %s", err)
+		}
+		if err := host.ChannelIdentifierValidator(identifiers[i+1]); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("path: %s", err)
+		}
+	}
+
+	return nil
+}
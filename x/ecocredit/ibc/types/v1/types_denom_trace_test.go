@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type denomTrace struct {
+	t        gocuke.TestingT
+	fullPath string
+	trace    DenomTrace
+	err      error
+}
+
+func TestDenomTrace(t *testing.T) {
+	gocuke.NewRunner(t, &denomTrace{}).Path("./features/types_denom_trace.feature").Run()
+}
+
+func (s *denomTrace) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *denomTrace) TheFullDenomPath(a string) {
+	s.fullPath = a
+}
+
+func (s *denomTrace) TheFullDenomPathIsParsed() {
+	s.trace = ParseDenomTrace(s.fullPath)
+}
+
+func (s *denomTrace) TheDenomTraceIsValidated() {
+	s.err = ParseDenomTrace(s.fullPath).Validate()
+}
+
+func (s *denomTrace) ExpectThePath(a string) {
+	require.Equal(s.t, a, s.trace.Path)
+}
+
+func (s *denomTrace) ExpectTheBaseDenom(a string) {
+	require.Equal(s.t, a, s.trace.BaseDenom)
+}
+
+func (s *denomTrace) ExpectTheClassTrace(a string) {
+	require.Equal(s.t, a, s.trace.ClassTrace())
+}
+
+func (s *denomTrace) ExpectTheProjectTrace(a string) {
+	require.Equal(s.t, a, s.trace.ProjectTrace())
+}
+
+func (s *denomTrace) ExpectTheFullDenomPath(a string) {
+	require.Equal(s.t, a, s.trace.GetFullDenomPath())
+}
+
+func (s *denomTrace) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *denomTrace) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
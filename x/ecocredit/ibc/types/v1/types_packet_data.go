@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"bytes"
+
+	"github.com/gogo/protobuf/jsonpb"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
+)
+
+// GetBytes returns the sorted JSON encoding of the packet data.
+func (m EcocreditPacketData) GetBytes() []byte {
+	bz, err := codec.ProtoMarshalJSON(&m, nil)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// UnmarshalPacketData decodes the JSON encoding of the packet data.
+func UnmarshalPacketData(bz []byte) (EcocreditPacketData, error) {
+	var data EcocreditPacketData
+	if err := jsonpb.Unmarshal(bytes.NewReader(bz), &data); err != nil {
+		return EcocreditPacketData{}, sdkerrors.ErrUnknownRequest.Wrapf("cannot unmarshal ecocredit packet data: %s", err)
+	}
+	return data, nil
+}
+
+// ValidateBasic performs basic validation of the EcocreditPacketData type
+func (m EcocreditPacketData) ValidateBasic() error {
+	if err := ParseDenomTrace(m.Denom).Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("denom: %s", err)
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Amount); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("amount: %s", err)
+	}
+
+	if m.Sender == "" {
+		return sdkerrors.ErrInvalidAddress.Wrap("sender cannot be empty")
+	}
+
+	if m.Receiver == "" {
+		return sdkerrors.ErrInvalidAddress.Wrap("receiver cannot be empty")
+	}
+
+	if m.Class == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("class cannot be empty")
+	}
+
+	if err := base.ValidateCreditTypeAbbreviation(m.Class.CreditTypeAbbrev); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("class: credit type abbrev: %s", err)
+	}
+
+	if m.Project == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("project cannot be empty")
+	}
+
+	if err := base.ValidateJurisdiction(m.Project.Jurisdiction); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("project: jurisdiction: %s", err)
+	}
+
+	if m.Batch == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("batch cannot be empty")
+	}
+
+	if m.Batch.StartDate == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("batch: start date cannot be empty")
+	}
+
+	if m.Batch.EndDate == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("batch: end date cannot be empty")
+	}
+
+	if m.Batch.IssuanceDate == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("batch: issuance date cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type packetData struct {
+	t       gocuke.TestingT
+	data    EcocreditPacketData
+	decoded EcocreditPacketData
+	err     error
+}
+
+func TestEcocreditPacketData(t *testing.T) {
+	gocuke.NewRunner(t, &packetData{}).Path("./features/types_packet_data.feature").Run()
+}
+
+func (s *packetData) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *packetData) ThePacketData(a gocuke.DocString) {
+	s.data = EcocreditPacketData{}
+	err := jsonpb.UnmarshalString(a.Content, &s.data)
+	require.NoError(s.t, err)
+}
+
+func (s *packetData) ThePacketDataFieldIs(field, value string) {
+	switch field {
+	case "denom":
+		s.data.Denom = value
+	case "amount":
+		s.data.Amount = value
+	case "sender":
+		s.data.Sender = value
+	case "receiver":
+		s.data.Receiver = value
+	default:
+		s.t.Fatalf("unknown field %s", field)
+	}
+}
+
+func (s *packetData) ThePacketDataFieldIsEmpty(field string) {
+	switch field {
+	case "class":
+		s.data.Class = nil
+	case "project":
+		s.data.Project = nil
+	case "batch":
+		s.data.Batch = nil
+	default:
+		s.t.Fatalf("unknown field %s", field)
+	}
+}
+
+func (s *packetData) ThePacketDataIsValidated() {
+	s.err = s.data.ValidateBasic()
+}
+
+func (s *packetData) ThePacketDataIsEncodedAndDecoded() {
+	s.decoded, s.err = UnmarshalPacketData(s.data.GetBytes())
+	require.NoError(s.t, s.err)
+}
+
+func (s *packetData) ExpectTheDecodedPacketDataToEqualThePacketData() {
+	require.Equal(s.t, s.data, s.decoded)
+}
+
+func (s *packetData) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *packetData) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
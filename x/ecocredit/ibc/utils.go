@@ -0,0 +1,38 @@
+package ibc
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// EscrowAddress returns the address of the account holding the credits sent
+// over a channel from a chain that is the source of the credits. The address
+// is derived from the submodule name, the port and the channel, and therefore
+// no private key exists for the account.
+func EscrowAddress(portID, channelID string) sdk.AccAddress {
+	return address.Module(SubModuleName, []byte(fmt.Sprintf("escrow/%s/%s", portID, channelID)))
+}
+
+// DenomPrefix returns the prefix added to the full denom path of credits
+// received over a channel (e.g. "ecocredit/channel-0/").
+func DenomPrefix(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/", portID, channelID)
+}
+
+// ReceiverChainIsSource returns true if the credits were originally sent from
+// the receiving chain over the port and channel the credits were sent from.
+// In this case the credits are returned from escrow on the receiving chain
+// rather than minted as vouchers.
+func ReceiverChainIsSource(sourcePort, sourceChannel, denom string) bool {
+	return strings.HasPrefix(denom, DenomPrefix(sourcePort, sourceChannel))
+}
+
+// SenderChainIsSource returns true if the credits were not originally received
+// over the port and channel the credits are being sent to. In this case the
+// credits are escrowed on the sending chain rather than cancelled.
+func SenderChainIsSource(sourcePort, sourceChannel, denom string) bool {
+	return !ReceiverChainIsSource(sourcePort, sourceChannel, denom)
+}
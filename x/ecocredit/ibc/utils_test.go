@@ -0,0 +1,26 @@
+package ibc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscrowAddress(t *testing.T) {
+	addr := EscrowAddress(PortID, "channel-0")
+	require.Len(t, addr, 32)
+	require.Equal(t, addr, EscrowAddress(PortID, "channel-0"))
+	require.NotEqual(t, addr, EscrowAddress(PortID, "channel-1"))
+}
+
+func TestChainIsSource(t *testing.T) {
+	denom := "ecocredit/channel-0/C01-001-20200101-20210101-001"
+
+	require.True(t, ReceiverChainIsSource("ecocredit", "channel-0", denom))
+	require.False(t, SenderChainIsSource("ecocredit", "channel-0", denom))
+
+	require.False(t, ReceiverChainIsSource("ecocredit", "channel-1", denom))
+	require.True(t, SenderChainIsSource("ecocredit", "channel-1", denom))
+
+	require.False(t, ReceiverChainIsSource("ecocredit", "channel-0", "C01-001-20200101-20210101-001"))
+}
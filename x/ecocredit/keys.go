@@ -4,6 +4,7 @@ import (
 	ormapi "github.com/cosmos/cosmos-sdk/api/cosmos/orm/v1alpha1"
 
 	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	ibcapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/ibc/v1"
 	marketApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 )
@@ -22,6 +23,7 @@ var ModuleSchema = ormapi.ModuleSchemaDescriptor{
 		{Id: 1, ProtoFileName: basketapi.File_regen_ecocredit_basket_v1_state_proto.Path()},
 		{Id: 2, ProtoFileName: api.File_regen_ecocredit_v1_state_proto.Path()},
 		{Id: 3, ProtoFileName: marketApi.File_regen_ecocredit_marketplace_v1_state_proto.Path()},
+		{Id: 4, ProtoFileName: ibcapi.File_regen_ecocredit_ibc_v1_state_proto.Path()},
 	},
 	Prefix: []byte{ORMPrefix},
 }
@@ -16,6 +16,8 @@ import (
 
 const (
 	FlagRetirementJurisdiction = "retirement-jurisdiction"
+	FlagRetirementReason       = "retirement-reason"
+	FlagRetirementBeneficiary  = "retirement-beneficiary"
 )
 
 // TxSellCmd returns a transaction command that creates sell orders.
@@ -181,6 +183,16 @@ NOTE: The bid price is the price paid PER credit. The total cost will be quantit
 				return err
 			}
 
+			retireReason, err := cmd.Flags().GetString(FlagRetirementReason)
+			if err != nil {
+				return err
+			}
+
+			retireBeneficiary, err := cmd.Flags().GetString(FlagRetirementBeneficiary)
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgBuyDirect{
 				Buyer: clientCtx.GetFromAddress().String(),
 				Orders: []*types.MsgBuyDirect_Order{
@@ -190,6 +202,8 @@ NOTE: The bid price is the price paid PER credit. The total cost will be quantit
 						BidPrice:               &bidPrice,
 						DisableAutoRetire:      disableAutoRetire,
 						RetirementJurisdiction: retireJurisdiction,
+						RetirementReason:       retireReason,
+						RetirementBeneficiary:  retireBeneficiary,
 					},
 				},
 			}
@@ -199,6 +213,8 @@ NOTE: The bid price is the price paid PER credit. The total cost will be quantit
 	}
 
 	cmd.Flags().String(FlagRetirementJurisdiction, "", "the jurisdiction to use for retirement when auto retire is true.")
+	cmd.Flags().String(FlagRetirementReason, "", "the reason for retirement when auto retire is true.")
+	cmd.Flags().String(FlagRetirementBeneficiary, "", "the address or name of the beneficiary of the retirement when auto retire is true.")
 
 	return txFlags(cmd)
 }
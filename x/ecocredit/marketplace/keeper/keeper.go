@@ -18,15 +18,17 @@ type Keeper struct {
 	stateStore marketapi.StateStore
 	baseStore  baseapi.StateStore
 	bankKeeper ecocredit.BankKeeper
+	nftKeeper  ecocredit.NFTKeeper
 	authority  sdk.AccAddress
 }
 
 func NewKeeper(ss marketapi.StateStore, cs baseapi.StateStore, bk ecocredit.BankKeeper,
-	authority sdk.AccAddress) Keeper {
+	nk ecocredit.NFTKeeper, authority sdk.AccAddress) Keeper {
 	return Keeper{
 		baseStore:  cs,
 		stateStore: ss,
 		bankKeeper: bk,
+		nftKeeper:  nk,
 		authority:  authority,
 	}
 }
@@ -13,6 +13,8 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
 	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
 	"github.com/cosmos/cosmos-sdk/orm/testing/ormtest"
@@ -20,6 +22,9 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
@@ -45,6 +50,7 @@ type baseSuite struct {
 	ctrl        *gomock.Controller
 	addrs       []sdk.AccAddress
 	bankKeeper  *mocks.MockBankKeeper
+	nftKeeper   nftkeeper.Keeper
 	storeKey    *storetypes.KVStoreKey
 	sdkCtx      sdk.Context
 }
@@ -64,6 +70,8 @@ func setupBase(t gocuke.TestingT, numAddresses int) *baseSuite {
 	cms := store.NewCommitMultiStore(db)
 	s.storeKey = sdk.NewKVStoreKey("test")
 	cms.MountStoreWithDB(s.storeKey, storetypes.StoreTypeIAVL, db)
+	nftKey := sdk.NewKVStoreKey(nftkeeper.StoreKey)
+	cms.MountStoreWithDB(nftKey, storetypes.StoreTypeIAVL, db)
 	assert.NilError(t, cms.LoadLatestVersion())
 	ormCtx := ormtable.WrapContextDefault(ormtest.NewMemoryBackend())
 	s.sdkCtx = sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger()).WithContext(ormCtx)
@@ -74,9 +82,14 @@ func setupBase(t gocuke.TestingT, numAddresses int) *baseSuite {
 	assert.NilError(t, err)
 	s.bankKeeper = mocks.NewMockBankKeeper(s.ctrl)
 
+	accountKeeper := mocks.NewMockAccountKeeper(s.ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(nft.ModuleName).Return(authtypes.NewModuleAddress(nft.ModuleName))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	s.nftKeeper = nftkeeper.NewKeeper(nftKey, cdc, accountKeeper, s.bankKeeper)
+
 	authority, err := sdk.AccAddressFromBech32("regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68")
 	assert.NilError(s.t, err)
-	s.k = NewKeeper(s.marketStore, s.baseStore, s.bankKeeper, authority)
+	s.k = NewKeeper(s.marketStore, s.baseStore, s.bankKeeper, s.nftKeeper, authority)
 
 	// set test accounts
 	for i := 0; i < numAddresses; i++ {
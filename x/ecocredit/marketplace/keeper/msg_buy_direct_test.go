@@ -18,6 +18,7 @@ import (
 	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/types/testutil"
+	basekeeper "github.com/regen-network/regen-ledger/x/ecocredit/base/keeper"
 	types "github.com/regen-network/regen-ledger/x/ecocredit/marketplace/types/v1"
 )
 
@@ -538,14 +539,21 @@ func (s *buyDirectSuite) createSellOrders(count int) {
 		totalQuantity = t.String()
 	}
 
-	err := s.baseStore.ClassTable().Insert(s.ctx, &baseapi.Class{
+	classKey, err := s.baseStore.ClassTable().InsertReturningID(s.ctx, &baseapi.Class{
 		Id:               s.classID,
 		CreditTypeAbbrev: s.creditTypeAbbrev,
 	})
 	require.NoError(s.t, err)
 
+	projectKey, err := s.baseStore.ProjectTable().InsertReturningID(s.ctx, &baseapi.Project{
+		Id:       s.classID + "-001",
+		ClassKey: classKey,
+	})
+	require.NoError(s.t, err)
+
 	batchKey, err := s.baseStore.BatchTable().InsertReturningID(s.ctx, &baseapi.Batch{
-		Denom: s.batchDenom,
+		ProjectKey: projectKey,
+		Denom:      s.batchDenom,
 	})
 	require.NoError(s.t, err)
 
@@ -562,6 +570,13 @@ func (s *buyDirectSuite) createSellOrders(count int) {
 	})
 	require.NoError(s.t, err)
 
+	tradable, err := math.NewDecFromString(totalQuantity)
+	require.NoError(s.t, err)
+
+	zero := math.NewDecFromInt64(0)
+	err = basekeeper.AddAggregateSupply(s.ctx, s.baseStore, batchKey, tradable, zero, zero)
+	require.NoError(s.t, err)
+
 	marketKey, err := s.marketStore.MarketTable().InsertReturningID(s.ctx, &api.Market{
 		CreditTypeAbbrev: s.creditTypeAbbrev,
 		BankDenom:        s.askPrice.Denom,
@@ -9,7 +9,9 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/math"
+	basekeeper "github.com/regen-network/regen-ledger/x/ecocredit/base/keeper"
 	basetypes "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
@@ -23,6 +25,8 @@ type orderOptions struct {
 	autoRetire   bool
 	batchDenom   string
 	jurisdiction string
+	reason       string
+	beneficiary  string
 }
 
 // fillOrder moves credits and coins according to the order. It will:
@@ -141,11 +145,26 @@ func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api
 		if err = k.baseStore.BatchSupplyTable().Update(ctx, supply); err != nil {
 			return err
 		}
+		if err = basekeeper.RetireAggregateSupply(ctx, k.baseStore, sellOrder.BatchKey, purchaseQty); err != nil {
+			return err
+		}
+		if _, err = basekeeper.RecordRetirement(ctx, k.baseStore, k.nftKeeper, &baseapi.Retirement{
+			Owner:        buyerAcc,
+			BatchKey:     sellOrder.BatchKey,
+			Amount:       purchaseQty.String(),
+			Jurisdiction: opts.jurisdiction,
+			Reason:       opts.reason,
+			Beneficiary:  opts.beneficiary,
+		}, opts.batchDenom); err != nil {
+			return err
+		}
 		if err = sdkCtx.EventManager().EmitTypedEvent(&basetypes.EventRetire{
 			Owner:        buyerAcc.String(),
 			BatchDenom:   opts.batchDenom,
 			Amount:       purchaseQty.String(),
 			Jurisdiction: opts.jurisdiction,
+			Reason:       opts.reason,
+			Beneficiary:  opts.beneficiary,
 		}); err != nil {
 			return err
 		}
@@ -153,6 +172,17 @@ func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api
 	if err = k.baseStore.BatchBalanceTable().Save(ctx, buyerBal); err != nil {
 		return err
 	}
+	if err = basekeeper.IndexBatchBalance(ctx, k.baseStore, buyerAcc, sellOrder.BatchKey); err != nil {
+		return err
+	}
+
+	serialStatus := baseapi.SerialStatus_SERIAL_STATUS_TRADABLE
+	if opts.autoRetire {
+		serialStatus = baseapi.SerialStatus_SERIAL_STATUS_RETIRED
+	}
+	if err = basekeeper.TransferSerials(ctx, k.baseStore, sellOrder.BatchKey, sellOrder.Seller, buyerAcc, purchaseQty, serialStatus); err != nil {
+		return err
+	}
 
 	return k.bankKeeper.SendCoins(sdkCtx, buyerAcc, sellOrder.Seller, sdk.NewCoins(cost))
 }
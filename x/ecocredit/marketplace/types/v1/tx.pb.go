@@ -568,6 +568,19 @@ type MsgBuyDirect_Order struct {
 	// retirement_jurisdiction is the optional retirement jurisdiction for the
 	// credits which will be used only if disable_auto_retire is false.
 	RetirementJurisdiction string `protobuf:"bytes,6,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// retirement_reason is the optional reason for retiring the credits (e.g.
+	// the sustainability claim the retirement is used for) and is only used if
+	// disable_auto_retire is false. The reason can be up to 512 characters.
+	//
+	// Since Revision 1
+	RetirementReason string `protobuf:"bytes,7,opt,name=retirement_reason,json=retirementReason,proto3" json:"retirement_reason,omitempty"`
+	// retirement_beneficiary is the optional beneficiary on whose behalf the
+	// credits are retired and is only used if disable_auto_retire is false. The
+	// beneficiary can be either an address or a free-text name and can be up to
+	// 256 characters.
+	//
+	// Since Revision 1
+	RetirementBeneficiary string `protobuf:"bytes,8,opt,name=retirement_beneficiary,json=retirementBeneficiary,proto3" json:"retirement_beneficiary,omitempty"`
 }
 
 func (m *MsgBuyDirect_Order) Reset()         { *m = MsgBuyDirect_Order{} }
@@ -638,6 +651,20 @@ func (m *MsgBuyDirect_Order) GetRetirementJurisdiction() string {
 	return ""
 }
 
+func (m *MsgBuyDirect_Order) GetRetirementReason() string {
+	if m != nil {
+		return m.RetirementReason
+	}
+	return ""
+}
+
+func (m *MsgBuyDirect_Order) GetRetirementBeneficiary() string {
+	if m != nil {
+		return m.RetirementBeneficiary
+	}
+	return ""
+}
+
 // MsgBuyDirectResponse is the Msg/BuyDirect response type.
 type MsgBuyDirectResponse struct {
 }
@@ -911,67 +938,69 @@ func init() {
 }
 
 var fileDescriptor_68c9b4e4b7fcb584 = []byte{
-	// 946 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x5f, 0x6f, 0xdb, 0x54,
-	0x14, 0xaf, 0xf3, 0x6f, 0xcd, 0x49, 0xdb, 0x81, 0x57, 0xba, 0x60, 0xd6, 0x34, 0x04, 0xa4, 0x45,
-	0x88, 0xd9, 0x6a, 0x07, 0x4c, 0x0a, 0x9a, 0x44, 0xbb, 0x0d, 0xc4, 0x50, 0x04, 0x78, 0xe3, 0x05,
-	0x09, 0x65, 0xfe, 0x73, 0x70, 0x2f, 0xb1, 0x7d, 0x8d, 0xef, 0x75, 0xdb, 0x3c, 0x82, 0x90, 0x90,
-	0x78, 0xda, 0x33, 0x8f, 0x7c, 0x02, 0x3e, 0x06, 0x8f, 0x7b, 0xe4, 0x0d, 0xd4, 0x4a, 0x8c, 0x27,
-	0x9e, 0xf8, 0x00, 0xe8, 0x5e, 0x3b, 0x6e, 0x9a, 0x34, 0xc3, 0xc9, 0x5b, 0xee, 0xf9, 0x7f, 0x7e,
-	0xbf, 0x73, 0x8e, 0x03, 0x37, 0x63, 0xf4, 0x30, 0x34, 0xd0, 0xa1, 0x4e, 0x8c, 0x2e, 0xe1, 0x46,
-	0x60, 0xc5, 0x43, 0xe4, 0x91, 0x6f, 0x39, 0x68, 0x1c, 0xed, 0x1a, 0xfc, 0x44, 0x8f, 0x62, 0xca,
-	0xa9, 0xda, 0x92, 0x86, 0x7a, 0x6e, 0xa8, 0x4f, 0x18, 0xea, 0x47, 0xbb, 0x5a, 0xcb, 0xa1, 0x2c,
-	0xa0, 0xcc, 0xb0, 0x2d, 0x26, 0x1c, 0x6d, 0xe4, 0xd6, 0xae, 0xe1, 0x50, 0x12, 0xa6, 0xfe, 0xda,
-	0xf5, 0x4c, 0x1f, 0x30, 0x4f, 0xc4, 0x0d, 0x98, 0x97, 0x29, 0x36, 0x3d, 0xea, 0x51, 0xf9, 0xd3,
-	0x10, 0xbf, 0x32, 0xe9, 0x8e, 0x47, 0xa9, 0xe7, 0xa3, 0x21, 0x5f, 0x76, 0xf2, 0xb5, 0xc1, 0x49,
-	0x80, 0x8c, 0x5b, 0x41, 0x94, 0x1a, 0x74, 0xfe, 0x2a, 0xc1, 0x95, 0x3e, 0xf3, 0x1e, 0xa1, 0xef,
-	0xab, 0x5b, 0x50, 0x63, 0xe8, 0xfb, 0x18, 0x37, 0x95, 0xb6, 0xd2, 0xad, 0x9b, 0xd9, 0x4b, 0x7d,
-	0x00, 0x35, 0x1a, 0xbb, 0x18, 0xb3, 0x66, 0xa9, 0x5d, 0xee, 0x36, 0xf6, 0x6e, 0xe9, 0x2f, 0x6e,
-	0x42, 0xcf, 0x02, 0xea, 0x9f, 0x0a, 0x2f, 0x33, 0x73, 0xd6, 0xfe, 0x51, 0xa0, 0x2a, 0x25, 0xea,
-	0x0e, 0x34, 0x6c, 0x8b, 0x3b, 0x87, 0x03, 0x17, 0x43, 0x1a, 0x64, 0xd9, 0x40, 0x8a, 0xee, 0x0b,
-	0x89, 0xaa, 0xc1, 0xea, 0xb7, 0x89, 0x15, 0x72, 0xc2, 0x47, 0xcd, 0x92, 0xd4, 0xe6, 0x6f, 0xf5,
-	0x3d, 0xa8, 0x5b, 0x6c, 0x38, 0x88, 0x62, 0xe2, 0x60, 0xb3, 0xdc, 0x56, 0xba, 0x8d, 0xbd, 0x57,
-	0xf5, 0x14, 0x15, 0x5d, 0xa0, 0xa6, 0x67, 0xa8, 0xe9, 0xf7, 0x28, 0x09, 0xcd, 0x55, 0x8b, 0x0d,
-	0x3f, 0x13, 0xa6, 0xaa, 0x0e, 0xd7, 0x5c, 0xc2, 0x2c, 0xdb, 0xc7, 0x81, 0x95, 0x70, 0x3a, 0x88,
-	0x91, 0x93, 0x18, 0x9b, 0x95, 0xb6, 0xd2, 0x5d, 0x35, 0x5f, 0xce, 0x54, 0xfb, 0x09, 0xa7, 0xa6,
-	0x54, 0xa8, 0x1f, 0x00, 0xe0, 0x49, 0x44, 0x62, 0x8b, 0x13, 0x1a, 0x36, 0xab, 0x32, 0x91, 0xa6,
-	0xa7, 0x78, 0xea, 0x63, 0x3c, 0xf5, 0xc7, 0x63, 0x3c, 0x0f, 0x2a, 0x4f, 0xff, 0xd8, 0x51, 0xcc,
-	0x09, 0x9f, 0x5e, 0xe3, 0xfb, 0xe7, 0xbf, 0xbe, 0x95, 0x81, 0xd8, 0xb9, 0x03, 0x57, 0x33, 0x58,
-	0x4c, 0x64, 0x11, 0x0d, 0x19, 0xaa, 0x6f, 0xc2, 0x86, 0x50, 0x0e, 0x24, 0x3e, 0x03, 0xe2, 0xb2,
-	0xa6, 0xd2, 0x2e, 0x77, 0x2b, 0xe6, 0x9a, 0x90, 0x4a, 0xa4, 0x3e, 0x76, 0x59, 0xe7, 0xe7, 0x32,
-	0x5c, 0xeb, 0x33, 0xef, 0x8b, 0xc8, 0xb5, 0x38, 0x3e, 0x1a, 0x6b, 0xd8, 0x5c, 0xb6, 0x1e, 0xc3,
-	0x95, 0x44, 0xda, 0x8e, 0xe9, 0xea, 0x15, 0xa0, 0x6b, 0x3a, 0xba, 0x9e, 0x0a, 0xcc, 0x71, 0x28,
-	0xed, 0xc7, 0x12, 0xd4, 0x52, 0x99, 0xda, 0x81, 0xf5, 0x0b, 0x65, 0xcb, 0xfc, 0x15, 0xb3, 0x31,
-	0x51, 0xb5, 0xfa, 0x3a, 0xac, 0x85, 0x78, 0x3c, 0x98, 0x22, 0xb1, 0x11, 0xe2, 0xf1, 0xe7, 0x63,
-	0x1e, 0xef, 0xc2, 0xba, 0x30, 0x59, 0x80, 0x4b, 0xe1, 0xbe, 0xbf, 0x2c, 0x9d, 0x1f, 0xc1, 0x86,
-	0x48, 0xb7, 0x04, 0xa5, 0xa2, 0xcc, 0x07, 0x73, 0x58, 0xdd, 0x86, 0xd7, 0x2e, 0x41, 0x6f, 0xcc,
-	0x70, 0xe7, 0x2b, 0x50, 0xfb, 0xcc, 0xbb, 0x67, 0x85, 0x0e, 0xfa, 0xb9, 0x7a, 0x2e, 0x73, 0x33,
-	0xc0, 0x96, 0x66, 0x80, 0xbd, 0x98, 0xfd, 0x06, 0x68, 0xb3, 0xe1, 0xf3, 0xe4, 0xcf, 0x4b, 0xb0,
-	0xd6, 0x67, 0xde, 0x41, 0x32, 0xba, 0x4f, 0x62, 0x74, 0xb8, 0xba, 0x09, 0x55, 0x3b, 0x19, 0xe5,
-	0x69, 0xd3, 0x87, 0xfa, 0x70, 0x6a, 0xbb, 0xf7, 0x0a, 0x8c, 0x4b, 0x1e, 0x73, 0x6a, 0xc5, 0xff,
-	0xce, 0x57, 0xbc, 0x40, 0x2f, 0x17, 0xb6, 0xbc, 0x3c, 0xbb, 0xe5, 0x36, 0x71, 0xb3, 0xc9, 0xa8,
-	0xfc, 0xef, 0x96, 0xdb, 0xc4, 0x7d, 0xe1, 0x58, 0x54, 0xe7, 0x8d, 0xc5, 0x1d, 0xb8, 0x9e, 0x9a,
-	0x04, 0x18, 0xf2, 0xc1, 0x37, 0x49, 0x4c, 0x98, 0x4b, 0x1c, 0x39, 0x1f, 0x35, 0x59, 0xd2, 0xd6,
-	0xb9, 0xfa, 0xe1, 0x84, 0xb6, 0x07, 0x82, 0x88, 0x14, 0xc2, 0xce, 0x16, 0x6c, 0x4e, 0x82, 0x92,
-	0x33, 0xf0, 0x8b, 0x22, 0xf9, 0xdf, 0x77, 0xdd, 0x7d, 0xdf, 0xa7, 0xc7, 0xe8, 0xa6, 0xd7, 0xed,
-	0x06, 0xd4, 0xad, 0x84, 0x1f, 0xd2, 0x58, 0x34, 0x9e, 0x72, 0x71, 0x2e, 0x50, 0xb7, 0x01, 0x6c,
-	0x2b, 0x1c, 0x66, 0xb7, 0x31, 0x5d, 0x9c, 0xba, 0x90, 0xa4, 0xce, 0x6f, 0xc0, 0xba, 0x4b, 0x58,
-	0xe4, 0x5b, 0xa3, 0xcc, 0x22, 0x45, 0x6e, 0x2d, 0x13, 0xe6, 0xf7, 0x13, 0x4f, 0x22, 0x1a, 0x62,
-	0xc8, 0x25, 0x78, 0xeb, 0x66, 0xfe, 0xee, 0x6d, 0x88, 0xc2, 0xcf, 0xf3, 0x65, 0x43, 0x34, 0x55,
-	0x63, 0xde, 0xc2, 0x27, 0xf0, 0x4a, 0x9f, 0x79, 0x26, 0x06, 0xf4, 0x08, 0x17, 0x68, 0x62, 0x13,
-	0xaa, 0x93, 0xf5, 0xa7, 0x8f, 0xce, 0x0e, 0x6c, 0x5f, 0x1a, 0x6c, 0x9c, 0x6d, 0xef, 0xdf, 0x2a,
-	0x94, 0xfb, 0xcc, 0x53, 0x9f, 0x40, 0x45, 0x7e, 0x91, 0x6e, 0x16, 0xfc, 0xd2, 0x68, 0x46, 0x41,
-	0xc3, 0xfc, 0xf6, 0xfe, 0xa0, 0xc0, 0x4b, 0x33, 0x27, 0xf5, 0xf6, 0x12, 0x97, 0x52, 0x7b, 0x7f,
-	0x09, 0xa7, 0xbc, 0x8c, 0xef, 0x14, 0xb8, 0x3a, 0x7d, 0x1e, 0x8a, 0x2c, 0xe0, 0x94, 0x8f, 0xd6,
-	0x5b, 0xdc, 0x27, 0xaf, 0x81, 0x42, 0xfd, 0xfc, 0x46, 0xbc, 0xbd, 0xc8, 0xf6, 0x6b, 0xef, 0x2c,
-	0x62, 0x7d, 0xa1, 0xe9, 0xe9, 0x9d, 0x28, 0xd2, 0xf4, 0x94, 0x4f, 0xa1, 0xa6, 0xe7, 0xcc, 0xb5,
-	0xfa, 0x93, 0x02, 0xea, 0x25, 0x53, 0xfd, 0x6e, 0x81, 0x90, 0xb3, 0x6e, 0xda, 0xdd, 0xa5, 0xdc,
-	0xc6, 0xc5, 0x1c, 0x3c, 0xf9, 0xed, 0xb4, 0xa5, 0x3c, 0x3b, 0x6d, 0x29, 0x7f, 0x9e, 0xb6, 0x94,
-	0xa7, 0x67, 0xad, 0x95, 0x67, 0x67, 0xad, 0x95, 0xdf, 0xcf, 0x5a, 0x2b, 0x5f, 0x7e, 0xe8, 0x11,
-	0x7e, 0x98, 0xd8, 0xba, 0x43, 0x03, 0x43, 0xa6, 0xb8, 0x15, 0x22, 0x3f, 0xa6, 0xf1, 0x30, 0x7b,
-	0xf9, 0xe8, 0x7a, 0x18, 0x1b, 0x27, 0x73, 0xfe, 0x79, 0xf2, 0x51, 0x84, 0x4c, 0xfc, 0x8d, 0xac,
-	0xc9, 0xaf, 0xdb, 0xed, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x19, 0x49, 0xcc, 0x65, 0xa8, 0x0a,
-	0x00, 0x00,
+	// 989 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0x1b, 0x55,
+	0x10, 0xcf, 0xfa, 0x4f, 0xfe, 0x8c, 0x93, 0xb4, 0xdd, 0xa6, 0xa9, 0x59, 0x1a, 0x27, 0x18, 0xa4,
+	0x5a, 0x40, 0x77, 0x95, 0x94, 0x52, 0xc9, 0xa8, 0x12, 0x49, 0x5b, 0x10, 0x45, 0x16, 0xb0, 0x2d,
+	0x17, 0x24, 0xe4, 0xbe, 0xdd, 0x9d, 0x6e, 0x1e, 0xde, 0xdd, 0xb7, 0xec, 0x7b, 0x9b, 0xc4, 0x47,
+	0x10, 0x12, 0x12, 0xa7, 0x9e, 0x39, 0xf2, 0x09, 0xf8, 0x18, 0x48, 0x70, 0xe8, 0x91, 0x1b, 0x28,
+	0x91, 0xe0, 0xc6, 0x89, 0x0f, 0x80, 0xf6, 0xed, 0x1f, 0x3b, 0x76, 0x5c, 0x6c, 0xdf, 0xfc, 0x66,
+	0xe6, 0x37, 0x33, 0xef, 0xf7, 0x7b, 0x33, 0x6b, 0xb8, 0x19, 0xa1, 0x8b, 0x81, 0x81, 0x36, 0xb3,
+	0x23, 0x74, 0xa8, 0x30, 0x7c, 0x12, 0xf5, 0x50, 0x84, 0x1e, 0xb1, 0xd1, 0x38, 0xda, 0x35, 0xc4,
+	0x89, 0x1e, 0x46, 0x4c, 0x30, 0xb5, 0x21, 0x03, 0xf5, 0x22, 0x50, 0x1f, 0x0a, 0xd4, 0x8f, 0x76,
+	0xb5, 0x86, 0xcd, 0xb8, 0xcf, 0xb8, 0x61, 0x11, 0x9e, 0x00, 0x2d, 0x14, 0x64, 0xd7, 0xb0, 0x19,
+	0x0d, 0x52, 0xbc, 0x76, 0x3d, 0xf3, 0xfb, 0xdc, 0x4d, 0xf2, 0xfa, 0xdc, 0xcd, 0x1c, 0x1b, 0x2e,
+	0x73, 0x99, 0xfc, 0x69, 0x24, 0xbf, 0x32, 0xeb, 0xb6, 0xcb, 0x98, 0xeb, 0xa1, 0x21, 0x4f, 0x56,
+	0xfc, 0xcc, 0x10, 0xd4, 0x47, 0x2e, 0x88, 0x1f, 0xa6, 0x01, 0xcd, 0xbf, 0x4a, 0xb0, 0xd4, 0xe1,
+	0xee, 0x63, 0xf4, 0x3c, 0x75, 0x13, 0x16, 0x39, 0x7a, 0x1e, 0x46, 0x75, 0x65, 0x47, 0x69, 0xad,
+	0x98, 0xd9, 0x49, 0x7d, 0x08, 0x8b, 0x2c, 0x72, 0x30, 0xe2, 0xf5, 0xd2, 0x4e, 0xb9, 0x55, 0xdb,
+	0xbb, 0xa5, 0xbf, 0xfc, 0x12, 0x7a, 0x96, 0x50, 0xff, 0x24, 0x41, 0x99, 0x19, 0x58, 0xfb, 0x47,
+	0x81, 0xaa, 0xb4, 0xa8, 0xdb, 0x50, 0xb3, 0x88, 0xb0, 0x0f, 0xbb, 0x0e, 0x06, 0xcc, 0xcf, 0xaa,
+	0x81, 0x34, 0x3d, 0x48, 0x2c, 0xaa, 0x06, 0xcb, 0x5f, 0xc7, 0x24, 0x10, 0x54, 0xf4, 0xeb, 0x25,
+	0xe9, 0x2d, 0xce, 0xea, 0xbb, 0xb0, 0x42, 0x78, 0xaf, 0x1b, 0x46, 0xd4, 0xc6, 0x7a, 0x79, 0x47,
+	0x69, 0xd5, 0xf6, 0x5e, 0xd1, 0x53, 0x56, 0xf4, 0x84, 0x35, 0x3d, 0x63, 0x4d, 0xbf, 0xcf, 0x68,
+	0x60, 0x2e, 0x13, 0xde, 0xfb, 0x34, 0x09, 0x55, 0x75, 0xb8, 0xea, 0x50, 0x4e, 0x2c, 0x0f, 0xbb,
+	0x24, 0x16, 0xac, 0x1b, 0xa1, 0xa0, 0x11, 0xd6, 0x2b, 0x3b, 0x4a, 0x6b, 0xd9, 0xbc, 0x92, 0xb9,
+	0xf6, 0x63, 0xc1, 0x4c, 0xe9, 0x50, 0xdf, 0x07, 0xc0, 0x93, 0x90, 0x46, 0x44, 0x50, 0x16, 0xd4,
+	0xab, 0xb2, 0x90, 0xa6, 0xa7, 0x7c, 0xea, 0x39, 0x9f, 0xfa, 0x93, 0x9c, 0xcf, 0x83, 0xca, 0xf3,
+	0x3f, 0xb6, 0x15, 0x73, 0x08, 0xd3, 0xae, 0x7d, 0xfb, 0xf7, 0xcf, 0x6f, 0x66, 0x24, 0x36, 0xef,
+	0xc2, 0xa5, 0x8c, 0x16, 0x13, 0x79, 0xc8, 0x02, 0x8e, 0xea, 0x1b, 0xb0, 0x9e, 0x38, 0xbb, 0x92,
+	0x9f, 0x2e, 0x75, 0x78, 0x5d, 0xd9, 0x29, 0xb7, 0x2a, 0xe6, 0x6a, 0x62, 0x95, 0x4c, 0x7d, 0xe4,
+	0xf0, 0xe6, 0x8f, 0x65, 0xb8, 0xda, 0xe1, 0xee, 0xe7, 0xa1, 0x43, 0x04, 0x3e, 0xce, 0x3d, 0x7c,
+	0xa2, 0x5a, 0x4f, 0x60, 0x29, 0x96, 0xb1, 0xb9, 0x5c, 0xed, 0x29, 0xe4, 0x1a, 0xcd, 0xae, 0xa7,
+	0x06, 0x33, 0x4f, 0xa5, 0x7d, 0x5f, 0x82, 0xc5, 0xd4, 0xa6, 0x36, 0x61, 0xed, 0x5c, 0xdb, 0xb2,
+	0x7e, 0xc5, 0xac, 0x0d, 0x75, 0xad, 0xbe, 0x06, 0xab, 0x01, 0x1e, 0x77, 0x47, 0x44, 0xac, 0x05,
+	0x78, 0xfc, 0x59, 0xae, 0xe3, 0x3d, 0x58, 0x4b, 0x42, 0x66, 0xd0, 0x32, 0x81, 0xef, 0xcf, 0x2b,
+	0xe7, 0x87, 0xb0, 0x9e, 0x94, 0x9b, 0x43, 0xd2, 0xa4, 0xcd, 0x87, 0x13, 0x54, 0xdd, 0x82, 0x57,
+	0x2f, 0x60, 0x2f, 0x57, 0xb8, 0xf9, 0x25, 0xa8, 0x1d, 0xee, 0xde, 0x27, 0x81, 0x8d, 0x5e, 0xe1,
+	0x9e, 0xa8, 0xdc, 0x18, 0xb1, 0xa5, 0x31, 0x62, 0xcf, 0x57, 0xbf, 0x01, 0xda, 0x78, 0xfa, 0xa2,
+	0xf8, 0xaf, 0x65, 0x58, 0xed, 0x70, 0xf7, 0x20, 0xee, 0x3f, 0xa0, 0x11, 0xda, 0x42, 0xdd, 0x80,
+	0xaa, 0x15, 0xf7, 0x8b, 0xb2, 0xe9, 0x41, 0x7d, 0x34, 0x32, 0xdd, 0x7b, 0x53, 0x3c, 0x97, 0x22,
+	0xe7, 0xc8, 0x88, 0xff, 0x56, 0xca, 0x47, 0x7c, 0x8a, 0xbb, 0x9c, 0x9b, 0xf2, 0xf2, 0xf8, 0x94,
+	0x5b, 0xd4, 0xc9, 0x5e, 0x46, 0xe5, 0x7f, 0xa7, 0xdc, 0xa2, 0xce, 0x4b, 0x9f, 0x45, 0x75, 0xd2,
+	0xb3, 0xb8, 0x0b, 0xd7, 0xd3, 0x10, 0x1f, 0x03, 0xd1, 0xfd, 0x2a, 0x8e, 0x28, 0x77, 0xa8, 0x2d,
+	0xdf, 0xc7, 0xa2, 0x6c, 0x69, 0x73, 0xe0, 0x7e, 0x34, 0xe4, 0x55, 0xdf, 0x82, 0x2b, 0x43, 0xc0,
+	0x08, 0x09, 0x67, 0x41, 0x7d, 0x49, 0x42, 0x2e, 0x0f, 0x1c, 0xa6, 0xb4, 0xab, 0x77, 0x60, 0x28,
+	0x4d, 0xd7, 0xc2, 0x00, 0x9f, 0x51, 0x9b, 0x92, 0xa8, 0x5f, 0x5f, 0x96, 0x88, 0x6b, 0x03, 0xef,
+	0xc1, 0xc0, 0xd9, 0x86, 0x44, 0xec, 0x54, 0xa6, 0xe6, 0x26, 0x6c, 0x0c, 0x13, 0x5f, 0xa8, 0xfc,
+	0x93, 0x22, 0xdf, 0xd8, 0xbe, 0xe3, 0xec, 0x7b, 0x1e, 0x3b, 0x46, 0x27, 0xdd, 0xa0, 0x37, 0x60,
+	0x85, 0xc4, 0xe2, 0x90, 0x45, 0x09, 0xb9, 0xa9, 0xde, 0x03, 0x83, 0xba, 0x05, 0x60, 0x91, 0xa0,
+	0x97, 0xed, 0xdf, 0x74, 0x38, 0x57, 0x12, 0x4b, 0x0a, 0x7e, 0x1d, 0xd6, 0x1c, 0xca, 0x43, 0x8f,
+	0xf4, 0xb3, 0x88, 0x54, 0x9d, 0xd5, 0xcc, 0x58, 0xec, 0x68, 0x3c, 0x09, 0x59, 0x80, 0x81, 0x90,
+	0x02, 0xad, 0x99, 0xc5, 0xb9, 0xbd, 0x9e, 0x34, 0x3e, 0xa8, 0x97, 0x3d, 0xd4, 0x91, 0x1e, 0x8b,
+	0x2b, 0x7c, 0x0c, 0xd7, 0x3a, 0xdc, 0x35, 0xd1, 0x67, 0x47, 0x38, 0xc3, 0x25, 0x36, 0xa0, 0x3a,
+	0xdc, 0x7f, 0x7a, 0x68, 0x6e, 0xc3, 0xd6, 0x85, 0xc9, 0xf2, 0x6a, 0x7b, 0xff, 0x56, 0xa1, 0xdc,
+	0xe1, 0xae, 0xfa, 0x14, 0x2a, 0xf2, 0xab, 0x77, 0x73, 0xca, 0xaf, 0x99, 0x66, 0x4c, 0x19, 0x58,
+	0xec, 0xf7, 0xef, 0x14, 0xb8, 0x3c, 0xb6, 0xb6, 0x6f, 0xcf, 0xb1, 0x8d, 0xb5, 0xf7, 0xe6, 0x00,
+	0x15, 0x6d, 0x7c, 0xa3, 0xc0, 0xa5, 0xd1, 0x15, 0x34, 0xcd, 0x90, 0x8f, 0x60, 0xb4, 0xf6, 0xec,
+	0x98, 0xa2, 0x07, 0x06, 0x2b, 0x83, 0x3d, 0xf4, 0xf6, 0x2c, 0x1b, 0x46, 0x7b, 0x67, 0x96, 0xe8,
+	0x73, 0x97, 0x1e, 0x9d, 0x89, 0x69, 0x2e, 0x3d, 0x82, 0xd1, 0xda, 0xb3, 0x63, 0x8a, 0x1e, 0x7e,
+	0x50, 0x40, 0xbd, 0xe0, 0x55, 0xdf, 0x99, 0x22, 0xe5, 0x38, 0x4c, 0xbb, 0x37, 0x17, 0x2c, 0x6f,
+	0xe6, 0xe0, 0xe9, 0x2f, 0xa7, 0x0d, 0xe5, 0xc5, 0x69, 0x43, 0xf9, 0xf3, 0xb4, 0xa1, 0x3c, 0x3f,
+	0x6b, 0x2c, 0xbc, 0x38, 0x6b, 0x2c, 0xfc, 0x7e, 0xd6, 0x58, 0xf8, 0xe2, 0x03, 0x97, 0x8a, 0xc3,
+	0xd8, 0xd2, 0x6d, 0xe6, 0x1b, 0xb2, 0xc4, 0xad, 0x00, 0xc5, 0x31, 0x8b, 0x7a, 0xd9, 0xc9, 0x43,
+	0xc7, 0xc5, 0xc8, 0x38, 0x99, 0xf0, 0xef, 0x56, 0xf4, 0x43, 0xe4, 0xc9, 0x5f, 0xd5, 0x45, 0xf9,
+	0x05, 0xbd, 0xfd, 0xdf, 0x00, 0xc2, 0x48, 0x1f, 0x55, 0x0c, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1666,6 +1695,20 @@ func (m *MsgBuyDirect_Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RetirementBeneficiary) > 0 {
+		i -= len(m.RetirementBeneficiary)
+		copy(dAtA[i:], m.RetirementBeneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RetirementBeneficiary)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RetirementReason) > 0 {
+		i -= len(m.RetirementReason)
+		copy(dAtA[i:], m.RetirementReason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RetirementReason)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.RetirementJurisdiction) > 0 {
 		i -= len(m.RetirementJurisdiction)
 		copy(dAtA[i:], m.RetirementJurisdiction)
@@ -2062,6 +2105,14 @@ func (m *MsgBuyDirect_Order) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.RetirementReason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RetirementBeneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3381,6 +3432,70 @@ func (m *MsgBuyDirect_Order) Unmarshal(dAtA []byte) error {
 			}
 			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementBeneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementBeneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
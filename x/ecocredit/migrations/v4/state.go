@@ -0,0 +1,242 @@
+package v4
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/orm/encoding/ormkv"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	basekeeper "github.com/regen-network/regen-ledger/x/ecocredit/base/keeper"
+)
+
+// bridgeChainName is the name of the only allowed bridge chain before allowed
+// bridge contracts were introduced (see Migrator.Migrate2to3).
+const bridgeChainName = "polygon"
+
+// MigrateState performs in-place store migrations from ConsensusVersion 3 to 4.
+func MigrateState(sdkCtx sdk.Context, storeKey storetypes.StoreKey, baseStore baseapi.StateStore) error {
+	// aggregate the supply of existing credit batches by project, credit class,
+	// and credit type
+	itr, err := baseStore.BatchSupplyTable().List(sdkCtx, baseapi.BatchSupplyPrimaryKey{})
+	if err != nil {
+		return err
+	}
+
+	// collect batch supplies before writing to avoid modifying the store while
+	// iterating
+	var supplies []*baseapi.BatchSupply
+	for itr.Next() {
+		supply, err := itr.Value()
+		if err != nil {
+			itr.Close()
+			return err
+		}
+		supplies = append(supplies, supply)
+	}
+	itr.Close()
+
+	for _, supply := range supplies {
+		tradable, err := parseAmount(supply.TradableAmount)
+		if err != nil {
+			return err
+		}
+		retired, err := parseAmount(supply.RetiredAmount)
+		if err != nil {
+			return err
+		}
+		cancelled, err := parseAmount(supply.CancelledAmount)
+		if err != nil {
+			return err
+		}
+		if err := basekeeper.AddAggregateSupply(sdkCtx, baseStore, supply.BatchKey, tradable, retired, cancelled); err != nil {
+			return err
+		}
+	}
+
+	// index existing batch balances by credit class and project and track the
+	// holders of each project
+	bItr, err := baseStore.BatchBalanceTable().List(sdkCtx, baseapi.BatchBalancePrimaryKey{})
+	if err != nil {
+		return err
+	}
+
+	var balances []*baseapi.BatchBalance
+	for bItr.Next() {
+		balance, err := bItr.Value()
+		if err != nil {
+			bItr.Close()
+			return err
+		}
+		balances = append(balances, balance)
+	}
+	bItr.Close()
+
+	for _, balance := range balances {
+		if err := basekeeper.IndexBatchBalance(sdkCtx, baseStore, balance.Address, balance.BatchKey); err != nil {
+			return err
+		}
+	}
+
+	// index existing projects by jurisdiction, writing the index entries
+	// directly because the index is only updated when the indexed field changes
+	pItr, err := baseStore.ProjectTable().List(sdkCtx, baseapi.ProjectPrimaryKey{})
+	if err != nil {
+		return err
+	}
+
+	var projects []*baseapi.Project
+	for pItr.Next() {
+		project, err := pItr.Value()
+		if err != nil {
+			pItr.Close()
+			return err
+		}
+		projects = append(projects, project)
+	}
+	pItr.Close()
+
+	db, err := ormdb.NewModuleDB(&ecocredit.ModuleSchema, ormdb.ModuleDBOptions{})
+	if err != nil {
+		return err
+	}
+
+	jurisdictionIndex, ok := db.GetTable(&baseapi.Project{}).GetIndex("jurisdiction").(ormkv.IndexCodec)
+	if !ok {
+		return fmt.Errorf("project jurisdiction index not found")
+	}
+
+	store := sdkCtx.KVStore(storeKey)
+	for _, project := range projects {
+		k, v, err := jurisdictionIndex.EncodeKVFromMessage(project.ProtoReflect())
+		if err != nil {
+			return err
+		}
+		store.Set(k, v)
+	}
+
+	// allow the contracts of existing bridged credit batches on the bridge
+	// chain they were bridged from so that credits can continue to be bridged
+	// to and from those contracts after the upgrade, bridge services are not
+	// allowed here and must be added through governance
+	bcItr, err := baseStore.BatchContractTable().List(sdkCtx, baseapi.BatchContractPrimaryKey{})
+	if err != nil {
+		return err
+	}
+
+	var batchContracts []*baseapi.BatchContract
+	for bcItr.Next() {
+		batchContract, err := bcItr.Value()
+		if err != nil {
+			bcItr.Close()
+			return err
+		}
+		batchContracts = append(batchContracts, batchContract)
+	}
+	bcItr.Close()
+
+	for _, batchContract := range batchContracts {
+		if err := baseStore.AllowedBridgeContractTable().Save(sdkCtx, &baseapi.AllowedBridgeContract{
+			ChainName: bridgeChainName,
+			Contract:  strings.ToLower(batchContract.Contract),
+		}); err != nil {
+			return err
+		}
+	}
+
+	// link existing origin tx index entries to the credit batch that was
+	// created or had credits minted by the originating transaction
+	return backfillOriginTxBatches(sdkCtx, baseStore, projects, batchContracts)
+}
+
+// backfillOriginTxBatches sets the batch key of origin tx index entries that
+// were created before the batch key was recorded. The credit batch can only be
+// determined when the credit class has a single credit batch or, for origin
+// transactions from the bridge chain, a single credit batch bridged from a
+// contract. All other origin tx index entries remain unlinked.
+func backfillOriginTxBatches(sdkCtx sdk.Context, baseStore baseapi.StateStore, projects []*baseapi.Project, batchContracts []*baseapi.BatchContract) error {
+	oItr, err := baseStore.OriginTxIndexTable().List(sdkCtx, baseapi.OriginTxIndexPrimaryKey{})
+	if err != nil {
+		return err
+	}
+
+	var indexes []*baseapi.OriginTxIndex
+	for oItr.Next() {
+		index, err := oItr.Value()
+		if err != nil {
+			oItr.Close()
+			return err
+		}
+		if index.BatchKey == 0 {
+			indexes = append(indexes, index)
+		}
+	}
+	oItr.Close()
+
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	projectClasses := make(map[uint64]uint64, len(projects))
+	for _, project := range projects {
+		projectClasses[project.Key] = project.ClassKey
+	}
+
+	bItr, err := baseStore.BatchTable().List(sdkCtx, baseapi.BatchPrimaryKey{})
+	if err != nil {
+		return err
+	}
+
+	classBatches := make(map[uint64][]uint64)
+	for bItr.Next() {
+		batch, err := bItr.Value()
+		if err != nil {
+			bItr.Close()
+			return err
+		}
+		classKey := projectClasses[batch.ProjectKey]
+		classBatches[classKey] = append(classBatches[classKey], batch.Key)
+	}
+	bItr.Close()
+
+	classContracts := make(map[uint64][]*baseapi.BatchContract)
+	batchContractByKey := make(map[uint64]*baseapi.BatchContract, len(batchContracts))
+	for _, batchContract := range batchContracts {
+		classContracts[batchContract.ClassKey] = append(classContracts[batchContract.ClassKey], batchContract)
+		batchContractByKey[batchContract.BatchKey] = batchContract
+	}
+
+	for _, index := range indexes {
+		switch {
+		case len(classBatches[index.ClassKey]) == 1:
+			index.BatchKey = classBatches[index.ClassKey][0]
+		case index.Source == bridgeChainName && len(classContracts[index.ClassKey]) == 1:
+			index.BatchKey = classContracts[index.ClassKey][0].BatchKey
+		default:
+			continue
+		}
+
+		if batchContract, ok := batchContractByKey[index.BatchKey]; ok {
+			index.Contract = batchContract.Contract
+		}
+
+		if err := baseStore.OriginTxIndexTable().Update(sdkCtx, index); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseAmount parses a supply amount, treating an empty amount as zero.
+func parseAmount(amount string) (math.Dec, error) {
+	if amount == "" {
+		return math.NewDecFromInt64(0), nil
+	}
+	return math.NewNonNegativeDecFromString(amount)
+}
@@ -0,0 +1,269 @@
+package v4_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/orm/encoding/ormkv"
+	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormstore"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	v4 "github.com/regen-network/regen-ledger/x/ecocredit/migrations/v4"
+)
+
+func TestMigrations(t *testing.T) {
+	ecocreditKey := sdk.NewKVStoreKey("ecocredit")
+
+	db := dbm.NewMemDB()
+	cms := store.NewCommitMultiStore(db)
+	cms.MountStoreWithDB(ecocreditKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, cms.LoadLatestVersion())
+	sdkCtx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+
+	modDB, err := ormstore.NewStoreKeyDB(&ecocredit.ModuleSchema, ecocreditKey, ormdb.ModuleDBOptions{})
+	require.NoError(t, err)
+	baseStore, err := baseapi.NewStateStore(modDB)
+	require.NoError(t, err)
+
+	require.NoError(t, baseStore.CreditTypeTable().Insert(sdkCtx, &baseapi.CreditType{
+		Abbreviation: "C",
+		Name:         "carbon",
+		Unit:         "metric ton CO2 equivalent",
+		Precision:    6,
+	}))
+
+	classKey, err := baseStore.ClassTable().InsertReturningID(sdkCtx, &baseapi.Class{
+		Id:               "C01",
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(t, err)
+
+	projectKey1, err := baseStore.ProjectTable().InsertReturningID(sdkCtx, &baseapi.Project{
+		Id:           "C01-001",
+		ClassKey:     classKey,
+		Jurisdiction: "US-WA",
+	})
+	require.NoError(t, err)
+
+	projectKey2, err := baseStore.ProjectTable().InsertReturningID(sdkCtx, &baseapi.Project{
+		Id:           "C01-002",
+		ClassKey:     classKey,
+		Jurisdiction: "KE",
+	})
+	require.NoError(t, err)
+
+	// remove the jurisdiction index entries of the projects to reproduce the
+	// state of projects created before the index was added
+	jurisdictionIndex := modDB.GetTable(&baseapi.Project{}).GetIndex("jurisdiction").(ormkv.IndexCodec)
+	for _, key := range []uint64{projectKey1, projectKey2} {
+		project, err := baseStore.ProjectTable().Get(sdkCtx, key)
+		require.NoError(t, err)
+		k, _, err := jurisdictionIndex.EncodeKVFromMessage(project.ProtoReflect())
+		require.NoError(t, err)
+		sdkCtx.KVStore(ecocreditKey).Delete(k)
+	}
+
+	pItr, err := baseStore.ProjectTable().List(sdkCtx, baseapi.ProjectJurisdictionIndexKey{}.WithJurisdiction("US-WA"))
+	require.NoError(t, err)
+	require.False(t, pItr.Next())
+	pItr.Close()
+
+	batches := []struct {
+		projectKey uint64
+		denom      string
+		supply     *baseapi.BatchSupply
+	}{
+		{projectKey1, "C01-001-20200101-20210101-001", &baseapi.BatchSupply{TradableAmount: "10", RetiredAmount: "5", CancelledAmount: "1"}},
+		{projectKey1, "C01-001-20200101-20210101-002", &baseapi.BatchSupply{TradableAmount: "2.5", RetiredAmount: "0", CancelledAmount: ""}},
+		{projectKey2, "C01-002-20200101-20210101-001", &baseapi.BatchSupply{TradableAmount: "7", RetiredAmount: "3", CancelledAmount: "0"}},
+	}
+	holder := sdk.AccAddress("holder")
+	for _, b := range batches {
+		batchKey, err := baseStore.BatchTable().InsertReturningID(sdkCtx, &baseapi.Batch{
+			ProjectKey: b.projectKey,
+			Denom:      b.denom,
+		})
+		require.NoError(t, err)
+
+		b.supply.BatchKey = batchKey
+		require.NoError(t, baseStore.BatchSupplyTable().Insert(sdkCtx, b.supply))
+
+		require.NoError(t, baseStore.BatchBalanceTable().Insert(sdkCtx, &baseapi.BatchBalance{
+			BatchKey:       batchKey,
+			Address:        holder,
+			TradableAmount: b.supply.TradableAmount,
+			RetiredAmount:  b.supply.RetiredAmount,
+		}))
+	}
+
+	// credit batches bridged from contracts on polygon, issued by a bridge
+	// service that must not be allowed by the migration
+	bridgeService := sdk.AccAddress("bridge_service")
+	for i, contract := range []string{
+		"0x06F4A1E7B1A1B2F2D3C4B5A6978695A4B3C2D1E0",
+		"0x0E65079A29D7793AB5CA500C2D88E60EE99BA606",
+	} {
+		bridgedBatchKey, err := baseStore.BatchTable().InsertReturningID(sdkCtx, &baseapi.Batch{
+			ProjectKey: projectKey2,
+			Denom:      fmt.Sprintf("C01-002-20200101-20210101-%03d", i+2),
+			Issuer:     bridgeService,
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, baseStore.BatchContractTable().Insert(sdkCtx, &baseapi.BatchContract{
+			BatchKey: bridgedBatchKey,
+			ClassKey: classKey,
+			Contract: contract,
+		}))
+	}
+
+	// a credit class with a native and a bridged credit batch and a credit
+	// class with a single credit batch
+	classKey2, err := baseStore.ClassTable().InsertReturningID(sdkCtx, &baseapi.Class{Id: "C02", CreditTypeAbbrev: "C"})
+	require.NoError(t, err)
+	projectKey3, err := baseStore.ProjectTable().InsertReturningID(sdkCtx, &baseapi.Project{Id: "C02-001", ClassKey: classKey2, Jurisdiction: "DE"})
+	require.NoError(t, err)
+	_, err = baseStore.BatchTable().InsertReturningID(sdkCtx, &baseapi.Batch{ProjectKey: projectKey3, Denom: "C02-001-20200101-20210101-001"})
+	require.NoError(t, err)
+	bridgedBatchKey, err := baseStore.BatchTable().InsertReturningID(sdkCtx, &baseapi.Batch{ProjectKey: projectKey3, Denom: "C02-001-20200101-20210101-002", Issuer: bridgeService})
+	require.NoError(t, err)
+	require.NoError(t, baseStore.BatchContractTable().Insert(sdkCtx, &baseapi.BatchContract{
+		BatchKey: bridgedBatchKey,
+		ClassKey: classKey2,
+		Contract: "0x06F4A1E7B1A1B2F2D3C4B5A6978695A4B3C2D1E0",
+	}))
+
+	classKey3, err := baseStore.ClassTable().InsertReturningID(sdkCtx, &baseapi.Class{Id: "C03", CreditTypeAbbrev: "C"})
+	require.NoError(t, err)
+	projectKey4, err := baseStore.ProjectTable().InsertReturningID(sdkCtx, &baseapi.Project{Id: "C03-001", ClassKey: classKey3, Jurisdiction: "FR"})
+	require.NoError(t, err)
+	singleBatchKey, err := baseStore.BatchTable().InsertReturningID(sdkCtx, &baseapi.Batch{ProjectKey: projectKey4, Denom: "C03-001-20200101-20210101-001"})
+	require.NoError(t, err)
+
+	// origin tx index entries created before the batch key was recorded
+	originTxs := []*baseapi.OriginTxIndex{
+		{ClassKey: classKey, Id: "0x1", Source: "polygon"},
+		{ClassKey: classKey2, Id: "0x2", Source: "polygon"},
+		{ClassKey: classKey2, Id: "0x3", Source: "verra"},
+		{ClassKey: classKey3, Id: "0x4", Source: "verra"},
+	}
+	for _, originTx := range originTxs {
+		require.NoError(t, baseStore.OriginTxIndexTable().Insert(sdkCtx, originTx))
+	}
+
+	require.NoError(t, v4.MigrateState(sdkCtx, ecocreditKey, baseStore))
+
+	// verify origin tx index entries are linked to a credit batch only when the
+	// credit batch can be determined
+	for _, tc := range []struct {
+		classKey uint64
+		id       string
+		source   string
+		batchKey uint64
+		contract string
+	}{
+		{classKey, "0x1", "polygon", 0, ""},
+		{classKey2, "0x2", "polygon", bridgedBatchKey, "0x06F4A1E7B1A1B2F2D3C4B5A6978695A4B3C2D1E0"},
+		{classKey2, "0x3", "verra", 0, ""},
+		{classKey3, "0x4", "verra", singleBatchKey, ""},
+	} {
+		index, err := baseStore.OriginTxIndexTable().Get(sdkCtx, tc.classKey, tc.id, tc.source)
+		require.NoError(t, err)
+		require.Equal(t, tc.batchKey, index.BatchKey, tc.id)
+		require.Equal(t, tc.contract, index.Contract, tc.id)
+	}
+
+	projectSupply, err := baseStore.ProjectSupplyTable().Get(sdkCtx, projectKey1)
+	require.NoError(t, err)
+	require.Equal(t, "12.5", projectSupply.TradableAmount)
+	require.Equal(t, "5", projectSupply.RetiredAmount)
+	require.Equal(t, "1", projectSupply.CancelledAmount)
+
+	projectSupply, err = baseStore.ProjectSupplyTable().Get(sdkCtx, projectKey2)
+	require.NoError(t, err)
+	require.Equal(t, "7", projectSupply.TradableAmount)
+	require.Equal(t, "3", projectSupply.RetiredAmount)
+	require.Equal(t, "0", projectSupply.CancelledAmount)
+
+	classSupply, err := baseStore.ClassSupplyTable().Get(sdkCtx, classKey)
+	require.NoError(t, err)
+	require.Equal(t, "19.5", classSupply.TradableAmount)
+	require.Equal(t, "8", classSupply.RetiredAmount)
+	require.Equal(t, "1", classSupply.CancelledAmount)
+
+	creditTypeSupply, err := baseStore.CreditTypeSupplyTable().Get(sdkCtx, "C")
+	require.NoError(t, err)
+	require.Equal(t, "19.5", creditTypeSupply.TradableAmount)
+	require.Equal(t, "8", creditTypeSupply.RetiredAmount)
+	require.Equal(t, "1", creditTypeSupply.CancelledAmount)
+
+	// verify batch balances are indexed by credit class and project
+	itr, err := baseStore.BatchBalanceIndexTable().List(
+		sdkCtx,
+		baseapi.BatchBalanceIndexAddressProjectKeyBatchKeyIndexKey{}.WithAddressProjectKey(holder, projectKey1),
+	)
+	require.NoError(t, err)
+	var batchKeys []uint64
+	for itr.Next() {
+		index, err := itr.Value()
+		require.NoError(t, err)
+		require.Equal(t, classKey, index.ClassKey)
+		batchKeys = append(batchKeys, index.BatchKey)
+	}
+	itr.Close()
+	require.Equal(t, []uint64{1, 2}, batchKeys)
+
+	found, err := baseStore.ProjectHolderTable().Has(sdkCtx, projectKey1, holder)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	found, err = baseStore.ProjectHolderTable().Has(sdkCtx, projectKey2, holder)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	// verify only the contracts of the bridged credit batches are allowed on
+	// polygon and no bridge services are allowed
+	cItr, err := baseStore.AllowedBridgeContractTable().List(sdkCtx, baseapi.AllowedBridgeContractPrimaryKey{})
+	require.NoError(t, err)
+	var contracts []*baseapi.AllowedBridgeContract
+	for cItr.Next() {
+		contract, err := cItr.Value()
+		require.NoError(t, err)
+		contracts = append(contracts, contract)
+	}
+	cItr.Close()
+	require.Len(t, contracts, 2)
+	require.Equal(t, "polygon", contracts[0].ChainName)
+	require.Equal(t, "0x06f4a1e7b1a1b2f2d3c4b5a6978695a4b3c2d1e0", contracts[0].Contract)
+	require.Equal(t, "polygon", contracts[1].ChainName)
+	require.Equal(t, "0x0e65079a29d7793ab5ca500c2d88e60ee99ba606", contracts[1].Contract)
+
+	sItr, err := baseStore.AllowedBridgeServiceTable().List(sdkCtx, baseapi.AllowedBridgeServicePrimaryKey{})
+	require.NoError(t, err)
+	require.False(t, sItr.Next())
+	sItr.Close()
+
+	// verify projects are indexed by jurisdiction
+	for jurisdiction, projectKey := range map[string]uint64{"US-WA": projectKey1, "KE": projectKey2} {
+		pItr, err := baseStore.ProjectTable().List(sdkCtx, baseapi.ProjectJurisdictionIndexKey{}.WithJurisdiction(jurisdiction))
+		require.NoError(t, err)
+		require.True(t, pItr.Next())
+		project, err := pItr.Value()
+		require.NoError(t, err)
+		require.Equal(t, projectKey, project.Key)
+		require.Equal(t, jurisdiction, project.Jurisdiction)
+		require.False(t, pItr.Next())
+		pItr.Close()
+	}
+}
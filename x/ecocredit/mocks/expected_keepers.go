@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -13,6 +14,7 @@ import (
 	types0 "github.com/cosmos/cosmos-sdk/x/auth/types"
 	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
 	v1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	nft "github.com/cosmos/cosmos-sdk/x/nft"
 )
 
 // MockAccountKeeper is a mock of AccountKeeper interface.
@@ -275,3 +277,149 @@ func (mr *MockGovKeeperMockRecorder) GetDepositParams(ctx interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDepositParams", reflect.TypeOf((*MockGovKeeper)(nil).GetDepositParams), ctx)
 }
+
+// MockNFTKeeper is a mock of NFTKeeper interface.
+type MockNFTKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockNFTKeeperMockRecorder
+}
+
+// MockNFTKeeperMockRecorder is the mock recorder for MockNFTKeeper.
+type MockNFTKeeperMockRecorder struct {
+	mock *MockNFTKeeper
+}
+
+// NewMockNFTKeeper creates a new mock instance.
+func NewMockNFTKeeper(ctrl *gomock.Controller) *MockNFTKeeper {
+	mock := &MockNFTKeeper{ctrl: ctrl}
+	mock.recorder = &MockNFTKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNFTKeeper) EXPECT() *MockNFTKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetOwner mocks base method.
+func (m *MockNFTKeeper) GetOwner(ctx types.Context, classID, nftID string) types.AccAddress {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwner", ctx, classID, nftID)
+	ret0, _ := ret[0].(types.AccAddress)
+	return ret0
+}
+
+// GetOwner indicates an expected call of GetOwner.
+func (mr *MockNFTKeeperMockRecorder) GetOwner(ctx, classID, nftID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwner", reflect.TypeOf((*MockNFTKeeper)(nil).GetOwner), ctx, classID, nftID)
+}
+
+// HasClass mocks base method.
+func (m *MockNFTKeeper) HasClass(ctx types.Context, classID string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasClass", ctx, classID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasClass indicates an expected call of HasClass.
+func (mr *MockNFTKeeperMockRecorder) HasClass(ctx, classID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasClass", reflect.TypeOf((*MockNFTKeeper)(nil).HasClass), ctx, classID)
+}
+
+// HasNFT mocks base method.
+func (m *MockNFTKeeper) HasNFT(ctx types.Context, classID, id string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasNFT", ctx, classID, id)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// HasNFT indicates an expected call of HasNFT.
+func (mr *MockNFTKeeperMockRecorder) HasNFT(ctx, classID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasNFT", reflect.TypeOf((*MockNFTKeeper)(nil).HasNFT), ctx, classID, id)
+}
+
+// Mint mocks base method.
+func (m *MockNFTKeeper) Mint(ctx types.Context, token nft.NFT, receiver types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Mint", ctx, token, receiver)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Mint indicates an expected call of Mint.
+func (mr *MockNFTKeeperMockRecorder) Mint(ctx, token, receiver interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mint", reflect.TypeOf((*MockNFTKeeper)(nil).Mint), ctx, token, receiver)
+}
+
+// NFTs mocks base method.
+func (m *MockNFTKeeper) NFTs(ctx context.Context, req *nft.QueryNFTsRequest) (*nft.QueryNFTsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NFTs", ctx, req)
+	ret0, _ := ret[0].(*nft.QueryNFTsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NFTs indicates an expected call of NFTs.
+func (mr *MockNFTKeeperMockRecorder) NFTs(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NFTs", reflect.TypeOf((*MockNFTKeeper)(nil).NFTs), ctx, req)
+}
+
+// SaveClass mocks base method.
+func (m *MockNFTKeeper) SaveClass(ctx types.Context, class nft.Class) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveClass", ctx, class)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveClass indicates an expected call of SaveClass.
+func (mr *MockNFTKeeperMockRecorder) SaveClass(ctx, class interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveClass", reflect.TypeOf((*MockNFTKeeper)(nil).SaveClass), ctx, class)
+}
+
+// MockDataKeeper is a mock of DataKeeper interface.
+type MockDataKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataKeeperMockRecorder
+}
+
+// MockDataKeeperMockRecorder is the mock recorder for MockDataKeeper.
+type MockDataKeeperMockRecorder struct {
+	mock *MockDataKeeper
+}
+
+// NewMockDataKeeper creates a new mock instance.
+func NewMockDataKeeper(ctrl *gomock.Controller) *MockDataKeeper {
+	mock := &MockDataKeeper{ctrl: ctrl}
+	mock.recorder = &MockDataKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataKeeper) EXPECT() *MockDataKeeperMockRecorder {
+	return m.recorder
+}
+
+// HasAnchor mocks base method.
+func (m *MockDataKeeper) HasAnchor(ctx context.Context, iri string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasAnchor", ctx, iri)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasAnchor indicates an expected call of HasAnchor.
+func (mr *MockDataKeeperMockRecorder) HasAnchor(ctx, iri interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasAnchor", reflect.TypeOf((*MockDataKeeper)(nil).HasAnchor), ctx, iri)
+}
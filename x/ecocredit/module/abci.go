@@ -10,7 +10,9 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit/server"
 )
 
-// BeginBlocker checks if there are any expired sell orders and removes them from state.
+// BeginBlocker checks if there are any expired sell orders and removes them from state,
+// unlocks any locked credits that have unlocked according to their lock schedule, and
+// removes any pending batches that have expired.
 func BeginBlocker(ctx sdk.Context, k server.Keeper) error {
 	defer telemetry.ModuleMeasureSince(ecocredit.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
@@ -18,5 +20,13 @@ func BeginBlocker(ctx sdk.Context, k server.Keeper) error {
 		return err
 	}
 
+	if err := k.UnlockCredits(ctx); err != nil {
+		return err
+	}
+
+	if err := k.ExpirePendingBatches(ctx); err != nil {
+		return err
+	}
+
 	return nil
 }
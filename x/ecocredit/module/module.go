@@ -42,7 +42,7 @@ var (
 )
 
 const (
-	ConsensusVersion = 3 // ConsensusVersion is the module consensus version
+	ConsensusVersion = 4 // ConsensusVersion is the module consensus version
 )
 
 // Module implements the AppModule interface.
@@ -52,6 +52,8 @@ type Module struct {
 	Keeper        server.Keeper
 	accountKeeper ecocredit.AccountKeeper
 	bankKeeper    ecocredit.BankKeeper
+	nftKeeper     ecocredit.NFTKeeper
+	dataKeeper    ecocredit.DataKeeper
 	govKeeper     ecocredit.GovKeeper
 
 	// legacySubspace is used solely for migration of x/ecocredit managed parameters
@@ -64,6 +66,8 @@ func NewModule(
 	authority sdk.AccAddress,
 	accountKeeper ecocredit.AccountKeeper,
 	bankKeeper ecocredit.BankKeeper,
+	nftKeeper ecocredit.NFTKeeper,
+	dataKeeper ecocredit.DataKeeper,
 	legacySubspace paramtypes.Subspace,
 	govKeeper ecocredit.GovKeeper,
 ) *Module {
@@ -78,6 +82,8 @@ func NewModule(
 		legacySubspace: legacySubspace,
 		bankKeeper:     bankKeeper,
 		accountKeeper:  accountKeeper,
+		nftKeeper:      nftKeeper,
+		dataKeeper:     dataKeeper,
 		authority:      authority,
 		govKeeper:      govKeeper,
 	}
@@ -120,20 +126,27 @@ func (m Module) RegisterInterfaces(registry types.InterfaceRegistry) {
 
 // RegisterServices implements AppModule/RegisterServices.
 func (m *Module) RegisterServices(cfg module.Configurator) {
-	svr := server.NewServer(m.key, m.accountKeeper, m.bankKeeper, m.authority)
+	svr := server.NewServer(m.key, m.accountKeeper, m.bankKeeper, m.nftKeeper, m.dataKeeper, m.authority)
 	basetypes.RegisterMsgServer(cfg.MsgServer(), svr.BaseKeeper)
 	basetypes.RegisterQueryServer(cfg.QueryServer(), svr.BaseKeeper)
 
+	// credit class limits of authorizations apply to the current credit class
+	// of the credit batch rather than the credit class in the batch denom
+	basetypes.SetBatchClassFunc(svr.BaseKeeper.BatchClassID)
+
 	baskettypes.RegisterMsgServer(cfg.MsgServer(), svr.BasketKeeper)
 	baskettypes.RegisterQueryServer(cfg.QueryServer(), svr.BasketKeeper)
 
 	markettypes.RegisterMsgServer(cfg.MsgServer(), svr.MarketplaceKeeper)
 	markettypes.RegisterQueryServer(cfg.QueryServer(), svr.MarketplaceKeeper)
 
-	migrator := server.NewMigrator(svr, m.legacySubspace)
+	migrator := server.NewMigrator(svr, m.key, m.legacySubspace)
 	if err := cfg.RegisterMigration(ecocredit.ModuleName, 2, migrator.Migrate2to3); err != nil {
 		panic(err)
 	}
+	if err := cfg.RegisterMigration(ecocredit.ModuleName, 3, migrator.Migrate3to4); err != nil {
+		panic(err)
+	}
 
 	m.Keeper = svr
 }
@@ -255,7 +268,8 @@ func (m Module) GetQueryCmd() *cobra.Command {
 	return client.QueryCmd(m.Name())
 }
 
-// BeginBlock checks if there are any expired sell or buy orders and removes them from state.
+// BeginBlock checks if there are any expired sell or buy orders and removes them from state
+// and unlocks any locked credits that have unlocked according to their lock schedule.
 func (m Module) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	err := BeginBlocker(ctx, m.Keeper)
 	if err != nil {
@@ -8,3 +8,15 @@ import (
 func (s serverImpl) PruneOrders(ctx sdk.Context) error {
 	return s.MarketplaceKeeper.PruneSellOrders(sdk.WrapSDKContext(ctx))
 }
+
+// UnlockCredits moves credits that have unlocked according to their lock schedule
+// into the tradable balance of the owner.
+func (s serverImpl) UnlockCredits(ctx sdk.Context) error {
+	return s.BaseKeeper.UnlockCredits(sdk.WrapSDKContext(ctx))
+}
+
+// ExpirePendingBatches removes pending batches that have not been approved or
+// rejected before their expiration.
+func (s serverImpl) ExpirePendingBatches(ctx sdk.Context) error {
+	return s.BaseKeeper.ExpirePendingBatches(sdk.WrapSDKContext(ctx))
+}
@@ -10,6 +10,9 @@ import (
 // RegisterInvariants registers the ecocredit module invariants.
 func (s serverImpl) RegisterInvariants(ir sdk.InvariantRegistry) {
 	ir.RegisterRoute(ecocredit.ModuleName, "batch-supply", s.batchSupplyInvariant())
+	ir.RegisterRoute(ecocredit.ModuleName, "certificate-supply", s.certificateSupplyInvariant())
+	ir.RegisterRoute(ecocredit.ModuleName, "credit-locks", s.creditLockInvariant())
+	ir.RegisterRoute(ecocredit.ModuleName, "aggregate-supply", s.aggregateSupplyInvariant())
 	s.BasketKeeper.RegisterInvariants(ir)
 }
 
@@ -25,3 +28,27 @@ func (s serverImpl) batchSupplyInvariant() sdk.Invariant {
 		return sdk.FormatInvariant(ecocredit.ModuleName, "batch-supply", msg), broken
 	}
 }
+
+func (s serverImpl) certificateSupplyInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		goCtx := sdk.WrapSDKContext(ctx)
+		msg, broken := basekeeper.CertificateSupplyInvariant(goCtx, s.BaseKeeper)
+		return sdk.FormatInvariant(ecocredit.ModuleName, "certificate-supply", msg), broken
+	}
+}
+
+func (s serverImpl) creditLockInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		goCtx := sdk.WrapSDKContext(ctx)
+		msg, broken := basekeeper.CreditLockInvariant(goCtx, s.BaseKeeper)
+		return sdk.FormatInvariant(ecocredit.ModuleName, "credit-locks", msg), broken
+	}
+}
+
+func (s serverImpl) aggregateSupplyInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		goCtx := sdk.WrapSDKContext(ctx)
+		msg, broken := basekeeper.AggregateSupplyInvariant(goCtx, s.BaseKeeper)
+		return sdk.FormatInvariant(ecocredit.ModuleName, "aggregate-supply", msg), broken
+	}
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
 	"github.com/cosmos/cosmos-sdk/orm/model/ormtable"
 	"github.com/cosmos/cosmos-sdk/orm/testing/ormtest"
@@ -19,6 +21,9 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
 
 	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
 	marketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
@@ -38,6 +43,7 @@ type baseSuite struct {
 	k          basekeeper.Keeper
 	ctrl       *gomock.Controller
 	bankKeeper *mocks.MockBankKeeper
+	nftKeeper  nftkeeper.Keeper
 	storeKey   *storetypes.KVStoreKey
 	sdkCtx     sdk.Context
 }
@@ -55,6 +61,8 @@ func setupBase(t *testing.T) *baseSuite {
 	cms := store.NewCommitMultiStore(db)
 	s.storeKey = sdk.NewKVStoreKey("test")
 	cms.MountStoreWithDB(s.storeKey, storetypes.StoreTypeIAVL, db)
+	nftKey := sdk.NewKVStoreKey(nftkeeper.StoreKey)
+	cms.MountStoreWithDB(nftKey, storetypes.StoreTypeIAVL, db)
 	assert.NilError(t, cms.LoadLatestVersion())
 	ormCtx := ormtable.WrapContextDefault(ormtest.NewMemoryBackend())
 	s.sdkCtx = sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger()).WithContext(ormCtx)
@@ -64,6 +72,12 @@ func setupBase(t *testing.T) *baseSuite {
 	s.ctrl = gomock.NewController(t)
 	assert.NilError(t, err)
 	s.bankKeeper = mocks.NewMockBankKeeper(s.ctrl)
+
+	accountKeeper := mocks.NewMockAccountKeeper(s.ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(nft.ModuleName).Return(authtypes.NewModuleAddress(nft.ModuleName))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	s.nftKeeper = nftkeeper.NewKeeper(nftKey, cdc, accountKeeper, s.bankKeeper)
+
 	_, _, moduleAddress := testdata.KeyTestPubAddr()
 	_, _, authorityAddress := testdata.KeyTestPubAddr()
 
@@ -73,7 +87,7 @@ func setupBase(t *testing.T) *baseSuite {
 	marketStore, err := marketapi.NewStateStore(s.db)
 	assert.NilError(t, err)
 
-	s.k = basekeeper.NewKeeper(s.stateStore, s.bankKeeper, moduleAddress, basketStore, marketStore, authorityAddress)
+	s.k = basekeeper.NewKeeper(s.stateStore, s.bankKeeper, s.nftKeeper, nil, moduleAddress, basketStore, marketStore, authorityAddress)
 
 	return s
 }
@@ -271,6 +285,64 @@ func TestBatchSupplyInvariant(t *testing.T) {
 			map[uint64]math.Dec{},
 			false,
 		},
+		{
+			"valid case locked balance",
+			[]*basetypes.BatchBalance{
+				{
+					Address:        acc1,
+					BatchKey:       1,
+					TradableAmount: "100",
+					EscrowedAmount: "10",
+					RetiredAmount:  "1",
+				},
+				{
+					BatchKey:       1,
+					Address:        acc2,
+					TradableAmount: "0",
+					RetiredAmount:  "0",
+					EscrowedAmount: "0",
+					LockedAmount:   "50.5",
+				},
+			},
+			[]*basetypes.BatchSupply{
+				{
+					BatchKey:       1,
+					TradableAmount: "160.5",
+					RetiredAmount:  "1",
+				},
+			},
+			map[uint64]math.Dec{},
+			false,
+		},
+		{
+			"fail with locked balance not in tradable supply",
+			[]*basetypes.BatchBalance{
+				{
+					Address:        acc1,
+					BatchKey:       1,
+					TradableAmount: "100",
+					EscrowedAmount: "10",
+					RetiredAmount:  "1",
+				},
+				{
+					BatchKey:       1,
+					Address:        acc2,
+					TradableAmount: "0",
+					RetiredAmount:  "0",
+					EscrowedAmount: "0",
+					LockedAmount:   "50.5",
+				},
+			},
+			[]*basetypes.BatchSupply{
+				{
+					BatchKey:       1,
+					TradableAmount: "110",
+					RetiredAmount:  "1",
+				},
+			},
+			map[uint64]math.Dec{},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -301,6 +373,7 @@ func initBalances(ctx context.Context, t *testing.T, ss baseapi.StateStore, bala
 			TradableAmount: b.TradableAmount,
 			RetiredAmount:  b.RetiredAmount,
 			EscrowedAmount: b.EscrowedAmount,
+			LockedAmount:   b.LockedAmount,
 		}))
 	}
 }
@@ -316,3 +389,293 @@ func initSupply(ctx context.Context, t *testing.T, ss baseapi.StateStore, supply
 		require.NoError(t, err)
 	}
 }
+
+func TestCreditLockInvariant(t *testing.T) {
+	acc1 := sdk.AccAddress([]byte("account1"))
+	acc2 := sdk.AccAddress([]byte("account2"))
+
+	testCases := []struct {
+		msg       string
+		balances  []*basetypes.BatchBalance
+		locks     []*baseapi.CreditLock
+		expBroken bool
+	}{
+		{
+			"valid test case",
+			[]*basetypes.BatchBalance{
+				{Address: acc1, BatchKey: 1, TradableAmount: "10", RetiredAmount: "0", EscrowedAmount: "0", LockedAmount: "90"},
+				{Address: acc2, BatchKey: 1, TradableAmount: "10", RetiredAmount: "0", EscrowedAmount: "0"},
+			},
+			[]*baseapi.CreditLock{
+				{Owner: acc1, BatchKey: 1, Amount: "50", UnlockedAmount: "10"},
+				{Owner: acc1, BatchKey: 1, Amount: "50", UnlockedAmount: "0"},
+			},
+			false,
+		},
+		{
+			"fail with locked balance not matching locks",
+			[]*basetypes.BatchBalance{
+				{Address: acc1, BatchKey: 1, TradableAmount: "10", RetiredAmount: "0", EscrowedAmount: "0", LockedAmount: "100"},
+			},
+			[]*baseapi.CreditLock{
+				{Owner: acc1, BatchKey: 1, Amount: "50", UnlockedAmount: "10"},
+			},
+			true,
+		},
+		{
+			"fail with locked balance without locks",
+			[]*basetypes.BatchBalance{
+				{Address: acc1, BatchKey: 1, TradableAmount: "10", RetiredAmount: "0", EscrowedAmount: "0", LockedAmount: "1"},
+			},
+			[]*baseapi.CreditLock{},
+			true,
+		},
+		{
+			"fail with locks without locked balance",
+			[]*basetypes.BatchBalance{
+				{Address: acc2, BatchKey: 1, TradableAmount: "10", RetiredAmount: "0", EscrowedAmount: "0"},
+			},
+			[]*baseapi.CreditLock{
+				{Owner: acc2, BatchKey: 1, Amount: "50", UnlockedAmount: "0"},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite := setupBase(t)
+		t.Run(tc.msg, func(t *testing.T) {
+			initBalances(suite.ctx, t, suite.stateStore, tc.balances)
+
+			for _, lock := range tc.locks {
+				lock.LockType = baseapi.LockType_LOCK_TYPE_CLIFF
+				_, err := suite.stateStore.CreditLockTable().InsertReturningID(suite.ctx, lock)
+				require.NoError(t, err)
+			}
+
+			msg, broken := basekeeper.CreditLockInvariant(suite.ctx, suite.k)
+			if tc.expBroken {
+				require.True(t, broken, msg)
+			} else {
+				require.False(t, broken, msg)
+			}
+		})
+	}
+}
+
+func TestAggregateSupplyInvariant(t *testing.T) {
+	testCases := []struct {
+		msg              string
+		supply           []*basetypes.BatchSupply
+		projectSupply    []*baseapi.ProjectSupply
+		classSupply      []*baseapi.ClassSupply
+		creditTypeSupply []*baseapi.CreditTypeSupply
+		expBroken        bool
+	}{
+		{
+			"valid test case",
+			[]*basetypes.BatchSupply{
+				{BatchKey: 1, TradableAmount: "10", RetiredAmount: "5", CancelledAmount: "1"},
+				{BatchKey: 2, TradableAmount: "20", RetiredAmount: "0", CancelledAmount: "0"},
+				{BatchKey: 3, TradableAmount: "7.5", RetiredAmount: "2.5", CancelledAmount: "0"},
+			},
+			[]*baseapi.ProjectSupply{
+				{ProjectKey: 1, TradableAmount: "30", RetiredAmount: "5", CancelledAmount: "1"},
+				{ProjectKey: 2, TradableAmount: "7.5", RetiredAmount: "2.5", CancelledAmount: "0"},
+			},
+			[]*baseapi.ClassSupply{
+				{ClassKey: 1, TradableAmount: "30", RetiredAmount: "5", CancelledAmount: "1"},
+				{ClassKey: 2, TradableAmount: "7.5", RetiredAmount: "2.5", CancelledAmount: "0"},
+			},
+			[]*baseapi.CreditTypeSupply{
+				{CreditTypeAbbrev: "C", TradableAmount: "30", RetiredAmount: "5", CancelledAmount: "1"},
+				{CreditTypeAbbrev: "BIO", TradableAmount: "7.5", RetiredAmount: "2.5", CancelledAmount: "0"},
+			},
+			false,
+		},
+		{
+			"fail with incorrect project supply",
+			[]*basetypes.BatchSupply{
+				{BatchKey: 1, TradableAmount: "10", RetiredAmount: "5", CancelledAmount: "1"},
+			},
+			[]*baseapi.ProjectSupply{
+				{ProjectKey: 1, TradableAmount: "11", RetiredAmount: "5", CancelledAmount: "1"},
+			},
+			[]*baseapi.ClassSupply{
+				{ClassKey: 1, TradableAmount: "10", RetiredAmount: "5", CancelledAmount: "1"},
+			},
+			[]*baseapi.CreditTypeSupply{
+				{CreditTypeAbbrev: "C", TradableAmount: "10", RetiredAmount: "5", CancelledAmount: "1"},
+			},
+			true,
+		},
+		{
+			"fail with incorrect credit class supply",
+			[]*basetypes.BatchSupply{
+				{BatchKey: 1, TradableAmount: "10", RetiredAmount: "5", CancelledAmount: "1"},
+			},
+			[]*baseapi.ProjectSupply{
+				{ProjectKey: 1, TradableAmount: "10", RetiredAmount: "5", CancelledAmount: "1"},
+			},
+			[]*baseapi.ClassSupply{
+				{ClassKey: 1, TradableAmount: "10", RetiredAmount: "4", CancelledAmount: "1"},
+			},
+			[]*baseapi.CreditTypeSupply{
+				{CreditTypeAbbrev: "C", TradableAmount: "10", RetiredAmount: "5", CancelledAmount: "1"},
+			},
+			true,
+		},
+		{
+			"fail with incorrect credit type supply",
+			[]*basetypes.BatchSupply{
+				{BatchKey: 1, TradableAmount: "10", RetiredAmount: "5", CancelledAmount: "1"},
+			},
+			[]*baseapi.ProjectSupply{
+				{ProjectKey: 1, TradableAmount: "10", RetiredAmount: "5", CancelledAmount: "1"},
+			},
+			[]*baseapi.ClassSupply{
+				{ClassKey: 1, TradableAmount: "10", RetiredAmount: "5", CancelledAmount: "1"},
+			},
+			[]*baseapi.CreditTypeSupply{
+				{CreditTypeAbbrev: "C", TradableAmount: "10", RetiredAmount: "5", CancelledAmount: "0"},
+			},
+			true,
+		},
+		{
+			"fail with missing aggregated supply",
+			[]*basetypes.BatchSupply{
+				{BatchKey: 1, TradableAmount: "10", RetiredAmount: "5", CancelledAmount: "1"},
+			},
+			[]*baseapi.ProjectSupply{},
+			[]*baseapi.ClassSupply{},
+			[]*baseapi.CreditTypeSupply{},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite := setupBase(t)
+		t.Run(tc.msg, func(t *testing.T) {
+			ctx := suite.ctx
+			ss := suite.stateStore
+
+			// classes C01 (carbon) and BIO01 (biodiversity), projects within each
+			// class, batches 1 and 2 within project 1 and batch 3 within project 2
+			require.NoError(t, ss.ClassTable().Insert(ctx, &baseapi.Class{Id: "C01", CreditTypeAbbrev: "C"}))
+			require.NoError(t, ss.ClassTable().Insert(ctx, &baseapi.Class{Id: "BIO01", CreditTypeAbbrev: "BIO"}))
+			require.NoError(t, ss.ProjectTable().Insert(ctx, &baseapi.Project{Id: "C01-001", ClassKey: 1}))
+			require.NoError(t, ss.ProjectTable().Insert(ctx, &baseapi.Project{Id: "BIO01-001", ClassKey: 2}))
+			require.NoError(t, ss.BatchTable().Insert(ctx, &baseapi.Batch{ProjectKey: 1, Denom: "C01-001-20200101-20210101-001"}))
+			require.NoError(t, ss.BatchTable().Insert(ctx, &baseapi.Batch{ProjectKey: 1, Denom: "C01-001-20200101-20210101-002"}))
+			require.NoError(t, ss.BatchTable().Insert(ctx, &baseapi.Batch{ProjectKey: 2, Denom: "BIO01-001-20200101-20210101-001"}))
+
+			initSupply(ctx, t, ss, tc.supply)
+
+			for _, supply := range tc.projectSupply {
+				require.NoError(t, ss.ProjectSupplyTable().Insert(ctx, supply))
+			}
+			for _, supply := range tc.classSupply {
+				require.NoError(t, ss.ClassSupplyTable().Insert(ctx, supply))
+			}
+			for _, supply := range tc.creditTypeSupply {
+				require.NoError(t, ss.CreditTypeSupplyTable().Insert(ctx, supply))
+			}
+
+			msg, broken := basekeeper.AggregateSupplyInvariant(ctx, suite.k)
+			if tc.expBroken {
+				require.True(t, broken, msg)
+			} else {
+				require.False(t, broken, msg)
+			}
+		})
+	}
+}
+
+func TestCertificateSupplyInvariant(t *testing.T) {
+	acc1 := sdk.AccAddress([]byte("account1"))
+	batchDenom := "C01-001-20200101-20210101-001"
+
+	testCases := []struct {
+		msg         string
+		retirements []string
+		retired     string
+		missingNFT  bool
+		expBroken   bool
+	}{
+		{
+			"valid test case",
+			[]string{"40", "60"},
+			"100",
+			false,
+			false,
+		},
+		{
+			"valid test case certified less than retired",
+			[]string{"40"},
+			"100",
+			false,
+			false,
+		},
+		{
+			"fail with certified amount exceeding retired supply",
+			[]string{"40", "60.5"},
+			"100",
+			false,
+			true,
+		},
+		{
+			"fail with missing nft",
+			[]string{"40"},
+			"100",
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite := setupBase(t)
+		t.Run(tc.msg, func(t *testing.T) {
+			require.NoError(t, suite.stateStore.ClassTable().Insert(suite.ctx, &baseapi.Class{Id: "C01", CreditTypeAbbrev: "C"}))
+			require.NoError(t, suite.stateStore.ProjectTable().Insert(suite.ctx, &baseapi.Project{Id: "C01-001", ClassKey: 1}))
+			require.NoError(t, suite.stateStore.BatchTable().Insert(suite.ctx, &baseapi.Batch{ProjectKey: 1, Denom: batchDenom}))
+
+			initSupply(suite.ctx, t, suite.stateStore, []*basetypes.BatchSupply{
+				{BatchKey: 1, TradableAmount: "0", RetiredAmount: tc.retired, CancelledAmount: "0"},
+			})
+
+			for _, amount := range tc.retirements {
+				_, err := basekeeper.RecordRetirement(suite.ctx, suite.stateStore, suite.nftKeeper, &baseapi.Retirement{
+					Owner:        acc1,
+					BatchKey:     1,
+					Amount:       amount,
+					Jurisdiction: "US-WA",
+				}, batchDenom)
+				require.NoError(t, err)
+			}
+
+			if tc.missingNFT {
+				id, err := suite.stateStore.RetirementTable().InsertReturningID(suite.ctx, &baseapi.Retirement{
+					Owner:    acc1,
+					BatchKey: 1,
+					Amount:   "1",
+				})
+				require.NoError(t, err)
+				require.NoError(t, suite.stateStore.RetirementCertificateTable().Insert(suite.ctx, &baseapi.RetirementCertificate{
+					RetirementId: id,
+					NftClassId:   "ecocredit:C01",
+					NftId:        "missing",
+				}))
+			}
+
+			msg, broken := basekeeper.CertificateSupplyInvariant(suite.ctx, suite.k)
+			if tc.expBroken {
+				require.True(t, broken, msg)
+			} else {
+				require.False(t, broken, msg)
+			}
+		})
+	}
+}
@@ -1,23 +1,27 @@
 package server
 
 import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	ecocreditv1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	v3 "github.com/regen-network/regen-ledger/x/ecocredit/migrations/v3"
+	v4 "github.com/regen-network/regen-ledger/x/ecocredit/migrations/v4"
 )
 
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper         Keeper
+	storeKey       storetypes.StoreKey
 	legacySubspace paramtypes.Subspace
 }
 
 // NewMigrator returns a new Migrator.
-func NewMigrator(keeper Keeper, legacySubspace paramtypes.Subspace) Migrator {
+func NewMigrator(keeper Keeper, storeKey storetypes.StoreKey, legacySubspace paramtypes.Subspace) Migrator {
 	return Migrator{
 		keeper:         keeper,
+		storeKey:       storeKey,
 		legacySubspace: legacySubspace,
 	}
 }
@@ -38,3 +42,9 @@ func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 
 	return nil
 }
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	baseStore, _, _ := m.keeper.GetStateStores()
+	return v4.MigrateState(ctx, m.storeKey, baseStore)
+}
@@ -12,6 +12,7 @@ import (
 	baseapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/ormstore"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
 	basekeeper "github.com/regen-network/regen-ledger/x/ecocredit/base/keeper"
 	basetypes "github.com/regen-network/regen-ledger/x/ecocredit/base/types/v1"
 	"github.com/regen-network/regen-ledger/x/ecocredit/basket"
@@ -24,6 +25,8 @@ import (
 type serverImpl struct {
 	bankKeeper    ecocredit.BankKeeper
 	accountKeeper ecocredit.AccountKeeper
+	nftKeeper     ecocredit.NFTKeeper
+	dataKeeper    ecocredit.DataKeeper
 
 	BaseKeeper        basekeeper.Keeper
 	BasketKeeper      basketkeeper.Keeper
@@ -37,10 +40,13 @@ type serverImpl struct {
 
 //nolint:revive
 func NewServer(storeKey storetypes.StoreKey,
-	accountKeeper ecocredit.AccountKeeper, bankKeeper ecocredit.BankKeeper, authority sdk.AccAddress) serverImpl {
+	accountKeeper ecocredit.AccountKeeper, bankKeeper ecocredit.BankKeeper, nftKeeper ecocredit.NFTKeeper,
+	dataKeeper ecocredit.DataKeeper, authority sdk.AccAddress) serverImpl {
 	s := serverImpl{
 		bankKeeper:    bankKeeper,
 		accountKeeper: accountKeeper,
+		nftKeeper:     nftKeeper,
+		dataKeeper:    dataKeeper,
 	}
 
 	// ensure ecocredit module account is set
@@ -55,6 +61,12 @@ func NewServer(storeKey storetypes.StoreKey,
 		panic(fmt.Sprintf("%s module account has not been set", basket.BasketSubModuleName))
 	}
 
+	// ensure wrap submodule account is set
+	wrapAddr := s.accountKeeper.GetModuleAddress(base.WrapSubModuleName)
+	if wrapAddr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", base.WrapSubModuleName))
+	}
+
 	var err error
 	s.db, err = ormstore.NewStoreKeyDB(&ecocredit.ModuleSchema, storeKey, ormdb.ModuleDBOptions{})
 	if err != nil {
@@ -65,9 +77,9 @@ func NewServer(storeKey storetypes.StoreKey,
 	s.stateStore = baseStore
 	s.basketStore = basketStore
 	s.marketplaceStore = marketStore
-	s.BaseKeeper = basekeeper.NewKeeper(baseStore, bankKeeper, baseAddr, basketStore, marketStore, authority)
-	s.BasketKeeper = basketkeeper.NewKeeper(basketStore, baseStore, bankKeeper, basketAddr, authority)
-	s.MarketplaceKeeper = marketkeeper.NewKeeper(marketStore, baseStore, bankKeeper, authority)
+	s.BaseKeeper = basekeeper.NewKeeper(baseStore, bankKeeper, nftKeeper, dataKeeper, baseAddr, basketStore, marketStore, authority)
+	s.BasketKeeper = basketkeeper.NewKeeper(basketStore, baseStore, bankKeeper, nftKeeper, basketAddr, authority)
+	s.MarketplaceKeeper = marketkeeper.NewKeeper(marketStore, baseStore, bankKeeper, nftKeeper, authority)
 
 	return s
 }
@@ -16,11 +16,14 @@ import (
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	params "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
 	"github.com/regen-network/regen-ledger/types/testutil/fixture"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
 	"github.com/regen-network/regen-ledger/x/ecocredit/basket"
 	"github.com/regen-network/regen-ledger/x/ecocredit/module"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/testsuite"
@@ -51,6 +54,7 @@ func setup(t *testing.T) (fixture.Factory, bankkeeper.BaseKeeper, authkeeper.Acc
 	bankKey := sdk.NewKVStoreKey(banktypes.StoreKey)
 	distKey := sdk.NewKVStoreKey(disttypes.StoreKey)
 	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	nftKey := sdk.NewKVStoreKey(nftkeeper.StoreKey)
 	ecoKey := sdk.NewKVStoreKey(ecocredit.ModuleName)
 	tkey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 
@@ -59,6 +63,7 @@ func setup(t *testing.T) (fixture.Factory, bankkeeper.BaseKeeper, authkeeper.Acc
 	baseApp.MountStore(bankKey, storetypes.StoreTypeIAVL)
 	baseApp.MountStore(distKey, storetypes.StoreTypeIAVL)
 	baseApp.MountStore(paramsKey, storetypes.StoreTypeIAVL)
+	baseApp.MountStore(nftKey, storetypes.StoreTypeIAVL)
 	baseApp.MountStore(tkey, storetypes.StoreTypeTransient)
 
 	authSubspace := paramstypes.NewSubspace(cdc, amino, paramsKey, tkey, authtypes.ModuleName)
@@ -69,6 +74,8 @@ func setup(t *testing.T) (fixture.Factory, bankkeeper.BaseKeeper, authkeeper.Acc
 		minttypes.ModuleName:       {authtypes.Minter},
 		ecocredit.ModuleName:       {authtypes.Burner},
 		basket.BasketSubModuleName: {authtypes.Burner, authtypes.Minter},
+		base.WrapSubModuleName:     {authtypes.Burner, authtypes.Minter},
+		nft.ModuleName:             nil,
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -79,8 +86,10 @@ func setup(t *testing.T) (fixture.Factory, bankkeeper.BaseKeeper, authkeeper.Acc
 		cdc, bankKey, accountKeeper, bankSubspace, nil,
 	)
 
+	nftKeeper := nftkeeper.NewKeeper(nftKey, cdc, accountKeeper, bankKeeper)
+
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
-	ecocreditModule := module.NewModule(ecoKey, authority, accountKeeper, bankKeeper, ecocreditSubspace, nil)
+	ecocreditModule := module.NewModule(ecoKey, authority, accountKeeper, bankKeeper, nftKeeper, nil, ecocreditSubspace, nil)
 	ff.SetModules([]sdkmodule.AppModule{ecocreditModule})
 
 	return ff, bankKeeper, accountKeeper
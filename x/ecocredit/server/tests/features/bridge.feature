@@ -32,6 +32,26 @@ Feature: Bridge Integration
         {
           "chain_name": "polygon"
         }
+      ],
+      "regen.ecocredit.v1.AllowedBridgeService": [
+        {
+          "chain_name": "polygon",
+          "address": "BTZfSbi0JKqguZ/tIAPUIhdAa7Y="
+        }
+      ],
+      "regen.ecocredit.v1.AllowedBridgeContract": [
+        {
+          "chain_name": "polygon",
+          "contract": "0x0000000000000000000000000000000000000001"
+        },
+        {
+          "chain_name": "polygon",
+          "contract": "0x0000000000000000000000000000000000000002"
+        },
+        {
+          "chain_name": "polygon",
+          "contract": "0x0000000000000000000000000000000000000003"
+        }
       ]
     }
     """
@@ -384,7 +404,9 @@ Feature: Bridge Integration
       "target": "polygon",
       "recipient": "0x1000000000000000000000000000000000000000",
       "contract": "0x0000000000000000000000000000000000000001",
-      "amount": "200"
+      "amount": "200",
+      "request_id": 1,
+      "batch_denom": "C01-001-20200101-20210101-001"
     }
     """
     And expect batch supply with batch denom "C01-001-20200101-20210101-001"
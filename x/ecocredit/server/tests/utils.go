@@ -11,11 +11,14 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	params "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 
 	"github.com/regen-network/regen-ledger/types/testutil/fixture"
 	ecocredittypes "github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/base"
 	"github.com/regen-network/regen-ledger/x/ecocredit/basket"
 	ecocredit "github.com/regen-network/regen-ledger/x/ecocredit/module"
 )
@@ -33,6 +36,7 @@ func NewEcocreditModule(ff fixture.Factory) *ecocredit.Module {
 	bankKey := sdk.NewKVStoreKey(banktypes.StoreKey)
 	distKey := sdk.NewKVStoreKey(disttypes.StoreKey)
 	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	nftKey := sdk.NewKVStoreKey(nftkeeper.StoreKey)
 	tkey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 
 	baseApp.MountStore(authKey, storetypes.StoreTypeIAVL)
@@ -40,6 +44,7 @@ func NewEcocreditModule(ff fixture.Factory) *ecocredit.Module {
 	baseApp.MountStore(bankKey, storetypes.StoreTypeIAVL)
 	baseApp.MountStore(distKey, storetypes.StoreTypeIAVL)
 	baseApp.MountStore(paramsKey, storetypes.StoreTypeIAVL)
+	baseApp.MountStore(nftKey, storetypes.StoreTypeIAVL)
 	baseApp.MountStore(tkey, storetypes.StoreTypeTransient)
 
 	authSubspace := paramstypes.NewSubspace(cdc, amino, paramsKey, tkey, authtypes.ModuleName)
@@ -50,6 +55,8 @@ func NewEcocreditModule(ff fixture.Factory) *ecocredit.Module {
 		minttypes.ModuleName:       {authtypes.Minter},
 		ecocredittypes.ModuleName:  {authtypes.Burner},
 		basket.BasketSubModuleName: {authtypes.Burner, authtypes.Minter},
+		base.WrapSubModuleName:     {authtypes.Burner, authtypes.Minter},
+		nft.ModuleName:             nil,
 	}
 
 	accountKeeper := authkeeper.NewAccountKeeper(
@@ -60,6 +67,8 @@ func NewEcocreditModule(ff fixture.Factory) *ecocredit.Module {
 		cdc, bankKey, accountKeeper, bankSubspace, nil,
 	)
 
+	nftKeeper := nftkeeper.NewKeeper(nftKey, cdc, accountKeeper, bankKeeper)
+
 	_, _, addr := testdata.KeyTestPubAddr()
-	return ecocredit.NewModule(ecocreditKey, addr, accountKeeper, bankKeeper, ecocreditSubspace, nil)
+	return ecocredit.NewModule(ecocreditKey, addr, accountKeeper, bankKeeper, nftKeeper, nil, ecocreditSubspace, nil)
 }
@@ -335,6 +335,73 @@ func (s *IntegrationTestSuite) TestBasketScenario() {
 	require.Equal(creditsToDeposit.String(), cbRes.Balance.RetiredAmount)
 }
 
+func (s *IntegrationTestSuite) TestWrapScenario() {
+	require := s.Require()
+	user := s.signers[0]
+	user2 := s.signers[1]
+
+	// create a class and issue a batch
+	_, batchDenom := s.createClassAndIssueBatch(user, user, "C", "100", "2020-01-01", "2022-01-01")
+
+	// wrap some credits
+	wRes, err := s.msgClient.Wrap(s.ctx, &basetypes.MsgWrap{
+		Owner:      user.String(),
+		BatchDenom: batchDenom,
+		Amount:     "10.5",
+	})
+	require.NoError(err)
+	require.Equal("10500000", wRes.Amount)
+	wrappedDenom := wRes.Denom
+
+	// the user holds the wrapped tokens and the credits are in escrow
+	require.Equal(int64(10500000), s.getUserBalance(user, wrappedDenom).Amount.Int64())
+	s.assertDecStrEqual("89.5", s.getUserBatchBalance(user, batchDenom).TradableAmount)
+
+	// the denom metadata uses the precision of the credit type
+	metadata, found := s.bankKeeper.GetDenomMetaData(s.sdkCtx, wrappedDenom)
+	require.True(found)
+	require.Len(metadata.DenomUnits, 2)
+	require.Equal(uint32(6), metadata.DenomUnits[1].Exponent)
+	require.Equal(metadata.DenomUnits[1].Denom, metadata.Display)
+
+	// wrapped tokens can be sent with the bank module
+	require.NoError(s.bankKeeper.SendCoins(s.sdkCtx, user, user2, sdk.NewCoins(sdk.NewInt64Coin(wrappedDenom, 4000000))))
+
+	// user2 can unwrap the credits
+	_, err = s.msgClient.Unwrap(s.ctx, &basetypes.MsgUnwrap{
+		Owner:      user2.String(),
+		BatchDenom: batchDenom,
+		Amount:     "4",
+	})
+	require.NoError(err)
+	require.True(s.getUserBalance(user2, wrappedDenom).Amount.IsZero())
+	s.assertDecStrEqual("4", s.getUserBatchBalance(user2, batchDenom).TradableAmount)
+
+	// user2 cannot unwrap more credits than the wrapped tokens held
+	_, err = s.msgClient.Unwrap(s.ctx, &basetypes.MsgUnwrap{
+		Owner:      user2.String(),
+		BatchDenom: batchDenom,
+		Amount:     "1",
+	})
+	require.Error(err)
+	require.Contains(err.Error(), sdkerrors.ErrInsufficientFunds.Error())
+
+	// the user unwraps the remaining credits
+	_, err = s.msgClient.Unwrap(s.ctx, &basetypes.MsgUnwrap{
+		Owner:      user.String(),
+		BatchDenom: batchDenom,
+		Amount:     "6.5",
+	})
+	require.NoError(err)
+	require.True(s.getUserBalance(user, wrappedDenom).Amount.IsZero())
+	s.assertDecStrEqual("96", s.getUserBatchBalance(user, batchDenom).TradableAmount)
+	require.True(s.bankKeeper.GetSupply(s.sdkCtx, wrappedDenom).Amount.IsZero())
+
+	supply, err := s.queryClient.Supply(s.ctx, &basetypes.QuerySupplyRequest{BatchDenom: batchDenom})
+	require.NoError(err)
+	s.assertDecStrEqual("100", supply.TradableAmount)
+}
+
 func (s *IntegrationTestSuite) createClassAndIssueBatch(admin, recipient sdk.AccAddress, creditTypeAbbrev, tradableAmount, startStr, endStr string) (string, string) {
 	require := s.Require()
 	// fund the account so this doesn't fail
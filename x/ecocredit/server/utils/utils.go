@@ -55,3 +55,12 @@ func GetBalance(ctx context.Context, table api.BatchBalanceTable, addr types.Acc
 	}
 	return bal, nil
 }
+
+// GetLockedAmount returns the locked amount of the balance. The locked amount was
+// added in a later revision and is empty for balances that never held locked credits.
+func GetLockedAmount(bal *api.BatchBalance) (math.Dec, error) {
+	if bal.LockedAmount == "" {
+		return math.NewDecFromInt64(0), nil
+	}
+	return math.NewNonNegativeDecFromString(bal.LockedAmount)
+}
@@ -413,6 +413,24 @@ func genGenesisState(ctx context.Context, simState *module.SimulationState, ss a
 		return err
 	}
 
+	// index batch balances
+	for _, index := range []*api.BatchBalanceIndex{
+		{Address: accs[0].Address, BatchKey: bKey1, ClassKey: cKey1, ProjectKey: pKey1},
+		{Address: accs[2].Address, BatchKey: bKey2, ClassKey: cKey2, ProjectKey: pKey2},
+		{Address: accs[1].Address, BatchKey: bKey2, ClassKey: cKey2, ProjectKey: pKey2},
+	} {
+		if err := ss.BatchBalanceIndexTable().Insert(ctx, index); err != nil {
+			return err
+		}
+
+		if err := ss.ProjectHolderTable().Insert(ctx, &api.ProjectHolder{
+			ProjectKey: index.ProjectKey,
+			Address:    index.Address,
+		}); err != nil {
+			return err
+		}
+	}
+
 	// add batch supply
 	if err := ss.BatchSupplyTable().Insert(ctx, &api.BatchSupply{
 		BatchKey:       bKey1,
@@ -430,6 +448,52 @@ func genGenesisState(ctx context.Context, simState *module.SimulationState, ss a
 		return err
 	}
 
+	// add aggregated supply
+	if err := ss.ProjectSupplyTable().Insert(ctx, &api.ProjectSupply{
+		ProjectKey:      pKey1,
+		TradableAmount:  "100",
+		RetiredAmount:   "10",
+		CancelledAmount: "0",
+	}); err != nil {
+		return err
+	}
+
+	if err := ss.ProjectSupplyTable().Insert(ctx, &api.ProjectSupply{
+		ProjectKey:      pKey2,
+		TradableAmount:  "200",
+		RetiredAmount:   "10",
+		CancelledAmount: "0",
+	}); err != nil {
+		return err
+	}
+
+	if err := ss.ClassSupplyTable().Insert(ctx, &api.ClassSupply{
+		ClassKey:        cKey1,
+		TradableAmount:  "100",
+		RetiredAmount:   "10",
+		CancelledAmount: "0",
+	}); err != nil {
+		return err
+	}
+
+	if err := ss.ClassSupplyTable().Insert(ctx, &api.ClassSupply{
+		ClassKey:        cKey2,
+		TradableAmount:  "200",
+		RetiredAmount:   "10",
+		CancelledAmount: "0",
+	}); err != nil {
+		return err
+	}
+
+	if err := ss.CreditTypeSupplyTable().Insert(ctx, &api.CreditTypeSupply{
+		CreditTypeAbbrev: "C",
+		TradableAmount:   "300",
+		RetiredAmount:    "20",
+		CancelledAmount:  "0",
+	}); err != nil {
+		return err
+	}
+
 	if err := ss.AllowedBridgeChainTable().Insert(ctx, &api.AllowedBridgeChain{
 		ChainName: "polygon",
 	}); err != nil {